@@ -0,0 +1,26 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// v1alpha1 is the storage version of the NonAdminBackup and NonAdminRestore APIs, and the hub the other
+// versions are converted to and from by the NAC conversion webhook
+
+// Hub marks NonAdminBackup as a conversion hub.
+func (*NonAdminBackup) Hub() {}
+
+// Hub marks NonAdminRestore as a conversion hub.
+func (*NonAdminRestore) Hub() {}
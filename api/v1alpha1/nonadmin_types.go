@@ -16,23 +16,33 @@ limitations under the License.
 
 package v1alpha1
 
-// NonAdminPhase is a simple one high-level summary of the lifecycle of a NonAdminBackup, NonAdminRestore, NonAdminBackupStorageLocation, or NonAdminDownloadRequest
-// +kubebuilder:validation:Enum=New;BackingOff;Created;Deleting
+// NonAdminPhase is a simple one high-level summary of the lifecycle of a NonAdminBackup, NonAdminRestore, NonAdminBackupStorageLocation, NonAdminDownloadRequest, or NonAdminSchedule
+// +kubebuilder:validation:Enum=New;Pending;BackingOff;Created;Deleting;Completed;PartiallyFailed;Failed;Canceled
 type NonAdminPhase string
 
 const (
 	// NonAdminPhaseNew - NonAdmin object was accepted by the OpenShift cluster, but it has not yet been processed by the NonAdminController
 	NonAdminPhaseNew NonAdminPhase = "New"
+	// NonAdminPhasePending - NonAdmin object was validated, but its Velero object is not created until the NonAdmin object it depends on completes
+	NonAdminPhasePending NonAdminPhase = "Pending"
 	// NonAdminPhaseBackingOff - Velero object was not created due to NonAdmin object error (configuration or similar)
 	NonAdminPhaseBackingOff NonAdminPhase = "BackingOff"
 	// NonAdminPhaseCreated - Velero object was created. The Phase will not have additional information about it.
 	NonAdminPhaseCreated NonAdminPhase = "Created"
 	// NonAdminPhaseDeleting - Velero object is pending deletion. The Phase will not have additional information about it.
 	NonAdminPhaseDeleting NonAdminPhase = "Deleting"
+	// NonAdminPhaseCompleted - Velero object has completed successfully.
+	NonAdminPhaseCompleted NonAdminPhase = "Completed"
+	// NonAdminPhasePartiallyFailed - Velero object has completed, but with errors.
+	NonAdminPhasePartiallyFailed NonAdminPhase = "PartiallyFailed"
+	// NonAdminPhaseFailed - Velero object has failed, including failed validation by Velero.
+	NonAdminPhaseFailed NonAdminPhase = "Failed"
+	// NonAdminPhaseCanceled - NonAdmin object was canceled by the user, the Velero object data movement was cancelled.
+	NonAdminPhaseCanceled NonAdminPhase = "Canceled"
 )
 
 // NonAdminCondition are used for more detailed information supporing NonAdminBackupPhase state.
-// +kubebuilder:validation:Enum=Accepted;Queued;Deleting
+// +kubebuilder:validation:Enum=Accepted;Queued;Deleting;DeletionStalled;QuotaWouldBeExceeded;DeadlineExceeded;SpecOverridden;RestoreCompletedWithWarnings;Previewed;Canceled;ConcurrentRestore;RestoreVerified
 type NonAdminCondition string
 
 // Predefined conditions for NonAdminController objects.
@@ -40,9 +50,21 @@ type NonAdminCondition string
 // It is more granular knowledge of the NonAdminController object and represents the
 // array of the conditions through which the NonAdminController has or has not passed
 const (
-	NonAdminConditionAccepted NonAdminCondition = "Accepted"
-	NonAdminConditionQueued   NonAdminCondition = "Queued"
-	NonAdminConditionDeleting NonAdminCondition = "Deleting"
+	NonAdminConditionAccepted                     NonAdminCondition = "Accepted"
+	NonAdminConditionQueued                       NonAdminCondition = "Queued"
+	NonAdminConditionDeleting                     NonAdminCondition = "Deleting"
+	NonAdminConditionDeletionStalled              NonAdminCondition = "DeletionStalled"
+	NonAdminConditionQuotaWouldBeExceeded         NonAdminCondition = "QuotaWouldBeExceeded"
+	NonAdminConditionDeadlineExceeded             NonAdminCondition = "DeadlineExceeded"
+	NonAdminConditionSpecOverridden               NonAdminCondition = "SpecOverridden"
+	NonAdminConditionRestoreCompletedWithWarnings NonAdminCondition = "RestoreCompletedWithWarnings"
+	NonAdminConditionPreviewed                    NonAdminCondition = "Previewed"
+	NonAdminConditionCanceled                     NonAdminCondition = "Canceled"
+	NonAdminConditionConcurrentRestore            NonAdminCondition = "ConcurrentRestore"
+	NonAdminConditionRestoreVerified              NonAdminCondition = "RestoreVerified"
+	NonAdminConditionApproved                     NonAdminCondition = "Approved"
+	NonAdminConditionBackupVerified               NonAdminCondition = "BackupVerified"
+	NonAdminConditionDelivered                    NonAdminCondition = "Delivered"
 )
 
 // QueueInfo holds the queue position for a specific operation.
@@ -62,4 +84,31 @@ const (
 
 	// NonAdminBackupStorageLocations represents the resource name for non-admin backup storage locations.
 	NonAdminBackupStorageLocations = "nonadminbackupstoragelocations"
+
+	// NonAdminSchedules represents the resource name for non-admin schedules.
+	NonAdminSchedules = "nonadminschedules"
+
+	// NonAdminVolumeSnapshotLocations represents the resource name for non-admin volume snapshot locations.
+	NonAdminVolumeSnapshotLocations = "nonadminvolumesnapshotlocations"
+
+	// NonAdminDataProtectionTests represents the resource name for non-admin data protection tests.
+	NonAdminDataProtectionTests = "nonadmindataprotectiontests"
+
+	// NonAdminQuotaStatuses represents the resource name for non-admin quota statuses.
+	NonAdminQuotaStatuses = "nonadminquotastatuses"
+
+	// NonAdminBackupVerifications represents the resource name for non-admin backup verifications.
+	NonAdminBackupVerifications = "nonadminbackupverifications"
+
+	// NonAdminNotifications represents the resource name for non-admin notifications.
+	NonAdminNotifications = "nonadminnotifications"
+
+	// NonAdminGroupBackups represents the resource name for non-admin group backups.
+	NonAdminGroupBackups = "nonadmingroupbackups"
+
+	// NonAdminDeleteBackupRequests represents the resource name for non-admin delete backup requests.
+	NonAdminDeleteBackupRequests = "nonadmindeletebackuprequests"
+
+	// NonAdminRetentionPolicies represents the resource name for non-admin retention policies.
+	NonAdminRetentionPolicies = "nonadminretentionpolicies"
 )
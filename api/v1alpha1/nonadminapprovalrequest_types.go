@@ -0,0 +1,145 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminApprovalDecision is the decision of the cluster admin on a NonAdminApprovalRequest
+// +kubebuilder:validation:Enum=approve;reject;pending
+type NonAdminApprovalDecision string
+
+// NonAdminApprovalRequestPhase is the phase of the NonAdminApprovalRequest
+// +kubebuilder:validation:Enum=Pending;Approved;Rejected;Expired
+type NonAdminApprovalRequestPhase string
+
+// NonAdminApprovalOperation identifies the guarded operation a NonAdminApprovalRequest asks approval for
+type NonAdminApprovalOperation string
+
+// Predefined NonAdminApprovalDecisions
+const (
+	NonAdminApprovalDecisionApprove NonAdminApprovalDecision = "approve"
+	NonAdminApprovalDecisionReject  NonAdminApprovalDecision = "reject"
+	NonAdminApprovalDecisionPending NonAdminApprovalDecision = "pending"
+)
+
+// Predefined NonAdminApprovalRequestPhases
+const (
+	NonAdminApprovalRequestPhasePending  NonAdminApprovalRequestPhase = "Pending"
+	NonAdminApprovalRequestPhaseApproved NonAdminApprovalRequestPhase = "Approved"
+	NonAdminApprovalRequestPhaseRejected NonAdminApprovalRequestPhase = "Rejected"
+	NonAdminApprovalRequestPhaseExpired  NonAdminApprovalRequestPhase = "Expired"
+)
+
+// Predefined NonAdminApprovalOperations
+const (
+	// NonAdminApprovalOperationMultiNamespaceBackup guards NonAdminBackups including namespaces other than their own
+	NonAdminApprovalOperationMultiNamespaceBackup NonAdminApprovalOperation = "multi-namespace-backup"
+)
+
+// NonAdminApprovalRequestSpec defines the desired state of NonAdminApprovalRequest
+type NonAdminApprovalRequestSpec struct {
+	// approvalDecision is the decision of the cluster admin on the requested operation.
+	// The value may be set to either approve or reject.
+	// +optional
+	ApprovalDecision NonAdminApprovalDecision `json:"approvalDecision,omitempty"`
+
+	// reason is the explanation of the cluster admin for the approval decision.
+	// It is shown in the Approved condition of the non admin object requesting the operation.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Reason string `json:"reason,omitempty"`
+}
+
+// ApprovalSourceObject contains information of the non admin object that triggered the NonAdminApprovalRequest
+type ApprovalSourceObject struct {
+	// kind of the non admin object, for example NonAdminBackup
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// nacuuid references the non admin object by it's label containing same NACUUID.
+	// +optional
+	NACUUID string `json:"nacuuid,omitempty"`
+
+	// name references the non admin object by it's name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// namespace references the Namespace in which the non admin object exists.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NonAdminApprovalRequestStatus defines the observed state of NonAdminApprovalRequest
+type NonAdminApprovalRequestStatus struct {
+	// sourceObject contains information of the non admin object that triggered the NonAdminApprovalRequest
+	// +optional
+	SourceObject *ApprovalSourceObject `json:"sourceObject,omitempty"`
+
+	// expirationTime is the time after which a pending NonAdminApprovalRequest expires.
+	// It is not set when NonAdminApprovalRequests do not expire.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// operation is the guarded operation the approval is requested for, for example multi-namespace-backup
+	// +optional
+	Operation NonAdminApprovalOperation `json:"operation,omitempty"`
+
+	// details describes what the approval is requested for, for example the namespaces included in a backup.
+	// When they change, the request goes back to pending.
+	// +optional
+	Details string `json:"details,omitempty"`
+
+	// phase represents the current state of the NonAdminApprovalRequest.
+	// It can be either Pending, Approved, Rejected or Expired.
+	// +optional
+	Phase NonAdminApprovalRequestPhase `json:"phase,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminapprovalrequests,shortName=naar
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Operation",type="string",JSONPath=".status.operation"
+// +kubebuilder:printcolumn:name="Request-Namespace",type="string",JSONPath=".status.sourceObject.namespace"
+// +kubebuilder:printcolumn:name="Request-Name",type="string",JSONPath=".status.sourceObject.name"
+// +kubebuilder:printcolumn:name="Expiration",type="date",JSONPath=".status.expirationTime",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminApprovalRequest is the Schema for the nonadminapprovalrequests API.
+// NAC creates it in the OADP namespace when a non admin object requests an operation
+// which needs the sign-off of the cluster admin.
+type NonAdminApprovalRequest struct {
+	Status            NonAdminApprovalRequestStatus `json:"status,omitempty"`
+	Spec              NonAdminApprovalRequestSpec   `json:"spec,omitempty"`
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminApprovalRequestList contains a list of NonAdminApprovalRequest
+type NonAdminApprovalRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminApprovalRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminApprovalRequest{}, &NonAdminApprovalRequestList{})
+}
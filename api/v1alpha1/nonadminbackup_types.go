@@ -23,15 +23,63 @@ import (
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
 )
 
+// NonAdminBackupDeletionStage is the step of the NonAdminBackup deletion in progress
+// +kubebuilder:validation:Enum=DeleteBackupRequestPending;DeleteBackupRequestCreated;BackupDataDeleting;BackupDataDeletionFailed;BackupDataDeleted;FinalizerRemovalPending
+type NonAdminBackupDeletionStage string
+
+// Predefined NonAdminBackup deletion stages
+const (
+	// NonAdminBackupDeletionStageDeleteBackupRequestPending - the deletion was accepted, the DeleteBackupRequest is not created yet
+	NonAdminBackupDeletionStageDeleteBackupRequestPending NonAdminBackupDeletionStage = "DeleteBackupRequestPending"
+	// NonAdminBackupDeletionStageDeleteBackupRequestCreated - the DeleteBackupRequest was created and waits for Velero
+	NonAdminBackupDeletionStageDeleteBackupRequestCreated NonAdminBackupDeletionStage = "DeleteBackupRequestCreated"
+	// NonAdminBackupDeletionStageBackupDataDeleting - Velero is removing the snapshots and the backup data in object storage
+	NonAdminBackupDeletionStageBackupDataDeleting NonAdminBackupDeletionStage = "BackupDataDeleting"
+	// NonAdminBackupDeletionStageBackupDataDeletionFailed - Velero processed the DeleteBackupRequest with errors
+	NonAdminBackupDeletionStageBackupDataDeletionFailed NonAdminBackupDeletionStage = "BackupDataDeletionFailed"
+	// NonAdminBackupDeletionStageBackupDataDeleted - Velero removed the backup data, the VeleroBackup removal is pending
+	NonAdminBackupDeletionStageBackupDataDeleted NonAdminBackupDeletionStage = "BackupDataDeleted"
+	// NonAdminBackupDeletionStageFinalizerRemovalPending - the VeleroBackup was removed, the NonAdminBackup finalizer removal is pending
+	NonAdminBackupDeletionStageFinalizerRemovalPending NonAdminBackupDeletionStage = "FinalizerRemovalPending"
+)
+
 // NonAdminBackupSpec defines the desired state of NonAdminBackup
 type NonAdminBackupSpec struct {
 	// BackupSpec defines the specification for a Velero backup.
 	BackupSpec *velerov1.BackupSpec `json:"backupSpec"`
 
+	// ActiveDeadlineSeconds is the time, since the VeleroBackup started, after which a VeleroBackup
+	// still running has its DataUploads cancelled and the DeadlineExceeded condition set.
+	// It may not exceed the maximum set by the cluster admin, which applies when it is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
 	// DeleteBackup removes the NonAdminBackup and its associated NonAdminRestores and VeleroBackup from the cluster,
 	// as well as the corresponding data in object storage
 	// +optional
 	DeleteBackup bool `json:"deleteBackup,omitempty"`
+
+	// DeleteBackupConfirmation must be set to the NonAdminBackup name for DeleteBackup to take effect,
+	// when the cluster admin requires deletion confirmation
+	// +optional
+	DeleteBackupConfirmation string `json:"deleteBackupConfirmation,omitempty"`
+
+	// RetainBackupOnDelete keeps the VeleroBackup and its data in object storage when the NonAdminBackup
+	// is deleted, handing the VeleroBackup over to the cluster admin. Ignored when DeleteBackup is set.
+	// +optional
+	RetainBackupOnDelete bool `json:"retainBackupOnDelete,omitempty"`
+
+	// RecreateOnMissingVeleroBackup creates a new VeleroBackup when the VeleroBackup is deleted
+	// before it completes, for example by mistake, instead of moving the NonAdminBackup to BackingOff.
+	// +optional
+	RecreateOnMissingVeleroBackup bool `json:"recreateOnMissingVeleroBackup,omitempty"`
+
+	// VerifyRestore restores the backup, once it completes, into a temporary namespace created by NAC,
+	// and records whether it could be restored in the RestoreVerified condition.
+	// The temporary namespace enforces the restricted Pod Security Standard, and is deleted once the verification is done.
+	// +optional
+	VerifyRestore bool `json:"verifyRestore,omitempty"`
 }
 
 // VeleroBackup contains information of the related Velero backup object.
@@ -113,10 +161,49 @@ type DataMoverDataUploads struct {
 	// number of DataUploads related to this NonAdminBackup's Backup in phase Completed
 	// +optional
 	Completed int `json:"completed,omitempty"`
+
+	// total number of bytes to be transferred by the DataUploads related to this NonAdminBackup's Backup
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+
+	// number of bytes transferred by the DataUploads related to this NonAdminBackup's Backup
+	// +optional
+	BytesDone int64 `json:"bytesDone,omitempty"`
+
+	// percentage of bytes transferred by the DataUploads related to this NonAdminBackup's Backup
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ProgressPercentage int `json:"progressPercentage,omitempty"`
+}
+
+// PodVolumeBackupFailure contains information of a related Velero PodVolumeBackup object in phase Failed.
+type PodVolumeBackupFailure struct {
+	// namespace of the pod whose volume was backed up
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// name of the pod whose volume was backed up
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// name of the pod volume that was backed up
+	// +optional
+	Volume string `json:"volume,omitempty"`
+
+	// message of the PodVolumeBackup failure
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // FileSystemPodVolumeBackups contains information of the related Velero PodVolumeBackup objects.
 type FileSystemPodVolumeBackups struct {
+	// failures lists the PodVolumeBackups related to this NonAdminBackup's Backup in phase Failed,
+	// up to 10 of them, ordered by pod namespace, pod name and volume name
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	Failures []PodVolumeBackupFailure `json:"failures,omitempty"`
+
 	// number of PodVolumeBackups related to this NonAdminBackup's Backup
 	// +optional
 	Total int `json:"total,omitempty"`
@@ -138,6 +225,35 @@ type FileSystemPodVolumeBackups struct {
 	Completed int `json:"completed,omitempty"`
 }
 
+// SnapshotMoveData contains the snapshotMoveData value used by this NonAdminBackup's Backup.
+type SnapshotMoveData struct {
+	// enabled is true if the CSI snapshots of this NonAdminBackup's Backup are moved to the backup storage location
+	Enabled bool `json:"enabled"`
+
+	// overridden is true if the cluster admin forced a value different from spec.backupSpec.snapshotMoveData
+	// +optional
+	Overridden bool `json:"overridden,omitempty"`
+}
+
+// RestoreVerification contains information of the restore verifying this NonAdminBackup, when spec.verifyRestore is set.
+type RestoreVerification struct {
+	// namespace is the temporary namespace, created by NAC, the backup is restored into
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// nonAdminRestore is the name of the NonAdminRestore, in the NonAdminBackup namespace, restoring the backup
+	// +optional
+	NonAdminRestore string `json:"nonAdminRestore,omitempty"`
+
+	// phase is the phase of the NonAdminRestore restoring the backup
+	// +optional
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	// cleanedUp is true once the NonAdminRestore and the temporary namespace were deleted
+	// +optional
+	CleanedUp bool `json:"cleanedUp,omitempty"`
+}
+
 // NonAdminBackupStatus defines the observed state of NonAdminBackup
 type NonAdminBackupStatus struct {
 	// +optional
@@ -152,6 +268,14 @@ type NonAdminBackupStatus struct {
 	// +optional
 	FileSystemPodVolumeBackups *FileSystemPodVolumeBackups `json:"fileSystemPodVolumeBackups,omitempty"`
 
+	// +optional
+	SnapshotMoveData *SnapshotMoveData `json:"snapshotMoveData,omitempty"`
+
+	// enforcedFields lists the spec.backupSpec fields of this NonAdminBackup's Backup set or overridden
+	// by the cluster admin or NAC, which is why the Backup may differ from spec.backupSpec.
+	// +optional
+	EnforcedFields []string `json:"enforcedFields,omitempty"`
+
 	// queueInfo is used to estimate how many backups are scheduled before the given VeleroBackup in the OADP namespace.
 	// This number is not guaranteed to be accurate, but it should be close. It's inaccurate for cases when
 	// Velero pod is not running or being restarted after Backup object were created.
@@ -159,6 +283,19 @@ type NonAdminBackupStatus struct {
 	// +optional
 	QueueInfo *QueueInfo `json:"queueInfo,omitempty"`
 
+	// retryCount is the number of times creating the VeleroBackup was retried after a transient error.
+	// +optional
+	RetryCount int `json:"retryCount,omitempty"`
+
+	// deletionStage details which step of the deletion the NonAdminBackup is in,
+	// while spec.deleteBackup is being processed.
+	// +optional
+	DeletionStage NonAdminBackupDeletionStage `json:"deletionStage,omitempty"`
+
+	// restoreVerification details the restore verifying this NonAdminBackup, when spec.verifyRestore is set.
+	// +optional
+	RestoreVerification *RestoreVerification `json:"restoreVerification,omitempty"`
+
 	// phase is a simple one high-level summary of the lifecycle of an NonAdminBackup.
 	Phase NonAdminPhase `json:"phase,omitempty"`
 
@@ -168,8 +305,10 @@ type NonAdminBackupStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=nonadminbackups,shortName=nab
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="Velero-Phase",type="string",JSONPath=".status.veleroBackup.status.phase"
+// +kubebuilder:printcolumn:name="Deletion-Stage",type="string",JSONPath=".status.deletionStage",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NonAdminBackup is the Schema for the nonadminbackups API
@@ -0,0 +1,62 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminBackupShareSpec defines the desired state of NonAdminBackupShare
+type NonAdminBackupShareSpec struct {
+	// nonAdminBackupName is the name of the shared NonAdminBackup, in the NonAdminBackupShare namespace.
+	// +kubebuilder:validation:MinLength=1
+	NonAdminBackupName string `json:"nonAdminBackupName"`
+
+	// targetNamespace is the namespace whose NonAdminRestores may restore the shared NonAdminBackup,
+	// setting spec.backupNamespace to the NonAdminBackupShare namespace.
+	// +kubebuilder:validation:MinLength=1
+	TargetNamespace string `json:"targetNamespace"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=nonadminbackupshares,shortName=nabshare
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.nonAdminBackupName"
+// +kubebuilder:printcolumn:name="Target-Namespace",type="string",JSONPath=".spec.targetNamespace"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminBackupShare is the Schema for the nonadminbackupshares API.
+// It is created in the namespace of a NonAdminBackup, by its owner or the cluster admin, to let the
+// NonAdminRestores of another namespace restore the NonAdminBackup.
+type NonAdminBackupShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NonAdminBackupShareSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminBackupShareList contains a list of NonAdminBackupShare
+type NonAdminBackupShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminBackupShare `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminBackupShare{}, &NonAdminBackupShareList{})
+}
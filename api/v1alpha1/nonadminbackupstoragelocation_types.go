@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,11 +32,137 @@ const (
 	NonAdminBSLConditionBSLSynced          NonAdminBSLCondition = "BackupStorageLocationSynced"
 	NonAdminBSLConditionApproved           NonAdminBSLCondition = "ClusterAdminApproved"
 	NonAdminBSLConditionSpecUpdateApproved NonAdminBSLCondition = "SpecUpdateApproved"
+	// NonAdminBSLConditionObjectStorageAvailable reports whether Velero could reach the bucket of the
+	// BackupStorageLocation, and why not: authentication, network or missing bucket
+	NonAdminBSLConditionObjectStorageAvailable NonAdminBSLCondition = "ObjectStorageAvailable"
+	// NonAdminBSLConditionDeletionBlocked reports the running NonAdminBackups and NonAdminRestores the deletion
+	// of the NonAdminBackupStorageLocation waits for
+	NonAdminBSLConditionDeletionBlocked NonAdminBSLCondition = "DeletionBlocked"
+	// NonAdminBSLConditionTransferred reports the transfer of the NonAdminBackupStorageLocation to another
+	// namespace by the cluster admin: its progress in the source namespace, its origin in the target one
+	NonAdminBSLConditionTransferred NonAdminBSLCondition = "Transferred"
+	// NonAdminBSLConditionConfigDrift reports the fields of the BackupStorageLocation modified out-of-band, by the
+	// cluster admin or another operator, which differ from the NonAdminBackupStorageLocation
+	NonAdminBSLConditionConfigDrift NonAdminBSLCondition = "ConfigDrift"
+	// NonAdminBSLConditionPaused reports whether the NonAdminBackupStorageLocation is taken out of service by
+	// its spec.paused field
+	NonAdminBSLConditionPaused NonAdminBSLCondition = "Paused"
 )
 
 // NonAdminBackupStorageLocationSpec defines the desired state of NonAdminBackupStorageLocation
 type NonAdminBackupStorageLocationSpec struct {
 	BackupStorageLocationSpec *velerov1.BackupStorageLocationSpec `json:"backupStorageLocationSpec"`
+
+	// default marks the NonAdminBackupStorageLocation as the default one of its namespace, used by the
+	// NonAdminBackups which do not set spec.backupSpec.storageLocation. Only one NonAdminBackupStorageLocation
+	// per namespace can be the default.
+	// +optional
+	Default bool `json:"default,omitempty"`
+
+	// caCertConfigMap references the key of a ConfigMap, in the NonAdminBackupStorageLocation namespace, containing
+	// the PEM encoded CA bundle of the object storage, for example a self-signed S3-compatible endpoint. It is copied
+	// to the objectStorage.caCert field of the VeleroBackupStorageLocation and kept in sync with the ConfigMap.
+	// +optional
+	CACertConfigMap *corev1.ConfigMapKeySelector `json:"caCertConfigMap,omitempty"`
+
+	// cloudIdentity configures short-lived credentials, obtained by Velero with its projected service account token,
+	// in place of a long-lived key Secret in backupStorageLocationSpec.credential. The credential file of the
+	// VeleroBackupStorageLocation is rendered from it. The cluster admin has to allow it.
+	// +optional
+	CloudIdentity *CloudIdentity `json:"cloudIdentity,omitempty"`
+
+	// credential configures how the Secret referenced by backupStorageLocationSpec.credential is rendered
+	// into the Secret of the VeleroBackupStorageLocation.
+	// +optional
+	Credential *CredentialOptions `json:"credential,omitempty"`
+
+	// accessMode of the VeleroBackupStorageLocation. ReadOnly attaches a location containing existing backups,
+	// which are synced and can be restored, without NonAdminBackups being able to write new backups to it.
+	// +optional
+	// +kubebuilder:validation:Enum=ReadWrite;ReadOnly
+	AccessMode velerov1.BackupStorageLocationAccessMode `json:"accessMode,omitempty"`
+
+	// objectLock declares the bucket of the object storage has S3 Object Lock enabled with a default retention, which
+	// makes the backups immutable (WORM), for example to protect them from ransomware. Velero writes the backups with
+	// the retention of the bucket, so the NonAdminBackups using the location must not expire before it ends.
+	// +optional
+	ObjectLock *ObjectLock `json:"objectLock,omitempty"`
+
+	// paused takes the NonAdminBackupStorageLocation out of service, for example during a maintenance window of the
+	// object storage, without deleting it: its VeleroBackupStorageLocation is made ReadOnly and does not sync its
+	// backups, and NonAdminBackups can not use it, until it is unset.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ObjectLockMode is the S3 Object Lock retention mode of a bucket
+// +kubebuilder:validation:Enum=Governance;Compliance
+type ObjectLockMode string
+
+// Predefined ObjectLockModes
+const (
+	// ObjectLockModeGovernance lets users with a special permission delete the objects before their retention ends
+	ObjectLockModeGovernance ObjectLockMode = "Governance"
+	// ObjectLockModeCompliance prevents any user, the root account included, from deleting the objects before
+	// their retention ends
+	ObjectLockModeCompliance ObjectLockMode = "Compliance"
+)
+
+// ObjectLock describes the S3 Object Lock default retention of the bucket of the backup storage location.
+type ObjectLock struct {
+	// mode of the default retention of the bucket.
+	Mode ObjectLockMode `json:"mode"`
+
+	// retentionPeriod of the default retention of the bucket, the backups can not be deleted from the object
+	// storage before. It must be positive.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod"`
+}
+
+// CredentialOptions configures the rendering of the credential Secret of the backup storage location.
+type CredentialOptions struct {
+	// profile selects a profile of the shared credentials file containing several ones, for the aws provider.
+	// Only this profile is copied, as the default profile, to the Secret of the VeleroBackupStorageLocation.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.@+-]+$`
+	Profile string `json:"profile"`
+}
+
+// CloudIdentity configures the workload identity Velero uses for the backup storage location.
+// Exactly one of its fields must be set, matching the backup storage location provider.
+type CloudIdentity struct {
+	// aws assumes an IAM role with AWS STS, for the aws provider
+	// +optional
+	AWS *AWSCloudIdentity `json:"aws,omitempty"`
+
+	// azure uses Azure workload identity, for the azure provider
+	// +optional
+	Azure *AzureCloudIdentity `json:"azure,omitempty"`
+}
+
+// AWSCloudIdentity configures the IAM role assumed with AWS STS
+type AWSCloudIdentity struct {
+	// roleARN is the ARN of the IAM role Velero assumes, its trust policy must allow the Velero service account
+	// +kubebuilder:validation:Pattern=`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`
+	RoleARN string `json:"roleARN"`
+}
+
+// AzureCloudIdentity configures the Azure workload identity
+type AzureCloudIdentity struct {
+	// subscriptionID is the Azure subscription of the storage account
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+	SubscriptionID string `json:"subscriptionID"`
+
+	// tenantID is the Microsoft Entra tenant of the managed identity
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+	TenantID string `json:"tenantID"`
+
+	// clientID is the client ID of the managed identity, its federated credential must allow the Velero service account
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`
+	ClientID string `json:"clientID"`
+
+	// cloudName is the Azure cloud, defaults to AzurePublicCloud
+	// +optional
+	// +kubebuilder:validation:Enum=AzurePublicCloud;AzureUSGovernmentCloud;AzureChinaCloud;AzureGermanCloud
+	CloudName string `json:"cloudName,omitempty"`
 }
 
 // VeleroBackupStorageLocation contains information of the related Velero backup object.
@@ -57,11 +184,57 @@ type VeleroBackupStorageLocation struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// BackupSummary summarizes the Velero backups stored in a backup storage location.
+type BackupSummary struct {
+	// backupCount is the number of Velero backups stored in the backup storage location
+	BackupCount int `json:"backupCount"`
+
+	// mostRecentBackup is the name of the most recently started backup stored in the backup storage location
+	// +optional
+	MostRecentBackup string `json:"mostRecentBackup,omitempty"`
+
+	// mostRecentBackupTimestamp is the start time of the most recently started backup
+	// +optional
+	MostRecentBackupTimestamp *metav1.Time `json:"mostRecentBackupTimestamp,omitempty"`
+
+	// totalBytes is the sum of bytes reported by the file system and data mover
+	// backups of the Velero backups stored in the backup storage location
+	// +optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+
+	// updateTime is the last time the summary changed, or was computed again after the storage usage
+	// period set by the cluster admin
+	// +optional
+	// +nullable
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
 // NonAdminBackupStorageLocationStatus defines the observed state of NonAdminBackupStorageLocation
 type NonAdminBackupStorageLocationStatus struct {
 	// +optional
 	VeleroBackupStorageLocation *VeleroBackupStorageLocation `json:"veleroBackupStorageLocation,omitempty"`
 
+	// backupSummary summarizes the backups stored in the backup storage location
+	// +optional
+	BackupSummary *BackupSummary `json:"backupSummary,omitempty"`
+
+	// lastSuccessfulValidationTime is the last time Velero validated the backup storage location as Available
+	// +optional
+	// +nullable
+	LastSuccessfulValidationTime *metav1.Time `json:"lastSuccessfulValidationTime,omitempty"`
+
+	// lastSyncedTime is the last time Velero synced the backups stored in the backup storage location
+	// into the cluster
+	// +optional
+	// +nullable
+	LastSyncedTime *metav1.Time `json:"lastSyncedTime,omitempty"`
+
+	// enforcedFields lists the spec.backupStorageLocationSpec fields of this NonAdminBackupStorageLocation's
+	// VeleroBackupStorageLocation set or overridden by the cluster admin or NAC, which is why the
+	// VeleroBackupStorageLocation may differ from spec.backupStorageLocationSpec.
+	// +optional
+	EnforcedFields []string `json:"enforcedFields,omitempty"`
+
 	// phase is a simple one high-level summary of the lifecycle of an NonAdminBackupStorageLocation.
 	Phase NonAdminPhase `json:"phase,omitempty"`
 
@@ -74,6 +247,15 @@ type NonAdminBackupStorageLocationStatus struct {
 // +kubebuilder:printcolumn:name="Request-Approved",type="string",JSONPath=".status.conditions[?(@.type=='ClusterAdminApproved')].status"
 // +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="Velero-Phase",type="string",JSONPath=".status.veleroBackupStorageLocation.status.phase"
+// +kubebuilder:printcolumn:name="Last-Validated",type="date",JSONPath=".status.veleroBackupStorageLocation.status.lastValidationTime"
+// +kubebuilder:printcolumn:name="Last-Successful-Validation",type="date",JSONPath=".status.lastSuccessfulValidationTime"
+// +kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.default"
+// +kubebuilder:printcolumn:name="Access-Mode",type="string",JSONPath=".spec.accessMode",priority=1
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.paused",priority=1
+// +kubebuilder:printcolumn:name="Backups",type="integer",JSONPath=".status.backupSummary.backupCount",priority=1
+// +kubebuilder:printcolumn:name="Last-Synced",type="date",JSONPath=".status.lastSyncedTime",priority=1
+// +kubebuilder:printcolumn:name="Stored-Bytes",type="integer",JSONPath=".status.backupSummary.totalBytes",priority=1
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.veleroBackupStorageLocation.status.message",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NonAdminBackupStorageLocation is the Schema for the nonadminbackupstoragelocations API
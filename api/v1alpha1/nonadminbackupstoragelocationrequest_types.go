@@ -49,6 +49,13 @@ type NonAdminBackupStorageLocationRequestSpec struct {
 	// The value may be set to either approve or reject.
 	// +optional
 	ApprovalDecision NonAdminBSLRequest `json:"approvalDecision,omitempty"`
+
+	// reason is the explanation of the cluster admin for the approval decision, for example why an approved
+	// NonAdminBackupStorageLocation is revoked by setting approvalDecision to reject. It is shown in the
+	// ClusterAdminApproved condition of the NonAdminBackupStorageLocation.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Reason string `json:"reason,omitempty"`
 }
 
 // SourceNonAdminBSL contains information of the NonAdminBackupStorageLocation object that triggered NonAdminBSLRequest
@@ -57,6 +64,10 @@ type SourceNonAdminBSL struct {
 	// +optionl
 	RequestedSpec *velerov1.BackupStorageLocationSpec `json:"requestedSpec"`
 
+	// requestedCloudIdentity contains the requested cloud identity from the NonAdminBackupStorageLocation
+	// +optional
+	RequestedCloudIdentity *CloudIdentity `json:"requestedCloudIdentity,omitempty"`
+
 	// nacuuid references the NonAdminBackupStorageLocation object by it's label containing same NACUUID.
 	// +optional
 	NACUUID string `json:"nacuuid,omitempty"`
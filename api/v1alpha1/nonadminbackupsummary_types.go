@@ -0,0 +1,172 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminBackupSummarySpec defines the desired state of NonAdminBackupSummary.
+// It is empty, the summary covers the non admin objects of every namespace.
+type NonAdminBackupSummarySpec struct{}
+
+// NonAdminObjectCounts counts non admin objects by phase.
+type NonAdminObjectCounts struct {
+	// total is the number of objects, including the ones being deleted
+	Total int `json:"total"`
+
+	// completed is the number of objects in phase Completed
+	// +optional
+	Completed int `json:"completed,omitempty"`
+
+	// partiallyFailed is the number of objects in phase PartiallyFailed
+	// +optional
+	PartiallyFailed int `json:"partiallyFailed,omitempty"`
+
+	// failed is the number of objects in phase Failed
+	// +optional
+	Failed int `json:"failed,omitempty"`
+
+	// other is the number of objects in any other phase, like Created while Velero processes them, or BackingOff
+	// +optional
+	Other int `json:"other,omitempty"`
+
+	// failureRate is the percentage of the finished objects, Completed, PartiallyFailed or Failed,
+	// which are PartiallyFailed or Failed
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	FailureRate int `json:"failureRate,omitempty"`
+}
+
+// SuccessfulBackup references a Completed NonAdminBackup.
+type SuccessfulBackup struct {
+	// completionTimestamp is the completion time of the NonAdminBackup's VeleroBackup
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// name of the NonAdminBackup
+	Name string `json:"name"`
+}
+
+// NamespaceBackupSummary summarizes the non admin objects of a namespace.
+type NamespaceBackupSummary struct {
+	// oldestSuccessfulBackup is the Completed NonAdminBackup of the namespace which completed first,
+	// which tells how far back the namespace can be restored
+	// +optional
+	OldestSuccessfulBackup *SuccessfulBackup `json:"oldestSuccessfulBackup,omitempty"`
+
+	// latestSuccessfulBackup is the Completed NonAdminBackup of the namespace which completed last
+	// +optional
+	LatestSuccessfulBackup *SuccessfulBackup `json:"latestSuccessfulBackup,omitempty"`
+
+	// namespace the non admin objects belong to
+	Namespace string `json:"namespace"`
+
+	// nonAdminBackups counts the NonAdminBackups of the namespace
+	NonAdminBackups NonAdminObjectCounts `json:"nonAdminBackups"`
+
+	// nonAdminRestores counts the NonAdminRestores of the namespace
+	NonAdminRestores NonAdminObjectCounts `json:"nonAdminRestores"`
+
+	// nonAdminBackupStorageLocations is the number of NonAdminBackupStorageLocations of the namespace
+	// +optional
+	NonAdminBackupStorageLocations int `json:"nonAdminBackupStorageLocations,omitempty"`
+
+	// storedBytes is the sum of the bytes reported by the file system and data mover backups stored
+	// in the NonAdminBackupStorageLocations of the namespace
+	// +optional
+	StoredBytes int64 `json:"storedBytes,omitempty"`
+
+	// dataMoverBytes is the sum of the bytes transferred by the DataUploads of the NonAdminBackups of the namespace
+	// +optional
+	DataMoverBytes int64 `json:"dataMoverBytes,omitempty"`
+}
+
+// NonAdminBackupSummaryStatus defines the observed state of NonAdminBackupSummary
+type NonAdminBackupSummaryStatus struct {
+	// updateTime is the last time the summary was computed
+	// +optional
+	// +nullable
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+
+	// namespaces summarizes the non admin objects of each namespace having any, ordered by namespace
+	// +optional
+	Namespaces []NamespaceBackupSummary `json:"namespaces,omitempty"`
+
+	// nonAdminBackups counts the NonAdminBackups of every namespace
+	// +optional
+	NonAdminBackups NonAdminObjectCounts `json:"nonAdminBackups,omitempty"`
+
+	// nonAdminRestores counts the NonAdminRestores of every namespace
+	// +optional
+	NonAdminRestores NonAdminObjectCounts `json:"nonAdminRestores,omitempty"`
+
+	// nonAdminBackupStorageLocations is the number of NonAdminBackupStorageLocations of every namespace
+	// +optional
+	NonAdminBackupStorageLocations int `json:"nonAdminBackupStorageLocations,omitempty"`
+
+	// storedBytes is the sum of the storedBytes of every namespace
+	// +optional
+	StoredBytes int64 `json:"storedBytes,omitempty"`
+
+	// dataMoverBytes is the sum of the dataMoverBytes of every namespace
+	// +optional
+	DataMoverBytes int64 `json:"dataMoverBytes,omitempty"`
+
+	// namespaceCount is the number of namespaces having non admin objects
+	// +optional
+	NamespaceCount int `json:"namespaceCount,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminbackupsummaries,scope=Cluster,shortName=nabsum
+// +kubebuilder:printcolumn:name="Namespaces",type="integer",JSONPath=".status.namespaceCount"
+// +kubebuilder:printcolumn:name="Backups",type="integer",JSONPath=".status.nonAdminBackups.total"
+// +kubebuilder:printcolumn:name="Backup-Failure-Rate",type="integer",JSONPath=".status.nonAdminBackups.failureRate"
+// +kubebuilder:printcolumn:name="Restores",type="integer",JSONPath=".status.nonAdminRestores.total"
+// +kubebuilder:printcolumn:name="Restore-Failure-Rate",type="integer",JSONPath=".status.nonAdminRestores.failureRate"
+// +kubebuilder:printcolumn:name="Stored-Bytes",type="integer",JSONPath=".status.storedBytes",priority=1
+// +kubebuilder:printcolumn:name="Updated",type="date",JSONPath=".status.updateTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminBackupSummary is the Schema for the nonadminbackupsummaries API.
+// It is created by the cluster admin, and NAC periodically reports in its status the NonAdminBackups,
+// NonAdminRestores and NonAdminBackupStorageLocations of every namespace, to audit the data protection
+// of the non admin users.
+type NonAdminBackupSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminBackupSummarySpec   `json:"spec,omitempty"`
+	Status NonAdminBackupSummaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminBackupSummaryList contains a list of NonAdminBackupSummary
+type NonAdminBackupSummaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminBackupSummary `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminBackupSummary{}, &NonAdminBackupSummaryList{})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminBackupTestSpec defines the desired state of NonAdminBackupTest
+type NonAdminBackupTestSpec struct {
+	// StorageLocation is the name of the NonAdminBackupStorageLocation the test backup is stored in.
+	// If not set, the default backup storage location is used.
+	// +optional
+	StorageLocation string `json:"storageLocation,omitempty"`
+
+	// Restore additionally restores the test backup and verifies the canary object is recreated.
+	// +optional
+	Restore bool `json:"restore,omitempty"`
+
+	// Volume additionally backs up, and restores, a canary PersistentVolumeClaim, so the volume backup is tested.
+	// The canary claim must be bound without a pod using it, its storage class must have the Immediate binding mode.
+	// +optional
+	Volume *NonAdminBackupTestVolume `json:"volume,omitempty"`
+}
+
+// NonAdminBackupTestVolume defines the canary PersistentVolumeClaim of a NonAdminBackupTest
+type NonAdminBackupTestVolume struct {
+	// StorageClassName is the storage class of the canary PersistentVolumeClaim.
+	// If not set, the default storage class is used.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// NonAdminBackupTestStatus defines the observed state of NonAdminBackupTest
+type NonAdminBackupTestStatus struct {
+	// NonAdminBackupName references the NonAdminBackup created by the test.
+	// +optional
+	NonAdminBackupName string `json:"nonAdminBackupName,omitempty"`
+
+	// NonAdminRestoreName references the NonAdminRestore created by the test.
+	// +optional
+	NonAdminRestoreName string `json:"nonAdminRestoreName,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of a NonAdminBackupTest.
+	// Completed means the test passed, Failed means it did not.
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminbackuptests,shortName=nabt
+// +kubebuilder:printcolumn:name="Test-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".status.nonAdminBackupName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminBackupTest is the Schema for the nonadminbackuptests API.
+// It runs a small backup, and optionally restore, of a canary object in its namespace
+// so that users can verify their storage location and permissions.
+type NonAdminBackupTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminBackupTestSpec   `json:"spec,omitempty"`
+	Status NonAdminBackupTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminBackupTestList contains a list of NonAdminBackupTest
+type NonAdminBackupTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminBackupTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminBackupTest{}, &NonAdminBackupTestList{})
+}
+
+// NonAdminBackupTestConditionType prevents untyped strings for NABT conditions functions
+type NonAdminBackupTestConditionType string
+
+const (
+	// NonAdminBackupTestConditionBackupVerified indicates whether the test backup completed
+	NonAdminBackupTestConditionBackupVerified NonAdminBackupTestConditionType = "BackupVerified"
+	// NonAdminBackupTestConditionRestoreVerified indicates whether the test restore recreated the canary object
+	NonAdminBackupTestConditionRestoreVerified NonAdminBackupTestConditionType = "RestoreVerified"
+	// NonAdminBackupTestConditionCleanedUp indicates whether the objects created by the test were removed
+	NonAdminBackupTestConditionCleanedUp NonAdminBackupTestConditionType = "CleanedUp"
+)
+
+// CanaryName defines the name of the canary ConfigMap, and PersistentVolumeClaim, for this NonAdminBackupTest
+func (nabt *NonAdminBackupTest) CanaryName() string {
+	return nabt.Name + "-canary"
+}
+
+// NonAdminBackupName defines the name of the NonAdminBackup for this NonAdminBackupTest
+func (nabt *NonAdminBackupTest) NonAdminBackupName() string {
+	return nabt.Name + "-backup"
+}
+
+// NonAdminRestoreName defines the name of the NonAdminRestore for this NonAdminBackupTest
+func (nabt *NonAdminBackupTest) NonAdminRestoreName() string {
+	return nabt.Name + "-restore"
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminBackupVerificationSpec defines the desired state of NonAdminBackupVerification.
+// Exactly one of backupName and nonAdminScheduleName must be set.
+type NonAdminBackupVerificationSpec struct {
+	// backupName is the name of the NonAdminBackup to verify, which must be Completed or PartiallyFailed.
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+
+	// nonAdminScheduleName is the name of the NonAdminSchedule whose latest Completed or PartiallyFailed
+	// NonAdminBackup is verified.
+	// +optional
+	NonAdminScheduleName string `json:"nonAdminScheduleName,omitempty"`
+
+	// interval repeats the verification, each time on the latest backup, the given duration after the
+	// previous one finished. If not set, the verification runs once.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// validationJob is the spec of a Job run in the temporary namespace once the backup is restored into it.
+	// The verification passes only if the Job succeeds. The user creating the NonAdminBackupVerification must be
+	// allowed to create Jobs in its namespace. The Job runs with a ServiceAccount created by NAC, without permissions,
+	// and its Pods must meet the restricted Pod Security Standard enforced in the temporary namespace.
+	// +optional
+	ValidationJob *batchv1.JobSpec `json:"validationJob,omitempty"`
+}
+
+// NonAdminBackupVerificationResult is the result of a verification
+// +kubebuilder:validation:Enum=Passed;Failed
+type NonAdminBackupVerificationResult string
+
+// Predefined NonAdminBackupVerificationResults
+const (
+	NonAdminBackupVerificationPassed NonAdminBackupVerificationResult = "Passed"
+	NonAdminBackupVerificationFailed NonAdminBackupVerificationResult = "Failed"
+)
+
+// BackupVerificationRun contains information of a verification of a NonAdminBackup
+type BackupVerificationRun struct {
+	// startTime is when the verification started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// completionTime is when the verification finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// backupName is the name of the verified NonAdminBackup
+	// +optional
+	BackupName string `json:"backupName,omitempty"`
+
+	// namespace is the temporary namespace, created by NAC, the backup is restored into
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// nonAdminRestore is the name of the NonAdminRestore restoring the backup into the temporary namespace
+	// +optional
+	NonAdminRestore string `json:"nonAdminRestore,omitempty"`
+
+	// job is the name of the validation Job, in the temporary namespace
+	// +optional
+	Job string `json:"job,omitempty"`
+
+	// result of the verification, not set while it is running
+	// +optional
+	Result NonAdminBackupVerificationResult `json:"result,omitempty"`
+
+	// message explains the result of the verification
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// cleanedUp is true once the NonAdminRestore and the temporary namespace are deleted
+	// +optional
+	CleanedUp bool `json:"cleanedUp,omitempty"`
+}
+
+// NonAdminBackupVerificationStatus defines the observed state of NonAdminBackupVerification
+type NonAdminBackupVerificationStatus struct {
+	// verification contains information of the running verification, or of the last one
+	// +optional
+	Verification *BackupVerificationRun `json:"verification,omitempty"`
+
+	// nextVerificationTime is when the next verification starts, when spec.interval is set
+	// +optional
+	NextVerificationTime *metav1.Time `json:"nextVerificationTime,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of a NonAdminBackupVerification.
+	// Created means a verification is running, Completed that the last one finished.
+	// +optional
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminbackupverifications,shortName=nabv
+// +kubebuilder:printcolumn:name="Verification-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".status.verification.backupName"
+// +kubebuilder:printcolumn:name="Result",type="string",JSONPath=".status.verification.result"
+// +kubebuilder:printcolumn:name="Next-Verification",type="date",JSONPath=".status.nextVerificationTime",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminBackupVerification is the Schema for the nonadminbackupverifications API.
+// It restores a NonAdminBackup into a temporary namespace, optionally runs a validation Job there,
+// records whether the backup passed in its status and in the NonAdminBackup one, and deletes the
+// temporary namespace.
+type NonAdminBackupVerification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminBackupVerificationSpec   `json:"spec,omitempty"`
+	Status NonAdminBackupVerificationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminBackupVerificationList contains a list of NonAdminBackupVerification
+type NonAdminBackupVerificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminBackupVerification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminBackupVerification{}, &NonAdminBackupVerificationList{})
+}
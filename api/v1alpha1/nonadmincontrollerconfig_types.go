@@ -0,0 +1,142 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminControllerBackupConfig configures the NonAdminBackups, overriding the NAC flags of the same name
+type NonAdminControllerBackupConfig struct {
+	// enforceBackupSpec is the Velero Backup spec enforced on every NonAdminBackup. The fields it sets override
+	// the ones of the DPA, the others stay enforced. NonAdminPolicies setting it override its fields the same way
+	// in the namespaces they select.
+	// +optional
+	EnforceBackupSpec *velerov1.BackupSpec `json:"enforceBackupSpec,omitempty"`
+
+	// deletionTimeout is the time a NonAdminBackup may stay in the standard delete path before it is marked
+	// as DeletionStalled. Zero disables the check.
+	// +optional
+	DeletionTimeout *metav1.Duration `json:"deletionTimeout,omitempty"`
+
+	// inProgressRequeueAfter is the interval at which a NonAdminBackup is reconciled while its Velero Backup
+	// is running. Zero disables it.
+	// +optional
+	InProgressRequeueAfter *metav1.Duration `json:"inProgressRequeueAfter,omitempty"`
+
+	// maxActiveDeadline is the maximum spec.activeDeadlineSeconds of a NonAdminBackup, also applied to
+	// NonAdminBackups not setting it. Zero allows any active deadline and sets none by default.
+	// +optional
+	MaxActiveDeadline *metav1.Duration `json:"maxActiveDeadline,omitempty"`
+
+	// maxParallelFilesUpload is the maximum spec.backupSpec.uploaderConfig.parallelFilesUpload of a NonAdminBackup,
+	// also applied to NonAdminBackups not setting it. Zero allows any value and sets none by default.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxParallelFilesUpload *int32 `json:"maxParallelFilesUpload,omitempty"`
+
+	// requireDeleteBackupConfirmation makes spec.deleteBackup take effect only when spec.deleteBackupConfirmation
+	// is set to the NonAdminBackup name
+	// +optional
+	RequireDeleteBackupConfirmation *bool `json:"requireDeleteBackupConfirmation,omitempty"`
+}
+
+// NonAdminControllerRestoreConfig configures the NonAdminRestores, overriding the NAC flags of the same name
+type NonAdminControllerRestoreConfig struct {
+	// enforceRestoreSpec is the Velero Restore spec enforced on every NonAdminRestore, instead of the one of the DPA.
+	// NonAdminPolicies setting it override it in the namespaces they select.
+	// +optional
+	EnforceRestoreSpec *velerov1.RestoreSpec `json:"enforceRestoreSpec,omitempty"`
+
+	// maxParallelFilesDownload is the maximum spec.restoreSpec.uploaderConfig.parallelFilesDownload of a NonAdminRestore,
+	// also applied to NonAdminRestores not setting it. Zero allows any value and sets none by default.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxParallelFilesDownload *int32 `json:"maxParallelFilesDownload,omitempty"`
+
+	// blockConcurrentRestores only creates the Velero Restore of a NonAdminRestore once the other Velero Restores
+	// into its namespace finished
+	// +optional
+	BlockConcurrentRestores *bool `json:"blockConcurrentRestores,omitempty"`
+
+	// resultsErrorSummary lists a summary of the Velero Restore results in the NonAdminRestore status
+	// +optional
+	ResultsErrorSummary *bool `json:"resultsErrorSummary,omitempty"`
+}
+
+// NonAdminControllerGarbageCollectionConfig configures the garbage collector, overriding the NAC flags of the same name
+type NonAdminControllerGarbageCollectionConfig struct {
+	// orphanMinAge is the minimum age of the orphan objects of the OADP namespace before they are deleted.
+	// Zero deletes them on the first garbage collection.
+	// +optional
+	OrphanMinAge *metav1.Duration `json:"orphanMinAge,omitempty"`
+
+	// reportOnly only reports the orphan objects of the OADP namespace instead of deleting them
+	// +optional
+	ReportOnly *bool `json:"reportOnly,omitempty"`
+}
+
+// NonAdminControllerConfigSpec defines the desired state of NonAdminControllerConfig.
+// A field not set follows the NAC flag.
+type NonAdminControllerConfigSpec struct {
+	// backup configures the NonAdminBackups
+	// +optional
+	Backup *NonAdminControllerBackupConfig `json:"backup,omitempty"`
+
+	// restore configures the NonAdminRestores
+	// +optional
+	Restore *NonAdminControllerRestoreConfig `json:"restore,omitempty"`
+
+	// garbageCollection configures the garbage collector. Its period is set in the DPA.
+	// +optional
+	GarbageCollection *NonAdminControllerGarbageCollectionConfig `json:"garbageCollection,omitempty"`
+
+	// allowedFeatures allows or denies non admin features in every namespace. NonAdminPolicies setting them
+	// override them in the namespaces they select. multiNamespaceBackups and namespaceMapping also require
+	// the webhooks NAC only serves when their flag is set.
+	// +optional
+	AllowedFeatures *NonAdminPolicyFeatures `json:"allowedFeatures,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=nonadmincontrollerconfigs,scope=Cluster,shortName=nacconfig
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminControllerConfig is the Schema for the nonadmincontrollerconfigs API.
+// It is created by the cluster admin, named cluster, to tune NAC without restarting it: NAC reads it on
+// every reconcile. The OADP namespace, the webhooks, the periods of the periodic controllers and the
+// other flags not listed here are only read when NAC starts.
+type NonAdminControllerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NonAdminControllerConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminControllerConfigList contains a list of NonAdminControllerConfig
+type NonAdminControllerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminControllerConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminControllerConfig{}, &NonAdminControllerConfigList{})
+}
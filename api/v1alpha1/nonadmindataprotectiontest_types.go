@@ -0,0 +1,133 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminDataProtectionTestSpec defines the desired state of NonAdminDataProtectionTest.
+// Mirrors the OADP DataProtectionTestSpec, limited to the NonAdminBackupStorageLocations and the
+// PersistentVolumeClaims of the NonAdminDataProtectionTest namespace.
+type NonAdminDataProtectionTestSpec struct {
+	// backupLocationName is the name of the NonAdminBackupStorageLocation to test.
+	// Its connectivity is reported, and the upload speed test writes to its bucket.
+	// +kubebuilder:validation:MinLength=1
+	BackupLocationName string `json:"backupLocationName"`
+
+	// uploadSpeedTestConfig specifies parameters for an object storage upload speed test.
+	// +optional
+	UploadSpeedTestConfig *oadpv1alpha1.UploadSpeedTestConfig `json:"uploadSpeedTestConfig,omitempty"`
+
+	// csiVolumeSnapshotTestConfigs defines one or more CSI VolumeSnapshot tests to perform,
+	// on PersistentVolumeClaims of the NonAdminDataProtectionTest namespace.
+	// +optional
+	CSIVolumeSnapshotTestConfigs []NonAdminCSIVolumeSnapshotTestConfig `json:"csiVolumeSnapshotTestConfigs,omitempty"`
+}
+
+// NonAdminCSIVolumeSnapshotTestConfig contains config for performing a CSI VolumeSnapshot test
+type NonAdminCSIVolumeSnapshotTestConfig struct {
+	// snapshotClassName specifies the CSI snapshot class to use.
+	// +optional
+	SnapshotClassName string `json:"snapshotClassName,omitempty"`
+
+	// persistentVolumeClaimName is the name of the PersistentVolumeClaim to snapshot.
+	// +kubebuilder:validation:MinLength=1
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName"`
+
+	// timeout specifies how long to wait for the snapshot to become ready, e.g., "60s"
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// OADPDataProtectionTest represents the OADP DataProtectionTest run for the NonAdminDataProtectionTest
+type OADPDataProtectionTest struct {
+	// status captures the results of the OADP DataProtectionTest.
+	// +optional
+	Status *oadpv1alpha1.DataProtectionTestStatus `json:"status,omitempty"`
+
+	// name references the OADP DataProtectionTest object by it's name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// namespace references the Namespace in which the OADP DataProtectionTest exists.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NonAdminDataProtectionTestStatus defines the observed state of NonAdminDataProtectionTest
+type NonAdminDataProtectionTestStatus struct {
+	// +optional
+	DataProtectionTest *OADPDataProtectionTest `json:"dataProtectionTest,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of a NonAdminDataProtectionTest.
+	// Completed means every requested test passed, Failed means at least one did not.
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadmindataprotectiontests,shortName=nadpt
+// +kubebuilder:printcolumn:name="Test-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="UploadSpeed(Mbps)",type="integer",JSONPath=".status.dataProtectionTest.status.uploadTest.speedMbps"
+// +kubebuilder:printcolumn:name="Snapshots",type="string",JSONPath=".status.dataProtectionTest.status.snapshotSummary"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminDataProtectionTest is the Schema for the nonadmindataprotectiontests API.
+// It checks the connectivity of a NonAdminBackupStorageLocation, its upload speed, and the CSI snapshot
+// capability of the storage classes of the namespace, by running an OADP DataProtectionTest.
+type NonAdminDataProtectionTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminDataProtectionTestSpec   `json:"spec,omitempty"`
+	Status NonAdminDataProtectionTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminDataProtectionTestList contains a list of NonAdminDataProtectionTest
+type NonAdminDataProtectionTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminDataProtectionTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminDataProtectionTest{}, &NonAdminDataProtectionTestList{})
+}
+
+// NonAdminDataProtectionTestConditionType prevents untyped strings for NADPT conditions functions
+type NonAdminDataProtectionTestConditionType string
+
+const (
+	// NonAdminDataProtectionTestConditionBackupLocationAvailable indicates whether Velero validated the
+	// BackupStorageLocation of the NonAdminBackupStorageLocation
+	NonAdminDataProtectionTestConditionBackupLocationAvailable NonAdminDataProtectionTestConditionType = "BackupLocationAvailable"
+	// NonAdminDataProtectionTestConditionTested indicates whether the OADP DataProtectionTest finished
+	NonAdminDataProtectionTestConditionTested NonAdminDataProtectionTestConditionType = "Tested"
+)
+
+// DataProtectionTestName defines the OADP DataProtectionTest name for this NonAdminDataProtectionTest
+func (nadpt *NonAdminDataProtectionTest) DataProtectionTestName() string {
+	return fmt.Sprintf("nadpt-%s", string(nadpt.GetUID()))
+}
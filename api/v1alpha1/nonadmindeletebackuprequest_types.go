@@ -0,0 +1,102 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NonAdminDeleteBackupRequestSpec defines the desired state of NonAdminDeleteBackupRequest
+type NonAdminDeleteBackupRequestSpec struct {
+	// backupName is the name of the NonAdminBackup, in the NonAdminDeleteBackupRequest namespace,
+	// removed together with its VeleroBackup and the corresponding data in object storage
+	// +kubebuilder:validation:MinLength=1
+	BackupName string `json:"backupName"`
+}
+
+// DeleteBackupRequestBackup contains information of the NonAdminBackup a NonAdminDeleteBackupRequest removes
+type DeleteBackupRequestBackup struct {
+	// name of the NonAdminBackup
+	Name string `json:"name"`
+
+	// uid of the NonAdminBackup, so a NonAdminBackup later created with the same name is not removed
+	UID types.UID `json:"uid"`
+
+	// nacuuid of the VeleroBackup of the NonAdminBackup
+	// +optional
+	NACUUID string `json:"nacuuid,omitempty"`
+}
+
+// NonAdminDeleteBackupRequestStatus defines the observed state of NonAdminDeleteBackupRequest
+type NonAdminDeleteBackupRequestStatus struct {
+	// requestedBy is the username of the user that created the NonAdminDeleteBackupRequest,
+	// recorded by its admission webhook
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// backup is the NonAdminBackup accepted for deletion
+	// +optional
+	Backup *DeleteBackupRequestBackup `json:"backup,omitempty"`
+
+	// deletionStage is the step of the NonAdminBackup deletion in progress
+	// +optional
+	DeletionStage NonAdminBackupDeletionStage `json:"deletionStage,omitempty"`
+
+	// completionTimestamp is when the NonAdminBackup was removed
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of a NonAdminDeleteBackupRequest.
+	// It is Deleting while the NonAdminBackup is being removed, and Completed once it is removed.
+	// +optional
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadmindeletebackuprequests,shortName=nadbr
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName"
+// +kubebuilder:printcolumn:name="Requested-By",type="string",JSONPath=".status.requestedBy",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminDeleteBackupRequest is the Schema for the nonadmindeletebackuprequests API.
+// It requests the removal of a NonAdminBackup and its backup data, and is kept as the record of that request.
+type NonAdminDeleteBackupRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminDeleteBackupRequestSpec   `json:"spec,omitempty"`
+	Status NonAdminDeleteBackupRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminDeleteBackupRequestList contains a list of NonAdminDeleteBackupRequest
+type NonAdminDeleteBackupRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminDeleteBackupRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminDeleteBackupRequest{}, &NonAdminDeleteBackupRequestList{})
+}
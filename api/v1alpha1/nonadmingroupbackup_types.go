@@ -0,0 +1,129 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminGroupBackupMode is how the selected namespaces of a NonAdminGroupBackup are backed up
+// +kubebuilder:validation:Enum=Single;PerNamespace
+type NonAdminGroupBackupMode string
+
+// Predefined NonAdminGroupBackupModes
+const (
+	// NonAdminGroupBackupModeSingle backs up every selected namespace with a single NonAdminBackup, in the
+	// NonAdminGroupBackup namespace, so a single Velero Backup. It requires multi namespace backups to be allowed.
+	NonAdminGroupBackupModeSingle NonAdminGroupBackupMode = "Single"
+	// NonAdminGroupBackupModePerNamespace backs up each selected namespace with its own NonAdminBackup,
+	// created in that namespace
+	NonAdminGroupBackupModePerNamespace NonAdminGroupBackupMode = "PerNamespace"
+)
+
+// NonAdminGroupBackupSpec defines the desired state of NonAdminGroupBackup
+type NonAdminGroupBackupSpec struct {
+	// namespaceSelector selects the namespaces backed up together. The user creating the NonAdminGroupBackup
+	// must be allowed to create NonAdminBackups in each of them.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+
+	// mode is how the selected namespaces are backed up, Single by default
+	// +optional
+	// +kubebuilder:default=Single
+	Mode NonAdminGroupBackupMode `json:"mode,omitempty"`
+
+	// backupSpec is the spec of the NonAdminBackups created for the group.
+	// Its includedNamespaces is set by NAC and must not be set.
+	// +optional
+	BackupSpec *velerov1.BackupSpec `json:"backupSpec,omitempty"`
+}
+
+// GroupBackupMember contains information of a NonAdminBackup of a NonAdminGroupBackup
+type GroupBackupMember struct {
+	// namespace of the NonAdminBackup
+	Namespace string `json:"namespace"`
+
+	// name of the NonAdminBackup
+	Name string `json:"name"`
+
+	// phase of the NonAdminBackup, empty until it is created
+	// +optional
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	// message explains why the NonAdminBackup failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// NonAdminGroupBackupStatus defines the observed state of NonAdminGroupBackup
+type NonAdminGroupBackupStatus struct {
+	// namespaces selected when the NonAdminGroupBackup was accepted, in which it backs up
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// backups are the NonAdminBackups created for the group
+	// +optional
+	Backups []GroupBackupMember `json:"backups,omitempty"`
+
+	// startTimestamp is when the NonAdminBackups of the group were created
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// completionTimestamp is when every NonAdminBackup of the group finished
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of a NonAdminGroupBackup, aggregated from the
+	// phases of its NonAdminBackups. It is Completed if all of them completed, Failed if all of them failed, and
+	// PartiallyFailed otherwise once they all finished. It is BackingOff while one of them is BackingOff.
+	// +optional
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadmingroupbackups,shortName=nagb
+// +kubebuilder:printcolumn:name="Group-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Mode",type="string",JSONPath=".spec.mode"
+// +kubebuilder:printcolumn:name="Namespaces",type="string",JSONPath=".status.namespaces",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminGroupBackup is the Schema for the nonadmingroupbackups API.
+// It backs up a set of namespaces of one tenant, selected by label, for applications spanning several namespaces.
+type NonAdminGroupBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminGroupBackupSpec   `json:"spec,omitempty"`
+	Status NonAdminGroupBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminGroupBackupList contains a list of NonAdminGroupBackup
+type NonAdminGroupBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminGroupBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminGroupBackup{}, &NonAdminGroupBackupList{})
+}
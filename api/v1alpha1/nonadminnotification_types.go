@@ -0,0 +1,152 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminNotificationFormat is the payload format of the webhook
+// +kubebuilder:validation:Enum=Generic;Slack;Teams
+type NonAdminNotificationFormat string
+
+// Predefined NonAdminNotificationFormats
+const (
+	// NonAdminNotificationFormatGeneric posts the notification as a JSON object with the event, the object and its phase
+	NonAdminNotificationFormatGeneric NonAdminNotificationFormat = "Generic"
+	// NonAdminNotificationFormatSlack posts the notification as a Slack incoming webhook message
+	NonAdminNotificationFormatSlack NonAdminNotificationFormat = "Slack"
+	// NonAdminNotificationFormatTeams posts the notification as a Microsoft Teams incoming webhook message card
+	NonAdminNotificationFormatTeams NonAdminNotificationFormat = "Teams"
+)
+
+// NonAdminNotificationEvent is an event of a non admin object a notification is posted for
+// +kubebuilder:validation:Enum=BackupCompleted;BackupPartiallyFailed;BackupFailed;BackupExpired;RestoreCompleted;RestorePartiallyFailed;RestoreFailed
+type NonAdminNotificationEvent string
+
+// Predefined NonAdminNotificationEvents
+const (
+	NonAdminNotificationEventBackupCompleted        NonAdminNotificationEvent = "BackupCompleted"
+	NonAdminNotificationEventBackupPartiallyFailed  NonAdminNotificationEvent = "BackupPartiallyFailed"
+	NonAdminNotificationEventBackupFailed           NonAdminNotificationEvent = "BackupFailed"
+	NonAdminNotificationEventBackupExpired          NonAdminNotificationEvent = "BackupExpired"
+	NonAdminNotificationEventRestoreCompleted       NonAdminNotificationEvent = "RestoreCompleted"
+	NonAdminNotificationEventRestorePartiallyFailed NonAdminNotificationEvent = "RestorePartiallyFailed"
+	NonAdminNotificationEventRestoreFailed          NonAdminNotificationEvent = "RestoreFailed"
+)
+
+// NonAdminNotificationSpec defines the desired state of NonAdminNotification
+type NonAdminNotificationSpec struct {
+	// url is the HTTPS endpoint the notifications are posted to
+	// +kubebuilder:validation:Pattern=`^https://`
+	URL string `json:"url"`
+
+	// format of the posted payload, Generic by default
+	// +optional
+	// +kubebuilder:default=Generic
+	Format NonAdminNotificationFormat `json:"format,omitempty"`
+
+	// events filters the events a notification is posted for. If empty, notifications are posted for every event.
+	// +optional
+	Events []NonAdminNotificationEvent `json:"events,omitempty"`
+
+	// signingKeySecretRef references a key of a Secret, in the NonAdminNotification namespace, used to sign the
+	// payload with HMAC-SHA256. The hex encoded signature is sent in the X-Nac-Signature header, prefixed
+	// with sha256=. If not set, the notifications are not signed.
+	// +optional
+	SigningKeySecretRef *corev1.SecretKeySelector `json:"signingKeySecretRef,omitempty"`
+}
+
+// NonAdminNotificationDelivery contains information of a notification posted to the webhook
+type NonAdminNotificationDelivery struct {
+	// time the notification was posted
+	// +optional
+	Time *metav1.Time `json:"time,omitempty"`
+
+	// event the notification was posted for
+	// +optional
+	Event NonAdminNotificationEvent `json:"event,omitempty"`
+
+	// name of the NonAdminBackup or NonAdminRestore the notification was posted for
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// succeeded is true if the webhook accepted the notification
+	// +optional
+	Succeeded bool `json:"succeeded,omitempty"`
+
+	// message explains why the webhook did not accept the notification
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// NonAdminNotificationStatus defines the observed state of NonAdminNotification
+type NonAdminNotificationStatus struct {
+	// lastDelivery contains information of the last notification posted to the webhook
+	// +optional
+	LastDelivery *NonAdminNotificationDelivery `json:"lastDelivery,omitempty"`
+
+	// deliveredCount is the number of notifications the webhook accepted
+	// +optional
+	DeliveredCount int `json:"deliveredCount,omitempty"`
+
+	// failedCount is the number of notifications the webhook did not accept, or which could not be posted
+	// +optional
+	FailedCount int `json:"failedCount,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of a NonAdminNotification.
+	// Created means notifications are posted, BackingOff that the spec is invalid or restricted.
+	// +optional
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminnotifications,shortName=nan
+// +kubebuilder:printcolumn:name="Notification-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Format",type="string",JSONPath=".spec.format"
+// +kubebuilder:printcolumn:name="Delivered",type="integer",JSONPath=".status.deliveredCount"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failedCount"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminNotification is the Schema for the nonadminnotifications API.
+// NAC posts a notification to its webhook when a NonAdminBackup or NonAdminRestore of its namespace
+// completes, fails or expires.
+type NonAdminNotification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminNotificationSpec   `json:"spec,omitempty"`
+	Status NonAdminNotificationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminNotificationList contains a list of NonAdminNotification
+type NonAdminNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminNotification `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminNotification{}, &NonAdminNotificationList{})
+}
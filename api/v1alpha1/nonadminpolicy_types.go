@@ -0,0 +1,119 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminPolicyQuotas limits the number of non admin objects of a namespace
+type NonAdminPolicyQuotas struct {
+	// maxNonAdminBackups is the maximum number of NonAdminBackups of a namespace, not counting the ones being deleted.
+	// NonAdminBackups created over it are not accepted.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxNonAdminBackups *int32 `json:"maxNonAdminBackups,omitempty"`
+
+	// maxNonAdminRestores is the maximum number of NonAdminRestores of a namespace, not counting the ones being deleted.
+	// NonAdminRestores created over it are not accepted.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxNonAdminRestores *int32 `json:"maxNonAdminRestores,omitempty"`
+}
+
+// NonAdminPolicyFeatures allows or denies non admin features, overriding the NAC flags of the cluster admin.
+// A feature not set here follows the NAC flag.
+type NonAdminPolicyFeatures struct {
+	// multiNamespaceBackups lets spec.backupSpec.includedNamespaces of NonAdminBackups contain other namespaces
+	// +optional
+	MultiNamespaceBackups *bool `json:"multiNamespaceBackups,omitempty"`
+
+	// restoreVerification lets NonAdminBackups set spec.verifyRestore
+	// +optional
+	RestoreVerification *bool `json:"restoreVerification,omitempty"`
+
+	// execHooks lets NonAdminBackups have exec hooks
+	// +optional
+	ExecHooks *bool `json:"execHooks,omitempty"`
+
+	// restoreHooks lets NonAdminRestores have exec or init hooks
+	// +optional
+	RestoreHooks *bool `json:"restoreHooks,omitempty"`
+
+	// namespaceMapping lets spec.restoreSpec.namespaceMapping of NonAdminRestores map their namespace to another one
+	// +optional
+	NamespaceMapping *bool `json:"namespaceMapping,omitempty"`
+}
+
+// NonAdminPolicySpec defines the desired state of NonAdminPolicy
+type NonAdminPolicySpec struct {
+	// enforceBackupSpec is the Velero Backup spec enforced on the NonAdminBackups of the selected namespaces.
+	// The fields it sets override the ones of the NonAdminControllerConfig and the DPA, the others stay enforced.
+	// +optional
+	EnforceBackupSpec *velerov1.BackupSpec `json:"enforceBackupSpec,omitempty"`
+
+	// enforceRestoreSpec is the Velero Restore spec enforced on the NonAdminRestores of the selected namespaces,
+	// instead of the one of the DPA.
+	// +optional
+	EnforceRestoreSpec *velerov1.RestoreSpec `json:"enforceRestoreSpec,omitempty"`
+
+	// quotas limits the number of non admin objects of each selected namespace.
+	// +optional
+	Quotas *NonAdminPolicyQuotas `json:"quotas,omitempty"`
+
+	// allowedFeatures allows or denies non admin features in the selected namespaces.
+	// +optional
+	AllowedFeatures *NonAdminPolicyFeatures `json:"allowedFeatures,omitempty"`
+
+	// namespaceSelector selects the namespaces whose non admin objects this policy applies to.
+	// An empty selector selects every namespace.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// priority orders the NonAdminPolicies selecting the same namespace, only the one with the highest priority
+	// applies to it. Policies with the same priority are ordered by name.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=nonadminpolicies,scope=Cluster,shortName=nap
+// +kubebuilder:printcolumn:name="Priority",type="integer",JSONPath=".spec.priority"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminPolicy is the Schema for the nonadminpolicies API.
+// It is created by the cluster admin to enforce specs, quotas and features on the non admin objects
+// of the namespaces it selects.
+type NonAdminPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NonAdminPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminPolicyList contains a list of NonAdminPolicy
+type NonAdminPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminPolicy{}, &NonAdminPolicyList{})
+}
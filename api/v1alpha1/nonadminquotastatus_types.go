@@ -0,0 +1,101 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminQuotaStatusSpec defines the desired state of NonAdminQuotaStatus.
+// It is empty, the NonAdminQuotaStatus is created and updated by NAC.
+type NonAdminQuotaStatusSpec struct{}
+
+// NonAdminQuotaUsage reports the usage of a NonAdminPolicy quota.
+type NonAdminQuotaUsage struct {
+	// limit is the maximum number of objects of the namespace set by the NonAdminPolicy quota.
+	// It is not set when the NonAdminPolicy sets no quota for the objects.
+	// +optional
+	Limit *int32 `json:"limit,omitempty"`
+
+	// remaining is the number of objects which can still be created before new ones are rejected.
+	// It is not set when the NonAdminPolicy sets no quota for the objects.
+	// +optional
+	Remaining *int32 `json:"remaining,omitempty"`
+
+	// used is the number of objects of the namespace counting against the quota, the ones not being deleted
+	Used int32 `json:"used"`
+}
+
+// NonAdminQuotaStatusStatus defines the observed state of NonAdminQuotaStatus
+type NonAdminQuotaStatusStatus struct {
+	// nonAdminPolicy is the name of the NonAdminPolicy applying to the namespace
+	// +optional
+	NonAdminPolicy string `json:"nonAdminPolicy,omitempty"`
+
+	// nonAdminBackups reports the NonAdminBackups quota usage of the namespace
+	// +optional
+	NonAdminBackups NonAdminQuotaUsage `json:"nonAdminBackups,omitempty"`
+
+	// nonAdminRestores reports the NonAdminRestores quota usage of the namespace
+	// +optional
+	NonAdminRestores NonAdminQuotaUsage `json:"nonAdminRestores,omitempty"`
+
+	// nonAdminSchedules is the number of NonAdminSchedules of the namespace, not counting the ones being deleted
+	// +optional
+	NonAdminSchedules int32 `json:"nonAdminSchedules,omitempty"`
+
+	// storedBytes is the sum of the bytes reported by the file system and data mover backups stored
+	// in the NonAdminBackupStorageLocations of the namespace
+	// +optional
+	StoredBytes int64 `json:"storedBytes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminquotastatuses,shortName=naqs
+// +kubebuilder:printcolumn:name="Policy",type="string",JSONPath=".status.nonAdminPolicy"
+// +kubebuilder:printcolumn:name="Backups",type="integer",JSONPath=".status.nonAdminBackups.used"
+// +kubebuilder:printcolumn:name="Backups-Limit",type="integer",JSONPath=".status.nonAdminBackups.limit"
+// +kubebuilder:printcolumn:name="Restores",type="integer",JSONPath=".status.nonAdminRestores.used"
+// +kubebuilder:printcolumn:name="Restores-Limit",type="integer",JSONPath=".status.nonAdminRestores.limit"
+// +kubebuilder:printcolumn:name="Schedules",type="integer",JSONPath=".status.nonAdminSchedules",priority=1
+// +kubebuilder:printcolumn:name="Stored-Bytes",type="integer",JSONPath=".status.storedBytes",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminQuotaStatus is the Schema for the nonadminquotastatuses API.
+// NAC creates it in each namespace a NonAdminPolicy applies to, so the non admin users can see
+// the usage of their quotas before their next NonAdminBackup or NonAdminRestore gets rejected.
+type NonAdminQuotaStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminQuotaStatusSpec   `json:"spec,omitempty"`
+	Status NonAdminQuotaStatusStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminQuotaStatusList contains a list of NonAdminQuotaStatus
+type NonAdminQuotaStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminQuotaStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminQuotaStatus{}, &NonAdminQuotaStatusList{})
+}
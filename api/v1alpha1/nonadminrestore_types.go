@@ -27,6 +27,52 @@ import (
 type NonAdminRestoreSpec struct {
 	// restoreSpec defines the specification for a Velero restore.
 	RestoreSpec *velerov1.RestoreSpec `json:"restoreSpec"`
+
+	// retryPolicy creates a new Velero Restore when the Velero Restore fails, for example because
+	// the backup storage location was briefly unavailable.
+	// +optional
+	RetryPolicy *RestoreRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// preview lists, in status.preview, the resources of the backup the Velero Restore would restore,
+	// instead of creating it. Setting it to false afterwards creates the Velero Restore.
+	// +optional
+	Preview bool `json:"preview,omitempty"`
+
+	// volumeSelector restores only the PersistentVolumeClaims of the backup whose labels match it, and their volumes
+	// restored from snapshots or with the Data Mover. The other resources of the backup are not restored.
+	// It can not be set with spec.restoreSpec includedResources, labelSelector or orLabelSelectors.
+	// +optional
+	VolumeSelector *metav1.LabelSelector `json:"volumeSelector,omitempty"`
+
+	// cancel stops the NonAdminRestore: the running DataDownloads of its Velero Restore are cancelled, or no
+	// Velero Restore is created if it was not yet. A canceled NonAdminRestore can not be resumed.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// waitForBackupCompletion allows spec.restoreSpec.backupName to be a NonAdminBackup whose Velero Backup is not
+	// completed yet. The NonAdminRestore is Pending, and its Velero Restore is created once the NonAdminBackup completes.
+	// +optional
+	WaitForBackupCompletion bool `json:"waitForBackupCompletion,omitempty"`
+
+	// backupNamespace is the namespace of the NonAdminBackup spec.restoreSpec.backupName, when it is not the
+	// NonAdminRestore one. A NonAdminBackupShare in that namespace must share the NonAdminBackup with the
+	// NonAdminRestore namespace, and the NonAdminBackup must be completed.
+	// +optional
+	BackupNamespace string `json:"backupNamespace,omitempty"`
+}
+
+// RestoreRetryPolicy defines how the failed Velero Restores of a NonAdminRestore are retried.
+type RestoreRetryPolicy struct {
+	// backoffSeconds is the time, since the Velero Restore failed, after which a new one is created.
+	// Defaults to 60.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackoffSeconds *int64 `json:"backoffSeconds,omitempty"`
+
+	// maxRetries is the number of new Velero Restores created after Velero Restores fail
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxRetries int `json:"maxRetries"`
 }
 
 // VeleroRestore contains information of the related Velero restore object.
@@ -110,11 +156,235 @@ type FileSystemPodVolumeRestores struct {
 	Completed int `json:"completed,omitempty"`
 }
 
+// RestoreProgress contains the progress of the related Velero Restore.
+type RestoreProgress struct {
+	// totalItems is the total number of items to be restored. It may change during the restore,
+	// as plugins may add items to restore.
+	// +optional
+	TotalItems int `json:"totalItems,omitempty"`
+
+	// itemsRestored is the number of items that have been restored so far
+	// +optional
+	ItemsRestored int `json:"itemsRestored,omitempty"`
+}
+
+// RestoreResults contains the warnings and errors of the related Velero Restore.
+type RestoreResults struct {
+	// failureReason is the error that caused the whole Velero Restore to fail
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// errorMessages summarizes the Velero Restore error messages, up to 10 of them, each truncated to 256 characters.
+	// It is read from the Velero Restore results when the cluster admin enables it.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	ErrorMessages []string `json:"errorMessages,omitempty"`
+
+	// number of warnings of the Velero Restore
+	// +optional
+	Warnings int `json:"warnings,omitempty"`
+
+	// number of errors of the Velero Restore
+	// +optional
+	Errors int `json:"errors,omitempty"`
+}
+
+// RestoreItemOperations contains the asynchronous item operations of the related Velero Restore, like the
+// data mover restores of its volumes, which run while the Velero Restore is finalizing.
+type RestoreItemOperations struct {
+	// failedOperations lists up to 10 failed item operations, each error truncated to 256 characters.
+	// It is read from the Velero Restore item operations when the cluster admin enables it.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	FailedOperations []FailedItemOperation `json:"failedOperations,omitempty"`
+
+	// number of item operations attempted by the Velero Restore
+	// +optional
+	Attempted int `json:"attempted,omitempty"`
+
+	// number of item operations of the Velero Restore that completed successfully
+	// +optional
+	Completed int `json:"completed,omitempty"`
+
+	// number of item operations of the Velero Restore that failed
+	// +optional
+	Failed int `json:"failed,omitempty"`
+}
+
+// FailedItemOperation contains a failed item operation of the related Velero Restore.
+type FailedItemOperation struct {
+	// resource is the group resource of the item the operation restored
+	// +optional
+	Resource string `json:"resource,omitempty"`
+
+	// namespace of the item the operation restored
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// name of the item the operation restored
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// error is why the operation failed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ExistingResourcePolicy contains the existingResourcePolicy value used by this NonAdminRestore's Restore.
+type ExistingResourcePolicy struct {
+	// policy is the existingResourcePolicy of this NonAdminRestore's Restore
+	Policy velerov1.PolicyType `json:"policy"`
+
+	// enforced is true if the cluster admin set the policy, spec.restoreSpec.existingResourcePolicy being unset
+	// +optional
+	Enforced bool `json:"enforced,omitempty"`
+}
+
+// RestorePreview contains the resources the Velero Restore of this NonAdminRestore would restore.
+// The namespace and resource filters of the restore spec are applied, its label selectors are not.
+type RestorePreview struct {
+	// resources lists up to 1000 of the resources the Velero Restore would restore
+	// +optional
+	// +kubebuilder:validation:MaxItems=1000
+	Resources []PreviewResource `json:"resources,omitempty"`
+
+	// total is the number of resources the Velero Restore would restore
+	Total int `json:"total"`
+
+	// observedGeneration is the NonAdminRestore generation the preview was computed for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// PreviewResource is a resource the Velero Restore of this NonAdminRestore would restore.
+type PreviewResource struct {
+	// resource is the group resource of the item, for example deployments.apps
+	Resource string `json:"resource"`
+
+	// namespace the item would be restored to
+	Namespace string `json:"namespace"`
+
+	// name of the item
+	Name string `json:"name"`
+}
+
+// RestoreInventory summarizes the resources restored by the related Velero Restore, read from its restored resource list.
+type RestoreInventory struct {
+	// resources counts the items of each kind the Velero Restore created, updated, skipped or failed to restore
+	// +optional
+	Resources []RestoredResourceCount `json:"resources,omitempty"`
+
+	// skippedResources lists up to 100 items the Velero Restore skipped, like the ones already existing in the cluster
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	SkippedResources []InventoryResource `json:"skippedResources,omitempty"`
+
+	// failedResources lists up to 10 items the Velero Restore failed to restore. Their errors are summarized in results.errorMessages.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	FailedResources []InventoryResource `json:"failedResources,omitempty"`
+}
+
+// RestoredResourceCount counts the items of a kind restored by the related Velero Restore.
+type RestoredResourceCount struct {
+	// kind of the items, prefixed by their group version, for example apps/v1/Deployment
+	Kind string `json:"kind"`
+
+	// number of items created
+	// +optional
+	Created int `json:"created,omitempty"`
+
+	// number of items updated, following the existingResourcePolicy
+	// +optional
+	Updated int `json:"updated,omitempty"`
+
+	// number of items skipped
+	// +optional
+	Skipped int `json:"skipped,omitempty"`
+
+	// number of items that failed to be restored
+	// +optional
+	Failed int `json:"failed,omitempty"`
+}
+
+// InventoryResource is an item of the restored resource list of the related Velero Restore.
+type InventoryResource struct {
+	// kind of the item, prefixed by its group version, for example apps/v1/Deployment
+	Kind string `json:"kind"`
+
+	// namespace of the item, empty for cluster scoped items
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// name of the item
+	Name string `json:"name"`
+}
+
+// AppliedRestoreOptions contains the volume and node port options used by this NonAdminRestore's Restore.
+type AppliedRestoreOptions struct {
+	// restorePVs is true if the persistent volumes of this NonAdminRestore's Restore are restored from their snapshots
+	RestorePVs bool `json:"restorePVs"`
+
+	// preserveNodePorts is true if the node ports of the restored services are kept
+	PreserveNodePorts bool `json:"preserveNodePorts"`
+
+	// writeSparseFiles is true if the files restored by the node-agent are written as sparse files
+	// +optional
+	WriteSparseFiles bool `json:"writeSparseFiles,omitempty"`
+
+	// parallelFilesDownload is the number of files downloaded in parallel by the node-agent, zero being its default
+	// +optional
+	ParallelFilesDownload int `json:"parallelFilesDownload,omitempty"`
+}
+
+// RestoreRetries contains the retries of the failed Velero Restores of this NonAdminRestore.
+type RestoreRetries struct {
+	// lastAttemptTime is when the last new Velero Restore was requested
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+
+	// lastError is why the Velero Restore retried last failed
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// attempts is the number of new Velero Restores created after Velero Restores failed
+	Attempts int `json:"attempts"`
+}
+
 // NonAdminRestoreStatus defines the observed state of NonAdminRestore
 type NonAdminRestoreStatus struct {
 	// +optional
 	VeleroRestore *VeleroRestore `json:"veleroRestore,omitempty"`
 
+	// progress of the related Velero Restore, copied from its status
+	// +optional
+	Progress *RestoreProgress `json:"progress,omitempty"`
+
+	// results of the related Velero Restore, copied from its status
+	// +optional
+	Results *RestoreResults `json:"results,omitempty"`
+
+	// inventory of the resources restored by the related Velero Restore, read from its restored resource list
+	// when the cluster admin enables it
+	// +optional
+	Inventory *RestoreInventory `json:"inventory,omitempty"`
+
+	// +optional
+	ExistingResourcePolicy *ExistingResourcePolicy `json:"existingResourcePolicy,omitempty"`
+
+	// appliedOptions are the volume and node port options used by the related Velero Restore
+	// +optional
+	AppliedOptions *AppliedRestoreOptions `json:"appliedOptions,omitempty"`
+
+	// enforcedFields lists the spec.restoreSpec fields of this NonAdminRestore's Restore set or overridden
+	// by the cluster admin, which is why the Restore may differ from spec.restoreSpec.
+	// +optional
+	EnforcedFields []string `json:"enforcedFields,omitempty"`
+
+	// itemOperations of the related Velero Restore, counted in its status
+	// +optional
+	ItemOperations *RestoreItemOperations `json:"itemOperations,omitempty"`
+
 	// +optional
 	DataMoverDataDownloads *DataMoverDataDownloads `json:"dataMoverDataDownloads,omitempty"`
 
@@ -128,6 +398,18 @@ type NonAdminRestoreStatus struct {
 	// +optional
 	QueueInfo *QueueInfo `json:"queueInfo,omitempty"`
 
+	// preview of the resources the Velero Restore would restore, when spec.preview is set
+	// +optional
+	Preview *RestorePreview `json:"preview,omitempty"`
+
+	// retries of the failed Velero Restores, when spec.retryPolicy is set
+	// +optional
+	Retries *RestoreRetries `json:"retries,omitempty"`
+
+	// retryCount is the number of times creating the VeleroRestore was retried after a transient error.
+	// +optional
+	RetryCount int `json:"retryCount,omitempty"`
+
 	// phase is a simple one high-level summary of the lifecycle of an NonAdminRestore.
 	Phase NonAdminPhase `json:"phase,omitempty"`
 
@@ -137,8 +419,11 @@ type NonAdminRestoreStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=nonadminrestores,shortName=nar
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
 // +kubebuilder:printcolumn:name="Velero-Phase",type="string",JSONPath=".status.veleroRestore.status.phase"
+// +kubebuilder:printcolumn:name="Items-Restored",type="integer",JSONPath=".status.progress.itemsRestored"
+// +kubebuilder:printcolumn:name="Total-Items",type="integer",JSONPath=".status.progress.totalItems"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NonAdminRestore is the Schema for the nonadminrestores API
@@ -0,0 +1,98 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminRetentionPolicySpec defines the desired state of NonAdminRetentionPolicy.
+// At least one of keepLast and maxAge must be set.
+type NonAdminRetentionPolicySpec struct {
+	// selector selects, by label, the NonAdminBackups of the namespace the retention applies to.
+	// An empty selector selects every NonAdminBackup of the namespace.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// keepLast is the number of Completed NonAdminBackups kept. The finished NonAdminBackups older than
+	// the last keepLast Completed ones, failed ones included, are deleted.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// maxAge deletes the finished NonAdminBackups started longer ago than it. It must be at least 1h.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1h')",message="maxAge must be at least 1h"
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// NonAdminRetentionPolicyStatus defines the observed state of NonAdminRetentionPolicy
+type NonAdminRetentionPolicyStatus struct {
+	// retainedBackups is the number of finished NonAdminBackups selected and kept by the policy
+	// +optional
+	RetainedBackups int32 `json:"retainedBackups,omitempty"`
+
+	// expiredBackups are the names of the NonAdminBackups whose deletion the policy requested,
+	// with a NonAdminDeleteBackupRequest, at its last enforcement
+	// +optional
+	ExpiredBackups []string `json:"expiredBackups,omitempty"`
+
+	// lastEnforcementTimestamp is when the policy was last enforced
+	// +optional
+	LastEnforcementTimestamp *metav1.Time `json:"lastEnforcementTimestamp,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of a NonAdminRetentionPolicy.
+	// It is Created once accepted, and BackingOff otherwise.
+	// +optional
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminretentionpolicies,shortName=narp
+// +kubebuilder:printcolumn:name="Policy-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Keep-Last",type="integer",JSONPath=".spec.keepLast"
+// +kubebuilder:printcolumn:name="Max-Age",type="string",JSONPath=".spec.maxAge"
+// +kubebuilder:printcolumn:name="Retained",type="integer",JSONPath=".status.retainedBackups",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminRetentionPolicy is the Schema for the nonadminretentionpolicies API.
+// It deletes the oldest NonAdminBackups of its namespace, with NonAdminDeleteBackupRequests,
+// so the backups of a team do not accumulate without bound.
+type NonAdminRetentionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminRetentionPolicySpec   `json:"spec,omitempty"`
+	Status NonAdminRetentionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminRetentionPolicyList contains a list of NonAdminRetentionPolicy
+type NonAdminRetentionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminRetentionPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminRetentionPolicy{}, &NonAdminRetentionPolicyList{})
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+// NonAdminScheduleSpec defines the desired state of NonAdminSchedule
+type NonAdminScheduleSpec struct {
+	// ScheduleSpec defines the specification for a Velero schedule.
+	// Its template is restricted the same way as the spec.backupSpec of a NonAdminBackup.
+	ScheduleSpec *velerov1.ScheduleSpec `json:"scheduleSpec"`
+}
+
+// VeleroSchedule contains information of the related Velero schedule object.
+type VeleroSchedule struct {
+	// spec captures the current spec of the Velero schedule.
+	// +optional
+	Spec *velerov1.ScheduleSpec `json:"spec,omitempty"`
+
+	// status captures the current status of the Velero schedule.
+	// +optional
+	Status *velerov1.ScheduleStatus `json:"status,omitempty"`
+
+	// nacuuid references the Velero Schedule object by it's label containing same NACUUID.
+	// +optional
+	NACUUID string `json:"nacuuid,omitempty"`
+
+	// references the Velero Schedule object by it's name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// namespace references the Namespace in which Velero schedule exists.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NonAdminScheduleStatus defines the observed state of NonAdminSchedule
+type NonAdminScheduleStatus struct {
+	// +optional
+	VeleroSchedule *VeleroSchedule `json:"veleroSchedule,omitempty"`
+
+	// enforcedFields lists the spec.scheduleSpec.template fields of this NonAdminSchedule's Schedule set or
+	// overridden by the cluster admin or NAC, which is why the Schedule may differ from spec.scheduleSpec.
+	// +optional
+	EnforcedFields []string `json:"enforcedFields,omitempty"`
+
+	// lastNonAdminBackup references, by name, the NonAdminBackup created for the most recent Velero Backup of
+	// this NonAdminSchedule's Schedule.
+	// +optional
+	LastNonAdminBackup string `json:"lastNonAdminBackup,omitempty"`
+
+	// queueInfo is used to estimate how many backups are scheduled before the most recent VeleroBackup of this
+	// NonAdminSchedule's Schedule in the OADP namespace, while it is not finished.
+	// +optional
+	QueueInfo *QueueInfo `json:"queueInfo,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of an NonAdminSchedule.
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminschedules,shortName=nas
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Velero-Phase",type="string",JSONPath=".status.veleroSchedule.status.phase"
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.scheduleSpec.schedule"
+// +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".spec.scheduleSpec.paused",priority=1
+// +kubebuilder:printcolumn:name="Last-Backup",type="date",JSONPath=".status.veleroSchedule.status.lastBackup"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminSchedule is the Schema for the nonadminschedules API.
+// It creates a Velero Schedule whose Velero Backups are surfaced as NonAdminBackups in its namespace.
+type NonAdminSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminScheduleSpec   `json:"spec,omitempty"`
+	Status NonAdminScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminScheduleList contains a list of NonAdminSchedule
+type NonAdminScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminSchedule{}, &NonAdminScheduleList{})
+}
+
+// VeleroScheduleName returns the name of the VeleroSchedule object.
+func (nas *NonAdminSchedule) VeleroScheduleName() string {
+	if nas.Status.VeleroSchedule == nil {
+		return constant.EmptyString
+	}
+	return nas.Status.VeleroSchedule.Name
+}
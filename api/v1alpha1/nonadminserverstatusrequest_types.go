@@ -0,0 +1,84 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminServerStatusRequestSpec defines the desired state of NonAdminServerStatusRequest.
+// Mirrors velero ServerStatusRequestSpec, which is empty, to allow non admins to get the Velero server status
+type NonAdminServerStatusRequestSpec struct{}
+
+// VeleroServerStatusRequest represents VeleroServerStatusRequest
+type VeleroServerStatusRequest struct {
+	// VeleroServerStatusRequestStatus represents VeleroServerStatusRequestStatus
+	// +optional
+	Status *velerov1.ServerStatusRequestStatus `json:"status,omitempty"`
+}
+
+// NonAdminServerStatusRequestStatus defines the observed state of NonAdminServerStatusRequest.
+type NonAdminServerStatusRequestStatus struct {
+	// +optional
+	VeleroServerStatusRequest VeleroServerStatusRequest `json:"velero,omitempty"`
+
+	// featureFlags lists the Velero feature flags enabled by the cluster admin, like EnableCSI.
+	// +optional
+	FeatureFlags []string `json:"featureFlags,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of an NonAdminServerStatusRequest
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminserverstatusrequests,shortName=nassr
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Server-Version",type="string",JSONPath=".status.velero.status.serverVersion"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminServerStatusRequest is the Schema for the nonadminserverstatusrequests API.
+type NonAdminServerStatusRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminServerStatusRequestSpec   `json:"spec,omitempty"`
+	Status NonAdminServerStatusRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminServerStatusRequestList contains a list of NonAdminServerStatusRequest.
+type NonAdminServerStatusRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminServerStatusRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminServerStatusRequest{}, &NonAdminServerStatusRequestList{})
+}
+
+// VeleroServerStatusRequestName defines velero server status request name for this NonAdminServerStatusRequest
+func (nassr *NonAdminServerStatusRequest) VeleroServerStatusRequestName() string {
+	return fmt.Sprintf("nassr-%s", string(nassr.GetUID()))
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminVSLCondition contains additional conditions to the
+// generic ones defined as part of this API
+type NonAdminVSLCondition string
+
+// Predefined NonAdminVSLConditions
+const (
+	NonAdminVSLConditionSecretSynced NonAdminVSLCondition = "SecretSynced"
+	NonAdminVSLConditionVSLSynced    NonAdminVSLCondition = "VolumeSnapshotLocationSynced"
+	NonAdminVSLConditionApproved     NonAdminVSLCondition = "ClusterAdminApproved"
+)
+
+// NonAdminVolumeSnapshotLocationSpec defines the desired state of NonAdminVolumeSnapshotLocation
+type NonAdminVolumeSnapshotLocationSpec struct {
+	// volumeSnapshotLocationSpec is the spec of the Velero VolumeSnapshotLocation.
+	// Its credential references a Secret in the NonAdminVolumeSnapshotLocation namespace, which is copied
+	// to the OADP namespace.
+	VolumeSnapshotLocationSpec *velerov1.VolumeSnapshotLocationSpec `json:"volumeSnapshotLocationSpec"`
+}
+
+// VeleroVolumeSnapshotLocation contains information of the related Velero volume snapshot location object.
+type VeleroVolumeSnapshotLocation struct {
+	// status captures the current status of the Velero volume snapshot location.
+	// +optional
+	Status *velerov1.VolumeSnapshotLocationStatus `json:"status,omitempty"`
+
+	// nacuuid references the Velero VolumeSnapshotLocation object by it's label containing same NACUUID.
+	// +optional
+	NACUUID string `json:"nacuuid,omitempty"`
+
+	// references the Velero VolumeSnapshotLocation object by it's name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// namespace references the Namespace in which Velero volume snapshot location exists.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NonAdminVolumeSnapshotLocationStatus defines the observed state of NonAdminVolumeSnapshotLocation
+type NonAdminVolumeSnapshotLocationStatus struct {
+	// +optional
+	VeleroVolumeSnapshotLocation *VeleroVolumeSnapshotLocation `json:"veleroVolumeSnapshotLocation,omitempty"`
+
+	// phase is a simple one high-level summary of the lifecycle of an NonAdminVolumeSnapshotLocation.
+	Phase NonAdminPhase `json:"phase,omitempty"`
+
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminvolumesnapshotlocations,shortName=navsl
+// +kubebuilder:printcolumn:name="Request-Approved",type="string",JSONPath=".status.conditions[?(@.type=='ClusterAdminApproved')].status"
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.volumeSnapshotLocationSpec.provider"
+// +kubebuilder:printcolumn:name="Velero-Phase",type="string",JSONPath=".status.veleroVolumeSnapshotLocation.status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminVolumeSnapshotLocation is the Schema for the nonadminvolumesnapshotlocations API
+type NonAdminVolumeSnapshotLocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminVolumeSnapshotLocationSpec   `json:"spec,omitempty"`
+	Status NonAdminVolumeSnapshotLocationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminVolumeSnapshotLocationList contains a list of NonAdminVolumeSnapshotLocation
+type NonAdminVolumeSnapshotLocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminVolumeSnapshotLocation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminVolumeSnapshotLocation{}, &NonAdminVolumeSnapshotLocationList{})
+}
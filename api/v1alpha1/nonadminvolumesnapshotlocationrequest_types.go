@@ -0,0 +1,97 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonAdminVolumeSnapshotLocationRequestSpec defines the desired state of NonAdminVolumeSnapshotLocationRequest
+type NonAdminVolumeSnapshotLocationRequestSpec struct {
+	// approvalDecision is the decision of the cluster admin on the Requested NonAdminVolumeSnapshotLocation creation.
+	// The value may be set to either approve or reject.
+	// +optional
+	ApprovalDecision NonAdminBSLRequest `json:"approvalDecision,omitempty"`
+
+	// reason is the explanation of the cluster admin for the approval decision. It is shown in the
+	// ClusterAdminApproved condition of the NonAdminVolumeSnapshotLocation.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Reason string `json:"reason,omitempty"`
+}
+
+// SourceNonAdminVSL contains information of the NonAdminVolumeSnapshotLocation object that triggered the request
+type SourceNonAdminVSL struct {
+	// requestedSpec contains the requested Velero VolumeSnapshotLocation spec from the NonAdminVolumeSnapshotLocation
+	// +optional
+	RequestedSpec *velerov1.VolumeSnapshotLocationSpec `json:"requestedSpec,omitempty"`
+
+	// nacuuid references the NonAdminVolumeSnapshotLocation object by it's label containing same NACUUID.
+	// +optional
+	NACUUID string `json:"nacuuid,omitempty"`
+
+	// name references the NonAdminVolumeSnapshotLocation object by it's name.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// namespace references the Namespace in which NonAdminVolumeSnapshotLocation exists.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// NonAdminVolumeSnapshotLocationRequestStatus defines the observed state of NonAdminVolumeSnapshotLocationRequest
+type NonAdminVolumeSnapshotLocationRequestStatus struct {
+	// nonAdminVolumeSnapshotLocation contains information of the NonAdminVolumeSnapshotLocation object that triggered the request
+	// +optional
+	SourceNonAdminVSL *SourceNonAdminVSL `json:"nonAdminVolumeSnapshotLocation,omitempty"`
+
+	// phase represents the current state of the request. It can be either Pending, Approved or Rejected.
+	// +optional
+	Phase NonAdminBSLRequestPhase `json:"phase,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminvolumesnapshotlocationrequests,shortName=navslrequest
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Request-Namespace",type="string",JSONPath=".status.nonAdminVolumeSnapshotLocation.namespace"
+// +kubebuilder:printcolumn:name="Request-Name",type="string",JSONPath=".status.nonAdminVolumeSnapshotLocation.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminVolumeSnapshotLocationRequest is the Schema for the nonadminvolumesnapshotlocationrequests API.
+// It is created by NAC in the OADP namespace for each NonAdminVolumeSnapshotLocation, for the cluster admin to approve.
+type NonAdminVolumeSnapshotLocationRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NonAdminVolumeSnapshotLocationRequestSpec   `json:"spec,omitempty"`
+	Status NonAdminVolumeSnapshotLocationRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminVolumeSnapshotLocationRequestList contains a list of NonAdminVolumeSnapshotLocationRequest
+type NonAdminVolumeSnapshotLocationRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminVolumeSnapshotLocationRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminVolumeSnapshotLocationRequest{}, &NonAdminVolumeSnapshotLocationRequestList{})
+}
@@ -21,92 +21,2725 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apiv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
 	"github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudIdentity) DeepCopyInto(out *AWSCloudIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudIdentity.
+func (in *AWSCloudIdentity) DeepCopy() *AWSCloudIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedRestoreOptions) DeepCopyInto(out *AppliedRestoreOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedRestoreOptions.
+func (in *AppliedRestoreOptions) DeepCopy() *AppliedRestoreOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedRestoreOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalSourceObject) DeepCopyInto(out *ApprovalSourceObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalSourceObject.
+func (in *ApprovalSourceObject) DeepCopy() *ApprovalSourceObject {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalSourceObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureCloudIdentity) DeepCopyInto(out *AzureCloudIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureCloudIdentity.
+func (in *AzureCloudIdentity) DeepCopy() *AzureCloudIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureCloudIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSummary) DeepCopyInto(out *BackupSummary) {
+	*out = *in
+	if in.MostRecentBackupTimestamp != nil {
+		in, out := &in.MostRecentBackupTimestamp, &out.MostRecentBackupTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSummary.
+func (in *BackupSummary) DeepCopy() *BackupSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVerificationRun) DeepCopyInto(out *BackupVerificationRun) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVerificationRun.
+func (in *BackupVerificationRun) DeepCopy() *BackupVerificationRun {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVerificationRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudIdentity) DeepCopyInto(out *CloudIdentity) {
+	*out = *in
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSCloudIdentity)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureCloudIdentity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudIdentity.
+func (in *CloudIdentity) DeepCopy() *CloudIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialOptions) DeepCopyInto(out *CredentialOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialOptions.
+func (in *CredentialOptions) DeepCopy() *CredentialOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataMoverDataDownloads) DeepCopyInto(out *DataMoverDataDownloads) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMoverDataDownloads.
-func (in *DataMoverDataDownloads) DeepCopy() *DataMoverDataDownloads {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMoverDataDownloads.
+func (in *DataMoverDataDownloads) DeepCopy() *DataMoverDataDownloads {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMoverDataDownloads)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMoverDataUploads) DeepCopyInto(out *DataMoverDataUploads) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMoverDataUploads.
+func (in *DataMoverDataUploads) DeepCopy() *DataMoverDataUploads {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMoverDataUploads)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeleteBackupRequestBackup) DeepCopyInto(out *DeleteBackupRequestBackup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteBackupRequestBackup.
+func (in *DeleteBackupRequestBackup) DeepCopy() *DeleteBackupRequestBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DeleteBackupRequestBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExistingResourcePolicy) DeepCopyInto(out *ExistingResourcePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExistingResourcePolicy.
+func (in *ExistingResourcePolicy) DeepCopy() *ExistingResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ExistingResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedItemOperation) DeepCopyInto(out *FailedItemOperation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedItemOperation.
+func (in *FailedItemOperation) DeepCopy() *FailedItemOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedItemOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystemPodVolumeBackups) DeepCopyInto(out *FileSystemPodVolumeBackups) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]PodVolumeBackupFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemPodVolumeBackups.
+func (in *FileSystemPodVolumeBackups) DeepCopy() *FileSystemPodVolumeBackups {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystemPodVolumeBackups)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystemPodVolumeRestores) DeepCopyInto(out *FileSystemPodVolumeRestores) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemPodVolumeRestores.
+func (in *FileSystemPodVolumeRestores) DeepCopy() *FileSystemPodVolumeRestores {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystemPodVolumeRestores)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupBackupMember) DeepCopyInto(out *GroupBackupMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupBackupMember.
+func (in *GroupBackupMember) DeepCopy() *GroupBackupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupBackupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryResource) DeepCopyInto(out *InventoryResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryResource.
+func (in *InventoryResource) DeepCopy() *InventoryResource {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceBackupSummary) DeepCopyInto(out *NamespaceBackupSummary) {
+	*out = *in
+	if in.OldestSuccessfulBackup != nil {
+		in, out := &in.OldestSuccessfulBackup, &out.OldestSuccessfulBackup
+		*out = new(SuccessfulBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LatestSuccessfulBackup != nil {
+		in, out := &in.LatestSuccessfulBackup, &out.LatestSuccessfulBackup
+		*out = new(SuccessfulBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	out.NonAdminBackups = in.NonAdminBackups
+	out.NonAdminRestores = in.NonAdminRestores
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceBackupSummary.
+func (in *NamespaceBackupSummary) DeepCopy() *NamespaceBackupSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceBackupSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminApprovalRequest) DeepCopyInto(out *NonAdminApprovalRequest) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	out.Spec = in.Spec
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminApprovalRequest.
+func (in *NonAdminApprovalRequest) DeepCopy() *NonAdminApprovalRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminApprovalRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminApprovalRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminApprovalRequestList) DeepCopyInto(out *NonAdminApprovalRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminApprovalRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminApprovalRequestList.
+func (in *NonAdminApprovalRequestList) DeepCopy() *NonAdminApprovalRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminApprovalRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminApprovalRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminApprovalRequestSpec) DeepCopyInto(out *NonAdminApprovalRequestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminApprovalRequestSpec.
+func (in *NonAdminApprovalRequestSpec) DeepCopy() *NonAdminApprovalRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminApprovalRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminApprovalRequestStatus) DeepCopyInto(out *NonAdminApprovalRequestStatus) {
+	*out = *in
+	if in.SourceObject != nil {
+		in, out := &in.SourceObject, &out.SourceObject
+		*out = new(ApprovalSourceObject)
+		**out = **in
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminApprovalRequestStatus.
+func (in *NonAdminApprovalRequestStatus) DeepCopy() *NonAdminApprovalRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminApprovalRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackup) DeepCopyInto(out *NonAdminBackup) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackup.
+func (in *NonAdminBackup) DeepCopy() *NonAdminBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupList) DeepCopyInto(out *NonAdminBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupList.
+func (in *NonAdminBackupList) DeepCopy() *NonAdminBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupShare) DeepCopyInto(out *NonAdminBackupShare) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupShare.
+func (in *NonAdminBackupShare) DeepCopy() *NonAdminBackupShare {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupShare) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupShareList) DeepCopyInto(out *NonAdminBackupShareList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminBackupShare, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupShareList.
+func (in *NonAdminBackupShareList) DeepCopy() *NonAdminBackupShareList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupShareList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupShareList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupShareSpec) DeepCopyInto(out *NonAdminBackupShareSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupShareSpec.
+func (in *NonAdminBackupShareSpec) DeepCopy() *NonAdminBackupShareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupShareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupSpec) DeepCopyInto(out *NonAdminBackupSpec) {
+	*out = *in
+	if in.BackupSpec != nil {
+		in, out := &in.BackupSpec, &out.BackupSpec
+		*out = new(v1.BackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupSpec.
+func (in *NonAdminBackupSpec) DeepCopy() *NonAdminBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStatus) DeepCopyInto(out *NonAdminBackupStatus) {
+	*out = *in
+	if in.VeleroBackup != nil {
+		in, out := &in.VeleroBackup, &out.VeleroBackup
+		*out = new(VeleroBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VeleroDeleteBackupRequest != nil {
+		in, out := &in.VeleroDeleteBackupRequest, &out.VeleroDeleteBackupRequest
+		*out = new(VeleroDeleteBackupRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataMoverDataUploads != nil {
+		in, out := &in.DataMoverDataUploads, &out.DataMoverDataUploads
+		*out = new(DataMoverDataUploads)
+		**out = **in
+	}
+	if in.FileSystemPodVolumeBackups != nil {
+		in, out := &in.FileSystemPodVolumeBackups, &out.FileSystemPodVolumeBackups
+		*out = new(FileSystemPodVolumeBackups)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotMoveData != nil {
+		in, out := &in.SnapshotMoveData, &out.SnapshotMoveData
+		*out = new(SnapshotMoveData)
+		**out = **in
+	}
+	if in.EnforcedFields != nil {
+		in, out := &in.EnforcedFields, &out.EnforcedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueueInfo != nil {
+		in, out := &in.QueueInfo, &out.QueueInfo
+		*out = new(QueueInfo)
+		**out = **in
+	}
+	if in.RestoreVerification != nil {
+		in, out := &in.RestoreVerification, &out.RestoreVerification
+		*out = new(RestoreVerification)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStatus.
+func (in *NonAdminBackupStatus) DeepCopy() *NonAdminBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocation) DeepCopyInto(out *NonAdminBackupStorageLocation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocation.
+func (in *NonAdminBackupStorageLocation) DeepCopy() *NonAdminBackupStorageLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupStorageLocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocationList) DeepCopyInto(out *NonAdminBackupStorageLocationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminBackupStorageLocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationList.
+func (in *NonAdminBackupStorageLocationList) DeepCopy() *NonAdminBackupStorageLocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupStorageLocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocationRequest) DeepCopyInto(out *NonAdminBackupStorageLocationRequest) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	out.Spec = in.Spec
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequest.
+func (in *NonAdminBackupStorageLocationRequest) DeepCopy() *NonAdminBackupStorageLocationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupStorageLocationRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocationRequestList) DeepCopyInto(out *NonAdminBackupStorageLocationRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminBackupStorageLocationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequestList.
+func (in *NonAdminBackupStorageLocationRequestList) DeepCopy() *NonAdminBackupStorageLocationRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocationRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupStorageLocationRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocationRequestSpec) DeepCopyInto(out *NonAdminBackupStorageLocationRequestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequestSpec.
+func (in *NonAdminBackupStorageLocationRequestSpec) DeepCopy() *NonAdminBackupStorageLocationRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocationRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocationRequestStatus) DeepCopyInto(out *NonAdminBackupStorageLocationRequestStatus) {
+	*out = *in
+	if in.SourceNonAdminBSL != nil {
+		in, out := &in.SourceNonAdminBSL, &out.SourceNonAdminBSL
+		*out = new(SourceNonAdminBSL)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequestStatus.
+func (in *NonAdminBackupStorageLocationRequestStatus) DeepCopy() *NonAdminBackupStorageLocationRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocationRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocationSpec) DeepCopyInto(out *NonAdminBackupStorageLocationSpec) {
+	*out = *in
+	if in.BackupStorageLocationSpec != nil {
+		in, out := &in.BackupStorageLocationSpec, &out.BackupStorageLocationSpec
+		*out = new(v1.BackupStorageLocationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACertConfigMap != nil {
+		in, out := &in.CACertConfigMap, &out.CACertConfigMap
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudIdentity != nil {
+		in, out := &in.CloudIdentity, &out.CloudIdentity
+		*out = new(CloudIdentity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Credential != nil {
+		in, out := &in.Credential, &out.Credential
+		*out = new(CredentialOptions)
+		**out = **in
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLock)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationSpec.
+func (in *NonAdminBackupStorageLocationSpec) DeepCopy() *NonAdminBackupStorageLocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupStorageLocationStatus) DeepCopyInto(out *NonAdminBackupStorageLocationStatus) {
+	*out = *in
+	if in.VeleroBackupStorageLocation != nil {
+		in, out := &in.VeleroBackupStorageLocation, &out.VeleroBackupStorageLocation
+		*out = new(VeleroBackupStorageLocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupSummary != nil {
+		in, out := &in.BackupSummary, &out.BackupSummary
+		*out = new(BackupSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSuccessfulValidationTime != nil {
+		in, out := &in.LastSuccessfulValidationTime, &out.LastSuccessfulValidationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncedTime != nil {
+		in, out := &in.LastSyncedTime, &out.LastSyncedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EnforcedFields != nil {
+		in, out := &in.EnforcedFields, &out.EnforcedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationStatus.
+func (in *NonAdminBackupStorageLocationStatus) DeepCopy() *NonAdminBackupStorageLocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupStorageLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupSummary) DeepCopyInto(out *NonAdminBackupSummary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupSummary.
+func (in *NonAdminBackupSummary) DeepCopy() *NonAdminBackupSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupSummary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupSummaryList) DeepCopyInto(out *NonAdminBackupSummaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminBackupSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupSummaryList.
+func (in *NonAdminBackupSummaryList) DeepCopy() *NonAdminBackupSummaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupSummaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupSummaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupSummarySpec) DeepCopyInto(out *NonAdminBackupSummarySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupSummarySpec.
+func (in *NonAdminBackupSummarySpec) DeepCopy() *NonAdminBackupSummarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupSummarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupSummaryStatus) DeepCopyInto(out *NonAdminBackupSummaryStatus) {
+	*out = *in
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceBackupSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.NonAdminBackups = in.NonAdminBackups
+	out.NonAdminRestores = in.NonAdminRestores
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupSummaryStatus.
+func (in *NonAdminBackupSummaryStatus) DeepCopy() *NonAdminBackupSummaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupSummaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupTest) DeepCopyInto(out *NonAdminBackupTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupTest.
+func (in *NonAdminBackupTest) DeepCopy() *NonAdminBackupTest {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupTest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupTestList) DeepCopyInto(out *NonAdminBackupTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminBackupTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupTestList.
+func (in *NonAdminBackupTestList) DeepCopy() *NonAdminBackupTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupTestSpec) DeepCopyInto(out *NonAdminBackupTestSpec) {
+	*out = *in
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(NonAdminBackupTestVolume)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupTestSpec.
+func (in *NonAdminBackupTestSpec) DeepCopy() *NonAdminBackupTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupTestStatus) DeepCopyInto(out *NonAdminBackupTestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupTestStatus.
+func (in *NonAdminBackupTestStatus) DeepCopy() *NonAdminBackupTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupTestVolume) DeepCopyInto(out *NonAdminBackupTestVolume) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupTestVolume.
+func (in *NonAdminBackupTestVolume) DeepCopy() *NonAdminBackupTestVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupTestVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupVerification) DeepCopyInto(out *NonAdminBackupVerification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupVerification.
+func (in *NonAdminBackupVerification) DeepCopy() *NonAdminBackupVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupVerification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupVerificationList) DeepCopyInto(out *NonAdminBackupVerificationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminBackupVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupVerificationList.
+func (in *NonAdminBackupVerificationList) DeepCopy() *NonAdminBackupVerificationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupVerificationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminBackupVerificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupVerificationSpec) DeepCopyInto(out *NonAdminBackupVerificationSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ValidationJob != nil {
+		in, out := &in.ValidationJob, &out.ValidationJob
+		*out = new(batchv1.JobSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupVerificationSpec.
+func (in *NonAdminBackupVerificationSpec) DeepCopy() *NonAdminBackupVerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupVerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminBackupVerificationStatus) DeepCopyInto(out *NonAdminBackupVerificationStatus) {
+	*out = *in
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(BackupVerificationRun)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextVerificationTime != nil {
+		in, out := &in.NextVerificationTime, &out.NextVerificationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupVerificationStatus.
+func (in *NonAdminBackupVerificationStatus) DeepCopy() *NonAdminBackupVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminBackupVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminCSIVolumeSnapshotTestConfig) DeepCopyInto(out *NonAdminCSIVolumeSnapshotTestConfig) {
+	*out = *in
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminCSIVolumeSnapshotTestConfig.
+func (in *NonAdminCSIVolumeSnapshotTestConfig) DeepCopy() *NonAdminCSIVolumeSnapshotTestConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminCSIVolumeSnapshotTestConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminControllerBackupConfig) DeepCopyInto(out *NonAdminControllerBackupConfig) {
+	*out = *in
+	if in.EnforceBackupSpec != nil {
+		in, out := &in.EnforceBackupSpec, &out.EnforceBackupSpec
+		*out = new(v1.BackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionTimeout != nil {
+		in, out := &in.DeletionTimeout, &out.DeletionTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.InProgressRequeueAfter != nil {
+		in, out := &in.InProgressRequeueAfter, &out.InProgressRequeueAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxActiveDeadline != nil {
+		in, out := &in.MaxActiveDeadline, &out.MaxActiveDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxParallelFilesUpload != nil {
+		in, out := &in.MaxParallelFilesUpload, &out.MaxParallelFilesUpload
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequireDeleteBackupConfirmation != nil {
+		in, out := &in.RequireDeleteBackupConfirmation, &out.RequireDeleteBackupConfirmation
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminControllerBackupConfig.
+func (in *NonAdminControllerBackupConfig) DeepCopy() *NonAdminControllerBackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminControllerBackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminControllerConfig) DeepCopyInto(out *NonAdminControllerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminControllerConfig.
+func (in *NonAdminControllerConfig) DeepCopy() *NonAdminControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminControllerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminControllerConfigList) DeepCopyInto(out *NonAdminControllerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminControllerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminControllerConfigList.
+func (in *NonAdminControllerConfigList) DeepCopy() *NonAdminControllerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminControllerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminControllerConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminControllerConfigSpec) DeepCopyInto(out *NonAdminControllerConfigSpec) {
+	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(NonAdminControllerBackupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(NonAdminControllerRestoreConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(NonAdminControllerGarbageCollectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedFeatures != nil {
+		in, out := &in.AllowedFeatures, &out.AllowedFeatures
+		*out = new(NonAdminPolicyFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminControllerConfigSpec.
+func (in *NonAdminControllerConfigSpec) DeepCopy() *NonAdminControllerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminControllerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminControllerGarbageCollectionConfig) DeepCopyInto(out *NonAdminControllerGarbageCollectionConfig) {
+	*out = *in
+	if in.OrphanMinAge != nil {
+		in, out := &in.OrphanMinAge, &out.OrphanMinAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ReportOnly != nil {
+		in, out := &in.ReportOnly, &out.ReportOnly
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminControllerGarbageCollectionConfig.
+func (in *NonAdminControllerGarbageCollectionConfig) DeepCopy() *NonAdminControllerGarbageCollectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminControllerGarbageCollectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminControllerRestoreConfig) DeepCopyInto(out *NonAdminControllerRestoreConfig) {
+	*out = *in
+	if in.EnforceRestoreSpec != nil {
+		in, out := &in.EnforceRestoreSpec, &out.EnforceRestoreSpec
+		*out = new(v1.RestoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxParallelFilesDownload != nil {
+		in, out := &in.MaxParallelFilesDownload, &out.MaxParallelFilesDownload
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BlockConcurrentRestores != nil {
+		in, out := &in.BlockConcurrentRestores, &out.BlockConcurrentRestores
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResultsErrorSummary != nil {
+		in, out := &in.ResultsErrorSummary, &out.ResultsErrorSummary
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminControllerRestoreConfig.
+func (in *NonAdminControllerRestoreConfig) DeepCopy() *NonAdminControllerRestoreConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminControllerRestoreConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDataProtectionTest) DeepCopyInto(out *NonAdminDataProtectionTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDataProtectionTest.
+func (in *NonAdminDataProtectionTest) DeepCopy() *NonAdminDataProtectionTest {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDataProtectionTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminDataProtectionTest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDataProtectionTestList) DeepCopyInto(out *NonAdminDataProtectionTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminDataProtectionTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDataProtectionTestList.
+func (in *NonAdminDataProtectionTestList) DeepCopy() *NonAdminDataProtectionTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDataProtectionTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminDataProtectionTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDataProtectionTestSpec) DeepCopyInto(out *NonAdminDataProtectionTestSpec) {
+	*out = *in
+	if in.UploadSpeedTestConfig != nil {
+		in, out := &in.UploadSpeedTestConfig, &out.UploadSpeedTestConfig
+		*out = new(apiv1alpha1.UploadSpeedTestConfig)
+		**out = **in
+	}
+	if in.CSIVolumeSnapshotTestConfigs != nil {
+		in, out := &in.CSIVolumeSnapshotTestConfigs, &out.CSIVolumeSnapshotTestConfigs
+		*out = make([]NonAdminCSIVolumeSnapshotTestConfig, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDataProtectionTestSpec.
+func (in *NonAdminDataProtectionTestSpec) DeepCopy() *NonAdminDataProtectionTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDataProtectionTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDataProtectionTestStatus) DeepCopyInto(out *NonAdminDataProtectionTestStatus) {
+	*out = *in
+	if in.DataProtectionTest != nil {
+		in, out := &in.DataProtectionTest, &out.DataProtectionTest
+		*out = new(OADPDataProtectionTest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDataProtectionTestStatus.
+func (in *NonAdminDataProtectionTestStatus) DeepCopy() *NonAdminDataProtectionTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDataProtectionTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDeleteBackupRequest) DeepCopyInto(out *NonAdminDeleteBackupRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDeleteBackupRequest.
+func (in *NonAdminDeleteBackupRequest) DeepCopy() *NonAdminDeleteBackupRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDeleteBackupRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminDeleteBackupRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDeleteBackupRequestList) DeepCopyInto(out *NonAdminDeleteBackupRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminDeleteBackupRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDeleteBackupRequestList.
+func (in *NonAdminDeleteBackupRequestList) DeepCopy() *NonAdminDeleteBackupRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDeleteBackupRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminDeleteBackupRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDeleteBackupRequestSpec) DeepCopyInto(out *NonAdminDeleteBackupRequestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDeleteBackupRequestSpec.
+func (in *NonAdminDeleteBackupRequestSpec) DeepCopy() *NonAdminDeleteBackupRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDeleteBackupRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDeleteBackupRequestStatus) DeepCopyInto(out *NonAdminDeleteBackupRequestStatus) {
+	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(DeleteBackupRequestBackup)
+		**out = **in
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDeleteBackupRequestStatus.
+func (in *NonAdminDeleteBackupRequestStatus) DeepCopy() *NonAdminDeleteBackupRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDeleteBackupRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDownloadRequest) DeepCopyInto(out *NonAdminDownloadRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequest.
+func (in *NonAdminDownloadRequest) DeepCopy() *NonAdminDownloadRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDownloadRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminDownloadRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDownloadRequestList) DeepCopyInto(out *NonAdminDownloadRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminDownloadRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequestList.
+func (in *NonAdminDownloadRequestList) DeepCopy() *NonAdminDownloadRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDownloadRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminDownloadRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDownloadRequestSpec) DeepCopyInto(out *NonAdminDownloadRequestSpec) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequestSpec.
+func (in *NonAdminDownloadRequestSpec) DeepCopy() *NonAdminDownloadRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDownloadRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminDownloadRequestStatus) DeepCopyInto(out *NonAdminDownloadRequestStatus) {
+	*out = *in
+	in.VeleroDownloadRequest.DeepCopyInto(&out.VeleroDownloadRequest)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequestStatus.
+func (in *NonAdminDownloadRequestStatus) DeepCopy() *NonAdminDownloadRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminDownloadRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminGroupBackup) DeepCopyInto(out *NonAdminGroupBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminGroupBackup.
+func (in *NonAdminGroupBackup) DeepCopy() *NonAdminGroupBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminGroupBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminGroupBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminGroupBackupList) DeepCopyInto(out *NonAdminGroupBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminGroupBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminGroupBackupList.
+func (in *NonAdminGroupBackupList) DeepCopy() *NonAdminGroupBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminGroupBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminGroupBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminGroupBackupSpec) DeepCopyInto(out *NonAdminGroupBackupSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupSpec != nil {
+		in, out := &in.BackupSpec, &out.BackupSpec
+		*out = new(v1.BackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminGroupBackupSpec.
+func (in *NonAdminGroupBackupSpec) DeepCopy() *NonAdminGroupBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminGroupBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminGroupBackupStatus) DeepCopyInto(out *NonAdminGroupBackupStatus) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]GroupBackupMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminGroupBackupStatus.
+func (in *NonAdminGroupBackupStatus) DeepCopy() *NonAdminGroupBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminGroupBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminNotification) DeepCopyInto(out *NonAdminNotification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminNotification.
+func (in *NonAdminNotification) DeepCopy() *NonAdminNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminNotification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminNotificationDelivery) DeepCopyInto(out *NonAdminNotificationDelivery) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminNotificationDelivery.
+func (in *NonAdminNotificationDelivery) DeepCopy() *NonAdminNotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminNotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminNotificationList) DeepCopyInto(out *NonAdminNotificationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminNotificationList.
+func (in *NonAdminNotificationList) DeepCopy() *NonAdminNotificationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminNotificationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminNotificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminNotificationSpec) DeepCopyInto(out *NonAdminNotificationSpec) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NonAdminNotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.SigningKeySecretRef != nil {
+		in, out := &in.SigningKeySecretRef, &out.SigningKeySecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminNotificationSpec.
+func (in *NonAdminNotificationSpec) DeepCopy() *NonAdminNotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminNotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminNotificationStatus) DeepCopyInto(out *NonAdminNotificationStatus) {
+	*out = *in
+	if in.LastDelivery != nil {
+		in, out := &in.LastDelivery, &out.LastDelivery
+		*out = new(NonAdminNotificationDelivery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminNotificationStatus.
+func (in *NonAdminNotificationStatus) DeepCopy() *NonAdminNotificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminNotificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminObjectCounts) DeepCopyInto(out *NonAdminObjectCounts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminObjectCounts.
+func (in *NonAdminObjectCounts) DeepCopy() *NonAdminObjectCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminObjectCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminPolicy) DeepCopyInto(out *NonAdminPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminPolicy.
+func (in *NonAdminPolicy) DeepCopy() *NonAdminPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminPolicyFeatures) DeepCopyInto(out *NonAdminPolicyFeatures) {
+	*out = *in
+	if in.MultiNamespaceBackups != nil {
+		in, out := &in.MultiNamespaceBackups, &out.MultiNamespaceBackups
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreVerification != nil {
+		in, out := &in.RestoreVerification, &out.RestoreVerification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExecHooks != nil {
+		in, out := &in.ExecHooks, &out.ExecHooks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreHooks != nil {
+		in, out := &in.RestoreHooks, &out.RestoreHooks
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NamespaceMapping != nil {
+		in, out := &in.NamespaceMapping, &out.NamespaceMapping
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminPolicyFeatures.
+func (in *NonAdminPolicyFeatures) DeepCopy() *NonAdminPolicyFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminPolicyFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminPolicyList) DeepCopyInto(out *NonAdminPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminPolicyList.
+func (in *NonAdminPolicyList) DeepCopy() *NonAdminPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminPolicyQuotas) DeepCopyInto(out *NonAdminPolicyQuotas) {
+	*out = *in
+	if in.MaxNonAdminBackups != nil {
+		in, out := &in.MaxNonAdminBackups, &out.MaxNonAdminBackups
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNonAdminRestores != nil {
+		in, out := &in.MaxNonAdminRestores, &out.MaxNonAdminRestores
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminPolicyQuotas.
+func (in *NonAdminPolicyQuotas) DeepCopy() *NonAdminPolicyQuotas {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminPolicyQuotas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminPolicySpec) DeepCopyInto(out *NonAdminPolicySpec) {
+	*out = *in
+	if in.EnforceBackupSpec != nil {
+		in, out := &in.EnforceBackupSpec, &out.EnforceBackupSpec
+		*out = new(v1.BackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnforceRestoreSpec != nil {
+		in, out := &in.EnforceRestoreSpec, &out.EnforceRestoreSpec
+		*out = new(v1.RestoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = new(NonAdminPolicyQuotas)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedFeatures != nil {
+		in, out := &in.AllowedFeatures, &out.AllowedFeatures
+		*out = new(NonAdminPolicyFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminPolicySpec.
+func (in *NonAdminPolicySpec) DeepCopy() *NonAdminPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminQuotaStatus) DeepCopyInto(out *NonAdminQuotaStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminQuotaStatus.
+func (in *NonAdminQuotaStatus) DeepCopy() *NonAdminQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminQuotaStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminQuotaStatusList) DeepCopyInto(out *NonAdminQuotaStatusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminQuotaStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminQuotaStatusList.
+func (in *NonAdminQuotaStatusList) DeepCopy() *NonAdminQuotaStatusList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminQuotaStatusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminQuotaStatusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminQuotaStatusSpec) DeepCopyInto(out *NonAdminQuotaStatusSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminQuotaStatusSpec.
+func (in *NonAdminQuotaStatusSpec) DeepCopy() *NonAdminQuotaStatusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminQuotaStatusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminQuotaStatusStatus) DeepCopyInto(out *NonAdminQuotaStatusStatus) {
+	*out = *in
+	in.NonAdminBackups.DeepCopyInto(&out.NonAdminBackups)
+	in.NonAdminRestores.DeepCopyInto(&out.NonAdminRestores)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminQuotaStatusStatus.
+func (in *NonAdminQuotaStatusStatus) DeepCopy() *NonAdminQuotaStatusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminQuotaStatusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminQuotaUsage) DeepCopyInto(out *NonAdminQuotaUsage) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Remaining != nil {
+		in, out := &in.Remaining, &out.Remaining
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminQuotaUsage.
+func (in *NonAdminQuotaUsage) DeepCopy() *NonAdminQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRestore) DeepCopyInto(out *NonAdminRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestore.
+func (in *NonAdminRestore) DeepCopy() *NonAdminRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRestoreList) DeepCopyInto(out *NonAdminRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestoreList.
+func (in *NonAdminRestoreList) DeepCopy() *NonAdminRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRestoreSpec) DeepCopyInto(out *NonAdminRestoreSpec) {
+	*out = *in
+	if in.RestoreSpec != nil {
+		in, out := &in.RestoreSpec, &out.RestoreSpec
+		*out = new(v1.RestoreSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RestoreRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeSelector != nil {
+		in, out := &in.VolumeSelector, &out.VolumeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestoreSpec.
+func (in *NonAdminRestoreSpec) DeepCopy() *NonAdminRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRestoreStatus) DeepCopyInto(out *NonAdminRestoreStatus) {
+	*out = *in
+	if in.VeleroRestore != nil {
+		in, out := &in.VeleroRestore, &out.VeleroRestore
+		*out = new(VeleroRestore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(RestoreProgress)
+		**out = **in
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = new(RestoreResults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(RestoreInventory)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExistingResourcePolicy != nil {
+		in, out := &in.ExistingResourcePolicy, &out.ExistingResourcePolicy
+		*out = new(ExistingResourcePolicy)
+		**out = **in
+	}
+	if in.AppliedOptions != nil {
+		in, out := &in.AppliedOptions, &out.AppliedOptions
+		*out = new(AppliedRestoreOptions)
+		**out = **in
+	}
+	if in.EnforcedFields != nil {
+		in, out := &in.EnforcedFields, &out.EnforcedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ItemOperations != nil {
+		in, out := &in.ItemOperations, &out.ItemOperations
+		*out = new(RestoreItemOperations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataMoverDataDownloads != nil {
+		in, out := &in.DataMoverDataDownloads, &out.DataMoverDataDownloads
+		*out = new(DataMoverDataDownloads)
+		**out = **in
+	}
+	if in.FileSystemPodVolumeRestores != nil {
+		in, out := &in.FileSystemPodVolumeRestores, &out.FileSystemPodVolumeRestores
+		*out = new(FileSystemPodVolumeRestores)
+		**out = **in
+	}
+	if in.QueueInfo != nil {
+		in, out := &in.QueueInfo, &out.QueueInfo
+		*out = new(QueueInfo)
+		**out = **in
+	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(RestorePreview)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(RestoreRetries)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestoreStatus.
+func (in *NonAdminRestoreStatus) DeepCopy() *NonAdminRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRetentionPolicy) DeepCopyInto(out *NonAdminRetentionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRetentionPolicy.
+func (in *NonAdminRetentionPolicy) DeepCopy() *NonAdminRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminRetentionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRetentionPolicyList) DeepCopyInto(out *NonAdminRetentionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminRetentionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRetentionPolicyList.
+func (in *NonAdminRetentionPolicyList) DeepCopy() *NonAdminRetentionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminRetentionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminRetentionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRetentionPolicySpec) DeepCopyInto(out *NonAdminRetentionPolicySpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRetentionPolicySpec.
+func (in *NonAdminRetentionPolicySpec) DeepCopy() *NonAdminRetentionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminRetentionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminRetentionPolicyStatus) DeepCopyInto(out *NonAdminRetentionPolicyStatus) {
+	*out = *in
+	if in.ExpiredBackups != nil {
+		in, out := &in.ExpiredBackups, &out.ExpiredBackups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastEnforcementTimestamp != nil {
+		in, out := &in.LastEnforcementTimestamp, &out.LastEnforcementTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRetentionPolicyStatus.
+func (in *NonAdminRetentionPolicyStatus) DeepCopy() *NonAdminRetentionPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DataMoverDataDownloads)
+	out := new(NonAdminRetentionPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataMoverDataUploads) DeepCopyInto(out *DataMoverDataUploads) {
+func (in *NonAdminSchedule) DeepCopyInto(out *NonAdminSchedule) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMoverDataUploads.
-func (in *DataMoverDataUploads) DeepCopy() *DataMoverDataUploads {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminSchedule.
+func (in *NonAdminSchedule) DeepCopy() *NonAdminSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(DataMoverDataUploads)
+	out := new(NonAdminSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSystemPodVolumeBackups) DeepCopyInto(out *FileSystemPodVolumeBackups) {
+func (in *NonAdminScheduleList) DeepCopyInto(out *NonAdminScheduleList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NonAdminSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemPodVolumeBackups.
-func (in *FileSystemPodVolumeBackups) DeepCopy() *FileSystemPodVolumeBackups {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminScheduleList.
+func (in *NonAdminScheduleList) DeepCopy() *NonAdminScheduleList {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSystemPodVolumeBackups)
+	out := new(NonAdminScheduleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSystemPodVolumeRestores) DeepCopyInto(out *FileSystemPodVolumeRestores) {
+func (in *NonAdminScheduleSpec) DeepCopyInto(out *NonAdminScheduleSpec) {
 	*out = *in
+	if in.ScheduleSpec != nil {
+		in, out := &in.ScheduleSpec, &out.ScheduleSpec
+		*out = new(v1.ScheduleSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemPodVolumeRestores.
-func (in *FileSystemPodVolumeRestores) DeepCopy() *FileSystemPodVolumeRestores {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminScheduleSpec.
+func (in *NonAdminScheduleSpec) DeepCopy() *NonAdminScheduleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSystemPodVolumeRestores)
+	out := new(NonAdminScheduleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackup) DeepCopyInto(out *NonAdminBackup) {
+func (in *NonAdminScheduleStatus) DeepCopyInto(out *NonAdminScheduleStatus) {
+	*out = *in
+	if in.VeleroSchedule != nil {
+		in, out := &in.VeleroSchedule, &out.VeleroSchedule
+		*out = new(VeleroSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnforcedFields != nil {
+		in, out := &in.EnforcedFields, &out.EnforcedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueueInfo != nil {
+		in, out := &in.QueueInfo, &out.QueueInfo
+		*out = new(QueueInfo)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminScheduleStatus.
+func (in *NonAdminScheduleStatus) DeepCopy() *NonAdminScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminServerStatusRequest) DeepCopyInto(out *NonAdminServerStatusRequest) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackup.
-func (in *NonAdminBackup) DeepCopy() *NonAdminBackup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminServerStatusRequest.
+func (in *NonAdminServerStatusRequest) DeepCopy() *NonAdminServerStatusRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackup)
+	out := new(NonAdminServerStatusRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminBackup) DeepCopyObject() runtime.Object {
+func (in *NonAdminServerStatusRequest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -114,31 +2747,31 @@ func (in *NonAdminBackup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupList) DeepCopyInto(out *NonAdminBackupList) {
+func (in *NonAdminServerStatusRequestList) DeepCopyInto(out *NonAdminServerStatusRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NonAdminBackup, len(*in))
+		*out = make([]NonAdminServerStatusRequest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupList.
-func (in *NonAdminBackupList) DeepCopy() *NonAdminBackupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminServerStatusRequestList.
+func (in *NonAdminServerStatusRequestList) DeepCopy() *NonAdminServerStatusRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupList)
+	out := new(NonAdminServerStatusRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminBackupList) DeepCopyObject() runtime.Object {
+func (in *NonAdminServerStatusRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -146,52 +2779,28 @@ func (in *NonAdminBackupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupSpec) DeepCopyInto(out *NonAdminBackupSpec) {
+func (in *NonAdminServerStatusRequestSpec) DeepCopyInto(out *NonAdminServerStatusRequestSpec) {
 	*out = *in
-	if in.BackupSpec != nil {
-		in, out := &in.BackupSpec, &out.BackupSpec
-		*out = new(v1.BackupSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupSpec.
-func (in *NonAdminBackupSpec) DeepCopy() *NonAdminBackupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminServerStatusRequestSpec.
+func (in *NonAdminServerStatusRequestSpec) DeepCopy() *NonAdminServerStatusRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupSpec)
+	out := new(NonAdminServerStatusRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStatus) DeepCopyInto(out *NonAdminBackupStatus) {
+func (in *NonAdminServerStatusRequestStatus) DeepCopyInto(out *NonAdminServerStatusRequestStatus) {
 	*out = *in
-	if in.VeleroBackup != nil {
-		in, out := &in.VeleroBackup, &out.VeleroBackup
-		*out = new(VeleroBackup)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.VeleroDeleteBackupRequest != nil {
-		in, out := &in.VeleroDeleteBackupRequest, &out.VeleroDeleteBackupRequest
-		*out = new(VeleroDeleteBackupRequest)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DataMoverDataUploads != nil {
-		in, out := &in.DataMoverDataUploads, &out.DataMoverDataUploads
-		*out = new(DataMoverDataUploads)
-		**out = **in
-	}
-	if in.FileSystemPodVolumeBackups != nil {
-		in, out := &in.FileSystemPodVolumeBackups, &out.FileSystemPodVolumeBackups
-		*out = new(FileSystemPodVolumeBackups)
-		**out = **in
-	}
-	if in.QueueInfo != nil {
-		in, out := &in.QueueInfo, &out.QueueInfo
-		*out = new(QueueInfo)
-		**out = **in
+	in.VeleroServerStatusRequest.DeepCopyInto(&out.VeleroServerStatusRequest)
+	if in.FeatureFlags != nil {
+		in, out := &in.FeatureFlags, &out.FeatureFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -202,18 +2811,18 @@ func (in *NonAdminBackupStatus) DeepCopyInto(out *NonAdminBackupStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStatus.
-func (in *NonAdminBackupStatus) DeepCopy() *NonAdminBackupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminServerStatusRequestStatus.
+func (in *NonAdminServerStatusRequestStatus) DeepCopy() *NonAdminServerStatusRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStatus)
+	out := new(NonAdminServerStatusRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocation) DeepCopyInto(out *NonAdminBackupStorageLocation) {
+func (in *NonAdminVolumeSnapshotLocation) DeepCopyInto(out *NonAdminVolumeSnapshotLocation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -221,18 +2830,18 @@ func (in *NonAdminBackupStorageLocation) DeepCopyInto(out *NonAdminBackupStorage
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocation.
-func (in *NonAdminBackupStorageLocation) DeepCopy() *NonAdminBackupStorageLocation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocation.
+func (in *NonAdminVolumeSnapshotLocation) DeepCopy() *NonAdminVolumeSnapshotLocation {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocation)
+	out := new(NonAdminVolumeSnapshotLocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminBackupStorageLocation) DeepCopyObject() runtime.Object {
+func (in *NonAdminVolumeSnapshotLocation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -240,31 +2849,31 @@ func (in *NonAdminBackupStorageLocation) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocationList) DeepCopyInto(out *NonAdminBackupStorageLocationList) {
+func (in *NonAdminVolumeSnapshotLocationList) DeepCopyInto(out *NonAdminVolumeSnapshotLocationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NonAdminBackupStorageLocation, len(*in))
+		*out = make([]NonAdminVolumeSnapshotLocation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationList.
-func (in *NonAdminBackupStorageLocationList) DeepCopy() *NonAdminBackupStorageLocationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocationList.
+func (in *NonAdminVolumeSnapshotLocationList) DeepCopy() *NonAdminVolumeSnapshotLocationList {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocationList)
+	out := new(NonAdminVolumeSnapshotLocationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminBackupStorageLocationList) DeepCopyObject() runtime.Object {
+func (in *NonAdminVolumeSnapshotLocationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -272,26 +2881,26 @@ func (in *NonAdminBackupStorageLocationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocationRequest) DeepCopyInto(out *NonAdminBackupStorageLocationRequest) {
+func (in *NonAdminVolumeSnapshotLocationRequest) DeepCopyInto(out *NonAdminVolumeSnapshotLocationRequest) {
 	*out = *in
-	in.Status.DeepCopyInto(&out.Status)
-	out.Spec = in.Spec
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequest.
-func (in *NonAdminBackupStorageLocationRequest) DeepCopy() *NonAdminBackupStorageLocationRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocationRequest.
+func (in *NonAdminVolumeSnapshotLocationRequest) DeepCopy() *NonAdminVolumeSnapshotLocationRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocationRequest)
+	out := new(NonAdminVolumeSnapshotLocationRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminBackupStorageLocationRequest) DeepCopyObject() runtime.Object {
+func (in *NonAdminVolumeSnapshotLocationRequest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -299,369 +2908,449 @@ func (in *NonAdminBackupStorageLocationRequest) DeepCopyObject() runtime.Object
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocationRequestList) DeepCopyInto(out *NonAdminBackupStorageLocationRequestList) {
+func (in *NonAdminVolumeSnapshotLocationRequestList) DeepCopyInto(out *NonAdminVolumeSnapshotLocationRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NonAdminBackupStorageLocationRequest, len(*in))
+		*out = make([]NonAdminVolumeSnapshotLocationRequest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequestList.
-func (in *NonAdminBackupStorageLocationRequestList) DeepCopy() *NonAdminBackupStorageLocationRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocationRequestList.
+func (in *NonAdminVolumeSnapshotLocationRequestList) DeepCopy() *NonAdminVolumeSnapshotLocationRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminVolumeSnapshotLocationRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NonAdminVolumeSnapshotLocationRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminVolumeSnapshotLocationRequestSpec) DeepCopyInto(out *NonAdminVolumeSnapshotLocationRequestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocationRequestSpec.
+func (in *NonAdminVolumeSnapshotLocationRequestSpec) DeepCopy() *NonAdminVolumeSnapshotLocationRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminVolumeSnapshotLocationRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminVolumeSnapshotLocationRequestStatus) DeepCopyInto(out *NonAdminVolumeSnapshotLocationRequestStatus) {
+	*out = *in
+	if in.SourceNonAdminVSL != nil {
+		in, out := &in.SourceNonAdminVSL, &out.SourceNonAdminVSL
+		*out = new(SourceNonAdminVSL)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocationRequestStatus.
+func (in *NonAdminVolumeSnapshotLocationRequestStatus) DeepCopy() *NonAdminVolumeSnapshotLocationRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminVolumeSnapshotLocationRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminVolumeSnapshotLocationSpec) DeepCopyInto(out *NonAdminVolumeSnapshotLocationSpec) {
+	*out = *in
+	if in.VolumeSnapshotLocationSpec != nil {
+		in, out := &in.VolumeSnapshotLocationSpec, &out.VolumeSnapshotLocationSpec
+		*out = new(v1.VolumeSnapshotLocationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocationSpec.
+func (in *NonAdminVolumeSnapshotLocationSpec) DeepCopy() *NonAdminVolumeSnapshotLocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminVolumeSnapshotLocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonAdminVolumeSnapshotLocationStatus) DeepCopyInto(out *NonAdminVolumeSnapshotLocationStatus) {
+	*out = *in
+	if in.VeleroVolumeSnapshotLocation != nil {
+		in, out := &in.VeleroVolumeSnapshotLocation, &out.VeleroVolumeSnapshotLocation
+		*out = new(VeleroVolumeSnapshotLocation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminVolumeSnapshotLocationStatus.
+func (in *NonAdminVolumeSnapshotLocationStatus) DeepCopy() *NonAdminVolumeSnapshotLocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NonAdminVolumeSnapshotLocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OADPDataProtectionTest) DeepCopyInto(out *OADPDataProtectionTest) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(apiv1alpha1.DataProtectionTestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OADPDataProtectionTest.
+func (in *OADPDataProtectionTest) DeepCopy() *OADPDataProtectionTest {
+	if in == nil {
+		return nil
+	}
+	out := new(OADPDataProtectionTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLock) DeepCopyInto(out *ObjectLock) {
+	*out = *in
+	out.RetentionPeriod = in.RetentionPeriod
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLock.
+func (in *ObjectLock) DeepCopy() *ObjectLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodVolumeBackupFailure) DeepCopyInto(out *PodVolumeBackupFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodVolumeBackupFailure.
+func (in *PodVolumeBackupFailure) DeepCopy() *PodVolumeBackupFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocationRequestList)
+	out := new(PodVolumeBackupFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminBackupStorageLocationRequestList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewResource) DeepCopyInto(out *PreviewResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewResource.
+func (in *PreviewResource) DeepCopy() *PreviewResource {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(PreviewResource)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocationRequestSpec) DeepCopyInto(out *NonAdminBackupStorageLocationRequestSpec) {
+func (in *QueueInfo) DeepCopyInto(out *QueueInfo) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequestSpec.
-func (in *NonAdminBackupStorageLocationRequestSpec) DeepCopy() *NonAdminBackupStorageLocationRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueInfo.
+func (in *QueueInfo) DeepCopy() *QueueInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocationRequestSpec)
+	out := new(QueueInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocationRequestStatus) DeepCopyInto(out *NonAdminBackupStorageLocationRequestStatus) {
+func (in *RestoreInventory) DeepCopyInto(out *RestoreInventory) {
 	*out = *in
-	if in.SourceNonAdminBSL != nil {
-		in, out := &in.SourceNonAdminBSL, &out.SourceNonAdminBSL
-		*out = new(SourceNonAdminBSL)
-		(*in).DeepCopyInto(*out)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]RestoredResourceCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.SkippedResources != nil {
+		in, out := &in.SkippedResources, &out.SkippedResources
+		*out = make([]InventoryResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailedResources != nil {
+		in, out := &in.FailedResources, &out.FailedResources
+		*out = make([]InventoryResource, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationRequestStatus.
-func (in *NonAdminBackupStorageLocationRequestStatus) DeepCopy() *NonAdminBackupStorageLocationRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreInventory.
+func (in *RestoreInventory) DeepCopy() *RestoreInventory {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocationRequestStatus)
+	out := new(RestoreInventory)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocationSpec) DeepCopyInto(out *NonAdminBackupStorageLocationSpec) {
+func (in *RestoreItemOperations) DeepCopyInto(out *RestoreItemOperations) {
 	*out = *in
-	if in.BackupStorageLocationSpec != nil {
-		in, out := &in.BackupStorageLocationSpec, &out.BackupStorageLocationSpec
-		*out = new(v1.BackupStorageLocationSpec)
-		(*in).DeepCopyInto(*out)
+	if in.FailedOperations != nil {
+		in, out := &in.FailedOperations, &out.FailedOperations
+		*out = make([]FailedItemOperation, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationSpec.
-func (in *NonAdminBackupStorageLocationSpec) DeepCopy() *NonAdminBackupStorageLocationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreItemOperations.
+func (in *RestoreItemOperations) DeepCopy() *RestoreItemOperations {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocationSpec)
+	out := new(RestoreItemOperations)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminBackupStorageLocationStatus) DeepCopyInto(out *NonAdminBackupStorageLocationStatus) {
+func (in *RestorePreview) DeepCopyInto(out *RestorePreview) {
 	*out = *in
-	if in.VeleroBackupStorageLocation != nil {
-		in, out := &in.VeleroBackupStorageLocation, &out.VeleroBackupStorageLocation
-		*out = new(VeleroBackupStorageLocation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]PreviewResource, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminBackupStorageLocationStatus.
-func (in *NonAdminBackupStorageLocationStatus) DeepCopy() *NonAdminBackupStorageLocationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestorePreview.
+func (in *RestorePreview) DeepCopy() *RestorePreview {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminBackupStorageLocationStatus)
+	out := new(RestorePreview)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminDownloadRequest) DeepCopyInto(out *NonAdminDownloadRequest) {
+func (in *RestoreProgress) DeepCopyInto(out *RestoreProgress) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequest.
-func (in *NonAdminDownloadRequest) DeepCopy() *NonAdminDownloadRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreProgress.
+func (in *RestoreProgress) DeepCopy() *RestoreProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminDownloadRequest)
+	out := new(RestoreProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminDownloadRequest) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminDownloadRequestList) DeepCopyInto(out *NonAdminDownloadRequestList) {
+func (in *RestoreResults) DeepCopyInto(out *RestoreResults) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NonAdminDownloadRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ErrorMessages != nil {
+		in, out := &in.ErrorMessages, &out.ErrorMessages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequestList.
-func (in *NonAdminDownloadRequestList) DeepCopy() *NonAdminDownloadRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResults.
+func (in *RestoreResults) DeepCopy() *RestoreResults {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminDownloadRequestList)
+	out := new(RestoreResults)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminDownloadRequestList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminDownloadRequestSpec) DeepCopyInto(out *NonAdminDownloadRequestSpec) {
+func (in *RestoreRetries) DeepCopyInto(out *RestoreRetries) {
 	*out = *in
-	out.Target = in.Target
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequestSpec.
-func (in *NonAdminDownloadRequestSpec) DeepCopy() *NonAdminDownloadRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreRetries.
+func (in *RestoreRetries) DeepCopy() *RestoreRetries {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminDownloadRequestSpec)
+	out := new(RestoreRetries)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminDownloadRequestStatus) DeepCopyInto(out *NonAdminDownloadRequestStatus) {
+func (in *RestoreRetryPolicy) DeepCopyInto(out *RestoreRetryPolicy) {
 	*out = *in
-	in.VeleroDownloadRequest.DeepCopyInto(&out.VeleroDownloadRequest)
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BackoffSeconds != nil {
+		in, out := &in.BackoffSeconds, &out.BackoffSeconds
+		*out = new(int64)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminDownloadRequestStatus.
-func (in *NonAdminDownloadRequestStatus) DeepCopy() *NonAdminDownloadRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreRetryPolicy.
+func (in *RestoreRetryPolicy) DeepCopy() *RestoreRetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminDownloadRequestStatus)
+	out := new(RestoreRetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminRestore) DeepCopyInto(out *NonAdminRestore) {
+func (in *RestoreVerification) DeepCopyInto(out *RestoreVerification) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestore.
-func (in *NonAdminRestore) DeepCopy() *NonAdminRestore {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerification.
+func (in *RestoreVerification) DeepCopy() *RestoreVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminRestore)
+	out := new(RestoreVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminRestore) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminRestoreList) DeepCopyInto(out *NonAdminRestoreList) {
+func (in *RestoredResourceCount) DeepCopyInto(out *RestoredResourceCount) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NonAdminRestore, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestoreList.
-func (in *NonAdminRestoreList) DeepCopy() *NonAdminRestoreList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoredResourceCount.
+func (in *RestoredResourceCount) DeepCopy() *RestoredResourceCount {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminRestoreList)
+	out := new(RestoredResourceCount)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NonAdminRestoreList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminRestoreSpec) DeepCopyInto(out *NonAdminRestoreSpec) {
+func (in *SnapshotMoveData) DeepCopyInto(out *SnapshotMoveData) {
 	*out = *in
-	if in.RestoreSpec != nil {
-		in, out := &in.RestoreSpec, &out.RestoreSpec
-		*out = new(v1.RestoreSpec)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestoreSpec.
-func (in *NonAdminRestoreSpec) DeepCopy() *NonAdminRestoreSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotMoveData.
+func (in *SnapshotMoveData) DeepCopy() *SnapshotMoveData {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminRestoreSpec)
+	out := new(SnapshotMoveData)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NonAdminRestoreStatus) DeepCopyInto(out *NonAdminRestoreStatus) {
+func (in *SourceNonAdminBSL) DeepCopyInto(out *SourceNonAdminBSL) {
 	*out = *in
-	if in.VeleroRestore != nil {
-		in, out := &in.VeleroRestore, &out.VeleroRestore
-		*out = new(VeleroRestore)
+	if in.RequestedSpec != nil {
+		in, out := &in.RequestedSpec, &out.RequestedSpec
+		*out = new(v1.BackupStorageLocationSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DataMoverDataDownloads != nil {
-		in, out := &in.DataMoverDataDownloads, &out.DataMoverDataDownloads
-		*out = new(DataMoverDataDownloads)
-		**out = **in
-	}
-	if in.FileSystemPodVolumeRestores != nil {
-		in, out := &in.FileSystemPodVolumeRestores, &out.FileSystemPodVolumeRestores
-		*out = new(FileSystemPodVolumeRestores)
-		**out = **in
-	}
-	if in.QueueInfo != nil {
-		in, out := &in.QueueInfo, &out.QueueInfo
-		*out = new(QueueInfo)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RequestedCloudIdentity != nil {
+		in, out := &in.RequestedCloudIdentity, &out.RequestedCloudIdentity
+		*out = new(CloudIdentity)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonAdminRestoreStatus.
-func (in *NonAdminRestoreStatus) DeepCopy() *NonAdminRestoreStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceNonAdminBSL.
+func (in *SourceNonAdminBSL) DeepCopy() *SourceNonAdminBSL {
 	if in == nil {
 		return nil
 	}
-	out := new(NonAdminRestoreStatus)
+	out := new(SourceNonAdminBSL)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueueInfo) DeepCopyInto(out *QueueInfo) {
+func (in *SourceNonAdminVSL) DeepCopyInto(out *SourceNonAdminVSL) {
 	*out = *in
+	if in.RequestedSpec != nil {
+		in, out := &in.RequestedSpec, &out.RequestedSpec
+		*out = new(v1.VolumeSnapshotLocationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueueInfo.
-func (in *QueueInfo) DeepCopy() *QueueInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceNonAdminVSL.
+func (in *SourceNonAdminVSL) DeepCopy() *SourceNonAdminVSL {
 	if in == nil {
 		return nil
 	}
-	out := new(QueueInfo)
+	out := new(SourceNonAdminVSL)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SourceNonAdminBSL) DeepCopyInto(out *SourceNonAdminBSL) {
+func (in *SuccessfulBackup) DeepCopyInto(out *SuccessfulBackup) {
 	*out = *in
-	if in.RequestedSpec != nil {
-		in, out := &in.RequestedSpec, &out.RequestedSpec
-		*out = new(v1.BackupStorageLocationSpec)
-		(*in).DeepCopyInto(*out)
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceNonAdminBSL.
-func (in *SourceNonAdminBSL) DeepCopy() *SourceNonAdminBSL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuccessfulBackup.
+func (in *SuccessfulBackup) DeepCopy() *SuccessfulBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(SourceNonAdminBSL)
+	out := new(SuccessfulBackup)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -770,3 +3459,68 @@ func (in *VeleroRestore) DeepCopy() *VeleroRestore {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VeleroSchedule) DeepCopyInto(out *VeleroSchedule) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(v1.ScheduleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(v1.ScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VeleroSchedule.
+func (in *VeleroSchedule) DeepCopy() *VeleroSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VeleroSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VeleroServerStatusRequest) DeepCopyInto(out *VeleroServerStatusRequest) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(v1.ServerStatusRequestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VeleroServerStatusRequest.
+func (in *VeleroServerStatusRequest) DeepCopy() *VeleroServerStatusRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VeleroServerStatusRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VeleroVolumeSnapshotLocation) DeepCopyInto(out *VeleroVolumeSnapshotLocation) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(v1.VolumeSnapshotLocationStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VeleroVolumeSnapshotLocation.
+func (in *VeleroVolumeSnapshotLocation) DeepCopy() *VeleroVolumeSnapshotLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(VeleroVolumeSnapshotLocation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+func TestIsConvertible(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+	assert.NoError(t, AddToScheme(scheme))
+
+	for _, object := range []runtime.Object{&v1alpha1.NonAdminBackup{}, &v1alpha1.NonAdminRestore{}} {
+		convertible, err := conversion.IsConvertible(scheme, object)
+		assert.NoError(t, err)
+		assert.True(t, convertible)
+	}
+}
+
+func TestNonAdminBackupRoundTrip(t *testing.T) {
+	deadline := int64(3600)
+	tests := []struct {
+		name string
+		spec v1alpha1.NonAdminBackupSpec
+	}{
+		{
+			name: "backup",
+			spec: v1alpha1.NonAdminBackupSpec{
+				BackupSpec:                    &velerov1.BackupSpec{IncludedResources: []string{"deployments"}},
+				ActiveDeadlineSeconds:         &deadline,
+				RecreateOnMissingVeleroBackup: true,
+				VerifyRestore:                 true,
+			},
+		},
+		{
+			name: "retained backup",
+			spec: v1alpha1.NonAdminBackupSpec{
+				BackupSpec:           &velerov1.BackupSpec{},
+				RetainBackupOnDelete: true,
+			},
+		},
+		{
+			name: "backup deletion",
+			spec: v1alpha1.NonAdminBackupSpec{
+				BackupSpec:   &velerov1.BackupSpec{},
+				DeleteBackup: true,
+			},
+		},
+		{
+			name: "confirmed backup deletion",
+			spec: v1alpha1.NonAdminBackupSpec{
+				BackupSpec:               &velerov1.BackupSpec{},
+				DeleteBackup:             true,
+				DeleteBackupConfirmation: "daily",
+			},
+		},
+		{
+			name: "confirmation without backup deletion",
+			spec: v1alpha1.NonAdminBackupSpec{
+				BackupSpec:               &velerov1.BackupSpec{},
+				DeleteBackupConfirmation: "daily",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hub := &v1alpha1.NonAdminBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "daily",
+					Namespace:   "team",
+					Annotations: map[string]string{"team": "a"},
+				},
+				Spec: test.spec,
+				Status: v1alpha1.NonAdminBackupStatus{
+					Phase:        v1alpha1.NonAdminPhaseCompleted,
+					VeleroBackup: &v1alpha1.VeleroBackup{Name: "daily-velero", NACUUID: "uuid"},
+				},
+			}
+
+			spoke := &NonAdminBackup{}
+			assert.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+			assert.Equal(t, hub.Status, spoke.Status)
+			confirmation, ok := spoke.Annotations[DeleteBackupAnnotation]
+			assert.Equal(t, test.spec.DeleteBackup, ok)
+			if !ok {
+				confirmation = spoke.Annotations[DeleteBackupConfirmationAnnotation]
+			}
+			assert.Equal(t, test.spec.DeleteBackupConfirmation, confirmation)
+
+			converted := &v1alpha1.NonAdminBackup{}
+			assert.NoError(t, spoke.ConvertTo(converted))
+			assert.Equal(t, hub, converted)
+		})
+	}
+}
+
+func FuzzNonAdminBackupRoundTrip(f *testing.F) {
+	f.Add(false, "", false, "team", "a")
+	f.Add(true, "", false, "team", "a")
+	f.Add(true, "daily", true, "team", "")
+	f.Add(false, "daily", false, "", "")
+	f.Fuzz(func(t *testing.T, deleteBackup bool, confirmation string, retain bool, annotationKey string, annotationValue string) {
+		if annotationKey == DeleteBackupAnnotation || annotationKey == DeleteBackupConfirmationAnnotation {
+			t.Skip("annotation used by the conversion")
+		}
+		hub := &v1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "daily", Namespace: "team"},
+			Spec: v1alpha1.NonAdminBackupSpec{
+				BackupSpec:               &velerov1.BackupSpec{},
+				RetainBackupOnDelete:     retain,
+				DeleteBackup:             deleteBackup,
+				DeleteBackupConfirmation: confirmation,
+			},
+		}
+		if annotationKey != "" {
+			hub.Annotations = map[string]string{annotationKey: annotationValue}
+		}
+
+		spoke := &NonAdminBackup{}
+		assert.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+		converted := &v1alpha1.NonAdminBackup{}
+		assert.NoError(t, spoke.ConvertTo(converted))
+		assert.Equal(t, hub, converted)
+
+		convertedSpoke := &NonAdminBackup{}
+		assert.NoError(t, convertedSpoke.ConvertFrom(converted))
+		assert.Equal(t, spoke, convertedSpoke)
+	})
+}
+
+func TestNonAdminBackupConvertTo(t *testing.T) {
+	spoke := &NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "daily",
+			Namespace:   "team",
+			Annotations: map[string]string{DeleteBackupAnnotation: "daily"},
+		},
+		Spec: NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+	}
+
+	hub := &v1alpha1.NonAdminBackup{}
+	assert.NoError(t, spoke.ConvertTo(hub))
+	assert.True(t, hub.Spec.DeleteBackup)
+	assert.Equal(t, "daily", hub.Spec.DeleteBackupConfirmation)
+	assert.Empty(t, hub.Annotations)
+	// the spoke is not modified
+	assert.Contains(t, spoke.Annotations, DeleteBackupAnnotation)
+
+	converted := &NonAdminBackup{}
+	assert.NoError(t, converted.ConvertFrom(hub))
+	assert.Equal(t, spoke, converted)
+}
+
+func TestNonAdminRestoreRoundTrip(t *testing.T) {
+	backoffSeconds := int64(30)
+	hub := &v1alpha1.NonAdminRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "team"},
+		Spec: v1alpha1.NonAdminRestoreSpec{
+			RestoreSpec:             &velerov1.RestoreSpec{BackupName: "daily"},
+			RetryPolicy:             &v1alpha1.RestoreRetryPolicy{BackoffSeconds: &backoffSeconds, MaxRetries: 2},
+			Preview:                 true,
+			VolumeSelector:          &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			Cancel:                  true,
+			WaitForBackupCompletion: true,
+			BackupNamespace:         "shared",
+		},
+		Status: v1alpha1.NonAdminRestoreStatus{
+			Phase:         v1alpha1.NonAdminPhaseCreated,
+			VeleroRestore: &v1alpha1.VeleroRestore{Name: "restore-velero"},
+		},
+	}
+
+	spoke := &NonAdminRestore{}
+	assert.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+	assert.Equal(t, hub.Spec.RestoreSpec, spoke.Spec.RestoreSpec)
+	assert.Equal(t, hub.Status, spoke.Status)
+
+	converted := &v1alpha1.NonAdminRestore{}
+	assert.NoError(t, spoke.ConvertTo(converted))
+	assert.Equal(t, hub, converted)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// ConvertTo converts this NonAdminBackup to the Hub version (v1alpha1).
+// The delete-backup annotation becomes spec.deleteBackup, and its value spec.deleteBackupConfirmation.
+// Without it, the delete-backup-confirmation annotation becomes spec.deleteBackupConfirmation.
+func (nab *NonAdminBackup) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1alpha1.NonAdminBackup)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 NonAdminBackup but got %T", hub)
+	}
+	dst.ObjectMeta = *nab.ObjectMeta.DeepCopy()
+	dst.Spec = v1alpha1.NonAdminBackupSpec{
+		BackupSpec:                    nab.Spec.BackupSpec.DeepCopy(),
+		ActiveDeadlineSeconds:         nab.Spec.ActiveDeadlineSeconds,
+		RetainBackupOnDelete:          nab.Spec.RetainBackupOnDelete,
+		RecreateOnMissingVeleroBackup: nab.Spec.RecreateOnMissingVeleroBackup,
+		VerifyRestore:                 nab.Spec.VerifyRestore,
+	}
+	if confirmation, ok := dst.Annotations[DeleteBackupAnnotation]; ok {
+		dst.Spec.DeleteBackup = true
+		dst.Spec.DeleteBackupConfirmation = confirmation
+	} else {
+		dst.Spec.DeleteBackupConfirmation = dst.Annotations[DeleteBackupConfirmationAnnotation]
+	}
+	delete(dst.Annotations, DeleteBackupAnnotation)
+	delete(dst.Annotations, DeleteBackupConfirmationAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+	dst.Status = *nab.Status.DeepCopy()
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+// spec.deleteBackup becomes the delete-backup annotation, whose value is spec.deleteBackupConfirmation;
+// when spec.deleteBackup is not set, spec.deleteBackupConfirmation is kept in the delete-backup-confirmation annotation.
+func (nab *NonAdminBackup) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1alpha1.NonAdminBackup)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 NonAdminBackup but got %T", hub)
+	}
+	nab.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	nab.Spec = NonAdminBackupSpec{
+		BackupSpec:                    src.Spec.BackupSpec.DeepCopy(),
+		ActiveDeadlineSeconds:         src.Spec.ActiveDeadlineSeconds,
+		RetainBackupOnDelete:          src.Spec.RetainBackupOnDelete,
+		RecreateOnMissingVeleroBackup: src.Spec.RecreateOnMissingVeleroBackup,
+		VerifyRestore:                 src.Spec.VerifyRestore,
+	}
+	delete(nab.Annotations, DeleteBackupAnnotation)
+	delete(nab.Annotations, DeleteBackupConfirmationAnnotation)
+	switch {
+	case src.Spec.DeleteBackup:
+		if nab.Annotations == nil {
+			nab.Annotations = map[string]string{}
+		}
+		nab.Annotations[DeleteBackupAnnotation] = src.Spec.DeleteBackupConfirmation
+	case src.Spec.DeleteBackupConfirmation != "":
+		if nab.Annotations == nil {
+			nab.Annotations = map[string]string{}
+		}
+		nab.Annotations[DeleteBackupConfirmationAnnotation] = src.Spec.DeleteBackupConfirmation
+	}
+	nab.Status = *src.Status.DeepCopy()
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// DeleteBackupAnnotation requests the deletion of a NonAdminBackup together with its VeleroBackup and the
+// corresponding data in object storage. Its value must be the NonAdminBackup name when the cluster admin
+// requires deletion confirmation. It replaces the v1alpha1 spec.deleteBackup and spec.deleteBackupConfirmation.
+const DeleteBackupAnnotation = "oadp.openshift.io/delete-backup"
+
+// DeleteBackupConfirmationAnnotation keeps the v1alpha1 spec.deleteBackupConfirmation of a NonAdminBackup whose
+// spec.deleteBackup is not set, so it is not lost when the NonAdminBackup is converted to v1beta1 and back.
+// It has no effect in v1beta1.
+const DeleteBackupConfirmationAnnotation = "oadp.openshift.io/delete-backup-confirmation"
+
+// NonAdminBackupSpec defines the desired state of NonAdminBackup
+type NonAdminBackupSpec struct {
+	// backupSpec defines the specification for a Velero backup.
+	BackupSpec *velerov1.BackupSpec `json:"backupSpec"`
+
+	// activeDeadlineSeconds is the time, since the VeleroBackup started, after which a VeleroBackup
+	// still running has its DataUploads cancelled and the DeadlineExceeded condition set.
+	// It may not exceed the maximum set by the cluster admin, which applies when it is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// retainBackupOnDelete keeps the VeleroBackup and its data in object storage when the NonAdminBackup
+	// is deleted, handing the VeleroBackup over to the cluster admin. Ignored when the delete-backup annotation is set.
+	// +optional
+	RetainBackupOnDelete bool `json:"retainBackupOnDelete,omitempty"`
+
+	// recreateOnMissingVeleroBackup creates a new VeleroBackup when the VeleroBackup is deleted
+	// before it completes, for example by mistake, instead of moving the NonAdminBackup to BackingOff.
+	// +optional
+	RecreateOnMissingVeleroBackup bool `json:"recreateOnMissingVeleroBackup,omitempty"`
+
+	// verifyRestore restores the backup, once it completes, into a temporary namespace created by NAC,
+	// and records whether it could be restored in the RestoreVerified condition.
+	// The temporary namespace is deleted once the verification is done.
+	// +optional
+	VerifyRestore bool `json:"verifyRestore,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminbackups,shortName=nab
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Velero-Phase",type="string",JSONPath=".status.veleroBackup.status.phase"
+// +kubebuilder:printcolumn:name="Deletion-Stage",type="string",JSONPath=".status.deletionStage",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminBackup is the Schema for the nonadminbackups API
+type NonAdminBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NonAdminBackupSpec `json:"spec,omitempty"`
+	// status is unchanged from v1alpha1
+	Status v1alpha1.NonAdminBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminBackupList contains a list of NonAdminBackup
+type NonAdminBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminBackup{}, &NonAdminBackupList{})
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// ConvertTo converts this NonAdminRestore to the Hub version (v1alpha1).
+func (nar *NonAdminRestore) ConvertTo(hub conversion.Hub) error {
+	dst, ok := hub.(*v1alpha1.NonAdminRestore)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 NonAdminRestore but got %T", hub)
+	}
+	dst.ObjectMeta = *nar.ObjectMeta.DeepCopy()
+	dst.Spec = v1alpha1.NonAdminRestoreSpec{
+		RestoreSpec:             nar.Spec.RestoreSpec.DeepCopy(),
+		RetryPolicy:             nar.Spec.RetryPolicy.DeepCopy(),
+		Preview:                 nar.Spec.Preview,
+		VolumeSelector:          nar.Spec.VolumeSelector.DeepCopy(),
+		Cancel:                  nar.Spec.Cancel,
+		WaitForBackupCompletion: nar.Spec.WaitForBackupCompletion,
+		BackupNamespace:         nar.Spec.BackupNamespace,
+	}
+	dst.Status = *nar.Status.DeepCopy()
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1alpha1) to this version.
+func (nar *NonAdminRestore) ConvertFrom(hub conversion.Hub) error {
+	src, ok := hub.(*v1alpha1.NonAdminRestore)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 NonAdminRestore but got %T", hub)
+	}
+	nar.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	nar.Spec = NonAdminRestoreSpec{
+		RestoreSpec:             src.Spec.RestoreSpec.DeepCopy(),
+		RetryPolicy:             src.Spec.RetryPolicy.DeepCopy(),
+		Preview:                 src.Spec.Preview,
+		VolumeSelector:          src.Spec.VolumeSelector.DeepCopy(),
+		Cancel:                  src.Spec.Cancel,
+		WaitForBackupCompletion: src.Spec.WaitForBackupCompletion,
+		BackupNamespace:         src.Spec.BackupNamespace,
+	}
+	nar.Status = *src.Status.DeepCopy()
+	return nil
+}
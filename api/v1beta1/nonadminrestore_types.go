@@ -0,0 +1,95 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// NonAdminRestoreSpec defines the desired state of NonAdminRestore
+type NonAdminRestoreSpec struct {
+	// restoreSpec defines the specification for a Velero restore.
+	RestoreSpec *velerov1.RestoreSpec `json:"restoreSpec"`
+
+	// retryPolicy creates a new Velero Restore when the Velero Restore fails, for example because
+	// the backup storage location was briefly unavailable.
+	// +optional
+	RetryPolicy *v1alpha1.RestoreRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// preview lists, in status.preview, the resources of the backup the Velero Restore would restore,
+	// instead of creating it. Setting it to false afterwards creates the Velero Restore.
+	// +optional
+	Preview bool `json:"preview,omitempty"`
+
+	// volumeSelector restores only the PersistentVolumeClaims of the backup whose labels match it, and their volumes
+	// restored from snapshots or with the Data Mover. The other resources of the backup are not restored.
+	// It can not be set with spec.restoreSpec includedResources, labelSelector or orLabelSelectors.
+	// +optional
+	VolumeSelector *metav1.LabelSelector `json:"volumeSelector,omitempty"`
+
+	// cancel stops the NonAdminRestore: the running DataDownloads of its Velero Restore are cancelled, or no
+	// Velero Restore is created if it was not yet. A canceled NonAdminRestore can not be resumed.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+
+	// waitForBackupCompletion allows spec.restoreSpec.backupName to be a NonAdminBackup whose Velero Backup is not
+	// completed yet. The NonAdminRestore is Pending, and its Velero Restore is created once the NonAdminBackup completes.
+	// +optional
+	WaitForBackupCompletion bool `json:"waitForBackupCompletion,omitempty"`
+
+	// backupNamespace is the namespace of the NonAdminBackup spec.restoreSpec.backupName, when it is not the
+	// NonAdminRestore one. A NonAdminBackupShare in that namespace must share the NonAdminBackup with the
+	// NonAdminRestore namespace, and the NonAdminBackup must be completed.
+	// +optional
+	BackupNamespace string `json:"backupNamespace,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nonadminrestores,shortName=nar
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Request-Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Velero-Phase",type="string",JSONPath=".status.veleroRestore.status.phase"
+// +kubebuilder:printcolumn:name="Items-Restored",type="integer",JSONPath=".status.progress.itemsRestored"
+// +kubebuilder:printcolumn:name="Total-Items",type="integer",JSONPath=".status.progress.totalItems"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// NonAdminRestore is the Schema for the nonadminrestores API
+type NonAdminRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec NonAdminRestoreSpec `json:"spec,omitempty"`
+	// status is unchanged from v1alpha1
+	Status v1alpha1.NonAdminRestoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NonAdminRestoreList contains a list of NonAdminRestore
+type NonAdminRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NonAdminRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NonAdminRestore{}, &NonAdminRestoreList{})
+}
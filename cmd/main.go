@@ -20,10 +20,12 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	// TODO when to update oadp-operator version in go.mod?
@@ -33,6 +35,7 @@ import (
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	uberzap "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -50,8 +53,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	nacv1beta1 "github.com/migtools/oadp-non-admin/api/v1beta1"
+	"github.com/migtools/oadp-non-admin/internal/approval"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
 	"github.com/migtools/oadp-non-admin/internal/controller"
+	"github.com/migtools/oadp-non-admin/internal/notification"
+	"github.com/migtools/oadp-non-admin/internal/startup"
+	"github.com/migtools/oadp-non-admin/internal/validationhook"
+	nacwebhook "github.com/migtools/oadp-non-admin/internal/webhook"
 )
 
 var (
@@ -63,10 +73,13 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(nacv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(nacv1beta1.AddToScheme(scheme))
 
 	utilruntime.Must(velerov1.AddToScheme(scheme))
 
 	utilruntime.Must(velerov2alpha1.AddToScheme(scheme))
+
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -78,6 +91,64 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var enableConversionWebhook bool
+	var serveRequesterWebhooks bool
+	var backupDeletionTimeout time.Duration
+	var backupInProgressRequeueAfter time.Duration
+	var bslValidationDeadline time.Duration
+	var bslStorageUsagePeriod time.Duration
+	var backupMaxActiveDeadline time.Duration
+	var backupMaxParallelFilesUpload int
+	var backupSnapshotMoveData string
+	var forceBackupSnapshotMoveData bool
+	var forceFinalizerRemovalOnBackupDeletionTimeout bool
+	var additionalExcludedNamespacedResources string
+	var additionalExcludedClusterResources string
+	var restoreQuotaCheck string
+	var fetchRestoreResults bool
+	var requireDeleteBackupConfirmation bool
+	var requireDeleteBackupRequest bool
+	var allowRetentionPolicies bool
+	var propagatedBackupLabels string
+	var propagatedBackupAnnotations string
+	var allowMultiNamespaceBackups bool
+	var requireMultiNamespaceBackupApproval bool
+	var allowGroupBackups bool
+	var approvalRequestExpiration time.Duration
+	var allowRestoreVerification bool
+	var allowBackupVerificationJobs bool
+	var allowRestoreNamespaceMapping bool
+	var disableBackupExecHooks bool
+	var backupExecHookAllowedCommands string
+	var disableRestoreHooks bool
+	var restoreExecHookAllowedCommands string
+	var restoreInitHookAllowedImages string
+	var restoreDeniedResources string
+	var restoreEnforcementConfigMap string
+	var bslAutoApprovalConfigMap string
+	var bslPrefixTemplate string
+	var bslAllowedProviders string
+	var bslAllowedS3URLs string
+	var bslAllowCloudIdentity bool
+	var bslDisableDriftRevert bool
+	var bslMinBackupSyncPeriod time.Duration
+	var bslMinValidationFrequency time.Duration
+	var restoreMaxParallelFilesDownload int
+	var blockConcurrentRestores bool
+	var validationHookURL string
+	var validationHookCAFile string
+	var validationHookTimeout time.Duration
+	var allowNotifications bool
+	var notificationAllowedHosts string
+	var notificationTimeout time.Duration
+	var notificationMaxPendingPerNamespace int
+	var veleroBackupNameTemplate string
+	var startupReconcileQPS float64
+	var startupReconcileBurst int
+	var garbageCollectionOrphanMinAge time.Duration
+	var garbageCollectionReportOnly bool
+	var adoptOrphanBackups bool
+	var backupSummaryPeriod time.Duration
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -87,6 +158,192 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&enableConversionWebhook, "enable-conversion-webhook", false,
+		"If set, the conversion webhook of the NonAdminBackup and NonAdminRestore v1beta1 API is served. "+
+			"Required to use v1beta1, with the CRD conversion webhook and v1beta1 serving patches.")
+	flag.BoolVar(&serveRequesterWebhooks, "serve-requester-webhooks", false,
+		"If set, the NonAdminBackup, NonAdminRestore, NonAdminGroupBackup, NonAdminDeleteBackupRequest, NonAdminRetentionPolicy "+
+			"and NonAdminBackupVerification webhooks, recording the requester, are served whatever the features enabled, as config/default configures all of them. "+
+			"Each webhook is also served by the flag of the feature requiring it.")
+	flag.DurationVar(&backupDeletionTimeout, "backup-deletion-timeout", 0,
+		"Time after which a NonAdminBackup still being deleted is marked as DeletionStalled. "+
+			"Zero disables the check.")
+	flag.DurationVar(&bslValidationDeadline, "backup-storage-location-validation-deadline", 0,
+		"Time Velero has to validate the Velero BackupStorageLocation of a NonAdminBackupStorageLocation, after which its "+
+			"ObjectStorageAvailable condition is set to False with the ValidationTimeout reason. Zero waits for Velero indefinitely")
+	flag.DurationVar(&bslStorageUsagePeriod, "backup-storage-location-storage-usage-period", 0,
+		"Period the backup summary of the NonAdminBackupStorageLocations, with the approximate storage they use, is computed "+
+			"again at, even if their Velero BackupStorageLocation did not change. Zero only computes it when the Velero "+
+			"BackupStorageLocation changes")
+	flag.DurationVar(&backupInProgressRequeueAfter, "backup-in-progress-requeue-after", 0,
+		"Interval at which a NonAdminBackup is reconciled while its Velero Backup is running, "+
+			"refreshing its status if a Velero Backup event is missed. Zero disables it.")
+	flag.DurationVar(&backupMaxActiveDeadline, "backup-max-active-deadline", 0,
+		"Maximum spec.activeDeadlineSeconds of a NonAdminBackup, also applied to NonAdminBackups not setting it. "+
+			"Zero allows any active deadline and sets none by default.")
+	flag.IntVar(&backupMaxParallelFilesUpload, "backup-max-parallel-files-upload", 0,
+		"Maximum spec.backupSpec.uploaderConfig.parallelFilesUpload of a NonAdminBackup, also applied to NonAdminBackups "+
+			"not setting it, so a namespace can not saturate the node-agent bandwidth. Zero allows any value and sets none by default.")
+	flag.StringVar(&backupSnapshotMoveData, "backup-snapshot-move-data", "",
+		"Value of spec.backupSpec.snapshotMoveData used by NonAdminBackups not setting it, \"true\" or \"false\". "+
+			"Empty leaves it to Velero.")
+	flag.BoolVar(&forceBackupSnapshotMoveData, "force-backup-snapshot-move-data", false,
+		"If set, the backup-snapshot-move-data value is also used by NonAdminBackups setting spec.backupSpec.snapshotMoveData")
+	flag.BoolVar(&forceFinalizerRemovalOnBackupDeletionTimeout, "backup-deletion-force-finalizer-removal", false,
+		"If set, the NonAdminBackup finalizer is removed once the backup deletion timeout is exceeded")
+	flag.StringVar(&additionalExcludedNamespacedResources, "additional-excluded-namespaced-resources", "",
+		"Comma separated list of namespaced resources excluded from all NonAdminBackups, "+
+			"in addition to the always excluded ones")
+	flag.StringVar(&additionalExcludedClusterResources, "additional-excluded-cluster-resources", "",
+		"Comma separated list of cluster scoped resources excluded from all NonAdminBackups, "+
+			"in addition to the always excluded ones")
+	flag.StringVar(&restoreQuotaCheck, "restore-quota-check", "",
+		fmt.Sprintf("Check NonAdminRestore volumes against the namespace ResourceQuotas and LimitRanges before restoring. "+
+			"Only storage and PersistentVolumeClaim quotas are checked, not the other count/* object quotas. "+
+			"%q only sets the QuotaWouldBeExceeded condition, %q also stops the restore. Empty disables the check.",
+			constant.RestoreQuotaCheckWarn, constant.RestoreQuotaCheckFail))
+	flag.BoolVar(&fetchRestoreResults, "restore-results-error-summary", false,
+		"If set, a summary of the Velero Restore error messages, failed item operations and restored resources, read from "+
+			"the restore results, item operations and resource list in object storage, is listed in the NonAdminRestore status")
+	flag.BoolVar(&requireDeleteBackupConfirmation, "require-delete-backup-confirmation", false,
+		"If set, NonAdminBackup spec.deleteBackup only takes effect when spec.deleteBackupConfirmation "+
+			"is set to the NonAdminBackup name")
+	flag.BoolVar(&requireDeleteBackupRequest, "require-delete-backup-request", false,
+		"If set, NonAdminBackup spec.deleteBackup only takes effect when a NonAdminDeleteBackupRequest accepted the "+
+			"NonAdminBackup for deletion, so RBAC may allow updating NonAdminBackups without allowing to delete their backup data. "+
+			"Requires the NonAdminDeleteBackupRequest webhooks, recording the requester, which are served when this is set.")
+	flag.BoolVar(&allowRetentionPolicies, "allow-retention-policies", false,
+		"If set, non admin users may create NonAdminRetentionPolicies, and NAC creates NonAdminDeleteBackupRequests "+
+			"for the NonAdminBackups of their namespace exceeding the policy spec.keepLast or spec.maxAge, if the policy creator may "+
+			"create NonAdminDeleteBackupRequests. Requires the NonAdminRetentionPolicy webhooks, recording the requester, "+
+			"which are served when this is set.")
+	flag.StringVar(&propagatedBackupLabels, "propagated-backup-labels", "",
+		"Comma separated list of NonAdminBackup label keys copied to the Velero Backup")
+	flag.StringVar(&propagatedBackupAnnotations, "propagated-backup-annotations", "",
+		"Comma separated list of NonAdminBackup annotation keys copied to the Velero Backup")
+	flag.BoolVar(&allowMultiNamespaceBackups, "allow-multi-namespace-backups", false,
+		"If set, NonAdminBackup spec.backupSpec.includedNamespaces may contain namespaces where the requester "+
+			"can also create NonAdminBackups. Requires the NonAdminBackup webhooks, which are served when this is set.")
+	flag.BoolVar(&allowGroupBackups, "allow-group-backups", false,
+		"If set, NonAdminGroupBackups may back up the namespaces selected by label where the requester can create "+
+			"NonAdminBackups. Requires the NonAdminGroupBackup webhooks, which are served when this is set. "+
+			"Mode Single also requires --allow-multi-namespace-backups")
+	flag.BoolVar(&requireMultiNamespaceBackupApproval, "require-multi-namespace-backup-approval", false,
+		"If set, the VeleroBackup of a NonAdminBackup including namespaces other than its own is only created once the "+
+			"cluster admin approves the NonAdminApprovalRequest created for it in the OADP namespace")
+	flag.DurationVar(&approvalRequestExpiration, "approval-request-expiration", 0,
+		"How long a NonAdminApprovalRequest may stay pending before it expires and the operation requesting it is "+
+			"given up. Zero never expires NonAdminApprovalRequests")
+	flag.BoolVar(&allowRestoreVerification, "allow-restore-verification", false,
+		"If set, NonAdminBackup spec.verifyRestore may be set, restoring the backup once it completes into a temporary "+
+			"namespace created and deleted by NAC, and recording the outcome in the RestoreVerified condition. "+
+			"Also allows NonAdminBackupVerifications")
+	flag.BoolVar(&allowBackupVerificationJobs, "allow-backup-verification-jobs", false,
+		"If set, NonAdminBackupVerification spec.validationJob may be set, running a Job of the tenant in the temporary "+
+			"namespace the backup is restored into, if the tenant is allowed to create Jobs in its namespace. "+
+			"Requires the NonAdminBackupVerification webhooks, recording the requester, which are served when this is set.")
+	flag.BoolVar(&allowRestoreNamespaceMapping, "allow-restore-namespace-mapping", false,
+		"If set, NonAdminRestore spec.restoreSpec.namespaceMapping may map the NonAdminRestore namespace to a namespace "+
+			"where the requester can also create NonAdminRestores. Requires the NonAdminRestore webhooks, which are served when this is set.")
+	flag.BoolVar(&disableBackupExecHooks, "disable-backup-exec-hooks", false,
+		"Reject NonAdminBackups with exec hooks in spec.backupSpec.hooks")
+	flag.StringVar(&backupExecHookAllowedCommands, "backup-exec-hook-allowed-commands", "",
+		"Comma separated list of the executables, the first element of the command, NonAdminBackup exec hooks may run. "+
+			"Empty allows any executable.")
+	flag.BoolVar(&disableRestoreHooks, "disable-restore-hooks", false,
+		"Reject NonAdminRestores with exec or init hooks in spec.restoreSpec.hooks")
+	flag.StringVar(&restoreExecHookAllowedCommands, "restore-exec-hook-allowed-commands", "",
+		"Comma separated list of the executables, the first element of the command, NonAdminRestore exec hooks may run. "+
+			"Empty allows any executable.")
+	flag.StringVar(&restoreInitHookAllowedImages, "restore-init-hook-allowed-images", "",
+		"Comma separated list of the images, without tag or digest, NonAdminRestore init hook containers may use. "+
+			"Empty allows any image.")
+	flag.StringVar(&restoreDeniedResources, "restore-denied-resources", "",
+		"Comma separated list of the resources, like secrets or serviceaccounts, NonAdminRestores may not restore into any namespace. "+
+			"The openshift.io/oadp-nac-restore-denied-resources annotation of a namespace adds resources denied in it. "+
+			"NonAdminRestores including them in spec.restoreSpec.includedResources are rejected.")
+	flag.StringVar(&restoreEnforcementConfigMap, "restore-enforcement-configmap", "",
+		"Name of a ConfigMap, in the OADP namespace, listing rules that enforce NonAdminRestore spec.restoreSpec restorePVs, "+
+			"preserveNodePorts and uploaderConfig on the namespaces selected by their namespaceSelector. Empty disables it.")
+	flag.StringVar(&bslAutoApprovalConfigMap, "bsl-auto-approval-configmap", "",
+		"Name of a ConfigMap, in the OADP namespace, listing rules that approve the NonAdminBackupStorageLocations of the "+
+			"namespaces selected by their namespaceSelector, when their provider, bucket and prefix match, if the DPA requires "+
+			"approval for them. Empty leaves every approval to the cluster admin.")
+	flag.StringVar(&bslPrefixTemplate, "bsl-prefix-template", "",
+		fmt.Sprintf("Object storage prefix template put in front of the NonAdminBackupStorageLocation prefix in its Velero "+
+			"BackupStorageLocation, for example %q, so tenants can not see each other backups. The %s placeholder is required, "+
+			"as a whole path segment, "+
+			"%s is also allowed. Empty puts the namespace in front of the prefix.",
+			"tenants/"+constant.NameTemplateNamespace, constant.NameTemplateNamespace, constant.NameTemplateName))
+	flag.StringVar(&bslAllowedProviders, "bsl-allowed-providers", "",
+		"Comma separated list of the providers, like aws or azure, NonAdminBackupStorageLocations may use. "+
+			"Empty allows any provider.")
+	flag.StringVar(&bslAllowedS3URLs, "bsl-allowed-s3-urls", "",
+		"Comma separated list of shell patterns, like https://*.internal.example.com, the s3Url config of "+
+			"NonAdminBackupStorageLocations using the aws provider must match. Empty allows any endpoint, AWS S3 included.")
+	flag.DurationVar(&bslMinBackupSyncPeriod, "bsl-min-backup-sync-period", 0,
+		"Minimum backupSyncPeriod of the Velero BackupStorageLocations of NonAdminBackupStorageLocations, also applied to the "+
+			"ones not setting it, so many tenant locations do not list the object storage too often. It must be lower than the "+
+			"non admin backupSyncPeriod. Zero sets no minimum.")
+	flag.DurationVar(&bslMinValidationFrequency, "bsl-min-validation-frequency", 0,
+		"Minimum validationFrequency of the Velero BackupStorageLocations of NonAdminBackupStorageLocations, also applied to "+
+			"the ones not setting it. Zero sets no minimum.")
+	flag.BoolVar(&bslAllowCloudIdentity, "bsl-allow-cloud-identity", false,
+		"If set, NonAdminBackupStorageLocations may set spec.cloudIdentity, an AWS role ARN or an Azure workload identity, to use "+
+			"short-lived credentials of the Velero workload identity instead of a credential Secret. The roles trust Velero, not the "+
+			"namespace, so they always need the approval of the cluster admin, even if requireApprovalForBSL is not set.")
+	flag.BoolVar(&bslDisableDriftRevert, "bsl-disable-drift-revert", false,
+		"If set, the fields of the Velero BackupStorageLocations of NonAdminBackupStorageLocations modified out-of-band, by the "+
+			"cluster admin or another operator, are kept and only reported in the NonAdminBackupStorageLocation ConfigDrift "+
+			"condition. Otherwise they are reverted to the NonAdminBackupStorageLocation spec.")
+	flag.IntVar(&restoreMaxParallelFilesDownload, "restore-max-parallel-files-download", 0,
+		"Maximum spec.restoreSpec.uploaderConfig.parallelFilesDownload of a NonAdminRestore, also applied to NonAdminRestores "+
+			"not setting it, so a namespace can not saturate the node-agent bandwidth. Zero allows any value and sets none by default.")
+	flag.BoolVar(&blockConcurrentRestores, "block-concurrent-restores", false,
+		"If set, the Velero Restore of a NonAdminRestore is only created once the other Velero Restores into its namespace "+
+			"finished. Otherwise they are only reported in the NonAdminRestore ConcurrentRestore condition.")
+	flag.StringVar(&validationHookURL, "validation-hook-url", "",
+		"URL of an endpoint called to validate NonAdminBackup, NonAdminRestore and NonAdminBackupStorageLocation "+
+			"objects with site-specific rules. Empty disables the validation hook.")
+	flag.StringVar(&validationHookCAFile, "validation-hook-ca-file", "",
+		"PEM encoded CA bundle used to verify the validation hook certificate. Empty uses the system CA bundle.")
+	flag.DurationVar(&validationHookTimeout, "validation-hook-timeout", validationhook.DefaultTimeout,
+		"Timeout of validation hook calls")
+	flag.BoolVar(&allowNotifications, "allow-notifications", false,
+		"If set, non admin users may create NonAdminNotifications, and NAC posts a notification to their webhook when a "+
+			"NonAdminBackup or NonAdminRestore of their namespace completes, fails or expires")
+	flag.StringVar(&notificationAllowedHosts, "notification-allowed-hosts", "",
+		"Comma separated list of shell patterns the host of the NonAdminNotification webhook URLs must match, for example "+
+			"\"hooks.slack.com,*.webhook.office.com\". Empty allows no host, so it must be set to use NonAdminNotifications.")
+	flag.DurationVar(&notificationTimeout, "notification-timeout", notification.DefaultTimeout,
+		"Timeout of NonAdminNotification webhook calls")
+	flag.IntVar(&notificationMaxPendingPerNamespace, "notification-max-pending-per-namespace", notification.DefaultMaxPendingPerNamespace,
+		"Number of notifications of a namespace waiting to be posted, for example while its webhooks are unavailable, "+
+			"after which the new notifications of the namespace are dropped and recorded as failed")
+	flag.StringVar(&veleroBackupNameTemplate, "velero-backup-name-template", "",
+		fmt.Sprintf("Name template of the Velero Backups created for NonAdminBackups, for example %q. "+
+			"Allowed placeholders are %s, %s, %s and %s, one of the last two is required. "+
+			"Names that would be too long fall back to the default. Empty names Velero Backups with their NACUUID.",
+			constant.NameTemplateNamespace+constant.NameDelimiter+constant.NameTemplateName+constant.NameDelimiter+constant.NameTemplateShortUUID,
+			constant.NameTemplateNamespace, constant.NameTemplateName, constant.NameTemplateUUID, constant.NameTemplateShortUUID))
+	flag.Float64Var(&startupReconcileQPS, "startup-reconcile-qps", 0,
+		"Reconciles per second each controller releases while warming up after a start, once the burst is released. "+
+			"The /startupz endpoint of the metrics server fails while a controller warms up. Zero disables the startup backpressure.")
+	flag.IntVar(&startupReconcileBurst, "startup-reconcile-burst", startup.DefaultBurst,
+		"Reconciles each controller releases right away while warming up after a start")
+	flag.DurationVar(&garbageCollectionOrphanMinAge, "garbage-collection-orphan-min-age", 0,
+		"Minimum age of the objects created by NAC in the OADP namespace, whose NAC object no longer exists, "+
+			"before the garbage collector deletes them. Zero deletes them on the first garbage collection.")
+	flag.BoolVar(&garbageCollectionReportOnly, "garbage-collection-report-only", false,
+		"If set, the garbage collector only reports the orphan objects of the OADP namespace, "+
+			"with a log and a Warning event, instead of deleting them.")
+	flag.BoolVar(&adoptOrphanBackups, "adopt-orphan-backups", false,
+		"If set, the backup synchronizer also runs as soon as a NonAdminBackup is deleted, recreating the NonAdminBackup of a completed "+
+			"Velero Backup created by NAC which no longer exists in its origin namespace, even if the non admin backupSyncPeriod is zero. "+
+			"Velero Backups released with spec.retainBackupOnDelete set are left to the cluster admin.")
+	flag.DurationVar(&backupSummaryPeriod, "backup-summary-period", 5*time.Minute,
+		"How often the status of the NonAdminBackupSummaries of the cluster admin is computed again. "+
+			"Zero disables the NonAdminBackupSummary controller.")
 	logLevel := zapcore.InfoLevel
 	// read loglevel string coming from DPA which is a logrus level
 	logLevelEnvInvalid := false
@@ -144,6 +401,50 @@ func main() {
 		TLSOpts: tlsOpts,
 	})
 
+	if restoreQuotaCheck != constant.EmptyString &&
+		restoreQuotaCheck != constant.RestoreQuotaCheckWarn &&
+		restoreQuotaCheck != constant.RestoreQuotaCheckFail {
+		setupLog.Error(fmt.Errorf("invalid restore-quota-check value %q", restoreQuotaCheck), "invalid flag value")
+		os.Exit(1)
+	}
+
+	var defaultBackupSnapshotMoveData *bool
+	if backupSnapshotMoveData != constant.EmptyString {
+		snapshotMoveData, err := strconv.ParseBool(backupSnapshotMoveData)
+		if err != nil {
+			setupLog.Error(fmt.Errorf("invalid backup-snapshot-move-data value %q", backupSnapshotMoveData), "invalid flag value")
+			os.Exit(1)
+		}
+		defaultBackupSnapshotMoveData = &snapshotMoveData
+	} else if forceBackupSnapshotMoveData {
+		setupLog.Error(errors.New("force-backup-snapshot-move-data requires backup-snapshot-move-data"), "invalid flag value")
+		os.Exit(1)
+	}
+
+	if veleroBackupNameTemplate != constant.EmptyString {
+		if err := function.ValidateNacObjectNameTemplate(veleroBackupNameTemplate); err != nil {
+			setupLog.Error(err, "invalid flag value")
+			os.Exit(1)
+		}
+	}
+
+	if err := function.ValidateBslAllowedS3URLs(splitCommaSeparatedList(bslAllowedS3URLs)); err != nil {
+		setupLog.Error(err, "invalid flag value")
+		os.Exit(1)
+	}
+
+	if err := notification.ValidateAllowedHosts(splitCommaSeparatedList(notificationAllowedHosts)); err != nil {
+		setupLog.Error(err, "invalid flag value")
+		os.Exit(1)
+	}
+
+	if bslPrefixTemplate != constant.EmptyString {
+		if err := function.ValidateBslPrefixTemplate(bslPrefixTemplate); err != nil {
+			setupLog.Error(err, "invalid flag value")
+			os.Exit(1)
+		}
+	}
+
 	oadpNamespace := os.Getenv(constant.NamespaceEnvVar)
 	if len(oadpNamespace) == 0 {
 		setupLog.Error(fmt.Errorf("%v environment variable is empty", constant.NamespaceEnvVar), "environment variable must be set")
@@ -152,12 +453,29 @@ func main() {
 
 	restConfig := ctrl.GetConfigOrDie()
 
-	dpaConfiguration, defaultSyncPeriod, err := getDPAConfiguration(restConfig, oadpNamespace)
+	dpaConfiguration, veleroConfiguration, err := getDPAConfiguration(restConfig, oadpNamespace)
 	if err != nil {
 		setupLog.Error(err, "unable to get enforced spec")
 		os.Exit(1)
 	}
 
+	controllerUsername := constant.EmptyString
+	if serveRequesterWebhooks || allowMultiNamespaceBackups || allowRestoreNamespaceMapping || allowGroupBackups ||
+		requireDeleteBackupRequest || allowRetentionPolicies || allowBackupVerificationJobs {
+		controllerUsername, err = getControllerUsername(restConfig)
+		if err != nil {
+			setupLog.Error(err, "unable to get the username of the controller")
+			os.Exit(1)
+		}
+	}
+
+	if bslMinBackupSyncPeriod > 0 && dpaConfiguration.BackupSyncPeriod.Duration > 0 &&
+		bslMinBackupSyncPeriod >= dpaConfiguration.BackupSyncPeriod.Duration {
+		setupLog.Error(fmt.Errorf("bsl-min-backup-sync-period (%v) must be lower than the non admin backupSyncPeriod (%v)",
+			bslMinBackupSyncPeriod, dpaConfiguration.BackupSyncPeriod.Duration), "invalid flag value")
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Logger: zap.New(zap.UseFlagOptions(&opts)),
 		Scheme: scheme,
@@ -187,51 +505,294 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controller.NonAdminBackupReconciler{
-		Client:             mgr.GetClient(),
-		Scheme:             mgr.GetScheme(),
-		OADPNamespace:      oadpNamespace,
-		EnforcedBackupSpec: dpaConfiguration.EnforceBackupSpec,
+	var startupBackpressure *startup.Backpressure
+	if startupReconcileQPS != 0 {
+		startupBackpressure, err = startup.New(startupReconcileQPS, startupReconcileBurst)
+		if err != nil {
+			setupLog.Error(err, "invalid flag value")
+			os.Exit(1)
+		}
+	}
+
+	var validationHook *validationhook.Hook
+	if validationHookURL != "" {
+		validationHook, err = validationhook.New(validationHookURL, validationHookCAFile, validationHookTimeout)
+		if err != nil {
+			setupLog.Error(err, "unable to setup validation hook")
+			os.Exit(1)
+		}
+	}
+
+	var notifier *notification.Notifier
+	if allowNotifications {
+		notifier = notification.New(mgr.GetClient(), splitCommaSeparatedList(notificationAllowedHosts), notificationTimeout,
+			notificationMaxPendingPerNamespace)
+		if err = mgr.Add(notifier); err != nil {
+			setupLog.Error(err, "unable to add the notifier to the manager")
+			os.Exit(1)
+		}
+	}
+	if err = (&controller.NonAdminNotificationReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Notifier: notifier,
 	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminNotification controller with manager")
+		os.Exit(1)
+	}
+
+	var multiNamespaceBackupApproval *approval.Engine
+	if requireMultiNamespaceBackupApproval {
+		multiNamespaceBackupApproval = &approval.Engine{
+			Client:        mgr.GetClient(),
+			OADPNamespace: oadpNamespace,
+			Expiration:    approvalRequestExpiration,
+		}
+		if err = (&controller.NonAdminApprovalRequestReconciler{
+			Client:        mgr.GetClient(),
+			Scheme:        mgr.GetScheme(),
+			OADPNamespace: oadpNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminApprovalRequest controller with manager")
+			os.Exit(1)
+		}
+	}
+
+	nonAdminBackupReconciler := &controller.NonAdminBackupReconciler{
+		Client:                                 mgr.GetClient(),
+		Scheme:                                 mgr.GetScheme(),
+		Recorder:                               mgr.GetEventRecorderFor("nonadminbackup-controller"),
+		OADPNamespace:                          oadpNamespace,
+		EnforcedBackupSpec:                     dpaConfiguration.EnforceBackupSpec,
+		DeletionTimeout:                        backupDeletionTimeout,
+		InProgressRequeueAfter:                 backupInProgressRequeueAfter,
+		MaxActiveDeadline:                      backupMaxActiveDeadline,
+		MaxParallelFilesUpload:                 backupMaxParallelFilesUpload,
+		DefaultSnapshotMoveData:                defaultBackupSnapshotMoveData,
+		ForceSnapshotMoveData:                  forceBackupSnapshotMoveData,
+		ForceFinalizerRemovalOnDeletionTimeout: forceFinalizerRemovalOnBackupDeletionTimeout,
+		AdditionalExcludedNamespacedResources:  splitCommaSeparatedList(additionalExcludedNamespacedResources),
+		AdditionalExcludedClusterResources:     splitCommaSeparatedList(additionalExcludedClusterResources),
+		RequireDeleteBackupConfirmation:        requireDeleteBackupConfirmation,
+		RequireDeleteBackupRequest:             requireDeleteBackupRequest,
+		PropagatedLabels:                       splitCommaSeparatedList(propagatedBackupLabels),
+		PropagatedAnnotations:                  splitCommaSeparatedList(propagatedBackupAnnotations),
+		AllowMultiNamespaceBackups:             allowMultiNamespaceBackups,
+		RequesterWebhookServed:                 allowMultiNamespaceBackups || serveRequesterWebhooks,
+		MultiNamespaceBackupApproval:           multiNamespaceBackupApproval,
+		Notifier:                               notifier,
+		AllowRestoreVerification:               allowRestoreVerification,
+		DisableExecHooks:                       disableBackupExecHooks,
+		AllowedExecHookCommands:                splitCommaSeparatedList(backupExecHookAllowedCommands),
+		ValidationHook:                         validationHook,
+		VeleroBackupNameTemplate:               veleroBackupNameTemplate,
+		StartupBackpressure:                    startupBackpressure,
+	}
+	if err = nonAdminBackupReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to setup NonAdminBackup controller with manager")
 		os.Exit(1)
 	}
+	if allowMultiNamespaceBackups || serveRequesterWebhooks {
+		if err = nacwebhook.SetupNonAdminBackupWebhookWithManager(mgr, controllerUsername); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminBackup webhook with manager")
+			os.Exit(1)
+		}
+	}
 	if err = (&controller.NonAdminRestoreReconciler{
-		Client:              mgr.GetClient(),
-		Scheme:              mgr.GetScheme(),
-		OADPNamespace:       oadpNamespace,
-		EnforcedRestoreSpec: dpaConfiguration.EnforceRestoreSpec,
+		Client:                      mgr.GetClient(),
+		Scheme:                      mgr.GetScheme(),
+		OADPNamespace:               oadpNamespace,
+		EnforcedRestoreSpec:         dpaConfiguration.EnforceRestoreSpec,
+		RestoreQuotaCheck:           restoreQuotaCheck,
+		FetchRestoreResults:         fetchRestoreResults,
+		AllowNamespaceMapping:       allowRestoreNamespaceMapping,
+		RequesterWebhookServed:      allowRestoreNamespaceMapping || serveRequesterWebhooks,
+		DisableHooks:                disableRestoreHooks,
+		AllowedExecHookCommands:     splitCommaSeparatedList(restoreExecHookAllowedCommands),
+		AllowedInitHookImages:       splitCommaSeparatedList(restoreInitHookAllowedImages),
+		DeniedResources:             splitCommaSeparatedList(restoreDeniedResources),
+		RestoreEnforcementConfigMap: restoreEnforcementConfigMap,
+		MaxParallelFilesDownload:    restoreMaxParallelFilesDownload,
+		BlockConcurrentRestores:     blockConcurrentRestores,
+		ValidationHook:              validationHook,
+		Notifier:                    notifier,
+		StartupBackpressure:         startupBackpressure,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to setup NonAdminRestore controller with manager")
 		os.Exit(1)
 	}
+	if allowRestoreNamespaceMapping || serveRequesterWebhooks {
+		if err = nacwebhook.SetupNonAdminRestoreWebhookWithManager(mgr, controllerUsername); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminRestore webhook with manager")
+			os.Exit(1)
+		}
+	}
+	if enableConversionWebhook {
+		if err = nacwebhook.SetupConversionWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to setup conversion webhook with manager")
+			os.Exit(1)
+		}
+	}
 	if err = (&controller.NonAdminBackupStorageLocationReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		Recorder:               mgr.GetEventRecorderFor("nonadminbackupstoragelocation-controller"),
+		OADPNamespace:          oadpNamespace,
+		RequireApprovalForBSL:  *dpaConfiguration.RequireApprovalForBSL,
+		SyncPeriod:             dpaConfiguration.BackupSyncPeriod.Duration,
+		DefaultSyncPeriod:      veleroConfiguration.defaultSyncPeriod,
+		EnforcedBslSpec:        dpaConfiguration.EnforceBSLSpec,
+		ValidationDeadline:     bslValidationDeadline,
+		StorageUsagePeriod:     bslStorageUsagePeriod,
+		AutoApprovalConfigMap:  bslAutoApprovalConfigMap,
+		PrefixTemplate:         bslPrefixTemplate,
+		AllowedProviders:       splitCommaSeparatedList(bslAllowedProviders),
+		AllowedS3URLs:          splitCommaSeparatedList(bslAllowedS3URLs),
+		AllowCloudIdentity:     bslAllowCloudIdentity,
+		DisableDriftRevert:     bslDisableDriftRevert,
+		MinBackupSyncPeriod:    bslMinBackupSyncPeriod,
+		MinValidationFrequency: bslMinValidationFrequency,
+		ValidationHook:         validationHook,
+		StartupBackpressure:    startupBackpressure,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminBackupStorageLocation controller with manager")
+		os.Exit(1)
+	}
+	if err = (&controller.NonAdminDownloadRequestReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		OADPNamespace: oadpNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NonAdminDownloadRequest")
+		os.Exit(1)
+	}
+	if err = (&controller.NonAdminBackupTestReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminBackupTest controller with manager")
+		os.Exit(1)
+	}
+	if err = (&controller.NonAdminScheduleReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		BackupReconciler: nonAdminBackupReconciler,
+		ValidationHook:   validationHook,
+		OADPNamespace:    oadpNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminSchedule controller with manager")
+		os.Exit(1)
+	}
+	if err = (&controller.NonAdminVolumeSnapshotLocationReconciler{
 		Client:                mgr.GetClient(),
 		Scheme:                mgr.GetScheme(),
 		OADPNamespace:         oadpNamespace,
 		RequireApprovalForBSL: *dpaConfiguration.RequireApprovalForBSL,
-		SyncPeriod:            dpaConfiguration.BackupSyncPeriod.Duration,
-		DefaultSyncPeriod:     defaultSyncPeriod,
-		EnforcedBslSpec:       dpaConfiguration.EnforceBSLSpec,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to setup NonAdminBackupStorageLocation controller with manager")
+		setupLog.Error(err, "unable to setup NonAdminVolumeSnapshotLocation controller with manager")
 		os.Exit(1)
 	}
-	if err = (&controller.NonAdminDownloadRequestReconciler{
+	if err = (&controller.NonAdminDataProtectionTestReconciler{
 		Client:        mgr.GetClient(),
 		Scheme:        mgr.GetScheme(),
 		OADPNamespace: oadpNamespace,
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "NonAdminDownloadRequest")
+		setupLog.Error(err, "unable to setup NonAdminDataProtectionTest controller with manager")
+		os.Exit(1)
+	}
+	if err = (&controller.NonAdminGroupBackupReconciler{
+		Client:                     mgr.GetClient(),
+		Scheme:                     mgr.GetScheme(),
+		OADPNamespace:              oadpNamespace,
+		AllowGroupBackups:          allowGroupBackups,
+		AllowMultiNamespaceBackups: allowMultiNamespaceBackups,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminGroupBackup controller with manager")
+		os.Exit(1)
+	}
+	if allowGroupBackups || serveRequesterWebhooks {
+		if err = nacwebhook.SetupNonAdminGroupBackupWebhookWithManager(mgr, controllerUsername); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminGroupBackup webhook with manager")
+			os.Exit(1)
+		}
+	}
+	if err = (&controller.NonAdminDeleteBackupRequestReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminDeleteBackupRequest controller with manager")
+		os.Exit(1)
+	}
+	if requireDeleteBackupRequest || serveRequesterWebhooks {
+		if err = nacwebhook.SetupNonAdminDeleteBackupRequestWebhookWithManager(mgr, controllerUsername); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminDeleteBackupRequest webhook with manager")
+			os.Exit(1)
+		}
+	}
+	if err = (&controller.NonAdminRetentionPolicyReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		AllowRetentionPolicies: allowRetentionPolicies,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminRetentionPolicy controller with manager")
+		os.Exit(1)
+	}
+	if allowRetentionPolicies || serveRequesterWebhooks {
+		if err = nacwebhook.SetupNonAdminRetentionPolicyWebhookWithManager(mgr, controllerUsername); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminRetentionPolicy webhook with manager")
+			os.Exit(1)
+		}
+	}
+	if err = (&controller.NonAdminBackupVerificationReconciler{
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		AllowRestoreVerification: allowRestoreVerification,
+		AllowValidationJobs:      allowBackupVerificationJobs,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminBackupVerification controller with manager")
+		os.Exit(1)
+	}
+	if allowBackupVerificationJobs || serveRequesterWebhooks {
+		if err = nacwebhook.SetupNonAdminBackupVerificationWebhookWithManager(mgr, controllerUsername); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminBackupVerification webhook with manager")
+			os.Exit(1)
+		}
+	}
+	if err = (&controller.NonAdminServerStatusRequestReconciler{
+		Client:             mgr.GetClient(),
+		Scheme:             mgr.GetScheme(),
+		OADPNamespace:      oadpNamespace,
+		VeleroFeatureFlags: veleroConfiguration.featureFlags,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NonAdminServerStatusRequest")
+		os.Exit(1)
+	}
+	if err = (&controller.NonAdminQuotaStatusReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		OADPNamespace: oadpNamespace,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup NonAdminQuotaStatus controller with manager")
 		os.Exit(1)
 	}
 	// +kubebuilder:scaffold:builder
-	if dpaConfiguration.BackupSyncPeriod.Duration > 0 {
-		if err = (&controller.NonAdminBackupSynchronizerReconciler{
+	if backupSummaryPeriod > 0 {
+		if err = (&controller.NonAdminBackupSummaryReconciler{
 			Client:        mgr.GetClient(),
 			Scheme:        mgr.GetScheme(),
-			OADPNamespace: oadpNamespace,
-			SyncPeriod:    dpaConfiguration.BackupSyncPeriod.Duration,
+			SummaryPeriod: backupSummaryPeriod,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to setup NonAdminBackupSummary controller with manager")
+			os.Exit(1)
+		}
+	}
+	if dpaConfiguration.BackupSyncPeriod.Duration > 0 || adoptOrphanBackups {
+		if err = (&controller.NonAdminBackupSynchronizerReconciler{
+			Client:             mgr.GetClient(),
+			Scheme:             mgr.GetScheme(),
+			OADPNamespace:      oadpNamespace,
+			SyncPeriod:         dpaConfiguration.BackupSyncPeriod.Duration,
+			AdoptOrphanBackups: adoptOrphanBackups,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to setup NonAdminBackupSynchronizer controller with manager")
 			os.Exit(1)
@@ -242,8 +803,11 @@ func main() {
 			Client:                mgr.GetClient(),
 			Scheme:                mgr.GetScheme(),
 			OADPNamespace:         oadpNamespace,
+			Recorder:              mgr.GetEventRecorderFor("nonadmingarbagecollector"),
 			Frequency:             dpaConfiguration.GarbageCollectionPeriod.Duration,
 			RequireApprovalForBSL: *dpaConfiguration.RequireApprovalForBSL,
+			OrphanMinAge:          garbageCollectionOrphanMinAge,
+			ReportOnly:            garbageCollectionReportOnly,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to setup GarbageCollector controller with manager")
 			os.Exit(1)
@@ -258,6 +822,15 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if startupBackpressure != nil {
+		// not a readiness check, so the webhooks stay available while the controllers warm up
+		if err := mgr.AddMetricsServerExtraHandler("/startupz", &healthz.Handler{
+			Checks: map[string]healthz.Checker{"startup": startupBackpressure.Checker},
+		}); err != nil {
+			setupLog.Error(err, "unable to set up startup check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -266,7 +839,13 @@ func main() {
 	}
 }
 
-func getDPAConfiguration(restConfig *rest.Config, oadpNamespace string) (v1alpha1.NonAdmin, *time.Duration, error) {
+// dpaVeleroConfiguration holds the Velero configuration of the DPA read by NAC
+type dpaVeleroConfiguration struct {
+	defaultSyncPeriod *time.Duration
+	featureFlags      []string
+}
+
+func getDPAConfiguration(restConfig *rest.Config, oadpNamespace string) (v1alpha1.NonAdmin, dpaVeleroConfiguration, error) {
 	dpaConfiguration := v1alpha1.NonAdmin{
 		GarbageCollectionPeriod: &metav1.Duration{
 			Duration: v1alpha1.DefaultGarbageCollectionPeriod,
@@ -279,7 +858,7 @@ func getDPAConfiguration(restConfig *rest.Config, oadpNamespace string) (v1alpha
 		EnforceBSLSpec:        &v1alpha1.EnforceBackupStorageLocationSpec{},
 		RequireApprovalForBSL: ptr.To(false),
 	}
-	var defaultSyncPeriod *time.Duration
+	veleroConfiguration := dpaVeleroConfiguration{}
 
 	dpaClientScheme := runtime.NewScheme()
 	utilruntime.Must(v1alpha1.AddToScheme(dpaClientScheme))
@@ -287,13 +866,13 @@ func getDPAConfiguration(restConfig *rest.Config, oadpNamespace string) (v1alpha
 		Scheme: dpaClientScheme,
 	})
 	if err != nil {
-		return dpaConfiguration, defaultSyncPeriod, err
+		return dpaConfiguration, veleroConfiguration, err
 	}
 	// TODO we could pass DPA name as env var and do a get call directly. Better?
 	dpaList := &v1alpha1.DataProtectionApplicationList{}
 	err = dpaClient.List(context.Background(), dpaList, &client.ListOptions{Namespace: oadpNamespace})
 	if err != nil {
-		return dpaConfiguration, defaultSyncPeriod, err
+		return dpaConfiguration, veleroConfiguration, err
 	}
 	for _, dpa := range dpaList.Items {
 		if nonAdmin := dpa.Spec.NonAdmin; nonAdmin != nil {
@@ -316,13 +895,32 @@ func getDPAConfiguration(restConfig *rest.Config, oadpNamespace string) (v1alpha
 				dpaConfiguration.RequireApprovalForBSL = nonAdmin.RequireApprovalForBSL
 			}
 			if dpa.Spec.Configuration.Velero.Args != nil && dpa.Spec.Configuration.Velero.Args.BackupSyncPeriod != nil {
-				defaultSyncPeriod = dpa.Spec.Configuration.Velero.Args.BackupSyncPeriod
+				veleroConfiguration.defaultSyncPeriod = dpa.Spec.Configuration.Velero.Args.BackupSyncPeriod
 			}
+			veleroConfiguration.featureFlags = dpa.Spec.Configuration.Velero.FeatureFlags
 			break
 		}
 	}
 
-	return dpaConfiguration, defaultSyncPeriod, nil
+	return dpaConfiguration, veleroConfiguration, nil
+}
+
+// getControllerUsername returns the username the controller authenticates with, so the requester webhooks
+// keep the requester annotations of the objects it creates on behalf of a requester
+func getControllerUsername(restConfig *rest.Config) (string, error) {
+	reviewClientScheme := runtime.NewScheme()
+	utilruntime.Must(authenticationv1.AddToScheme(reviewClientScheme))
+	reviewClient, err := client.New(restConfig, client.Options{
+		Scheme: reviewClientScheme,
+	})
+	if err != nil {
+		return constant.EmptyString, err
+	}
+	review := &authenticationv1.SelfSubjectReview{}
+	if err = reviewClient.Create(context.Background(), review); err != nil {
+		return constant.EmptyString, err
+	}
+	return review.Status.UserInfo.Username, nil
 }
 
 func translateLogrusToZapLevel(level logrus.Level) (logLevel zapcore.Level, logLevelEnvInvalid bool) {
@@ -347,6 +945,16 @@ func translateLogrusToZapLevel(level logrus.Level) (logLevel zapcore.Level, logL
 	return logLevel, logLevelEnvInvalid
 }
 
+func splitCommaSeparatedList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, constant.CommaString) {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func encoderForFormat(format string) zapcore.Encoder {
 	switch format {
 	case "json":
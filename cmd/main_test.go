@@ -86,3 +86,36 @@ func TestEncoderForFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitCommaSeparatedList(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "empty",
+			input: "",
+			want:  nil,
+		},
+		{
+			name:  "single item",
+			input: "routes",
+			want:  []string{"routes"},
+		},
+		{
+			name:  "multiple items with spaces and empty entries",
+			input: " routes, ,builds.build.openshift.io ,",
+			want:  []string{"routes", "builds.build.openshift.io"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitCommaSeparatedList(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitCommaSeparatedList(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approval contains the engine of the NonAdminApprovalRequests, which guard the
+// operations of non admin objects that need the sign-off of the cluster admin. The approval of
+// NonAdminBackupStorageLocations and NonAdminVolumeSnapshotLocations is not part of it, they keep
+// their own requests.
+package approval
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
+)
+
+// Request describes the guarded operation a non admin object asks approval for
+type Request struct {
+	// Origin identifies the non admin object requesting the operation
+	Origin nacmeta.Origin
+	// Operation is the guarded operation
+	Operation nacv1alpha1.NonAdminApprovalOperation
+	// Details describes what is requested, a change of the details requires a new approval
+	Details string
+}
+
+// Decision is the state of the approval of a Request
+type Decision struct {
+	// Name of the NonAdminApprovalRequest
+	Name string
+	// Reason is the explanation of the cluster admin for the approval decision
+	Reason string
+	// Phase of the NonAdminApprovalRequest
+	Phase nacv1alpha1.NonAdminApprovalRequestPhase
+}
+
+// Engine creates the NonAdminApprovalRequests of guarded operations and reads their decision
+type Engine struct {
+	Client        client.Client
+	OADPNamespace string
+	// Expiration is how long a NonAdminApprovalRequest may stay pending, zero never expires them
+	Expiration time.Duration
+}
+
+// RequestName returns the name of the NonAdminApprovalRequest of the operation requested by the
+// non admin object with the given NACUUID
+func RequestName(operation nacv1alpha1.NonAdminApprovalOperation, nacUUID string) string {
+	return string(operation) + "-" + nacUUID
+}
+
+// Phase returns the phase of the NonAdminApprovalRequest at the given time.
+// An expired NonAdminApprovalRequest stays expired, even if a decision is set afterwards.
+func Phase(approvalRequest *nacv1alpha1.NonAdminApprovalRequest, now time.Time) nacv1alpha1.NonAdminApprovalRequestPhase {
+	if approvalRequest.Status.Phase == nacv1alpha1.NonAdminApprovalRequestPhaseExpired {
+		return nacv1alpha1.NonAdminApprovalRequestPhaseExpired
+	}
+	switch approvalRequest.Spec.ApprovalDecision {
+	case nacv1alpha1.NonAdminApprovalDecisionApprove:
+		return nacv1alpha1.NonAdminApprovalRequestPhaseApproved
+	case nacv1alpha1.NonAdminApprovalDecisionReject:
+		return nacv1alpha1.NonAdminApprovalRequestPhaseRejected
+	}
+	if expirationTime := approvalRequest.Status.ExpirationTime; expirationTime != nil && !now.Before(expirationTime.Time) {
+		return nacv1alpha1.NonAdminApprovalRequestPhaseExpired
+	}
+	return nacv1alpha1.NonAdminApprovalRequestPhasePending
+}
+
+// ExpiresAfter returns how long the pending NonAdminApprovalRequest has left before expiring,
+// zero if it is not pending or does not expire
+func ExpiresAfter(approvalRequest *nacv1alpha1.NonAdminApprovalRequest, now time.Time) time.Duration {
+	if approvalRequest.Status.ExpirationTime == nil ||
+		Phase(approvalRequest, now) != nacv1alpha1.NonAdminApprovalRequestPhasePending {
+		return 0
+	}
+	return approvalRequest.Status.ExpirationTime.Sub(now)
+}
+
+// Request creates the NonAdminApprovalRequest of request, if it does not exist, and returns its decision.
+// A NonAdminApprovalRequest whose details differ from the ones of request goes back to pending.
+// A nil Engine approves every request.
+func (e *Engine) Request(ctx context.Context, request Request) (Decision, error) {
+	name := RequestName(request.Operation, request.Origin.NACUUID)
+	if e == nil {
+		return Decision{Name: name, Phase: nacv1alpha1.NonAdminApprovalRequestPhaseApproved}, nil
+	}
+
+	now := time.Now()
+	approvalRequest := &nacv1alpha1.NonAdminApprovalRequest{}
+	err := e.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: e.OADPNamespace}, approvalRequest)
+	switch {
+	case apierrors.IsNotFound(err):
+		approvalRequest = &nacv1alpha1.NonAdminApprovalRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: e.OADPNamespace,
+			},
+			Spec: nacv1alpha1.NonAdminApprovalRequestSpec{
+				ApprovalDecision: nacv1alpha1.NonAdminApprovalDecisionPending,
+			},
+		}
+		if err = nacmeta.SetOrigin(approvalRequest, request.Origin); err != nil {
+			return Decision{}, err
+		}
+		if err = e.Client.Create(ctx, approvalRequest); err != nil {
+			return Decision{}, err
+		}
+		return e.resetStatus(ctx, approvalRequest, request, now)
+	case err != nil:
+		return Decision{}, err
+	}
+
+	if approvalRequest.Status.Operation != request.Operation || approvalRequest.Status.Details != request.Details {
+		// the previous decision was taken for other details
+		if approvalRequest.Spec.ApprovalDecision != nacv1alpha1.NonAdminApprovalDecisionPending {
+			approvalRequest.Spec.ApprovalDecision = nacv1alpha1.NonAdminApprovalDecisionPending
+			approvalRequest.Spec.Reason = ""
+			if err = e.Client.Update(ctx, approvalRequest); err != nil {
+				return Decision{}, err
+			}
+		}
+		return e.resetStatus(ctx, approvalRequest, request, now)
+	}
+
+	if phase := Phase(approvalRequest, now); phase != approvalRequest.Status.Phase {
+		approvalRequest.Status.Phase = phase
+		if err = e.Client.Status().Update(ctx, approvalRequest); err != nil {
+			return Decision{}, err
+		}
+	}
+	return Decision{Name: name, Reason: approvalRequest.Spec.Reason, Phase: approvalRequest.Status.Phase}, nil
+}
+
+// Delete deletes the NonAdminApprovalRequest of the operation requested by the non admin object
+// with the given NACUUID, if it exists
+func (e *Engine) Delete(ctx context.Context, operation nacv1alpha1.NonAdminApprovalOperation, nacUUID string) error {
+	if e == nil {
+		return nil
+	}
+	approvalRequest := &nacv1alpha1.NonAdminApprovalRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RequestName(operation, nacUUID),
+			Namespace: e.OADPNamespace,
+		},
+	}
+	return client.IgnoreNotFound(e.Client.Delete(ctx, approvalRequest))
+}
+
+// resetStatus sets the NonAdminApprovalRequest status of a new pending request
+func (e *Engine) resetStatus(ctx context.Context, approvalRequest *nacv1alpha1.NonAdminApprovalRequest, request Request, now time.Time) (Decision, error) {
+	approvalRequest.Status = nacv1alpha1.NonAdminApprovalRequestStatus{
+		SourceObject: &nacv1alpha1.ApprovalSourceObject{
+			Kind:      string(request.Origin.Kind),
+			NACUUID:   request.Origin.NACUUID,
+			Name:      request.Origin.Name,
+			Namespace: request.Origin.Namespace,
+		},
+		Operation: request.Operation,
+		Details:   request.Details,
+		Phase:     nacv1alpha1.NonAdminApprovalRequestPhasePending,
+	}
+	if e.Expiration > 0 {
+		approvalRequest.Status.ExpirationTime = &metav1.Time{Time: now.Add(e.Expiration)}
+	}
+	if err := e.Client.Status().Update(ctx, approvalRequest); err != nil {
+		return Decision{}, err
+	}
+	return Decision{Name: approvalRequest.Name, Phase: nacv1alpha1.NonAdminApprovalRequestPhasePending}, nil
+}
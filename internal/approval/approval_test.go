@@ -0,0 +1,148 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
+)
+
+func TestPhase(t *testing.T) {
+	now := time.Now()
+	expired := &metav1.Time{Time: now.Add(-time.Minute)}
+	notExpired := &metav1.Time{Time: now.Add(time.Minute)}
+	tests := []struct {
+		expirationTime *metav1.Time
+		name           string
+		decision       nacv1alpha1.NonAdminApprovalDecision
+		currentPhase   nacv1alpha1.NonAdminApprovalRequestPhase
+		expectedPhase  nacv1alpha1.NonAdminApprovalRequestPhase
+	}{
+		{
+			name:          "pending",
+			decision:      nacv1alpha1.NonAdminApprovalDecisionPending,
+			expectedPhase: nacv1alpha1.NonAdminApprovalRequestPhasePending,
+		},
+		{
+			name:           "pending before expiration",
+			expirationTime: notExpired,
+			expectedPhase:  nacv1alpha1.NonAdminApprovalRequestPhasePending,
+		},
+		{
+			name:           "pending after expiration",
+			decision:       nacv1alpha1.NonAdminApprovalDecisionPending,
+			expirationTime: expired,
+			expectedPhase:  nacv1alpha1.NonAdminApprovalRequestPhaseExpired,
+		},
+		{
+			name:           "approved after expiration time",
+			decision:       nacv1alpha1.NonAdminApprovalDecisionApprove,
+			expirationTime: expired,
+			expectedPhase:  nacv1alpha1.NonAdminApprovalRequestPhaseApproved,
+		},
+		{
+			name:          "approved once expired",
+			decision:      nacv1alpha1.NonAdminApprovalDecisionApprove,
+			currentPhase:  nacv1alpha1.NonAdminApprovalRequestPhaseExpired,
+			expectedPhase: nacv1alpha1.NonAdminApprovalRequestPhaseExpired,
+		},
+		{
+			name:          "rejected",
+			decision:      nacv1alpha1.NonAdminApprovalDecisionReject,
+			currentPhase:  nacv1alpha1.NonAdminApprovalRequestPhaseApproved,
+			expectedPhase: nacv1alpha1.NonAdminApprovalRequestPhaseRejected,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			approvalRequest := &nacv1alpha1.NonAdminApprovalRequest{
+				Spec: nacv1alpha1.NonAdminApprovalRequestSpec{ApprovalDecision: test.decision},
+				Status: nacv1alpha1.NonAdminApprovalRequestStatus{
+					ExpirationTime: test.expirationTime,
+					Phase:          test.currentPhase,
+				},
+			}
+			assert.Equal(t, test.expectedPhase, Phase(approvalRequest, now))
+		})
+	}
+}
+
+func TestEngineRequest(t *testing.T) {
+	ctx := context.Background()
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).
+		WithStatusSubresource(&nacv1alpha1.NonAdminApprovalRequest{}).Build()
+	engine := &Engine{Client: fakeClient, OADPNamespace: "openshift-adp", Expiration: time.Hour}
+	request := Request{
+		Origin:    nacmeta.Origin{Kind: nacmeta.KindNonAdminBackup, NACUUID: "tenant-nightly-uuid", Namespace: "tenant", Name: "nightly"},
+		Operation: nacv1alpha1.NonAdminApprovalOperationMultiNamespaceBackup,
+		Details:   "includedNamespaces: tenant,tenant-db",
+	}
+	key := types.NamespacedName{Name: "multi-namespace-backup-tenant-nightly-uuid", Namespace: "openshift-adp"}
+
+	decision, err := engine.Request(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, Decision{Name: key.Name, Phase: nacv1alpha1.NonAdminApprovalRequestPhasePending}, decision)
+
+	approvalRequest := &nacv1alpha1.NonAdminApprovalRequest{}
+	assert.NoError(t, fakeClient.Get(ctx, key, approvalRequest))
+	origin, err := nacmeta.GetOrigin(approvalRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, request.Origin, origin)
+	assert.Equal(t, request.Details, approvalRequest.Status.Details)
+	assert.NotNil(t, approvalRequest.Status.ExpirationTime)
+	assert.Greater(t, ExpiresAfter(approvalRequest, time.Now()), time.Duration(0))
+
+	approvalRequest.Spec.ApprovalDecision = nacv1alpha1.NonAdminApprovalDecisionApprove
+	approvalRequest.Spec.Reason = "database owned by the same team"
+	assert.NoError(t, fakeClient.Update(ctx, approvalRequest))
+	decision, err = engine.Request(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, nacv1alpha1.NonAdminApprovalRequestPhaseApproved, decision.Phase)
+	assert.Equal(t, "database owned by the same team", decision.Reason)
+
+	request.Details = "includedNamespaces: tenant,tenant-db,tenant-cache"
+	decision, err = engine.Request(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, nacv1alpha1.NonAdminApprovalRequestPhasePending, decision.Phase)
+	assert.NoError(t, fakeClient.Get(ctx, key, approvalRequest))
+	assert.Equal(t, nacv1alpha1.NonAdminApprovalDecisionPending, approvalRequest.Spec.ApprovalDecision)
+	assert.Empty(t, approvalRequest.Spec.Reason)
+
+	assert.NoError(t, engine.Delete(ctx, request.Operation, request.Origin.NACUUID))
+	assert.True(t, apierrors.IsNotFound(fakeClient.Get(ctx, key, approvalRequest)))
+	assert.NoError(t, engine.Delete(ctx, request.Operation, request.Origin.NACUUID))
+
+	var nilEngine *Engine
+	decision, err = nilEngine.Request(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, nacv1alpha1.NonAdminApprovalRequestPhaseApproved, decision.Phase)
+}
@@ -20,35 +20,152 @@ package constant
 import (
 	"github.com/openshift/oadp-operator/api/v1alpha1"
 	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
 )
 
 // Common labels for objects manipulated by the Non Admin Controller
 // Labels should be used to identify the NAC object
 // Annotations on the other hand should be used to define ownership
 // of the specific Object, such as Backup/Restore.
+// Labels and annotations read by external tools are defined in the public nacmeta package.
 const (
-	OadpLabel               = v1alpha1.OadpOperatorLabel
-	OadpLabelValue          = TrueString
-	ManagedByLabel          = "app.kubernetes.io/managed-by"
-	ManagedByLabelValue     = "oadp-nac-controller" // TODO why not use same project name as in PROJECT file?
-	NabOriginNACUUIDLabel   = v1alpha1.OadpOperatorLabel + "-nab-origin-nacuuid"
-	NarOriginNACUUIDLabel   = v1alpha1.OadpOperatorLabel + "-nar-origin-nacuuid"
-	NabslOriginNACUUIDLabel = v1alpha1.OadpOperatorLabel + "-nabsl-origin-nacuuid"
-	NadrOriginNACUUIDLabel  = v1alpha1.OadpOperatorLabel + "-nadr-origin-nacuuid"
+	OadpLabel               = nacmeta.OadpLabel
+	OadpLabelValue          = nacmeta.OadpLabelValue
+	ManagedByLabel          = nacmeta.ManagedByLabel
+	ManagedByLabelValue     = nacmeta.ManagedByLabelValue // TODO why not use same project name as in PROJECT file?
+	NabOriginNACUUIDLabel   = nacmeta.NabOriginNACUUIDLabel
+	NarOriginNACUUIDLabel   = nacmeta.NarOriginNACUUIDLabel
+	NabslOriginNACUUIDLabel = nacmeta.NabslOriginNACUUIDLabel
+	NadrOriginNACUUIDLabel  = nacmeta.NadrOriginNACUUIDLabel
+	NasOriginNACUUIDLabel   = nacmeta.NasOriginNACUUIDLabel
+	NassrOriginNACUUIDLabel = nacmeta.NassrOriginNACUUIDLabel
+	NavslOriginNACUUIDLabel = nacmeta.NavslOriginNACUUIDLabel
+	NadptOriginNACUUIDLabel = nacmeta.NadptOriginNACUUIDLabel
+	NabvOriginNACUUIDLabel  = nacmeta.NabvOriginNACUUIDLabel
+	NagbOriginNACUUIDLabel  = nacmeta.NagbOriginNACUUIDLabel
 	NabSyncLabel            = v1alpha1.OadpOperatorLabel + "-nab-synced-from-nacuuid"
+	NabtCanaryLabel         = v1alpha1.OadpOperatorLabel + "-nabt-canary"
+	// NabScheduleNameLabel is set by NAC on the NonAdminBackups it creates for the Velero Backups of a
+	// NonAdminSchedule, with the name of the NonAdminSchedule
+	NabScheduleNameLabel = v1alpha1.OadpOperatorLabel + "-nab-nas-name"
+	// SharedWithNamespaceLabel is set by the admin user on a Velero Backup not created by NAC, with the
+	// namespace whose NonAdminRestores may restore it
+	SharedWithNamespaceLabel = v1alpha1.OadpOperatorLabel + "-nac-shared-with-namespace"
+	// EnforcedRestoreSpecOverrideLabel is set by the admin user on a ConfigMap, in the OADP namespace, with the name
+	// of the namespace whose NonAdminRestores get the restore spec of the ConfigMap enforced, instead of the DPA one
+	EnforcedRestoreSpecOverrideLabel = v1alpha1.OadpOperatorLabel + "-nac-enforced-restore-spec-override"
+	// RestoreVerificationNamespaceLabel is set by NAC on the temporary namespace it restores a NonAdminBackup with
+	// spec.verifyRestore into, with the namespace of the NonAdminBackup
+	RestoreVerificationNamespaceLabel = v1alpha1.OadpOperatorLabel + "-nac-restore-verification-for"
 
-	NabOriginNameAnnotation        = v1alpha1.OadpOperatorLabel + "-nab-origin-name"
-	NabOriginNamespaceAnnotation   = v1alpha1.OadpOperatorLabel + "-nab-origin-namespace"
-	NarOriginNameAnnotation        = v1alpha1.OadpOperatorLabel + "-nar-origin-name"
-	NarOriginNamespaceAnnotation   = v1alpha1.OadpOperatorLabel + "-nar-origin-namespace"
-	NabslOriginNameAnnotation      = v1alpha1.OadpOperatorLabel + "-nabsl-origin-name"
-	NabslOriginNamespaceAnnotation = v1alpha1.OadpOperatorLabel + "-nabsl-origin-namespace"
-	NadrOriginNameAnnotation       = v1alpha1.OadpOperatorLabel + "-nadr-origin-name"
-	NadrOriginNamespaceAnnotation  = v1alpha1.OadpOperatorLabel + "-nadr-origin-namespace"
+	NabOriginNameAnnotation        = nacmeta.NabOriginNameAnnotation
+	NabOriginNamespaceAnnotation   = nacmeta.NabOriginNamespaceAnnotation
+	NarOriginNameAnnotation        = nacmeta.NarOriginNameAnnotation
+	NarOriginNamespaceAnnotation   = nacmeta.NarOriginNamespaceAnnotation
+	NabslOriginNameAnnotation      = nacmeta.NabslOriginNameAnnotation
+	NabslOriginNamespaceAnnotation = nacmeta.NabslOriginNamespaceAnnotation
+	NadrOriginNameAnnotation       = nacmeta.NadrOriginNameAnnotation
+	NadrOriginNamespaceAnnotation  = nacmeta.NadrOriginNamespaceAnnotation
+	NasOriginNameAnnotation        = nacmeta.NasOriginNameAnnotation
+	NasOriginNamespaceAnnotation   = nacmeta.NasOriginNamespaceAnnotation
+	NassrOriginNameAnnotation      = nacmeta.NassrOriginNameAnnotation
+	NassrOriginNamespaceAnnotation = nacmeta.NassrOriginNamespaceAnnotation
+	NavslOriginNameAnnotation      = nacmeta.NavslOriginNameAnnotation
+	NavslOriginNamespaceAnnotation = nacmeta.NavslOriginNamespaceAnnotation
+	NadptOriginNameAnnotation      = nacmeta.NadptOriginNameAnnotation
+	NadptOriginNamespaceAnnotation = nacmeta.NadptOriginNamespaceAnnotation
+	NabvOriginNameAnnotation       = nacmeta.NabvOriginNameAnnotation
+	NabvOriginNamespaceAnnotation  = nacmeta.NabvOriginNamespaceAnnotation
+	NagbOriginNameAnnotation       = nacmeta.NagbOriginNameAnnotation
+	NagbOriginNamespaceAnnotation  = nacmeta.NagbOriginNamespaceAnnotation
+	NabRequesterUsernameAnnotation = v1alpha1.OadpOperatorLabel + "-nab-requester-username"
+	NabRequesterUIDAnnotation      = v1alpha1.OadpOperatorLabel + "-nab-requester-uid"
+	NabRequesterGroupsAnnotation   = v1alpha1.OadpOperatorLabel + "-nab-requester-groups"
+	NabRequesterExtraAnnotation    = v1alpha1.OadpOperatorLabel + "-nab-requester-extra"
+	NarRequesterUsernameAnnotation = v1alpha1.OadpOperatorLabel + "-nar-requester-username"
+	NarRequesterUIDAnnotation      = v1alpha1.OadpOperatorLabel + "-nar-requester-uid"
+	NarRequesterGroupsAnnotation   = v1alpha1.OadpOperatorLabel + "-nar-requester-groups"
+	NarRequesterExtraAnnotation    = v1alpha1.OadpOperatorLabel + "-nar-requester-extra"
+	// NagbRequester annotations record the user creating a NonAdminGroupBackup, set by its admission webhook
+	NagbRequesterUsernameAnnotation = v1alpha1.OadpOperatorLabel + "-nagb-requester-username"
+	NagbRequesterUIDAnnotation      = v1alpha1.OadpOperatorLabel + "-nagb-requester-uid"
+	NagbRequesterGroupsAnnotation   = v1alpha1.OadpOperatorLabel + "-nagb-requester-groups"
+	NagbRequesterExtraAnnotation    = v1alpha1.OadpOperatorLabel + "-nagb-requester-extra"
+	// NadbrRequester annotations record the user creating a NonAdminDeleteBackupRequest, set by its admission webhook
+	NadbrRequesterUsernameAnnotation = v1alpha1.OadpOperatorLabel + "-nadbr-requester-username"
+	NadbrRequesterUIDAnnotation      = v1alpha1.OadpOperatorLabel + "-nadbr-requester-uid"
+	NadbrRequesterGroupsAnnotation   = v1alpha1.OadpOperatorLabel + "-nadbr-requester-groups"
+	NadbrRequesterExtraAnnotation    = v1alpha1.OadpOperatorLabel + "-nadbr-requester-extra"
+	// NarpRequester annotations record the user creating a NonAdminRetentionPolicy, set by its admission webhook
+	NarpRequesterUsernameAnnotation = v1alpha1.OadpOperatorLabel + "-narp-requester-username"
+	NarpRequesterUIDAnnotation      = v1alpha1.OadpOperatorLabel + "-narp-requester-uid"
+	NarpRequesterGroupsAnnotation   = v1alpha1.OadpOperatorLabel + "-narp-requester-groups"
+	NarpRequesterExtraAnnotation    = v1alpha1.OadpOperatorLabel + "-narp-requester-extra"
+	// NabvRequester annotations record the user creating a NonAdminBackupVerification, set by its admission webhook
+	NabvRequesterUsernameAnnotation = v1alpha1.OadpOperatorLabel + "-nabv-requester-username"
+	NabvRequesterUIDAnnotation      = v1alpha1.OadpOperatorLabel + "-nabv-requester-uid"
+	NabvRequesterGroupsAnnotation   = v1alpha1.OadpOperatorLabel + "-nabv-requester-groups"
+	NabvRequesterExtraAnnotation    = v1alpha1.OadpOperatorLabel + "-nabv-requester-extra"
+	// Nab, Nar, Nagb, Narp and Nabv webhook names are the names of the admission webhooks recording the requester annotations,
+	// which can only be trusted while the webhooks are configured in the cluster
+	NabMutatingWebhookName    = "mnonadminbackup.oadp.openshift.io"
+	NabValidatingWebhookName  = "vnonadminbackup.oadp.openshift.io"
+	NarMutatingWebhookName    = "mnonadminrestore.oadp.openshift.io"
+	NarValidatingWebhookName  = "vnonadminrestore.oadp.openshift.io"
+	NagbMutatingWebhookName   = "mnonadmingroupbackup.oadp.openshift.io"
+	NagbValidatingWebhookName = "vnonadmingroupbackup.oadp.openshift.io"
+	NarpMutatingWebhookName   = "mnonadminretentionpolicy.oadp.openshift.io"
+	NarpValidatingWebhookName = "vnonadminretentionpolicy.oadp.openshift.io"
+	NabvMutatingWebhookName   = "mnonadminbackupverification.oadp.openshift.io"
+	NabvValidatingWebhookName = "vnonadminbackupverification.oadp.openshift.io"
+	// NarpOriginNameAnnotation is set by NAC on the NonAdminDeleteBackupRequests of a NonAdminRetentionPolicy, to its name
+	NarpOriginNameAnnotation = v1alpha1.OadpOperatorLabel + "-narp-origin-name"
+	// NabslAutoApprovedAnnotation is set by NAC on the NonAdminBackupStorageLocationRequests it approved
+	// because they match an auto approval rule of the cluster admin
+	NabslAutoApprovedAnnotation = v1alpha1.OadpOperatorLabel + "-nabsl-auto-approved"
+	// NabslForceDeletionAnnotation is set by the admin user on a NonAdminBackupStorageLocationRequest to delete its
+	// NonAdminBackupStorageLocation even if running NonAdminBackups or NonAdminRestores use it
+	NabslForceDeletionAnnotation = v1alpha1.OadpOperatorLabel + "-nabsl-force-deletion"
+	// NabslTransferNamespaceAnnotation is set by the admin user on a NonAdminBackupStorageLocationRequest with the
+	// namespace its NonAdminBackupStorageLocation, and the NonAdminBackups using it, are moved to
+	NabslTransferNamespaceAnnotation = v1alpha1.OadpOperatorLabel + "-nabsl-transfer-namespace"
+	// NabslTransferredNACUUIDAnnotation is set by NAC on the NonAdminBackupStorageLocation it creates in the transfer
+	// namespace, with the NACUUID of the VeleroBackupStorageLocation it takes over
+	NabslTransferredNACUUIDAnnotation = v1alpha1.OadpOperatorLabel + "-nabsl-transferred-nacuuid"
+	// NabslPrefixNamespaceAnnotation is set by NAC on the NonAdminBackupStorageLocationRequest of a transferred
+	// NonAdminBackupStorageLocation, with the namespace its objectStorage prefix keeps being rendered for
+	NabslPrefixNamespaceAnnotation = v1alpha1.OadpOperatorLabel + "-nabsl-prefix-namespace"
+	// NabslLastAppliedSpecAnnotation is set by NAC on the VeleroBackupStorageLocation of a NonAdminBackupStorageLocation
+	// with the JSON spec it last applied, to detect the fields modified out-of-band
+	NabslLastAppliedSpecAnnotation = v1alpha1.OadpOperatorLabel + "-nabsl-last-applied-spec"
+	// NabSourceNamespaceAnnotation is set by NAC on the VeleroBackup of a NonAdminBackup transferred with its
+	// NonAdminBackupStorageLocation, with the namespace it backed up, which its NonAdminRestores restore
+	NabSourceNamespaceAnnotation = v1alpha1.OadpOperatorLabel + "-nab-source-namespace"
+	// NabslApprovalRevokedReason is the ClusterAdminApproved condition reason of a NonAdminBackupStorageLocation
+	// whose approval was revoked by the cluster admin
+	NabslApprovalRevokedReason = "BslSpecRevoked"
+	// SharedSourceNamespaceAnnotation is set by the admin user on a shared Velero Backup with the backed up
+	// namespace restored into the namespace it is shared with, which it defaults to
+	SharedSourceNamespaceAnnotation = v1alpha1.OadpOperatorLabel + "-nac-shared-source-namespace"
+	// EnforcedExistingResourcePolicyAnnotation is set by the admin user on a namespace to override the
+	// enforced spec.restoreSpec.existingResourcePolicy of its NonAdminRestores
+	EnforcedExistingResourcePolicyAnnotation = v1alpha1.OadpOperatorLabel + "-nac-enforced-existing-resource-policy"
+	// RestoreDeniedResourcesAnnotation is set by the admin user on a namespace with a comma separated list of the
+	// resources NonAdminRestores may not restore into it, in addition to the ones denied in every namespace
+	RestoreDeniedResourcesAnnotation = v1alpha1.OadpOperatorLabel + "-nac-restore-denied-resources"
+	// RestorePriorityAnnotation is set by the admin user on a namespace with the integer priority of its NonAdminRestores.
+	// Their Velero Restores are only created once the ones of the namespaces with a higher priority completed.
+	RestorePriorityAnnotation = v1alpha1.OadpOperatorLabel + "-nac-restore-priority"
 
 	NabFinalizerName   = "nonadminbackup.oadp.openshift.io/finalizer"
 	NarFinalizerName   = "nonadminrestore.oadp.openshift.io/finalizer"
 	NabslFinalizerName = "nonadminbackupstoragelocation.oadp.openshift.io/finalizer"
+	NasFinalizerName   = "nonadminschedule.oadp.openshift.io/finalizer"
+	NavslFinalizerName = "nonadminvolumesnapshotlocation.oadp.openshift.io/finalizer"
+	NadptFinalizerName = "nonadmindataprotectiontest.oadp.openshift.io/finalizer"
+	NabvFinalizerName  = "nonadminbackupverification.oadp.openshift.io/finalizer"
+	NagbFinalizerName  = "nonadmingroupbackup.oadp.openshift.io/finalizer"
 )
 
 // Common environment variables for the Non Admin Controller
@@ -97,12 +214,69 @@ const CommaString = ","
 // must be below 63 characters, because it's used within object Label Value
 const MaximumNacObjectNameLength = validation.DNS1123LabelMaxLength
 
+// Placeholders of the name template of Velero objects created by NAC
+const (
+	NameTemplateNamespace = "{namespace}"
+	NameTemplateName      = "{name}"
+	NameTemplateUUID      = "{uuid}"
+	NameTemplateShortUUID = "{shortuuid}"
+)
+
+// UUIDStringLength is the length of the string form of an UUID, which ends every generated NACUUID
+const UUIDStringLength = 36
+
+// ShortUUIDLength is the number of leading UUID characters used by the NameTemplateShortUUID placeholder
+const ShortUUIDLength = 8
+
+// Credential file of the VeleroBackupStorageLocations of NonAdminBackupStorageLocations using a cloud identity
+const (
+	// CloudIdentityCredentialKey is the key of the credential file in the VeleroBackupStorageLocation Secret
+	CloudIdentityCredentialKey = "cloud"
+	// CloudIdentityWebIdentityTokenFile is the projected service account token of Velero, exchanged with AWS STS
+	CloudIdentityWebIdentityTokenFile = "/var/run/secrets/openshift/serviceaccount/token"
+	// CloudIdentityDefaultAzureCloudName is the Azure cloud of the cloud identities not setting it
+	CloudIdentityDefaultAzureCloudName = "AzurePublicCloud"
+)
+
+// ResourcePolicyConfigMapKind is the kind of the Velero resource policy references, the only one supported by Velero
+const ResourcePolicyConfigMapKind = "configmap"
+
+// ResourceModifierConfigMapKind is the kind of the Velero resource modifier references, the only one supported by Velero
+const ResourceModifierConfigMapKind = "configmap"
+
 // NABRestrictedErr holds an error message template for a non-admin backup operation that is restricted.
 const NABRestrictedErr = "NonAdminBackup %s is restricted"
 
+// NASRestrictedErr holds an error message template for a non-admin schedule operation that is restricted.
+const NASRestrictedErr = "NonAdminSchedule %s is restricted"
+
 // NARRestrictedErr holds an error message template for a non-admin restore operation that is restricted.
 const NARRestrictedErr = "NonAdminRestore %s is restricted"
 
+// NABVRestrictedErr holds an error message template for a non-admin backup verification operation that is restricted.
+const NABVRestrictedErr = "NonAdminBackupVerification %s is restricted"
+
+// NANRestrictedErr holds an error message template for a non-admin notification operation that is restricted.
+const NANRestrictedErr = "NonAdminNotification %s is restricted"
+
+// NAGBRestrictedErr holds an error message template for a non-admin group backup operation that is restricted.
+const NAGBRestrictedErr = "NonAdminGroupBackup %s is restricted"
+
+// NARPRestrictedErr holds an error message template for a non-admin retention policy operation that is restricted.
+const NARPRestrictedErr = "NonAdminRetentionPolicy %s is restricted"
+
+// NonAdminQuotaStatusName is the name of the NonAdminQuotaStatus NAC creates in each namespace a NonAdminPolicy applies to
+const NonAdminQuotaStatusName = "quota"
+
+// NonAdminControllerConfigName is the name of the NonAdminControllerConfig read by NAC, the other ones are ignored
+const NonAdminControllerConfigName = "cluster"
+
+// Policies of the namespace quota check done before creating a Velero Restore
+const (
+	RestoreQuotaCheckWarn = "Warn"
+	RestoreQuotaCheckFail = "Fail"
+)
+
 // Magic numbers
 const (
 	Base10 = 10
@@ -18,10 +18,17 @@ limitations under the License.
 package function
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"path"
 	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,17 +36,31 @@ import (
 	"github.com/google/uuid"
 	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
 )
 
 // Common labels for objects manipulated by the Non Admin Controller
@@ -55,6 +76,20 @@ func GetNonAdminLabels() map[string]string {
 	}
 }
 
+// CopyAllowedKeys copies the labels or annotations from source whose key is in allowedKeys
+// to destination. Keys already present in destination are not overwritten.
+func CopyAllowedKeys(destination, source map[string]string, allowedKeys []string) {
+	for _, allowedKey := range allowedKeys {
+		value, ok := source[allowedKey]
+		if !ok {
+			continue
+		}
+		if _, exists := destination[allowedKey]; !exists {
+			destination[allowedKey] = value
+		}
+	}
+}
+
 // GetNonAdminRestoreLabels return the required Non Admin restore labels
 func GetNonAdminRestoreLabels(uniqueIdentifier string) map[string]string {
 	nonAdminLabels := GetNonAdminLabels()
@@ -67,6 +102,7 @@ func GetNonAdminBackupAnnotations(objectMeta metav1.ObjectMeta) map[string]strin
 	return map[string]string{
 		constant.NabOriginNamespaceAnnotation: objectMeta.Namespace,
 		constant.NabOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:       nacmeta.SchemaVersion,
 	}
 }
 
@@ -75,6 +111,7 @@ func GetNonAdminRestoreAnnotations(objectMeta metav1.ObjectMeta) map[string]stri
 	return map[string]string{
 		constant.NarOriginNamespaceAnnotation: objectMeta.Namespace,
 		constant.NarOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:       nacmeta.SchemaVersion,
 	}
 }
 
@@ -83,6 +120,16 @@ func GetNonAdminBackupStorageLocationAnnotations(objectMeta metav1.ObjectMeta) m
 	return map[string]string{
 		constant.NabslOriginNamespaceAnnotation: objectMeta.Namespace,
 		constant.NabslOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:         nacmeta.SchemaVersion,
+	}
+}
+
+// GetNonAdminVolumeSnapshotLocationAnnotations return the required Non Admin annotations
+func GetNonAdminVolumeSnapshotLocationAnnotations(objectMeta metav1.ObjectMeta) map[string]string {
+	return map[string]string{
+		constant.NavslOriginNamespaceAnnotation: objectMeta.Namespace,
+		constant.NavslOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:         nacmeta.SchemaVersion,
 	}
 }
 
@@ -91,6 +138,43 @@ func GetNonAdminDownloadRequestAnnotations(objectMeta *nacv1alpha1.NonAdminDownl
 	return map[string]string{
 		constant.NadrOriginNamespaceAnnotation: objectMeta.Namespace,
 		constant.NadrOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:        nacmeta.SchemaVersion,
+	}
+}
+
+// GetNonAdminServerStatusRequestAnnotations return the required Non Admin annotations
+func GetNonAdminServerStatusRequestAnnotations(objectMeta *nacv1alpha1.NonAdminServerStatusRequest) map[string]string {
+	return map[string]string{
+		constant.NassrOriginNamespaceAnnotation: objectMeta.Namespace,
+		constant.NassrOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:         nacmeta.SchemaVersion,
+	}
+}
+
+// GetNonAdminDataProtectionTestAnnotations return the required Non Admin annotations
+func GetNonAdminDataProtectionTestAnnotations(objectMeta metav1.ObjectMeta) map[string]string {
+	return map[string]string{
+		constant.NadptOriginNamespaceAnnotation: objectMeta.Namespace,
+		constant.NadptOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:         nacmeta.SchemaVersion,
+	}
+}
+
+// GetNonAdminBackupVerificationAnnotations return the required Non Admin annotations
+func GetNonAdminBackupVerificationAnnotations(objectMeta metav1.ObjectMeta) map[string]string {
+	return map[string]string{
+		constant.NabvOriginNamespaceAnnotation: objectMeta.Namespace,
+		constant.NabvOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:        nacmeta.SchemaVersion,
+	}
+}
+
+// GetNonAdminScheduleAnnotations return the required Non Admin schedule annotations
+func GetNonAdminScheduleAnnotations(objectMeta metav1.ObjectMeta) map[string]string {
+	return map[string]string{
+		constant.NasOriginNamespaceAnnotation: objectMeta.Namespace,
+		constant.NasOriginNameAnnotation:      objectMeta.Name,
+		nacmeta.SchemaVersionAnnotation:       nacmeta.SchemaVersion,
 	}
 }
 
@@ -104,11 +188,311 @@ func containsOnlyNamespace(namespaces []string, namespace string) bool {
 	return true
 }
 
-// ValidateBackupSpec return nil, if NonAdminBackup is valid; error otherwise
-func ValidateBackupSpec(ctx context.Context, clientInstance client.Client, oadpNamespace string, nonAdminBackup *nacv1alpha1.NonAdminBackup, enforcedBackupSpec *velerov1.BackupSpec) error {
+// validateIncludesExcludes returns nil, if the include and exclude lists of a resource filter are valid; error otherwise.
+// It follows the Velero validation, so invalid filters are reported before the VeleroBackup is created.
+// Scoped filters (includedNamespaceScopedResources and alike) may exclude '*' alone
+func validateIncludesExcludes(includesField string, includes []string, excludesField string, excludes []string, scoped bool) error {
+	if len(includes) > 1 && slices.Contains(includes, "*") {
+		return fmt.Errorf("NonAdminBackup spec.backupSpec.%s must either contain '*' only, or a list of resources", includesField)
+	}
+	if slices.Contains(excludes, "*") {
+		if !scoped {
+			return fmt.Errorf("NonAdminBackup spec.backupSpec.%s can not contain '*'", excludesField)
+		}
+		if len(excludes) > 1 {
+			return fmt.Errorf("NonAdminBackup spec.backupSpec.%s must either contain '*' only, or a list of resources", excludesField)
+		}
+		if len(includes) > 0 {
+			return fmt.Errorf("NonAdminBackup spec.backupSpec.%s must be empty when spec.backupSpec.%s is '*'", includesField, excludesField)
+		}
+	}
+	for _, item := range append(slices.Clone(includes), excludes...) {
+		if item == "*" {
+			continue
+		}
+		if err := validateResourceFilterItem(item); err != nil {
+			return fmt.Errorf("NonAdminBackup spec.backupSpec resource filter %q is invalid: %v", item, err)
+		}
+	}
+	for _, item := range excludes {
+		if slices.Contains(includes, item) {
+			return fmt.Errorf("NonAdminBackup spec.backupSpec.%s can not contain %q, which is in spec.backupSpec.%s", excludesField, item, includesField)
+		}
+	}
+	return nil
+}
+
+// validateResourceFilterItem returns nil, if item is a resource, resource short name
+// or resource.group; error otherwise
+func validateResourceFilterItem(item string) error {
+	if strings.Contains(item, "*") {
+		return errors.New("wildcard is only supported as the whole filter")
+	}
+	groupResource := schema.ParseGroupResource(item)
+	if errs := validation.IsDNS1123Label(groupResource.Resource); len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	if groupResource.Group != constant.EmptyString {
+		if errs := validation.IsDNS1123Subdomain(groupResource.Group); len(errs) > 0 {
+			return errors.New(strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// validateResourcePolicy returns nil, if the resource policy ConfigMap referenced by the NonAdminBackup exists in its namespace; error otherwise
+func validateResourcePolicy(ctx context.Context, clientInstance client.Client, namespace string, resourcePolicy *corev1.TypedLocalObjectReference) error {
+	if !strings.EqualFold(resourcePolicy.Kind, constant.ResourcePolicyConfigMapKind) {
+		return fmt.Errorf(constant.NABRestrictedErr+", kind must be %s", "spec.backupSpec.resourcePolicy", constant.ResourcePolicyConfigMapKind)
+	}
+	err := clientInstance.Get(ctx, types.NamespacedName{Name: resourcePolicy.Name, Namespace: namespace}, &corev1.ConfigMap{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("NonAdminBackup spec.backupSpec.resourcePolicy ConfigMap %s not found in the namespace", resourcePolicy.Name)
+	} else if err != nil {
+		return fmt.Errorf("NonAdminBackup spec.backupSpec.resourcePolicy is invalid: %v", err)
+	}
+	return nil
+}
+
+// ValidateBackupExecHooks returns nil, if the exec hooks of the NonAdminBackup are allowed by the
+// administrator; error otherwise. Exec hooks run commands in the backed up pods, the administrator may
+// disable them or restrict the executables they run. An empty allowedCommands allows any executable.
+func ValidateBackupExecHooks(backupSpec *velerov1.BackupSpec, disableExecHooks bool, allowedCommands []string) error {
+	for resourceIndex, resourceHookSpec := range backupSpec.Hooks.Resources {
+		for _, hooks := range []struct {
+			hookType      string
+			resourceHooks []velerov1.BackupResourceHook
+		}{
+			{hookType: "pre", resourceHooks: resourceHookSpec.PreHooks},
+			{hookType: "post", resourceHooks: resourceHookSpec.PostHooks},
+		} {
+			for hookIndex, resourceHook := range hooks.resourceHooks {
+				if resourceHook.Exec == nil {
+					continue
+				}
+				if restriction := execHookRestriction(resourceHook.Exec.Command, disableExecHooks, allowedCommands); restriction != constant.EmptyString {
+					field := fmt.Sprintf("spec.backupSpec.hooks.resources[%d].%s[%d].exec", resourceIndex, hooks.hookType, hookIndex)
+					return fmt.Errorf(constant.NABRestrictedErr+", %s", field, restriction)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// execHookRestriction returns why the administrator does not allow an exec hook running command, empty if it is allowed
+func execHookRestriction(command []string, disableExecHooks bool, allowedCommands []string) string {
+	if disableExecHooks {
+		return "exec hooks are disabled by the administrator"
+	}
+	if len(allowedCommands) > 0 && (len(command) == 0 || !slices.Contains(allowedCommands, command[0])) {
+		return "command must run one of: " + strings.Join(allowedCommands, constant.CommaString+" ")
+	}
+	return constant.EmptyString
+}
+
+// ValidateRestoreHooks returns nil, if the hooks of the NonAdminRestore are allowed by the administrator; error otherwise.
+// Exec hooks run commands in the restored pods and init hooks add init containers to them, the administrator may
+// disable both or restrict the executables exec hooks run and the images of the init containers. An empty
+// allowedCommands allows any executable, an empty allowedImages any image.
+func ValidateRestoreHooks(restoreSpec *velerov1.RestoreSpec, disableHooks bool, allowedCommands []string, allowedImages []string) error {
+	for resourceIndex, resourceHookSpec := range restoreSpec.Hooks.Resources {
+		for hookIndex, resourceHook := range resourceHookSpec.PostHooks {
+			field := fmt.Sprintf("nonAdminRestore.spec.restoreSpec.hooks.resources[%d].postHooks[%d]", resourceIndex, hookIndex)
+			if resourceHook.Exec != nil {
+				restriction := execHookRestriction(resourceHook.Exec.Command, disableHooks, allowedCommands)
+				if restriction != constant.EmptyString {
+					return fmt.Errorf(constant.NARRestrictedErr+", %s", field+".exec", restriction)
+				}
+			}
+			if resourceHook.Init == nil {
+				continue
+			}
+			for containerIndex, rawContainer := range resourceHook.Init.InitContainers {
+				initContainerField := fmt.Sprintf("%s.init.initContainers[%d]", field, containerIndex)
+				if disableHooks {
+					return fmt.Errorf(constant.NARRestrictedErr+", init hooks are disabled by the administrator", initContainerField)
+				}
+				initContainer := corev1.Container{}
+				if err := json.Unmarshal(rawContainer.Raw, &initContainer); err != nil {
+					return fmt.Errorf("NonAdminRestore %s is invalid: %v", initContainerField, err)
+				}
+				if len(allowedImages) > 0 && !slices.Contains(allowedImages, imageRepository(initContainer.Image)) {
+					return fmt.Errorf(constant.NARRestrictedErr+", image must be one of: %s", initContainerField, strings.Join(allowedImages, constant.CommaString+" "))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ErrResourceFilterRejected is wrapped by ValidateRestoreResourceFilters errors caused by resources the administrator
+// denies restoring into the NonAdminRestore target namespace
+var ErrResourceFilterRejected = errors.New("NonAdminRestore spec.restoreSpec.includedResources is rejected")
+
+// GetRestoreDeniedResources returns the resources NonAdminRestores may not restore into namespace, deniedResources,
+// denied in every namespace, and the ones listed in the namespace constant.RestoreDeniedResourcesAnnotation
+func GetRestoreDeniedResources(ctx context.Context, clientInstance client.Client, namespace string, deniedResources []string) ([]string, error) {
+	namespaceObject := &corev1.Namespace{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: namespace}, namespaceObject); err != nil {
+		if apierrors.IsNotFound(err) {
+			return deniedResources, nil
+		}
+		return nil, err
+	}
+	namespaceDeniedResources := slices.Clone(deniedResources)
+	for _, resource := range strings.Split(namespaceObject.Annotations[constant.RestoreDeniedResourcesAnnotation], constant.CommaString) {
+		if resource = strings.TrimSpace(resource); resource != constant.EmptyString && !slices.Contains(namespaceDeniedResources, resource) {
+			namespaceDeniedResources = append(namespaceDeniedResources, resource)
+		}
+	}
+	return namespaceDeniedResources, nil
+}
+
+// ValidateRestoreResourceFilters returns nil, if spec.restoreSpec.includedResources of the NonAdminRestore does not
+// include a resource denied in targetNamespace; error wrapping ErrResourceFilterRejected otherwise. The denied resources
+// are excluded from the Velero Restore, so restoring every resource is not rejected.
+func ValidateRestoreResourceFilters(restoreSpec *velerov1.RestoreSpec, deniedResources []string, targetNamespace string) error {
+	for index, filter := range restoreSpec.IncludedResources {
+		for _, deniedResource := range deniedResources {
+			if resourceFilterIncludes(filter, deniedResource) {
+				return fmt.Errorf("%w: nonAdminRestore.spec.restoreSpec.includedResources[%d] %q, %s can not be restored into namespace %s",
+					ErrResourceFilterRejected, index, filter, deniedResource, targetNamespace)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateRestoreVolumeSelector returns nil, if spec.volumeSelector of the NonAdminRestore is not set, or is a valid
+// label selector not set with the restore spec filters it replaces; error otherwise
+func ValidateRestoreVolumeSelector(nonAdminRestore *nacv1alpha1.NonAdminRestore) error {
+	volumeSelector := nonAdminRestore.Spec.VolumeSelector
+	if volumeSelector == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(volumeSelector); err != nil {
+		return fmt.Errorf("NonAdminRestore spec.volumeSelector is invalid: %v", err)
+	}
+	restoreSpec := nonAdminRestore.Spec.RestoreSpec
+	switch {
+	case len(restoreSpec.IncludedResources) > 0:
+		return errors.New("NonAdminRestore spec.volumeSelector can not be set with spec.restoreSpec.includedResources")
+	case restoreSpec.LabelSelector != nil:
+		return errors.New("NonAdminRestore spec.volumeSelector can not be set with spec.restoreSpec.labelSelector")
+	case len(restoreSpec.OrLabelSelectors) > 0:
+		return errors.New("NonAdminRestore spec.volumeSelector can not be set with spec.restoreSpec.orLabelSelectors")
+	}
+	return nil
+}
+
+// resourceFilterIncludes returns true if the resource filter item, a resource, its kind or resource.group,
+// includes resource. An item without group includes the resource of any group.
+func resourceFilterIncludes(item string, resource string) bool {
+	itemResource := schema.ParseGroupResource(strings.ToLower(item))
+	deniedResource := schema.ParseGroupResource(strings.ToLower(resource))
+	if itemResource.Group != constant.EmptyString && deniedResource.Group != constant.EmptyString && itemResource.Group != deniedResource.Group {
+		return false
+	}
+	if itemResource.Resource == deniedResource.Resource {
+		return true
+	}
+	plural, _ := meta.UnsafeGuessKindToResource(schema.GroupVersionKind{Kind: itemResource.Resource})
+	return plural.Resource == deniedResource.Resource
+}
+
+// imageRepository returns the image reference without its tag or digest
+func imageRepository(image string) string {
+	repository, _, _ := strings.Cut(image, "@")
+	if index := strings.LastIndex(repository, ":"); index > strings.LastIndex(repository, "/") {
+		repository = repository[:index]
+	}
+	return repository
+}
+
+// validateBackupResourceFilters returns nil, if the label selectors and resource filters of the NonAdminBackup are valid; error otherwise
+func validateBackupResourceFilters(backupSpec *velerov1.BackupSpec) error {
+	if backupSpec.LabelSelector != nil && len(backupSpec.OrLabelSelectors) > 0 {
+		return errors.New("NonAdminBackup spec.backupSpec.labelSelector and spec.backupSpec.orLabelSelectors can not be used together")
+	}
+	for _, labelSelector := range append([]*metav1.LabelSelector{backupSpec.LabelSelector}, backupSpec.OrLabelSelectors...) {
+		if labelSelector == nil {
+			continue
+		}
+		if _, err := metav1.LabelSelectorAsSelector(labelSelector); err != nil {
+			return fmt.Errorf("NonAdminBackup spec.backupSpec label selector is invalid: %v", err)
+		}
+	}
+
+	haveOldResourceFilterParameters := len(backupSpec.IncludedResources) > 0 ||
+		len(backupSpec.ExcludedResources) > 0 ||
+		backupSpec.IncludeClusterResources != nil
+	haveNewResourceFilterParameters := len(backupSpec.IncludedClusterScopedResources) > 0 ||
+		len(backupSpec.ExcludedClusterScopedResources) > 0 ||
+		len(backupSpec.IncludedNamespaceScopedResources) > 0 ||
+		len(backupSpec.ExcludedNamespaceScopedResources) > 0
+	if haveOldResourceFilterParameters && haveNewResourceFilterParameters {
+		return errors.New("NonAdminBackup spec.backupSpec includedResources, excludedResources and includeClusterResources " +
+			"can not be used together with includedNamespaceScopedResources, excludedNamespaceScopedResources and excludedClusterScopedResources")
+	}
+
+	if err := validateIncludesExcludes("includedResources", backupSpec.IncludedResources, "excludedResources", backupSpec.ExcludedResources, false); err != nil {
+		return err
+	}
+	if err := validateIncludesExcludes("includedNamespaceScopedResources", backupSpec.IncludedNamespaceScopedResources, "excludedNamespaceScopedResources", backupSpec.ExcludedNamespaceScopedResources, true); err != nil {
+		return err
+	}
+	return validateIncludesExcludes("includedClusterScopedResources", backupSpec.IncludedClusterScopedResources, "excludedClusterScopedResources", backupSpec.ExcludedClusterScopedResources, true)
+}
+
+// validateBackupOrderedResources returns nil, if the ordered resources of the NonAdminBackup are all in the backed up namespaces; error otherwise.
+// Velero orders the resources in the format namespace/name, or name for cluster scoped resources.
+func validateBackupOrderedResources(nonAdminBackup *nacv1alpha1.NonAdminBackup) error {
+	namespaces := nonAdminBackup.Spec.BackupSpec.IncludedNamespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{nonAdminBackup.Namespace}
+	}
+	orderedResources := nonAdminBackup.Spec.BackupSpec.OrderedResources
+	for _, resource := range slices.Sorted(maps.Keys(orderedResources)) {
+		for _, item := range strings.Split(orderedResources[resource], constant.CommaString) {
+			namespace, name, found := strings.Cut(strings.TrimSpace(item), "/")
+			if !found || name == constant.EmptyString || !slices.Contains(namespaces, namespace) {
+				return fmt.Errorf(constant.NABRestrictedErr+", %s item %q must be namespace/name in the namespaces: %s",
+					"spec.backupSpec.orderedResources", resource, item, strings.Join(namespaces, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// validateBackupTimeouts returns nil, if the timeouts of the NonAdminBackup are not negative; error otherwise
+func validateBackupTimeouts(backupSpec *velerov1.BackupSpec) error {
+	for _, timeout := range []struct {
+		field    string
+		duration time.Duration
+	}{
+		{"csiSnapshotTimeout", backupSpec.CSISnapshotTimeout.Duration},
+		{"itemOperationTimeout", backupSpec.ItemOperationTimeout.Duration},
+	} {
+		if timeout.duration < 0 {
+			return fmt.Errorf("NonAdminBackup spec.backupSpec.%s is invalid, can not be negative", timeout.field)
+		}
+	}
+	return nil
+}
+
+// ValidateBackupSpec return nil, if NonAdminBackup is valid; error otherwise.
+// If allowMultipleNamespaces is true, spec.backupSpec.includedNamespaces may contain other namespaces,
+// as long as the NonAdminBackup requester is allowed to create NonAdminBackups in each of them
+func ValidateBackupSpec(ctx context.Context, clientInstance client.Client, oadpNamespace string, nonAdminBackup *nacv1alpha1.NonAdminBackup, enforcedBackupSpec *velerov1.BackupSpec, allowMultipleNamespaces bool) error {
 	if nonAdminBackup.Spec.BackupSpec.IncludedNamespaces != nil {
 		if !containsOnlyNamespace(nonAdminBackup.Spec.BackupSpec.IncludedNamespaces, nonAdminBackup.Namespace) {
-			return fmt.Errorf(constant.NABRestrictedErr+", can not contain namespaces other than: %s", "spec.backupSpec.includedNamespaces", nonAdminBackup.Namespace)
+			if !allowMultipleNamespaces {
+				return fmt.Errorf(constant.NABRestrictedErr+", can not contain namespaces other than: %s", "spec.backupSpec.includedNamespaces", nonAdminBackup.Namespace)
+			}
+			if err := CheckRequesterCanBackupNamespaces(ctx, clientInstance, nonAdminBackup); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -124,10 +508,20 @@ func ValidateBackupSpec(ctx context.Context, clientInstance client.Client, oadpN
 		return fmt.Errorf(constant.NABRestrictedErr+", must remain empty", "spec.backupSpec.includedScopedResources")
 	}
 
-	if nonAdminBackup.Spec.BackupSpec.StorageLocation != constant.EmptyString {
+	storageLocation := nonAdminBackup.Spec.BackupSpec.StorageLocation
+	if storageLocation == constant.EmptyString && enforcedBackupSpec.StorageLocation == constant.EmptyString {
+		defaultNonAdminBsl, err := GetDefaultNonAdminBackupStorageLocation(ctx, clientInstance, nonAdminBackup.Namespace)
+		if err != nil {
+			return fmt.Errorf("unable to get the default NonAdminBackupStorageLocation of the namespace: %v", err)
+		}
+		if defaultNonAdminBsl != nil {
+			storageLocation = defaultNonAdminBsl.Name
+		}
+	}
+	if storageLocation != constant.EmptyString {
 		nonAdminBsl := &nacv1alpha1.NonAdminBackupStorageLocation{}
 		err := clientInstance.Get(ctx, types.NamespacedName{
-			Name:      nonAdminBackup.Spec.BackupSpec.StorageLocation,
+			Name:      storageLocation,
 			Namespace: nonAdminBackup.Namespace,
 		}, nonAdminBsl)
 		if apierrors.IsNotFound(err) {
@@ -135,9 +529,16 @@ func ValidateBackupSpec(ctx context.Context, clientInstance client.Client, oadpN
 		} else if err != nil {
 			return fmt.Errorf("NonAdminBackup spec.backupSpec.storageLocation is invalid: %v", err)
 		}
+		approved := meta.FindStatusCondition(nonAdminBsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionApproved))
+		if approved != nil && approved.Reason == constant.NabslApprovalRevokedReason {
+			return fmt.Errorf("NonAdminBackupStorageLocation can not be used for the NonAdminBackup: %s", approved.Message)
+		}
 		if nonAdminBsl.Status.Phase != nacv1alpha1.NonAdminPhaseCreated {
 			return errors.New("NonAdminBackupStorageLocation is not in created state and can not be used for the NonAdminBackup")
 		}
+		if nonAdminBsl.Spec.Paused {
+			return fmt.Errorf("NonAdminBackupStorageLocation %s is paused and can not be used for the NonAdminBackup", storageLocation)
+		}
 
 		if nonAdminBsl.Status.VeleroBackupStorageLocation == nil || nonAdminBsl.Status.VeleroBackupStorageLocation.NACUUID == constant.EmptyString {
 			return errors.New("unable to get VeleroBackupStorageLocation UUID from NonAdminBackupStorageLocation Status")
@@ -150,13 +551,40 @@ func ValidateBackupSpec(ctx context.Context, clientInstance client.Client, oadpN
 		if veleroBackupStorageLocation == nil {
 			return fmt.Errorf("VeleroBackupStorageLocation with NACUUID %s not found in the OADP namespace", veleroObjectsNACUUID)
 		}
+		if veleroBackupStorageLocation.Spec.AccessMode == velerov1.BackupStorageLocationAccessModeReadOnly {
+			return fmt.Errorf("NonAdminBackupStorageLocation %s is ReadOnly and can only be used to restore its backups", storageLocation)
+		}
+		if err := validateBackupObjectLockTTL(nonAdminBackup.Spec.BackupSpec, enforcedBackupSpec, nonAdminBsl); err != nil {
+			return err
+		}
 		if veleroBackupStorageLocation.Status.Phase != velerov1.BackupStorageLocationPhaseAvailable {
 			return fmt.Errorf("VeleroBackupStorageLocation with NACUUID %s is not in available state and can not be used for the NonAdminBackup", veleroObjectsNACUUID)
 		}
 	}
 
-	if nonAdminBackup.Spec.BackupSpec.VolumeSnapshotLocations != nil {
-		return fmt.Errorf(constant.NABRestrictedErr, "spec.backupSpec.volumeSnapshotLocations")
+	for _, volumeSnapshotLocation := range nonAdminBackup.Spec.BackupSpec.VolumeSnapshotLocations {
+		if err := validateNonAdminVolumeSnapshotLocation(ctx, clientInstance, oadpNamespace, nonAdminBackup.Namespace, volumeSnapshotLocation); err != nil {
+			return err
+		}
+	}
+
+	// A resource policy enforced by the admin user references a ConfigMap in the OADP namespace
+	if nonAdminBackup.Spec.BackupSpec.ResourcePolicy != nil && enforcedBackupSpec.ResourcePolicy == nil {
+		if err := validateResourcePolicy(ctx, clientInstance, nonAdminBackup.Namespace, nonAdminBackup.Spec.BackupSpec.ResourcePolicy); err != nil {
+			return err
+		}
+	}
+
+	if err := validateBackupResourceFilters(nonAdminBackup.Spec.BackupSpec); err != nil {
+		return err
+	}
+
+	if err := validateBackupOrderedResources(nonAdminBackup); err != nil {
+		return err
+	}
+
+	if err := validateBackupTimeouts(nonAdminBackup.Spec.BackupSpec); err != nil {
+		return err
 	}
 
 	enforcedSpec := reflect.ValueOf(enforcedBackupSpec).Elem()
@@ -178,110 +606,1214 @@ func ValidateBackupSpec(ctx context.Context, clientInstance client.Client, oadpN
 	return nil
 }
 
-// ValidateRestoreSpec return nil, if NonAdminRestore is valid; error otherwise
-func ValidateRestoreSpec(ctx context.Context, clientInstance client.Client, nonAdminRestore *nacv1alpha1.NonAdminRestore, enforcedRestoreSpec *velerov1.RestoreSpec) error {
-	if len(nonAdminRestore.Spec.RestoreSpec.ScheduleName) > 0 {
-		return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.scheduleName")
+// ValidateScheduleSpec return nil, if NonAdminSchedule spec.scheduleSpec fields not validated as a
+// NonAdminBackup spec.backupSpec are valid; error otherwise
+func ValidateScheduleSpec(nonAdminSchedule *nacv1alpha1.NonAdminSchedule, enforcedBackupSpec *velerov1.BackupSpec) error {
+	scheduleSpec := nonAdminSchedule.Spec.ScheduleSpec
+	if scheduleSpec == nil {
+		return fmt.Errorf("NonAdminSchedule spec.scheduleSpec is not defined")
 	}
 
-	if nonAdminRestore.Spec.RestoreSpec.BackupName == constant.EmptyString {
-		return errors.New("NonAdminRestore spec.restoreSpec.backupName is not set")
+	if strings.TrimSpace(scheduleSpec.Schedule) == constant.EmptyString {
+		return fmt.Errorf("NonAdminSchedule spec.scheduleSpec.schedule is not defined")
 	}
 
-	nab := &nacv1alpha1.NonAdminBackup{}
-	err := clientInstance.Get(ctx, types.NamespacedName{
-		Name:      nonAdminRestore.Spec.RestoreSpec.BackupName,
-		Namespace: nonAdminRestore.Namespace,
-	}, nab)
-	if err != nil {
-		return fmt.Errorf("NonAdminRestore spec.restoreSpec.backupName is invalid: %v", err)
+	// Velero Backups owned by the Velero Schedule would be removed with it, while the NonAdminBackups
+	// created for them are kept
+	if scheduleSpec.UseOwnerReferencesInBackup != nil && *scheduleSpec.UseOwnerReferencesInBackup {
+		return fmt.Errorf(constant.NASRestrictedErr+", can only be set to false", "spec.scheduleSpec.useOwnerReferencesInBackup")
 	}
-	// TODO better way to check readiness? simplify and ask user to pass velero backup name? (user has access to this info in nonAdminBackup status)
-	if nab.Status.Phase != nacv1alpha1.NonAdminPhaseCreated {
-		return errors.New("NonAdminRestore spec.restoreSpec.backupName is invalid: NonAdminBackup is not ready to be restored")
+
+	// The resource policy ConfigMap is copied to the OADP namespace per NonAdminBackup, which does not
+	// exist yet when the Velero Schedule creates a Velero Backup
+	if scheduleSpec.Template.ResourcePolicy != nil && enforcedBackupSpec.ResourcePolicy == nil {
+		return fmt.Errorf(constant.NASRestrictedErr, "spec.scheduleSpec.template.resourcePolicy")
 	}
-	// TODO validate that velero backup exists?
-	// TODO does velero validate if backup is ready to be restored?
-	// Issue link: https://github.com/migtools/oadp-non-admin/issues/225
 
-	if nonAdminRestore.Spec.RestoreSpec.IncludedNamespaces != nil {
-		return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.includedNamespaces")
+	return nil
+}
+
+// RequesterAnnotations are the keys of the annotations recording the identity of the user creating an object,
+// set by the admission webhook of its kind
+type RequesterAnnotations struct {
+	Username string
+	UID      string
+	// Groups records the groups of the user as a JSON list
+	Groups string
+	// Extra records the extra information of the user, like the scopes of its token, as a JSON map
+	Extra string
+}
+
+var (
+	// NonAdminBackupRequesterAnnotations are the requester annotations of NonAdminBackups
+	NonAdminBackupRequesterAnnotations = RequesterAnnotations{
+		Username: constant.NabRequesterUsernameAnnotation,
+		UID:      constant.NabRequesterUIDAnnotation,
+		Groups:   constant.NabRequesterGroupsAnnotation,
+		Extra:    constant.NabRequesterExtraAnnotation,
+	}
+	// NonAdminRestoreRequesterAnnotations are the requester annotations of NonAdminRestores
+	NonAdminRestoreRequesterAnnotations = RequesterAnnotations{
+		Username: constant.NarRequesterUsernameAnnotation,
+		UID:      constant.NarRequesterUIDAnnotation,
+		Groups:   constant.NarRequesterGroupsAnnotation,
+		Extra:    constant.NarRequesterExtraAnnotation,
+	}
+	// NonAdminGroupBackupRequesterAnnotations are the requester annotations of NonAdminGroupBackups
+	NonAdminGroupBackupRequesterAnnotations = RequesterAnnotations{
+		Username: constant.NagbRequesterUsernameAnnotation,
+		UID:      constant.NagbRequesterUIDAnnotation,
+		Groups:   constant.NagbRequesterGroupsAnnotation,
+		Extra:    constant.NagbRequesterExtraAnnotation,
+	}
+	// NonAdminDeleteBackupRequestRequesterAnnotations are the requester annotations of NonAdminDeleteBackupRequests
+	NonAdminDeleteBackupRequestRequesterAnnotations = RequesterAnnotations{
+		Username: constant.NadbrRequesterUsernameAnnotation,
+		UID:      constant.NadbrRequesterUIDAnnotation,
+		Groups:   constant.NadbrRequesterGroupsAnnotation,
+		Extra:    constant.NadbrRequesterExtraAnnotation,
+	}
+	// NonAdminRetentionPolicyRequesterAnnotations are the requester annotations of NonAdminRetentionPolicies
+	NonAdminRetentionPolicyRequesterAnnotations = RequesterAnnotations{
+		Username: constant.NarpRequesterUsernameAnnotation,
+		UID:      constant.NarpRequesterUIDAnnotation,
+		Groups:   constant.NarpRequesterGroupsAnnotation,
+		Extra:    constant.NarpRequesterExtraAnnotation,
+	}
+	// NonAdminBackupVerificationRequesterAnnotations are the requester annotations of NonAdminBackupVerifications
+	NonAdminBackupVerificationRequesterAnnotations = RequesterAnnotations{
+		Username: constant.NabvRequesterUsernameAnnotation,
+		UID:      constant.NabvRequesterUIDAnnotation,
+		Groups:   constant.NabvRequesterGroupsAnnotation,
+		Extra:    constant.NabvRequesterExtraAnnotation,
 	}
+)
 
-	if nonAdminRestore.Spec.RestoreSpec.ExcludedNamespaces != nil {
-		return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.excludedNamespaces")
+// Keys returns the keys of the requester annotations
+func (requesterAnnotations RequesterAnnotations) Keys() []string {
+	return []string{requesterAnnotations.Username, requesterAnnotations.UID, requesterAnnotations.Groups, requesterAnnotations.Extra}
+}
+
+// GetRequesterAnnotations returns the requesterAnnotations recording the identity of userInfo,
+// the user creating an object
+func GetRequesterAnnotations(userInfo authenticationv1.UserInfo, requesterAnnotations RequesterAnnotations) map[string]string {
+	annotations := map[string]string{
+		requesterAnnotations.Username: userInfo.Username,
+		requesterAnnotations.UID:      userInfo.UID,
+		requesterAnnotations.Groups:   constant.EmptyString,
+		requesterAnnotations.Extra:    constant.EmptyString,
+	}
+	// group names and extra values may contain commas, so they are recorded as JSON
+	if len(userInfo.Groups) > 0 {
+		groups, _ := json.Marshal(userInfo.Groups)
+		annotations[requesterAnnotations.Groups] = string(groups)
+	}
+	if len(userInfo.Extra) > 0 {
+		extra, _ := json.Marshal(userInfo.Extra)
+		annotations[requesterAnnotations.Extra] = string(extra)
+	}
+	return annotations
+}
+
+// GetNonAdminGroupBackupRequester returns the identity of the user that created the NonAdminGroupBackup,
+// as recorded by the NonAdminGroupBackup admission webhook
+func GetNonAdminGroupBackupRequester(nonAdminGroupBackup *nacv1alpha1.NonAdminGroupBackup) authenticationv1.UserInfo {
+	return getRequester(nonAdminGroupBackup.Annotations, NonAdminGroupBackupRequesterAnnotations)
+}
+
+// GetNonAdminDeleteBackupRequestRequester returns the identity of the user that created the NonAdminDeleteBackupRequest,
+// as recorded by the NonAdminDeleteBackupRequest admission webhook
+func GetNonAdminDeleteBackupRequestRequester(nonAdminDeleteBackupRequest *nacv1alpha1.NonAdminDeleteBackupRequest) authenticationv1.UserInfo {
+	return getRequester(nonAdminDeleteBackupRequest.Annotations, NonAdminDeleteBackupRequestRequesterAnnotations)
+}
+
+// GetNonAdminRetentionPolicyRequester returns the identity of the user that created the NonAdminRetentionPolicy,
+// as recorded by the NonAdminRetentionPolicy admission webhook
+func GetNonAdminRetentionPolicyRequester(nonAdminRetentionPolicy *nacv1alpha1.NonAdminRetentionPolicy) authenticationv1.UserInfo {
+	return getRequester(nonAdminRetentionPolicy.Annotations, NonAdminRetentionPolicyRequesterAnnotations)
+}
+
+// GetNonAdminBackupVerificationRequester returns the identity of the user that created the NonAdminBackupVerification,
+// as recorded by the NonAdminBackupVerification admission webhook
+func GetNonAdminBackupVerificationRequester(nonAdminBackupVerification *nacv1alpha1.NonAdminBackupVerification) authenticationv1.UserInfo {
+	return getRequester(nonAdminBackupVerification.Annotations, NonAdminBackupVerificationRequesterAnnotations)
+}
+
+// getRequester returns the identity of the user recorded in the requester annotations. Groups and extra
+// information that can not be decoded are left out, so the requester is not granted more than it was.
+func getRequester(annotations map[string]string, requesterAnnotations RequesterAnnotations) authenticationv1.UserInfo {
+	requester := authenticationv1.UserInfo{
+		Username: annotations[requesterAnnotations.Username],
+		UID:      annotations[requesterAnnotations.UID],
+	}
+	if groups := annotations[requesterAnnotations.Groups]; groups != constant.EmptyString {
+		if err := json.Unmarshal([]byte(groups), &requester.Groups); err != nil {
+			requester.Groups = nil
+		}
+	}
+	if extra := annotations[requesterAnnotations.Extra]; extra != constant.EmptyString {
+		if err := json.Unmarshal([]byte(extra), &requester.Extra); err != nil {
+			requester.Extra = nil
+		}
 	}
+	return requester
+}
 
-	if nonAdminRestore.Spec.RestoreSpec.NamespaceMapping != nil {
-		return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.namespaceMapping")
+// CheckRequesterWebhooksConfigured returns nil if the admission webhooks recording the requester annotations of the
+// objects of resource, named mutatingWebhookName and validatingWebhookName, are configured in the cluster for the
+// creation and update, respectively, of every object of resource, without selectors nor match conditions, and
+// reject the requests they can not process; error otherwise. Without them, non admin users can write the requester
+// annotations themselves.
+func CheckRequesterWebhooksConfigured(ctx context.Context, clientInstance client.Client, resource string, mutatingWebhookName string, validatingWebhookName string) error {
+	mutatingWebhookConfigurations := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := clientInstance.List(ctx, mutatingWebhookConfigurations); err != nil {
+		return fmt.Errorf("unable to list MutatingWebhookConfigurations: %v", err)
+	}
+	mutatingWebhookConfigured := slices.ContainsFunc(mutatingWebhookConfigurations.Items,
+		func(configuration admissionregistrationv1.MutatingWebhookConfiguration) bool {
+			return slices.ContainsFunc(configuration.Webhooks, func(webhook admissionregistrationv1.MutatingWebhook) bool {
+				return webhook.Name == mutatingWebhookName && isFailurePolicyFail(webhook.FailurePolicy) &&
+					isEverySelected(webhook.NamespaceSelector, webhook.ObjectSelector) && len(webhook.MatchConditions) == 0 &&
+					areRulesMatching(webhook.Rules, resource, admissionregistrationv1.Create)
+			})
+		})
+
+	validatingWebhookConfigurations := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := clientInstance.List(ctx, validatingWebhookConfigurations); err != nil {
+		return fmt.Errorf("unable to list ValidatingWebhookConfigurations: %v", err)
+	}
+	validatingWebhookConfigured := slices.ContainsFunc(validatingWebhookConfigurations.Items,
+		func(configuration admissionregistrationv1.ValidatingWebhookConfiguration) bool {
+			return slices.ContainsFunc(configuration.Webhooks, func(webhook admissionregistrationv1.ValidatingWebhook) bool {
+				return webhook.Name == validatingWebhookName && isFailurePolicyFail(webhook.FailurePolicy) &&
+					isEverySelected(webhook.NamespaceSelector, webhook.ObjectSelector) && len(webhook.MatchConditions) == 0 &&
+					areRulesMatching(webhook.Rules, resource, admissionregistrationv1.Update)
+			})
+		})
+
+	if !mutatingWebhookConfigured || !validatingWebhookConfigured {
+		return fmt.Errorf("admission webhooks %s and %s recording the requester are not configured with failurePolicy Fail, "+
+			"for the creation and update of every object of %s", mutatingWebhookName, validatingWebhookName, resource)
 	}
+	return nil
+}
 
-	enforcedSpec := reflect.ValueOf(enforcedRestoreSpec).Elem()
-	for index := range enforcedSpec.NumField() {
-		enforcedField := enforcedSpec.Field(index)
-		enforcedFieldName := enforcedSpec.Type().Field(index).Name
-		currentField := reflect.ValueOf(nonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(enforcedFieldName)
-		if !enforcedField.IsZero() && !currentField.IsZero() && !reflect.DeepEqual(enforcedField.Interface(), currentField.Interface()) {
-			field, _ := reflect.TypeOf(nonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(enforcedFieldName)
-			tagName, _, _ := strings.Cut(field.Tag.Get(constant.JSONTagString), constant.CommaString)
-			return fmt.Errorf(
-				"the administrator has restricted spec.restoreSpec.%v field value to %v",
-				tagName,
-				reflect.Indirect(enforcedField),
-			)
-		}
+// isEverySelected returns true if the admission webhook selectors select every object, in every namespace
+func isEverySelected(namespaceSelector *metav1.LabelSelector, objectSelector *metav1.LabelSelector) bool {
+	isEmpty := func(selector *metav1.LabelSelector) bool {
+		return selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0)
 	}
+	return isEmpty(namespaceSelector) && isEmpty(objectSelector)
+}
+
+// areRulesMatching returns true if one of the admission webhook rules matches the operation on the NAC objects
+// of resource, in the version served by the NAC webhooks
+func areRulesMatching(rules []admissionregistrationv1.RuleWithOperations, resource string, operation admissionregistrationv1.OperationType) bool {
+	return slices.ContainsFunc(rules, func(rule admissionregistrationv1.RuleWithOperations) bool {
+		return (slices.Contains(rule.Operations, operation) || slices.Contains(rule.Operations, admissionregistrationv1.OperationAll)) &&
+			(slices.Contains(rule.APIGroups, nacv1alpha1.GroupVersion.Group) || slices.Contains(rule.APIGroups, "*")) &&
+			(slices.Contains(rule.APIVersions, nacv1alpha1.GroupVersion.Version) || slices.Contains(rule.APIVersions, "*")) &&
+			(slices.Contains(rule.Resources, resource) || slices.Contains(rule.Resources, "*")) &&
+			(rule.Scope == nil || *rule.Scope == admissionregistrationv1.AllScopes || *rule.Scope == admissionregistrationv1.NamespacedScope)
+	})
+}
 
+// isFailurePolicyFail returns true if the admission webhook failure policy rejects the requests it can not process,
+// the default of admissionregistration.k8s.io/v1
+func isFailurePolicyFail(failurePolicy *admissionregistrationv1.FailurePolicyType) bool {
+	return failurePolicy == nil || *failurePolicy == admissionregistrationv1.Fail
+}
+
+// checkRequesterCanCreate returns nil if requester is allowed to create objects of resource, named kinds
+// in the error, in namespace; error otherwise
+func checkRequesterCanCreate(ctx context.Context, clientInstance client.Client, requester authenticationv1.UserInfo, namespace string, group string, resource string, kinds string) error {
+	subjectAccessReview := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   requester.Username,
+			UID:    requester.UID,
+			Groups: requester.Groups,
+			Extra:  getSubjectAccessReviewExtra(requester.Extra),
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "create",
+				Group:     group,
+				Resource:  resource,
+			},
+		},
+	}
+	if err := clientInstance.Create(ctx, subjectAccessReview); err != nil {
+		return fmt.Errorf("unable to verify access to namespace %s: %v", namespace, err)
+	}
+	if !subjectAccessReview.Status.Allowed {
+		return fmt.Errorf("user %s is not allowed to create %s in namespace %s", requester.Username, kinds, namespace)
+	}
 	return nil
 }
 
-// ValidateBslSpec return nil, if NonAdminBackupStorageLocation is valid; error otherwise
-func ValidateBslSpec(ctx context.Context, clientInstance client.Client, nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation, enforcedBSLSpec *oadpv1alpha1.EnforceBackupStorageLocationSpec, appliedBackupSyncPeriod time.Duration, defaultBackupSyncPeriod *time.Duration) error {
-	if nonAdminBsl.Spec.BackupStorageLocationSpec.Default {
-		return errors.New("NonAdminBackupStorageLocation cannot be used as a default BSL")
+// getSubjectAccessReviewExtra returns the extra information of a SubjectAccessReview of a user with extra
+func getSubjectAccessReviewExtra(extra map[string]authenticationv1.ExtraValue) map[string]authorizationv1.ExtraValue {
+	if extra == nil {
+		return nil
 	}
-	if nonAdminBsl.Spec.BackupStorageLocationSpec.Credential == nil {
-		return errors.New("NonAdminBackupStorageLocation spec.bslSpec.credential is not set")
-	} else if nonAdminBsl.Spec.BackupStorageLocationSpec.Credential.Name == constant.EmptyString || nonAdminBsl.Spec.BackupStorageLocationSpec.Credential.Key == constant.EmptyString {
-		return errors.New("NonAdminBackupStorageLocation spec.bslSpec.credential.name or spec.bslSpec.credential.key is not set")
+	subjectAccessReviewExtra := make(map[string]authorizationv1.ExtraValue, len(extra))
+	for key, value := range extra {
+		subjectAccessReviewExtra[key] = authorizationv1.ExtraValue(value)
 	}
-	bslSyncPeriodErrorMessage := "NABSL spec.backupStorageLocationSpec.backupSyncPeriod (%v) can not be greater or equal non admin backupSyncPeriod (%v)"
-	if nonAdminBsl.Spec.BackupStorageLocationSpec.BackupSyncPeriod != nil {
-		if appliedBackupSyncPeriod <= nonAdminBsl.Spec.BackupStorageLocationSpec.BackupSyncPeriod.Duration {
-			return fmt.Errorf(
-				bslSyncPeriodErrorMessage,
-				nonAdminBsl.Spec.BackupStorageLocationSpec.BackupSyncPeriod.Duration, appliedBackupSyncPeriod,
-			)
+	return subjectAccessReviewExtra
+}
+
+// CheckRequesterCanBackupNamespaces returns nil if the user that created the NonAdminBackup, as recorded by
+// the NonAdminBackup admission webhook, is allowed to create NonAdminBackups in every namespace listed in
+// spec.backupSpec.includedNamespaces; error otherwise
+func CheckRequesterCanBackupNamespaces(ctx context.Context, clientInstance client.Client, nonAdminBackup *nacv1alpha1.NonAdminBackup) error {
+	requester := getRequester(nonAdminBackup.Annotations, NonAdminBackupRequesterAnnotations)
+	if requester.Username == constant.EmptyString {
+		return fmt.Errorf(constant.NABRestrictedErr+", requester identity is not recorded, can not contain namespaces other than: %s", "spec.backupSpec.includedNamespaces", nonAdminBackup.Namespace)
+	}
+	if err := CheckRequesterWebhooksConfigured(ctx, clientInstance, nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName); err != nil {
+		return fmt.Errorf(constant.NABRestrictedErr+", requester identity can not be trusted, can not contain namespaces other than: %s: %v", "spec.backupSpec.includedNamespaces", nonAdminBackup.Namespace, err)
+	}
+
+	for _, namespace := range nonAdminBackup.Spec.BackupSpec.IncludedNamespaces {
+		if namespace == nonAdminBackup.Namespace {
+			continue
 		}
-	} else {
-		if defaultBackupSyncPeriod != nil {
-			if appliedBackupSyncPeriod <= *defaultBackupSyncPeriod {
-				return fmt.Errorf(
-					bslSyncPeriodErrorMessage,
-					defaultBackupSyncPeriod, appliedBackupSyncPeriod,
-				)
-			}
-		} else {
-			// https://github.com/vmware-tanzu/velero/blob/9295be4cc061038b91b7bfaf55d99e9bc9dcf0af/pkg/cmd/server/config/config.go#L24
-			if appliedBackupSyncPeriod <= time.Minute {
-				return fmt.Errorf(
-					bslSyncPeriodErrorMessage,
-					time.Minute, appliedBackupSyncPeriod,
-				)
-			}
+		if namespace == "*" {
+			return fmt.Errorf(constant.NABRestrictedErr+", can not contain wildcard", "spec.backupSpec.includedNamespaces")
+		}
+		if err := checkRequesterCanCreate(ctx, clientInstance, requester, namespace, nacv1alpha1.GroupVersion.Group, nacv1alpha1.NonAdminBackups, "NonAdminBackups"); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	nonAdminBslSpec := reflect.ValueOf(nonAdminBsl.Spec.BackupStorageLocationSpec).Elem()
-	enforcedSpec := reflect.ValueOf(enforcedBSLSpec).Elem()
-	for index := range enforcedSpec.NumField() {
-		enforcedField := enforcedSpec.Field(index)
-		enforcedFieldName := enforcedSpec.Type().Field(index).Name
-		currentField := nonAdminBslSpec.FieldByName(enforcedFieldName)
-		switch enforcedFieldName {
-		case "StorageType":
-			enforcedStorageType := compareStorageTypes(enforcedField, currentField)
-			if enforcedStorageType != constant.EmptyString {
+// CheckRequesterCanGroupBackupNamespaces returns nil if the user that created the NonAdminGroupBackup, as recorded
+// by the NonAdminGroupBackup admission webhook, is allowed to create NonAdminBackups in every namespace; error otherwise
+func CheckRequesterCanGroupBackupNamespaces(ctx context.Context, clientInstance client.Client, nonAdminGroupBackup *nacv1alpha1.NonAdminGroupBackup, namespaces []string) error {
+	requester := GetNonAdminGroupBackupRequester(nonAdminGroupBackup)
+	if requester.Username == constant.EmptyString {
+		return fmt.Errorf(constant.NAGBRestrictedErr+", requester identity is not recorded", "creation")
+	}
+	if err := CheckRequesterWebhooksConfigured(ctx, clientInstance, nacv1alpha1.NonAdminGroupBackups, constant.NagbMutatingWebhookName, constant.NagbValidatingWebhookName); err != nil {
+		return fmt.Errorf(constant.NAGBRestrictedErr+", requester identity can not be trusted: %v", "creation", err)
+	}
+	for _, namespace := range namespaces {
+		if err := checkRequesterCanCreate(ctx, clientInstance, requester, namespace, nacv1alpha1.GroupVersion.Group, nacv1alpha1.NonAdminBackups, "NonAdminBackups"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckRequesterCanDeleteBackups returns nil if the user that created the NonAdminRetentionPolicy, as recorded by
+// the NonAdminRetentionPolicy admission webhook, is allowed to create NonAdminDeleteBackupRequests in its namespace;
+// error otherwise. NAC creates the NonAdminDeleteBackupRequests of the policy on behalf of that user.
+func CheckRequesterCanDeleteBackups(ctx context.Context, clientInstance client.Client, nonAdminRetentionPolicy *nacv1alpha1.NonAdminRetentionPolicy) error {
+	requester := GetNonAdminRetentionPolicyRequester(nonAdminRetentionPolicy)
+	if requester.Username == constant.EmptyString {
+		return fmt.Errorf(constant.NARPRestrictedErr+", requester identity is not recorded", "creation")
+	}
+	if err := CheckRequesterWebhooksConfigured(ctx, clientInstance, nacv1alpha1.NonAdminRetentionPolicies, constant.NarpMutatingWebhookName, constant.NarpValidatingWebhookName); err != nil {
+		return fmt.Errorf(constant.NARPRestrictedErr+", requester identity can not be trusted: %v", "creation", err)
+	}
+	return checkRequesterCanCreate(ctx, clientInstance, requester, nonAdminRetentionPolicy.Namespace,
+		nacv1alpha1.GroupVersion.Group, nacv1alpha1.NonAdminDeleteBackupRequests, "NonAdminDeleteBackupRequests")
+}
+
+// CheckRequesterCanRunValidationJobs returns nil if the user that created the NonAdminBackupVerification, as recorded
+// by the NonAdminBackupVerification admission webhook, is allowed to create Jobs in its namespace; error otherwise.
+// NAC creates the validation Job of the verification on behalf of that user.
+func CheckRequesterCanRunValidationJobs(ctx context.Context, clientInstance client.Client, nonAdminBackupVerification *nacv1alpha1.NonAdminBackupVerification) error {
+	requester := GetNonAdminBackupVerificationRequester(nonAdminBackupVerification)
+	if requester.Username == constant.EmptyString {
+		return fmt.Errorf(constant.NABVRestrictedErr+", requester identity is not recorded", "spec.validationJob")
+	}
+	if err := CheckRequesterWebhooksConfigured(ctx, clientInstance, nacv1alpha1.NonAdminBackupVerifications, constant.NabvMutatingWebhookName, constant.NabvValidatingWebhookName); err != nil {
+		return fmt.Errorf(constant.NABVRestrictedErr+", requester identity can not be trusted: %v", "spec.validationJob", err)
+	}
+	return checkRequesterCanCreate(ctx, clientInstance, requester, nonAdminBackupVerification.Namespace,
+		batchv1.GroupName, "jobs", "Jobs")
+}
+
+// ErrNamespaceMappingRejected is wrapped by ValidateRestoreSpec errors caused by spec.restoreSpec.namespaceMapping
+// targets the NonAdminRestore requester can not restore to
+var ErrNamespaceMappingRejected = errors.New("NonAdminRestore spec.restoreSpec.namespaceMapping is rejected")
+
+// CheckRequesterCanRestoreNamespaceMapping returns nil if spec.restoreSpec.namespaceMapping only maps the
+// NonAdminRestore namespace, to namespaces where the user that created the NonAdminRestore, as recorded by
+// the NonAdminRestore admission webhook, is allowed to create NonAdminRestores; error otherwise
+func CheckRequesterCanRestoreNamespaceMapping(ctx context.Context, clientInstance client.Client, nonAdminRestore *nacv1alpha1.NonAdminRestore) error {
+	requester := getRequester(nonAdminRestore.Annotations, NonAdminRestoreRequesterAnnotations)
+	if requester.Username == constant.EmptyString {
+		return fmt.Errorf(constant.NARRestrictedErr+", requester identity is not recorded", "nonAdminRestore.spec.restoreSpec.namespaceMapping")
+	}
+	if err := CheckRequesterWebhooksConfigured(ctx, clientInstance, nacv1alpha1.NonAdminRestores, constant.NarMutatingWebhookName, constant.NarValidatingWebhookName); err != nil {
+		return fmt.Errorf(constant.NARRestrictedErr+", requester identity can not be trusted: %v", "nonAdminRestore.spec.restoreSpec.namespaceMapping", err)
+	}
+
+	for _, source := range slices.Sorted(maps.Keys(nonAdminRestore.Spec.RestoreSpec.NamespaceMapping)) {
+		if source != nonAdminRestore.Namespace {
+			return fmt.Errorf(constant.NARRestrictedErr+", can only map namespace: %s", "nonAdminRestore.spec.restoreSpec.namespaceMapping", nonAdminRestore.Namespace)
+		}
+		target := nonAdminRestore.Spec.RestoreSpec.NamespaceMapping[source]
+		if target == constant.EmptyString || target == "*" {
+			return fmt.Errorf(constant.NARRestrictedErr+", namespace %s can not be mapped to %q", "nonAdminRestore.spec.restoreSpec.namespaceMapping", source, target)
+		}
+		if target == nonAdminRestore.Namespace {
+			continue
+		}
+		if err := checkRequesterCanCreate(ctx, clientInstance, requester, target, nacv1alpha1.GroupVersion.Group, nacv1alpha1.NonAdminRestores, "NonAdminRestores"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsRestoreVerificationNamespaceMapping returns true if spec.restoreSpec.namespaceMapping only maps the NonAdminRestore
+// namespace to the temporary namespace NAC created, with the same name as the NonAdminRestore, to verify a NonAdminBackup
+// of that namespace can be restored; false otherwise
+func IsRestoreVerificationNamespaceMapping(ctx context.Context, clientInstance client.Client, nonAdminRestore *nacv1alpha1.NonAdminRestore) bool {
+	target, ok := nonAdminRestore.Spec.RestoreSpec.NamespaceMapping[nonAdminRestore.Namespace]
+	if !ok || len(nonAdminRestore.Spec.RestoreSpec.NamespaceMapping) != 1 || target != nonAdminRestore.Name {
+		return false
+	}
+	namespace := &corev1.Namespace{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: target}, namespace); err != nil {
+		return false
+	}
+	return namespace.Labels[constant.RestoreVerificationNamespaceLabel] == nonAdminRestore.Namespace
+}
+
+// GetSharedVeleroBackup returns the Velero Backup named name in the OADP namespace, if the admin user
+// shared it with namespace with the SharedWithNamespaceLabel; error otherwise
+func GetSharedVeleroBackup(ctx context.Context, clientInstance client.Client, oadpNamespace string, namespace string, name string) (*velerov1.Backup, error) {
+	veleroBackup := &velerov1.Backup{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Namespace: oadpNamespace, Name: name}, veleroBackup); err != nil {
+		return nil, err
+	}
+	// Velero Backups created by NAC belong to NonAdminBackups and can not be shared
+	if nacmeta.IsManagedByNAC(veleroBackup) || veleroBackup.Labels[constant.SharedWithNamespaceLabel] != namespace {
+		return nil, fmt.Errorf("Velero Backup %s is not shared with namespace %s", name, namespace)
+	}
+	return veleroBackup, nil
+}
+
+// IsSharedNonAdminBackupRestore returns true if the NonAdminRestore restores a NonAdminBackup of another
+// namespace, shared with its namespace by a NonAdminBackupShare
+func IsSharedNonAdminBackupRestore(nonAdminRestore *nacv1alpha1.NonAdminRestore) bool {
+	return nonAdminRestore.Spec.BackupNamespace != constant.EmptyString &&
+		nonAdminRestore.Spec.BackupNamespace != nonAdminRestore.Namespace
+}
+
+// GetSharedNonAdminBackup returns the NonAdminBackup spec.restoreSpec.backupName, in spec.backupNamespace, restored by
+// the NonAdminRestore, if a NonAdminBackupShare in spec.backupNamespace shares it with the NonAdminRestore namespace;
+// error otherwise
+func GetSharedNonAdminBackup(ctx context.Context, clientInstance client.Client, nonAdminRestore *nacv1alpha1.NonAdminRestore) (*nacv1alpha1.NonAdminBackup, error) {
+	backupNamespace := nonAdminRestore.Spec.BackupNamespace
+	backupName := nonAdminRestore.Spec.RestoreSpec.BackupName
+	shareList := &nacv1alpha1.NonAdminBackupShareList{}
+	if err := clientInstance.List(ctx, shareList, client.InNamespace(backupNamespace)); err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(shareList.Items, func(share nacv1alpha1.NonAdminBackupShare) bool {
+		return share.Spec.NonAdminBackupName == backupName && share.Spec.TargetNamespace == nonAdminRestore.Namespace
+	}) {
+		return nil, fmt.Errorf("NonAdminBackup %s/%s is not shared with namespace %s", backupNamespace, backupName, nonAdminRestore.Namespace)
+	}
+	nab := &nacv1alpha1.NonAdminBackup{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Namespace: backupNamespace, Name: backupName}, nab); err != nil {
+		return nil, err
+	}
+	return nab, nil
+}
+
+// GetSyncedVeleroBackup returns the Velero Backup with NACUUID nacUUID in the OADP namespace, if it was created
+// for a NonAdminBackup of namespace, like the ones synced from a backup storage location before their
+// NonAdminBackup is recreated; error otherwise.
+// Its origin labels and annotations must point to namespace, and so must the ones of its backup storage
+// location, if it was created for a NonAdminBackupStorageLocation.
+func GetSyncedVeleroBackup(ctx context.Context, clientInstance client.Client, oadpNamespace string, namespace string, nacUUID string) (*velerov1.Backup, error) {
+	veleroBackup, err := GetVeleroBackupByLabel(ctx, clientInstance, oadpNamespace, nacUUID)
+	if err != nil {
+		return nil, err
+	}
+	if veleroBackup == nil {
+		return nil, fmt.Errorf("Velero Backup with NACUUID %s not found", nacUUID)
+	}
+	origin, err := nacmeta.GetOrigin(veleroBackup)
+	if err != nil {
+		return nil, err
+	}
+	if origin.Kind != nacmeta.KindNonAdminBackup || origin.NACUUID != nacUUID || origin.Namespace != namespace {
+		return nil, fmt.Errorf("Velero Backup with NACUUID %s was not created for namespace %s", nacUUID, namespace)
+	}
+
+	if veleroBackup.Spec.StorageLocation != constant.EmptyString {
+		veleroBackupStorageLocation := &velerov1.BackupStorageLocation{}
+		if err := clientInstance.Get(ctx, types.NamespacedName{Namespace: oadpNamespace, Name: veleroBackup.Spec.StorageLocation}, veleroBackupStorageLocation); err != nil {
+			return nil, err
+		}
+		if nacmeta.IsManagedByNAC(veleroBackupStorageLocation) {
+			bslOrigin, err := nacmeta.GetOrigin(veleroBackupStorageLocation)
+			if err != nil {
+				return nil, err
+			}
+			if bslOrigin.Namespace != namespace {
+				return nil, fmt.Errorf("Velero Backup with NACUUID %s was not stored for namespace %s", nacUUID, namespace)
+			}
+		}
+	}
+	return veleroBackup, nil
+}
+
+// GetRestorableVeleroBackup returns the Velero Backup a NonAdminRestore of namespace restores, when its
+// spec.restoreSpec.backupName is not a NonAdminBackup: a Velero Backup shared with namespace, see
+// GetSharedVeleroBackup, or the Velero Backup of a NonAdminBackup of namespace, see GetSyncedVeleroBackup
+func GetRestorableVeleroBackup(ctx context.Context, clientInstance client.Client, oadpNamespace string, namespace string, backupName string) (*velerov1.Backup, error) {
+	if veleroBackup, err := GetSharedVeleroBackup(ctx, clientInstance, oadpNamespace, namespace, backupName); err == nil {
+		return veleroBackup, nil
+	}
+	return GetSyncedVeleroBackup(ctx, clientInstance, oadpNamespace, namespace, backupName)
+}
+
+// GetVeleroBackupSourceNamespace returns the backed up namespace of the Velero Backup restored into namespace:
+// the SharedSourceNamespaceAnnotation of a shared Velero Backup, or the NabSourceNamespaceAnnotation of a Velero
+// Backup transferred with its NonAdminBackupStorageLocation, if set, namespace otherwise
+func GetVeleroBackupSourceNamespace(veleroBackup *velerov1.Backup, namespace string) string {
+	sourceNamespaceAnnotation := constant.SharedSourceNamespaceAnnotation
+	if nacmeta.IsManagedByNAC(veleroBackup) {
+		sourceNamespaceAnnotation = constant.NabSourceNamespaceAnnotation
+	}
+	if sourceNamespace := veleroBackup.Annotations[sourceNamespaceAnnotation]; sourceNamespace != constant.EmptyString {
+		return sourceNamespace
+	}
+	return namespace
+}
+
+// validateRestorableVeleroBackup returns nil if the Velero Backup can be restored into namespace; error otherwise
+func validateRestorableVeleroBackup(veleroBackup *velerov1.Backup, namespace string) error {
+	if veleroBackup.Status.Phase != velerov1.BackupPhaseCompleted &&
+		veleroBackup.Status.Phase != velerov1.BackupPhasePartiallyFailed {
+		return errors.New("NonAdminRestore spec.restoreSpec.backupName is invalid: Velero Backup is not ready to be restored")
+	}
+	sourceNamespace := GetVeleroBackupSourceNamespace(veleroBackup, namespace)
+	includedNamespaces := veleroBackup.Spec.IncludedNamespaces
+	if len(includedNamespaces) > 0 && !slices.Contains(includedNamespaces, sourceNamespace) && !slices.Contains(includedNamespaces, "*") {
+		return fmt.Errorf("NonAdminRestore spec.restoreSpec.backupName is invalid: Velero Backup does not include namespace %s", sourceNamespace)
+	}
+	return nil
+}
+
+// GetNamespaceEnforcedRestoreSpec returns the spec enforced by the admin user on the NonAdminRestores of namespace:
+// enforcedRestoreSpec, with the existingResourcePolicy overridden by the EnforcedExistingResourcePolicyAnnotation
+// of namespace, if set
+func GetNamespaceEnforcedRestoreSpec(ctx context.Context, clientInstance client.Client, namespace string, enforcedRestoreSpec *velerov1.RestoreSpec) (*velerov1.RestoreSpec, error) {
+	namespaceObject := &corev1.Namespace{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: namespace}, namespaceObject); err != nil {
+		if apierrors.IsNotFound(err) {
+			return enforcedRestoreSpec, nil
+		}
+		return nil, err
+	}
+	policy, ok := namespaceObject.Annotations[constant.EnforcedExistingResourcePolicyAnnotation]
+	if !ok {
+		return enforcedRestoreSpec, nil
+	}
+	if err := validateExistingResourcePolicy(velerov1.PolicyType(policy)); err != nil {
+		return nil, fmt.Errorf("namespace %s annotation %s is invalid: %v", namespace, constant.EnforcedExistingResourcePolicyAnnotation, err)
+	}
+	namespaceEnforcedRestoreSpec := enforcedRestoreSpec.DeepCopy()
+	namespaceEnforcedRestoreSpec.ExistingResourcePolicy = velerov1.PolicyType(policy)
+	return namespaceEnforcedRestoreSpec, nil
+}
+
+// GetOverriddenEnforcedRestoreSpec returns the spec enforced by the admin user on the NonAdminRestores of namespace:
+// the restore spec of the ConfigMap, in oadpNamespace, with the EnforcedRestoreSpecOverrideLabel set to namespace,
+// if any, which replaces enforcedRestoreSpec but its resourceModifier; enforcedRestoreSpec otherwise
+func GetOverriddenEnforcedRestoreSpec(ctx context.Context, clientInstance client.Client, oadpNamespace string, namespace string, enforcedRestoreSpec *velerov1.RestoreSpec) (*velerov1.RestoreSpec, error) {
+	configMapList := &corev1.ConfigMapList{}
+	if err := clientInstance.List(ctx, configMapList, client.InNamespace(oadpNamespace), client.MatchingLabels{constant.EnforcedRestoreSpecOverrideLabel: namespace}); err != nil {
+		return nil, err
+	}
+	switch len(configMapList.Items) {
+	case 0:
+		return enforcedRestoreSpec, nil
+	case 1:
+	default:
+		return nil, fmt.Errorf("%d ConfigMaps override the enforced restore spec of namespace %s, at most one is allowed", len(configMapList.Items), namespace)
+	}
+	configMap := &configMapList.Items[0]
+	overriddenRestoreSpec, err := parseEnforcedRestoreSpecOverride(configMap)
+	if err != nil {
+		return nil, fmt.Errorf("enforced restore spec override ConfigMap %s is invalid: %v", configMap.Name, err)
+	}
+	if enforcedRestoreSpec != nil {
+		// the enforced resource modifiers are read from the OADP namespace for every namespace
+		overriddenRestoreSpec.ResourceModifier = enforcedRestoreSpec.ResourceModifier
+	}
+	return overriddenRestoreSpec, nil
+}
+
+// parseEnforcedRestoreSpecOverride returns the restore spec of the enforced restore spec override ConfigMap,
+// set in its only data key
+func parseEnforcedRestoreSpecOverride(configMap *corev1.ConfigMap) (*velerov1.RestoreSpec, error) {
+	if len(configMap.Data) != 1 {
+		return nil, errors.New("it must have exactly one data key")
+	}
+	restoreSpec := &velerov1.RestoreSpec{}
+	for _, data := range configMap.Data {
+		if err := yaml.UnmarshalStrict([]byte(data), restoreSpec); err != nil {
+			return nil, err
+		}
+	}
+	if restoreSpec.ResourceModifier != nil {
+		return nil, errors.New("resourceModifier can not be overridden")
+	}
+	return restoreSpec, nil
+}
+
+// GetRestorePriority returns the priority of the NonAdminRestores of namespace, set by the admin user with the
+// RestorePriorityAnnotation of namespace; 0 if it is not set
+func GetRestorePriority(ctx context.Context, clientInstance client.Client, namespace string) (int, error) {
+	namespaceObject := &corev1.Namespace{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: namespace}, namespaceObject); err != nil {
+		if apierrors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	value, ok := namespaceObject.Annotations[constant.RestorePriorityAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	priority, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("namespace %s annotation %s is invalid: %v", namespace, constant.RestorePriorityAnnotation, err)
+	}
+	return priority, nil
+}
+
+// restoreEnforcementRule enforces restore spec fields on the NonAdminRestores of the namespaces its namespaceSelector selects
+type restoreEnforcementRule struct {
+	NamespaceSelector *metav1.LabelSelector        `json:"namespaceSelector"`
+	RestoreSpec       restoreEnforcementRuleFields `json:"restoreSpec"`
+}
+
+// restoreEnforcementRuleFields are the restore spec fields a restoreEnforcementRule may enforce
+type restoreEnforcementRuleFields struct {
+	RestorePVs        *bool                              `json:"restorePVs,omitempty"`
+	PreserveNodePorts *bool                              `json:"preserveNodePorts,omitempty"`
+	UploaderConfig    *velerov1.UploaderConfigForRestore `json:"uploaderConfig,omitempty"`
+}
+
+// GetSelectorEnforcedRestoreSpec returns the spec enforced by the admin user on the NonAdminRestores of namespace:
+// enforcedRestoreSpec, with the fields set by the rules of the configMapName ConfigMap, in oadpNamespace, whose
+// namespaceSelector selects namespace. A later rule overrides the fields set by an earlier one.
+// An empty configMapName returns enforcedRestoreSpec.
+func GetSelectorEnforcedRestoreSpec(ctx context.Context, clientInstance client.Client, oadpNamespace string, configMapName string, namespace string, enforcedRestoreSpec *velerov1.RestoreSpec) (*velerov1.RestoreSpec, error) {
+	if configMapName == constant.EmptyString {
+		return enforcedRestoreSpec, nil
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: configMapName, Namespace: oadpNamespace}, configMap); err != nil {
+		return nil, fmt.Errorf("failed to get restore enforcement ConfigMap %s: %w", configMapName, err)
+	}
+	rules, err := parseRestoreEnforcementRules(configMap)
+	if err != nil {
+		return nil, fmt.Errorf("restore enforcement ConfigMap %s is invalid: %v", configMapName, err)
+	}
+	namespaceObject := &corev1.Namespace{}
+	if err = clientInstance.Get(ctx, types.NamespacedName{Name: namespace}, namespaceObject); err != nil {
+		if apierrors.IsNotFound(err) {
+			return enforcedRestoreSpec, nil
+		}
+		return nil, err
+	}
+
+	selectorEnforcedRestoreSpec := &velerov1.RestoreSpec{}
+	if enforcedRestoreSpec != nil {
+		selectorEnforcedRestoreSpec = enforcedRestoreSpec.DeepCopy()
+	}
+	for _, rule := range rules {
+		selector, _ := metav1.LabelSelectorAsSelector(rule.NamespaceSelector)
+		if !selector.Matches(labels.Set(namespaceObject.Labels)) {
+			continue
+		}
+		if rule.RestoreSpec.RestorePVs != nil {
+			selectorEnforcedRestoreSpec.RestorePVs = rule.RestoreSpec.RestorePVs
+		}
+		if rule.RestoreSpec.PreserveNodePorts != nil {
+			selectorEnforcedRestoreSpec.PreserveNodePorts = rule.RestoreSpec.PreserveNodePorts
+		}
+		if rule.RestoreSpec.UploaderConfig != nil {
+			selectorEnforcedRestoreSpec.UploaderConfig = rule.RestoreSpec.UploaderConfig
+		}
+	}
+	return selectorEnforcedRestoreSpec, nil
+}
+
+// parseRestoreEnforcementRules returns the rules of the restore enforcement ConfigMap, listed in its only data key
+func parseRestoreEnforcementRules(configMap *corev1.ConfigMap) ([]restoreEnforcementRule, error) {
+	if len(configMap.Data) != 1 {
+		return nil, errors.New("it must have exactly one data key")
+	}
+	var rules []restoreEnforcementRule
+	for _, data := range configMap.Data {
+		if err := yaml.UnmarshalStrict([]byte(data), &rules); err != nil {
+			return nil, err
+		}
+	}
+	for index, rule := range rules {
+		if rule.NamespaceSelector == nil {
+			return nil, fmt.Errorf("rule %d namespaceSelector is not set", index)
+		}
+		if _, err := metav1.LabelSelectorAsSelector(rule.NamespaceSelector); err != nil {
+			return nil, fmt.Errorf("rule %d namespaceSelector is invalid: %v", index, err)
+		}
+	}
+	return rules, nil
+}
+
+// MergeEnforcedBackupSpec returns enforcedBackupSpec with the fields set in overridingBackupSpec overridden,
+// field by field, so the fields overridingBackupSpec does not set stay enforced
+func MergeEnforcedBackupSpec(enforcedBackupSpec *velerov1.BackupSpec, overridingBackupSpec *velerov1.BackupSpec) *velerov1.BackupSpec {
+	mergedBackupSpec := &velerov1.BackupSpec{}
+	if enforcedBackupSpec != nil {
+		mergedBackupSpec = enforcedBackupSpec.DeepCopy()
+	}
+	if overridingBackupSpec == nil {
+		return mergedBackupSpec
+	}
+	overridingSpec := reflect.ValueOf(overridingBackupSpec.DeepCopy()).Elem()
+	mergedSpec := reflect.ValueOf(mergedBackupSpec).Elem()
+	for index := range overridingSpec.NumField() {
+		if overridingField := overridingSpec.Field(index); !overridingField.IsZero() {
+			mergedSpec.Field(index).Set(overridingField)
+		}
+	}
+	return mergedBackupSpec
+}
+
+// GetNonAdminControllerConfig returns the NonAdminControllerConfig of the cluster admin, nil if it does not exist
+func GetNonAdminControllerConfig(ctx context.Context, clientInstance client.Client) (*nacv1alpha1.NonAdminControllerConfig, error) {
+	config := &nacv1alpha1.NonAdminControllerConfig{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: constant.NonAdminControllerConfigName}, config); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return config, nil
+}
+
+// GetNamespaceNonAdminPolicy returns the NonAdminPolicy applying to the non admin objects of namespace: the one with
+// the highest priority among the NonAdminPolicies whose namespaceSelector selects namespace, ties are broken by name.
+// nil is returned if no NonAdminPolicy selects namespace.
+func GetNamespaceNonAdminPolicy(ctx context.Context, clientInstance client.Client, namespace string) (*nacv1alpha1.NonAdminPolicy, error) {
+	policyList := &nacv1alpha1.NonAdminPolicyList{}
+	if err := clientInstance.List(ctx, policyList); err != nil {
+		return nil, err
+	}
+	if len(policyList.Items) == 0 {
+		return nil, nil
+	}
+	namespaceObject := &corev1.Namespace{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: namespace}, namespaceObject); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var namespacePolicy *nacv1alpha1.NonAdminPolicy
+	for index := range policyList.Items {
+		policy := &policyList.Items[index]
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("NonAdminPolicy %s namespaceSelector is invalid: %v", policy.Name, err)
+		}
+		if !selector.Matches(labels.Set(namespaceObject.Labels)) {
+			continue
+		}
+		if namespacePolicy == nil || policy.Spec.Priority > namespacePolicy.Spec.Priority ||
+			(policy.Spec.Priority == namespacePolicy.Spec.Priority && policy.Name < namespacePolicy.Name) {
+			namespacePolicy = policy
+		}
+	}
+	return namespacePolicy, nil
+}
+
+// ErrNonAdminQuotaExceeded is wrapped by ValidateNonAdminQuota errors
+var ErrNonAdminQuotaExceeded = errors.New("NonAdminPolicy quota is exceeded")
+
+// ValidateNonAdminQuota returns nil if object fits in the maxObjects quota of its namespace, error wrapping
+// ErrNonAdminQuotaExceeded otherwise. objects are the objects of the same kind in the namespace; only the ones
+// created before object, and not being deleted, count against the quota. A nil maxObjects means no quota.
+func ValidateNonAdminQuota(kind string, object metav1.Object, objects []metav1.Object, maxObjects *int32) error {
+	if maxObjects == nil {
+		return nil
+	}
+	olderObjects := 0
+	for _, other := range objects {
+		if other.GetUID() == object.GetUID() || other.GetDeletionTimestamp() != nil {
+			continue
+		}
+		otherCreation := other.GetCreationTimestamp()
+		objectCreation := object.GetCreationTimestamp()
+		if otherCreation.Before(&objectCreation) ||
+			(otherCreation.Equal(&objectCreation) && other.GetName() < object.GetName()) {
+			olderObjects++
+		}
+	}
+	if olderObjects >= int(*maxObjects) {
+		return fmt.Errorf("%w: namespace %s may have at most %d %s", ErrNonAdminQuotaExceeded, object.GetNamespace(), *maxObjects, kind)
+	}
+	return nil
+}
+
+// bslAutoApprovalRule approves the NonAdminBackupStorageLocations of the namespaces its namespaceSelector selects,
+// whose provider, bucket and prefix match the rule. An empty list matches any value.
+type bslAutoApprovalRule struct {
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"`
+	Providers         []string              `json:"providers,omitempty"`
+	Buckets           []string              `json:"buckets,omitempty"`
+	Prefixes          []string              `json:"prefixes,omitempty"`
+}
+
+// IsBackupStorageLocationAutoApproved returns true if a rule of the configMapName ConfigMap, in oadpNamespace,
+// whose namespaceSelector selects namespace, matches the provider, bucket and prefix of backupStorageLocationSpec.
+// An empty configMapName returns false.
+func IsBackupStorageLocationAutoApproved(ctx context.Context, clientInstance client.Client, oadpNamespace string, configMapName string, namespace string, backupStorageLocationSpec *velerov1.BackupStorageLocationSpec) (bool, error) {
+	if configMapName == constant.EmptyString || backupStorageLocationSpec == nil {
+		return false, nil
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: configMapName, Namespace: oadpNamespace}, configMap); err != nil {
+		return false, fmt.Errorf("failed to get backup storage location auto approval ConfigMap %s: %w", configMapName, err)
+	}
+	rules, err := parseBSLAutoApprovalRules(configMap)
+	if err != nil {
+		return false, fmt.Errorf("backup storage location auto approval ConfigMap %s is invalid: %v", configMapName, err)
+	}
+	namespaceObject := &corev1.Namespace{}
+	if err = clientInstance.Get(ctx, types.NamespacedName{Name: namespace}, namespaceObject); err != nil {
+		return false, err
+	}
+
+	bucket, prefix := constant.EmptyString, constant.EmptyString
+	if backupStorageLocationSpec.ObjectStorage != nil {
+		bucket = backupStorageLocationSpec.ObjectStorage.Bucket
+		prefix = backupStorageLocationSpec.ObjectStorage.Prefix
+	}
+	for _, rule := range rules {
+		selector, _ := metav1.LabelSelectorAsSelector(rule.NamespaceSelector)
+		if selector.Matches(labels.Set(namespaceObject.Labels)) &&
+			(len(rule.Providers) == 0 || slices.Contains(rule.Providers, backupStorageLocationSpec.Provider)) &&
+			matchesAnyPattern(rule.Buckets, bucket) &&
+			matchesAnyPattern(rule.Prefixes, prefix) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchesAnyPattern returns true if patterns is empty or value matches one of its shell patterns
+func matchesAnyPattern(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		// patterns are validated when the rules are parsed
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// parseBSLAutoApprovalRules returns the rules of the backup storage location auto approval ConfigMap, listed in its only data key
+func parseBSLAutoApprovalRules(configMap *corev1.ConfigMap) ([]bslAutoApprovalRule, error) {
+	if len(configMap.Data) != 1 {
+		return nil, errors.New("it must have exactly one data key")
+	}
+	var rules []bslAutoApprovalRule
+	for _, data := range configMap.Data {
+		if err := yaml.UnmarshalStrict([]byte(data), &rules); err != nil {
+			return nil, err
+		}
+	}
+	for index, rule := range rules {
+		if rule.NamespaceSelector == nil {
+			return nil, fmt.Errorf("rule %d namespaceSelector is not set", index)
+		}
+		if _, err := metav1.LabelSelectorAsSelector(rule.NamespaceSelector); err != nil {
+			return nil, fmt.Errorf("rule %d namespaceSelector is invalid: %v", index, err)
+		}
+		for _, pattern := range slices.Concat(rule.Buckets, rule.Prefixes) {
+			if _, err := path.Match(pattern, constant.EmptyString); err != nil {
+				return nil, fmt.Errorf("rule %d pattern %q is invalid: %v", index, pattern, err)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// validateExistingResourcePolicy returns nil if policy is an existingResourcePolicy supported by Velero; error otherwise
+func validateExistingResourcePolicy(policy velerov1.PolicyType) error {
+	if policy != velerov1.PolicyTypeNone && policy != velerov1.PolicyTypeUpdate {
+		return fmt.Errorf("existingResourcePolicy %q must be one of: %s, %s", policy, velerov1.PolicyTypeNone, velerov1.PolicyTypeUpdate)
+	}
+	return nil
+}
+
+// resourceModifiers is the content of a Velero resource modifiers ConfigMap, see
+// https://velero.io/docs/main/restore-resource-modifiers/
+type resourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []resourceModifierRule `json:"resourceModifierRules"`
+}
+
+type resourceModifierRule struct {
+	Conditions       resourceModifierConditions `json:"conditions"`
+	Patches          []resourceModifierPatch    `json:"patches,omitempty"`
+	MergePatches     []resourceModifierData     `json:"mergePatches,omitempty"`
+	StrategicPatches []resourceModifierData     `json:"strategicPatches,omitempty"`
+}
+
+type resourceModifierConditions struct {
+	LabelSelector     *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	GroupResource     string                `json:"groupResource"`
+	ResourceNameRegex string                `json:"resourceNameRegex,omitempty"`
+	Namespaces        []string              `json:"namespaces,omitempty"`
+	Matches           []struct {
+		Path  string `json:"path,omitempty"`
+		Value string `json:"value,omitempty"`
+	} `json:"matches,omitempty"`
+}
+
+type resourceModifierPatch struct {
+	Operation string `json:"operation"`
+	From      string `json:"from,omitempty"`
+	Path      string `json:"path"`
+	Value     string `json:"value,omitempty"`
+}
+
+type resourceModifierData struct {
+	PatchData string `json:"patchData,omitempty"`
+}
+
+// ValidateResourceModifiers returns nil, if the resource modifiers ConfigMap referenced by a NonAdminRestore
+// only modifies objects restored in namespaces; error otherwise.
+// Its rules can not match other namespaces, RBAC resources or resources of any group, and its patches
+// can not change the namespace of the restored objects.
+func ValidateResourceModifiers(configMap *corev1.ConfigMap, namespaces []string) error {
+	const field = "nonAdminRestore.spec.restoreSpec.resourceModifier"
+	if len(configMap.Data) != 1 {
+		return fmt.Errorf("NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap %s must have exactly one data key", configMap.Name)
+	}
+	modifiers := &resourceModifiers{}
+	for _, data := range configMap.Data {
+		if err := yaml.UnmarshalStrict([]byte(data), modifiers); err != nil {
+			return fmt.Errorf("NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap %s is invalid: %v", configMap.Name, err)
+		}
+	}
+	if !strings.EqualFold(modifiers.Version, "v1") {
+		return fmt.Errorf("NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap %s version %q is not supported", configMap.Name, modifiers.Version)
+	}
+	if len(modifiers.ResourceModifierRules) == 0 {
+		return fmt.Errorf("NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap %s has no resourceModifierRules", configMap.Name)
+	}
+
+	for index, rule := range modifiers.ResourceModifierRules {
+		for _, namespace := range rule.Conditions.Namespaces {
+			if !slices.Contains(namespaces, namespace) {
+				return fmt.Errorf(constant.NARRestrictedErr+", resourceModifierRules[%d] can not match namespace %s", field, index, namespace)
+			}
+		}
+		if err := validateResourceModifierGroupResource(rule.Conditions.GroupResource); err != nil {
+			return fmt.Errorf(constant.NARRestrictedErr+", resourceModifierRules[%d] %v", field, index, err)
+		}
+		for _, patch := range rule.Patches {
+			if isNamespacePath(patch.Path) || (patch.From != constant.EmptyString && isNamespacePath(patch.From)) {
+				return fmt.Errorf(constant.NARRestrictedErr+", resourceModifierRules[%d] can not patch %s", field, index, patch.Path)
+			}
+		}
+		for _, patch := range slices.Concat(rule.MergePatches, rule.StrategicPatches) {
+			patchData := map[string]interface{}{}
+			if err := yaml.Unmarshal([]byte(patch.PatchData), &patchData); err != nil {
+				return fmt.Errorf("NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap %s resourceModifierRules[%d] patchData is invalid: %v", configMap.Name, index, err)
+			}
+			if metadata, ok := patchData["metadata"].(map[string]interface{}); ok {
+				if _, ok := metadata["namespace"]; ok {
+					return fmt.Errorf(constant.NARRestrictedErr+", resourceModifierRules[%d] can not patch metadata.namespace", field, index)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateResourceModifierGroupResource returns nil, if the groupResource glob of a resource modifier rule
+// can not match RBAC resources; error otherwise. Velero compiles the glob with '.' as separator, so '*' and
+// '?' can not match across the group of the resources.
+func validateResourceModifierGroupResource(groupResource string) error {
+	if strings.ContainsAny(groupResource, "[]{}\\!") || strings.Contains(groupResource, "**") {
+		return fmt.Errorf("groupResource %s can only use * and ? wildcards", groupResource)
+	}
+	_, group, _ := strings.Cut(groupResource, ".")
+	if strings.ContainsAny(group, "*?") {
+		return fmt.Errorf("groupResource %s can not use wildcards in its group", groupResource)
+	}
+	if strings.EqualFold(group, rbacv1.GroupName) {
+		return fmt.Errorf("groupResource %s can not match RBAC resources", groupResource)
+	}
+	return nil
+}
+
+// isNamespacePath returns true if the JSON patch path is, or contains, the namespace of the patched object
+func isNamespacePath(path string) bool {
+	const namespacePath = "/metadata/namespace"
+	return path == namespacePath || strings.HasPrefix(namespacePath, path+"/") || strings.HasPrefix(path, namespacePath+"/")
+}
+
+// validateResourceModifier returns nil, if the resource modifiers ConfigMap referenced by the NonAdminRestore
+// exists in its namespace and is valid, see ValidateResourceModifiers; error otherwise
+func validateResourceModifier(ctx context.Context, clientInstance client.Client, nonAdminRestore *nacv1alpha1.NonAdminRestore) error {
+	resourceModifier := nonAdminRestore.Spec.RestoreSpec.ResourceModifier
+	if !strings.EqualFold(resourceModifier.Kind, constant.ResourceModifierConfigMapKind) {
+		return fmt.Errorf(constant.NARRestrictedErr+", kind must be %s", "nonAdminRestore.spec.restoreSpec.resourceModifier", constant.ResourceModifierConfigMapKind)
+	}
+	configMap := &corev1.ConfigMap{}
+	err := clientInstance.Get(ctx, types.NamespacedName{Name: resourceModifier.Name, Namespace: nonAdminRestore.Namespace}, configMap)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap %s not found in the namespace", resourceModifier.Name)
+	} else if err != nil {
+		return fmt.Errorf("NonAdminRestore spec.restoreSpec.resourceModifier is invalid: %v", err)
+	}
+	return ValidateResourceModifiers(configMap, GetResourceModifierNamespaces(nonAdminRestore))
+}
+
+// GetResourceModifierNamespaces returns the namespaces the resource modifiers of the NonAdminRestore can match:
+// its namespace and the namespace it is mapped to
+func GetResourceModifierNamespaces(nonAdminRestore *nacv1alpha1.NonAdminRestore) []string {
+	namespaces := []string{nonAdminRestore.Namespace}
+	for _, target := range nonAdminRestore.Spec.RestoreSpec.NamespaceMapping {
+		if !slices.Contains(namespaces, target) {
+			namespaces = append(namespaces, target)
+		}
+	}
+	return namespaces
+}
+
+// ValidateRestoreSpec return nil, if NonAdminRestore is valid; error otherwise.
+// spec.restoreSpec.backupName is a NonAdminBackup in the NonAdminRestore namespace, a completed NonAdminBackup in
+// spec.backupNamespace shared with it, see GetSharedNonAdminBackup, or a Velero Backup it can restore without one,
+// see GetRestorableVeleroBackup. With spec.waitForBackupCompletion the NonAdminBackup
+// may not be processed yet.
+// If allowNamespaceMapping is true, spec.restoreSpec.namespaceMapping may map the NonAdminRestore namespace
+// to a namespace where the NonAdminRestore requester is allowed to create NonAdminRestores
+func ValidateRestoreSpec(ctx context.Context, clientInstance client.Client, oadpNamespace string, nonAdminRestore *nacv1alpha1.NonAdminRestore, enforcedRestoreSpec *velerov1.RestoreSpec, allowNamespaceMapping bool) error {
+	if len(nonAdminRestore.Spec.RestoreSpec.ScheduleName) > 0 {
+		return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.scheduleName")
+	}
+
+	if nonAdminRestore.Spec.RestoreSpec.BackupName == constant.EmptyString {
+		return errors.New("NonAdminRestore spec.restoreSpec.backupName is not set")
+	}
+
+	nab := &nacv1alpha1.NonAdminBackup{}
+	var err error
+	if IsSharedNonAdminBackupRestore(nonAdminRestore) {
+		nab, err = GetSharedNonAdminBackup(ctx, clientInstance, nonAdminRestore)
+		if err == nil && nab.Status.Phase != nacv1alpha1.NonAdminPhaseCompleted &&
+			nab.Status.Phase != nacv1alpha1.NonAdminPhasePartiallyFailed {
+			return errors.New("NonAdminRestore spec.restoreSpec.backupName is invalid: shared NonAdminBackup is not completed")
+		}
+	} else {
+		err = clientInstance.Get(ctx, types.NamespacedName{
+			Name:      nonAdminRestore.Spec.RestoreSpec.BackupName,
+			Namespace: nonAdminRestore.Namespace,
+		}, nab)
+		if apierrors.IsNotFound(err) {
+			if veleroBackup, restorableErr := GetRestorableVeleroBackup(ctx, clientInstance, oadpNamespace, nonAdminRestore.Namespace, nonAdminRestore.Spec.RestoreSpec.BackupName); restorableErr == nil {
+				if err = validateRestorableVeleroBackup(veleroBackup, nonAdminRestore.Namespace); err != nil {
+					return err
+				}
+				nab = nil
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("NonAdminRestore spec.restoreSpec.backupName is invalid: %v", err)
+	}
+	// TODO better way to check readiness? simplify and ask user to pass velero backup name? (user has access to this info in nonAdminBackup status)
+	if nab != nil && !(nonAdminRestore.Spec.WaitForBackupCompletion &&
+		(nab.Status.Phase == constant.EmptyString || nab.Status.Phase == nacv1alpha1.NonAdminPhaseNew)) &&
+		nab.Status.Phase != nacv1alpha1.NonAdminPhaseCreated &&
+		nab.Status.Phase != nacv1alpha1.NonAdminPhaseCompleted &&
+		nab.Status.Phase != nacv1alpha1.NonAdminPhasePartiallyFailed {
+		return errors.New("NonAdminRestore spec.restoreSpec.backupName is invalid: NonAdminBackup is not ready to be restored")
+	}
+	// TODO validate that velero backup exists?
+	// TODO does velero validate if backup is ready to be restored?
+	// Issue link: https://github.com/migtools/oadp-non-admin/issues/225
+
+	if nonAdminRestore.Spec.RestoreSpec.IncludedNamespaces != nil {
+		return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.includedNamespaces")
+	}
+
+	if nonAdminRestore.Spec.RestoreSpec.ExcludedNamespaces != nil {
+		return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.excludedNamespaces")
+	}
+
+	if nonAdminRestore.Spec.RestoreSpec.NamespaceMapping != nil && !IsRestoreVerificationNamespaceMapping(ctx, clientInstance, nonAdminRestore) {
+		if !allowNamespaceMapping {
+			return fmt.Errorf(constant.NARRestrictedErr, "nonAdminRestore.spec.restoreSpec.namespaceMapping")
+		}
+		if err := CheckRequesterCanRestoreNamespaceMapping(ctx, clientInstance, nonAdminRestore); err != nil {
+			return fmt.Errorf("%w: %v", ErrNamespaceMappingRejected, err)
+		}
+	}
+
+	if nonAdminRestore.Spec.RestoreSpec.ResourceModifier != nil && enforcedRestoreSpec.ResourceModifier == nil {
+		if err := validateResourceModifier(ctx, clientInstance, nonAdminRestore); err != nil {
+			return err
+		}
+	}
+
+	if policy := nonAdminRestore.Spec.RestoreSpec.ExistingResourcePolicy; policy != constant.EmptyString {
+		if err := validateExistingResourcePolicy(policy); err != nil {
+			return fmt.Errorf("NonAdminRestore spec.restoreSpec.existingResourcePolicy is invalid: %v", err)
+		}
+	}
+
+	enforcedRestoreSpec, err = GetNamespaceEnforcedRestoreSpec(ctx, clientInstance, nonAdminRestore.Namespace, enforcedRestoreSpec)
+	if err != nil {
+		return err
+	}
+	enforcedSpec := reflect.ValueOf(enforcedRestoreSpec).Elem()
+	for index := range enforcedSpec.NumField() {
+		enforcedField := enforcedSpec.Field(index)
+		enforcedFieldName := enforcedSpec.Type().Field(index).Name
+		currentField := reflect.ValueOf(nonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(enforcedFieldName)
+		if !enforcedField.IsZero() && !currentField.IsZero() && !reflect.DeepEqual(enforcedField.Interface(), currentField.Interface()) {
+			field, _ := reflect.TypeOf(nonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(enforcedFieldName)
+			tagName, _, _ := strings.Cut(field.Tag.Get(constant.JSONTagString), constant.CommaString)
+			return fmt.Errorf(
+				"the administrator has restricted spec.restoreSpec.%v field value to %v",
+				tagName,
+				reflect.Indirect(enforcedField),
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetDefaultNonAdminBackupStorageLocation returns the NonAdminBackupStorageLocation of the namespace with spec.default set,
+// or nil if there is none. If several are set, the oldest one is the default.
+func GetDefaultNonAdminBackupStorageLocation(ctx context.Context, clientInstance client.Client, namespace string) (*nacv1alpha1.NonAdminBackupStorageLocation, error) {
+	nonAdminBslList := &nacv1alpha1.NonAdminBackupStorageLocationList{}
+	if err := clientInstance.List(ctx, nonAdminBslList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	var defaultNonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation
+	for index := range nonAdminBslList.Items {
+		nonAdminBsl := &nonAdminBslList.Items[index]
+		if !nonAdminBsl.Spec.Default || nonAdminBsl.DeletionTimestamp != nil {
+			continue
+		}
+		if defaultNonAdminBsl == nil ||
+			nonAdminBsl.CreationTimestamp.Before(&defaultNonAdminBsl.CreationTimestamp) ||
+			(nonAdminBsl.CreationTimestamp.Equal(&defaultNonAdminBsl.CreationTimestamp) && nonAdminBsl.Name < defaultNonAdminBsl.Name) {
+			defaultNonAdminBsl = nonAdminBsl
+		}
+	}
+	return defaultNonAdminBsl, nil
+}
+
+// ValidateBslSpec return nil, if NonAdminBackupStorageLocation is valid; error otherwise
+func ValidateBslSpec(ctx context.Context, clientInstance client.Client, nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation, enforcedBSLSpec *oadpv1alpha1.EnforceBackupStorageLocationSpec, appliedBackupSyncPeriod time.Duration, defaultBackupSyncPeriod *time.Duration) error {
+	if nonAdminBsl.Spec.BackupStorageLocationSpec.Default {
+		return errors.New("NonAdminBackupStorageLocation cannot be used as a default BSL")
+	}
+	if nonAdminBsl.Spec.Default {
+		defaultNonAdminBsl, err := GetDefaultNonAdminBackupStorageLocation(ctx, clientInstance, nonAdminBsl.Namespace)
+		if err != nil {
+			return fmt.Errorf("unable to get the default NonAdminBackupStorageLocation of the namespace: %v", err)
+		}
+		if defaultNonAdminBsl != nil && defaultNonAdminBsl.Name != nonAdminBsl.Name {
+			return fmt.Errorf("NonAdminBackupStorageLocation spec.default can only be set once per namespace, %s is already the default", defaultNonAdminBsl.Name)
+		}
+	}
+	if err := validateBslAccessMode(nonAdminBsl, enforcedBSLSpec); err != nil {
+		return err
+	}
+	if err := validateBslObjectLock(nonAdminBsl, enforcedBSLSpec); err != nil {
+		return err
+	}
+	if nonAdminBsl.Spec.CloudIdentity != nil {
+		if nonAdminBsl.Spec.BackupStorageLocationSpec.Credential != nil {
+			return errors.New("NonAdminBackupStorageLocation spec.cloudIdentity and spec.backupStorageLocationSpec.credential can not be both set")
+		}
+		if nonAdminBsl.Spec.Credential != nil {
+			return errors.New("NonAdminBackupStorageLocation spec.cloudIdentity and spec.credential can not be both set")
+		}
+		if err := ValidateBslCloudIdentity(nonAdminBsl.Spec.CloudIdentity, nonAdminBsl.Spec.BackupStorageLocationSpec.Provider); err != nil {
+			return err
+		}
+	} else if nonAdminBsl.Spec.BackupStorageLocationSpec.Credential == nil {
+		return errors.New("NonAdminBackupStorageLocation spec.bslSpec.credential is not set")
+	} else if err := validateBslCredentialProfile(nonAdminBsl); err != nil {
+		return err
+	} else if nonAdminBsl.Spec.BackupStorageLocationSpec.Credential.Name == constant.EmptyString || nonAdminBsl.Spec.BackupStorageLocationSpec.Credential.Key == constant.EmptyString {
+		return errors.New("NonAdminBackupStorageLocation spec.bslSpec.credential.name or spec.bslSpec.credential.key is not set")
+	}
+	bslSyncPeriodErrorMessage := "NABSL spec.backupStorageLocationSpec.backupSyncPeriod (%v) can not be greater or equal non admin backupSyncPeriod (%v)"
+	if nonAdminBsl.Spec.BackupStorageLocationSpec.BackupSyncPeriod != nil {
+		if appliedBackupSyncPeriod <= nonAdminBsl.Spec.BackupStorageLocationSpec.BackupSyncPeriod.Duration {
+			return fmt.Errorf(
+				bslSyncPeriodErrorMessage,
+				nonAdminBsl.Spec.BackupStorageLocationSpec.BackupSyncPeriod.Duration, appliedBackupSyncPeriod,
+			)
+		}
+	} else {
+		if defaultBackupSyncPeriod != nil {
+			if appliedBackupSyncPeriod <= *defaultBackupSyncPeriod {
+				return fmt.Errorf(
+					bslSyncPeriodErrorMessage,
+					defaultBackupSyncPeriod, appliedBackupSyncPeriod,
+				)
+			}
+		} else {
+			// https://github.com/vmware-tanzu/velero/blob/9295be4cc061038b91b7bfaf55d99e9bc9dcf0af/pkg/cmd/server/config/config.go#L24
+			if appliedBackupSyncPeriod <= time.Minute {
+				return fmt.Errorf(
+					bslSyncPeriodErrorMessage,
+					time.Minute, appliedBackupSyncPeriod,
+				)
+			}
+		}
+	}
+
+	nonAdminBslSpec := reflect.ValueOf(nonAdminBsl.Spec.BackupStorageLocationSpec).Elem()
+	enforcedSpec := reflect.ValueOf(enforcedBSLSpec).Elem()
+	for index := range enforcedSpec.NumField() {
+		enforcedField := enforcedSpec.Field(index)
+		enforcedFieldName := enforcedSpec.Type().Field(index).Name
+		currentField := nonAdminBslSpec.FieldByName(enforcedFieldName)
+		switch enforcedFieldName {
+		case "Config":
+			// The enforced config keys are merged with the other keys set by the user
+			for _, key := range slices.Sorted(maps.Keys(enforcedBSLSpec.Config)) {
+				if value, ok := nonAdminBsl.Spec.BackupStorageLocationSpec.Config[key]; ok && value != enforcedBSLSpec.Config[key] {
+					return fmt.Errorf("the administrator has restricted spec.backupStorageLocationSpec.config.%s field to: %s", key, enforcedBSLSpec.Config[key])
+				}
+			}
+		case "StorageType":
+			enforcedStorageType := compareStorageTypes(enforcedField, currentField)
+			if enforcedStorageType != constant.EmptyString {
 				return fmt.Errorf("the administrator has restricted spec.backupStorageLocationSpec.objectStorage field value(s) to: %v", enforcedStorageType)
 			}
 		default:
@@ -308,103 +1840,736 @@ func ValidateBslSpec(ctx context.Context, clientInstance client.Client, nonAdmin
 	}
 
 	// Check if the secret exists in the same namespace
-	secret := &corev1.Secret{}
+	if nonAdminBsl.Spec.CloudIdentity == nil {
+		secret := &corev1.Secret{}
+		if err := clientInstance.Get(ctx, types.NamespacedName{
+			Namespace: nonAdminBsl.Namespace,
+			Name:      nonAdminBsl.Spec.BackupStorageLocationSpec.Credential.Name,
+		}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("BSL credentials secret not found: %v", err)
+			}
+			return fmt.Errorf("failed to get BSL credentials secret: %v", err)
+		}
+		if err := ValidateBslCredentialSecret(nonAdminBsl.Spec.BackupStorageLocationSpec, GetBslCredentialProfile(nonAdminBsl), secret); err != nil {
+			return err
+		}
+	}
+
+	if nonAdminBsl.Spec.CACertConfigMap != nil {
+		if nonAdminBsl.Spec.BackupStorageLocationSpec.ObjectStorage != nil && len(nonAdminBsl.Spec.BackupStorageLocationSpec.ObjectStorage.CACert) > 0 {
+			return errors.New("NonAdminBackupStorageLocation spec.caCertConfigMap and spec.backupStorageLocationSpec.objectStorage.caCert can not be both set")
+		}
+		if _, err := GetBslCACert(ctx, clientInstance, nonAdminBsl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateBslAccessMode returns an error if the NonAdminBackupStorageLocation spec.accessMode conflicts with its
+// spec.backupStorageLocationSpec.accessMode or the one enforced by the administrator, or if a read-only
+// NonAdminBackupStorageLocation is the default one, used by NonAdminBackups.
+func validateBslAccessMode(nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation, enforcedBSLSpec *oadpv1alpha1.EnforceBackupStorageLocationSpec) error {
+	accessMode := nonAdminBsl.Spec.AccessMode
+	bslAccessMode := nonAdminBsl.Spec.BackupStorageLocationSpec.AccessMode
+	if accessMode != constant.EmptyString && bslAccessMode != constant.EmptyString && accessMode != bslAccessMode {
+		return errors.New("NonAdminBackupStorageLocation spec.accessMode and spec.backupStorageLocationSpec.accessMode can not differ")
+	}
+	if accessMode == velerov1.BackupStorageLocationAccessModeReadWrite && enforcedBSLSpec != nil &&
+		enforcedBSLSpec.AccessMode == velerov1.BackupStorageLocationAccessModeReadOnly {
+		return fmt.Errorf("the administrator has restricted spec.accessMode field to: %s", velerov1.BackupStorageLocationAccessModeReadOnly)
+	}
+	if nonAdminBsl.Spec.Default && (accessMode == velerov1.BackupStorageLocationAccessModeReadOnly ||
+		bslAccessMode == velerov1.BackupStorageLocationAccessModeReadOnly) {
+		return errors.New("NonAdminBackupStorageLocation spec.default can not be set on a ReadOnly NonAdminBackupStorageLocation")
+	}
+	return nil
+}
+
+// awsChecksumAlgorithmConfigKey is the backup storage location config of the aws provider selecting the checksum
+// algorithm of the uploaded objects, an empty value disables the checksums
+const awsChecksumAlgorithmConfigKey = "checksumAlgorithm"
+
+// validateBslObjectLock returns an error if the NonAdminBackupStorageLocation declares an S3 Object Lock bucket while
+// its provider is not aws, its retention period is not positive, or its config, merged with the enforced one, disables
+// the checksums S3 requires to upload objects to such a bucket
+func validateBslObjectLock(nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation, enforcedBSLSpec *oadpv1alpha1.EnforceBackupStorageLocationSpec) error {
+	objectLock := nonAdminBsl.Spec.ObjectLock
+	if objectLock == nil {
+		return nil
+	}
+	bslSpec := nonAdminBsl.Spec.BackupStorageLocationSpec
+	if strings.TrimPrefix(bslSpec.Provider, veleroProviderPrefix) != "aws" {
+		return fmt.Errorf("NonAdminBackupStorageLocation spec.objectLock is not supported by provider %s, only by aws", bslSpec.Provider)
+	}
+	if objectLock.Mode != nacv1alpha1.ObjectLockModeGovernance && objectLock.Mode != nacv1alpha1.ObjectLockModeCompliance {
+		return fmt.Errorf("NonAdminBackupStorageLocation spec.objectLock.mode must be one of: %s, %s",
+			nacv1alpha1.ObjectLockModeGovernance, nacv1alpha1.ObjectLockModeCompliance)
+	}
+	if objectLock.RetentionPeriod.Duration <= 0 {
+		return errors.New("NonAdminBackupStorageLocation spec.objectLock.retentionPeriod must be positive")
+	}
+	checksumAlgorithm, ok := bslSpec.Config[awsChecksumAlgorithmConfigKey]
+	if enforcedChecksumAlgorithm, enforced := enforcedBSLSpec.Config[awsChecksumAlgorithmConfigKey]; enforced {
+		checksumAlgorithm, ok = enforcedChecksumAlgorithm, true
+	}
+	if ok && checksumAlgorithm == constant.EmptyString {
+		return fmt.Errorf("NonAdminBackupStorageLocation spec.objectLock requires checksums, spec.backupStorageLocationSpec.config.%s can not be empty",
+			awsChecksumAlgorithmConfigKey)
+	}
+	return nil
+}
+
+// validateBackupObjectLockTTL returns an error if the NonAdminBackup, using a NonAdminBackupStorageLocation with
+// an S3 Object Lock bucket, expires before the retention of its objects ends, so Velero could not delete them
+func validateBackupObjectLockTTL(backupSpec *velerov1.BackupSpec, enforcedBackupSpec *velerov1.BackupSpec, nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation) error {
+	if nonAdminBsl.Spec.ObjectLock == nil {
+		return nil
+	}
+	ttl := backupSpec.TTL
+	if ttl.Duration == 0 {
+		ttl = enforcedBackupSpec.TTL
+	}
+	if ttl.Duration > 0 && ttl.Duration < nonAdminBsl.Spec.ObjectLock.RetentionPeriod.Duration {
+		return fmt.Errorf("NonAdminBackup spec.backupSpec.ttl (%v) can not be lower than the objectLock retentionPeriod (%v) of NonAdminBackupStorageLocation %s",
+			ttl.Duration, nonAdminBsl.Spec.ObjectLock.RetentionPeriod.Duration, nonAdminBsl.Name)
+	}
+	return nil
+}
+
+// GetBslCACert returns the CA bundle of the NonAdminBackupStorageLocation spec.caCertConfigMap, nil if it is not set
+func GetBslCACert(ctx context.Context, clientInstance client.Client, nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation) ([]byte, error) {
+	caCertConfigMap := nonAdminBsl.Spec.CACertConfigMap
+	if caCertConfigMap == nil {
+		return nil, nil
+	}
+	if caCertConfigMap.Name == constant.EmptyString || caCertConfigMap.Key == constant.EmptyString {
+		return nil, errors.New("NonAdminBackupStorageLocation spec.caCertConfigMap.name or spec.caCertConfigMap.key is not set")
+	}
+	configMap := &corev1.ConfigMap{}
 	if err := clientInstance.Get(ctx, types.NamespacedName{
 		Namespace: nonAdminBsl.Namespace,
-		Name:      nonAdminBsl.Spec.BackupStorageLocationSpec.Credential.Name,
-	}, secret); err != nil {
+		Name:      caCertConfigMap.Name,
+	}, configMap); err != nil {
 		if apierrors.IsNotFound(err) {
-			return fmt.Errorf("BSL credentials secret not found: %v", err)
+			return nil, fmt.Errorf("BSL CA bundle ConfigMap not found: %v", err)
+		}
+		return nil, fmt.Errorf("failed to get BSL CA bundle ConfigMap: %v", err)
+	}
+	if caCert, ok := configMap.Data[caCertConfigMap.Key]; ok && caCert != constant.EmptyString {
+		return []byte(caCert), nil
+	}
+	if caCert, ok := configMap.BinaryData[caCertConfigMap.Key]; ok && len(caCert) > 0 {
+		return caCert, nil
+	}
+	return nil, fmt.Errorf("BSL CA bundle ConfigMap %s has no %s key", caCertConfigMap.Name, caCertConfigMap.Key)
+}
+
+func formatCredentialToString(credential *corev1.SecretKeySelector) string {
+	if credential == nil {
+		return constant.EmptyString
+	}
+	return fmt.Sprintf("name: %s, key: %s", credential.Name, credential.Key)
+}
+
+func formatMapToString(value any) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	if m, ok := value.(map[string]string); ok {
+		var parts []string
+		for k, v := range m {
+			parts = append(parts, fmt.Sprintf("%s: %s", k, v))
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func compareStorageTypes(enforcedStorageType, currentStorageType reflect.Value) string {
+	if enforcedStorageType.Kind() == reflect.Struct && currentStorageType.Kind() == reflect.Struct {
+		enforcedStorage, ok1 := enforcedStorageType.Interface().(oadpv1alpha1.StorageType)
+		currentStorage, ok2 := currentStorageType.Interface().(velerov1.StorageType)
+
+		if ok1 && ok2 {
+			if enforcedStorage.ObjectStorage != nil && currentStorage.ObjectStorage != nil {
+				if enforcedStorage.ObjectStorage.Bucket != currentStorage.ObjectStorage.Bucket ||
+					enforcedStorage.ObjectStorage.Prefix != currentStorage.ObjectStorage.Prefix ||
+					string(enforcedStorage.ObjectStorage.CACert) != string(currentStorage.ObjectStorage.CACert) {
+					return fmt.Sprintf("bucket: %s, prefix: %s, caCert: %s",
+						enforcedStorage.ObjectStorage.Bucket,
+						enforcedStorage.ObjectStorage.Prefix,
+						string(enforcedStorage.ObjectStorage.CACert))
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// IsVeleroObjectCreatedPhase returns true if the NonAdmin phase means that the related
+// Velero object was created, whether it is still running or already finished.
+func IsVeleroObjectCreatedPhase(phase nacv1alpha1.NonAdminPhase) bool {
+	switch phase {
+	case nacv1alpha1.NonAdminPhaseCreated,
+		nacv1alpha1.NonAdminPhaseCompleted,
+		nacv1alpha1.NonAdminPhasePartiallyFailed,
+		nacv1alpha1.NonAdminPhaseFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// GenerateNacObjectUUID generates a unique name based on the provided namespace and object origin name.
+// It includes a UUID suffix. If the name exceeds the maximum length, it truncates nacName first, then namespace.
+func GenerateNacObjectUUID(namespace, nacName string) string {
+	// Generate UUID suffix
+	uuidSuffix := uuid.New().String()
+
+	// Build the initial name based on the presence of namespace and nacName
+	nacObjectName := uuidSuffix
+	if len(nacName) > 0 {
+		nacObjectName = nacName + constant.NameDelimiter + nacObjectName
+	}
+	if len(namespace) > 0 {
+		nacObjectName = namespace + constant.NameDelimiter + nacObjectName
+	}
+
+	if len(nacObjectName) > constant.MaximumNacObjectNameLength {
+		// Calculate remaining length after UUID
+		remainingLength := constant.MaximumNacObjectNameLength - len(uuidSuffix)
+
+		delimeterLength := len(constant.NameDelimiter)
+
+		// Subtract two delimiter lengths to avoid a corner case where the namespace
+		// and delimiters leave no space for any part of nabName
+		if len(namespace) > remainingLength-delimeterLength-delimeterLength {
+			namespace = namespace[:remainingLength-delimeterLength-delimeterLength]
+			nacObjectName = namespace + constant.NameDelimiter + uuidSuffix
+		} else {
+			remainingLength = remainingLength - len(namespace) - delimeterLength - delimeterLength
+			nacName = nacName[:remainingLength]
+			nacObjectName = uuidSuffix
+			if len(nacName) > 0 {
+				nacObjectName = nacName + constant.NameDelimiter + nacObjectName
+			}
+			if len(namespace) > 0 {
+				nacObjectName = namespace + constant.NameDelimiter + nacObjectName
+			}
+		}
+	}
+
+	return nacObjectName
+}
+
+// ValidateNacObjectNameTemplate returns an error if the name template uses unknown placeholders,
+// does not contain the {uuid} or {shortuuid} placeholder, or renders an invalid object name.
+func ValidateNacObjectNameTemplate(template string) error {
+	if !strings.Contains(template, constant.NameTemplateUUID) && !strings.Contains(template, constant.NameTemplateShortUUID) {
+		return fmt.Errorf("name template %q must contain %s or %s placeholder", template, constant.NameTemplateUUID, constant.NameTemplateShortUUID)
+	}
+	rendered := renderNacObjectNameTemplate(template, "namespace", "name", uuid.New().String())
+	if strings.ContainsAny(rendered, "{}") {
+		return fmt.Errorf("name template %q contains unknown placeholder, allowed placeholders are %s, %s, %s and %s",
+			template, constant.NameTemplateNamespace, constant.NameTemplateName, constant.NameTemplateUUID, constant.NameTemplateShortUUID)
+	}
+	if errs := validation.IsDNS1123Label(rendered); len(errs) > 0 {
+		return fmt.Errorf("name template %q renders invalid name %q: %s", template, rendered, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// RenderNacObjectName returns the name of the Velero object of a NAC object from the name template.
+// The NACUUID is returned if the template is empty or if the rendered name is not a valid label value,
+// for example because the NAC object namespace and name are too long.
+func RenderNacObjectName(template, namespace, nacName, nacUUID string) string {
+	if template == constant.EmptyString {
+		return nacUUID
+	}
+	rendered := renderNacObjectNameTemplate(template, namespace, nacName, nacUUID)
+	if len(validation.IsDNS1123Label(rendered)) > 0 {
+		return nacUUID
+	}
+	return rendered
+}
+
+func renderNacObjectNameTemplate(template, namespace, nacName, nacUUID string) string {
+	uuidPart := nacUUID
+	if len(uuidPart) > constant.UUIDStringLength {
+		uuidPart = uuidPart[len(uuidPart)-constant.UUIDStringLength:]
+	}
+	shortUUID := uuidPart
+	if len(shortUUID) > constant.ShortUUIDLength {
+		shortUUID = shortUUID[:constant.ShortUUIDLength]
+	}
+	return strings.NewReplacer(
+		constant.NameTemplateNamespace, namespace,
+		constant.NameTemplateName, nacName,
+		constant.NameTemplateUUID, uuidPart,
+		constant.NameTemplateShortUUID, shortUUID,
+	).Replace(template)
+}
+
+// veleroProviderPrefix is the optional prefix of the Velero BackupStorageLocation providers maintained by Velero
+const veleroProviderPrefix = "velero.io/"
+
+// s3URLConfigKey is the Velero BackupStorageLocation config key of the endpoint of S3-compatible object storage
+const s3URLConfigKey = "s3Url"
+
+// ErrBslProviderNotAllowed is wrapped by ValidateBslProvider errors caused by providers or S3 endpoints the
+// administrator does not allow
+var ErrBslProviderNotAllowed = errors.New("NonAdminBackupStorageLocation provider is not allowed")
+
+// ErrBslCredentialsInvalid is wrapped by ValidateBslCredentialSecret errors caused by credential Secrets
+// not matching the format the backup storage location provider expects
+var ErrBslCredentialsInvalid = errors.New("NonAdminBackupStorageLocation credentials are invalid")
+
+// awsProfileConfigKey is the backup storage location config selecting the profile of the AWS credentials file
+const awsProfileConfigKey = "profile"
+
+// GetBslCredentialProfile returns the profile of the credentials file selected by the NonAdminBackupStorageLocation
+// spec.credential, empty if it does not select one
+func GetBslCredentialProfile(nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation) string {
+	if nonAdminBsl.Spec.Credential == nil {
+		return constant.EmptyString
+	}
+	return nonAdminBsl.Spec.Credential.Profile
+}
+
+// validateBslCredentialProfile returns an error if the NonAdminBackupStorageLocation selects a profile of its credentials
+// file while its provider has no profiles, or while the profile is also selected by the backup storage location config
+func validateBslCredentialProfile(nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation) error {
+	if nonAdminBsl.Spec.Credential == nil {
+		return nil
+	}
+	if nonAdminBsl.Spec.Credential.Profile == constant.EmptyString {
+		return errors.New("NonAdminBackupStorageLocation spec.credential.profile is not set")
+	}
+	if strings.TrimPrefix(nonAdminBsl.Spec.BackupStorageLocationSpec.Provider, veleroProviderPrefix) != "aws" {
+		return fmt.Errorf("NonAdminBackupStorageLocation spec.credential.profile is not supported by provider %s, only by aws",
+			nonAdminBsl.Spec.BackupStorageLocationSpec.Provider)
+	}
+	if _, ok := nonAdminBsl.Spec.BackupStorageLocationSpec.Config[awsProfileConfigKey]; ok {
+		return fmt.Errorf("NonAdminBackupStorageLocation spec.credential.profile and spec.backupStorageLocationSpec.config.%s can not be both set",
+			awsProfileConfigKey)
+	}
+	return nil
+}
+
+// ValidateBslCredentialSecret returns an error if the credential Secret of the backup storage location misses its key,
+// or if the credentials file does not have the format its provider expects. With a credentialProfile, its profile must
+// be renderable by RenderBslCredentialProfile. Errors never contain the Secret data.
+func ValidateBslCredentialSecret(bslSpec *velerov1.BackupStorageLocationSpec, credentialProfile string, secret *corev1.Secret) error {
+	credentials, ok := secret.Data[bslSpec.Credential.Key]
+	if !ok {
+		return fmt.Errorf("%w, secret %s is missing key %s", ErrBslCredentialsInvalid, secret.Name, bslSpec.Credential.Key)
+	}
+	if len(bytes.TrimSpace(credentials)) == 0 {
+		return fmt.Errorf("%w, key %s of secret %s is empty", ErrBslCredentialsInvalid, bslSpec.Credential.Key, secret.Name)
+	}
+
+	var err error
+	switch strings.TrimPrefix(bslSpec.Provider, veleroProviderPrefix) {
+	case "aws":
+		profile := credentialProfile
+		if profile == constant.EmptyString {
+			profile = bslSpec.Config[awsProfileConfigKey]
+		}
+		if profile == constant.EmptyString {
+			profile = "default"
+		}
+		err = validateAWSCredentials(credentials, profile)
+		if err == nil && credentialProfile != constant.EmptyString {
+			_, err = RenderBslCredentialProfile(credentials, credentialProfile)
+		}
+	case "azure":
+		err = validateAzureCredentials(credentials)
+	case "gcp":
+		err = validateGCPCredentials(credentials)
+	}
+	if err != nil {
+		return fmt.Errorf("%w, key %s of secret %s: %v", ErrBslCredentialsInvalid, bslSpec.Credential.Key, secret.Name, err)
+	}
+	return nil
+}
+
+// awsCredentialsAllowedKeys are the keys the profiles of an AWS shared credentials file may set. The file is used by
+// the Velero server, so keys running a command or using another identity, like credential_process, role_arn,
+// credential_source, web_identity_token_file or source_profile, are not allowed.
+var awsCredentialsAllowedKeys = []string{"aws_access_key_id", "aws_secret_access_key", "aws_session_token", "region"}
+
+// validateAWSCredentialsKey returns an error if the key of the AWS credentials profile is not allowed
+func validateAWSCredentialsKey(profile string, key string) error {
+	if slices.Contains(awsCredentialsAllowedKeys, key) {
+		return nil
+	}
+	return fmt.Errorf("AWS credentials profile %s sets %s, only %s are allowed", profile, key, strings.Join(awsCredentialsAllowedKeys, ", "))
+}
+
+// validateAWSCredentials returns an error if the AWS shared credentials file is not made of [profile] sections and
+// key = value lines, if one of its profiles sets a key which is not allowed, or if its profile does not set an access key
+func validateAWSCredentials(credentials []byte, profile string) error {
+	profiles := map[string]map[string]bool{}
+	var current map[string]bool
+	var currentProfile string
+	for index, line := range strings.Split(string(credentials), "\n") {
+		line = strings.TrimSpace(line)
+		if line == constant.EmptyString || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = map[string]bool{}
+			currentProfile = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[1:len(line)-1]), "profile "))
+			profiles[currentProfile] = current
+			continue
+		}
+		key, _, found := strings.Cut(line, "=")
+		if !found || current == nil || strings.TrimSpace(key) == constant.EmptyString {
+			return fmt.Errorf("malformed AWS credentials profile, line %d is neither a [profile] nor a key = value line", index+1)
+		}
+		if err := validateAWSCredentialsKey(currentProfile, strings.TrimSpace(key)); err != nil {
+			return err
+		}
+		current[strings.TrimSpace(key)] = true
+	}
+	keys, ok := profiles[profile]
+	if !ok {
+		return fmt.Errorf("malformed AWS credentials profile, profile %s not found", profile)
+	}
+	if !keys["aws_access_key_id"] || !keys["aws_secret_access_key"] {
+		return fmt.Errorf("malformed AWS credentials profile, profile %s does not set aws_access_key_id and aws_secret_access_key", profile)
+	}
+	return nil
+}
+
+// RenderBslCredentialProfile returns the AWS shared credentials file containing only the profile of credentials,
+// as the default profile. Profiles setting a key which is not allowed, like source_profile, are not rendered.
+func RenderBslCredentialProfile(credentials []byte, profile string) ([]byte, error) {
+	var rendered bytes.Buffer
+	found, inProfile := false, false
+	for _, line := range strings.Split(string(credentials), "\n") {
+		line = strings.TrimSpace(line)
+		if line == constant.EmptyString || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			inProfile = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[1:len(line)-1]), "profile ")) == profile
+			if inProfile && !found {
+				found = true
+				rendered.WriteString("[default]\n")
+			}
+			continue
+		}
+		if !inProfile {
+			continue
+		}
+		key, _, _ := strings.Cut(line, "=")
+		if err := validateAWSCredentialsKey(profile, strings.TrimSpace(key)); err != nil {
+			return nil, err
+		}
+		rendered.WriteString(line + "\n")
+	}
+	if !found {
+		return nil, fmt.Errorf("malformed AWS credentials profile, profile %s not found", profile)
+	}
+	return rendered.Bytes(), nil
+}
+
+// validateAzureCredentials returns an error if the Azure credentials file is not made of KEY=VALUE lines, or if it
+// sets neither a storage account access key nor a client
+func validateAzureCredentials(credentials []byte) error {
+	keys := map[string]bool{}
+	for index, line := range strings.Split(string(credentials), "\n") {
+		line = strings.TrimSpace(line)
+		if line == constant.EmptyString || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, _, found := strings.Cut(line, "=")
+		if !found || strings.TrimSpace(key) == constant.EmptyString {
+			return fmt.Errorf("malformed Azure credentials file, line %d is not a KEY=VALUE line", index+1)
+		}
+		keys[strings.TrimSpace(key)] = true
+	}
+	if !keys["AZURE_STORAGE_ACCOUNT_ACCESS_KEY"] && !keys["AZURE_CLIENT_ID"] {
+		return errors.New("malformed Azure credentials file, it sets neither AZURE_STORAGE_ACCOUNT_ACCESS_KEY nor AZURE_CLIENT_ID")
+	}
+	return nil
+}
+
+// validateGCPCredentials returns an error if the GCP credentials file is not a JSON key with a type
+func validateGCPCredentials(credentials []byte) error {
+	var key struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(credentials, &key); err != nil || key.Type == constant.EmptyString {
+		return errors.New("malformed GCP credentials file, it is not a JSON service account or external account key")
+	}
+	return nil
+}
+
+// ValidateBslAllowedS3URLs returns an error if one of the S3 endpoint patterns is invalid
+func ValidateBslAllowedS3URLs(allowedS3URLs []string) error {
+	for _, pattern := range allowedS3URLs {
+		if _, err := path.Match(pattern, constant.EmptyString); err != nil {
+			return fmt.Errorf("S3 endpoint pattern %q is invalid: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// ValidateBslProvider returns nil, if the provider of the NonAdminBackupStorageLocation is allowed by the administrator; error otherwise.
+// allowedProviders lists the providers, with or without the velero.io/ prefix, an empty one allows any provider.
+// allowedS3URLs lists the shell patterns the s3Url config of the aws provider must match, an empty one allows any
+// endpoint, AWS S3 included.
+func ValidateBslProvider(bslSpec *velerov1.BackupStorageLocationSpec, allowedProviders []string, allowedS3URLs []string) error {
+	provider := strings.TrimPrefix(bslSpec.Provider, veleroProviderPrefix)
+	if len(allowedProviders) > 0 && !slices.ContainsFunc(allowedProviders, func(allowedProvider string) bool {
+		return strings.TrimPrefix(allowedProvider, veleroProviderPrefix) == provider
+	}) {
+		return fmt.Errorf("%w, spec.backupStorageLocationSpec.provider must be one of: %s",
+			ErrBslProviderNotAllowed, strings.Join(allowedProviders, constant.CommaString+" "))
+	}
+	if len(allowedS3URLs) > 0 && provider == "aws" {
+		s3URL := bslSpec.Config[s3URLConfigKey]
+		if s3URL == constant.EmptyString || !matchesAnyPattern(allowedS3URLs, s3URL) {
+			return fmt.Errorf("%w, spec.backupStorageLocationSpec.config.%s must match one of: %s",
+				ErrBslProviderNotAllowed, s3URLConfigKey, strings.Join(allowedS3URLs, constant.CommaString+" "))
+		}
+	}
+	return nil
+}
+
+var (
+	awsRoleARNRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/[\w+=,.@/-]+$`)
+	azureIDRegexp    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	azureCloudNames  = []string{"AzurePublicCloud", "AzureUSGovernmentCloud", "AzureChinaCloud", "AzureGermanCloud"}
+)
+
+// ValidateBslCloudIdentity returns an error if the cloud identity does not set exactly one identity,
+// matching the backup storage location provider, or if its identifiers are not valid.
+func ValidateBslCloudIdentity(cloudIdentity *nacv1alpha1.CloudIdentity, provider string) error {
+	provider = strings.TrimPrefix(provider, veleroProviderPrefix)
+	switch {
+	case cloudIdentity.AWS != nil && cloudIdentity.Azure != nil:
+		return errors.New("NonAdminBackupStorageLocation spec.cloudIdentity.aws and spec.cloudIdentity.azure can not be both set")
+	case cloudIdentity.AWS != nil:
+		if provider != "aws" {
+			return errors.New("NonAdminBackupStorageLocation spec.cloudIdentity.aws requires the aws provider")
+		}
+		if !awsRoleARNRegexp.MatchString(cloudIdentity.AWS.RoleARN) {
+			return fmt.Errorf("NonAdminBackupStorageLocation spec.cloudIdentity.aws.roleARN %q is not a valid IAM role ARN", cloudIdentity.AWS.RoleARN)
+		}
+	case cloudIdentity.Azure != nil:
+		if provider != "azure" {
+			return errors.New("NonAdminBackupStorageLocation spec.cloudIdentity.azure requires the azure provider")
+		}
+		for _, azureID := range []struct{ field, value string }{
+			{"subscriptionID", cloudIdentity.Azure.SubscriptionID},
+			{"tenantID", cloudIdentity.Azure.TenantID},
+			{"clientID", cloudIdentity.Azure.ClientID},
+		} {
+			if !azureIDRegexp.MatchString(azureID.value) {
+				return fmt.Errorf("NonAdminBackupStorageLocation spec.cloudIdentity.azure.%s %q is not a valid ID", azureID.field, azureID.value)
+			}
+		}
+		if cloudIdentity.Azure.CloudName != constant.EmptyString && !slices.Contains(azureCloudNames, cloudIdentity.Azure.CloudName) {
+			return fmt.Errorf("NonAdminBackupStorageLocation spec.cloudIdentity.azure.cloudName must be one of: %s",
+				strings.Join(azureCloudNames, constant.CommaString+" "))
+		}
+	default:
+		return errors.New("NonAdminBackupStorageLocation spec.cloudIdentity must set aws or azure")
+	}
+	return nil
+}
+
+// GetBslCloudIdentityCredentials returns the Velero credential file of the cloud identity
+func GetBslCloudIdentityCredentials(cloudIdentity *nacv1alpha1.CloudIdentity) []byte {
+	switch {
+	case cloudIdentity == nil:
+		return nil
+	case cloudIdentity.AWS != nil:
+		return []byte(fmt.Sprintf("[default]\nsts_regional_endpoints = regional\nrole_arn = %s\nweb_identity_token_file = %s\n",
+			cloudIdentity.AWS.RoleARN, constant.CloudIdentityWebIdentityTokenFile))
+	case cloudIdentity.Azure != nil:
+		cloudName := cloudIdentity.Azure.CloudName
+		if cloudName == constant.EmptyString {
+			cloudName = constant.CloudIdentityDefaultAzureCloudName
+		}
+		return []byte(fmt.Sprintf("AZURE_SUBSCRIPTION_ID=%s\nAZURE_TENANT_ID=%s\nAZURE_CLIENT_ID=%s\nAZURE_CLOUD_NAME=%s\n",
+			cloudIdentity.Azure.SubscriptionID, cloudIdentity.Azure.TenantID, cloudIdentity.Azure.ClientID, cloudName))
+	}
+	return nil
+}
+
+// GetBslCloudIdentityConfig returns the backup storage location config the cloud identity requires, nil if none
+func GetBslCloudIdentityConfig(cloudIdentity *nacv1alpha1.CloudIdentity) map[string]string {
+	if cloudIdentity != nil && cloudIdentity.Azure != nil {
+		// The storage account is accessed with Microsoft Entra ID, there is no storage account key
+		return map[string]string{"useAAD": "true"}
+	}
+	return nil
+}
+
+// ValidateBslMinimumPeriods returns an error if the backup storage location spec sets a backupSyncPeriod or
+// validationFrequency lower than the minimum set by the administrator. Zero, which disables them, is allowed.
+func ValidateBslMinimumPeriods(bslSpec *velerov1.BackupStorageLocationSpec, minBackupSyncPeriod, minValidationFrequency time.Duration) error {
+	for _, period := range []struct {
+		field   string
+		value   *metav1.Duration
+		minimum time.Duration
+	}{
+		{"backupSyncPeriod", bslSpec.BackupSyncPeriod, minBackupSyncPeriod},
+		{"validationFrequency", bslSpec.ValidationFrequency, minValidationFrequency},
+	} {
+		if period.value != nil && period.value.Duration > 0 && period.value.Duration < period.minimum {
+			return fmt.Errorf("NonAdminBackupStorageLocation spec.backupStorageLocationSpec.%s (%v) can not be lower than %v",
+				period.field, period.value.Duration, period.minimum)
 		}
-		return fmt.Errorf("failed to get BSL credentials secret: %v", err)
 	}
 	return nil
 }
 
-func formatCredentialToString(credential *corev1.SecretKeySelector) string {
-	if credential == nil {
-		return constant.EmptyString
+// ApplyBslMinimumPeriods sets the backupSyncPeriod and validationFrequency of the backup storage location spec, which
+// are not set or lower than the minimum set by the administrator, to the minimum. Zero, which disables them, is kept.
+func ApplyBslMinimumPeriods(bslSpec *velerov1.BackupStorageLocationSpec, minBackupSyncPeriod, minValidationFrequency time.Duration) {
+	if minBackupSyncPeriod > 0 && (bslSpec.BackupSyncPeriod == nil ||
+		(bslSpec.BackupSyncPeriod.Duration > 0 && bslSpec.BackupSyncPeriod.Duration < minBackupSyncPeriod)) {
+		bslSpec.BackupSyncPeriod = &metav1.Duration{Duration: minBackupSyncPeriod}
+	}
+	if minValidationFrequency > 0 && (bslSpec.ValidationFrequency == nil ||
+		(bslSpec.ValidationFrequency.Duration > 0 && bslSpec.ValidationFrequency.Duration < minValidationFrequency)) {
+		bslSpec.ValidationFrequency = &metav1.Duration{Duration: minValidationFrequency}
 	}
-	return fmt.Sprintf("name: %s, key: %s", credential.Name, credential.Key)
 }
 
-func formatMapToString(value any) string {
-	if str, ok := value.(string); ok {
-		return str
+// GetBslSpecChangedFields returns the sorted JSON paths of the fields of the backup storage location spec that differ
+// between previous and current, for example objectStorage.prefix or config.s3Url
+func GetBslSpecChangedFields(previous, current *velerov1.BackupStorageLocationSpec) ([]string, error) {
+	previousFields, err := toJSONValue(previous)
+	if err != nil {
+		return nil, err
+	}
+	currentFields, err := toJSONValue(current)
+	if err != nil {
+		return nil, err
 	}
+	changedFields := []string{}
+	appendChangedJSONFields(&changedFields, constant.EmptyString, previousFields, currentFields)
+	slices.Sort(changedFields)
+	return changedFields, nil
+}
 
-	if m, ok := value.(map[string]string); ok {
-		var parts []string
-		for k, v := range m {
-			parts = append(parts, fmt.Sprintf("%s: %s", k, v))
-		}
-		return strings.Join(parts, ", ")
+// SetBslLastAppliedSpec records the spec of the backup storage location in its annotations, as the one NAC applied
+func SetBslLastAppliedSpec(bsl *velerov1.BackupStorageLocation) error {
+	lastAppliedSpec, err := json.Marshal(bsl.Spec)
+	if err != nil {
+		return err
 	}
+	if bsl.Annotations == nil {
+		bsl.Annotations = map[string]string{}
+	}
+	bsl.Annotations[constant.NabslLastAppliedSpecAnnotation] = string(lastAppliedSpec)
+	return nil
+}
 
-	return fmt.Sprintf("%v", value)
+// GetBslDriftedFields returns the sorted JSON paths of the fields of the backup storage location spec modified since
+// NAC last applied it. It returns none if NAC did not record the spec it applied.
+func GetBslDriftedFields(bsl *velerov1.BackupStorageLocation) ([]string, error) {
+	lastAppliedSpecAnnotation, ok := bsl.Annotations[constant.NabslLastAppliedSpecAnnotation]
+	if !ok {
+		return []string{}, nil
+	}
+	lastAppliedSpec := &velerov1.BackupStorageLocationSpec{}
+	if err := json.Unmarshal([]byte(lastAppliedSpecAnnotation), lastAppliedSpec); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", constant.NabslLastAppliedSpecAnnotation, err)
+	}
+	return GetBslSpecChangedFields(lastAppliedSpec, &bsl.Spec)
 }
 
-func compareStorageTypes(enforcedStorageType, currentStorageType reflect.Value) string {
-	if enforcedStorageType.Kind() == reflect.Struct && currentStorageType.Kind() == reflect.Struct {
-		enforcedStorage, ok1 := enforcedStorageType.Interface().(oadpv1alpha1.StorageType)
-		currentStorage, ok2 := currentStorageType.Interface().(velerov1.StorageType)
+// toJSONValue returns the generic JSON representation of object
+func toJSONValue(object any) (any, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
 
-		if ok1 && ok2 {
-			if enforcedStorage.ObjectStorage != nil && currentStorage.ObjectStorage != nil {
-				if enforcedStorage.ObjectStorage.Bucket != currentStorage.ObjectStorage.Bucket ||
-					enforcedStorage.ObjectStorage.Prefix != currentStorage.ObjectStorage.Prefix ||
-					string(enforcedStorage.ObjectStorage.CACert) != string(currentStorage.ObjectStorage.CACert) {
-					return fmt.Sprintf("bucket: %s, prefix: %s, caCert: %s",
-						enforcedStorage.ObjectStorage.Bucket,
-						enforcedStorage.ObjectStorage.Prefix,
-						string(enforcedStorage.ObjectStorage.CACert))
-				}
-			}
+// appendChangedJSONFields appends to changedFields the paths, under fieldPath, of the JSON values that differ
+// between previous and current. JSON objects are compared field by field, other values, like lists, as a whole.
+func appendChangedJSONFields(changedFields *[]string, fieldPath string, previous, current any) {
+	previousObject, previousIsObject := previous.(map[string]any)
+	currentObject, currentIsObject := current.(map[string]any)
+	// A missing object is compared as an empty one, so its fields are listed
+	if previous == nil && currentIsObject {
+		previousObject, previousIsObject = map[string]any{}, true
+	}
+	if current == nil && previousIsObject {
+		currentObject, currentIsObject = map[string]any{}, true
+	}
+	if !previousIsObject || !currentIsObject {
+		if !reflect.DeepEqual(previous, current) {
+			*changedFields = append(*changedFields, fieldPath)
 		}
+		return
+	}
+	keys := slices.Collect(maps.Keys(previousObject))
+	for key := range currentObject {
+		if _, ok := previousObject[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		keyPath := key
+		if fieldPath != constant.EmptyString {
+			keyPath = fieldPath + "." + key
+		}
+		appendChangedJSONFields(changedFields, keyPath, previousObject[key], currentObject[key])
 	}
-	return ""
 }
 
-// GenerateNacObjectUUID generates a unique name based on the provided namespace and object origin name.
-// It includes a UUID suffix. If the name exceeds the maximum length, it truncates nacName first, then namespace.
-func GenerateNacObjectUUID(namespace, nacName string) string {
-	// Generate UUID suffix
-	uuidSuffix := uuid.New().String()
-
-	// Build the initial name based on the presence of namespace and nacName
-	nacObjectName := uuidSuffix
-	if len(nacName) > 0 {
-		nacObjectName = nacName + constant.NameDelimiter + nacObjectName
+// ValidateBslPrefixTemplate returns an error if the object storage prefix template does not contain
+// the {namespace} placeholder, uses it within a path segment, or uses unknown placeholders.
+// The {namespace} placeholder must be a whole path segment, otherwise different namespace and name
+// pairs could render the same prefix, for example "{namespace}-{name}" with "a-b" and "c", and "a" and "b-c".
+func ValidateBslPrefixTemplate(template string) error {
+	if !strings.Contains(template, constant.NameTemplateNamespace) {
+		return fmt.Errorf("prefix template %q must contain %s placeholder", template, constant.NameTemplateNamespace)
+	}
+	for _, segment := range strings.Split(template, "/") {
+		if segment != constant.NameTemplateNamespace && strings.Contains(segment, constant.NameTemplateNamespace) {
+			return fmt.Errorf("prefix template %q must use %s placeholder as a whole path segment", template, constant.NameTemplateNamespace)
+		}
 	}
-	if len(namespace) > 0 {
-		nacObjectName = namespace + constant.NameDelimiter + nacObjectName
+	if strings.ContainsAny(renderBslPrefixTemplate(template, "namespace", "name"), "{}") {
+		return fmt.Errorf("prefix template %q contains unknown placeholder, allowed placeholders are %s and %s",
+			template, constant.NameTemplateNamespace, constant.NameTemplateName)
 	}
+	return nil
+}
 
-	if len(nacObjectName) > constant.MaximumNacObjectNameLength {
-		// Calculate remaining length after UUID
-		remainingLength := constant.MaximumNacObjectNameLength - len(uuidSuffix)
-
-		delimeterLength := len(constant.NameDelimiter)
-
-		// Subtract two delimiter lengths to avoid a corner case where the namespace
-		// and delimiters leave no space for any part of nabName
-		if len(namespace) > remainingLength-delimeterLength-delimeterLength {
-			namespace = namespace[:remainingLength-delimeterLength-delimeterLength]
-			nacObjectName = namespace + constant.NameDelimiter + uuidSuffix
-		} else {
-			remainingLength = remainingLength - len(namespace) - delimeterLength - delimeterLength
-			nacName = nacName[:remainingLength]
-			nacObjectName = uuidSuffix
-			if len(nacName) > 0 {
-				nacObjectName = nacName + constant.NameDelimiter + nacObjectName
-			}
-			if len(namespace) > 0 {
-				nacObjectName = namespace + constant.NameDelimiter + nacObjectName
-			}
-		}
+// RenderBslPrefixTemplate returns the object storage prefix NAC puts in front of the prefix of a NonAdminBackupStorageLocation
+// in its VeleroBackupStorageLocation, rendered from the template. An empty template returns the namespace.
+func RenderBslPrefixTemplate(template, namespace, nonAdminBslName string) string {
+	if template == constant.EmptyString {
+		return namespace
 	}
+	return strings.Trim(renderBslPrefixTemplate(template, namespace, nonAdminBslName), "/")
+}
 
-	return nacObjectName
+func renderBslPrefixTemplate(template, namespace, nonAdminBslName string) string {
+	return strings.NewReplacer(
+		constant.NameTemplateNamespace, namespace,
+		constant.NameTemplateName, nonAdminBslName,
+	).Replace(template)
 }
 
 // ListObjectsByLabel retrieves a list of Kubernetes objects in a specified namespace
@@ -428,6 +2593,61 @@ func ListObjectsByLabel(ctx context.Context, clientInstance client.Client, names
 	return nil
 }
 
+// CreateRetryBackoff is the jittered exponential backoff used by CreateWithRetry
+var CreateRetryBackoff = wait.Backoff{
+	Duration: 200 * time.Millisecond,
+	Factor:   2,
+	Jitter:   0.5,
+	Steps:    4,
+}
+
+// IsTransientAPIError returns true for API errors that are expected to go away
+// when the request is retried, like timeouts and throttling.
+func IsTransientAPIError(err error) bool {
+	return apierrors.IsTimeout(err) ||
+		apierrors.IsServerTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err)
+}
+
+// CreateWithRetry creates the object, retrying transient API errors with CreateRetryBackoff.
+// Creation is idempotent: before every retry exists is called, so an object whose creation
+// was reported as failed, but did happen, is not created twice. An AlreadyExists error is
+// treated as success for the same reason, but only if exists finds the object, so an object
+// with the same name created for something else is never adopted.
+// It returns the number of retries done, which is returned even if creation failed.
+func CreateWithRetry(ctx context.Context, clientInstance client.Client, obj client.Object, exists func() (bool, error)) (int, error) {
+	retries := 0
+	attempted := false
+	err := retry.OnError(CreateRetryBackoff, IsTransientAPIError, func() error {
+		if attempted {
+			retries++
+			found, err := exists()
+			if err != nil {
+				return err
+			}
+			if found {
+				return nil
+			}
+		}
+		attempted = true
+		err := clientInstance.Create(ctx, obj)
+		if apierrors.IsAlreadyExists(err) {
+			found, existsErr := exists()
+			if existsErr != nil {
+				return existsErr
+			}
+			if !found {
+				return err
+			}
+			return nil
+		}
+		return err
+	})
+	return retries, err
+}
+
 // GetVeleroBackupByLabel retrieves a VeleroBackup object based on a specified label within a given namespace.
 // It returns the VeleroBackup only when exactly one object is found, throws an error if multiple backups are found,
 // or returns nil if no matches are found.
@@ -522,6 +2742,161 @@ func GetBackupQueueInfo(ctx context.Context, clientInstance client.Client, names
 	return queueInfo, nil
 }
 
+// GetBackupSummaryForStorageLocation summarizes the VeleroBackups in the namespace whose
+// storage location is the given Velero BackupStorageLocation. The total size is the sum
+// of bytes reported by the PodVolumeBackups and DataUploads of those backups.
+func GetBackupSummaryForStorageLocation(ctx context.Context, clientInstance client.Client, namespace, storageLocation string) (*nacv1alpha1.BackupSummary, error) {
+	var backupList velerov1.BackupList
+	if err := clientInstance.List(ctx, &backupList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	summary := &nacv1alpha1.BackupSummary{}
+	backupNames := map[string]struct{}{}
+	for i := range backupList.Items {
+		backup := &backupList.Items[i]
+		if backup.Spec.StorageLocation != storageLocation {
+			continue
+		}
+		summary.BackupCount++
+		backupNames[backup.Name] = struct{}{}
+
+		startTimestamp := backup.Status.StartTimestamp
+		if startTimestamp == nil {
+			startTimestamp = &backup.CreationTimestamp
+		}
+		if summary.MostRecentBackupTimestamp == nil || startTimestamp.After(summary.MostRecentBackupTimestamp.Time) {
+			summary.MostRecentBackupTimestamp = startTimestamp.DeepCopy()
+			summary.MostRecentBackup = backup.Name
+			if originName, ok := backup.Annotations[constant.NabOriginNameAnnotation]; ok {
+				summary.MostRecentBackup = originName
+			}
+		}
+	}
+	if summary.BackupCount == 0 {
+		return summary, nil
+	}
+
+	var podVolumeBackupList velerov1.PodVolumeBackupList
+	if err := clientInstance.List(ctx, &podVolumeBackupList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for _, podVolumeBackup := range podVolumeBackupList.Items {
+		if _, ok := backupNames[podVolumeBackup.Labels[velerov1.BackupNameLabel]]; ok {
+			summary.TotalBytes += podVolumeBackup.Status.Progress.TotalBytes
+		}
+	}
+
+	var dataUploadList velerov2alpha1.DataUploadList
+	if err := clientInstance.List(ctx, &dataUploadList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	for _, dataUpload := range dataUploadList.Items {
+		if _, ok := backupNames[dataUpload.Labels[velerov1.BackupNameLabel]]; ok {
+			summary.TotalBytes += dataUpload.Status.Progress.TotalBytes
+		}
+	}
+
+	return summary, nil
+}
+
+// countPersistentVolumeClaims is the object count quota of PersistentVolumeClaims
+const countPersistentVolumeClaims corev1.ResourceName = "count/persistentvolumeclaims"
+
+// CheckNamespaceQuotaForRestore compares the volumes of the given VeleroBackup with the ResourceQuotas and
+// the PersistentVolumeClaim LimitRanges of the namespace the backup is going to be restored to.
+// Volume sizes are taken from the bytes reported by the PodVolumeBackups and DataUploads of the backup,
+// which is a lower bound of the size of the restored PersistentVolumeClaims.
+// Only the storage and PersistentVolumeClaim quotas, including count/persistentvolumeclaims, are checked: the number
+// of the other objects of the backup is not known before restoring, so their count/* quotas are not.
+// It returns a message describing the limits that would be exceeded, or an empty string if none would be.
+func CheckNamespaceQuotaForRestore(ctx context.Context, clientInstance client.Client, oadpNamespace, namespace, veleroBackupName string) (string, error) {
+	backupNameSelector := client.MatchingLabels{velerov1.BackupNameLabel: label.GetValidName(veleroBackupName)}
+
+	var volumeSizes []int64
+	var podVolumeBackupList velerov1.PodVolumeBackupList
+	if err := clientInstance.List(ctx, &podVolumeBackupList, client.InNamespace(oadpNamespace), backupNameSelector); err != nil {
+		return constant.EmptyString, err
+	}
+	for _, podVolumeBackup := range podVolumeBackupList.Items {
+		// only volumes backed by a PersistentVolumeClaim count against the quota
+		if _, ok := podVolumeBackup.Labels[velerov1.PVCUIDLabel]; ok {
+			volumeSizes = append(volumeSizes, podVolumeBackup.Status.Progress.TotalBytes)
+		}
+	}
+	var dataUploadList velerov2alpha1.DataUploadList
+	if err := clientInstance.List(ctx, &dataUploadList, client.InNamespace(oadpNamespace), backupNameSelector); err != nil {
+		return constant.EmptyString, err
+	}
+	for _, dataUpload := range dataUploadList.Items {
+		volumeSizes = append(volumeSizes, dataUpload.Status.Progress.TotalBytes)
+	}
+	if len(volumeSizes) == 0 {
+		return constant.EmptyString, nil
+	}
+
+	var requiredStorage int64
+	for _, size := range volumeSizes {
+		requiredStorage += size
+	}
+	required := corev1.ResourceList{
+		corev1.ResourceRequestsStorage:        *resource.NewQuantity(requiredStorage, resource.BinarySI),
+		corev1.ResourcePersistentVolumeClaims: *resource.NewQuantity(int64(len(volumeSizes)), resource.DecimalSI),
+		countPersistentVolumeClaims:           *resource.NewQuantity(int64(len(volumeSizes)), resource.DecimalSI),
+	}
+
+	var exceeded []string
+
+	var resourceQuotaList corev1.ResourceQuotaList
+	if err := clientInstance.List(ctx, &resourceQuotaList, client.InNamespace(namespace)); err != nil {
+		return constant.EmptyString, err
+	}
+	for _, resourceQuota := range resourceQuotaList.Items {
+		hardLimits := resourceQuota.Status.Hard
+		if len(hardLimits) == 0 {
+			hardLimits = resourceQuota.Spec.Hard
+		}
+		for _, resourceName := range []corev1.ResourceName{corev1.ResourceRequestsStorage, corev1.ResourcePersistentVolumeClaims, countPersistentVolumeClaims} {
+			hard, ok := hardLimits[resourceName]
+			if !ok {
+				continue
+			}
+			total := resourceQuota.Status.Used[resourceName]
+			total.Add(required[resourceName])
+			if total.Cmp(hard) > 0 {
+				used := resourceQuota.Status.Used[resourceName]
+				requiredQuantity := required[resourceName]
+				exceeded = append(exceeded, fmt.Sprintf("ResourceQuota %s %s: used %s, required %s, hard %s",
+					resourceQuota.Name, resourceName, used.String(), requiredQuantity.String(), hard.String()))
+			}
+		}
+	}
+
+	var limitRangeList corev1.LimitRangeList
+	if err := clientInstance.List(ctx, &limitRangeList, client.InNamespace(namespace)); err != nil {
+		return constant.EmptyString, err
+	}
+	for _, limitRange := range limitRangeList.Items {
+		for _, limit := range limitRange.Spec.Limits {
+			if limit.Type != corev1.LimitTypePersistentVolumeClaim {
+				continue
+			}
+			maxStorage, ok := limit.Max[corev1.ResourceStorage]
+			if !ok {
+				continue
+			}
+			for _, size := range volumeSizes {
+				if size > maxStorage.Value() {
+					exceeded = append(exceeded, fmt.Sprintf("LimitRange %s: volume of %s exceeds max storage %s",
+						limitRange.Name, resource.NewQuantity(size, resource.BinarySI).String(), maxStorage.String()))
+				}
+			}
+		}
+	}
+
+	return strings.Join(exceeded, "; "), nil
+}
+
 // GetActiveVeleroRestoresByLabel retrieves all VeleroRestore objects based on a specified label within a given namespace.
 // It returns a slice of VeleroRestore objects or nil if none are found.
 func GetActiveVeleroRestoresByLabel(ctx context.Context, clientInstance client.Client, namespace, labelKey, labelValue string) ([]velerov1.Restore, error) {
@@ -635,6 +3010,25 @@ func GetVeleroRestoreByLabel(ctx context.Context, clientInstance client.Client,
 	}
 }
 
+// GetVeleroScheduleByLabel retrieves a VeleroSchedule object based on a specified label within a given namespace.
+// It returns the VeleroSchedule only when exactly one object is found, throws an error if multiple VeleroSchedules are found,
+// or returns nil if no matches are found.
+func GetVeleroScheduleByLabel(ctx context.Context, clientInstance client.Client, namespace string, labelValue string) (*velerov1.Schedule, error) {
+	veleroScheduleList := &velerov1.ScheduleList{}
+	if err := ListObjectsByLabel(ctx, clientInstance, namespace, constant.NasOriginNACUUIDLabel, labelValue, veleroScheduleList); err != nil {
+		return nil, err
+	}
+
+	switch len(veleroScheduleList.Items) {
+	case 0:
+		return nil, nil // No matching VeleroSchedules found
+	case 1:
+		return &veleroScheduleList.Items[0], nil
+	default:
+		return nil, fmt.Errorf("multiple VeleroSchedule objects found with label %s=%s in namespace '%s'", constant.NasOriginNACUUIDLabel, labelValue, namespace)
+	}
+}
+
 // GetNabslRequestByLabel retrieves a NonAdminBackupStorageLocationRequest object based on a specified label within a given namespace.
 // It returns the NonAdminBackupStorageLocationRequest only when exactly one object is found, throws an error if multiple NonAdminBackupStorageLocationRequests are found,
 // or returns nil if no matches are found.
@@ -698,6 +3092,118 @@ func GetVeleroBackupStorageLocationByLabel(ctx context.Context, clientInstance c
 	}
 }
 
+// ValidateVslSpec return nil, if NonAdminVolumeSnapshotLocation is valid; error otherwise
+func ValidateVslSpec(ctx context.Context, clientInstance client.Client, nonAdminVsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) error {
+	vslSpec := nonAdminVsl.Spec.VolumeSnapshotLocationSpec
+	if vslSpec == nil {
+		return errors.New("NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec is not defined")
+	}
+	if vslSpec.Provider == constant.EmptyString {
+		return errors.New("NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.provider is not set")
+	}
+	if vslSpec.Credential == nil {
+		return errors.New("NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.credential is not set")
+	}
+	if vslSpec.Credential.Name == constant.EmptyString || vslSpec.Credential.Key == constant.EmptyString {
+		return errors.New("NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.credential.name or spec.volumeSnapshotLocationSpec.credential.key is not set")
+	}
+	secret := &corev1.Secret{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: vslSpec.Credential.Name, Namespace: nonAdminVsl.Namespace}, secret); err != nil {
+		return fmt.Errorf("NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.credential is invalid: %v", err)
+	}
+	if _, ok := secret.Data[vslSpec.Credential.Key]; !ok {
+		return fmt.Errorf("NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.credential key %s not found in Secret %s", vslSpec.Credential.Key, vslSpec.Credential.Name)
+	}
+	return nil
+}
+
+// validateNonAdminVolumeSnapshotLocation returns an error if the NonAdminVolumeSnapshotLocation named in
+// spec.backupSpec.volumeSnapshotLocations of a NonAdminBackup can not be used by it
+func validateNonAdminVolumeSnapshotLocation(ctx context.Context, clientInstance client.Client, oadpNamespace string, namespace string, name string) error {
+	nonAdminVsl := &nacv1alpha1.NonAdminVolumeSnapshotLocation{}
+	err := clientInstance.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, nonAdminVsl)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("NonAdminVolumeSnapshotLocation %s not found in the namespace: %v", name, err)
+	} else if err != nil {
+		return fmt.Errorf("NonAdminBackup spec.backupSpec.volumeSnapshotLocations is invalid: %v", err)
+	}
+	if nonAdminVsl.Status.Phase != nacv1alpha1.NonAdminPhaseCreated {
+		return fmt.Errorf("NonAdminVolumeSnapshotLocation %s is not in created state and can not be used for the NonAdminBackup", name)
+	}
+	if nonAdminVsl.Status.VeleroVolumeSnapshotLocation == nil || nonAdminVsl.Status.VeleroVolumeSnapshotLocation.NACUUID == constant.EmptyString {
+		return fmt.Errorf("unable to get VeleroVolumeSnapshotLocation UUID from NonAdminVolumeSnapshotLocation %s Status", name)
+	}
+	veleroObjectsNACUUID := nonAdminVsl.Status.VeleroVolumeSnapshotLocation.NACUUID
+	veleroVolumeSnapshotLocation, err := GetVeleroVolumeSnapshotLocationByLabel(ctx, clientInstance, oadpNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		return fmt.Errorf("unable to get valid VeleroVolumeSnapshotLocation referenced by the NACUUID %s from NonAdminVolumeSnapshotLocation Status: %v", veleroObjectsNACUUID, err)
+	}
+	if veleroVolumeSnapshotLocation == nil {
+		return fmt.Errorf("VeleroVolumeSnapshotLocation with NACUUID %s not found in the OADP namespace", veleroObjectsNACUUID)
+	}
+	return nil
+}
+
+// GetNavslRequestByLabel retrieves a NonAdminVolumeSnapshotLocationRequest object based on a specified label within a given namespace.
+// It returns the NonAdminVolumeSnapshotLocationRequest only when exactly one object is found, throws an error if multiple
+// NonAdminVolumeSnapshotLocationRequests are found, or returns nil if no matches are found.
+func GetNavslRequestByLabel(ctx context.Context, clientInstance client.Client, namespace string, labelValue string) (*nacv1alpha1.NonAdminVolumeSnapshotLocationRequest, error) {
+	navslRequestList := &nacv1alpha1.NonAdminVolumeSnapshotLocationRequestList{}
+
+	if err := ListObjectsByLabel(ctx, clientInstance, namespace, constant.NavslOriginNACUUIDLabel, labelValue, navslRequestList); err != nil {
+		return nil, err
+	}
+
+	switch len(navslRequestList.Items) {
+	case 0:
+		return nil, nil // No matching NonAdminVolumeSnapshotLocationRequest found
+	case 1:
+		return &navslRequestList.Items[0], nil // Found 1 matching NonAdminVolumeSnapshotLocationRequest
+	default:
+		return nil, fmt.Errorf("multiple NonAdminVolumeSnapshotLocationRequest objects found with label %s=%s in namespace '%s'", constant.NavslOriginNACUUIDLabel, labelValue, namespace)
+	}
+}
+
+// GetVslSecretByLabel retrieves a Secret object, synced for a NonAdminVolumeSnapshotLocation, based on a specified label
+// within a given namespace. It returns the Secret only when exactly one object is found, throws an error if multiple
+// secrets are found, or returns nil if no matches are found.
+func GetVslSecretByLabel(ctx context.Context, clientInstance client.Client, namespace string, labelValue string) (*corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+
+	if err := ListObjectsByLabel(ctx, clientInstance, namespace, constant.NavslOriginNACUUIDLabel, labelValue, secretList); err != nil {
+		return nil, err
+	}
+
+	switch len(secretList.Items) {
+	case 0:
+		return nil, nil // No matching Secret found
+	case 1:
+		return &secretList.Items[0], nil // Found 1 matching Secret
+	default:
+		return nil, fmt.Errorf("multiple Secret objects found with label %s=%s in namespace '%s'", constant.NavslOriginNACUUIDLabel, labelValue, namespace)
+	}
+}
+
+// GetVeleroVolumeSnapshotLocationByLabel retrieves a VeleroVolumeSnapshotLocation object based on a specified label within a given namespace.
+// It returns the VeleroVolumeSnapshotLocation only when exactly one object is found, throws an error if multiple VeleroVolumeSnapshotLocation
+// are found, or returns nil if no matches are found.
+func GetVeleroVolumeSnapshotLocationByLabel(ctx context.Context, clientInstance client.Client, namespace string, labelValue string) (*velerov1.VolumeSnapshotLocation, error) {
+	vslList := &velerov1.VolumeSnapshotLocationList{}
+
+	if err := ListObjectsByLabel(ctx, clientInstance, namespace, constant.NavslOriginNACUUIDLabel, labelValue, vslList); err != nil {
+		return nil, err
+	}
+
+	switch len(vslList.Items) {
+	case 0:
+		return nil, nil // No matching VeleroVolumeSnapshotLocation found
+	case 1:
+		return &vslList.Items[0], nil // Found 1 matching VeleroVolumeSnapshotLocation
+	default:
+		return nil, fmt.Errorf("multiple VeleroVolumeSnapshotLocation objects found with label %s=%s in namespace '%s'", constant.NavslOriginNACUUIDLabel, labelValue, namespace)
+	}
+}
+
 // CheckVeleroBackupMetadata return true if Velero Backup object has required Non Admin labels and annotations, false otherwise
 func CheckVeleroBackupMetadata(obj client.Object) bool {
 	objLabels := obj.GetLabels()
@@ -759,6 +3265,37 @@ func CheckVeleroRestoreAnnotations(obj client.Object) bool {
 	return true
 }
 
+// CheckVeleroScheduleMetadata return true if Velero Schedule object, or Velero Backup created by it, has required
+// Non Admin labels and annotations, false otherwise
+func CheckVeleroScheduleMetadata(obj client.Object) bool {
+	objLabels := obj.GetLabels()
+	if !checkLabelValue(objLabels, constant.OadpLabel, constant.OadpLabelValue) {
+		return false
+	}
+	if !checkLabelValue(objLabels, constant.ManagedByLabel, constant.ManagedByLabelValue) {
+		return false
+	}
+
+	if !CheckLabelAnnotationValueIsValid(objLabels, constant.NasOriginNACUUIDLabel) {
+		return false
+	}
+
+	return CheckVeleroScheduleAnnotations(obj)
+}
+
+// CheckVeleroScheduleAnnotations return true if Velero Schedule object has required Non Admin annotations, false otherwise
+func CheckVeleroScheduleAnnotations(obj client.Object) bool {
+	annotations := obj.GetAnnotations()
+	if !CheckLabelAnnotationValueIsValid(annotations, constant.NasOriginNamespaceAnnotation) {
+		return false
+	}
+	if !CheckLabelAnnotationValueIsValid(annotations, constant.NasOriginNameAnnotation) {
+		return false
+	}
+
+	return true
+}
+
 // CheckVeleroBackupStorageLocationMetadata return true if Velero BackupStorageLocation object has required Non Admin labels and annotations, false otherwise
 func CheckVeleroBackupStorageLocationMetadata(obj client.Object) bool {
 	objLabels := obj.GetLabels()
@@ -789,6 +3326,19 @@ func CheckVeleroBackupStorageLocationAnnotations(obj client.Object) bool {
 	return true
 }
 
+// CheckVeleroVolumeSnapshotLocationAnnotations return true if Velero VolumeSnapshotLocation object has required Non Admin annotations, false otherwise
+func CheckVeleroVolumeSnapshotLocationAnnotations(obj client.Object) bool {
+	annotations := obj.GetAnnotations()
+	if !CheckLabelAnnotationValueIsValid(annotations, constant.NavslOriginNamespaceAnnotation) {
+		return false
+	}
+	if !CheckLabelAnnotationValueIsValid(annotations, constant.NavslOriginNameAnnotation) {
+		return false
+	}
+
+	return true
+}
+
 func checkLabelValue(objLabels map[string]string, key string, value string) bool {
 	got, exists := objLabels[key]
 	if !exists {
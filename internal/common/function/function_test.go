@@ -30,8 +30,15 @@ import (
 	"github.com/onsi/ginkgo/v2"
 	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -40,10 +47,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
 )
 
 var _ = ginkgo.Describe("PLACEHOLDER", func() {})
@@ -99,12 +108,31 @@ func TestGetNonAdminBackupAnnotations(t *testing.T) {
 	expected := map[string]string{
 		constant.NabOriginNamespaceAnnotation: testNonAdminBackupNamespace,
 		constant.NabOriginNameAnnotation:      testNonAdminBackupName,
+		nacmeta.SchemaVersionAnnotation:       nacmeta.SchemaVersion,
 	}
 
 	result := GetNonAdminBackupAnnotations(nonAdminBackup.ObjectMeta)
 	assert.Equal(t, expected, result)
 }
 
+func TestCopyAllowedKeys(t *testing.T) {
+	destination := map[string]string{
+		constant.OadpLabel: constant.OadpLabelValue,
+	}
+	source := map[string]string{
+		constant.OadpLabel: "tenant-value",
+		"cost-center":      "1234",
+		"not-allowed":      "value",
+	}
+
+	CopyAllowedKeys(destination, source, []string{constant.OadpLabel, "cost-center", "missing"})
+
+	assert.Equal(t, map[string]string{
+		constant.OadpLabel: constant.OadpLabelValue,
+		"cost-center":      "1234",
+	}, destination)
+}
+
 func TestValidateBackupSpec(t *testing.T) {
 	tests := []struct {
 		spec       *velerov1.BackupSpec
@@ -152,6 +180,144 @@ func TestValidateBackupSpec(t *testing.T) {
 			},
 			errMessage: "NonAdminBackupStorageLocation not found in the namespace: nonadminbackupstoragelocations.oadp.openshift.io \"user-defined-backup-storage-location\" not found",
 		},
+		{
+			name: "valid spec, orLabelSelectors and resource short names",
+			spec: &velerov1.BackupSpec{
+				OrLabelSelectors: []*metav1.LabelSelector{
+					{MatchLabels: map[string]string{"app": "frontend"}},
+					{MatchLabels: map[string]string{"app": "backend"}},
+				},
+				IncludedResources: []string{"cm", "deployments.apps", "secrets"},
+				ExcludedResources: []string{"events"},
+			},
+		},
+		{
+			name: "valid spec, new resource filters with wildcard",
+			spec: &velerov1.BackupSpec{
+				IncludedNamespaceScopedResources: []string{"*"},
+				ExcludedNamespaceScopedResources: []string{"pods"},
+			},
+		},
+		{
+			name: "invalid spec, labelSelector and orLabelSelectors",
+			spec: &velerov1.BackupSpec{
+				LabelSelector:    &metav1.LabelSelector{MatchLabels: map[string]string{"app": "frontend"}},
+				OrLabelSelectors: []*metav1.LabelSelector{{MatchLabels: map[string]string{"app": "backend"}}},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec.labelSelector and spec.backupSpec.orLabelSelectors can not be used together",
+		},
+		{
+			name: "invalid spec, invalid orLabelSelectors",
+			spec: &velerov1.BackupSpec{
+				OrLabelSelectors: []*metav1.LabelSelector{
+					{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Foo"}}},
+				},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec label selector is invalid: \"Foo\" is not a valid label selector operator",
+		},
+		{
+			name: "invalid spec, old and new resource filters",
+			spec: &velerov1.BackupSpec{
+				IncludedResources:                []string{"configmaps"},
+				ExcludedNamespaceScopedResources: []string{"secrets"},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec includedResources, excludedResources and includeClusterResources " +
+				"can not be used together with includedNamespaceScopedResources, excludedNamespaceScopedResources and excludedClusterScopedResources",
+		},
+		{
+			name: "invalid spec, wildcard with other resources",
+			spec: &velerov1.BackupSpec{
+				IncludedResources: []string{"*", "configmaps"},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec.includedResources must either contain '*' only, or a list of resources",
+		},
+		{
+			name: "invalid spec, partial wildcard",
+			spec: &velerov1.BackupSpec{
+				IncludedResources: []string{"config*"},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec resource filter \"config*\" is invalid: wildcard is only supported as the whole filter",
+		},
+		{
+			name: "invalid spec, excluded wildcard",
+			spec: &velerov1.BackupSpec{
+				ExcludedResources: []string{"*"},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec.excludedResources can not contain '*'",
+		},
+		{
+			name: "invalid spec, excluded scoped wildcard with included resources",
+			spec: &velerov1.BackupSpec{
+				IncludedNamespaceScopedResources: []string{"configmaps"},
+				ExcludedNamespaceScopedResources: []string{"*"},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec.includedNamespaceScopedResources must be empty when spec.backupSpec.excludedNamespaceScopedResources is '*'",
+		},
+		{
+			name: "invalid spec, resource included and excluded",
+			spec: &velerov1.BackupSpec{
+				IncludedResources: []string{"configmaps", "secrets"},
+				ExcludedResources: []string{"secrets"},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec.excludedResources can not contain \"secrets\", which is in spec.backupSpec.includedResources",
+		},
+		{
+			name: "valid spec, resource policy ConfigMap in the NonAdminBackup namespace",
+			spec: &velerov1.BackupSpec{
+				ResourcePolicy: &corev1.TypedLocalObjectReference{Kind: "ConfigMap", Name: "resource-policy"},
+			},
+		},
+		{
+			name: "invalid spec, resource policy ConfigMap not found",
+			spec: &velerov1.BackupSpec{
+				ResourcePolicy: &corev1.TypedLocalObjectReference{Kind: "configmap", Name: "other-resource-policy"},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec.resourcePolicy ConfigMap other-resource-policy not found in the namespace",
+		},
+		{
+			name: "invalid spec, resource policy kind",
+			spec: &velerov1.BackupSpec{
+				ResourcePolicy: &corev1.TypedLocalObjectReference{Kind: "Secret", Name: "resource-policy"},
+			},
+			errMessage: fmt.Sprintf(constant.NABRestrictedErr+", kind must be configmap", "spec.backupSpec.resourcePolicy"),
+		},
+		{
+			name: "valid spec, ordered resources in the NonAdminBackup namespace",
+			spec: &velerov1.BackupSpec{
+				OrderedResources: map[string]string{
+					"pods":                   testNonAdminBackupNamespace + "/pod1, " + testNonAdminBackupNamespace + "/pod2",
+					"persistentvolumeclaims": testNonAdminBackupNamespace + "/pvc1",
+				},
+				ItemOperationTimeout: metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name: "invalid spec, ordered resources in other namespace",
+			spec: &velerov1.BackupSpec{
+				OrderedResources: map[string]string{
+					"pods": testNonAdminBackupNamespace + "/pod1,openshift-adp/velero",
+				},
+			},
+			errMessage: fmt.Sprintf(constant.NABRestrictedErr+", pods item \"openshift-adp/velero\" must be namespace/name in the namespaces: %s",
+				"spec.backupSpec.orderedResources", testNonAdminBackupNamespace),
+		},
+		{
+			name: "invalid spec, cluster scoped ordered resources",
+			spec: &velerov1.BackupSpec{
+				OrderedResources: map[string]string{
+					"persistentvolumes": "pv1",
+				},
+			},
+			errMessage: fmt.Sprintf(constant.NABRestrictedErr+", persistentvolumes item \"pv1\" must be namespace/name in the namespaces: %s",
+				"spec.backupSpec.orderedResources", testNonAdminBackupNamespace),
+		},
+		{
+			name: "invalid spec, negative item operation timeout",
+			spec: &velerov1.BackupSpec{
+				ItemOperationTimeout: metav1.Duration{Duration: -time.Hour},
+			},
+			errMessage: "NonAdminBackup spec.backupSpec.itemOperationTimeout is invalid, can not be negative",
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -167,9 +333,14 @@ func TestValidateBackupSpec(t *testing.T) {
 			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
 				t.Fatalf("Failed to register NAC type: %v", err)
 			}
-			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).Build()
+			if err := corev1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register corev1 type: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "resource-policy", Namespace: testNonAdminBackupNamespace},
+			}).Build()
 
-			err := ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", nonAdminBackup, &velerov1.BackupSpec{})
+			err := ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", nonAdminBackup, &velerov1.BackupSpec{}, false)
 			if len(test.errMessage) == 0 {
 				assert.NoError(t, err)
 			} else {
@@ -180,6 +351,289 @@ func TestValidateBackupSpec(t *testing.T) {
 	}
 }
 
+func TestValidateBackupSpecReadOnlyStorageLocation(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	if err := velerov1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register velero type: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(
+		&nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "historical", Namespace: testNonAdminBackupNamespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				AccessMode: velerov1.BackupStorageLocationAccessModeReadOnly,
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: "historical-uuid"},
+			},
+		},
+		&velerov1.BackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "historical-uuid",
+				Namespace: "oadp-namespace",
+				Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: "historical-uuid"},
+			},
+			Spec:   velerov1.BackupStorageLocationSpec{AccessMode: velerov1.BackupStorageLocationAccessModeReadOnly},
+			Status: velerov1.BackupStorageLocationStatus{Phase: velerov1.BackupStorageLocationPhaseAvailable},
+		},
+	).Build()
+
+	err := ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNonAdminBackupNamespace},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: &velerov1.BackupSpec{StorageLocation: "historical"},
+		},
+	}, &velerov1.BackupSpec{}, false)
+	assert.EqualError(t, err, "NonAdminBackupStorageLocation historical is ReadOnly and can only be used to restore its backups")
+}
+
+func TestValidateBackupSpecPausedStorageLocation(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(
+		&nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "maintenance", Namespace: testNonAdminBackupNamespace},
+			Spec:       nacv1alpha1.NonAdminBackupStorageLocationSpec{Paused: true},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: "maintenance-uuid"},
+			},
+		},
+	).Build()
+
+	err := ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNonAdminBackupNamespace},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: &velerov1.BackupSpec{StorageLocation: "maintenance"},
+		},
+	}, &velerov1.BackupSpec{}, false)
+	assert.EqualError(t, err, "NonAdminBackupStorageLocation maintenance is paused and can not be used for the NonAdminBackup")
+}
+
+func TestValidateBackupSpecVolumeSnapshotLocations(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	if err := velerov1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register velero type: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(
+		&nacv1alpha1.NonAdminVolumeSnapshotLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshots", Namespace: testNonAdminBackupNamespace},
+			Status: nacv1alpha1.NonAdminVolumeSnapshotLocationStatus{
+				Phase:                        nacv1alpha1.NonAdminPhaseCreated,
+				VeleroVolumeSnapshotLocation: &nacv1alpha1.VeleroVolumeSnapshotLocation{NACUUID: "snapshots-uuid"},
+			},
+		},
+		&velerov1.VolumeSnapshotLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "snapshots-uuid",
+				Namespace: "oadp-namespace",
+				Labels:    map[string]string{constant.NavslOriginNACUUIDLabel: "snapshots-uuid"},
+			},
+		},
+		&nacv1alpha1.NonAdminVolumeSnapshotLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: testNonAdminBackupNamespace},
+			Status:     nacv1alpha1.NonAdminVolumeSnapshotLocationStatus{Phase: nacv1alpha1.NonAdminPhaseNew},
+		},
+		&nacv1alpha1.NonAdminVolumeSnapshotLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "deleted", Namespace: testNonAdminBackupNamespace},
+			Status: nacv1alpha1.NonAdminVolumeSnapshotLocationStatus{
+				Phase:                        nacv1alpha1.NonAdminPhaseCreated,
+				VeleroVolumeSnapshotLocation: &nacv1alpha1.VeleroVolumeSnapshotLocation{NACUUID: "deleted-uuid"},
+			},
+		},
+	).Build()
+
+	tests := []struct {
+		name                    string
+		errMessage              string
+		volumeSnapshotLocations []string
+	}{
+		{
+			name:                    "created NonAdminVolumeSnapshotLocation",
+			volumeSnapshotLocations: []string{"snapshots"},
+		},
+		{
+			name:                    "NonAdminVolumeSnapshotLocation not found",
+			volumeSnapshotLocations: []string{"snapshots", "missing"},
+			errMessage:              "NonAdminVolumeSnapshotLocation missing not found in the namespace: nonadminvolumesnapshotlocations.oadp.openshift.io \"missing\" not found",
+		},
+		{
+			name:                    "NonAdminVolumeSnapshotLocation not created",
+			volumeSnapshotLocations: []string{"pending"},
+			errMessage:              "NonAdminVolumeSnapshotLocation pending is not in created state and can not be used for the NonAdminBackup",
+		},
+		{
+			name:                    "VeleroVolumeSnapshotLocation deleted",
+			volumeSnapshotLocations: []string{"deleted"},
+			errMessage:              "VeleroVolumeSnapshotLocation with NACUUID deleted-uuid not found in the OADP namespace",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", &nacv1alpha1.NonAdminBackup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNonAdminBackupNamespace},
+				Spec: nacv1alpha1.NonAdminBackupSpec{
+					BackupSpec: &velerov1.BackupSpec{VolumeSnapshotLocations: test.volumeSnapshotLocations},
+				},
+			}, &velerov1.BackupSpec{}, false)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestValidateVslSpec(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	if err := corev1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register corev1 type: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot-credentials", Namespace: testNonAdminBackupNamespace},
+			Data:       map[string][]byte{"cloud": []byte("[default]")},
+		},
+	).Build()
+
+	tests := []struct {
+		vslSpec  *velerov1.VolumeSnapshotLocationSpec
+		name     string
+		errorMsg string
+	}{
+		{
+			name:     "Without spec",
+			errorMsg: "NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec is not defined",
+		},
+		{
+			name:     "Without provider",
+			vslSpec:  &velerov1.VolumeSnapshotLocationSpec{},
+			errorMsg: "NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.provider is not set",
+		},
+		{
+			name:     "Without credential",
+			vslSpec:  &velerov1.VolumeSnapshotLocationSpec{Provider: "aws"},
+			errorMsg: "NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.credential is not set",
+		},
+		{
+			name: "Without credential key",
+			vslSpec: &velerov1.VolumeSnapshotLocationSpec{
+				Provider: "aws",
+				Credential: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "snapshot-credentials"},
+				},
+			},
+			errorMsg: "NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.credential.name or spec.volumeSnapshotLocationSpec.credential.key is not set",
+		},
+		{
+			name: "Credential key not in the Secret",
+			vslSpec: &velerov1.VolumeSnapshotLocationSpec{
+				Provider: "aws",
+				Credential: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "snapshot-credentials"},
+					Key:                  "other",
+				},
+			},
+			errorMsg: "NonAdminVolumeSnapshotLocation spec.volumeSnapshotLocationSpec.credential key other not found in Secret snapshot-credentials",
+		},
+		{
+			name: "Valid spec",
+			vslSpec: &velerov1.VolumeSnapshotLocationSpec{
+				Provider: "aws",
+				Credential: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "snapshot-credentials"},
+					Key:                  "cloud",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nonAdminVsl := &nacv1alpha1.NonAdminVolumeSnapshotLocation{
+				ObjectMeta: metav1.ObjectMeta{Name: "snapshots", Namespace: testNonAdminBackupNamespace},
+				Spec:       nacv1alpha1.NonAdminVolumeSnapshotLocationSpec{VolumeSnapshotLocationSpec: test.vslSpec},
+			}
+			err := ValidateVslSpec(context.Background(), fakeClient, nonAdminVsl)
+			if test.errorMsg != "" {
+				assert.EqualError(t, err, test.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBackupObjectLockTTL(t *testing.T) {
+	nonAdminBsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "immutable"},
+		Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+			ObjectLock: &nacv1alpha1.ObjectLock{Mode: nacv1alpha1.ObjectLockModeGovernance, RetentionPeriod: metav1.Duration{Duration: 168 * time.Hour}},
+		},
+	}
+	tests := []struct {
+		name        string
+		ttl         time.Duration
+		enforcedTTL time.Duration
+		nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation
+		errorMsg    string
+	}{
+		{
+			name:        "No object lock",
+			ttl:         time.Hour,
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{},
+		},
+		{
+			name:        "Default ttl",
+			nonAdminBsl: nonAdminBsl,
+		},
+		{
+			name:        "Ttl longer than the retention period",
+			ttl:         720 * time.Hour,
+			nonAdminBsl: nonAdminBsl,
+		},
+		{
+			name:        "Ttl shorter than the retention period",
+			ttl:         24 * time.Hour,
+			nonAdminBsl: nonAdminBsl,
+			errorMsg:    "NonAdminBackup spec.backupSpec.ttl (24h0m0s) can not be lower than the objectLock retentionPeriod (168h0m0s) of NonAdminBackupStorageLocation immutable",
+		},
+		{
+			name:        "Enforced ttl shorter than the retention period",
+			enforcedTTL: time.Hour,
+			nonAdminBsl: nonAdminBsl,
+			errorMsg:    "NonAdminBackup spec.backupSpec.ttl (1h0m0s) can not be lower than the objectLock retentionPeriod (168h0m0s) of NonAdminBackupStorageLocation immutable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBackupObjectLockTTL(
+				&velerov1.BackupSpec{TTL: metav1.Duration{Duration: tt.ttl}},
+				&velerov1.BackupSpec{TTL: metav1.Duration{Duration: tt.enforcedTTL}},
+				tt.nonAdminBsl,
+			)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
 func TestValidateBackupSpecEnforcedFields(t *testing.T) {
 	all := "*"
 
@@ -337,7 +791,7 @@ func TestValidateBackupSpecEnforcedFields(t *testing.T) {
 		{
 			name: "OrderedResources",
 			enforcedValue: map[string]string{
-				"pods": "ns1/pod1,ns2/pod2",
+				"pods": "self-service-namespace/pod1,self-service-namespace/pod2",
 			},
 			overrideValue: map[string]string{},
 		},
@@ -427,13 +881,13 @@ func TestValidateBackupSpecEnforcedFields(t *testing.T) {
 				},
 			).Build()
 
-			err := ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminBackup, enforcedSpec)
+			err := ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminBackup, enforcedSpec, false)
 			if err != nil {
 				t.Errorf("not setting backup spec field '%v' test failed: %v", test.name, err)
 			}
 
 			reflect.ValueOf(userNonAdminBackup.Spec.BackupSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
-			err = ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminBackup, enforcedSpec)
+			err = ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminBackup, enforcedSpec, false)
 			if test.expectErrorEnforced {
 				if err == nil {
 					t.Errorf("expected error when setting field '%v' to enforced value, but got none", test.name)
@@ -445,7 +899,7 @@ func TestValidateBackupSpecEnforcedFields(t *testing.T) {
 			}
 
 			reflect.ValueOf(userNonAdminBackup.Spec.BackupSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.overrideValue))
-			err = ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminBackup, enforcedSpec)
+			err = ValidateBackupSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminBackup, enforcedSpec, false)
 			if err == nil {
 				t.Errorf("setting backup spec field '%v' with value overriding enforcement test failed: %v", test.name, err)
 			}
@@ -470,775 +924,4282 @@ func TestValidateBackupSpecEnforcedFields(t *testing.T) {
 	})
 }
 
-func TestValidateRestoreSpec(t *testing.T) {
+func TestValidateScheduleSpec(t *testing.T) {
 	tests := []struct {
-		name            string
-		errorMessage    string
-		nonAdminRestore *nacv1alpha1.NonAdminRestore
-		objects         []client.Object
+		spec               *velerov1.ScheduleSpec
+		enforcedBackupSpec *velerov1.BackupSpec
+		name               string
+		errMessage         string
 	}{
 		{
-			name: "[invalid] spec.restoreSpec.backupName not set",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{},
-				},
+			name:       "schedule spec not defined",
+			errMessage: "NonAdminSchedule spec.scheduleSpec is not defined",
+		},
+		{
+			name:       "schedule not defined",
+			spec:       &velerov1.ScheduleSpec{Schedule: " "},
+			errMessage: "NonAdminSchedule spec.scheduleSpec.schedule is not defined",
+		},
+		{
+			name: "valid spec",
+			spec: &velerov1.ScheduleSpec{
+				Schedule:                   "0 1 * * *",
+				UseOwnerReferencesInBackup: ptr.To(false),
 			},
-			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is not set",
 		},
 		{
-			name: "[invalid] spec.restoreSpec.backupName does not exist",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						BackupName: "john",
-					},
-				},
+			name: "non admin users specify useOwnerReferencesInBackup as true",
+			spec: &velerov1.ScheduleSpec{
+				Schedule:                   "0 1 * * *",
+				UseOwnerReferencesInBackup: ptr.To(true),
 			},
-			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: nonadminbackups.oadp.openshift.io \"john\" not found",
+			errMessage: fmt.Sprintf(constant.NASRestrictedErr+", can only be set to false", "spec.scheduleSpec.useOwnerReferencesInBackup"),
 		},
 		{
-			name: "[invalid] spec.restoreSpec.backupName not ready",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: defaultNS,
-				},
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						BackupName: "peter",
-					},
+			name: "non admin users specify template resourcePolicy",
+			spec: &velerov1.ScheduleSpec{
+				Schedule: "0 1 * * *",
+				Template: velerov1.BackupSpec{
+					ResourcePolicy: &corev1.TypedLocalObjectReference{Kind: "configmap", Name: "policy"},
 				},
 			},
-			objects: []client.Object{
-				&nacv1alpha1.NonAdminBackup{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "peter",
-						Namespace: defaultNS,
-					},
-					Status: nacv1alpha1.NonAdminBackupStatus{
-						Phase: nacv1alpha1.NonAdminPhaseBackingOff,
-					},
+			errMessage: fmt.Sprintf(constant.NASRestrictedErr, "spec.scheduleSpec.template.resourcePolicy"),
+		},
+		{
+			name: "template resourcePolicy enforced by admin",
+			spec: &velerov1.ScheduleSpec{
+				Schedule: "0 1 * * *",
+				Template: velerov1.BackupSpec{
+					ResourcePolicy: &corev1.TypedLocalObjectReference{Kind: "configmap", Name: "policy"},
 				},
 			},
-			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: NonAdminBackup is not ready to be restored",
+			enforcedBackupSpec: &velerov1.BackupSpec{
+				ResourcePolicy: &corev1.TypedLocalObjectReference{Kind: "configmap", Name: "policy"},
+			},
 		},
-		{
-			name: "[valid] spec.restoreSpec.backupName is ready",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nonAdminSchedule := &nacv1alpha1.NonAdminSchedule{
 				ObjectMeta: metav1.ObjectMeta{
-					Namespace: defaultNS,
+					Name:      "non-admin-schedule",
+					Namespace: testNonAdminBackupNamespace,
 				},
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						BackupName: "steve",
-					},
+				Spec: nacv1alpha1.NonAdminScheduleSpec{
+					ScheduleSpec: test.spec,
 				},
-			},
-			objects: []client.Object{
-				&nacv1alpha1.NonAdminBackup{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "steve",
-						Namespace: defaultNS,
-					},
-					Status: nacv1alpha1.NonAdminBackupStatus{
-						Phase: nacv1alpha1.NonAdminPhaseCreated,
-					},
+			}
+			enforcedBackupSpec := test.enforcedBackupSpec
+			if enforcedBackupSpec == nil {
+				enforcedBackupSpec = &velerov1.BackupSpec{}
+			}
+			err := ValidateScheduleSpec(nonAdminSchedule, enforcedBackupSpec)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestValidateBackupExecHooks(t *testing.T) {
+	execHook := func(command ...string) velerov1.BackupResourceHook {
+		return velerov1.BackupResourceHook{Exec: &velerov1.ExecHook{Command: command}}
+	}
+	hooks := velerov1.BackupHooks{
+		Resources: []velerov1.BackupResourceHookSpec{
+			{
+				Name:     "freeze",
+				PreHooks: []velerov1.BackupResourceHook{execHook("/sbin/fsfreeze", "--freeze", "/data")},
+				PostHooks: []velerov1.BackupResourceHook{
+					execHook("/sbin/fsfreeze", "--unfreeze", "/data"),
+					execHook("/bin/sh", "-c", "echo done"),
 				},
 			},
 		},
+	}
+
+	tests := []struct {
+		name             string
+		errorMsg         string
+		allowedCommands  []string
+		hooks            velerov1.BackupHooks
+		disableExecHooks bool
+	}{
 		{
-			name: "[invalid] spec.restoreSpec.scheduleName is restricted",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: defaultNS,
-				},
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						ScheduleName: "foo-schedule",
-					},
-				},
-			},
-			objects: []client.Object{
-				&nacv1alpha1.NonAdminBackup{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "foo-schedule",
-						Namespace: defaultNS,
-					},
-					Status: nacv1alpha1.NonAdminBackupStatus{
-						Phase: nacv1alpha1.NonAdminPhaseCreated,
-					},
-				},
+			name: "No hooks with exec hooks disabled",
+			hooks: velerov1.BackupHooks{
+				Resources: []velerov1.BackupResourceHookSpec{{Name: "empty"}},
 			},
-			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.scheduleName is restricted",
+			disableExecHooks: true,
 		},
 		{
-			name: "[invalid] spec.restoreSpec.includedNamespaces is restricted",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: defaultNS,
-				},
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						BackupName:         "foo-backup-incl",
-						IncludedNamespaces: []string{"foo-bar-ns"},
-					},
-				},
-			},
-			objects: []client.Object{
-				&nacv1alpha1.NonAdminBackup{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "foo-backup-incl",
-						Namespace: defaultNS,
-					},
-					Status: nacv1alpha1.NonAdminBackupStatus{
-						Phase: nacv1alpha1.NonAdminPhaseCreated,
-					},
-				},
-			},
-			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.includedNamespaces is restricted",
+			name:  "Any command allowed by default",
+			hooks: hooks,
 		},
 		{
-			name: "[invalid] spec.restoreSpec.excludedNamespaces is restricted",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: defaultNS,
-				},
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						BackupName:         "foo-backup-ns-ex",
-						ExcludedNamespaces: []string{"foo-bar-ns"},
-					},
+			name:             "Exec hooks disabled",
+			hooks:            hooks,
+			disableExecHooks: true,
+			errorMsg:         "NonAdminBackup spec.backupSpec.hooks.resources[0].pre[0].exec is restricted, exec hooks are disabled by the administrator",
+		},
+		{
+			name:            "All commands allowed",
+			hooks:           hooks,
+			allowedCommands: []string{"/sbin/fsfreeze", "/bin/sh"},
+		},
+		{
+			name:            "Command not allowed",
+			hooks:           hooks,
+			allowedCommands: []string{"/sbin/fsfreeze", "/usr/bin/sync"},
+			errorMsg:        "NonAdminBackup spec.backupSpec.hooks.resources[0].post[1].exec is restricted, command must run one of: /sbin/fsfreeze, /usr/bin/sync",
+		},
+		{
+			name: "Empty command not allowed",
+			hooks: velerov1.BackupHooks{
+				Resources: []velerov1.BackupResourceHookSpec{
+					{Name: "empty", PreHooks: []velerov1.BackupResourceHook{execHook()}},
 				},
 			},
-			objects: []client.Object{
-				&nacv1alpha1.NonAdminBackup{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "foo-backup-ns-ex",
-						Namespace: defaultNS,
-					},
-					Status: nacv1alpha1.NonAdminBackupStatus{
-						Phase: nacv1alpha1.NonAdminPhaseCreated,
-					},
+			allowedCommands: []string{"/sbin/fsfreeze"},
+			errorMsg:        "NonAdminBackup spec.backupSpec.hooks.resources[0].pre[0].exec is restricted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBackupExecHooks(&velerov1.BackupSpec{Hooks: tt.hooks}, tt.disableExecHooks, tt.allowedCommands)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestValidateRestoreHooks(t *testing.T) {
+	initHook := func(images ...string) velerov1.RestoreResourceHook {
+		initContainers := []runtime.RawExtension{}
+		for _, image := range images {
+			initContainers = append(initContainers, runtime.RawExtension{Raw: []byte(`{"name":"init","image":"` + image + `"}`)})
+		}
+		return velerov1.RestoreResourceHook{Init: &velerov1.InitRestoreHook{InitContainers: initContainers}}
+	}
+	hooks := velerov1.RestoreHooks{
+		Resources: []velerov1.RestoreResourceHookSpec{
+			{
+				Name: "database",
+				PostHooks: []velerov1.RestoreResourceHook{
+					initHook("registry.example.com/db/restore:1.0", "registry.example.com/db/check@sha256:abcd"),
+					{Exec: &velerov1.ExecRestoreHook{Command: []string{"/usr/bin/psql", "-f", "/data/init.sql"}}},
 				},
 			},
-			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.excludedNamespaces is restricted",
 		},
+	}
+
+	tests := []struct {
+		name            string
+		errorMsg        string
+		allowedCommands []string
+		allowedImages   []string
+		hooks           velerov1.RestoreHooks
+		disableHooks    bool
+	}{
 		{
-			name: "[invalid] spec.restoreSpec.namespaceMapping is restricted",
-			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: defaultNS,
-				},
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						BackupName: "foo-backup-ns-map",
-						NamespaceMapping: map[string]string{
-							"foo-ns": "bar-ns",
-						},
-					},
-				},
-			},
-			objects: []client.Object{
-				&nacv1alpha1.NonAdminBackup{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "foo-backup-ns-map",
-						Namespace: defaultNS,
-					},
-					Status: nacv1alpha1.NonAdminBackupStatus{
-						Phase: nacv1alpha1.NonAdminPhaseCreated,
-					},
+			name:  "Any hook allowed by default",
+			hooks: hooks,
+		},
+		{
+			name:         "Hooks disabled",
+			hooks:        hooks,
+			disableHooks: true,
+			errorMsg:     "NonAdminRestore nonAdminRestore.spec.restoreSpec.hooks.resources[0].postHooks[0].init.initContainers[0] is restricted, init hooks are disabled by the administrator",
+		},
+		{
+			name:            "All commands and images allowed",
+			hooks:           hooks,
+			allowedCommands: []string{"/usr/bin/psql"},
+			allowedImages:   []string{"registry.example.com/db/restore", "registry.example.com/db/check"},
+		},
+		{
+			name:            "Command not allowed",
+			hooks:           hooks,
+			allowedCommands: []string{"/usr/bin/mysql"},
+			errorMsg:        "NonAdminRestore nonAdminRestore.spec.restoreSpec.hooks.resources[0].postHooks[1].exec is restricted, command must run one of: /usr/bin/mysql",
+		},
+		{
+			name:          "Image not allowed",
+			hooks:         hooks,
+			allowedImages: []string{"registry.example.com/db/restore"},
+			errorMsg:      "NonAdminRestore nonAdminRestore.spec.restoreSpec.hooks.resources[0].postHooks[0].init.initContainers[1] is restricted, image must be one of: registry.example.com/db/restore",
+		},
+		{
+			name: "Image with registry port",
+			hooks: velerov1.RestoreHooks{
+				Resources: []velerov1.RestoreResourceHookSpec{
+					{Name: "port", PostHooks: []velerov1.RestoreResourceHook{initHook("registry.example.com:5000/db/restore")}},
 				},
 			},
-			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.namespaceMapping is restricted",
+			allowedImages: []string{"registry.example.com:5000/db/restore"},
 		},
 	}
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			fakeScheme := runtime.NewScheme()
-			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
-				t.Fatalf("Failed to register NAC type: %v", err)
-			}
-			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(test.objects...).Build()
-			err := ValidateRestoreSpec(context.Background(), fakeClient, test.nonAdminRestore, &velerov1.RestoreSpec{})
-			if err != nil {
-				if test.errorMessage != err.Error() {
-					t.Errorf("test '%s' failed: error messages differ. Expected %v, got %v", test.name, test.errorMessage, err)
-				}
-				return
-			}
-			if test.errorMessage != constant.EmptyString {
-				t.Errorf("test '%s' failed: expected test to error with '%v'", test.name, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRestoreHooks(&velerov1.RestoreSpec{Hooks: tt.hooks}, tt.disableHooks, tt.allowedCommands, tt.allowedImages)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
 			}
 		})
 	}
 }
 
-func TestValidateRestoreSpecEnforcedFields(t *testing.T) {
+// requesterWebhookConfigurations returns the admission webhook configurations recording the requester annotations
+// of resource
+func requesterWebhookConfigurations(resource string, mutatingWebhookName string, validatingWebhookName string, failurePolicy admissionregistrationv1.FailurePolicyType) []client.Object {
+	rules := func(operation admissionregistrationv1.OperationType) []admissionregistrationv1.RuleWithOperations {
+		return []admissionregistrationv1.RuleWithOperations{{
+			Operations: []admissionregistrationv1.OperationType{operation},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{nacv1alpha1.GroupVersion.Group},
+				APIVersions: []string{nacv1alpha1.GroupVersion.Version},
+				Resources:   []string{resource},
+			},
+		}}
+	}
+	return []client.Object{
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "mutating-webhook-configuration"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{{
+				Name:          mutatingWebhookName,
+				FailurePolicy: &failurePolicy,
+				Rules:         rules(admissionregistrationv1.Create),
+			}},
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "validating-webhook-configuration"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name:          validatingWebhookName,
+				FailurePolicy: &failurePolicy,
+				Rules:         rules(admissionregistrationv1.Update),
+			}},
+		},
+	}
+}
+
+func TestCheckRequesterWebhooksConfigured(t *testing.T) {
+	errMessage := "admission webhooks mnonadminbackup.oadp.openshift.io and vnonadminbackup.oadp.openshift.io recording the requester are not configured " +
+		"with failurePolicy Fail, for the creation and update of every object of nonadminbackups"
 	tests := []struct {
-		enforcedValue       any
-		overrideValue       any
-		name                string
-		expectErrorEnforced bool
+		update     func(mutatingWebhook *admissionregistrationv1.MutatingWebhook, validatingWebhook *admissionregistrationv1.ValidatingWebhook)
+		name       string
+		errMessage string
+		objects    []client.Object
 	}{
 		{
-			name:                "IncludedNamespaces",
-			enforcedValue:       []string{"self-service-namespace"},
-			overrideValue:       []string{"openshift-monitor"},
-			expectErrorEnforced: true,
+			name:    "webhooks configured",
+			objects: requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
 		},
 		{
-			name:                "ExcludedNamespaces",
-			enforcedValue:       []string{"openshift-monitor"},
-			overrideValue:       []string{"cherry"},
-			expectErrorEnforced: true,
+			name:    "webhooks configured with wildcard rules and empty selectors",
+			objects: requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
+			update: func(mutatingWebhook *admissionregistrationv1.MutatingWebhook, validatingWebhook *admissionregistrationv1.ValidatingWebhook) {
+				mutatingWebhook.Rules[0].Operations = []admissionregistrationv1.OperationType{admissionregistrationv1.OperationAll}
+				mutatingWebhook.Rules[0].Resources = []string{"*"}
+				mutatingWebhook.NamespaceSelector = &metav1.LabelSelector{}
+				validatingWebhook.Rules[0].APIVersions = []string{"*"}
+				validatingWebhook.ObjectSelector = &metav1.LabelSelector{}
+			},
 		},
 		{
-			name:          "BackupName",
-			enforcedValue: "self-service-backup",
-			overrideValue: "another",
+			name:       "webhooks not configured",
+			errMessage: errMessage,
 		},
 		{
-			name:                "ScheduleName",
-			enforcedValue:       "allowed",
-			overrideValue:       "not-alllowed",
-			expectErrorEnforced: true,
+			name:       "webhooks of another kind configured",
+			objects:    requesterWebhookConfigurations(nacv1alpha1.NonAdminRestores, constant.NarMutatingWebhookName, constant.NarValidatingWebhookName, admissionregistrationv1.Fail),
+			errMessage: errMessage,
 		},
 		{
-			name:          "IncludedResources",
-			enforcedValue: []string{"deployments"},
-			overrideValue: []string{"secrets"},
+			name:       "webhooks ignoring failures",
+			objects:    requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Ignore),
+			errMessage: errMessage,
 		},
 		{
-			name:          "ExcludedResources",
-			enforcedValue: []string{"foobar.io"},
-			overrideValue: []string{},
+			name:       "webhooks for another resource",
+			objects:    requesterWebhookConfigurations(nacv1alpha1.NonAdminRestores, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
+			errMessage: errMessage,
 		},
 		{
-			name:          "IncludeClusterResources",
-			enforcedValue: ptr.To(true),
-			overrideValue: ptr.To(false),
+			name:    "mutating webhook not intercepting creations",
+			objects: requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
+			update: func(mutatingWebhook *admissionregistrationv1.MutatingWebhook, _ *admissionregistrationv1.ValidatingWebhook) {
+				mutatingWebhook.Rules[0].Operations = []admissionregistrationv1.OperationType{admissionregistrationv1.Update}
+			},
+			errMessage: errMessage,
 		},
 		{
-			name: "Hooks",
-			enforcedValue: velerov1.RestoreHooks{
-				Resources: []velerov1.RestoreResourceHookSpec{
-					{
-						Name: "computer",
-					},
-				},
-			},
-			overrideValue: velerov1.RestoreHooks{
-				Resources: []velerov1.RestoreResourceHookSpec{
-					{
-						Name: "microwave",
-					},
-				},
+			name:    "validating webhook without rules",
+			objects: requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
+			update: func(_ *admissionregistrationv1.MutatingWebhook, validatingWebhook *admissionregistrationv1.ValidatingWebhook) {
+				validatingWebhook.Rules = nil
 			},
+			errMessage: errMessage,
 		},
 		{
-			name: "LabelSelector",
-			enforcedValue: &metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					"grapes": constant.TrueString,
-				},
-			},
-			overrideValue: &metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					constant.OadpLabel: constant.TrueString,
-				},
+			name:    "mutating webhook with a namespace selector",
+			objects: requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
+			update: func(mutatingWebhook *admissionregistrationv1.MutatingWebhook, _ *admissionregistrationv1.ValidatingWebhook) {
+				mutatingWebhook.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}}
 			},
+			errMessage: errMessage,
 		},
 		{
-			name: "OrLabelSelectors",
-			enforcedValue: []*metav1.LabelSelector{
-				{
-					MatchLabels: map[string]string{
-						"kiwi": constant.TrueString,
-					},
-				},
-				{
-					MatchLabels: map[string]string{
-						"green": "no",
-					},
-				},
-			},
-			overrideValue: []*metav1.LabelSelector{
-				{
-					MatchLabels: map[string]string{
-						constant.OadpLabel: constant.TrueString,
-					},
-				},
+			name:    "mutating webhook with a match condition",
+			objects: requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
+			update: func(mutatingWebhook *admissionregistrationv1.MutatingWebhook, _ *admissionregistrationv1.ValidatingWebhook) {
+				mutatingWebhook.MatchConditions = []admissionregistrationv1.MatchCondition{{
+					Name:       "not-tenant",
+					Expression: "request.userInfo.username != 'tenant'",
+				}}
 			},
+			errMessage: errMessage,
 		},
 		{
-			name:          "ItemOperationTimeout",
-			enforcedValue: metav1.Duration{Duration: 30 * time.Minute}, //nolint:revive // just test
-			overrideValue: metav1.Duration{Duration: time.Hour},
+			name:    "validating webhook with an object selector",
+			objects: requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail),
+			update: func(_ *admissionregistrationv1.MutatingWebhook, validatingWebhook *admissionregistrationv1.ValidatingWebhook) {
+				validatingWebhook.ObjectSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+					Key:      "skip-webhook",
+					Operator: metav1.LabelSelectorOpDoesNotExist,
+				}}}
+			},
+			errMessage: errMessage,
 		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.update != nil {
+				test.update(&test.objects[0].(*admissionregistrationv1.MutatingWebhookConfiguration).Webhooks[0],
+					&test.objects[1].(*admissionregistrationv1.ValidatingWebhookConfiguration).Webhooks[0])
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(test.objects...).Build()
+			err := CheckRequesterWebhooksConfigured(context.Background(), fakeClient, nacv1alpha1.NonAdminBackups,
+				constant.NabMutatingWebhookName, constant.NabValidatingWebhookName)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestGetRequester(t *testing.T) {
+	tests := []struct {
+		annotations map[string]string
+		expected    authenticationv1.UserInfo
+		name        string
+	}{
 		{
-			name: "UploaderConfig",
-			enforcedValue: &velerov1.UploaderConfigForRestore{
-				ParallelFilesDownload: 2, //nolint:revive // just test
-			},
-			overrideValue: &velerov1.UploaderConfigForRestore{
-				ParallelFilesDownload: 32, //nolint:revive // just test
+			name: "recorded requester",
+			annotations: GetRequesterAnnotations(authenticationv1.UserInfo{
+				Username: "tenant",
+				UID:      "tenant-uid",
+				Groups:   []string{"system:authenticated", "cn=tenants,ou=groups"},
+				Extra:    map[string]authenticationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:info", "user:check-access"}},
+			}, NonAdminBackupRequesterAnnotations),
+			expected: authenticationv1.UserInfo{
+				Username: "tenant",
+				UID:      "tenant-uid",
+				Groups:   []string{"system:authenticated", "cn=tenants,ou=groups"},
+				Extra:    map[string]authenticationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:info", "user:check-access"}},
 			},
 		},
 		{
-			name:          "RestorePVs",
-			enforcedValue: ptr.To(true),
-			overrideValue: ptr.To(false),
+			name:        "recorded requester without groups nor extra",
+			annotations: GetRequesterAnnotations(authenticationv1.UserInfo{Username: "tenant"}, NonAdminBackupRequesterAnnotations),
+			expected:    authenticationv1.UserInfo{Username: "tenant"},
 		},
 		{
-			name:          "PreserveNodePorts",
-			enforcedValue: ptr.To(true),
-			overrideValue: ptr.To(false),
+			name: "groups and extra that can not be decoded",
+			annotations: map[string]string{
+				constant.NabRequesterUsernameAnnotation: "tenant",
+				constant.NabRequesterGroupsAnnotation:   "system:authenticated,system:masters",
+				constant.NabRequesterExtraAnnotation:    "scopes",
+			},
+			expected: authenticationv1.UserInfo{Username: "tenant"},
 		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, getRequester(test.annotations, NonAdminBackupRequesterAnnotations))
+		})
+	}
+}
+
+func TestCheckRequesterCanBackupNamespaces(t *testing.T) {
+	requesterAnnotations := map[string]string{
+		constant.NabRequesterUsernameAnnotation: "tenant",
+		constant.NabRequesterUIDAnnotation:      "tenant-uid",
+		constant.NabRequesterGroupsAnnotation:   `["system:authenticated","tenants"]`,
+		constant.NabRequesterExtraAnnotation:    `{"scopes.authorization.openshift.io":["user:full"]}`,
+	}
+	tests := []struct {
+		annotations           map[string]string
+		name                  string
+		includedNamespaces    []string
+		allowedNamespaces     []string
+		errMessage            string
+		webhooksNotConfigured bool
+	}{
 		{
-			name: "NamespaceMapping",
-			enforcedValue: map[string]string{
-				"video": "game",
-			},
-			overrideValue: map[string]string{
-				"movie": "star",
-			},
-			expectErrorEnforced: true,
+			name:               "requester allowed in every namespace",
+			annotations:        requesterAnnotations,
+			includedNamespaces: []string{testNonAdminBackupNamespace, "namespace1", "namespace2"},
+			allowedNamespaces:  []string{"namespace1", "namespace2"},
 		},
 		{
-			name: "RestoreStatus",
-			enforcedValue: &velerov1.RestoreStatusSpec{
-				IncludedResources: []string{"conditions"},
-			},
-			overrideValue: &velerov1.RestoreStatusSpec{
-				IncludedResources: []string{"phase"},
-			},
+			name:               "requester not allowed in one namespace",
+			annotations:        requesterAnnotations,
+			includedNamespaces: []string{"namespace1", "namespace2"},
+			allowedNamespaces:  []string{"namespace1"},
+			errMessage:         "user tenant is not allowed to create NonAdminBackups in namespace namespace2",
 		},
 		{
-			name:          "ExistingResourcePolicy",
-			enforcedValue: velerov1.PolicyTypeNone,
-			overrideValue: velerov1.PolicyTypeUpdate,
+			name:               "wildcard namespace",
+			annotations:        requesterAnnotations,
+			includedNamespaces: []string{"*"},
+			allowedNamespaces:  []string{"*"},
+			errMessage:         fmt.Sprintf(constant.NABRestrictedErr+", can not contain wildcard", "spec.backupSpec.includedNamespaces"),
 		},
 		{
-			name: "ResourceModifier",
-			enforcedValue: &corev1.TypedLocalObjectReference{
-				Name: "banana",
-			},
-			overrideValue: &corev1.TypedLocalObjectReference{
-				Name: "melon",
-			},
+			name:               "requester identity not recorded",
+			includedNamespaces: []string{"namespace1"},
+			allowedNamespaces:  []string{"namespace1"},
+			errMessage:         fmt.Sprintf(constant.NABRestrictedErr+", requester identity is not recorded, can not contain namespaces other than: %s", "spec.backupSpec.includedNamespaces", testNonAdminBackupNamespace),
+		},
+		{
+			name:                  "requester webhooks not configured",
+			annotations:           requesterAnnotations,
+			includedNamespaces:    []string{"namespace1"},
+			allowedNamespaces:     []string{"namespace1"},
+			webhooksNotConfigured: true,
+			errMessage: fmt.Sprintf(constant.NABRestrictedErr+", requester identity can not be trusted, can not contain namespaces other than: %s: "+
+				"admission webhooks %s and %s recording the requester are not configured with failurePolicy Fail, "+
+				"for the creation and update of every object of %s",
+				"spec.backupSpec.includedNamespaces", testNonAdminBackupNamespace, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, nacv1alpha1.NonAdminBackups),
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			enforcedSpec := &velerov1.RestoreSpec{}
-			reflect.ValueOf(enforcedSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
-			userNonAdminRestore := &nacv1alpha1.NonAdminRestore{
+			nonAdminBackup := &nacv1alpha1.NonAdminBackup{
 				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "self-service-namespace",
+					Namespace:   testNonAdminBackupNamespace,
+					Annotations: test.annotations,
 				},
-				Spec: nacv1alpha1.NonAdminRestoreSpec{
-					RestoreSpec: &velerov1.RestoreSpec{
-						BackupName: "self-service-backup",
+				Spec: nacv1alpha1.NonAdminBackupSpec{
+					BackupSpec: &velerov1.BackupSpec{
+						IncludedNamespaces: test.includedNamespaces,
 					},
 				},
 			}
-
-			fakeScheme := runtime.NewScheme()
-			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
-				t.Fatalf("Failed to register NAC type: %v", err)
+			fakeClientBuilder := fake.NewClientBuilder()
+			if !test.webhooksNotConfigured {
+				fakeClientBuilder.WithObjects(requesterWebhookConfigurations(nacv1alpha1.NonAdminBackups, constant.NabMutatingWebhookName, constant.NabValidatingWebhookName, admissionregistrationv1.Fail)...)
 			}
-			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects([]client.Object{
-				&nacv1alpha1.NonAdminBackup{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "self-service-backup",
-						Namespace: "self-service-namespace",
-					},
-					Status: nacv1alpha1.NonAdminBackupStatus{
-						Phase: nacv1alpha1.NonAdminPhaseCreated,
-					},
+			fakeClient := fakeClientBuilder.WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					subjectAccessReview, ok := obj.(*authorizationv1.SubjectAccessReview)
+					if !ok {
+						return fmt.Errorf("unexpected object %T", obj)
+					}
+					assert.Equal(t, "tenant", subjectAccessReview.Spec.User)
+					assert.Equal(t, []string{"system:authenticated", "tenants"}, subjectAccessReview.Spec.Groups)
+					assert.Equal(t, map[string]authorizationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:full"}}, subjectAccessReview.Spec.Extra)
+					assert.Equal(t, "create", subjectAccessReview.Spec.ResourceAttributes.Verb)
+					assert.Equal(t, "nonadminbackups", subjectAccessReview.Spec.ResourceAttributes.Resource)
+					subjectAccessReview.Status.Allowed = slices.Contains(test.allowedNamespaces, subjectAccessReview.Spec.ResourceAttributes.Namespace)
+					return nil
 				},
-			}...).Build()
-
-			err := ValidateRestoreSpec(context.Background(), fakeClient, userNonAdminRestore, enforcedSpec)
-			if err != nil {
-				t.Errorf("not setting restore spec field '%v' test failed: %v", test.name, err)
-			}
+			}).Build()
 
-			reflect.ValueOf(userNonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
-			err = ValidateRestoreSpec(context.Background(), fakeClient, userNonAdminRestore, enforcedSpec)
-			if test.expectErrorEnforced {
-				if err == nil {
-					t.Errorf("expected error when setting field '%v' to enforced value, but got none", test.name)
-				}
+			err := CheckRequesterCanBackupNamespaces(context.Background(), fakeClient, nonAdminBackup)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
 			} else {
-				if err != nil {
-					t.Errorf("setting restore spec field '%v' with enforced value test failed: %v", test.name, err)
-				}
-			}
-			reflect.ValueOf(userNonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.overrideValue))
-			err = ValidateRestoreSpec(context.Background(), fakeClient, userNonAdminRestore, enforcedSpec)
-			if err == nil {
-				t.Errorf("setting restore spec field '%v' with value overriding enforcement test failed: %v", test.name, err)
+				assert.Error(t, err)
+				assert.Equal(t, test.errMessage, err.Error())
 			}
 		})
 	}
-	t.Run("Ensure all restore spec fields were tested", func(t *testing.T) {
-		restoreSpecFields := []string{}
-		for _, test := range tests {
-			restoreSpecFields = append(restoreSpecFields, test.name)
-		}
-		restoreSpec := reflect.ValueOf(&velerov1.RestoreSpec{}).Elem()
-		for index := range restoreSpec.NumField() {
-			if !slices.Contains(restoreSpecFields, restoreSpec.Type().Field(index).Name) {
-				t.Errorf("restore spec field '%v' is not tested", restoreSpec.Type().Field(index).Name)
-			}
-		}
-		if restoreSpec.NumField() != len(tests) {
-			t.Errorf("list of tests have different number of elements")
-		}
-	})
 }
 
-func TestValidateBslSpecEnforcedFields(t *testing.T) {
+func TestCheckRequesterCanGroupBackupNamespaces(t *testing.T) {
+	requesterAnnotations := GetRequesterAnnotations(authenticationv1.UserInfo{
+		Username: "tenant",
+		UID:      "tenant-uid",
+		Groups:   []string{"system:authenticated", "tenants"},
+		Extra:    map[string]authenticationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:full"}},
+	}, NonAdminGroupBackupRequesterAnnotations)
 	tests := []struct {
-		enforcedValue any
-		overrideValue any
-		name          string
+		annotations           map[string]string
+		name                  string
+		namespaces            []string
+		allowedNamespaces     []string
+		errMessage            string
+		webhooksNotConfigured bool
 	}{
 		{
-			name:          "Provider",
-			enforcedValue: "aws",
-			overrideValue: "azure",
+			name:              "requester allowed in every namespace",
+			annotations:       requesterAnnotations,
+			namespaces:        []string{"namespace1", "namespace2"},
+			allowedNamespaces: []string{"namespace1", "namespace2"},
 		},
 		{
-			name:          "Config",
-			enforcedValue: map[string]string{"key": "value"},
-			overrideValue: map[string]string{"key": "differentValue"},
+			name:              "requester not allowed in one namespace",
+			annotations:       requesterAnnotations,
+			namespaces:        []string{"namespace1", "namespace2"},
+			allowedNamespaces: []string{"namespace2"},
+			errMessage:        "user tenant is not allowed to create NonAdminBackups in namespace namespace1",
 		},
 		{
-			name:          "AccessMode",
-			enforcedValue: velerov1.BackupStorageLocationAccessModeReadOnly,
-			overrideValue: velerov1.BackupStorageLocationAccessModeReadWrite,
+			name:              "requester identity not recorded",
+			namespaces:        []string{"namespace1"},
+			allowedNamespaces: []string{"namespace1"},
+			errMessage:        fmt.Sprintf(constant.NAGBRestrictedErr+", requester identity is not recorded", "creation"),
 		},
 		{
-			name:          "BackupSyncPeriod",
-			enforcedValue: &metav1.Duration{Duration: time60},
-			overrideValue: &metav1.Duration{Duration: time30},
+			name:                  "requester webhooks not configured",
+			annotations:           requesterAnnotations,
+			namespaces:            []string{"namespace1"},
+			allowedNamespaces:     []string{"namespace1"},
+			webhooksNotConfigured: true,
+			errMessage: fmt.Sprintf(constant.NAGBRestrictedErr+", requester identity can not be trusted: "+
+				"admission webhooks %s and %s recording the requester are not configured with failurePolicy Fail, "+
+				"for the creation and update of every object of %s",
+				"creation", constant.NagbMutatingWebhookName, constant.NagbValidatingWebhookName, nacv1alpha1.NonAdminGroupBackups),
 		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nonAdminGroupBackup := &nacv1alpha1.NonAdminGroupBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   testNonAdminBackupNamespace,
+					Annotations: test.annotations,
+				},
+			}
+			fakeClientBuilder := fake.NewClientBuilder()
+			if !test.webhooksNotConfigured {
+				fakeClientBuilder.WithObjects(requesterWebhookConfigurations(nacv1alpha1.NonAdminGroupBackups, constant.NagbMutatingWebhookName, constant.NagbValidatingWebhookName, admissionregistrationv1.Fail)...)
+			}
+			fakeClient := fakeClientBuilder.WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					subjectAccessReview, ok := obj.(*authorizationv1.SubjectAccessReview)
+					if !ok {
+						return fmt.Errorf("unexpected object %T", obj)
+					}
+					assert.Equal(t, "tenant", subjectAccessReview.Spec.User)
+					assert.Equal(t, []string{"system:authenticated", "tenants"}, subjectAccessReview.Spec.Groups)
+					assert.Equal(t, map[string]authorizationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:full"}}, subjectAccessReview.Spec.Extra)
+					assert.Equal(t, "nonadminbackups", subjectAccessReview.Spec.ResourceAttributes.Resource)
+					subjectAccessReview.Status.Allowed = slices.Contains(test.allowedNamespaces, subjectAccessReview.Spec.ResourceAttributes.Namespace)
+					return nil
+				},
+			}).Build()
+
+			err := CheckRequesterCanGroupBackupNamespaces(context.Background(), fakeClient, nonAdminGroupBackup, test.namespaces)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, test.errMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestCheckRequesterCanDeleteBackups(t *testing.T) {
+	requesterAnnotations := GetRequesterAnnotations(authenticationv1.UserInfo{
+		Username: "tenant",
+		UID:      "tenant-uid",
+		Groups:   []string{"system:authenticated", "tenants"},
+	}, NonAdminRetentionPolicyRequesterAnnotations)
+	tests := []struct {
+		annotations           map[string]string
+		name                  string
+		errMessage            string
+		allowed               bool
+		webhooksNotConfigured bool
+	}{
 		{
-			name:          "ValidationFrequency",
-			enforcedValue: &metav1.Duration{Duration: time60},
-			overrideValue: &metav1.Duration{Duration: time30},
+			name:        "requester allowed to delete backups",
+			annotations: requesterAnnotations,
+			allowed:     true,
 		},
 		{
-			name: "StorageType",
-			enforcedValue: oadpv1alpha1.StorageType{
-				ObjectStorage: &oadpv1alpha1.ObjectStorageLocation{
-					Bucket: "test-bucket",
-				},
-			},
-			overrideValue: oadpv1alpha1.StorageType{
-				ObjectStorage: &oadpv1alpha1.ObjectStorageLocation{
-					Bucket: "override-bucket",
-				},
-			},
+			name:        "requester not allowed to delete backups",
+			annotations: requesterAnnotations,
+			errMessage:  "user tenant is not allowed to create NonAdminDeleteBackupRequests in namespace " + testNonAdminBackupNamespace,
 		},
 		{
-			name: "Credential",
-			enforcedValue: &corev1.SecretKeySelector{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: "test-secret",
-				},
-				Key: "creds",
-			},
-			overrideValue: &corev1.SecretKeySelector{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: "override-secret",
-				},
-				Key: "override-creds",
-			},
+			name:       "requester identity not recorded",
+			allowed:    true,
+			errMessage: fmt.Sprintf(constant.NARPRestrictedErr+", requester identity is not recorded", "creation"),
+		},
+		{
+			name:                  "requester webhooks not configured",
+			annotations:           requesterAnnotations,
+			allowed:               true,
+			webhooksNotConfigured: true,
+			errMessage: fmt.Sprintf(constant.NARPRestrictedErr+", requester identity can not be trusted: "+
+				"admission webhooks %s and %s recording the requester are not configured with failurePolicy Fail, "+
+				"for the creation and update of every object of %s",
+				"creation", constant.NarpMutatingWebhookName, constant.NarpValidatingWebhookName, nacv1alpha1.NonAdminRetentionPolicies),
 		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			enforcedSpec := &oadpv1alpha1.EnforceBackupStorageLocationSpec{}
-			reflect.ValueOf(enforcedSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
-			userNonAdminBsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			nonAdminRetentionPolicy := &nacv1alpha1.NonAdminRetentionPolicy{
 				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "self-service-namespace",
-				},
-				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
-					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
-						Credential: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: "test-secret",
-							},
-							Key: "creds",
-						},
-						StorageType: velerov1.StorageType{
-							ObjectStorage: &velerov1.ObjectStorageLocation{
-								Bucket: "test-bucket",
-							},
-						},
-					},
+					Namespace:   testNonAdminBackupNamespace,
+					Annotations: test.annotations,
 				},
 			}
-			fakeScheme := runtime.NewScheme()
-			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
-				t.Fatalf("Failed to register NAC type: %v", err)
-			}
-			if err := corev1.AddToScheme(fakeScheme); err != nil {
-				t.Fatalf("Failed to register corev1 type: %v", err)
+			fakeClientBuilder := fake.NewClientBuilder()
+			if !test.webhooksNotConfigured {
+				fakeClientBuilder.WithObjects(requesterWebhookConfigurations(nacv1alpha1.NonAdminRetentionPolicies, constant.NarpMutatingWebhookName, constant.NarpValidatingWebhookName, admissionregistrationv1.Fail)...)
 			}
-
-			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects([]client.Object{
-				&corev1.Secret{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-secret",
-						Namespace: "self-service-namespace",
-					},
+			fakeClient := fakeClientBuilder.WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					subjectAccessReview, ok := obj.(*authorizationv1.SubjectAccessReview)
+					if !ok {
+						return fmt.Errorf("unexpected object %T", obj)
+					}
+					assert.Equal(t, "tenant", subjectAccessReview.Spec.User)
+					assert.Equal(t, "nonadmindeletebackuprequests", subjectAccessReview.Spec.ResourceAttributes.Resource)
+					assert.Equal(t, testNonAdminBackupNamespace, subjectAccessReview.Spec.ResourceAttributes.Namespace)
+					subjectAccessReview.Status.Allowed = test.allowed
+					return nil
 				},
-			}...).Build()
-
-			err := ValidateBslSpec(context.Background(), fakeClient, userNonAdminBsl, enforcedSpec, 2*time.Minute, nil)
-			if err != nil {
-				t.Errorf("not setting bsl spec field '%v' test failed: %v", test.name, err)
-			}
+			}).Build()
 
-			// Handle StorageType separately to avoid reflect panic
-			if test.name == "StorageType" {
-				//nolint:errcheck //we know it's correct return type
-				if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage != nil {
-					if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage.Bucket != constant.EmptyString {
-						userNonAdminBsl.Spec.BackupStorageLocationSpec.StorageType.ObjectStorage.Bucket = test.overrideValue.(oadpv1alpha1.StorageType).ObjectStorage.Bucket
-					}
-					if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage.Prefix != constant.EmptyString {
-						userNonAdminBsl.Spec.BackupStorageLocationSpec.StorageType.ObjectStorage.Prefix = test.overrideValue.(oadpv1alpha1.StorageType).ObjectStorage.Prefix
-					}
-					if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage.CACert != nil {
-						userNonAdminBsl.Spec.BackupStorageLocationSpec.StorageType.ObjectStorage.CACert = test.overrideValue.(oadpv1alpha1.StorageType).ObjectStorage.CACert
-					}
-				}
+			err := CheckRequesterCanDeleteBackups(context.Background(), fakeClient, nonAdminRetentionPolicy)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
 			} else {
-				reflect.ValueOf(userNonAdminBsl.Spec.BackupStorageLocationSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
-			}
-			if test.name != "StorageType" {
-				reflect.ValueOf(userNonAdminBsl.Spec.BackupStorageLocationSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.overrideValue))
+				assert.Error(t, err)
+				assert.Equal(t, test.errMessage, err.Error())
 			}
-			err = ValidateBslSpec(context.Background(), fakeClient, userNonAdminBsl, enforcedSpec, 2*time.Minute, nil)
+		})
+	}
+}
+
+func TestCheckRequesterCanRunValidationJobs(t *testing.T) {
+	requesterAnnotations := GetRequesterAnnotations(authenticationv1.UserInfo{
+		Username: "tenant",
+		UID:      "tenant-uid",
+		Groups:   []string{"system:authenticated", "tenants"},
+	}, NonAdminBackupVerificationRequesterAnnotations)
+	tests := []struct {
+		annotations           map[string]string
+		name                  string
+		errMessage            string
+		allowed               bool
+		webhooksNotConfigured bool
+	}{
+		{
+			name:        "requester allowed to create Jobs",
+			annotations: requesterAnnotations,
+			allowed:     true,
+		},
+		{
+			name:        "requester not allowed to create Jobs",
+			annotations: requesterAnnotations,
+			errMessage:  "user tenant is not allowed to create Jobs in namespace " + testNonAdminBackupNamespace,
+		},
+		{
+			name:       "requester identity not recorded",
+			allowed:    true,
+			errMessage: fmt.Sprintf(constant.NABVRestrictedErr+", requester identity is not recorded", "spec.validationJob"),
+		},
+		{
+			name:                  "requester webhooks not configured",
+			annotations:           requesterAnnotations,
+			allowed:               true,
+			webhooksNotConfigured: true,
+			errMessage: fmt.Sprintf(constant.NABVRestrictedErr+", requester identity can not be trusted: "+
+				"admission webhooks %s and %s recording the requester are not configured with failurePolicy Fail, "+
+				"for the creation and update of every object of %s",
+				"spec.validationJob", constant.NabvMutatingWebhookName, constant.NabvValidatingWebhookName, nacv1alpha1.NonAdminBackupVerifications),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nonAdminBackupVerification := &nacv1alpha1.NonAdminBackupVerification{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   testNonAdminBackupNamespace,
+					Annotations: test.annotations,
+				},
+			}
+			fakeClientBuilder := fake.NewClientBuilder()
+			if !test.webhooksNotConfigured {
+				fakeClientBuilder.WithObjects(requesterWebhookConfigurations(nacv1alpha1.NonAdminBackupVerifications, constant.NabvMutatingWebhookName, constant.NabvValidatingWebhookName, admissionregistrationv1.Fail)...)
+			}
+			fakeClient := fakeClientBuilder.WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					subjectAccessReview, ok := obj.(*authorizationv1.SubjectAccessReview)
+					if !ok {
+						return fmt.Errorf("unexpected object %T", obj)
+					}
+					assert.Equal(t, "tenant", subjectAccessReview.Spec.User)
+					assert.Equal(t, "batch", subjectAccessReview.Spec.ResourceAttributes.Group)
+					assert.Equal(t, "jobs", subjectAccessReview.Spec.ResourceAttributes.Resource)
+					assert.Equal(t, testNonAdminBackupNamespace, subjectAccessReview.Spec.ResourceAttributes.Namespace)
+					subjectAccessReview.Status.Allowed = test.allowed
+					return nil
+				},
+			}).Build()
+
+			err := CheckRequesterCanRunValidationJobs(context.Background(), fakeClient, nonAdminBackupVerification)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, test.errMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestCheckRequesterCanRestoreNamespaceMapping(t *testing.T) {
+	requesterAnnotations := map[string]string{
+		constant.NarRequesterUsernameAnnotation: "tenant",
+		constant.NarRequesterUIDAnnotation:      "tenant-uid",
+		constant.NarRequesterGroupsAnnotation:   `["system:authenticated","tenants"]`,
+		constant.NarRequesterExtraAnnotation:    `{"scopes.authorization.openshift.io":["user:full"]}`,
+	}
+	tests := []struct {
+		annotations           map[string]string
+		namespaceMapping      map[string]string
+		name                  string
+		errMessage            string
+		webhooksNotConfigured bool
+		allowedNamespaces     []string
+	}{
+		{
+			name:              "requester allowed in target namespace",
+			annotations:       requesterAnnotations,
+			namespaceMapping:  map[string]string{testNonAdminBackupNamespace: "namespace1"},
+			allowedNamespaces: []string{"namespace1"},
+		},
+		{
+			name:             "namespace mapped to itself",
+			annotations:      requesterAnnotations,
+			namespaceMapping: map[string]string{testNonAdminBackupNamespace: testNonAdminBackupNamespace},
+		},
+		{
+			name:              "requester not allowed in target namespace",
+			annotations:       requesterAnnotations,
+			namespaceMapping:  map[string]string{testNonAdminBackupNamespace: "namespace2"},
+			allowedNamespaces: []string{"namespace1"},
+			errMessage:        "user tenant is not allowed to create NonAdminRestores in namespace namespace2",
+		},
+		{
+			name:              "source namespace other than the NonAdminRestore one",
+			annotations:       requesterAnnotations,
+			namespaceMapping:  map[string]string{"namespace1": "namespace2"},
+			allowedNamespaces: []string{"namespace1", "namespace2"},
+			errMessage:        fmt.Sprintf(constant.NARRestrictedErr+", can only map namespace: %s", "nonAdminRestore.spec.restoreSpec.namespaceMapping", testNonAdminBackupNamespace),
+		},
+		{
+			name:              "wildcard target namespace",
+			annotations:       requesterAnnotations,
+			namespaceMapping:  map[string]string{testNonAdminBackupNamespace: "*"},
+			allowedNamespaces: []string{"*"},
+			errMessage:        fmt.Sprintf(constant.NARRestrictedErr+", namespace %s can not be mapped to \"*\"", "nonAdminRestore.spec.restoreSpec.namespaceMapping", testNonAdminBackupNamespace),
+		},
+		{
+			name:              "requester identity not recorded",
+			namespaceMapping:  map[string]string{testNonAdminBackupNamespace: "namespace1"},
+			allowedNamespaces: []string{"namespace1"},
+			errMessage:        fmt.Sprintf(constant.NARRestrictedErr+", requester identity is not recorded", "nonAdminRestore.spec.restoreSpec.namespaceMapping"),
+		},
+		{
+			name:                  "requester webhooks not configured",
+			annotations:           requesterAnnotations,
+			namespaceMapping:      map[string]string{testNonAdminBackupNamespace: "namespace1"},
+			allowedNamespaces:     []string{"namespace1"},
+			webhooksNotConfigured: true,
+			errMessage: fmt.Sprintf(constant.NARRestrictedErr+", requester identity can not be trusted: "+
+				"admission webhooks %s and %s recording the requester are not configured with failurePolicy Fail, "+
+				"for the creation and update of every object of %s",
+				"nonAdminRestore.spec.restoreSpec.namespaceMapping", constant.NarMutatingWebhookName, constant.NarValidatingWebhookName, nacv1alpha1.NonAdminRestores),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nonAdminRestore := &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   testNonAdminBackupNamespace,
+					Annotations: test.annotations,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						NamespaceMapping: test.namespaceMapping,
+					},
+				},
+			}
+			fakeClientBuilder := fake.NewClientBuilder()
+			if !test.webhooksNotConfigured {
+				fakeClientBuilder.WithObjects(requesterWebhookConfigurations(nacv1alpha1.NonAdminRestores, constant.NarMutatingWebhookName, constant.NarValidatingWebhookName, admissionregistrationv1.Fail)...)
+			}
+			fakeClient := fakeClientBuilder.WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					subjectAccessReview, ok := obj.(*authorizationv1.SubjectAccessReview)
+					if !ok {
+						return fmt.Errorf("unexpected object %T", obj)
+					}
+					assert.Equal(t, "tenant", subjectAccessReview.Spec.User)
+					assert.Equal(t, []string{"system:authenticated", "tenants"}, subjectAccessReview.Spec.Groups)
+					assert.Equal(t, map[string]authorizationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:full"}}, subjectAccessReview.Spec.Extra)
+					assert.Equal(t, "create", subjectAccessReview.Spec.ResourceAttributes.Verb)
+					assert.Equal(t, "nonadminrestores", subjectAccessReview.Spec.ResourceAttributes.Resource)
+					subjectAccessReview.Status.Allowed = slices.Contains(test.allowedNamespaces, subjectAccessReview.Spec.ResourceAttributes.Namespace)
+					return nil
+				},
+			}).Build()
+
+			err := CheckRequesterCanRestoreNamespaceMapping(context.Background(), fakeClient, nonAdminRestore)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, test.errMessage, err.Error())
+			}
+		})
+	}
+}
+
+func TestIsRestoreVerificationNamespaceMapping(t *testing.T) {
+	const verificationNamespace = "verify-namespace"
+	tests := []struct {
+		namespaceMapping map[string]string
+		namespaceLabels  map[string]string
+		name             string
+		expected         bool
+	}{
+		{
+			name:             "mapped to the verification namespace of the NonAdminRestore namespace",
+			namespaceMapping: map[string]string{testNonAdminBackupNamespace: verificationNamespace},
+			namespaceLabels:  map[string]string{constant.RestoreVerificationNamespaceLabel: testNonAdminBackupNamespace},
+			expected:         true,
+		},
+		{
+			name:             "mapped to the verification namespace of another namespace",
+			namespaceMapping: map[string]string{testNonAdminBackupNamespace: verificationNamespace},
+			namespaceLabels:  map[string]string{constant.RestoreVerificationNamespaceLabel: "namespace1"},
+		},
+		{
+			name:             "mapped to a namespace not created by NAC",
+			namespaceMapping: map[string]string{testNonAdminBackupNamespace: verificationNamespace},
+		},
+		{
+			name: "mapping other namespaces too",
+			namespaceMapping: map[string]string{
+				testNonAdminBackupNamespace: verificationNamespace,
+				"namespace1":                verificationNamespace,
+			},
+			namespaceLabels: map[string]string{constant.RestoreVerificationNamespaceLabel: testNonAdminBackupNamespace},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nonAdminRestore := &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      verificationNamespace,
+					Namespace: testNonAdminBackupNamespace,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						NamespaceMapping: test.namespaceMapping,
+					},
+				},
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: verificationNamespace, Labels: test.namespaceLabels},
+			}).Build()
+
+			assert.Equal(t, test.expected, IsRestoreVerificationNamespaceMapping(context.Background(), fakeClient, nonAdminRestore))
+		})
+	}
+}
+
+func TestValidateRestoreSpec(t *testing.T) {
+	tests := []struct {
+		name                  string
+		errorMessage          string
+		nonAdminRestore       *nacv1alpha1.NonAdminRestore
+		objects               []client.Object
+		allowNamespaceMapping bool
+	}{
+		{
+			name: "[invalid] spec.restoreSpec.backupName not set",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is not set",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName does not exist",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "john",
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: nonadminbackups.oadp.openshift.io \"john\" not found",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName not ready",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "peter",
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "peter",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseBackingOff,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: NonAdminBackup is not ready to be restored",
+		},
+		{
+			name: "[valid] spec.restoreSpec.backupName is ready",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "steve",
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "steve",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName not shared",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared",
+					},
+					BackupNamespace: "source-namespace",
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shared",
+						Namespace: "source-namespace",
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCompleted,
+					},
+				},
+				&nacv1alpha1.NonAdminBackupShare{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "share",
+						Namespace: "source-namespace",
+					},
+					Spec: nacv1alpha1.NonAdminBackupShareSpec{
+						NonAdminBackupName: "shared",
+						TargetNamespace:    "another-namespace",
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: NonAdminBackup source-namespace/shared is not shared with namespace " + defaultNS,
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName shared but not completed",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared",
+					},
+					BackupNamespace: "source-namespace",
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shared",
+						Namespace: "source-namespace",
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+				&nacv1alpha1.NonAdminBackupShare{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "share",
+						Namespace: "source-namespace",
+					},
+					Spec: nacv1alpha1.NonAdminBackupShareSpec{
+						NonAdminBackupName: "shared",
+						TargetNamespace:    defaultNS,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: shared NonAdminBackup is not completed",
+		},
+		{
+			name: "[valid] spec.restoreSpec.backupName shared and completed",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared",
+					},
+					BackupNamespace: "source-namespace",
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "shared",
+						Namespace: "source-namespace",
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCompleted,
+					},
+				},
+				&nacv1alpha1.NonAdminBackupShare{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "share",
+						Namespace: "source-namespace",
+					},
+					Spec: nacv1alpha1.NonAdminBackupShareSpec{
+						NonAdminBackupName: "shared",
+						TargetNamespace:    defaultNS,
+					},
+				},
+			},
+		},
+		{
+			name: "[valid] spec.restoreSpec.backupName is completed",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "completed-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "completed-backup",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCompleted,
+					},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName not processed yet",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "new-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-backup",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseNew,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: NonAdminBackup is not ready to be restored",
+		},
+		{
+			name: "[valid] spec.restoreSpec.backupName not processed yet with spec.waitForBackupCompletion",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "new-backup",
+					},
+					WaitForBackupCompletion: true,
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "new-backup",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseNew,
+					},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName has failed",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "failed-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "failed-backup",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseFailed,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: NonAdminBackup is not ready to be restored",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.scheduleName is restricted",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						ScheduleName: "foo-schedule",
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-schedule",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.scheduleName is restricted",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.includedNamespaces is restricted",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName:         "foo-backup-incl",
+						IncludedNamespaces: []string{"foo-bar-ns"},
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-incl",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.includedNamespaces is restricted",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.excludedNamespaces is restricted",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName:         "foo-backup-ns-ex",
+						ExcludedNamespaces: []string{"foo-bar-ns"},
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-ns-ex",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.excludedNamespaces is restricted",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.namespaceMapping is restricted",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "foo-backup-ns-map",
+						NamespaceMapping: map[string]string{
+							"foo-ns": "bar-ns",
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-ns-map",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.namespaceMapping is restricted",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.namespaceMapping allowed, requester identity not recorded",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "foo-backup-ns-map",
+						NamespaceMapping: map[string]string{
+							defaultNS: "bar-ns",
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-ns-map",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			allowNamespaceMapping: true,
+			errorMessage:          "NonAdminRestore spec.restoreSpec.namespaceMapping is rejected: NonAdminRestore nonAdminRestore.spec.restoreSpec.namespaceMapping is restricted, requester identity is not recorded",
+		},
+		{
+			name: "[valid] spec.restoreSpec.backupName is a shared Velero Backup",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "shared-backup",
+						Namespace:   "oadp-namespace",
+						Labels:      map[string]string{constant.SharedWithNamespaceLabel: defaultNS},
+						Annotations: map[string]string{constant.SharedSourceNamespaceAnnotation: "source-ns"},
+					},
+					Spec: velerov1.BackupSpec{
+						IncludedNamespaces: []string{"source-ns", "other-ns"},
+					},
+					Status: velerov1.BackupStatus{
+						Phase: velerov1.BackupPhaseCompleted,
+					},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName is a Velero Backup shared with another namespace",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "shared-backup",
+						Namespace:   "oadp-namespace",
+						Labels:      map[string]string{constant.SharedWithNamespaceLabel: "other-ns"},
+						Annotations: nil,
+					},
+					Spec: velerov1.BackupSpec{
+						IncludedNamespaces: nil,
+					},
+					Status: velerov1.BackupStatus{
+						Phase: velerov1.BackupPhaseCompleted,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: nonadminbackups.oadp.openshift.io \"shared-backup\" not found",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName is a Velero Backup created by NAC",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "shared-backup",
+						Namespace:   "oadp-namespace",
+						Labels:      map[string]string{constant.SharedWithNamespaceLabel: defaultNS, constant.OadpLabel: constant.OadpLabelValue, constant.ManagedByLabel: constant.ManagedByLabelValue},
+						Annotations: nil,
+					},
+					Spec: velerov1.BackupSpec{
+						IncludedNamespaces: nil,
+					},
+					Status: velerov1.BackupStatus{
+						Phase: velerov1.BackupPhaseCompleted,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: nonadminbackups.oadp.openshift.io \"shared-backup\" not found",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName is a shared Velero Backup not ready",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "shared-backup",
+						Namespace:   "oadp-namespace",
+						Labels:      map[string]string{constant.SharedWithNamespaceLabel: defaultNS},
+						Annotations: nil,
+					},
+					Spec: velerov1.BackupSpec{
+						IncludedNamespaces: nil,
+					},
+					Status: velerov1.BackupStatus{
+						Phase: velerov1.BackupPhaseInProgress,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: Velero Backup is not ready to be restored",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.backupName is a shared Velero Backup not including the source namespace",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "shared-backup",
+					},
+				},
+			},
+			objects: []client.Object{
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "shared-backup",
+						Namespace:   "oadp-namespace",
+						Labels:      map[string]string{constant.SharedWithNamespaceLabel: defaultNS},
+						Annotations: nil,
+					},
+					Spec: velerov1.BackupSpec{
+						IncludedNamespaces: []string{"other-ns"},
+					},
+					Status: velerov1.BackupStatus{
+						Phase: velerov1.BackupPhaseCompleted,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.backupName is invalid: Velero Backup does not include namespace default",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.existingResourcePolicy is not supported",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName:             "foo-backup-policy",
+						ExistingResourcePolicy: "replace",
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-policy",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.existingResourcePolicy is invalid: existingResourcePolicy \"replace\" must be one of: none, update",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.existingResourcePolicy overrides the namespace enforced policy",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName:             "foo-backup-policy",
+						ExistingResourcePolicy: velerov1.PolicyTypeUpdate,
+					},
+				},
+			},
+			objects: []client.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        defaultNS,
+						Annotations: map[string]string{constant.EnforcedExistingResourcePolicyAnnotation: string(velerov1.PolicyTypeNone)},
+					},
+				},
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-policy",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			errorMessage: "the administrator has restricted spec.restoreSpec.existingResourcePolicy field value to none",
+		},
+		{
+			name: "[invalid] spec.restoreSpec.resourceModifier ConfigMap does not exist",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName:       "foo-backup-modifier",
+						ResourceModifier: &corev1.TypedLocalObjectReference{Kind: "ConfigMap", Name: "resource-modifiers"},
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-modifier",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			},
+			errorMessage: "NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap resource-modifiers not found in the namespace",
+		},
+		{
+			name: "[valid] spec.restoreSpec.resourceModifier ConfigMap modifies the namespace",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName:       "foo-backup-modifier",
+						ResourceModifier: &corev1.TypedLocalObjectReference{Kind: "ConfigMap", Name: "resource-modifiers"},
+					},
+				},
+			},
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo-backup-modifier",
+						Namespace: defaultNS,
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "resource-modifiers",
+						Namespace: defaultNS,
+					},
+					Data: map[string]string{"modifiers.yaml": "version: v1\nresourceModifierRules:\n- conditions:\n    groupResource: pods\n    namespaces: [default]\n"},
+				},
+			},
+		},
+		{
+			name: "[valid] spec.restoreSpec.backupName is the NACUUID of a Velero Backup of the namespace",
+			nonAdminRestore: &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "synced-backup-nacuuid",
+					},
+				},
+			},
+			objects: []client.Object{
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "synced-backup-nacuuid",
+						Namespace: "oadp-namespace",
+						Labels: map[string]string{
+							constant.OadpLabel:             constant.OadpLabelValue,
+							constant.ManagedByLabel:        constant.ManagedByLabelValue,
+							constant.NabOriginNACUUIDLabel: "synced-backup-nacuuid",
+						},
+						Annotations: map[string]string{
+							constant.NabOriginNamespaceAnnotation: defaultNS,
+							constant.NabOriginNameAnnotation:      "synced-backup",
+						},
+					},
+					Spec: velerov1.BackupSpec{
+						IncludedNamespaces: []string{defaultNS},
+					},
+					Status: velerov1.BackupStatus{
+						Phase: velerov1.BackupPhaseCompleted,
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeScheme := runtime.NewScheme()
+			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register NAC type: %v", err)
+			}
+			if err := velerov1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register Velero type: %v", err)
+			}
+			if err := corev1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register core type: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(test.objects...).Build()
+			err := ValidateRestoreSpec(context.Background(), fakeClient, "oadp-namespace", test.nonAdminRestore, &velerov1.RestoreSpec{}, test.allowNamespaceMapping)
+			if err != nil {
+				if test.errorMessage != err.Error() {
+					t.Errorf("test '%s' failed: error messages differ. Expected %v, got %v", test.name, test.errorMessage, err)
+				}
+				return
+			}
+			if test.errorMessage != constant.EmptyString {
+				t.Errorf("test '%s' failed: expected test to error with '%v'", test.name, err)
+			}
+		})
+	}
+}
+
+func TestValidateRestoreSpecEnforcedFields(t *testing.T) {
+	tests := []struct {
+		enforcedValue       any
+		overrideValue       any
+		name                string
+		expectErrorEnforced bool
+	}{
+		{
+			name:                "IncludedNamespaces",
+			enforcedValue:       []string{"self-service-namespace"},
+			overrideValue:       []string{"openshift-monitor"},
+			expectErrorEnforced: true,
+		},
+		{
+			name:                "ExcludedNamespaces",
+			enforcedValue:       []string{"openshift-monitor"},
+			overrideValue:       []string{"cherry"},
+			expectErrorEnforced: true,
+		},
+		{
+			name:          "BackupName",
+			enforcedValue: "self-service-backup",
+			overrideValue: "another",
+		},
+		{
+			name:                "ScheduleName",
+			enforcedValue:       "allowed",
+			overrideValue:       "not-alllowed",
+			expectErrorEnforced: true,
+		},
+		{
+			name:          "IncludedResources",
+			enforcedValue: []string{"deployments"},
+			overrideValue: []string{"secrets"},
+		},
+		{
+			name:          "ExcludedResources",
+			enforcedValue: []string{"foobar.io"},
+			overrideValue: []string{},
+		},
+		{
+			name:          "IncludeClusterResources",
+			enforcedValue: ptr.To(true),
+			overrideValue: ptr.To(false),
+		},
+		{
+			name: "Hooks",
+			enforcedValue: velerov1.RestoreHooks{
+				Resources: []velerov1.RestoreResourceHookSpec{
+					{
+						Name: "computer",
+					},
+				},
+			},
+			overrideValue: velerov1.RestoreHooks{
+				Resources: []velerov1.RestoreResourceHookSpec{
+					{
+						Name: "microwave",
+					},
+				},
+			},
+		},
+		{
+			name: "LabelSelector",
+			enforcedValue: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"grapes": constant.TrueString,
+				},
+			},
+			overrideValue: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					constant.OadpLabel: constant.TrueString,
+				},
+			},
+		},
+		{
+			name: "OrLabelSelectors",
+			enforcedValue: []*metav1.LabelSelector{
+				{
+					MatchLabels: map[string]string{
+						"kiwi": constant.TrueString,
+					},
+				},
+				{
+					MatchLabels: map[string]string{
+						"green": "no",
+					},
+				},
+			},
+			overrideValue: []*metav1.LabelSelector{
+				{
+					MatchLabels: map[string]string{
+						constant.OadpLabel: constant.TrueString,
+					},
+				},
+			},
+		},
+		{
+			name:          "ItemOperationTimeout",
+			enforcedValue: metav1.Duration{Duration: 30 * time.Minute}, //nolint:revive // just test
+			overrideValue: metav1.Duration{Duration: time.Hour},
+		},
+		{
+			name: "UploaderConfig",
+			enforcedValue: &velerov1.UploaderConfigForRestore{
+				ParallelFilesDownload: 2, //nolint:revive // just test
+			},
+			overrideValue: &velerov1.UploaderConfigForRestore{
+				ParallelFilesDownload: 32, //nolint:revive // just test
+			},
+		},
+		{
+			name:          "RestorePVs",
+			enforcedValue: ptr.To(true),
+			overrideValue: ptr.To(false),
+		},
+		{
+			name:          "PreserveNodePorts",
+			enforcedValue: ptr.To(true),
+			overrideValue: ptr.To(false),
+		},
+		{
+			name: "NamespaceMapping",
+			enforcedValue: map[string]string{
+				"video": "game",
+			},
+			overrideValue: map[string]string{
+				"movie": "star",
+			},
+			expectErrorEnforced: true,
+		},
+		{
+			name: "RestoreStatus",
+			enforcedValue: &velerov1.RestoreStatusSpec{
+				IncludedResources: []string{"conditions"},
+			},
+			overrideValue: &velerov1.RestoreStatusSpec{
+				IncludedResources: []string{"phase"},
+			},
+		},
+		{
+			name:          "ExistingResourcePolicy",
+			enforcedValue: velerov1.PolicyTypeNone,
+			overrideValue: velerov1.PolicyTypeUpdate,
+		},
+		{
+			name: "ResourceModifier",
+			enforcedValue: &corev1.TypedLocalObjectReference{
+				Name: "banana",
+			},
+			overrideValue: &corev1.TypedLocalObjectReference{
+				Name: "melon",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enforcedSpec := &velerov1.RestoreSpec{}
+			reflect.ValueOf(enforcedSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
+			userNonAdminRestore := &nacv1alpha1.NonAdminRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "self-service-namespace",
+				},
+				Spec: nacv1alpha1.NonAdminRestoreSpec{
+					RestoreSpec: &velerov1.RestoreSpec{
+						BackupName: "self-service-backup",
+					},
+				},
+			}
+
+			fakeScheme := runtime.NewScheme()
+			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register NAC type: %v", err)
+			}
+			if err := corev1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register core type: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects([]client.Object{
+				&nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "self-service-backup",
+						Namespace: "self-service-namespace",
+					},
+					Status: nacv1alpha1.NonAdminBackupStatus{
+						Phase: nacv1alpha1.NonAdminPhaseCreated,
+					},
+				},
+			}...).Build()
+
+			err := ValidateRestoreSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminRestore, enforcedSpec, false)
+			if err != nil {
+				t.Errorf("not setting restore spec field '%v' test failed: %v", test.name, err)
+			}
+
+			reflect.ValueOf(userNonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
+			err = ValidateRestoreSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminRestore, enforcedSpec, false)
+			if test.expectErrorEnforced {
+				if err == nil {
+					t.Errorf("expected error when setting field '%v' to enforced value, but got none", test.name)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("setting restore spec field '%v' with enforced value test failed: %v", test.name, err)
+				}
+			}
+			reflect.ValueOf(userNonAdminRestore.Spec.RestoreSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.overrideValue))
+			err = ValidateRestoreSpec(context.Background(), fakeClient, "oadp-namespace", userNonAdminRestore, enforcedSpec, false)
+			if err == nil {
+				t.Errorf("setting restore spec field '%v' with value overriding enforcement test failed: %v", test.name, err)
+			}
+		})
+	}
+	t.Run("Ensure all restore spec fields were tested", func(t *testing.T) {
+		restoreSpecFields := []string{}
+		for _, test := range tests {
+			restoreSpecFields = append(restoreSpecFields, test.name)
+		}
+		restoreSpec := reflect.ValueOf(&velerov1.RestoreSpec{}).Elem()
+		for index := range restoreSpec.NumField() {
+			if !slices.Contains(restoreSpecFields, restoreSpec.Type().Field(index).Name) {
+				t.Errorf("restore spec field '%v' is not tested", restoreSpec.Type().Field(index).Name)
+			}
+		}
+		if restoreSpec.NumField() != len(tests) {
+			t.Errorf("list of tests have different number of elements")
+		}
+	})
+}
+
+func TestGetNamespaceEnforcedRestoreSpec(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		errMessage     string
+		expectedPolicy velerov1.PolicyType
+	}{
+		{
+			name:           "namespace without annotation",
+			expectedPolicy: velerov1.PolicyTypeUpdate,
+		},
+		{
+			name:           "namespace overriding the enforced policy",
+			annotations:    map[string]string{constant.EnforcedExistingResourcePolicyAnnotation: string(velerov1.PolicyTypeNone)},
+			expectedPolicy: velerov1.PolicyTypeNone,
+		},
+		{
+			name:        "namespace with invalid policy",
+			annotations: map[string]string{constant.EnforcedExistingResourcePolicyAnnotation: "replace"},
+			errMessage:  "namespace self-service-namespace annotation openshift.io/oadp-nac-enforced-existing-resource-policy is invalid: existingResourcePolicy \"replace\" must be one of: none, update",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithObjects(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "self-service-namespace",
+					Annotations: test.annotations,
+				},
+			}).Build()
+			enforcedSpec := &velerov1.RestoreSpec{ExistingResourcePolicy: velerov1.PolicyTypeUpdate}
+
+			result, err := GetNamespaceEnforcedRestoreSpec(context.Background(), fakeClient, "self-service-namespace", enforcedSpec)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedPolicy, result.ExistingResourcePolicy)
+				assert.Equal(t, velerov1.PolicyTypeUpdate, enforcedSpec.ExistingResourcePolicy)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestGetSelectorEnforcedRestoreSpec(t *testing.T) {
+	const rules = `- namespaceSelector:
+    matchLabels:
+      tier: production
+  restoreSpec:
+    restorePVs: false
+    preserveNodePorts: true
+- namespaceSelector:
+    matchExpressions:
+    - key: team
+      operator: In
+      values: [payments]
+  restoreSpec:
+    restorePVs: true
+    uploaderConfig:
+      writeSparseFiles: true
+`
+	tests := []struct {
+		name            string
+		configMapName   string
+		data            map[string]string
+		namespaceLabels map[string]string
+		expected        *velerov1.RestoreSpec
+		errMessage      string
+	}{
+		{
+			name:            "without ConfigMap",
+			namespaceLabels: map[string]string{"tier": "production"},
+			expected:        &velerov1.RestoreSpec{ExistingResourcePolicy: velerov1.PolicyTypeUpdate},
+		},
+		{
+			name:            "namespace not selected",
+			configMapName:   "restore-enforcement",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "development"},
+			expected:        &velerov1.RestoreSpec{ExistingResourcePolicy: velerov1.PolicyTypeUpdate},
+		},
+		{
+			name:            "namespace selected by a rule",
+			configMapName:   "restore-enforcement",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "production"},
+			expected: &velerov1.RestoreSpec{
+				ExistingResourcePolicy: velerov1.PolicyTypeUpdate,
+				RestorePVs:             ptr.To(false),
+				PreserveNodePorts:      ptr.To(true),
+			},
+		},
+		{
+			name:            "namespace selected by both rules",
+			configMapName:   "restore-enforcement",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "production", "team": "payments"},
+			expected: &velerov1.RestoreSpec{
+				ExistingResourcePolicy: velerov1.PolicyTypeUpdate,
+				RestorePVs:             ptr.To(true),
+				PreserveNodePorts:      ptr.To(true),
+				UploaderConfig:         &velerov1.UploaderConfigForRestore{WriteSparseFiles: ptr.To(true)},
+			},
+		},
+		{
+			name:          "missing ConfigMap",
+			configMapName: "missing",
+			errMessage:    "failed to get restore enforcement ConfigMap missing: configmaps \"missing\" not found",
+		},
+		{
+			name:          "rule enforcing another field",
+			configMapName: "restore-enforcement",
+			data: map[string]string{"rules.yaml": `- namespaceSelector: {}
+  restoreSpec:
+    existingResourcePolicy: update
+`},
+			errMessage: "restore enforcement ConfigMap restore-enforcement is invalid: error unmarshaling JSON: while decoding JSON: json: unknown field \"existingResourcePolicy\"",
+		},
+		{
+			name:          "rule without namespace selector",
+			configMapName: "restore-enforcement",
+			data: map[string]string{"rules.yaml": `- restoreSpec:
+    restorePVs: false
+`},
+			errMessage: "restore enforcement ConfigMap restore-enforcement is invalid: rule 0 namespaceSelector is not set",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithObjects(
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "self-service-namespace",
+						Labels: test.namespaceLabels,
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore-enforcement",
+						Namespace: "oadp-namespace",
+					},
+					Data: test.data,
+				},
+			).Build()
+			enforcedSpec := &velerov1.RestoreSpec{ExistingResourcePolicy: velerov1.PolicyTypeUpdate}
+
+			result, err := GetSelectorEnforcedRestoreSpec(context.Background(), fakeClient, "oadp-namespace", test.configMapName, "self-service-namespace", enforcedSpec)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, result)
+				assert.Equal(t, &velerov1.RestoreSpec{ExistingResourcePolicy: velerov1.PolicyTypeUpdate}, enforcedSpec)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestGetNamespaceNonAdminPolicy(t *testing.T) {
+	newPolicy := func(name string, priority int32, selector metav1.LabelSelector) *nacv1alpha1.NonAdminPolicy {
+		return &nacv1alpha1.NonAdminPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: nacv1alpha1.NonAdminPolicySpec{
+				NamespaceSelector: selector,
+				Priority:          priority,
+			},
+		}
+	}
+	tests := []struct {
+		name            string
+		namespaceLabels map[string]string
+		expected        string
+		errMessage      string
+		policies        []client.Object
+	}{
+		{
+			name:            "without NonAdminPolicies",
+			namespaceLabels: map[string]string{"tier": "production"},
+		},
+		{
+			name:            "namespace not selected",
+			namespaceLabels: map[string]string{"tier": "development"},
+			policies: []client.Object{
+				newPolicy("production", 0, metav1.LabelSelector{MatchLabels: map[string]string{"tier": "production"}}),
+			},
+		},
+		{
+			name:            "namespace selected by a NonAdminPolicy",
+			namespaceLabels: map[string]string{"tier": "production"},
+			policies: []client.Object{
+				newPolicy("production", 0, metav1.LabelSelector{MatchLabels: map[string]string{"tier": "production"}}),
+				newPolicy("development", 0, metav1.LabelSelector{MatchLabels: map[string]string{"tier": "development"}}),
+			},
+			expected: "production",
+		},
+		{
+			name:            "namespace selected by NonAdminPolicies of different priorities",
+			namespaceLabels: map[string]string{"tier": "production"},
+			policies: []client.Object{
+				newPolicy("all", 0, metav1.LabelSelector{}),
+				newPolicy("production", 10, metav1.LabelSelector{MatchLabels: map[string]string{"tier": "production"}}),
+			},
+			expected: "production",
+		},
+		{
+			name:            "namespace selected by NonAdminPolicies of the same priority",
+			namespaceLabels: map[string]string{"tier": "production"},
+			policies: []client.Object{
+				newPolicy("production", 0, metav1.LabelSelector{MatchLabels: map[string]string{"tier": "production"}}),
+				newPolicy("all", 0, metav1.LabelSelector{}),
+			},
+			expected: "all",
+		},
+		{
+			name:            "invalid namespace selector",
+			namespaceLabels: map[string]string{"tier": "production"},
+			policies: []client.Object{
+				newPolicy("invalid", 0, metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tier", Operator: "Unknown"},
+				}}),
+			},
+			errMessage: "NonAdminPolicy invalid namespaceSelector is invalid: \"Unknown\" is not a valid label selector operator",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeScheme := runtime.NewScheme()
+			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register NAC type: %v", err)
+			}
+			if err := corev1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register corev1 type: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "self-service-namespace",
+						Labels: test.namespaceLabels,
+					},
+				},
+			).WithObjects(test.policies...).Build()
+
+			result, err := GetNamespaceNonAdminPolicy(context.Background(), fakeClient, "self-service-namespace")
+			if len(test.errMessage) != 0 {
+				assert.EqualError(t, err, test.errMessage)
+				return
+			}
+			assert.NoError(t, err)
+			if len(test.expected) == 0 {
+				assert.Nil(t, result)
+			} else if assert.NotNil(t, result) {
+				assert.Equal(t, test.expected, result.Name)
+			}
+		})
+	}
+}
+
+func TestGetNonAdminControllerConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		configs  []client.Object
+		expected bool
+	}{
+		{
+			name: "without NonAdminControllerConfig",
+		},
+		{
+			name: "with a NonAdminControllerConfig of another name",
+			configs: []client.Object{
+				&nacv1alpha1.NonAdminControllerConfig{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+			},
+		},
+		{
+			name: "with the NonAdminControllerConfig",
+			configs: []client.Object{
+				&nacv1alpha1.NonAdminControllerConfig{ObjectMeta: metav1.ObjectMeta{Name: constant.NonAdminControllerConfigName}},
+			},
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeScheme := runtime.NewScheme()
+			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register NAC type: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(test.configs...).Build()
+
+			result, err := GetNonAdminControllerConfig(context.Background(), fakeClient)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result != nil)
+		})
+	}
+}
+
+func TestMergeEnforcedBackupSpec(t *testing.T) {
+	tests := []struct {
+		enforced   *velerov1.BackupSpec
+		overriding *velerov1.BackupSpec
+		expected   *velerov1.BackupSpec
+		name       string
+	}{
+		{
+			name:     "without enforced nor overriding spec",
+			expected: &velerov1.BackupSpec{},
+		},
+		{
+			name:     "without overriding spec",
+			enforced: &velerov1.BackupSpec{StorageLocation: "default"},
+			expected: &velerov1.BackupSpec{StorageLocation: "default"},
+		},
+		{
+			name:       "without enforced spec",
+			overriding: &velerov1.BackupSpec{SnapshotVolumes: ptr.To(false)},
+			expected:   &velerov1.BackupSpec{SnapshotVolumes: ptr.To(false)},
+		},
+		{
+			name: "with overriding spec setting other and the same fields",
+			enforced: &velerov1.BackupSpec{
+				StorageLocation: "default",
+				TTL:             metav1.Duration{Duration: time.Hour},
+			},
+			overriding: &velerov1.BackupSpec{
+				TTL:             metav1.Duration{Duration: 2 * time.Hour},
+				SnapshotVolumes: ptr.To(false),
+			},
+			expected: &velerov1.BackupSpec{
+				StorageLocation: "default",
+				TTL:             metav1.Duration{Duration: 2 * time.Hour},
+				SnapshotVolumes: ptr.To(false),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enforced := test.enforced.DeepCopy()
+			assert.Equal(t, test.expected, MergeEnforcedBackupSpec(test.enforced, test.overriding))
+			assert.Equal(t, enforced, test.enforced)
+		})
+	}
+}
+
+func TestValidateNonAdminQuota(t *testing.T) {
+	now := metav1.Now()
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+	newNab := func(name string, creationTimestamp metav1.Time, deleting bool) *nacv1alpha1.NonAdminBackup {
+		nab := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "self-service-namespace",
+				UID:               types.UID(name),
+				CreationTimestamp: creationTimestamp,
+			},
+		}
+		if deleting {
+			nab.DeletionTimestamp = &now
+		}
+		return nab
+	}
+	tests := []struct {
+		maxObjects *int32
+		name       string
+		errMessage string
+		objects    []metav1.Object
+	}{
+		{
+			name:    "without quota",
+			objects: []metav1.Object{newNab("first", earlier, false), newNab("second", earlier, false)},
+		},
+		{
+			name:       "under quota",
+			maxObjects: ptr.To[int32](3),
+			objects:    []metav1.Object{newNab("first", earlier, false), newNab("second", earlier, false)},
+		},
+		{
+			name:       "newer objects do not count",
+			maxObjects: ptr.To[int32](1),
+			objects:    []metav1.Object{newNab("a-newer", metav1.NewTime(now.Add(time.Minute)), false), newNab("z-same-time", now, false)},
+		},
+		{
+			name:       "objects being deleted do not count",
+			maxObjects: ptr.To[int32](1),
+			objects:    []metav1.Object{newNab("first", earlier, true), newNab("second", earlier, true)},
+		},
+		{
+			name:       "over quota",
+			maxObjects: ptr.To[int32](2),
+			objects:    []metav1.Object{newNab("first", earlier, false), newNab("a-same-time", now, false)},
+			errMessage: "NonAdminPolicy quota is exceeded: namespace self-service-namespace may have at most 2 nonadminbackups",
+		},
+		{
+			name:       "zero quota",
+			maxObjects: ptr.To[int32](0),
+			errMessage: "NonAdminPolicy quota is exceeded: namespace self-service-namespace may have at most 0 nonadminbackups",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nab := newNab("test", now, false)
+			err := ValidateNonAdminQuota(nacv1alpha1.NonAdminBackups, nab, append(test.objects, nab), test.maxObjects)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+				assert.ErrorIs(t, err, ErrNonAdminQuotaExceeded)
+			}
+		})
+	}
+}
+
+func TestIsBackupStorageLocationAutoApproved(t *testing.T) {
+	const rules = `- namespaceSelector:
+    matchLabels:
+      tier: development
+  providers: [aws]
+  buckets: ["dev-*"]
+  prefixes: ["team-a/*", "team-b/*"]
+`
+	tests := []struct {
+		name            string
+		configMapName   string
+		data            map[string]string
+		namespaceLabels map[string]string
+		spec            *velerov1.BackupStorageLocationSpec
+		expected        bool
+		errMessage      string
+	}{
+		{
+			name:            "without ConfigMap",
+			namespaceLabels: map[string]string{"tier": "development"},
+			spec:            &velerov1.BackupStorageLocationSpec{Provider: "aws"},
+		},
+		{
+			name:            "matching rule",
+			configMapName:   "bsl-auto-approval",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "development"},
+			spec: &velerov1.BackupStorageLocationSpec{
+				Provider: "aws",
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "dev-backups", Prefix: "team-b/velero"},
+				},
+			},
+			expected: true,
+		},
+		{
+			name:            "namespace not selected",
+			configMapName:   "bsl-auto-approval",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "production"},
+			spec: &velerov1.BackupStorageLocationSpec{
+				Provider: "aws",
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "dev-backups", Prefix: "team-a/velero"},
+				},
+			},
+		},
+		{
+			name:            "provider not allowed",
+			configMapName:   "bsl-auto-approval",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "development"},
+			spec: &velerov1.BackupStorageLocationSpec{
+				Provider: "gcp",
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "dev-backups", Prefix: "team-a/velero"},
+				},
+			},
+		},
+		{
+			name:            "bucket not matching",
+			configMapName:   "bsl-auto-approval",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "development"},
+			spec: &velerov1.BackupStorageLocationSpec{
+				Provider: "aws",
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "prod-backups", Prefix: "team-a/velero"},
+				},
+			},
+		},
+		{
+			name:            "prefix not matching",
+			configMapName:   "bsl-auto-approval",
+			data:            map[string]string{"rules.yaml": rules},
+			namespaceLabels: map[string]string{"tier": "development"},
+			spec: &velerov1.BackupStorageLocationSpec{
+				Provider: "aws",
+				StorageType: velerov1.StorageType{
+					ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "dev-backups", Prefix: "team-c/velero"},
+				},
+			},
+		},
+		{
+			name:          "invalid pattern",
+			configMapName: "bsl-auto-approval",
+			data: map[string]string{"rules.yaml": `- namespaceSelector: {}
+  buckets: ["dev-["]
+`},
+			spec:       &velerov1.BackupStorageLocationSpec{Provider: "aws"},
+			errMessage: "backup storage location auto approval ConfigMap bsl-auto-approval is invalid: rule 0 pattern \"dev-[\" is invalid: syntax error in pattern",
+		},
+		{
+			name:          "missing ConfigMap",
+			configMapName: "missing",
+			spec:          &velerov1.BackupStorageLocationSpec{Provider: "aws"},
+			errMessage:    "failed to get backup storage location auto approval ConfigMap missing: configmaps \"missing\" not found",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithObjects(
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "self-service-namespace",
+						Labels: test.namespaceLabels,
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "bsl-auto-approval",
+						Namespace: "oadp-namespace",
+					},
+					Data: test.data,
+				},
+			).Build()
+
+			result, err := IsBackupStorageLocationAutoApproved(context.Background(), fakeClient, "oadp-namespace", test.configMapName, "self-service-namespace", test.spec)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, result)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestGetOverriddenEnforcedRestoreSpec(t *testing.T) {
+	overrideConfigMap := func(name string, namespace string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "oadp-namespace",
+				Labels:    map[string]string{constant.EnforcedRestoreSpecOverrideLabel: namespace},
+			},
+			Data: data,
+		}
+	}
+	tests := []struct {
+		name       string
+		objects    []client.Object
+		expected   *velerov1.RestoreSpec
+		errMessage string
+	}{
+		{
+			name:    "without override",
+			objects: []client.Object{overrideConfigMap("other", "other-namespace", map[string]string{"restoreSpec": "restorePVs: true\n"})},
+			expected: &velerov1.RestoreSpec{
+				RestorePVs:       ptr.To(false),
+				ResourceModifier: &corev1.TypedLocalObjectReference{Kind: "ConfigMap", Name: "modifiers"},
+			},
+		},
+		{
+			name:    "with override",
+			objects: []client.Object{overrideConfigMap("trusted", "self-service-namespace", map[string]string{"restoreSpec": "preserveNodePorts: true\n"})},
+			expected: &velerov1.RestoreSpec{
+				PreserveNodePorts: ptr.To(true),
+				ResourceModifier:  &corev1.TypedLocalObjectReference{Kind: "ConfigMap", Name: "modifiers"},
+			},
+		},
+		{
+			name: "with two overrides",
+			objects: []client.Object{
+				overrideConfigMap("trusted", "self-service-namespace", map[string]string{"restoreSpec": "restorePVs: true\n"}),
+				overrideConfigMap("trusted-again", "self-service-namespace", map[string]string{"restoreSpec": "restorePVs: true\n"}),
+			},
+			errMessage: "2 ConfigMaps override the enforced restore spec of namespace self-service-namespace, at most one is allowed",
+		},
+		{
+			name:       "with override setting an unknown field",
+			objects:    []client.Object{overrideConfigMap("trusted", "self-service-namespace", map[string]string{"restoreSpec": "restorePV: true\n"})},
+			errMessage: "enforced restore spec override ConfigMap trusted is invalid: error unmarshaling JSON: while decoding JSON: json: unknown field \"restorePV\"",
+		},
+		{
+			name:       "with override setting resource modifier",
+			objects:    []client.Object{overrideConfigMap("trusted", "self-service-namespace", map[string]string{"restoreSpec": "resourceModifier:\n  kind: ConfigMap\n  name: other\n"})},
+			errMessage: "enforced restore spec override ConfigMap trusted is invalid: resourceModifier can not be overridden",
+		},
+		{
+			name:       "with override without data",
+			objects:    []client.Object{overrideConfigMap("trusted", "self-service-namespace", nil)},
+			errMessage: "enforced restore spec override ConfigMap trusted is invalid: it must have exactly one data key",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithObjects(test.objects...).Build()
+			enforcedSpec := &velerov1.RestoreSpec{
+				RestorePVs:       ptr.To(false),
+				ResourceModifier: &corev1.TypedLocalObjectReference{Kind: "ConfigMap", Name: "modifiers"},
+			}
+
+			result, err := GetOverriddenEnforcedRestoreSpec(context.Background(), fakeClient, "oadp-namespace", "self-service-namespace", enforcedSpec)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, result)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestGetRestorePriority(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    int
+		errMessage  string
+	}{
+		{
+			name: "without annotation",
+		},
+		{
+			name:        "with priority",
+			annotations: map[string]string{constant.RestorePriorityAnnotation: "10"},
+			expected:    10,
+		},
+		{
+			name:        "with negative priority",
+			annotations: map[string]string{constant.RestorePriorityAnnotation: "-5"},
+			expected:    -5,
+		},
+		{
+			name:        "with invalid priority",
+			annotations: map[string]string{constant.RestorePriorityAnnotation: "high"},
+			errMessage:  "namespace self-service-namespace annotation openshift.io/oadp-nac-restore-priority is invalid: strconv.Atoi: parsing \"high\": invalid syntax",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithObjects(
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "self-service-namespace",
+						Annotations: test.annotations,
+					},
+				},
+			).Build()
+
+			priority, err := GetRestorePriority(context.Background(), fakeClient, "self-service-namespace")
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, priority)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestGetRestoreDeniedResources(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    []string
+	}{
+		{
+			name:     "namespace without annotation",
+			expected: []string{"secrets"},
+		},
+		{
+			name:        "namespace denying more resources",
+			annotations: map[string]string{constant.RestoreDeniedResourcesAnnotation: "serviceaccounts, secrets,roles.rbac.authorization.k8s.io,"},
+			expected:    []string{"secrets", "serviceaccounts", "roles.rbac.authorization.k8s.io"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithObjects(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "self-service-namespace",
+					Annotations: test.annotations,
+				},
+			}).Build()
+			deniedResources := []string{"secrets"}
+
+			result, err := GetRestoreDeniedResources(context.Background(), fakeClient, "self-service-namespace", deniedResources)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+			assert.Equal(t, []string{"secrets"}, deniedResources)
+		})
+	}
+}
+
+func TestValidateRestoreResourceFilters(t *testing.T) {
+	deniedResources := []string{"secrets", "serviceaccounts", "roles.rbac.authorization.k8s.io"}
+	tests := []struct {
+		name              string
+		includedResources []string
+		errMessage        string
+	}{
+		{
+			name: "every resource",
+		},
+		{
+			name:              "wildcard",
+			includedResources: []string{"*"},
+		},
+		{
+			name:              "allowed resources",
+			includedResources: []string{"deployments.apps", "configmaps", "roles.example.com"},
+		},
+		{
+			name:              "denied resource",
+			includedResources: []string{"configmaps", "secrets"},
+			errMessage:        "NonAdminRestore spec.restoreSpec.includedResources is rejected: nonAdminRestore.spec.restoreSpec.includedResources[1] \"secrets\", secrets can not be restored into namespace self-service-namespace",
+		},
+		{
+			name:              "denied resource kind",
+			includedResources: []string{"ServiceAccount"},
+			errMessage:        "NonAdminRestore spec.restoreSpec.includedResources is rejected: nonAdminRestore.spec.restoreSpec.includedResources[0] \"ServiceAccount\", serviceaccounts can not be restored into namespace self-service-namespace",
+		},
+		{
+			name:              "denied resource without group",
+			includedResources: []string{"roles"},
+			errMessage:        "NonAdminRestore spec.restoreSpec.includedResources is rejected: nonAdminRestore.spec.restoreSpec.includedResources[0] \"roles\", roles.rbac.authorization.k8s.io can not be restored into namespace self-service-namespace",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateRestoreResourceFilters(&velerov1.RestoreSpec{IncludedResources: test.includedResources}, deniedResources, "self-service-namespace")
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+				assert.ErrorIs(t, err, ErrResourceFilterRejected)
+			}
+		})
+	}
+}
+
+func TestValidateRestoreVolumeSelector(t *testing.T) {
+	tests := []struct {
+		name           string
+		volumeSelector *metav1.LabelSelector
+		restoreSpec    *velerov1.RestoreSpec
+		errMessage     string
+	}{
+		{
+			name:        "without volume selector",
+			restoreSpec: &velerov1.RestoreSpec{IncludedResources: []string{"configmaps"}},
+		},
+		{
+			name:           "with volume selector",
+			volumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "database"}},
+			restoreSpec:    &velerov1.RestoreSpec{ExcludedResources: []string{"secrets"}},
+		},
+		{
+			name: "with invalid volume selector",
+			volumeSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Matches"},
+			}},
+			restoreSpec: &velerov1.RestoreSpec{},
+			errMessage:  "NonAdminRestore spec.volumeSelector is invalid: \"Matches\" is not a valid label selector operator",
+		},
+		{
+			name:           "with included resources",
+			volumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "database"}},
+			restoreSpec:    &velerov1.RestoreSpec{IncludedResources: []string{"persistentvolumeclaims"}},
+			errMessage:     "NonAdminRestore spec.volumeSelector can not be set with spec.restoreSpec.includedResources",
+		},
+		{
+			name:           "with label selector",
+			volumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "database"}},
+			restoreSpec:    &velerov1.RestoreSpec{LabelSelector: &metav1.LabelSelector{}},
+			errMessage:     "NonAdminRestore spec.volumeSelector can not be set with spec.restoreSpec.labelSelector",
+		},
+		{
+			name:           "with or label selectors",
+			volumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "database"}},
+			restoreSpec:    &velerov1.RestoreSpec{OrLabelSelectors: []*metav1.LabelSelector{{}}},
+			errMessage:     "NonAdminRestore spec.volumeSelector can not be set with spec.restoreSpec.orLabelSelectors",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateRestoreVolumeSelector(&nacv1alpha1.NonAdminRestore{
+				Spec: nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: test.restoreSpec, VolumeSelector: test.volumeSelector},
+			})
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestValidateResourceModifiers(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       map[string]string
+		errMessage string
+	}{
+		{
+			name: "valid rules",
+			data: map[string]string{"modifiers.yaml": `version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: deployments.apps
+    namespaces:
+    - self-service-namespace
+    - mapped-namespace
+  patches:
+  - operation: replace
+    path: /spec/replicas
+    value: "1"
+- conditions:
+    groupResource: "*"
+  mergePatches:
+  - patchData: |
+      metadata:
+        labels:
+          restored: "true"
+`},
+		},
+		{
+			name:       "no data key",
+			errMessage: "NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap resource-modifiers must have exactly one data key",
+		},
+		{
+			name: "unsupported version",
+			data: map[string]string{"modifiers.yaml": `version: v2
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+`},
+			errMessage: "NonAdminRestore spec.restoreSpec.resourceModifier ConfigMap resource-modifiers version \"v2\" is not supported",
+		},
+		{
+			name: "rule matching another namespace",
+			data: map[string]string{"modifiers.yaml": `version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+    namespaces:
+    - other-namespace
+`},
+			errMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.resourceModifier is restricted, resourceModifierRules[0] can not match namespace other-namespace",
+		},
+		{
+			name: "rule matching RBAC resources",
+			data: map[string]string{"modifiers.yaml": `version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: "*.rbac.authorization.k8s.io"
+`},
+			errMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.resourceModifier is restricted, resourceModifierRules[0] groupResource *.rbac.authorization.k8s.io can not match RBAC resources",
+		},
+		{
+			name: "rule matching any group",
+			data: map[string]string{"modifiers.yaml": `version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: "roles.*.k8s.io"
+`},
+			errMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.resourceModifier is restricted, resourceModifierRules[0] groupResource roles.*.k8s.io can not use wildcards in its group",
+		},
+		{
+			name: "JSON patch of the namespace",
+			data: map[string]string{"modifiers.yaml": `version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+  patches:
+  - operation: replace
+    path: /metadata
+    value: "{}"
+`},
+			errMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.resourceModifier is restricted, resourceModifierRules[0] can not patch /metadata",
+		},
+		{
+			name: "strategic patch of the namespace",
+			data: map[string]string{"modifiers.yaml": `version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: pods
+  strategicPatches:
+  - patchData: |
+      {"metadata": {"namespace": "other-namespace"}}
+`},
+			errMessage: "NonAdminRestore nonAdminRestore.spec.restoreSpec.resourceModifier is restricted, resourceModifierRules[0] can not patch metadata.namespace",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "resource-modifiers", Namespace: "self-service-namespace"},
+				Data:       test.data,
+			}
+			err := ValidateResourceModifiers(configMap, []string{"self-service-namespace", "mapped-namespace"})
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
+
+func TestValidateBslSpecEnforcedFields(t *testing.T) {
+	tests := []struct {
+		enforcedValue any
+		overrideValue any
+		name          string
+	}{
+		{
+			name:          "Provider",
+			enforcedValue: "aws",
+			overrideValue: "azure",
+		},
+		{
+			name:          "Config",
+			enforcedValue: map[string]string{"key": "value"},
+			overrideValue: map[string]string{"key": "differentValue"},
+		},
+		{
+			name:          "AccessMode",
+			enforcedValue: velerov1.BackupStorageLocationAccessModeReadOnly,
+			overrideValue: velerov1.BackupStorageLocationAccessModeReadWrite,
+		},
+		{
+			name:          "BackupSyncPeriod",
+			enforcedValue: &metav1.Duration{Duration: time60},
+			overrideValue: &metav1.Duration{Duration: time30},
+		},
+		{
+			name:          "ValidationFrequency",
+			enforcedValue: &metav1.Duration{Duration: time60},
+			overrideValue: &metav1.Duration{Duration: time30},
+		},
+		{
+			name: "StorageType",
+			enforcedValue: oadpv1alpha1.StorageType{
+				ObjectStorage: &oadpv1alpha1.ObjectStorageLocation{
+					Bucket: "test-bucket",
+				},
+			},
+			overrideValue: oadpv1alpha1.StorageType{
+				ObjectStorage: &oadpv1alpha1.ObjectStorageLocation{
+					Bucket: "override-bucket",
+				},
+			},
+		},
+		{
+			name: "Credential",
+			enforcedValue: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "test-secret",
+				},
+				Key: "creds",
+			},
+			overrideValue: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "override-secret",
+				},
+				Key: "override-creds",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enforcedSpec := &oadpv1alpha1.EnforceBackupStorageLocationSpec{}
+			reflect.ValueOf(enforcedSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
+			userNonAdminBsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "self-service-namespace",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret",
+							},
+							Key: "creds",
+						},
+						StorageType: velerov1.StorageType{
+							ObjectStorage: &velerov1.ObjectStorageLocation{
+								Bucket: "test-bucket",
+							},
+						},
+					},
+				},
+			}
+			fakeScheme := runtime.NewScheme()
+			if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register NAC type: %v", err)
+			}
+			if err := corev1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register corev1 type: %v", err)
+			}
+
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects([]client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-secret",
+						Namespace: "self-service-namespace",
+					},
+					Data: map[string][]byte{"creds": []byte("credentials")},
+				},
+			}...).Build()
+
+			err := ValidateBslSpec(context.Background(), fakeClient, userNonAdminBsl, enforcedSpec, 2*time.Minute, nil)
+			if err != nil {
+				t.Errorf("not setting bsl spec field '%v' test failed: %v", test.name, err)
+			}
+
+			// Handle StorageType separately to avoid reflect panic
+			if test.name == "StorageType" {
+				//nolint:errcheck //we know it's correct return type
+				if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage != nil {
+					if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage.Bucket != constant.EmptyString {
+						userNonAdminBsl.Spec.BackupStorageLocationSpec.StorageType.ObjectStorage.Bucket = test.overrideValue.(oadpv1alpha1.StorageType).ObjectStorage.Bucket
+					}
+					if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage.Prefix != constant.EmptyString {
+						userNonAdminBsl.Spec.BackupStorageLocationSpec.StorageType.ObjectStorage.Prefix = test.overrideValue.(oadpv1alpha1.StorageType).ObjectStorage.Prefix
+					}
+					if test.enforcedValue.(oadpv1alpha1.StorageType).ObjectStorage.CACert != nil {
+						userNonAdminBsl.Spec.BackupStorageLocationSpec.StorageType.ObjectStorage.CACert = test.overrideValue.(oadpv1alpha1.StorageType).ObjectStorage.CACert
+					}
+				}
+			} else {
+				reflect.ValueOf(userNonAdminBsl.Spec.BackupStorageLocationSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.enforcedValue))
+			}
+			if test.name != "StorageType" {
+				reflect.ValueOf(userNonAdminBsl.Spec.BackupStorageLocationSpec).Elem().FieldByName(test.name).Set(reflect.ValueOf(test.overrideValue))
+			}
+			err = ValidateBslSpec(context.Background(), fakeClient, userNonAdminBsl, enforcedSpec, 2*time.Minute, nil)
 			if err == nil {
 				t.Errorf("setting bsl spec field '%v' with value overriding enforcement test failed: %v", test.name, err)
 			}
 		})
 	}
-	t.Run("Ensure all enforceable bsl spec fields were tested", func(t *testing.T) {
-		bslSpecFields := []string{}
-		for _, test := range tests {
-			bslSpecFields = append(bslSpecFields, test.name)
-		}
-		bslSpec := reflect.ValueOf(&oadpv1alpha1.EnforceBackupStorageLocationSpec{}).Elem()
-		for index := range bslSpec.NumField() {
-			if !slices.Contains(bslSpecFields, bslSpec.Type().Field(index).Name) {
-				t.Errorf("bsl spec field '%v' is not tested", bslSpec.Type().Field(index).Name)
+	t.Run("Ensure all enforceable bsl spec fields were tested", func(t *testing.T) {
+		bslSpecFields := []string{}
+		for _, test := range tests {
+			bslSpecFields = append(bslSpecFields, test.name)
+		}
+		bslSpec := reflect.ValueOf(&oadpv1alpha1.EnforceBackupStorageLocationSpec{}).Elem()
+		for index := range bslSpec.NumField() {
+			if !slices.Contains(bslSpecFields, bslSpec.Type().Field(index).Name) {
+				t.Errorf("bsl spec field '%v' is not tested", bslSpec.Type().Field(index).Name)
+			}
+		}
+		if bslSpec.NumField() != len(tests) {
+			t.Errorf("list of tests have different number of elements")
+		}
+	})
+}
+
+func TestValidateBslSpecEnforcedConfig(t *testing.T) {
+	enforcedSpec := &oadpv1alpha1.EnforceBackupStorageLocationSpec{
+		Config: map[string]string{"checksumAlgorithm": "", "s3ForcePathStyle": "true"},
+	}
+	tests := []struct {
+		name     string
+		config   map[string]string
+		errorMsg string
+	}{
+		{
+			name:   "Other config keys are merged",
+			config: map[string]string{"region": "us-east-1", "s3ForcePathStyle": "true"},
+		},
+		{
+			name:     "Enforced config key is overridden",
+			config:   map[string]string{"region": "us-east-1", "s3ForcePathStyle": "false"},
+			errorMsg: "the administrator has restricted spec.backupStorageLocationSpec.config.s3ForcePathStyle field to: true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeScheme := runtime.NewScheme()
+			if err := corev1.AddToScheme(fakeScheme); err != nil {
+				t.Fatalf("Failed to register corev1 type: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "self-service-namespace"},
+				Data:       map[string][]byte{"creds": []byte("credentials")},
+			}).Build()
+			err := ValidateBslSpec(context.Background(), fakeClient, &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "self-service-namespace"},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Config: tt.config,
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "test-secret"},
+							Key:                  "creds",
+						},
+					},
+				},
+			}, enforcedSpec, 2*time.Minute, nil)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestValidateBslObjectLock(t *testing.T) {
+	objectLock := &nacv1alpha1.ObjectLock{Mode: nacv1alpha1.ObjectLockModeCompliance, RetentionPeriod: metav1.Duration{Duration: 720 * time.Hour}}
+	tests := []struct {
+		name           string
+		provider       string
+		objectLock     *nacv1alpha1.ObjectLock
+		config         map[string]string
+		enforcedConfig map[string]string
+		errorMsg       string
+	}{
+		{
+			name:     "No object lock",
+			provider: "gcp",
+		},
+		{
+			name:       "Object lock with the default checksum",
+			provider:   "velero.io/aws",
+			objectLock: objectLock,
+		},
+		{
+			name:       "Object lock with another provider",
+			provider:   "azure",
+			objectLock: objectLock,
+			errorMsg:   "NonAdminBackupStorageLocation spec.objectLock is not supported by provider azure, only by aws",
+		},
+		{
+			name:       "Object lock without mode",
+			provider:   "aws",
+			objectLock: &nacv1alpha1.ObjectLock{RetentionPeriod: metav1.Duration{Duration: time.Hour}},
+			errorMsg:   "NonAdminBackupStorageLocation spec.objectLock.mode must be one of: Governance, Compliance",
+		},
+		{
+			name:       "Object lock without retention period",
+			provider:   "aws",
+			objectLock: &nacv1alpha1.ObjectLock{Mode: nacv1alpha1.ObjectLockModeGovernance},
+			errorMsg:   "NonAdminBackupStorageLocation spec.objectLock.retentionPeriod must be positive",
+		},
+		{
+			name:       "Object lock with disabled checksums",
+			provider:   "aws",
+			objectLock: objectLock,
+			config:     map[string]string{"checksumAlgorithm": ""},
+			errorMsg:   "NonAdminBackupStorageLocation spec.objectLock requires checksums, spec.backupStorageLocationSpec.config.checksumAlgorithm can not be empty",
+		},
+		{
+			name:           "Object lock with checksums disabled by the administrator",
+			provider:       "aws",
+			objectLock:     objectLock,
+			config:         map[string]string{"checksumAlgorithm": "CRC32"},
+			enforcedConfig: map[string]string{"checksumAlgorithm": ""},
+			errorMsg:       "NonAdminBackupStorageLocation spec.objectLock requires checksums, spec.backupStorageLocationSpec.config.checksumAlgorithm can not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBslObjectLock(&nacv1alpha1.NonAdminBackupStorageLocation{
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{Provider: tt.provider, Config: tt.config},
+					ObjectLock:                tt.objectLock,
+				},
+			}, &oadpv1alpha1.EnforceBackupStorageLocationSpec{Config: tt.enforcedConfig})
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestValidateBslSpec(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register corev1 type: %v", err)
+	}
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		errorMessage string
+		nonAdminBsl  *nacv1alpha1.NonAdminBackupStorageLocation
+		objects      []client.Object
+	}{
+		{
+			name: "[invalid] spec.bslSpec.credential not set",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: nil,
+					},
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.bslSpec.credential is not set",
+		},
+		{
+			name: "[invalid] spec.bslSpec.credential Name or Key not set",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: constant.EmptyString,
+							},
+							Key: "non-empty-key",
+						},
+					},
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.bslSpec.credential.name or spec.bslSpec.credential.key is not set",
+		},
+		{
+			name: "[invalid] secret not found",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-1",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-1",
+							},
+							Key: key,
+						},
+					},
+				},
+			},
+			errorMessage: "BSL credentials secret not found: secrets \"test-secret-1\" not found",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "different-secret", Namespace: "test-namespace-1"},
+				},
+			},
+		},
+		{
+			name: "[valid] secret found",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-2",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-2",
+							},
+							Key: key,
+						},
+					},
+				},
+			},
+			errorMessage: constant.EmptyString,
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-secret-2", Namespace: "test-namespace-2"},
+					Data:       map[string][]byte{key: []byte("credentials")},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.bslSpec.default is set to true",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Default: true,
+					},
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation cannot be used as a default BSL",
+		},
+		{
+			name: "[invalid] spec.caCertConfigMap key not found",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-5",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-5",
+							},
+							Key: key,
+						},
+					},
+					CACertConfigMap: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "test-ca-5",
+						},
+						Key: "ca.crt",
+					},
+				},
+			},
+			errorMessage: "BSL CA bundle ConfigMap test-ca-5 has no ca.crt key",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-secret-5", Namespace: "test-namespace-5"},
+					Data:       map[string][]byte{key: []byte("credentials")},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-ca-5", Namespace: "test-namespace-5"},
+					Data:       map[string]string{"ca.pem": "ca"},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.caCertConfigMap and spec.bslSpec.objectStorage.caCert are both set",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-6",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-6",
+							},
+							Key: key,
+						},
+						StorageType: velerov1.StorageType{
+							ObjectStorage: &velerov1.ObjectStorageLocation{CACert: []byte("ca")},
+						},
+					},
+					CACertConfigMap: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "test-ca-6",
+						},
+						Key: "ca.crt",
+					},
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.caCertConfigMap and spec.backupStorageLocationSpec.objectStorage.caCert can not be both set",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-secret-6", Namespace: "test-namespace-6"},
+					Data:       map[string][]byte{key: []byte("credentials")},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.accessMode differs from spec.bslSpec.accessMode",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-9",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						AccessMode: velerov1.BackupStorageLocationAccessModeReadWrite,
+					},
+					AccessMode: velerov1.BackupStorageLocationAccessModeReadOnly,
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.accessMode and spec.backupStorageLocationSpec.accessMode can not differ",
+		},
+		{
+			name: "[invalid] spec.default is set on a ReadOnly NonAdminBackupStorageLocation",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "read-only",
+					Namespace: "test-namespace-10",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{},
+					AccessMode:                velerov1.BackupStorageLocationAccessModeReadOnly,
+					Default:                   true,
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.default can not be set on a ReadOnly NonAdminBackupStorageLocation",
+		},
+		{
+			name: "[valid] spec.accessMode is ReadOnly",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-11",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-11",
+							},
+							Key: key,
+						},
+					},
+					AccessMode: velerov1.BackupStorageLocationAccessModeReadOnly,
+				},
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-secret-11", Namespace: "test-namespace-11"},
+					Data:       map[string][]byte{key: []byte("credentials")},
+				},
+			},
+		},
+		{
+			name: "[valid] spec.cloudIdentity is set without credential secret",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-7",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Provider: "aws",
+					},
+					CloudIdentity: &nacv1alpha1.CloudIdentity{
+						AWS: &nacv1alpha1.AWSCloudIdentity{RoleARN: "arn:aws:iam::123456789012:role/tenant-backups"},
+					},
+				},
+			},
+		},
+		{
+			name: "[invalid] spec.cloudIdentity and spec.bslSpec.credential are both set",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-8",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Provider: "aws",
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-8",
+							},
+							Key: key,
+						},
+					},
+					CloudIdentity: &nacv1alpha1.CloudIdentity{
+						AWS: &nacv1alpha1.AWSCloudIdentity{RoleARN: "arn:aws:iam::123456789012:role/tenant-backups"},
+					},
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.cloudIdentity and spec.backupStorageLocationSpec.credential can not be both set",
+		},
+		{
+			name: "[invalid] spec.credential.profile is set for a provider without profiles",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-8",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Provider: "gcp",
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-8",
+							},
+							Key: key,
+						},
+					},
+					Credential: &nacv1alpha1.CredentialOptions{Profile: "tenant"},
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.credential.profile is not supported by provider gcp, only by aws",
+		},
+		{
+			name: "[invalid] spec.credential.profile and spec.bslSpec.config.profile are both set",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace-8",
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Provider: "aws",
+						Config:   map[string]string{"profile": "tenant"},
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-8",
+							},
+							Key: key,
+						},
+					},
+					Credential: &nacv1alpha1.CredentialOptions{Profile: "tenant"},
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.credential.profile and spec.backupStorageLocationSpec.config.profile can not be both set",
+		},
+		{
+			name: "[invalid] spec.default is set on a second NonAdminBackupStorageLocation of the namespace",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "second-default",
+					Namespace:         "test-namespace-3",
+					CreationTimestamp: metav1.NewTime(time.Now()),
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{},
+					Default:                   true,
+				},
+			},
+			errorMessage: "NonAdminBackupStorageLocation spec.default can only be set once per namespace, first-default is already the default",
+			objects: []client.Object{
+				&nacv1alpha1.NonAdminBackupStorageLocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "first-default",
+						Namespace:         "test-namespace-3",
+						CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+					},
+					Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{Default: true},
+				},
+			},
+		},
+		{
+			name: "[valid] spec.default is set on the oldest NonAdminBackupStorageLocation of the namespace",
+			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "first-default",
+					Namespace:         "test-namespace-4",
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+				},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Credential: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "test-secret-4",
+							},
+							Key: key,
+						},
+					},
+					Default: true,
+				},
+			},
+			errorMessage: constant.EmptyString,
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-secret-4", Namespace: "test-namespace-4"},
+					Data:       map[string][]byte{key: []byte("credentials")},
+				},
+				&nacv1alpha1.NonAdminBackupStorageLocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "first-default",
+						Namespace:         "test-namespace-4",
+						CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+					},
+					Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{Default: true},
+				},
+				&nacv1alpha1.NonAdminBackupStorageLocation{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "second-default",
+						Namespace:         "test-namespace-4",
+						CreationTimestamp: metav1.NewTime(time.Now()),
+					},
+					Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{Default: true},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(test.objects...).Build()
+
+			err := ValidateBslSpec(context.Background(), fakeClient, test.nonAdminBsl, &oadpv1alpha1.EnforceBackupStorageLocationSpec{}, 2*time.Minute, nil)
+			if err != nil {
+				if test.errorMessage != err.Error() {
+					t.Errorf("test '%s' failed: error messages differ. Expected '%v', got '%v'", test.name, test.errorMessage, err)
+				}
+				return
+			}
+			if test.errorMessage != "" {
+				t.Errorf("test '%s' failed: expected error '%v' but got none", test.name, test.errorMessage)
+			}
+		})
+	}
+}
+
+func TestGetDefaultNonAdminBackupStorageLocation(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	newNonAdminBsl := func(name string, namespace string, age time.Duration, isDefault bool) *nacv1alpha1.NonAdminBackupStorageLocation {
+		return &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{Default: isDefault},
+		}
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(
+		newNonAdminBsl("oldest", "test-namespace", 3*time.Hour, false),
+		newNonAdminBsl("old-default", "test-namespace", 2*time.Hour, true),
+		newNonAdminBsl("new-default", "test-namespace", time.Hour, true),
+		newNonAdminBsl("other-namespace-default", "other-namespace", 4*time.Hour, true),
+		newNonAdminBsl("not-default", "no-default-namespace", time.Hour, false),
+	).Build()
+
+	tests := []struct {
+		name      string
+		namespace string
+		expected  string
+	}{
+		{
+			name:      "oldest default NonAdminBackupStorageLocation of the namespace",
+			namespace: "test-namespace",
+			expected:  "old-default",
+		},
+		{
+			name:      "no default NonAdminBackupStorageLocation in the namespace",
+			namespace: "no-default-namespace",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defaultNonAdminBsl, err := GetDefaultNonAdminBackupStorageLocation(context.Background(), fakeClient, test.namespace)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			name := constant.EmptyString
+			if defaultNonAdminBsl != nil {
+				name = defaultNonAdminBsl.Name
+			}
+			if name != test.expected {
+				t.Errorf("expected default NonAdminBackupStorageLocation '%v', got '%v'", test.expected, name)
+			}
+		})
+	}
+}
+
+func TestGenerateNacObjectNameWithUUID(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		nabName   string
+	}{
+		{
+			name:      "Valid names without truncation",
+			namespace: defaultNS,
+			nabName:   "my-backup",
+		},
+		{
+			name:      "Truncate nabName due to length",
+			namespace: "some",
+			nabName:   strings.Repeat("q", constant.MaximumNacObjectNameLength+10), // too long for DNS limit
+		},
+		{
+			name:      "Truncate very long namespace and very long name",
+			namespace: strings.Repeat("w", constant.MaximumNacObjectNameLength+10),
+			nabName:   strings.Repeat("e", constant.MaximumNacObjectNameLength+10),
+		},
+		{
+			name:      "nabName empty",
+			namespace: "example",
+			nabName:   constant.EmptyString,
+		},
+		{
+			name:      "namespace empty",
+			namespace: constant.EmptyString,
+			nabName:   "my-backup",
+		},
+		{
+			name:      "very long name and namespace empty",
+			namespace: constant.EmptyString,
+			nabName:   strings.Repeat("r", constant.MaximumNacObjectNameLength+10),
+		},
+		{
+			name:      "very long namespace and name empty",
+			namespace: strings.Repeat("t", constant.MaximumNacObjectNameLength+10),
+			nabName:   constant.EmptyString,
+		},
+		{
+			name:      "empty namespace and empty name",
+			namespace: constant.EmptyString,
+			nabName:   constant.EmptyString,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GenerateNacObjectUUID(tt.namespace, tt.nabName)
+
+			// Check length, don't use constant.MaximumNacObjectNameLength here
+			// so if constant is changed test needs to be changed as well
+			if len(result) > validation.DNS1123LabelMaxLength {
+				t.Errorf("Generated name is too long: %s", result)
+			}
+
+			// Extract the last 36 characters, which should be the UUID
+			if len(result) < 36 {
+				t.Errorf("Generated name is too short to contain a valid UUID: %s", result)
+			}
+			uuidPart := result[len(result)-36:] // The UUID is always the last 36 characters
+
+			// Attempt to parse the UUID part
+			if _, err := uuid.Parse(uuidPart); err != nil {
+				t.Errorf("Last part is not a valid UUID: %s", uuidPart)
+			}
+
+			// Check if no double hyphens are present
+			if strings.Contains(result, "--") {
+				t.Errorf("Generated name contains double hyphens: %s", result)
+			}
+		})
+	}
+}
+
+func TestValidateNacObjectNameTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		errorMsg string
+	}{
+		{
+			name:     "Valid template with short UUID",
+			template: "{namespace}-{name}-{shortuuid}",
+		},
+		{
+			name:     "Valid template with UUID",
+			template: "nac-{uuid}",
+		},
+		{
+			name:     "Template without UUID",
+			template: "{namespace}-{name}",
+			errorMsg: "name template \"{namespace}-{name}\" must contain {uuid} or {shortuuid} placeholder",
+		},
+		{
+			name:     "Template with unknown placeholder",
+			template: "{cluster}-{shortuuid}",
+			errorMsg: "name template \"{cluster}-{shortuuid}\" contains unknown placeholder, allowed placeholders are {namespace}, {name}, {uuid} and {shortuuid}",
+		},
+		{
+			name:     "Template rendering invalid name",
+			template: "Backup_{shortuuid}",
+			errorMsg: "name template \"Backup_{shortuuid}\" renders invalid name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNacObjectNameTemplate(tt.template)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errorMsg)
 			}
-		}
-		if bslSpec.NumField() != len(tests) {
-			t.Errorf("list of tests have different number of elements")
-		}
+		})
+	}
+}
+
+func TestValidateBslProvider(t *testing.T) {
+	tests := []struct {
+		name             string
+		provider         string
+		config           map[string]string
+		allowedProviders []string
+		allowedS3URLs    []string
+		errorMsg         string
+	}{
+		{
+			name:     "Empty allow lists allow any provider",
+			provider: "gcp",
+		},
+		{
+			name:             "Allowed provider with velero.io prefix",
+			provider:         "velero.io/azure",
+			allowedProviders: []string{"aws", "azure"},
+		},
+		{
+			name:             "Provider not allowed",
+			provider:         "gcp",
+			allowedProviders: []string{"aws", "velero.io/azure"},
+			errorMsg:         "NonAdminBackupStorageLocation provider is not allowed, spec.backupStorageLocationSpec.provider must be one of: aws, velero.io/azure",
+		},
+		{
+			name:          "Allowed S3 endpoint",
+			provider:      "aws",
+			config:        map[string]string{"s3Url": "https://minio.internal.example.com"},
+			allowedS3URLs: []string{"https://*.internal.example.com"},
+		},
+		{
+			name:          "AWS S3 not allowed",
+			provider:      "aws",
+			allowedS3URLs: []string{"https://*.internal.example.com"},
+			errorMsg:      "NonAdminBackupStorageLocation provider is not allowed, spec.backupStorageLocationSpec.config.s3Url must match one of: https://*.internal.example.com",
+		},
+		{
+			name:          "S3 endpoints do not restrict other providers",
+			provider:      "azure",
+			allowedS3URLs: []string{"https://*.internal.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBslProvider(&velerov1.BackupStorageLocationSpec{Provider: tt.provider, Config: tt.config}, tt.allowedProviders, tt.allowedS3URLs)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+				assert.ErrorIs(t, err, ErrBslProviderNotAllowed)
+			}
+		})
+	}
+
+	t.Run("Invalid S3 endpoint pattern", func(t *testing.T) {
+		assert.ErrorContains(t, ValidateBslAllowedS3URLs([]string{"https://[internal"}), "S3 endpoint pattern \"https://[internal\" is invalid")
 	})
 }
 
-func TestValidateBslSpec(t *testing.T) {
-	fakeScheme := runtime.NewScheme()
-	if err := corev1.AddToScheme(fakeScheme); err != nil {
-		t.Fatalf("Failed to register corev1 type: %v", err)
+func TestValidateBslMinimumPeriods(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *velerov1.BackupStorageLocationSpec
+		errorMsg string
+	}{
+		{
+			name: "Periods not set",
+			spec: &velerov1.BackupStorageLocationSpec{},
+		},
+		{
+			name: "Periods above the minimums",
+			spec: &velerov1.BackupStorageLocationSpec{
+				BackupSyncPeriod:    &metav1.Duration{Duration: 10 * time.Minute},
+				ValidationFrequency: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+		},
+		{
+			name: "Disabled periods",
+			spec: &velerov1.BackupStorageLocationSpec{
+				BackupSyncPeriod:    &metav1.Duration{},
+				ValidationFrequency: &metav1.Duration{},
+			},
+		},
+		{
+			name:     "Backup sync period below the minimum",
+			spec:     &velerov1.BackupStorageLocationSpec{BackupSyncPeriod: &metav1.Duration{Duration: 30 * time.Second}},
+			errorMsg: "NonAdminBackupStorageLocation spec.backupStorageLocationSpec.backupSyncPeriod (30s) can not be lower than 5m0s",
+		},
+		{
+			name:     "Validation frequency below the minimum",
+			spec:     &velerov1.BackupStorageLocationSpec{ValidationFrequency: &metav1.Duration{Duration: time.Minute}},
+			errorMsg: "NonAdminBackupStorageLocation spec.backupStorageLocationSpec.validationFrequency (1m0s) can not be lower than 2m0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBslMinimumPeriods(tt.spec, 5*time.Minute, 2*time.Minute)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+		})
 	}
+}
 
+func TestValidateBslCredentialSecret(t *testing.T) {
 	tests := []struct {
-		name         string
-		errorMessage string
-		nonAdminBsl  *nacv1alpha1.NonAdminBackupStorageLocation
-		objects      []client.Object
+		name     string
+		provider string
+		config   map[string]string
+		profile  string
+		data     map[string][]byte
+		errorMsg string
 	}{
 		{
-			name: "[invalid] spec.bslSpec.credential not set",
-			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
-				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
-					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
-						Credential: nil,
-					},
+			name:     "Missing key",
+			provider: "aws",
+			data:     map[string][]byte{"other": []byte("value")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, secret creds is missing key cloud",
+		},
+		{
+			name:     "Empty key",
+			provider: "aws",
+			data:     map[string][]byte{"cloud": []byte(" \n")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds is empty",
+		},
+		{
+			name:     "Valid AWS credentials",
+			provider: "velero.io/aws",
+			data:     map[string][]byte{"cloud": []byte("# tenant\n[default]\naws_access_key_id = id\naws_secret_access_key = secret\n")},
+		},
+		{
+			name:     "Valid AWS credentials of the configured profile",
+			provider: "aws",
+			config:   map[string]string{"profile": "tenant"},
+			data: map[string][]byte{"cloud": []byte("[profile tenant]\naws_access_key_id = id\naws_secret_access_key = secret\n" +
+				"aws_session_token = token\nregion = us-east-1\n")},
+		},
+		{
+			name:     "Malformed AWS credentials",
+			provider: "aws",
+			data:     map[string][]byte{"cloud": []byte("[default]\\naws_access_key_id = id\\naws_secret_access_key = secret")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"malformed AWS credentials profile, line 1 is neither a [profile] nor a key = value line",
+		},
+		{
+			name:     "AWS credentials without the profile",
+			provider: "aws",
+			config:   map[string]string{"profile": "tenant"},
+			data:     map[string][]byte{"cloud": []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"malformed AWS credentials profile, profile tenant not found",
+		},
+		{
+			name:     "Valid AWS credentials of the selected profile",
+			provider: "aws",
+			profile:  "tenant",
+			data: map[string][]byte{"cloud": []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n" +
+				"[tenant]\naws_access_key_id = tenant-id\naws_secret_access_key = tenant-secret\n")},
+		},
+		{
+			name:     "AWS credentials of the selected profile with source_profile",
+			provider: "aws",
+			profile:  "tenant",
+			data: map[string][]byte{"cloud": []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n" +
+				"[profile tenant]\nrole_arn = arn:aws:iam::123456789012:role/tenant\nsource_profile = default\n")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"AWS credentials profile tenant sets role_arn, only aws_access_key_id, aws_secret_access_key, aws_session_token, region are allowed",
+		},
+		{
+			name:     "AWS credentials without keys",
+			provider: "aws",
+			data:     map[string][]byte{"cloud": []byte("[default]\naws_access_key_id = id\n")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"malformed AWS credentials profile, profile default does not set aws_access_key_id and aws_secret_access_key",
+		},
+		{
+			name:     "AWS credentials with a key of another profile not allowed",
+			provider: "aws",
+			data: map[string][]byte{"cloud": []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n" +
+				"[other]\ncredential_process = /bin/sh -c id\n")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"AWS credentials profile other sets credential_process, only aws_access_key_id, aws_secret_access_key, aws_session_token, region are allowed",
+		},
+		{
+			name:     "Valid Azure credentials",
+			provider: "azure",
+			data:     map[string][]byte{"cloud": []byte("AZURE_SUBSCRIPTION_ID=subscription\nAZURE_STORAGE_ACCOUNT_ACCESS_KEY=key\n")},
+		},
+		{
+			name:     "Malformed Azure credentials",
+			provider: "azure",
+			data:     map[string][]byte{"cloud": []byte("AZURE_STORAGE_ACCOUNT_ACCESS_KEY key")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"malformed Azure credentials file, line 1 is not a KEY=VALUE line",
+		},
+		{
+			name:     "Azure credentials without keys",
+			provider: "azure",
+			data:     map[string][]byte{"cloud": []byte("AZURE_SUBSCRIPTION_ID=subscription\n")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"malformed Azure credentials file, it sets neither AZURE_STORAGE_ACCOUNT_ACCESS_KEY nor AZURE_CLIENT_ID",
+		},
+		{
+			name:     "Valid GCP credentials",
+			provider: "gcp",
+			data:     map[string][]byte{"cloud": []byte(`{"type": "service_account", "project_id": "project"}`)},
+		},
+		{
+			name:     "Malformed GCP credentials",
+			provider: "gcp",
+			data:     map[string][]byte{"cloud": []byte("[default]\n")},
+			errorMsg: "NonAdminBackupStorageLocation credentials are invalid, key cloud of secret creds: " +
+				"malformed GCP credentials file, it is not a JSON service account or external account key",
+		},
+		{
+			name:     "Other provider credentials",
+			provider: "example.io/provider",
+			data:     map[string][]byte{"cloud": []byte("opaque")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bslSpec := &velerov1.BackupStorageLocationSpec{
+				Provider: tt.provider,
+				Config:   tt.config,
+				Credential: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+					Key:                  "cloud",
 				},
+			}
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds"}, Data: tt.data}
+			err := ValidateBslCredentialSecret(bslSpec, tt.profile, secret)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrBslCredentialsInvalid)
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestRenderBslCredentialProfile(t *testing.T) {
+	credentials := []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n\n" +
+		"# tenant profile\n[profile tenant]\naws_access_key_id = tenant-id\naws_secret_access_key = tenant-secret\n" +
+		"[other]\naws_access_key_id = other-id\n")
+
+	rendered, err := RenderBslCredentialProfile(credentials, "tenant")
+	assert.NoError(t, err)
+	assert.Equal(t, "[default]\naws_access_key_id = tenant-id\naws_secret_access_key = tenant-secret\n", string(rendered))
+
+	_, err = RenderBslCredentialProfile(credentials, "missing")
+	assert.EqualError(t, err, "malformed AWS credentials profile, profile missing not found")
+}
+
+func TestValidateAWSCredentialsKeysNotAllowed(t *testing.T) {
+	for _, line := range []string{
+		"credential_process = /bin/sh -c id",
+		"role_arn = arn:aws:iam::123456789012:role/tenant",
+		"credential_source = Environment",
+		"web_identity_token_file = /var/run/secrets/openshift/serviceaccount/token",
+		"source_profile = default",
+	} {
+		key, _, _ := strings.Cut(line, " = ")
+		t.Run(key, func(t *testing.T) {
+			credentials := []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n" + line + "\n")
+			errorMsg := "AWS credentials profile default sets " + key +
+				", only aws_access_key_id, aws_secret_access_key, aws_session_token, region are allowed"
+
+			assert.EqualError(t, validateAWSCredentials(credentials, "default"), errorMsg)
+			_, err := RenderBslCredentialProfile(credentials, "default")
+			assert.EqualError(t, err, errorMsg)
+		})
+	}
+}
+
+func TestApplyBslMinimumPeriods(t *testing.T) {
+	spec := &velerov1.BackupStorageLocationSpec{ValidationFrequency: &metav1.Duration{Duration: 30 * time.Second}}
+	ApplyBslMinimumPeriods(spec, 5*time.Minute, 2*time.Minute)
+	assert.Equal(t, &metav1.Duration{Duration: 5 * time.Minute}, spec.BackupSyncPeriod)
+	assert.Equal(t, &metav1.Duration{Duration: 2 * time.Minute}, spec.ValidationFrequency)
+
+	spec = &velerov1.BackupStorageLocationSpec{
+		BackupSyncPeriod:    &metav1.Duration{},
+		ValidationFrequency: &metav1.Duration{Duration: time.Hour},
+	}
+	ApplyBslMinimumPeriods(spec, 5*time.Minute, 2*time.Minute)
+	assert.Equal(t, &metav1.Duration{}, spec.BackupSyncPeriod)
+	assert.Equal(t, &metav1.Duration{Duration: time.Hour}, spec.ValidationFrequency)
+
+	spec = &velerov1.BackupStorageLocationSpec{}
+	ApplyBslMinimumPeriods(spec, 0, 0)
+	assert.Nil(t, spec.BackupSyncPeriod)
+	assert.Nil(t, spec.ValidationFrequency)
+}
+
+func TestValidateBslCloudIdentity(t *testing.T) {
+	const azureID = "00000000-0000-0000-0000-000000000000"
+	tests := []struct {
+		name          string
+		provider      string
+		cloudIdentity *nacv1alpha1.CloudIdentity
+		errorMsg      string
+	}{
+		{
+			name:          "AWS role",
+			provider:      "velero.io/aws",
+			cloudIdentity: &nacv1alpha1.CloudIdentity{AWS: &nacv1alpha1.AWSCloudIdentity{RoleARN: "arn:aws:iam::123456789012:role/tenant-backups"}},
+		},
+		{
+			name:     "Azure workload identity",
+			provider: "azure",
+			cloudIdentity: &nacv1alpha1.CloudIdentity{Azure: &nacv1alpha1.AzureCloudIdentity{
+				SubscriptionID: azureID, TenantID: azureID, ClientID: azureID, CloudName: "AzureChinaCloud",
+			}},
+		},
+		{
+			name:          "No identity",
+			provider:      "aws",
+			cloudIdentity: &nacv1alpha1.CloudIdentity{},
+			errorMsg:      "NonAdminBackupStorageLocation spec.cloudIdentity must set aws or azure",
+		},
+		{
+			name:          "AWS role with another provider",
+			provider:      "gcp",
+			cloudIdentity: &nacv1alpha1.CloudIdentity{AWS: &nacv1alpha1.AWSCloudIdentity{RoleARN: "arn:aws:iam::123456789012:role/tenant-backups"}},
+			errorMsg:      "NonAdminBackupStorageLocation spec.cloudIdentity.aws requires the aws provider",
+		},
+		{
+			name:          "AWS role ARN with a new line",
+			provider:      "aws",
+			cloudIdentity: &nacv1alpha1.CloudIdentity{AWS: &nacv1alpha1.AWSCloudIdentity{RoleARN: "arn:aws:iam::123456789012:role/a\nsource_profile = admin"}},
+			errorMsg:      "NonAdminBackupStorageLocation spec.cloudIdentity.aws.roleARN \"arn:aws:iam::123456789012:role/a\\nsource_profile = admin\" is not a valid IAM role ARN",
+		},
+		{
+			name:     "Azure invalid client ID",
+			provider: "azure",
+			cloudIdentity: &nacv1alpha1.CloudIdentity{Azure: &nacv1alpha1.AzureCloudIdentity{
+				SubscriptionID: azureID, TenantID: azureID, ClientID: "client",
+			}},
+			errorMsg: "NonAdminBackupStorageLocation spec.cloudIdentity.azure.clientID \"client\" is not a valid ID",
+		},
+		{
+			name:     "Both identities",
+			provider: "aws",
+			cloudIdentity: &nacv1alpha1.CloudIdentity{
+				AWS:   &nacv1alpha1.AWSCloudIdentity{RoleARN: "arn:aws:iam::123456789012:role/tenant-backups"},
+				Azure: &nacv1alpha1.AzureCloudIdentity{SubscriptionID: azureID, TenantID: azureID, ClientID: azureID},
 			},
-			errorMessage: "NonAdminBackupStorageLocation spec.bslSpec.credential is not set",
+			errorMsg: "NonAdminBackupStorageLocation spec.cloudIdentity.aws and spec.cloudIdentity.azure can not be both set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBslCloudIdentity(tt.cloudIdentity, tt.provider)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestGetBslCloudIdentityCredentials(t *testing.T) {
+	const azureID = "00000000-0000-0000-0000-000000000000"
+
+	aws := &nacv1alpha1.CloudIdentity{AWS: &nacv1alpha1.AWSCloudIdentity{RoleARN: "arn:aws:iam::123456789012:role/tenant-backups"}}
+	assert.Equal(t, "[default]\nsts_regional_endpoints = regional\nrole_arn = arn:aws:iam::123456789012:role/tenant-backups\n"+
+		"web_identity_token_file = /var/run/secrets/openshift/serviceaccount/token\n", string(GetBslCloudIdentityCredentials(aws)))
+	assert.Nil(t, GetBslCloudIdentityConfig(aws))
+
+	azure := &nacv1alpha1.CloudIdentity{Azure: &nacv1alpha1.AzureCloudIdentity{SubscriptionID: azureID, TenantID: azureID, ClientID: azureID}}
+	assert.Equal(t, "AZURE_SUBSCRIPTION_ID="+azureID+"\nAZURE_TENANT_ID="+azureID+"\nAZURE_CLIENT_ID="+azureID+"\n"+
+		"AZURE_CLOUD_NAME=AzurePublicCloud\n", string(GetBslCloudIdentityCredentials(azure)))
+	assert.Equal(t, map[string]string{"useAAD": "true"}, GetBslCloudIdentityConfig(azure))
+
+	assert.Nil(t, GetBslCloudIdentityCredentials(nil))
+	assert.Nil(t, GetBslCloudIdentityConfig(nil))
+}
+
+func TestGetBslSpecChangedFields(t *testing.T) {
+	bslSpec := &velerov1.BackupStorageLocationSpec{
+		Provider: "aws",
+		StorageType: velerov1.StorageType{
+			ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "bucket"},
+		},
+		Config: map[string]string{"region": "us-east-1"},
+	}
+	tests := []struct {
+		name     string
+		update   func(bslSpec *velerov1.BackupStorageLocationSpec)
+		expected []string
+	}{
+		{
+			name:     "Unchanged spec",
+			update:   func(*velerov1.BackupStorageLocationSpec) {},
+			expected: []string{},
 		},
 		{
-			name: "[invalid] spec.bslSpec.credential Name or Key not set",
-			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
-				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
-					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
-						Credential: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: constant.EmptyString,
-							},
-							Key: "non-empty-key",
-						},
-					},
-				},
+			name: "Changed endpoint, prefix and checksum algorithm",
+			update: func(bslSpec *velerov1.BackupStorageLocationSpec) {
+				bslSpec.ObjectStorage.Prefix = "velero"
+				bslSpec.Config["s3Url"] = "https://s3.example.com"
+				bslSpec.Config["checksumAlgorithm"] = ""
 			},
-			errorMessage: "NonAdminBackupStorageLocation spec.bslSpec.credential.name or spec.bslSpec.credential.key is not set",
+			expected: []string{"config.checksumAlgorithm", "config.s3Url", "objectStorage.prefix"},
 		},
 		{
-			name: "[invalid] secret not found",
-			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "test-namespace-1",
-				},
-				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
-					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
-						Credential: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: "test-secret-1",
-							},
-							Key: key,
-						},
-					},
-				},
+			name: "Removed config",
+			update: func(bslSpec *velerov1.BackupStorageLocationSpec) {
+				bslSpec.Config = nil
 			},
-			errorMessage: "BSL credentials secret not found: secrets \"test-secret-1\" not found",
-			objects: []client.Object{
-				&corev1.Secret{
-					ObjectMeta: metav1.ObjectMeta{Name: "different-secret", Namespace: "test-namespace-1"},
-				},
+			expected: []string{"config.region"},
+		},
+		{
+			name: "Added credential",
+			update: func(bslSpec *velerov1.BackupStorageLocationSpec) {
+				bslSpec.Credential = &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"}
 			},
+			expected: []string{"credential.key", "credential.name"},
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updatedBslSpec := bslSpec.DeepCopy()
+			tt.update(updatedBslSpec)
+			changedFields, err := GetBslSpecChangedFields(bslSpec, updatedBslSpec)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, changedFields)
+		})
+	}
+}
+
+func TestGetBslDriftedFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		update   func(bsl *velerov1.BackupStorageLocation)
+		expected []string
+		errorMsg string
+	}{
 		{
-			name: "[valid] secret found",
-			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "test-namespace-2",
-				},
-				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
-					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
-						Credential: &corev1.SecretKeySelector{
-							LocalObjectReference: corev1.LocalObjectReference{
-								Name: "test-secret-2",
-							},
-							Key: key,
-						},
-					},
-				},
+			name:     "Unmodified spec",
+			update:   func(*velerov1.BackupStorageLocation) {},
+			expected: []string{},
+		},
+		{
+			name: "Modified bucket and endpoint",
+			update: func(bsl *velerov1.BackupStorageLocation) {
+				bsl.Spec.ObjectStorage.Bucket = "other-bucket"
+				bsl.Spec.Config["s3Url"] = "https://s3.example.com"
 			},
-			errorMessage: constant.EmptyString,
-			objects: []client.Object{
-				&corev1.Secret{
-					ObjectMeta: metav1.ObjectMeta{Name: "test-secret-2", Namespace: "test-namespace-2"},
-				},
+			expected: []string{"config.s3Url", "objectStorage.bucket"},
+		},
+		{
+			name: "No last applied spec",
+			update: func(bsl *velerov1.BackupStorageLocation) {
+				bsl.Spec.ObjectStorage.Bucket = "other-bucket"
+				delete(bsl.Annotations, constant.NabslLastAppliedSpecAnnotation)
 			},
+			expected: []string{},
 		},
 		{
-			name: "[invalid] spec.bslSpec.default is set to true",
-			nonAdminBsl: &nacv1alpha1.NonAdminBackupStorageLocation{
-				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
-					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
-						Default: true,
-					},
-				},
+			name: "Invalid last applied spec",
+			update: func(bsl *velerov1.BackupStorageLocation) {
+				bsl.Annotations[constant.NabslLastAppliedSpecAnnotation] = "{"
 			},
-			errorMessage: "NonAdminBackupStorageLocation cannot be used as a default BSL",
+			errorMsg: "invalid openshift.io/oadp-nabsl-last-applied-spec annotation",
 		},
 	}
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(test.objects...).Build()
-
-			err := ValidateBslSpec(context.Background(), fakeClient, test.nonAdminBsl, &oadpv1alpha1.EnforceBackupStorageLocationSpec{}, 2*time.Minute, nil)
-			if err != nil {
-				if test.errorMessage != err.Error() {
-					t.Errorf("test '%s' failed: error messages differ. Expected '%v', got '%v'", test.name, test.errorMessage, err)
-				}
-				return
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bsl := &velerov1.BackupStorageLocation{
+				Spec: velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "bucket", Prefix: "tenant"},
+					},
+					Config:           map[string]string{"region": "us-east-1"},
+					BackupSyncPeriod: &metav1.Duration{Duration: time.Minute},
+				},
 			}
-			if test.errorMessage != "" {
-				t.Errorf("test '%s' failed: expected error '%v' but got none", test.name, test.errorMessage)
+			assert.NoError(t, SetBslLastAppliedSpec(bsl))
+			tt.update(bsl)
+			driftedFields, err := GetBslDriftedFields(bsl)
+			if tt.errorMsg != "" {
+				assert.ErrorContains(t, err, tt.errorMsg)
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, driftedFields)
 		})
 	}
 }
 
-func TestGenerateNacObjectNameWithUUID(t *testing.T) {
+func TestValidateBslPrefixTemplate(t *testing.T) {
 	tests := []struct {
-		name      string
-		namespace string
-		nabName   string
+		name     string
+		template string
+		errorMsg string
 	}{
 		{
-			name:      "Valid names without truncation",
-			namespace: defaultNS,
-			nabName:   "my-backup",
+			name:     "Valid template with namespace",
+			template: "tenants/{namespace}",
 		},
 		{
-			name:      "Truncate nabName due to length",
-			namespace: "some",
-			nabName:   strings.Repeat("q", constant.MaximumNacObjectNameLength+10), // too long for DNS limit
+			name:     "Valid template with namespace and name",
+			template: "{namespace}/{name}",
 		},
 		{
-			name:      "Truncate very long namespace and very long name",
-			namespace: strings.Repeat("w", constant.MaximumNacObjectNameLength+10),
-			nabName:   strings.Repeat("e", constant.MaximumNacObjectNameLength+10),
+			name:     "Template without namespace",
+			template: "tenants/{name}",
+			errorMsg: "prefix template \"tenants/{name}\" must contain {namespace} placeholder",
 		},
 		{
-			name:      "nabName empty",
-			namespace: "example",
-			nabName:   constant.EmptyString,
+			name:     "Template with namespace within a path segment",
+			template: "tenants/{namespace}-{name}",
+			errorMsg: "prefix template \"tenants/{namespace}-{name}\" must use {namespace} placeholder as a whole path segment",
 		},
 		{
-			name:      "namespace empty",
-			namespace: constant.EmptyString,
-			nabName:   "my-backup",
+			name:     "Template with namespace within the last path segment",
+			template: "{namespace}/backups-{namespace}",
+			errorMsg: "prefix template \"{namespace}/backups-{namespace}\" must use {namespace} placeholder as a whole path segment",
 		},
 		{
-			name:      "very long name and namespace empty",
-			namespace: constant.EmptyString,
-			nabName:   strings.Repeat("r", constant.MaximumNacObjectNameLength+10),
+			name:     "Template with unknown placeholder",
+			template: "{cluster}/{namespace}",
+			errorMsg: "prefix template \"{cluster}/{namespace}\" contains unknown placeholder, allowed placeholders are {namespace} and {name}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBslPrefixTemplate(tt.template)
+			if tt.errorMsg == constant.EmptyString {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestRenderBslPrefixTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "Empty template",
+			template: constant.EmptyString,
+			expected: "my-namespace",
 		},
 		{
-			name:      "very long namespace and name empty",
-			namespace: strings.Repeat("t", constant.MaximumNacObjectNameLength+10),
-			nabName:   constant.EmptyString,
+			name:     "Template with namespace",
+			template: "/tenants/{namespace}/",
+			expected: "tenants/my-namespace",
 		},
 		{
-			name:      "empty namespace and empty name",
-			namespace: constant.EmptyString,
-			nabName:   constant.EmptyString,
+			name:     "Template with namespace and name",
+			template: "{namespace}/{name}",
+			expected: "my-namespace/my-bsl",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := GenerateNacObjectUUID(tt.namespace, tt.nabName)
-
-			// Check length, don't use constant.MaximumNacObjectNameLength here
-			// so if constant is changed test needs to be changed as well
-			if len(result) > validation.DNS1123LabelMaxLength {
-				t.Errorf("Generated name is too long: %s", result)
-			}
-
-			// Extract the last 36 characters, which should be the UUID
-			if len(result) < 36 {
-				t.Errorf("Generated name is too short to contain a valid UUID: %s", result)
-			}
-			uuidPart := result[len(result)-36:] // The UUID is always the last 36 characters
+			assert.Equal(t, tt.expected, RenderBslPrefixTemplate(tt.template, "my-namespace", "my-bsl"))
+		})
+	}
+}
 
-			// Attempt to parse the UUID part
-			if _, err := uuid.Parse(uuidPart); err != nil {
-				t.Errorf("Last part is not a valid UUID: %s", uuidPart)
-			}
+func TestRenderNacObjectName(t *testing.T) {
+	nacUUID := "my-namespace-my-backup-12345678-9abc-def0-1234-56789abcdef0"
+	tests := []struct {
+		name      string
+		template  string
+		namespace string
+		nacName   string
+		expected  string
+	}{
+		{
+			name:      "Empty template",
+			template:  constant.EmptyString,
+			namespace: "my-namespace",
+			nacName:   "my-backup",
+			expected:  nacUUID,
+		},
+		{
+			name:      "Template with short UUID",
+			template:  "{namespace}-{name}-{shortuuid}",
+			namespace: "my-namespace",
+			nacName:   "my-backup",
+			expected:  "my-namespace-my-backup-12345678",
+		},
+		{
+			name:      "Template with UUID",
+			template:  "{name}-{uuid}",
+			namespace: "my-namespace",
+			nacName:   "my-backup",
+			expected:  "my-backup-12345678-9abc-def0-1234-56789abcdef0",
+		},
+		{
+			name:      "Rendered name too long",
+			template:  "{namespace}-{name}-{uuid}",
+			namespace: strings.Repeat("n", 40),
+			nacName:   "my-backup",
+			expected:  nacUUID,
+		},
+		{
+			name:      "Rendered name invalid",
+			template:  "{name}-{shortuuid}",
+			namespace: "my-namespace",
+			nacName:   "my.backup",
+			expected:  nacUUID,
+		},
+	}
 
-			// Check if no double hyphens are present
-			if strings.Contains(result, "--") {
-				t.Errorf("Generated name contains double hyphens: %s", result)
-			}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RenderNacObjectName(tt.template, tt.namespace, tt.nacName, nacUUID))
 		})
 	}
 }
@@ -1310,37 +5271,173 @@ func TestGetVeleroBackupByLabel(t *testing.T) {
 			expectedError: errors.New("multiple VeleroBackup objects found with label openshift.io/oadp-nab-origin-nacuuid=test-app in namespace 'default'"),
 		},
 		{
-			name:          invalidInputEmptyNamespace,
-			namespace:     constant.EmptyString,
-			labelValue:    testAppStr,
-			mockBackups:   []velerov1.Backup{},
-			expected:      nil,
-			expectedError: errors.New("invalid input: namespace=\"\", labelKey=\"openshift.io/oadp-nab-origin-nacuuid\", labelValue=\"test-app\""),
+			name:          invalidInputEmptyNamespace,
+			namespace:     constant.EmptyString,
+			labelValue:    testAppStr,
+			mockBackups:   []velerov1.Backup{},
+			expected:      nil,
+			expectedError: errors.New("invalid input: namespace=\"\", labelKey=\"openshift.io/oadp-nab-origin-nacuuid\", labelValue=\"test-app\""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []client.Object
+			for _, backup := range tt.mockBackups {
+				backupCopy := backup // Create a copy to avoid memory aliasing
+				objects = append(objects, &backupCopy)
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			result, err := GetVeleroBackupByLabel(ctx, client, tt.namespace, tt.labelValue)
+
+			if tt.expectedError != nil {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				if tt.expected != nil && result != nil {
+					assert.Equal(t, tt.expected.Name, result.Name, "VeleroBackup Name should match")
+					assert.Equal(t, tt.expected.Namespace, result.Namespace, "VeleroBackup Namespace should match")
+					assert.Equal(t, tt.expected.Labels, result.Labels, "VeleroBackup Labels should match")
+				} else {
+					assert.Nil(t, result, "Expected result should be nil")
+				}
+			}
+		})
+	}
+}
+
+func TestGetVeleroBackupSourceNamespace(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		expected    string
+	}{
+		{
+			name:     "Velero Backup of a NonAdminBackup",
+			labels:   GetNonAdminLabels(),
+			expected: "target-ns",
+		},
+		{
+			name:        "Velero Backup transferred with its NonAdminBackupStorageLocation",
+			labels:      GetNonAdminLabels(),
+			annotations: map[string]string{constant.NabSourceNamespaceAnnotation: "source-ns"},
+			expected:    "source-ns",
+		},
+		{
+			name:        "Velero Backup of a NonAdminBackup with a shared source namespace annotation",
+			labels:      GetNonAdminLabels(),
+			annotations: map[string]string{constant.SharedSourceNamespaceAnnotation: "source-ns"},
+			expected:    "target-ns",
+		},
+		{
+			name:        "Shared Velero Backup",
+			labels:      map[string]string{constant.SharedWithNamespaceLabel: "target-ns"},
+			annotations: map[string]string{constant.SharedSourceNamespaceAnnotation: "source-ns"},
+			expected:    "source-ns",
+		},
+		{
+			name:        "Shared Velero Backup with a transferred source namespace annotation",
+			labels:      map[string]string{constant.SharedWithNamespaceLabel: "target-ns"},
+			annotations: map[string]string{constant.NabSourceNamespaceAnnotation: "source-ns"},
+			expected:    "target-ns",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			veleroBackup := &velerov1.Backup{
+				ObjectMeta: metav1.ObjectMeta{Labels: tt.labels, Annotations: tt.annotations},
+			}
+			assert.Equal(t, tt.expected, GetVeleroBackupSourceNamespace(veleroBackup, "target-ns"))
+		})
+	}
+}
+
+func TestGetSyncedVeleroBackup(t *testing.T) {
+	const (
+		testNACUUID = "tenant-nab-nacuuid"
+		testBSLName = "tenant-bsl"
+	)
+	scheme := runtime.NewScheme()
+	if err := velerov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register Velero types: %v", err)
+	}
+	nacLabels := func(originLabel string, nacUUID string) map[string]string {
+		return map[string]string{
+			constant.OadpLabel:      constant.OadpLabelValue,
+			constant.ManagedByLabel: constant.ManagedByLabelValue,
+			originLabel:             nacUUID,
+		}
+	}
+	veleroBackup := func(namespace string, storageLocation string) *velerov1.Backup {
+		return &velerov1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testNACUUID,
+				Namespace: "oadp-namespace",
+				Labels:    nacLabels(constant.NabOriginNACUUIDLabel, testNACUUID),
+				Annotations: map[string]string{
+					constant.NabOriginNamespaceAnnotation: namespace,
+					constant.NabOriginNameAnnotation:      "nab",
+				},
+			},
+			Spec: velerov1.BackupSpec{StorageLocation: storageLocation},
+		}
+	}
+	veleroBackupStorageLocation := func(namespace string) *velerov1.BackupStorageLocation {
+		return &velerov1.BackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testBSLName,
+				Namespace: "oadp-namespace",
+				Labels:    nacLabels(constant.NabslOriginNACUUIDLabel, "tenant-nabsl-nacuuid"),
+				Annotations: map[string]string{
+					constant.NabslOriginNamespaceAnnotation: namespace,
+					constant.NabslOriginNameAnnotation:      "nabsl",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		errMessage string
+		objects    []client.Object
+	}{
+		{
+			name:    "Velero Backup created for the namespace",
+			objects: []client.Object{veleroBackup(defaultNS, constant.EmptyString)},
+		},
+		{
+			name:    "Velero Backup stored in a NonAdminBackupStorageLocation of the namespace",
+			objects: []client.Object{veleroBackup(defaultNS, testBSLName), veleroBackupStorageLocation(defaultNS)},
+		},
+		{
+			name:       "Velero Backup not found",
+			errMessage: "Velero Backup with NACUUID tenant-nab-nacuuid not found",
+		},
+		{
+			name:       "Velero Backup created for another namespace",
+			objects:    []client.Object{veleroBackup("other", constant.EmptyString)},
+			errMessage: "Velero Backup with NACUUID tenant-nab-nacuuid was not created for namespace default",
+		},
+		{
+			name:       "Velero Backup stored in a NonAdminBackupStorageLocation of another namespace",
+			objects:    []client.Object{veleroBackup(defaultNS, testBSLName), veleroBackupStorageLocation("other")},
+			errMessage: "Velero Backup with NACUUID tenant-nab-nacuuid was not stored for namespace default",
 		},
 	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(test.objects...).Build()
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			var objects []client.Object
-			for _, backup := range tt.mockBackups {
-				backupCopy := backup // Create a copy to avoid memory aliasing
-				objects = append(objects, &backupCopy)
-			}
-			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
-
-			result, err := GetVeleroBackupByLabel(ctx, client, tt.namespace, tt.labelValue)
-
-			if tt.expectedError != nil {
-				assert.EqualError(t, err, tt.expectedError.Error())
-			} else {
+			result, err := GetSyncedVeleroBackup(context.Background(), fakeClient, "oadp-namespace", defaultNS, testNACUUID)
+			if len(test.errMessage) == 0 {
 				assert.NoError(t, err)
-				if tt.expected != nil && result != nil {
-					assert.Equal(t, tt.expected.Name, result.Name, "VeleroBackup Name should match")
-					assert.Equal(t, tt.expected.Namespace, result.Namespace, "VeleroBackup Namespace should match")
-					assert.Equal(t, tt.expected.Labels, result.Labels, "VeleroBackup Labels should match")
-				} else {
-					assert.Nil(t, result, "Expected result should be nil")
-				}
+				assert.Equal(t, testNACUUID, result.Name)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+				assert.Nil(t, result)
 			}
 		})
 	}
@@ -1681,6 +5778,73 @@ func TestCheckVeleroRestoreMetadata(t *testing.T) {
 	}
 }
 
+func TestCheckVeleroScheduleMetadata(t *testing.T) {
+	tests := []struct {
+		schedule *velerov1.Schedule
+		name     string
+		expected bool
+	}{
+		{
+			name:     "Velero Schedule without required non admin labels and annotations",
+			schedule: &velerov1.Schedule{},
+			expected: false,
+		},
+		{
+			name: "Velero Schedule with NonAdminBackup labels and annotations",
+			schedule: &velerov1.Schedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						constant.OadpLabel:             constant.OadpLabelValue,
+						constant.ManagedByLabel:        constant.ManagedByLabelValue,
+						constant.NabOriginNACUUIDLabel: testNonAdminBackupUUID,
+					},
+					Annotations: map[string]string{
+						constant.NabOriginNamespaceAnnotation: testNonAdminBackupNamespace,
+						constant.NabOriginNameAnnotation:      testNonAdminBackupName,
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "Velero Schedule without required non admin annotations",
+			schedule: &velerov1.Schedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						constant.OadpLabel:             constant.OadpLabelValue,
+						constant.ManagedByLabel:        constant.ManagedByLabelValue,
+						constant.NasOriginNACUUIDLabel: testNonAdminBackupUUID,
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "Velero Schedule with required non admin labels and annotations",
+			schedule: &velerov1.Schedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						constant.OadpLabel:             constant.OadpLabelValue,
+						constant.ManagedByLabel:        constant.ManagedByLabelValue,
+						constant.NasOriginNACUUIDLabel: testNonAdminBackupUUID,
+					},
+					Annotations: map[string]string{
+						constant.NasOriginNamespaceAnnotation: testNonAdminBackupNamespace,
+						constant.NasOriginNameAnnotation:      "non-admin-schedule",
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := CheckVeleroScheduleMetadata(test.schedule)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
 func TestGetNabslRequestByLabel(t *testing.T) {
 	log := zap.New(zap.UseDevMode(true))
 	ctx := context.Background()
@@ -2069,6 +6233,368 @@ func TestGetBackupQueueInfo(t *testing.T) {
 	}
 }
 
+func TestGetBackupSummaryForStorageLocation(t *testing.T) {
+	log := zap.New(zap.UseDevMode(true))
+	ctx := context.Background()
+	ctx = ctrl.LoggerInto(ctx, log)
+	scheme := runtime.NewScheme()
+
+	if err := velerov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register velerov1 types in TestGetBackupSummaryForStorageLocation: %v", err)
+	}
+	if err := velerov2alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register velerov2alpha1 types in TestGetBackupSummaryForStorageLocation: %v", err)
+	}
+
+	const storageLocation = "nabsl-storage-location"
+	olderStart := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	newerStart := metav1.NewTime(time.Now().Truncate(time.Second))
+
+	tests := []struct {
+		name            string
+		objects         []client.Object
+		expectedSummary *nacv1alpha1.BackupSummary
+	}{
+		{
+			name:            "No backups in storage location",
+			objects:         []client.Object{},
+			expectedSummary: &nacv1alpha1.BackupSummary{},
+		},
+		{
+			name: "Backups in storage location with file system and data mover backups",
+			objects: []client.Object{
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNS,
+						Name:      testNonAdminBackupName,
+					},
+					Spec:   velerov1.BackupSpec{StorageLocation: storageLocation},
+					Status: velerov1.BackupStatus{StartTimestamp: &olderStart},
+				},
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNS,
+						Name:      testNonAdminSecondBackupName,
+						Annotations: map[string]string{
+							constant.NabOriginNameAnnotation: test,
+						},
+					},
+					Spec:   velerov1.BackupSpec{StorageLocation: storageLocation},
+					Status: velerov1.BackupStatus{StartTimestamp: &newerStart},
+				},
+				&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNS,
+						Name:      "other-location-backup",
+					},
+					Spec: velerov1.BackupSpec{StorageLocation: "other"},
+				},
+				&velerov1.PodVolumeBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNS,
+						Name:      "pvb",
+						Labels:    map[string]string{velerov1.BackupNameLabel: testNonAdminBackupName},
+					},
+					Status: velerov1.PodVolumeBackupStatus{Progress: shared.DataMoveOperationProgress{TotalBytes: 100}},
+				},
+				&velerov2alpha1.DataUpload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNS,
+						Name:      "du",
+						Labels:    map[string]string{velerov1.BackupNameLabel: testNonAdminSecondBackupName},
+					},
+					Status: velerov2alpha1.DataUploadStatus{Progress: shared.DataMoveOperationProgress{TotalBytes: 50}},
+				},
+				&velerov2alpha1.DataUpload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNS,
+						Name:      "other-du",
+						Labels:    map[string]string{velerov1.BackupNameLabel: "other-location-backup"},
+					},
+					Status: velerov2alpha1.DataUploadStatus{Progress: shared.DataMoveOperationProgress{TotalBytes: 1000}},
+				},
+			},
+			expectedSummary: &nacv1alpha1.BackupSummary{
+				BackupCount:               expectedIntTwo,
+				MostRecentBackup:          test,
+				MostRecentBackupTimestamp: &newerStart,
+				TotalBytes:                150,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			summary, err := GetBackupSummaryForStorageLocation(ctx, client, defaultNS, storageLocation)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedSummary.BackupCount, summary.BackupCount)
+			assert.Equal(t, tt.expectedSummary.MostRecentBackup, summary.MostRecentBackup)
+			assert.Equal(t, tt.expectedSummary.TotalBytes, summary.TotalBytes)
+			if tt.expectedSummary.MostRecentBackupTimestamp == nil {
+				assert.Nil(t, summary.MostRecentBackupTimestamp)
+			} else {
+				assert.True(t, tt.expectedSummary.MostRecentBackupTimestamp.Equal(summary.MostRecentBackupTimestamp))
+			}
+		})
+	}
+}
+
+func TestCheckNamespaceQuotaForRestore(t *testing.T) {
+	log := zap.New(zap.UseDevMode(true))
+	ctx := context.Background()
+	ctx = ctrl.LoggerInto(ctx, log)
+	scheme := runtime.NewScheme()
+
+	if err := velerov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register velerov1 types in TestCheckNamespaceQuotaForRestore: %v", err)
+	}
+	if err := velerov2alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register velerov2alpha1 types in TestCheckNamespaceQuotaForRestore: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register corev1 types in TestCheckNamespaceQuotaForRestore: %v", err)
+	}
+
+	volumes := []client.Object{
+		&velerov1.PodVolumeBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: defaultNS,
+				Name:      "pvb",
+				Labels: map[string]string{
+					velerov1.BackupNameLabel: testNonAdminBackupName,
+					velerov1.PVCUIDLabel:     testNonAdminBackupUUID,
+				},
+			},
+			Status: velerov1.PodVolumeBackupStatus{Progress: shared.DataMoveOperationProgress{TotalBytes: 2 * 1024 * 1024 * 1024}},
+		},
+		&velerov1.PodVolumeBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: defaultNS,
+				Name:      "pvb-empty-dir",
+				Labels:    map[string]string{velerov1.BackupNameLabel: testNonAdminBackupName},
+			},
+			Status: velerov1.PodVolumeBackupStatus{Progress: shared.DataMoveOperationProgress{TotalBytes: 10 * 1024 * 1024 * 1024}},
+		},
+		&velerov2alpha1.DataUpload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: defaultNS,
+				Name:      "du",
+				Labels:    map[string]string{velerov1.BackupNameLabel: testNonAdminBackupName},
+			},
+			Status: velerov2alpha1.DataUploadStatus{Progress: shared.DataMoveOperationProgress{TotalBytes: 1024 * 1024 * 1024}},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		objects          []client.Object
+		expectedExceeded []string
+	}{
+		{
+			name:    "No quota in namespace",
+			objects: volumes,
+		},
+		{
+			name: "Quota is sufficient",
+			objects: append(slices.Clone(volumes),
+				&corev1.ResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNonAdminRestoreNamespace, Name: "quota"},
+					Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+						corev1.ResourceRequestsStorage:        resource.MustParse("10Gi"),
+						corev1.ResourcePersistentVolumeClaims: resource.MustParse("5"),
+					}},
+				},
+			),
+		},
+		{
+			name: "Storage and claims quota would be exceeded",
+			objects: append(slices.Clone(volumes),
+				&corev1.ResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNonAdminRestoreNamespace, Name: "quota"},
+					Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+						corev1.ResourceRequestsStorage:        resource.MustParse("10Gi"),
+						corev1.ResourcePersistentVolumeClaims: resource.MustParse("2"),
+					}},
+					Status: corev1.ResourceQuotaStatus{Used: corev1.ResourceList{
+						corev1.ResourceRequestsStorage:        resource.MustParse("8Gi"),
+						corev1.ResourcePersistentVolumeClaims: resource.MustParse("1"),
+					}},
+				},
+			),
+			expectedExceeded: []string{"ResourceQuota quota requests.storage", "ResourceQuota quota persistentvolumeclaims"},
+		},
+		{
+			name: "Claims object count quota would be exceeded",
+			objects: append(slices.Clone(volumes),
+				&corev1.ResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNonAdminRestoreNamespace, Name: "count-quota"},
+					Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+						"count/persistentvolumeclaims": resource.MustParse("1"),
+						"count/pods":                   resource.MustParse("1"),
+					}},
+				},
+			),
+			expectedExceeded: []string{"ResourceQuota count-quota count/persistentvolumeclaims: used 0, required 2, hard 1"},
+		},
+		{
+			name: "LimitRange max storage would be exceeded",
+			objects: append(slices.Clone(volumes),
+				&corev1.LimitRange{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNonAdminRestoreNamespace, Name: "limits"},
+					Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{
+						{
+							Type: corev1.LimitTypePersistentVolumeClaim,
+							Max:  corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1536Mi")},
+						},
+					}},
+				},
+			),
+			expectedExceeded: []string{"LimitRange limits: volume of 2Gi exceeds max storage 1536Mi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			exceeded, err := CheckNamespaceQuotaForRestore(ctx, client, defaultNS, testNonAdminRestoreNamespace, testNonAdminBackupName)
+			assert.NoError(t, err)
+			if len(tt.expectedExceeded) == 0 {
+				assert.Empty(t, exceeded)
+			}
+			for _, expected := range tt.expectedExceeded {
+				assert.Contains(t, exceeded, expected)
+			}
+		})
+	}
+}
+
+func TestCreateWithRetry(t *testing.T) {
+	log := zap.New(zap.UseDevMode(true))
+	ctx := context.Background()
+	ctx = ctrl.LoggerInto(ctx, log)
+	scheme := runtime.NewScheme()
+
+	if err := velerov1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to register VeleroBackup type in TestCreateWithRetry: %v", err)
+	}
+
+	defaultBackoff := CreateRetryBackoff
+	CreateRetryBackoff.Duration = time.Millisecond
+	defer func() { CreateRetryBackoff = defaultBackoff }()
+
+	timeoutErr := apierrors.NewTimeoutError("timeout", 1)
+	tests := []struct {
+		existingLabels  map[string]string
+		name            string
+		createErrors    []error
+		expectedRetries int
+		expectedCreates int
+		createdOnError  bool
+		existing        bool
+		expectedError   bool
+	}{
+		{
+			name:            "Created at first attempt",
+			expectedRetries: expectedIntZero,
+			expectedCreates: expectedIntOne,
+		},
+		{
+			name:            "Already created by previous reconcile",
+			existing:        true,
+			existingLabels:  map[string]string{constant.NabOriginNACUUIDLabel: testNonAdminBackupUUID},
+			expectedRetries: expectedIntZero,
+			expectedCreates: expectedIntOne,
+		},
+		{
+			name:            "Object with the same name created for something else",
+			existing:        true,
+			existingLabels:  map[string]string{constant.NabOriginNACUUIDLabel: "other"},
+			expectedRetries: expectedIntZero,
+			expectedCreates: expectedIntOne,
+			expectedError:   true,
+		},
+		{
+			name:            "Created after transient error",
+			createErrors:    []error{timeoutErr},
+			expectedRetries: expectedIntOne,
+			expectedCreates: expectedIntTwo,
+		},
+		{
+			name:            "Transient error but object was created",
+			createErrors:    []error{timeoutErr},
+			createdOnError:  true,
+			expectedRetries: expectedIntOne,
+			expectedCreates: expectedIntOne,
+		},
+		{
+			name:            "Not transient error is not retried",
+			createErrors:    []error{apierrors.NewBadRequest("invalid")},
+			expectedRetries: expectedIntZero,
+			expectedCreates: expectedIntOne,
+			expectedError:   true,
+		},
+		{
+			name:            "Transient errors exhaust retries",
+			createErrors:    []error{timeoutErr, timeoutErr, timeoutErr, timeoutErr},
+			expectedRetries: 3,
+			expectedCreates: 4,
+			expectedError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creates := 0
+			clientBuilder := fake.NewClientBuilder().WithScheme(scheme)
+			if tt.existing {
+				clientBuilder = clientBuilder.WithObjects(&velerov1.Backup{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNS,
+						Name:      testNonAdminBackupName,
+						Labels:    tt.existingLabels,
+					},
+				})
+			}
+			fakeClient := clientBuilder.WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, clientWithWatch client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					creates++
+					if creates <= len(tt.createErrors) {
+						if tt.createdOnError {
+							if err := clientWithWatch.Create(ctx, obj, opts...); err != nil {
+								return err
+							}
+						}
+						return tt.createErrors[creates-1]
+					}
+					return clientWithWatch.Create(ctx, obj, opts...)
+				},
+			}).Build()
+
+			veleroBackup := &velerov1.Backup{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: defaultNS,
+					Name:      testNonAdminBackupName,
+					Labels:    map[string]string{constant.NabOriginNACUUIDLabel: testNonAdminBackupUUID},
+				},
+			}
+			retries, err := CreateWithRetry(ctx, fakeClient, veleroBackup, func() (bool, error) {
+				existing, getErr := GetVeleroBackupByLabel(ctx, fakeClient, defaultNS, testNonAdminBackupUUID)
+				return existing != nil, getErr
+			})
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedRetries, retries)
+			assert.Equal(t, tt.expectedCreates, creates)
+		})
+	}
+}
+
 func TestGetRestoreQueueInfo(t *testing.T) {
 	log := zap.New(zap.UseDevMode(true))
 	ctx := context.Background()
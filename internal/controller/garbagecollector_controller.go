@@ -27,6 +27,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -43,13 +44,45 @@ type GarbageCollectorReconciler struct {
 	client.Client
 	Scheme                *runtime.Scheme
 	OADPNamespace         string
+	Recorder              record.EventRecorder
 	Frequency             time.Duration
 	RequireApprovalForBSL bool
+	// OrphanMinAge is the age an orphan object must reach before it is deleted, zero deletes it right away
+	OrphanMinAge time.Duration
+	// ReportOnly only reports the orphan objects, with a log and a Warning event, instead of deleting them
+	ReportOnly bool
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	configReconciler, err := r.withNonAdminControllerConfig(ctx)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Unable to get NonAdminControllerConfig")
+		return ctrl.Result{}, err
+	}
+	return configReconciler.collectGarbage(ctx)
+}
+
+// withNonAdminControllerConfig returns a copy of the reconciler with the NonAdminControllerConfig, if any,
+// overriding the cluster admin NAC flags
+func (r *GarbageCollectorReconciler) withNonAdminControllerConfig(ctx context.Context) (*GarbageCollectorReconciler, error) {
+	config, err := function.GetNonAdminControllerConfig(ctx, r.Client)
+	if err != nil || config == nil || config.Spec.GarbageCollection == nil {
+		return r, err
+	}
+	configReconciler := *r
+	if config.Spec.GarbageCollection.OrphanMinAge != nil {
+		configReconciler.OrphanMinAge = config.Spec.GarbageCollection.OrphanMinAge.Duration
+	}
+	if config.Spec.GarbageCollection.ReportOnly != nil {
+		configReconciler.ReportOnly = *config.Spec.GarbageCollection.ReportOnly
+	}
+	return &configReconciler, nil
+}
+
+// collectGarbage deletes, or only reports, the orphan objects created by NAC in the OADP namespace
+func (r *GarbageCollectorReconciler) collectGarbage(ctx context.Context) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
 	labelSelector := client.MatchingLabels{
@@ -61,7 +94,6 @@ func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Reque
 
 	var execution errgroup.Group
 
-	// TODO duplication in delete logic
 	execution.Go(func() error {
 		secretList := &corev1.SecretList{}
 		if err := r.List(ctx, secretList, client.InNamespace(r.OADPNamespace), labelSelector); err != nil {
@@ -69,32 +101,138 @@ func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Reque
 			return err
 		}
 		for _, secret := range secretList.Items {
-			if !function.CheckLabelAnnotationValueIsValid(secret.GetLabels(), constant.NabslOriginNACUUIDLabel) {
+			annotations := secret.GetAnnotations()
+			var err error
+			switch {
+			case function.CheckLabelAnnotationValueIsValid(secret.GetLabels(), constant.NabslOriginNACUUIDLabel):
+				if !function.CheckVeleroBackupStorageLocationAnnotations(&secret) {
+					logger.V(1).Info("Secret does not have required annotations", constant.NameString, secret.Name)
+					continue
+				}
+				err = r.Get(ctx, types.NamespacedName{
+					Name:      annotations[constant.NabslOriginNameAnnotation],
+					Namespace: annotations[constant.NabslOriginNamespaceAnnotation],
+				}, &nacv1alpha1.NonAdminBackupStorageLocation{})
+			case function.CheckLabelAnnotationValueIsValid(secret.GetLabels(), constant.NavslOriginNACUUIDLabel):
+				if !function.CheckVeleroVolumeSnapshotLocationAnnotations(&secret) {
+					logger.V(1).Info("Secret does not have required annotations", constant.NameString, secret.Name)
+					continue
+				}
+				err = r.Get(ctx, types.NamespacedName{
+					Name:      annotations[constant.NavslOriginNameAnnotation],
+					Namespace: annotations[constant.NavslOriginNamespaceAnnotation],
+				}, &nacv1alpha1.NonAdminVolumeSnapshotLocation{})
+			default:
 				logger.V(1).Info("Secret does not have required label", constant.NameString, secret.Name)
-				// TODO delete?
 				continue
 			}
-			annotations := secret.GetAnnotations()
-			if !function.CheckVeleroBackupStorageLocationAnnotations(&secret) {
-				logger.V(1).Info("Secret does not have required annotations", constant.NameString, secret.Name)
-				// TODO delete?
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					logger.Error(err, "Unable to fetch Secret owner", constant.NameString, secret.Name)
+					return err
+				}
+				if err = r.collectOrphan(ctx, logger, &secret, "Secret"); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	execution.Go(func() error {
+		veleroVolumeSnapshotLocationList := &velerov1.VolumeSnapshotLocationList{}
+		if err := r.List(ctx, veleroVolumeSnapshotLocationList, client.InNamespace(r.OADPNamespace), labelSelector); err != nil {
+			logger.Error(err, "Unable to fetch VolumeSnapshotLocations in OADP namespace")
+			return err
+		}
+		for _, volumeSnapshotLocation := range veleroVolumeSnapshotLocationList.Items {
+			if !function.CheckLabelAnnotationValueIsValid(volumeSnapshotLocation.GetLabels(), constant.NavslOriginNACUUIDLabel) {
+				logger.V(1).Info("VolumeSnapshotLocation does not have required label", constant.NameString, volumeSnapshotLocation.Name)
+				continue
+			}
+			annotations := volumeSnapshotLocation.GetAnnotations()
+			if !function.CheckVeleroVolumeSnapshotLocationAnnotations(&volumeSnapshotLocation) {
+				logger.V(1).Info("VolumeSnapshotLocation does not have required annotations", constant.NameString, volumeSnapshotLocation.Name)
 				continue
 			}
-			nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{}
 			err := r.Get(ctx, types.NamespacedName{
-				Name:      annotations[constant.NabslOriginNameAnnotation],
-				Namespace: annotations[constant.NabslOriginNamespaceAnnotation],
-			}, nabsl)
+				Name:      annotations[constant.NavslOriginNameAnnotation],
+				Namespace: annotations[constant.NavslOriginNamespaceAnnotation],
+			}, &nacv1alpha1.NonAdminVolumeSnapshotLocation{})
 			if err != nil {
 				if !apierrors.IsNotFound(err) {
-					logger.Error(err, "Unable to fetch NonAdminBackupStorageLocation")
+					logger.Error(err, "Unable to fetch NonAdminVolumeSnapshotLocation")
+					return err
+				}
+				if err = r.collectOrphan(ctx, logger, &volumeSnapshotLocation, "VolumeSnapshotLocation"); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	execution.Go(func() error {
+		nonAdminVolumeSnapshotLocationRequestList := &nacv1alpha1.NonAdminVolumeSnapshotLocationRequestList{}
+		if err := r.List(ctx, nonAdminVolumeSnapshotLocationRequestList, client.InNamespace(r.OADPNamespace), labelSelector); err != nil {
+			logger.Error(err, "Unable to fetch NonAdminVolumeSnapshotLocationRequests in OADP namespace")
+			return err
+		}
+		for _, navslRequest := range nonAdminVolumeSnapshotLocationRequestList.Items {
+			if !function.CheckVeleroVolumeSnapshotLocationAnnotations(&navslRequest) {
+				logger.V(1).Info("NonAdminVolumeSnapshotLocationRequest does not have required annotations", constant.NameString, navslRequest.Name)
+				continue
+			}
+			annotations := navslRequest.GetAnnotations()
+			err := r.Get(ctx, types.NamespacedName{
+				Name:      annotations[constant.NavslOriginNameAnnotation],
+				Namespace: annotations[constant.NavslOriginNamespaceAnnotation],
+			}, &nacv1alpha1.NonAdminVolumeSnapshotLocation{})
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					logger.Error(err, "Unable to fetch NonAdminVolumeSnapshotLocation")
+					return err
+				}
+				if err = r.collectOrphan(ctx, logger, &navslRequest, "NonAdminVolumeSnapshotLocationRequest"); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	execution.Go(func() error {
+		deleteBackupRequestList := &velerov1.DeleteBackupRequestList{}
+		if err := r.List(ctx, deleteBackupRequestList, client.InNamespace(r.OADPNamespace), labelSelector); err != nil {
+			logger.Error(err, "Unable to fetch DeleteBackupRequests in OADP namespace")
+			return err
+		}
+		for _, deleteBackupRequest := range deleteBackupRequestList.Items {
+			// Velero is still deleting the Backup of a request not processed yet
+			if deleteBackupRequest.Status.Phase != velerov1.DeleteBackupRequestPhaseProcessed {
+				continue
+			}
+			if !function.CheckLabelAnnotationValueIsValid(deleteBackupRequest.GetLabels(), constant.NabOriginNACUUIDLabel) {
+				logger.V(1).Info("DeleteBackupRequest does not have required label", constant.NameString, deleteBackupRequest.Name)
+				continue
+			}
+			annotations := deleteBackupRequest.GetAnnotations()
+			if !function.CheckVeleroBackupAnnotations(&deleteBackupRequest) {
+				logger.V(1).Info("DeleteBackupRequest does not have required annotations", constant.NameString, deleteBackupRequest.Name)
+				continue
+			}
+			err := r.Get(ctx, types.NamespacedName{
+				Name:      annotations[constant.NabOriginNameAnnotation],
+				Namespace: annotations[constant.NabOriginNamespaceAnnotation],
+			}, &nacv1alpha1.NonAdminBackup{})
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					logger.Error(err, "Unable to fetch NonAdminBackup")
 					return err
 				}
-				if err = r.Delete(ctx, &secret); err != nil {
-					logger.Error(err, "Failed to delete orphan Secret", constant.NameString, secret.Name)
+				if err = r.collectOrphan(ctx, logger, &deleteBackupRequest, "DeleteBackupRequest"); err != nil {
 					return err
 				}
-				logger.V(1).Info("orphan Secret deleted", constant.NameString, secret.Name)
 			}
 		}
 		return nil
@@ -128,11 +266,9 @@ func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Reque
 					logger.Error(err, "Unable to fetch NonAdminBackupStorageLocation")
 					return err
 				}
-				if err = r.Delete(ctx, &backupStorageLocation); err != nil {
-					logger.Error(err, "Failed to delete orphan BackupStorageLocation", constant.NameString, backupStorageLocation.Name)
+				if err = r.collectOrphan(ctx, logger, &backupStorageLocation, "BackupStorageLocation"); err != nil {
 					return err
 				}
-				logger.V(1).Info("orphan BackupStorageLocation deleted", constant.NameString, backupStorageLocation.Name)
 			}
 		}
 		return nil
@@ -166,11 +302,103 @@ func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Reque
 					logger.Error(err, "Unable to fetch NonAdminBackup")
 					return err
 				}
-				if err = r.Delete(ctx, &backup); err != nil {
-					logger.Error(err, "Failed to delete orphan backup", constant.NameString, backup.Name)
+				// The NonAdminBackup of a Velero Backup created by the Velero Schedule of a NonAdminSchedule
+				// may not be created yet
+				if function.CheckVeleroScheduleMetadata(&backup) {
+					err = r.Get(ctx, types.NamespacedName{
+						Name:      annotations[constant.NasOriginNameAnnotation],
+						Namespace: annotations[constant.NasOriginNamespaceAnnotation],
+					}, &nacv1alpha1.NonAdminSchedule{})
+					if err == nil {
+						continue
+					}
+					if !apierrors.IsNotFound(err) {
+						logger.Error(err, "Unable to fetch NonAdminSchedule")
+						return err
+					}
+				}
+				if err = r.collectOrphan(ctx, logger, &backup, "Backup"); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	execution.Go(func() error {
+		veleroScheduleList := &velerov1.ScheduleList{}
+		if err := r.List(ctx, veleroScheduleList, client.InNamespace(r.OADPNamespace), labelSelector); err != nil {
+			logger.Error(err, "Unable to fetch Schedules in OADP namespace")
+			return err
+		}
+		for _, schedule := range veleroScheduleList.Items {
+			if !function.CheckLabelAnnotationValueIsValid(schedule.GetLabels(), constant.NasOriginNACUUIDLabel) {
+				logger.V(1).Info("Schedule does not have required label", constant.NameString, schedule.Name)
+				continue
+			}
+			annotations := schedule.GetAnnotations()
+			if !function.CheckVeleroScheduleAnnotations(&schedule) {
+				logger.V(1).Info("Schedule does not have required annotations", constant.NameString, schedule.Name)
+				continue
+			}
+			nas := &nacv1alpha1.NonAdminSchedule{}
+			err := r.Get(ctx, types.NamespacedName{
+				Name:      annotations[constant.NasOriginNameAnnotation],
+				Namespace: annotations[constant.NasOriginNamespaceAnnotation],
+			}, nas)
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					logger.Error(err, "Unable to fetch NonAdminSchedule")
+					return err
+				}
+				if err = r.collectOrphan(ctx, logger, &schedule, "Schedule"); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	execution.Go(func() error {
+		configMapList := &corev1.ConfigMapList{}
+		if err := r.List(ctx, configMapList, client.InNamespace(r.OADPNamespace), labelSelector); err != nil {
+			logger.Error(err, "Unable to fetch ConfigMaps in OADP namespace")
+			return err
+		}
+		for _, configMap := range configMapList.Items {
+			annotations := configMap.GetAnnotations()
+			var err error
+			switch {
+			case function.CheckLabelAnnotationValueIsValid(configMap.GetLabels(), constant.NabOriginNACUUIDLabel):
+				if !function.CheckVeleroBackupAnnotations(&configMap) {
+					logger.V(1).Info("ConfigMap does not have required annotations", constant.NameString, configMap.Name)
+					continue
+				}
+				err = r.Get(ctx, types.NamespacedName{
+					Name:      annotations[constant.NabOriginNameAnnotation],
+					Namespace: annotations[constant.NabOriginNamespaceAnnotation],
+				}, &nacv1alpha1.NonAdminBackup{})
+			case function.CheckLabelAnnotationValueIsValid(configMap.GetLabels(), constant.NarOriginNACUUIDLabel):
+				if !function.CheckVeleroRestoreAnnotations(&configMap) {
+					logger.V(1).Info("ConfigMap does not have required annotations", constant.NameString, configMap.Name)
+					continue
+				}
+				err = r.Get(ctx, types.NamespacedName{
+					Name:      annotations[constant.NarOriginNameAnnotation],
+					Namespace: annotations[constant.NarOriginNamespaceAnnotation],
+				}, &nacv1alpha1.NonAdminRestore{})
+			default:
+				logger.V(1).Info("ConfigMap does not have required label", constant.NameString, configMap.Name)
+				continue
+			}
+			if err != nil {
+				if !apierrors.IsNotFound(err) {
+					logger.Error(err, "Unable to fetch ConfigMap owner", constant.NameString, configMap.Name)
+					return err
+				}
+				if err = r.collectOrphan(ctx, logger, &configMap, "ConfigMap"); err != nil {
 					return err
 				}
-				logger.V(1).Info("orphan Backup deleted", constant.NameString, backup.Name)
 			}
 		}
 		return nil
@@ -204,11 +432,9 @@ func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Reque
 					logger.Error(err, "Unable to fetch NonAdminRestore")
 					return err
 				}
-				if err = r.Delete(ctx, &restore); err != nil {
-					logger.Error(err, "Failed to delete orphan Restore", constant.NameString, restore.Name)
+				if err = r.collectOrphan(ctx, logger, &restore, "Restore"); err != nil {
 					return err
 				}
-				logger.V(1).Info("orphan Restore deleted", constant.NameString, restore.Name)
 			}
 		}
 		return nil
@@ -255,11 +481,9 @@ func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Reque
 			}
 
 			if shouldDelete {
-				if err := r.Delete(ctx, &nabslRequest); err != nil {
-					logger.Error(err, "Failed to delete orphan NonAdminBackupStorageLocationRequest", constant.NameString, nabslRequest.Name)
+				if err := r.collectOrphan(ctx, logger, &nabslRequest, "NonAdminBackupStorageLocationRequest"); err != nil {
 					return err
 				}
-				logger.V(1).Info("orphan NonAdminBackupStorageLocationRequest deleted", constant.NameString, nabslRequest.Name)
 			}
 		}
 		return nil
@@ -271,6 +495,29 @@ func (r *GarbageCollectorReconciler) Reconcile(ctx context.Context, _ ctrl.Reque
 	return ctrl.Result{}, err
 }
 
+// collectOrphan deletes an object of the OADP namespace whose NAC object no longer exists. Objects younger than
+// OrphanMinAge are kept, and orphans are only reported, with a log and a Warning event, when ReportOnly is set.
+func (r *GarbageCollectorReconciler) collectOrphan(ctx context.Context, logger logr.Logger, object client.Object, kind string) error {
+	if r.OrphanMinAge > 0 && time.Since(object.GetCreationTimestamp().Time) < r.OrphanMinAge {
+		logger.V(1).Info("orphan "+kind+" kept until it reaches the minimum age", constant.NameString, object.GetName())
+		return nil
+	}
+	if r.ReportOnly {
+		logger.Info("orphan "+kind+" found", constant.NameString, object.GetName())
+		if r.Recorder != nil {
+			r.Recorder.Event(object, corev1.EventTypeWarning, "OrphanFound",
+				kind+" was created by NAC for an object that no longer exists, delete it if it is not needed")
+		}
+		return nil
+	}
+	if err := r.Delete(ctx, object); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete orphan "+kind, constant.NameString, object.GetName())
+		return err
+	}
+	logger.V(1).Info("orphan "+kind+" deleted", constant.NameString, object.GetName())
+	return nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *GarbageCollectorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
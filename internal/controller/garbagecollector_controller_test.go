@@ -26,7 +26,9 @@ import (
 	"github.com/onsi/gomega"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -329,3 +331,45 @@ var _ = ginkgo.Describe("Test full reconcile loop of GarbageCollector Controller
 		}),
 	)
 })
+
+var _ = ginkgo.Describe("Test collectOrphan function of GarbageCollector Controller", func() {
+	var (
+		ctx               = context.Background()
+		nonAdminNamespace string
+		oadpNamespace     string
+		counter           int
+	)
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminNamespace = fmt.Sprintf("test-garbage-collector-collect-orphan-%v", counter)
+		oadpNamespace = nonAdminNamespace + "-oadp"
+		gomega.Expect(createTestNamespaces(ctx, nonAdminNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.DescribeTable("Should follow the orphan retention policy",
+		func(reconciler *GarbageCollectorReconciler, deleted bool) {
+			backup := buildTestBackup(oadpNamespace, "test-garbage-collector-backup", nonAdminNamespace)
+			gomega.Expect(k8sClient.Create(ctx, backup)).To(gomega.Succeed())
+
+			reconciler.Client = k8sClient
+			reconciler.Scheme = testEnv.Scheme
+			reconciler.OADPNamespace = oadpNamespace
+			gomega.Expect(reconciler.collectOrphan(ctx, ctrl.Log, backup, "Backup")).To(gomega.Succeed())
+
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(backup), &velerov1.Backup{})
+			if deleted {
+				gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+			} else {
+				gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+			}
+		},
+		ginkgo.Entry("Should delete orphan", &GarbageCollectorReconciler{}, true),
+		ginkgo.Entry("Should keep orphan younger than the minimum age", &GarbageCollectorReconciler{OrphanMinAge: time.Hour}, false),
+		ginkgo.Entry("Should only report orphan", &GarbageCollectorReconciler{ReportOnly: true, Recorder: record.NewFakeRecorder(1)}, false),
+	)
+})
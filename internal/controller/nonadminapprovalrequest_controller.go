@@ -0,0 +1,91 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/approval"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+// NonAdminApprovalRequestReconciler reconciles a NonAdminApprovalRequest object
+type NonAdminApprovalRequestReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	OADPNamespace string
+}
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminapprovalrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminapprovalrequests/status,verbs=get;update;patch
+
+// Reconcile sets the phase of a NonAdminApprovalRequest from the decision of the cluster admin, and expires
+// the pending NonAdminApprovalRequest once its expiration time is reached. The controllers of the non admin
+// objects requesting approval watch the phase changes.
+func (r *NonAdminApprovalRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminApprovalRequest Reconcile start")
+
+	approvalRequest := &nacv1alpha1.NonAdminApprovalRequest{}
+	if err := r.Get(ctx, req.NamespacedName, approvalRequest); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminApprovalRequest")
+		return ctrl.Result{}, err
+	}
+	if approvalRequest.Status.Operation == constant.EmptyString {
+		// the status is set by the engine right after the creation
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	if phase := approval.Phase(approvalRequest, now); phase != approvalRequest.Status.Phase {
+		approvalRequest.Status.Phase = phase
+		if err := r.Status().Update(ctx, approvalRequest); err != nil {
+			logger.Error(err, statusUpdateError)
+			return ctrl.Result{}, err
+		}
+		logger.V(1).Info("NonAdminApprovalRequest phase updated", "phase", phase)
+	}
+
+	if expiresAfter := approval.ExpiresAfter(approvalRequest, now); expiresAfter > 0 {
+		return ctrl.Result{RequeueAfter: expiresAfter}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminApprovalRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminApprovalRequest{}, ctrlbuilder.WithPredicates(ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+			return object.GetNamespace() == r.OADPNamespace
+		}))).
+		Named("nonadminapprovalrequest").
+		Complete(r)
+}
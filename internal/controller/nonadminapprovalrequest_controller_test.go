@@ -0,0 +1,99 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/approval"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
+)
+
+var _ = ginkgo.Describe("Test NonAdminApprovalRequest Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectNamespace = fmt.Sprintf("test-naar-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should expire a pending NonAdminApprovalRequest once its expiration time is reached", func() {
+		engine := &approval.Engine{Client: k8sClient, OADPNamespace: oadpNamespace, Expiration: time.Hour}
+		decision, err := engine.Request(ctx, approval.Request{
+			Origin: nacmeta.Origin{
+				Kind:      nacmeta.KindNonAdminBackup,
+				NACUUID:   nonAdminObjectNamespace + "-nab-uuid",
+				Namespace: nonAdminObjectNamespace,
+				Name:      "nab",
+			},
+			Operation: nacv1alpha1.NonAdminApprovalOperationMultiNamespaceBackup,
+			Details:   "includedNamespaces: " + nonAdminObjectNamespace + ",other",
+		})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(decision.Phase).To(gomega.Equal(nacv1alpha1.NonAdminApprovalRequestPhasePending))
+
+		reconciler := &NonAdminApprovalRequestReconciler{
+			Client:        k8sClient,
+			Scheme:        testEnv.Scheme,
+			OADPNamespace: oadpNamespace,
+		}
+		key := types.NamespacedName{Name: decision.Name, Namespace: oadpNamespace}
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+		gomega.Expect(result.RequeueAfter).To(gomega.BeNumerically("<=", time.Hour))
+
+		approvalRequest := &nacv1alpha1.NonAdminApprovalRequest{}
+		gomega.Expect(k8sClient.Get(ctx, key, approvalRequest)).To(gomega.Succeed())
+		approvalRequest.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+		gomega.Expect(k8sClient.Status().Update(ctx, approvalRequest)).To(gomega.Succeed())
+
+		result, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+		gomega.Expect(k8sClient.Get(ctx, key, approvalRequest)).To(gomega.Succeed())
+		gomega.Expect(approvalRequest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminApprovalRequestPhaseExpired))
+
+		approvalRequest.Spec.ApprovalDecision = nacv1alpha1.NonAdminApprovalDecisionApprove
+		gomega.Expect(k8sClient.Update(ctx, approvalRequest)).To(gomega.Succeed())
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(k8sClient.Get(ctx, key, approvalRequest)).To(gomega.Succeed())
+		gomega.Expect(approvalRequest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminApprovalRequestPhaseExpired))
+	})
+})
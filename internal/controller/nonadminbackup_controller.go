@@ -18,22 +18,29 @@ limitations under the License.
 package controller
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
-	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -41,22 +48,111 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/approval"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
 	"github.com/migtools/oadp-non-admin/internal/common/function"
 	"github.com/migtools/oadp-non-admin/internal/handler"
+	"github.com/migtools/oadp-non-admin/internal/notification"
 	"github.com/migtools/oadp-non-admin/internal/predicate"
+	"github.com/migtools/oadp-non-admin/internal/startup"
+	"github.com/migtools/oadp-non-admin/internal/validationhook"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
 )
 
 // NonAdminBackupReconciler reconciles a NonAdminBackup object
 type NonAdminBackupReconciler struct {
 	client.Client
 	Scheme             *runtime.Scheme
+	Recorder           record.EventRecorder
 	EnforcedBackupSpec *velerov1.BackupSpec
-	OADPNamespace      string
+	// DefaultSnapshotMoveData is the snapshotMoveData of the VeleroBackup of NonAdminBackups not
+	// setting it, nil leaves it to Velero
+	DefaultSnapshotMoveData *bool
+	// ValidationHook adds the cluster admin site-specific rules to the spec validation, nil disables it
+	ValidationHook *validationhook.Hook
+	// StartupBackpressure staggers the initial reconciles when the controller starts, nil disables it
+	StartupBackpressure *startup.Backpressure
+	OADPNamespace       string
+	// DeletionTimeout is the time a NonAdminBackup may stay in the standard
+	// delete path before it is reported as stalled. Zero disables the check.
+	DeletionTimeout time.Duration
+	// InProgressRequeueAfter requeues the NonAdminBackup while its VeleroBackup is running, so its
+	// status is refreshed even if a VeleroBackup event is missed. Zero disables it.
+	InProgressRequeueAfter time.Duration
+	// MaxActiveDeadline is the maximum spec.activeDeadlineSeconds of a NonAdminBackup, and the
+	// deadline of NonAdminBackups not setting it. Zero allows any deadline and sets none by default.
+	MaxActiveDeadline time.Duration
+	// MaxParallelFilesUpload is the maximum spec.backupSpec.uploaderConfig.parallelFilesUpload of a NonAdminBackup,
+	// and the value of NonAdminBackups not setting it. Zero allows any value and sets none by default.
+	MaxParallelFilesUpload int
+	// ForceFinalizerRemovalOnDeletionTimeout removes the NonAdminBackup finalizer
+	// once DeletionTimeout is exceeded, leaving Velero objects to the admin.
+	ForceFinalizerRemovalOnDeletionTimeout bool
+	// AdditionalExcludedNamespacedResources and AdditionalExcludedClusterResources
+	// are appended by the cluster admin to the always excluded resources
+	AdditionalExcludedNamespacedResources []string
+	AdditionalExcludedClusterResources    []string
+	// PropagatedLabels and PropagatedAnnotations are the keys of NonAdminBackup
+	// labels and annotations copied to the VeleroBackup
+	PropagatedLabels      []string
+	PropagatedAnnotations []string
+	// AllowedExecHookCommands restricts the executables the NonAdminBackup exec hooks may run, empty allows any of them
+	AllowedExecHookCommands []string
+	// RequireDeleteBackupConfirmation makes spec.deleteBackup take effect only when
+	// spec.deleteBackupConfirmation matches the NonAdminBackup name
+	RequireDeleteBackupConfirmation bool
+	// RequireDeleteBackupRequest makes spec.deleteBackup take effect only when a NonAdminDeleteBackupRequest
+	// accepted the NonAdminBackup for deletion
+	RequireDeleteBackupRequest bool
+	// AllowMultiNamespaceBackups lets spec.backupSpec.includedNamespaces contain namespaces other
+	// than the NonAdminBackup one, if the requester may create NonAdminBackups in each of them
+	AllowMultiNamespaceBackups bool
+	// RequesterWebhookServed is set when NAC serves the NonAdminBackup webhooks recording the requester. Multi namespace
+	// backups allowed by a NonAdminPolicy or the NonAdminControllerConfig without it are rejected.
+	RequesterWebhookServed bool
+	// Notifier posts the notifications of the NonAdminBackups completing, failing or expiring, nil disables them
+	Notifier *notification.Notifier
+	// MultiNamespaceBackupApproval requests the approval of the cluster admin, with a NonAdminApprovalRequest,
+	// before creating the VeleroBackup of a multi namespace NonAdminBackup. Nil does not require approval
+	MultiNamespaceBackupApproval *approval.Engine
+	// AllowRestoreVerification lets NonAdminBackups set spec.verifyRestore, restoring them, once they
+	// complete, into a temporary namespace created by NAC
+	AllowRestoreVerification bool
+	// DisableExecHooks rejects NonAdminBackups with exec hooks
+	DisableExecHooks bool
+	// ForceSnapshotMoveData makes DefaultSnapshotMoveData override the snapshotMoveData of every NonAdminBackup
+	ForceSnapshotMoveData bool
+	// VeleroBackupNameTemplate is the name template of the VeleroBackup, see function.RenderNacObjectName.
+	// Empty names the VeleroBackup with its NACUUID
+	VeleroBackupNameTemplate string
+	// maxNonAdminBackups is the quota of the NonAdminPolicy of the reconciled namespace, nil means no quota
+	maxNonAdminBackups *int32
 }
 
 type nonAdminBackupReconcileStepFunction func(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error)
 
+// maxVeleroObjectCreateRetries is the number of retries of transient errors, across
+// reconciles, after which creating a Velero object is given up
+const maxVeleroObjectCreateRetries = 12
+
+// maxPodVolumeBackupFailures is the maximum number of failed PodVolumeBackups listed in the NonAdminBackup status
+const maxPodVolumeBackupFailures = 10
+
+// fullProgressPercentage is the progress percentage of a finished data transfer
+const fullProgressPercentage = 100
+
+// restoreVerificationNamePrefix prefixes the name of the NonAdminRestore and temporary namespace verifying a NonAdminBackup
+const restoreVerificationNamePrefix = "verify"
+
+// Pod Security Admission labels enforcing the restricted Pod Security Standard on the temporary namespaces of the
+// restore verifications, so neither the restored workloads nor the validation Jobs can run privileged Pods or mount host paths
+const (
+	podSecurityEnforceLabel        = "pod-security.kubernetes.io/enforce"
+	podSecurityEnforceVersionLabel = "pod-security.kubernetes.io/enforce-version"
+	podSecurityRestricted          = "restricted"
+	podSecurityLatest              = "latest"
+)
+
 const (
 	veleroReferenceUpdated = "NonAdminBackup - Status Updated with UUID reference"
 	statusUpdateExit       = "NonAdminBackup - Exit after Status Update"
@@ -70,6 +166,15 @@ var (
 		nacv1alpha1.NonAdminBackups,
 		nacv1alpha1.NonAdminRestores,
 		nacv1alpha1.NonAdminBackupStorageLocations,
+		nacv1alpha1.NonAdminSchedules,
+		nacv1alpha1.NonAdminVolumeSnapshotLocations,
+		nacv1alpha1.NonAdminDataProtectionTests,
+		nacv1alpha1.NonAdminQuotaStatuses,
+		nacv1alpha1.NonAdminBackupVerifications,
+		nacv1alpha1.NonAdminNotifications,
+		nacv1alpha1.NonAdminGroupBackups,
+		nacv1alpha1.NonAdminDeleteBackupRequests,
+		nacv1alpha1.NonAdminRetentionPolicies,
 	}
 	alwaysExcludedClusterResources = []string{
 		"securitycontextconstraints",
@@ -85,11 +190,23 @@ var (
 // +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackups/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackups/finalizers,verbs=update
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmincontrollerconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminapprovalrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminapprovalrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmindeletebackuprequests,verbs=get;list;watch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations;validatingwebhookconfigurations,verbs=get;list;watch
 
 // +kubebuilder:rbac:groups=velero.io,resources=backups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=podvolumebackups,verbs=get;list;watch
-// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=datauploads,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;create;delete
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state,
@@ -110,18 +227,128 @@ func (r *NonAdminBackupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, err
 	}
 
+	policyReconciler, err := r.withNonAdminPolicy(ctx, nab.Namespace)
+	if err != nil {
+		logger.Error(err, "Unable to get NonAdminPolicy of NonAdminBackup namespace")
+		return ctrl.Result{}, err
+	}
+	return policyReconciler.reconcile(ctx, logger, nab)
+}
+
+// withNonAdminPolicy returns a copy of the reconciler enforcing the NonAdminControllerConfig, if any,
+// and the NonAdminPolicy of namespace, if any, over the cluster admin NAC flags. The enforced backup
+// spec fields they set override the enforced ones, the others stay enforced.
+func (r *NonAdminBackupReconciler) withNonAdminPolicy(ctx context.Context, namespace string) (*NonAdminBackupReconciler, error) {
+	configReconciler, err := r.withNonAdminControllerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := function.GetNamespaceNonAdminPolicy(ctx, r.Client, namespace)
+	if err != nil || policy == nil {
+		return configReconciler, err
+	}
+	policyReconciler := *configReconciler
+	if policy.Spec.EnforceBackupSpec != nil {
+		policyReconciler.EnforcedBackupSpec = function.MergeEnforcedBackupSpec(configReconciler.EnforcedBackupSpec, policy.Spec.EnforceBackupSpec)
+	}
+	if policy.Spec.Quotas != nil {
+		policyReconciler.maxNonAdminBackups = policy.Spec.Quotas.MaxNonAdminBackups
+	}
+	if features := policy.Spec.AllowedFeatures; features != nil {
+		if features.MultiNamespaceBackups != nil {
+			policyReconciler.AllowMultiNamespaceBackups = *features.MultiNamespaceBackups
+		}
+		if features.RestoreVerification != nil {
+			policyReconciler.AllowRestoreVerification = *features.RestoreVerification
+		}
+		if features.ExecHooks != nil {
+			policyReconciler.DisableExecHooks = !*features.ExecHooks
+		}
+	}
+	return &policyReconciler, nil
+}
+
+// withNonAdminControllerConfig returns a copy of the reconciler with the NonAdminControllerConfig, if any,
+// overriding the cluster admin NAC flags
+func (r *NonAdminBackupReconciler) withNonAdminControllerConfig(ctx context.Context) (*NonAdminBackupReconciler, error) {
+	config, err := function.GetNonAdminControllerConfig(ctx, r.Client)
+	if err != nil || config == nil {
+		return r, err
+	}
+	configReconciler := *r
+	if backupConfig := config.Spec.Backup; backupConfig != nil {
+		if backupConfig.EnforceBackupSpec != nil {
+			configReconciler.EnforcedBackupSpec = function.MergeEnforcedBackupSpec(r.EnforcedBackupSpec, backupConfig.EnforceBackupSpec)
+		}
+		if backupConfig.DeletionTimeout != nil {
+			configReconciler.DeletionTimeout = backupConfig.DeletionTimeout.Duration
+		}
+		if backupConfig.InProgressRequeueAfter != nil {
+			configReconciler.InProgressRequeueAfter = backupConfig.InProgressRequeueAfter.Duration
+		}
+		if backupConfig.MaxActiveDeadline != nil {
+			configReconciler.MaxActiveDeadline = backupConfig.MaxActiveDeadline.Duration
+		}
+		if backupConfig.MaxParallelFilesUpload != nil {
+			configReconciler.MaxParallelFilesUpload = int(*backupConfig.MaxParallelFilesUpload)
+		}
+		if backupConfig.RequireDeleteBackupConfirmation != nil {
+			configReconciler.RequireDeleteBackupConfirmation = *backupConfig.RequireDeleteBackupConfirmation
+		}
+	}
+	if features := config.Spec.AllowedFeatures; features != nil {
+		if features.MultiNamespaceBackups != nil {
+			configReconciler.AllowMultiNamespaceBackups = *features.MultiNamespaceBackups
+		}
+		if features.RestoreVerification != nil {
+			configReconciler.AllowRestoreVerification = *features.RestoreVerification
+		}
+		if features.ExecHooks != nil {
+			configReconciler.DisableExecHooks = !*features.ExecHooks
+		}
+	}
+	return &configReconciler, nil
+}
+
+// reconcile runs the reconcile steps of the path of the NonAdminBackup
+func (r *NonAdminBackupReconciler) reconcile(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (ctrl.Result, error) {
 	// Determine which path to take
 	var reconcileSteps []nonAdminBackupReconcileStepFunction
 
+	deleteBackupRequested := true
+	if nab.Spec.DeleteBackup {
+		var err error
+		if deleteBackupRequested, err = r.isDeleteBackupRequested(ctx, nab); err != nil {
+			logger.Error(err, "Unable to list NonAdminDeleteBackupRequests")
+			return ctrl.Result{}, err
+		}
+	}
+
 	// First switch statement takes precedence over the next one
 	switch {
-	case nab.Spec.DeleteBackup:
+	case nab.Spec.DeleteBackup && nab.DeletionTimestamp.IsZero() && !deleteBackupRequested:
+		// Delete path waiting for a NonAdminDeleteBackupRequest of the backup data removal
+		logger.V(1).Info("Executing delete request path")
+		reconcileSteps = []nonAdminBackupReconcileStepFunction{
+			r.setConditionForDeleteBackupRequest,
+		}
+
+	case nab.Spec.DeleteBackup && nab.DeletionTimestamp.IsZero() && !r.isDeleteBackupConfirmed(nab):
+		// Delete path waiting for the user to confirm the backup data removal
+		logger.V(1).Info("Executing delete confirmation path")
+		reconcileSteps = []nonAdminBackupReconcileStepFunction{
+			r.setConditionForDeleteBackupConfirmation,
+		}
+
+	case nab.Spec.DeleteBackup && deleteBackupRequested:
 		// Standard delete path - creates DeleteBackupRequest and waits for VeleroBackup deletion
 		logger.V(1).Info("Executing standard delete path")
 		reconcileSteps = []nonAdminBackupReconcileStepFunction{
 			r.setStatusAndConditionForDeletionAndCallDelete,
 			r.deleteNonAdminRestores,
+			r.deleteRestoreVerification,
 			r.createVeleroDeleteBackupRequest,
+			r.checkDeletionTimeout,
 		}
 
 	case !nab.DeletionTimestamp.IsZero():
@@ -134,8 +361,22 @@ func (r *NonAdminBackupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		reconcileSteps = []nonAdminBackupReconcileStepFunction{
 			r.setStatusForDirectKubernetesAPIDeletion,
 			r.deleteDeleteBackupRequestObjects,
+			r.deleteResourcePolicyConfigMap,
+			r.deleteMultiNamespaceBackupApprovalRequest,
+			r.deleteRestoreVerification,
 			r.deleteVeleroBackupObjects,
 		}
+		if nab.Spec.RetainBackupOnDelete {
+			logger.V(1).Info("Retaining VeleroBackup of deleted NonAdminBackup")
+			reconcileSteps = []nonAdminBackupReconcileStepFunction{
+				r.setStatusForDirectKubernetesAPIDeletion,
+				r.deleteDeleteBackupRequestObjects,
+				r.deleteResourcePolicyConfigMap,
+				r.deleteMultiNamespaceBackupApprovalRequest,
+				r.deleteRestoreVerification,
+				r.releaseVeleroBackupObjects,
+			}
+		}
 
 	case function.CheckLabelAnnotationValueIsValid(nab.Labels, constant.NabSyncLabel):
 		logger.V(1).Info("Executing nab sync path")
@@ -153,7 +394,11 @@ func (r *NonAdminBackupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			r.validateSpec,
 			r.setBackupUUIDInStatus,
 			r.setFinalizerOnNonAdminBackup,
+			r.requestMultiNamespaceBackupApproval,
+			r.syncResourcePolicy,
 			r.createVeleroBackupAndSyncWithNonAdminBackup,
+			r.enforceActiveDeadline,
+			r.verifyRestore,
 		}
 	}
 
@@ -168,6 +413,17 @@ func (r *NonAdminBackupReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	logger.V(1).Info("NonAdminBackup Reconcile exit")
+	if requeueAfter := r.deletionTimeoutRequeueAfter(nab); requeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	requeueAfter := r.inProgressRequeueAfter(nab)
+	if deadlineRequeueAfter := r.activeDeadlineRequeueAfter(nab); deadlineRequeueAfter > 0 &&
+		(requeueAfter == 0 || deadlineRequeueAfter < requeueAfter) {
+		requeueAfter = deadlineRequeueAfter
+	}
+	if requeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -193,7 +449,11 @@ func (r *NonAdminBackupReconciler) setStatusAndConditionForDeletionAndCallDelete
 			Message: "backup accepted for deletion",
 		},
 	)
-	if updatedPhase || updatedCondition {
+	updatedStage := false
+	if nab.Status.DeletionStage == constant.EmptyString {
+		updatedStage = updateNonAdminBackupDeletionStage(&nab.Status, nacv1alpha1.NonAdminBackupDeletionStageDeleteBackupRequestPending)
+	}
+	if updatedPhase || updatedCondition || updatedStage {
 		if err := r.Status().Update(ctx, nab); err != nil {
 			logger.Error(err, statusUpdateError)
 			return false, err
@@ -213,6 +473,93 @@ func (r *NonAdminBackupReconciler) setStatusAndConditionForDeletionAndCallDelete
 	return requeueRequired, nil
 }
 
+// isDeleteBackupConfirmed returns true if spec.deleteBackup of the NonAdminBackup
+// does not require confirmation, or it was confirmed by the user.
+func (r *NonAdminBackupReconciler) isDeleteBackupConfirmed(nab *nacv1alpha1.NonAdminBackup) bool {
+	return !r.RequireDeleteBackupConfirmation || nab.Spec.DeleteBackupConfirmation == nab.Name
+}
+
+// isDeleteBackupRequested returns true if spec.deleteBackup of the NonAdminBackup does not require
+// a NonAdminDeleteBackupRequest, its deletion already started, or a NonAdminDeleteBackupRequest
+// of the namespace accepted it for deletion.
+func (r *NonAdminBackupReconciler) isDeleteBackupRequested(ctx context.Context, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if !r.RequireDeleteBackupRequest || nab.Status.DeletionStage != constant.EmptyString {
+		return true, nil
+	}
+	deleteBackupRequests := &nacv1alpha1.NonAdminDeleteBackupRequestList{}
+	if err := r.List(ctx, deleteBackupRequests, client.InNamespace(nab.Namespace)); err != nil {
+		return false, err
+	}
+	for _, deleteBackupRequest := range deleteBackupRequests.Items {
+		if deleteBackupRequest.Status.Backup != nil && deleteBackupRequest.Status.Backup.UID == nab.UID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setConditionForDeleteBackupRequest sets the Deleting condition to False, informing the user that
+// the backup data is only removed through a NonAdminDeleteBackupRequest. Until then, the NonAdminBackup
+// is kept, and deleting it through the Kubernetes API takes the direct deletion path.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup waiting for a NonAdminDeleteBackupRequest
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminBackupReconciler) setConditionForDeleteBackupRequest(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	updated := meta.SetStatusCondition(&nab.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionDeleting),
+			Status:  metav1.ConditionFalse,
+			Reason:  "DeleteBackupRequestRequired",
+			Message: "permanent backup deletion requires a NonAdminDeleteBackupRequest for the NonAdminBackup",
+		},
+	)
+	if updated {
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackup condition set to DeleteBackupRequestRequired")
+	}
+	return false, nil
+}
+
+// setConditionForDeleteBackupConfirmation sets the Deleting condition to False, informing the user
+// that spec.deleteBackupConfirmation must be set to the NonAdminBackup name before the backup
+// data is removed. Until then, reverting spec.deleteBackup cancels the deletion.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup waiting for deletion confirmation
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminBackupReconciler) setConditionForDeleteBackupConfirmation(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	updated := meta.SetStatusCondition(&nab.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionDeleting),
+			Status:  metav1.ConditionFalse,
+			Reason:  "DeletionConfirmationRequired",
+			Message: "permanent backup deletion requires setting spec.deleteBackupConfirmation to the NonAdminBackup name",
+		},
+	)
+	if updated {
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackup condition set to DeletionConfirmationRequired")
+	}
+	return false, nil
+}
+
 // setStatusForDirectKubernetesAPIDeletion updates the status and conditions when a NonAdminBackup
 // is deleted directly through the Kubernetes API. Only updates status and conditions
 // if the NAB finalizer exists.
@@ -259,7 +606,7 @@ func (r *NonAdminBackupReconciler) deleteNonAdminRestores(ctx context.Context, l
 	}
 
 	for _, nonAdminRestore := range nonAdminRestores.Items {
-		if nonAdminRestore.Spec.RestoreSpec.BackupName == nab.Name {
+		if nonAdminRestore.Spec.RestoreSpec.BackupName == nab.Name && !function.IsSharedNonAdminBackupRestore(&nonAdminRestore) {
 			if err := r.Delete(ctx, &nonAdminRestore); err != nil {
 				logger.Error(err, "Failed to delete NonAdminRestore in NonAdminBackup namespace")
 				return false, err
@@ -308,10 +655,16 @@ func (r *NonAdminBackupReconciler) createVeleroDeleteBackupRequest(ctx context.C
 	}
 
 	if veleroBackup == nil {
+		if updateNonAdminBackupDeletionStage(&nab.Status, nacv1alpha1.NonAdminBackupDeletionStageFinalizerRemovalPending) {
+			if err := r.Status().Update(ctx, nab); err != nil {
+				logger.Error(err, statusUpdateError)
+				return false, err
+			}
+		}
 		return r.removeNabFinalizerUponVeleroBackupDeletion(ctx, logger, nab)
 	}
 
-	deleteBackupRequest, err := function.GetVeleroDeleteBackupRequestByLabel(ctx, r.Client, r.OADPNamespace, veleroBackupNACUUID)
+	deleteBackupRequest, err := function.GetVeleroDeleteBackupRequestByLabel(ctx, r.Client, r.OADPNamespace, label.GetValidName(veleroBackup.Name))
 	if err != nil {
 		// Log error if multiple DeleteBackupRequest objects are found
 		logger.Error(err, findSingleVDBRError, constant.UUIDString, veleroBackupNACUUID)
@@ -319,8 +672,9 @@ func (r *NonAdminBackupReconciler) createVeleroDeleteBackupRequest(ctx context.C
 	}
 
 	if deleteBackupRequest == nil {
-		// Build the delete request for VeleroBackup created by NAC
-		deleteBackupRequest = builder.ForDeleteBackupRequest(r.OADPNamespace, "").
+		// Build the delete request for VeleroBackup created by NAC. It is named after the VeleroBackup,
+		// so a request created before a controller restart is found instead of created again
+		deleteBackupRequest = builder.ForDeleteBackupRequest(r.OADPNamespace, veleroBackup.Name).
 			BackupName(veleroBackup.Name).
 			ObjectMeta(
 				builder.WithLabels(
@@ -330,11 +684,12 @@ func (r *NonAdminBackupReconciler) createVeleroDeleteBackupRequest(ctx context.C
 				),
 				builder.WithLabelsMap(function.GetNonAdminLabels()),
 				builder.WithAnnotationsMap(function.GetNonAdminBackupAnnotations(nab.ObjectMeta)),
-				builder.WithGenerateName(veleroBackup.Name+"-"),
 			).Result()
 
-		// Use CreateRetryGenerateName for retry logic in creating the delete request
-		if err := veleroclient.CreateRetryGenerateName(r.Client, ctx, deleteBackupRequest); err != nil {
+		if _, err := function.CreateWithRetry(ctx, r.Client, deleteBackupRequest, func() (bool, error) {
+			existing, getErr := function.GetVeleroDeleteBackupRequestByLabel(ctx, r.Client, r.OADPNamespace, label.GetValidName(veleroBackup.Name))
+			return existing != nil, getErr
+		}); err != nil {
 			logger.Error(err, "Failed to create delete request for VeleroBackup", "VeleroBackup name", veleroBackup.Name, "NonAdminBackup name", nab.Name)
 			return false, err
 		}
@@ -349,7 +704,8 @@ func (r *NonAdminBackupReconciler) createVeleroDeleteBackupRequest(ctx context.C
 	// with the DeleteBackupRequest. Any required updates to the NonAdminBackup
 	// Status will be applied based on the current state of the DeleteBackupRequest.
 	updated := updateNonAdminBackupDeleteBackupRequestStatus(&nab.Status, deleteBackupRequest)
-	if updated {
+	updatedStage := updateNonAdminBackupDeletionStage(&nab.Status, deletionStageForDeleteBackupRequest(deleteBackupRequest))
+	if updated || updatedStage {
 		if err := r.Status().Update(ctx, nab); err != nil {
 			logger.Error(err, "Failed to update NonAdminBackup Status after DeleteBackupRequest reconciliation")
 			return false, err
@@ -362,6 +718,428 @@ func (r *NonAdminBackupReconciler) createVeleroDeleteBackupRequest(ctx context.C
 	return false, nil // Continue so initNabDeletion can initialize deletion of a NonAdminBackup object
 }
 
+// checkDeletionTimeout reports a NonAdminBackup whose standard deletion did not
+// complete within DeletionTimeout, for example because the DeleteBackupRequest
+// can not be processed while the BackupStorageLocation is unavailable.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup being deleted
+//
+// The function sets the DeletionStalled condition and emits a warning event.
+// If ForceFinalizerRemovalOnDeletionTimeout is set, the NonAdminBackup finalizer
+// is removed so the object can go away, any remaining Velero objects are left
+// for the cluster admin (or garbage collector) to clean up.
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminBackupReconciler) checkDeletionTimeout(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if r.DeletionTimeout <= 0 ||
+		nab.DeletionTimestamp.IsZero() ||
+		!controllerutil.ContainsFinalizer(nab, constant.NabFinalizerName) ||
+		time.Since(nab.DeletionTimestamp.Time) < r.DeletionTimeout {
+		return false, nil
+	}
+
+	message := fmt.Sprintf("backup deletion did not complete within %s", r.DeletionTimeout)
+	updated := meta.SetStatusCondition(&nab.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionDeletionStalled),
+			Status:  metav1.ConditionTrue,
+			Reason:  "DeletionTimeoutExceeded",
+			Message: message,
+		},
+	)
+	if updated {
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackup condition set to DeletionStalled")
+		r.recordEvent(nab, corev1.EventTypeWarning, "DeletionStalled", message)
+	}
+
+	if !r.ForceFinalizerRemovalOnDeletionTimeout {
+		return false, nil
+	}
+
+	logger.Info("Deletion timeout exceeded, forcing NonAdminBackup finalizer removal", constant.NameString, nab.Name)
+	r.recordEvent(nab, corev1.EventTypeWarning, "FinalizerForceRemoved", "finalizer removed after deletion timeout, Velero objects may be left behind")
+	return r.removeNabFinalizerUponVeleroBackupDeletion(ctx, logger, nab)
+}
+
+// deletionTimeoutRequeueAfter returns the time left until the deletion timeout of
+// a NonAdminBackup in the standard delete path expires, or zero if no check is pending.
+func (r *NonAdminBackupReconciler) deletionTimeoutRequeueAfter(nab *nacv1alpha1.NonAdminBackup) time.Duration {
+	if r.DeletionTimeout <= 0 ||
+		!nab.Spec.DeleteBackup ||
+		nab.DeletionTimestamp.IsZero() ||
+		!controllerutil.ContainsFinalizer(nab, constant.NabFinalizerName) ||
+		meta.IsStatusConditionTrue(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionDeletionStalled)) {
+		return 0
+	}
+	remaining := time.Until(nab.DeletionTimestamp.Add(r.DeletionTimeout))
+	if remaining <= 0 {
+		// expired between the check and now, come back right away
+		return time.Second
+	}
+	return remaining
+}
+
+// inProgressRequeueAfter returns when the NonAdminBackup must be reconciled again to refresh
+// the status of its running VeleroBackup, zero if it does not need to
+func (r *NonAdminBackupReconciler) inProgressRequeueAfter(nab *nacv1alpha1.NonAdminBackup) time.Duration {
+	if r.InProgressRequeueAfter <= 0 || !nab.DeletionTimestamp.IsZero() || !isVeleroBackupRunning(nab) {
+		return 0
+	}
+	return r.InProgressRequeueAfter
+}
+
+// isVeleroBackupRunning returns true if the NonAdminBackup status reports its VeleroBackup as started and not finished
+func isVeleroBackupRunning(nab *nacv1alpha1.NonAdminBackup) bool {
+	if nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.Status == nil {
+		return false
+	}
+	switch nab.Status.VeleroBackup.Status.Phase {
+	case velerov1.BackupPhaseInProgress,
+		velerov1.BackupPhaseWaitingForPluginOperations,
+		velerov1.BackupPhaseWaitingForPluginOperationsPartiallyFailed,
+		velerov1.BackupPhaseFinalizing,
+		velerov1.BackupPhaseFinalizingPartiallyFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// activeDeadline returns the active deadline of the NonAdminBackup, zero if it has none
+func (r *NonAdminBackupReconciler) activeDeadline(nab *nacv1alpha1.NonAdminBackup) time.Duration {
+	if nab.Spec.ActiveDeadlineSeconds != nil {
+		return time.Duration(*nab.Spec.ActiveDeadlineSeconds) * time.Second
+	}
+	return r.MaxActiveDeadline
+}
+
+// activeDeadlineRequeueAfter returns when the running VeleroBackup of the NonAdminBackup exceeds
+// its active deadline, zero if it has none or the deadline was already enforced
+func (r *NonAdminBackupReconciler) activeDeadlineRequeueAfter(nab *nacv1alpha1.NonAdminBackup) time.Duration {
+	deadline := r.activeDeadline(nab)
+	if deadline <= 0 ||
+		!nab.DeletionTimestamp.IsZero() ||
+		!isVeleroBackupRunning(nab) ||
+		nab.Status.VeleroBackup.Status.StartTimestamp == nil ||
+		meta.IsStatusConditionTrue(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionDeadlineExceeded)) {
+		return 0
+	}
+	remaining := time.Until(nab.Status.VeleroBackup.Status.StartTimestamp.Add(deadline))
+	if remaining <= 0 {
+		// expired between the check and now, come back right away
+		return time.Second
+	}
+	return remaining
+}
+
+// enforceActiveDeadline cancels the DataUploads of a VeleroBackup still running after the active
+// deadline of the NonAdminBackup, and sets the DeadlineExceeded condition. Velero then finishes the
+// VeleroBackup without the cancelled data.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup whose VeleroBackup may exceed its active deadline
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminBackupReconciler) enforceActiveDeadline(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	deadline := r.activeDeadline(nab)
+	if deadline <= 0 ||
+		!isVeleroBackupRunning(nab) ||
+		nab.Status.VeleroBackup.Status.StartTimestamp == nil ||
+		time.Since(nab.Status.VeleroBackup.Status.StartTimestamp.Time) < deadline {
+		return false, nil
+	}
+
+	dataUploads := &velerov2alpha1.DataUploadList{}
+	if err := r.List(ctx, dataUploads, &client.ListOptions{
+		Namespace:     r.OADPNamespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{velerov1.BackupNameLabel: label.GetValidName(nab.Status.VeleroBackup.Name)}),
+	}); err != nil {
+		logger.Error(err, "Failed to list DataUploads in OADP namespace")
+		return false, err
+	}
+	cancelled := 0
+	for index := range dataUploads.Items {
+		dataUpload := &dataUploads.Items[index]
+		if dataUpload.Spec.Cancel || isDataUploadFinished(dataUpload.Status.Phase) {
+			continue
+		}
+		original := dataUpload.DeepCopy()
+		dataUpload.Spec.Cancel = true
+		if err := r.Patch(ctx, dataUpload, client.MergeFrom(original)); err != nil {
+			logger.Error(err, "Failed to cancel DataUpload", constant.NameString, dataUpload.Name)
+			return false, err
+		}
+		cancelled++
+	}
+	if cancelled > 0 {
+		logger.Info("Cancelled DataUploads of VeleroBackup exceeding its active deadline", "count", cancelled)
+	}
+
+	message := fmt.Sprintf("backup did not complete within its active deadline of %s", deadline)
+	updated := meta.SetStatusCondition(&nab.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionDeadlineExceeded),
+			Status:  metav1.ConditionTrue,
+			Reason:  "ActiveDeadlineExceeded",
+			Message: message + ", its data uploads were cancelled",
+		},
+	)
+	if updated {
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return false, err
+		}
+		r.recordEvent(nab, corev1.EventTypeWarning, "ActiveDeadlineExceeded", message)
+		logger.V(1).Info("NonAdminBackup condition set to DeadlineExceeded")
+	}
+	return false, nil
+}
+
+// verifyRestore restores the backup of a NonAdminBackup setting spec.verifyRestore, once it completed, into
+// a temporary namespace, with a NonAdminRestore of the same name, and sets the RestoreVerified condition from
+// the outcome of that NonAdminRestore. The NonAdminRestore and the temporary namespace are then deleted.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup whose backup is verified
+//
+// Returns:
+//   - bool: whether to requeue, while the NonAdminRestore is running
+//   - error: any error encountered
+func (r *NonAdminBackupReconciler) verifyRestore(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if !nab.Spec.VerifyRestore ||
+		(nab.Status.Phase != nacv1alpha1.NonAdminPhaseCompleted && nab.Status.Phase != nacv1alpha1.NonAdminPhasePartiallyFailed) ||
+		(nab.Status.RestoreVerification != nil && nab.Status.RestoreVerification.CleanedUp) {
+		return false, nil
+	}
+
+	if nab.Status.RestoreVerification == nil {
+		name := function.GenerateNacObjectUUID(restoreVerificationNamePrefix, nab.Namespace)
+		nab.Status.RestoreVerification = &nacv1alpha1.RestoreVerification{Namespace: name, NonAdminRestore: name}
+		meta.SetStatusCondition(&nab.Status.Conditions,
+			metav1.Condition{
+				Type:    string(nacv1alpha1.NonAdminConditionRestoreVerified),
+				Status:  metav1.ConditionUnknown,
+				Reason:  "VerificationRestoreInProgress",
+				Message: fmt.Sprintf("restoring backup into temporary namespace %s", name),
+			},
+		)
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackup restore verification started")
+	}
+	verification := nab.Status.RestoreVerification
+
+	if meta.IsStatusConditionPresentAndEqual(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionRestoreVerified), metav1.ConditionUnknown) {
+		namespace := newRestoreVerificationNamespace(verification.Namespace, nab.Namespace)
+		if err := r.Create(ctx, namespace); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create restore verification namespace", constant.NameString, verification.Namespace)
+			return false, err
+		}
+
+		nar := &nacv1alpha1.NonAdminRestore{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: nab.Namespace, Name: verification.NonAdminRestore}, nar)
+		if apierrors.IsNotFound(err) {
+			nar = newRestoreVerificationNonAdminRestore(verification.NonAdminRestore, nab.Namespace, nab.Name, verification.Namespace)
+			if err = controllerutil.SetOwnerReference(nab, nar, r.Scheme); err != nil {
+				return false, err
+			}
+			if err = r.Create(ctx, nar); err != nil {
+				logger.Error(err, "Failed to create restore verification NonAdminRestore", constant.NameString, verification.NonAdminRestore)
+				return false, err
+			}
+			logger.V(1).Info("Restore verification NonAdminRestore created")
+			return true, nil
+		}
+		if err != nil {
+			logger.Error(err, "Failed to get restore verification NonAdminRestore", constant.NameString, verification.NonAdminRestore)
+			return false, err
+		}
+
+		condition := metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionRestoreVerified),
+			Status:  metav1.ConditionFalse,
+			Reason:  "VerificationRestoreFailed",
+			Message: fmt.Sprintf("restore into temporary namespace %s ended in phase %s", verification.Namespace, nar.Status.Phase),
+		}
+		switch nar.Status.Phase {
+		case nacv1alpha1.NonAdminPhaseCompleted:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "VerificationRestoreCompleted"
+			condition.Message = fmt.Sprintf("backup was restored into temporary namespace %s", verification.Namespace)
+		case nacv1alpha1.NonAdminPhasePartiallyFailed, nacv1alpha1.NonAdminPhaseFailed, nacv1alpha1.NonAdminPhaseCanceled:
+		case nacv1alpha1.NonAdminPhaseBackingOff:
+			if accepted := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted)); accepted != nil {
+				condition.Message += ": " + accepted.Message
+			}
+		default:
+			if updateNonAdminPhase(&verification.Phase, nar.Status.Phase) {
+				if err := r.Status().Update(ctx, nab); err != nil {
+					logger.Error(err, statusUpdateError)
+					return false, err
+				}
+			}
+			logger.V(1).Info("Waiting for restore verification NonAdminRestore to finish", constant.NameString, nar.Name)
+			return true, nil
+		}
+		updateNonAdminPhase(&verification.Phase, nar.Status.Phase)
+		meta.SetStatusCondition(&nab.Status.Conditions, condition)
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return false, err
+		}
+		if condition.Status == metav1.ConditionTrue {
+			r.recordEvent(nab, corev1.EventTypeNormal, condition.Reason, condition.Message)
+		} else {
+			r.recordEvent(nab, corev1.EventTypeWarning, condition.Reason, condition.Message)
+		}
+		logger.V(1).Info("NonAdminBackup condition set to RestoreVerified", "status", condition.Status)
+	}
+
+	if err := r.deleteRestoreVerificationObjects(ctx, logger, nab); err != nil {
+		return false, err
+	}
+	nab.Status.RestoreVerification.CleanedUp = true
+	if err := r.Status().Update(ctx, nab); err != nil {
+		logger.Error(err, statusUpdateError)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackup restore verification cleaned up")
+	return false, nil
+}
+
+// newRestoreVerificationNamespace returns the temporary namespace, called name, a restore verification of
+// nonAdminNamespace restores into, labeled with the NAC labels, the namespace it verifies and the restricted Pod
+// Security Standard.
+// It is shared by the restore verifications of NonAdminBackups and NonAdminBackupVerifications.
+func newRestoreVerificationNamespace(name string, nonAdminNamespace string) *corev1.Namespace {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: function.GetNonAdminLabels(),
+		},
+	}
+	namespace.Labels[constant.RestoreVerificationNamespaceLabel] = nonAdminNamespace
+	namespace.Labels[podSecurityEnforceLabel] = podSecurityRestricted
+	namespace.Labels[podSecurityEnforceVersionLabel] = podSecurityLatest
+	return namespace
+}
+
+// newRestoreVerificationNonAdminRestore returns the NonAdminRestore, called name, of a restore verification, restoring
+// the NonAdminBackup backupName of nonAdminNamespace into the temporary namespace namespaceName.
+// It is shared by the restore verifications of NonAdminBackups and NonAdminBackupVerifications.
+func newRestoreVerificationNonAdminRestore(name string, nonAdminNamespace string, backupName string, namespaceName string) *nacv1alpha1.NonAdminRestore {
+	return &nacv1alpha1.NonAdminRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: nonAdminNamespace,
+		},
+		Spec: nacv1alpha1.NonAdminRestoreSpec{
+			RestoreSpec: &velerov1.RestoreSpec{
+				BackupName:       backupName,
+				NamespaceMapping: map[string]string{nonAdminNamespace: namespaceName},
+			},
+		},
+	}
+}
+
+// deleteRestoreVerification deletes the NonAdminRestore and the temporary namespace of the restore
+// verification of a NonAdminBackup being deleted
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup object
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminBackupReconciler) deleteRestoreVerification(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if nab.Status.RestoreVerification == nil || nab.Status.RestoreVerification.CleanedUp {
+		return false, nil
+	}
+	return false, r.deleteRestoreVerificationObjects(ctx, logger, nab)
+}
+
+// deleteRestoreVerificationObjects deletes the NonAdminRestore and the temporary namespace of the restore verification
+func (r *NonAdminBackupReconciler) deleteRestoreVerificationObjects(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) error {
+	verification := nab.Status.RestoreVerification
+	return deleteTemporaryVerificationObjects(ctx, r.Client, logger, nab.Namespace, verification.NonAdminRestore, verification.Namespace)
+}
+
+// deleteTemporaryVerificationObjects deletes the NonAdminRestore nonAdminRestoreName of nonAdminNamespace and the
+// temporary namespace namespaceName it restored into, with all its objects, if NAC created it to verify a backup of
+// nonAdminNamespace. It is shared by the restore verifications of NonAdminBackups and NonAdminBackupVerifications.
+func deleteTemporaryVerificationObjects(ctx context.Context, clientInstance client.Client, logger logr.Logger, nonAdminNamespace string, nonAdminRestoreName string, namespaceName string) error {
+	nar := &nacv1alpha1.NonAdminRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nonAdminRestoreName,
+			Namespace: nonAdminNamespace,
+		},
+	}
+	if err := clientInstance.Delete(ctx, nar); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete verification NonAdminRestore", constant.NameString, nar.Name)
+		return err
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := clientInstance.Get(ctx, types.NamespacedName{Name: namespaceName}, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		logger.Error(err, "Failed to get verification namespace", constant.NameString, namespaceName)
+		return err
+	}
+	// only delete the namespace NAC created for this namespace
+	if namespace.Labels[constant.RestoreVerificationNamespaceLabel] != nonAdminNamespace {
+		return nil
+	}
+	if err := clientInstance.Delete(ctx, namespace); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete verification namespace", constant.NameString, namespaceName)
+		return err
+	}
+	logger.V(1).Info("Verification NonAdminRestore and namespace deleted")
+	return nil
+}
+
+// isDataUploadFinished returns true if the DataUpload phase is terminal
+func isDataUploadFinished(phase velerov2alpha1.DataUploadPhase) bool {
+	return phase == velerov2alpha1.DataUploadPhaseCompleted ||
+		phase == velerov2alpha1.DataUploadPhaseCanceled ||
+		phase == velerov2alpha1.DataUploadPhaseFailed
+}
+
+// recordEvent emits an event for the NonAdminBackup if an event recorder is configured
+func (r *NonAdminBackupReconciler) recordEvent(nab *nacv1alpha1.NonAdminBackup, eventType, reason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(nab, eventType, reason, message)
+}
+
+// isVeleroBackupTransferred returns true if the VeleroBackup of the NonAdminBackup points to another namespace,
+// where it was transferred with its NonAdminBackupStorageLocation
+func isVeleroBackupTransferred(veleroBackup *velerov1.Backup, nab *nacv1alpha1.NonAdminBackup) bool {
+	originNamespace := veleroBackup.Annotations[constant.NabOriginNamespaceAnnotation]
+	return originNamespace != constant.EmptyString && originNamespace != nab.Namespace
+}
+
 // deleteVeleroBackupObjects deletes the VeleroBackup objects
 // associated with a given NonAdminBackup
 //
@@ -390,13 +1168,49 @@ func (r *NonAdminBackupReconciler) deleteVeleroBackupObjects(ctx context.Context
 		return false, err
 	}
 
-	if veleroBackup != nil {
+	// A VeleroBackup transferred to another namespace with its NonAdminBackupStorageLocation is not deleted
+	if veleroBackup != nil && !isVeleroBackupTransferred(veleroBackup, nab) {
 		if err = r.Delete(ctx, veleroBackup); err != nil {
 			logger.Error(err, "Failed to delete VeleroBackup", constant.NameString, veleroBackup.Name)
 			return false, err
 		}
-		logger.V(1).Info("VeleroBackup deletion initiated", constant.NameString, veleroBackup.Name)
-		return false, nil
+		logger.V(1).Info("VeleroBackup deletion initiated", constant.NameString, veleroBackup.Name)
+		return false, nil
+	}
+
+	return r.removeNabFinalizerUponVeleroBackupDeletion(ctx, logger, nab)
+}
+
+// releaseVeleroBackupObjects hands the VeleroBackup associated with a given NonAdminBackup
+// over to the cluster admin, by removing its managed-by label, so neither the garbage collector
+// nor the backup synchronizer act on it anymore. The NonAdminBackup finalizer is then removed.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup object
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered while releasing the VeleroBackup
+func (r *NonAdminBackupReconciler) releaseVeleroBackupObjects(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.NACUUID != constant.EmptyString {
+		veleroBackupNACUUID := nab.Status.VeleroBackup.NACUUID
+		veleroBackup, err := function.GetVeleroBackupByLabel(ctx, r.Client, r.OADPNamespace, veleroBackupNACUUID)
+		if err != nil {
+			logger.Error(err, findSingleVBError, constant.UUIDString, veleroBackupNACUUID)
+			return false, err
+		}
+
+		if veleroBackup != nil && !isVeleroBackupTransferred(veleroBackup, nab) &&
+			function.CheckLabelAnnotationValueIsValid(veleroBackup.Labels, constant.ManagedByLabel) {
+			delete(veleroBackup.Labels, constant.ManagedByLabel)
+			if err = r.Update(ctx, veleroBackup); err != nil {
+				logger.Error(err, "Failed to release VeleroBackup", constant.NameString, veleroBackup.Name)
+				return false, err
+			}
+			logger.V(1).Info("VeleroBackup released to cluster admin", constant.NameString, veleroBackup.Name)
+		}
 	}
 
 	return r.removeNabFinalizerUponVeleroBackupDeletion(ctx, logger, nab)
@@ -420,7 +1234,11 @@ func (r *NonAdminBackupReconciler) deleteDeleteBackupRequestObjects(ctx context.
 	}
 
 	veleroBackupNACUUID := nab.Status.VeleroBackup.NACUUID
-	deleteBackupRequest, err := function.GetVeleroDeleteBackupRequestByLabel(ctx, r.Client, r.OADPNamespace, veleroBackupNACUUID)
+	veleroBackupName := nab.VeleroBackupName()
+	if veleroBackupName == constant.EmptyString {
+		veleroBackupName = veleroBackupNACUUID
+	}
+	deleteBackupRequest, err := function.GetVeleroDeleteBackupRequestByLabel(ctx, r.Client, r.OADPNamespace, label.GetValidName(veleroBackupName))
 	if err != nil {
 		// Log error if multiple DeleteBackupRequest objects are found
 		logger.Error(err, findSingleVDBRError, constant.UUIDString, veleroBackupNACUUID)
@@ -515,14 +1333,37 @@ func (r *NonAdminBackupReconciler) initNabCreate(ctx context.Context, logger log
 // If the BackupSpec is invalid, the function sets the NonAdminBackup condition Accepted to "False".
 // If the BackupSpec is valid, the function sets the NonAdminBackup condition Accepted to "True".
 func (r *NonAdminBackupReconciler) validateSpec(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
-	err := function.ValidateBackupSpec(ctx, r.Client, r.OADPNamespace, nab, r.EnforcedBackupSpec)
+	err := r.validateBackupSpec(ctx, nab)
+	if err == nil && r.maxNonAdminBackups != nil && nab.Status.VeleroBackup == nil {
+		nabList := &nacv1alpha1.NonAdminBackupList{}
+		if listErr := r.List(ctx, nabList, client.InNamespace(nab.Namespace)); listErr != nil {
+			logger.Error(listErr, "Unable to list NonAdminBackups of NonAdminBackup namespace")
+			return false, listErr
+		}
+		nabs := make([]metav1.Object, 0, len(nabList.Items))
+		for index := range nabList.Items {
+			nabs = append(nabs, &nabList.Items[index])
+		}
+		err = function.ValidateNonAdminQuota(nacv1alpha1.NonAdminBackups, nab, nabs, r.maxNonAdminBackups)
+	}
+	if err == nil {
+		err = r.ValidationHook.Validate(ctx, nacv1alpha1.NonAdminBackups, nab, nab.Status.Conditions)
+		if err != nil && !validationhook.IsRejected(err) {
+			logger.Error(err, "Unable to validate NonAdminBackup with the validation hook")
+			return false, err
+		}
+	}
 	if err != nil {
+		reason := "InvalidBackupSpec"
+		if errors.Is(err, function.ErrNonAdminQuotaExceeded) {
+			reason = "QuotaExceeded"
+		}
 		updatedPhase := updateNonAdminPhase(&nab.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
 		updatedCondition := meta.SetStatusCondition(&nab.Status.Conditions,
 			metav1.Condition{
 				Type:    string(nacv1alpha1.NonAdminConditionAccepted),
 				Status:  metav1.ConditionFalse,
-				Reason:  "InvalidBackupSpec",
+				Reason:  reason,
 				Message: err.Error(),
 			},
 		)
@@ -532,7 +1373,7 @@ func (r *NonAdminBackupReconciler) validateSpec(ctx context.Context, logger logr
 				return false, updateErr
 			}
 			logger.V(1).Info("NonAdminBackup Phase set to BackingOff")
-			logger.V(1).Info("NonAdminBackup condition set to InvalidBackupSpec")
+			logger.V(1).Info("NonAdminBackup condition set to " + reason)
 		}
 		return false, reconcile.TerminalError(err)
 	}
@@ -541,10 +1382,11 @@ func (r *NonAdminBackupReconciler) validateSpec(ctx context.Context, logger logr
 
 	updated := meta.SetStatusCondition(&nab.Status.Conditions,
 		metav1.Condition{
-			Type:    string(nacv1alpha1.NonAdminConditionAccepted),
-			Status:  metav1.ConditionTrue,
-			Reason:  "BackupAccepted",
-			Message: "backup accepted",
+			Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             "BackupAccepted",
+			Message:            "backup accepted",
+			ObservedGeneration: nab.Generation,
 		},
 	)
 	if updated {
@@ -559,6 +1401,33 @@ func (r *NonAdminBackupReconciler) validateSpec(ctx context.Context, logger logr
 	return false, nil
 }
 
+// validateBackupSpec returns an error if the NonAdminBackup spec breaks the restrictions of NAC or of the
+// cluster admin, except the ones of the validation hook. It is shared with the NonAdminSchedule controller,
+// which validates its Velero Backup template as a NonAdminBackup spec.
+func (r *NonAdminBackupReconciler) validateBackupSpec(ctx context.Context, nab *nacv1alpha1.NonAdminBackup) error {
+	if r.AllowMultiNamespaceBackups && !r.RequesterWebhookServed &&
+		slices.ContainsFunc(nab.Spec.BackupSpec.IncludedNamespaces, func(namespace string) bool { return namespace != nab.Namespace }) {
+		return fmt.Errorf(constant.NABRestrictedErr+", multi namespace backups require the NonAdminBackup webhooks recording the requester, "+
+			"served with --allow-multi-namespace-backups or --serve-requester-webhooks", "spec.backupSpec.includedNamespaces")
+	}
+	if err := function.ValidateBackupSpec(ctx, r.Client, r.OADPNamespace, nab, r.EnforcedBackupSpec, r.AllowMultiNamespaceBackups); err != nil {
+		return err
+	}
+	if err := r.validateIncludedResourcesNotExcluded(nab); err != nil {
+		return err
+	}
+	if err := r.validateActiveDeadline(nab); err != nil {
+		return err
+	}
+	if err := r.validateParallelFilesUpload(nab); err != nil {
+		return err
+	}
+	if nab.Spec.VerifyRestore && !r.AllowRestoreVerification {
+		return fmt.Errorf(constant.NABRestrictedErr, "spec.verifyRestore")
+	}
+	return function.ValidateBackupExecHooks(nab.Spec.BackupSpec, r.DisableExecHooks, r.AllowedExecHookCommands)
+}
+
 // setBackupUUIDInStatus generates a UUID for VeleroBackup and stores it in the NonAdminBackup status.
 //
 // Parameters:
@@ -578,17 +1447,20 @@ func (r *NonAdminBackupReconciler) setBackupUUIDInStatus(ctx context.Context, lo
 	}
 
 	if nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.NACUUID == constant.EmptyString {
-		var veleroBackupNACUUID string
+		var veleroBackupNACUUID, veleroBackupName string
 		if value, ok := nab.Labels[constant.NabSyncLabel]; ok {
 			// TODO check value is valid?
+			// The name of the synced VeleroBackup is updated from the VeleroBackup itself
 			veleroBackupNACUUID = value
+			veleroBackupName = value
 		} else {
 			veleroBackupNACUUID = function.GenerateNacObjectUUID(nab.Namespace, nab.Name)
+			veleroBackupName = function.RenderNacObjectName(r.VeleroBackupNameTemplate, nab.Namespace, nab.Name, veleroBackupNACUUID)
 		}
 		nab.Status.VeleroBackup = &nacv1alpha1.VeleroBackup{
 			NACUUID:   veleroBackupNACUUID,
 			Namespace: r.OADPNamespace,
-			Name:      veleroBackupNACUUID,
+			Name:      veleroBackupName,
 		}
 		if err := r.Status().Update(ctx, nab); err != nil {
 			logger.Error(err, statusUpdateError)
@@ -643,8 +1515,13 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 		return false, err
 	}
 
+	updatedRetryCount := false
+	updatedEnforcedFields := false
 	if veleroBackup == nil {
-		if function.CheckLabelAnnotationValueIsValid(nab.Labels, constant.NabSyncLabel) || nab.Status.Phase == nacv1alpha1.NonAdminPhaseCreated {
+		if function.CheckLabelAnnotationValueIsValid(nab.Labels, constant.NabSyncLabel) || function.IsVeleroObjectCreatedPhase(nab.Status.Phase) {
+			if recreatesMissingVeleroBackup(nab) {
+				return r.resetMissingVeleroBackup(ctx, logger, nab)
+			}
 			if function.CheckLabelAnnotationValueIsValid(nab.Labels, constant.NabSyncLabel) {
 				err = errors.New("related Velero Backup to be synced from does not exist")
 			}
@@ -652,6 +1529,7 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 				err = errors.New("NonAdminBackup is finalized and its associated Velero Backup has been removed. Please create a new NonAdminBackup to initiate a new backup")
 			}
 			logger.Error(err, "related Velero Backup not found")
+			expired := isVeleroBackupExpired(nab, time.Now())
 			updatedPhase := updateNonAdminPhase(&nab.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
 			updatedCondition := meta.SetStatusCondition(&nab.Status.Conditions,
 				metav1.Condition{
@@ -668,58 +1546,33 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 					return false, updateErr
 				}
 			}
+			if updatedPhase && expired {
+				r.Notifier.Notify(ctx, logger, notification.Event{
+					Type:      nacv1alpha1.NonAdminNotificationEventBackupExpired,
+					Kind:      nacmeta.KindNonAdminBackup,
+					Namespace: nab.Namespace,
+					Name:      nab.Name,
+					Phase:     nab.Status.Phase,
+					Message:   fmt.Sprintf("Velero Backup expired at %s", nab.Status.VeleroBackup.Status.Expiration.UTC().Format(time.RFC3339)),
+				})
+			}
 			return false, reconcile.TerminalError(err)
 		}
 		logger.Info("VeleroBackup with label not found, creating one", constant.UUIDString, veleroBackupNACUUID)
 
-		backupSpec := nab.Spec.BackupSpec.DeepCopy()
-		enforcedSpec := reflect.ValueOf(r.EnforcedBackupSpec).Elem()
-		for index := range enforcedSpec.NumField() {
-			enforcedField := enforcedSpec.Field(index)
-			enforcedFieldName := enforcedSpec.Type().Field(index).Name
-			currentField := reflect.ValueOf(backupSpec).Elem().FieldByName(enforcedFieldName)
-			if !enforcedField.IsZero() && currentField.IsZero() {
-				currentField.Set(enforcedField)
-			}
-		}
-
-		// Included Namespaces are set by the controller and can not be overridden by the user
-		// nor admin user
-		backupSpec.IncludedNamespaces = []string{nab.Namespace}
-		if backupSpec.StorageLocation != constant.EmptyString {
-			nonAdminBsl := &nacv1alpha1.NonAdminBackupStorageLocation{}
-
-			if nabslErr := r.Get(ctx, types.NamespacedName{Name: backupSpec.StorageLocation, Namespace: nab.Namespace}, nonAdminBsl); nabslErr != nil {
-				return false, nabslErr
-			}
-
-			backupSpec.StorageLocation = nonAdminBsl.Status.VeleroBackupStorageLocation.Name
+		backupSpec, enforcedFields, buildErr := r.buildVeleroBackupSpec(ctx, logger, nab, veleroBackupNACUUID)
+		if buildErr != nil {
+			return false, buildErr
 		}
+		updatedEnforcedFields = updateNonAdminBackupEnforcedFieldsStatus(&nab.Status, enforcedFields)
 
-		// Exclude NAC resources (NAB, NAR, NABSL) from Non-Admin backups
-		// Determine if any of the new-style resource filter parameters are set
-		haveNewResourceFilterParameters := len(backupSpec.IncludedClusterScopedResources) > 0 ||
-			len(backupSpec.ExcludedClusterScopedResources) > 0 ||
-			len(backupSpec.IncludedNamespaceScopedResources) > 0 ||
-			len(backupSpec.ExcludedNamespaceScopedResources) > 0
-
-		if haveNewResourceFilterParameters {
-			// Use the new-style exclusion list
-			backupSpec.ExcludedNamespaceScopedResources = append(backupSpec.ExcludedNamespaceScopedResources,
-				alwaysExcludedNamespacedResources...)
-			backupSpec.ExcludedClusterScopedResources = append(backupSpec.ExcludedClusterScopedResources,
-				alwaysExcludedClusterResources...)
-		} else {
-			// Fallback to the old-style exclusion list
-			backupSpec.ExcludedResources = append(backupSpec.ExcludedResources,
-				alwaysExcludedNamespacedResources...)
-			backupSpec.ExcludedResources = append(backupSpec.ExcludedResources,
-				alwaysExcludedClusterResources...)
+		veleroBackupName := nab.VeleroBackupName()
+		if veleroBackupName == constant.EmptyString {
+			veleroBackupName = veleroBackupNACUUID
 		}
-
 		veleroBackup = &velerov1.Backup{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:        veleroBackupNACUUID,
+				Name:        veleroBackupName,
 				Namespace:   r.OADPNamespace,
 				Labels:      function.GetNonAdminLabels(),
 				Annotations: function.GetNonAdminBackupAnnotations(nab.ObjectMeta),
@@ -732,13 +1585,20 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 		// situations where NAC object do not require NabOriginUUIDLabel
 		veleroBackup.Labels[constant.NabOriginNACUUIDLabel] = veleroBackupNACUUID
 
-		err = r.Create(ctx, veleroBackup)
-
-		if err != nil {
-			// We do not retry here as the veleroBackupNACUUID
-			// should be guaranteed to be unique
-			logger.Error(err, "Failed to create VeleroBackup")
-			return false, err
+		// Labels and annotations required by NAC take precedence over the propagated ones
+		function.CopyAllowedKeys(veleroBackup.Labels, nab.Labels, r.PropagatedLabels)
+		function.CopyAllowedKeys(veleroBackup.Annotations, nab.Annotations, r.PropagatedAnnotations)
+
+		// The veleroBackupNACUUID is guaranteed to be unique, only transient errors are retried
+		retries, createErr := function.CreateWithRetry(ctx, r.Client, veleroBackup, func() (bool, error) {
+			existing, getErr := function.GetVeleroBackupByLabel(ctx, r.Client, r.OADPNamespace, veleroBackupNACUUID)
+			return existing != nil, getErr
+		})
+		updatedRetryCount = retries > 0
+		nab.Status.RetryCount += retries
+		if createErr != nil {
+			logger.Error(createErr, "Failed to create VeleroBackup", "retryCount", nab.Status.RetryCount)
+			return false, r.handleVeleroBackupCreateError(ctx, logger, nab, createErr, updatedRetryCount)
 		}
 		logger.Info("VeleroBackup successfully created")
 	} else if veleroBackup.Annotations == nil || veleroBackup.Annotations[constant.NabOriginNamespaceAnnotation] != nab.Namespace {
@@ -759,7 +1619,7 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 		updatedQueueInfo = true
 	}
 
-	updatedPhase := updateNonAdminPhase(&nab.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+	updatedPhase := updateNonAdminPhase(&nab.Status.Phase, nonAdminPhaseForVeleroBackup(veleroBackup))
 
 	updatedCondition := meta.SetStatusCondition(&nab.Status.Conditions,
 		metav1.Condition{
@@ -774,6 +1634,7 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 	// with the VeleroBackup. Any required updates to the NonAdminBackup
 	// Status will be applied based on the current state of the VeleroBackup.
 	updated := updateNonAdminBackupVeleroBackupSpecStatus(&nab.Status, veleroBackup)
+	updatedSnapshotMoveDataStatus := updateNonAdminBackupSnapshotMoveDataStatus(&nab.Status, nab.Spec.BackupSpec, veleroBackup)
 
 	podVolumeBackups := &velerov1.PodVolumeBackupList{}
 	err = r.List(ctx, podVolumeBackups, &client.ListOptions{
@@ -797,12 +1658,22 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 	}
 	updatedDataUploadStatus := updateNonAdminBackupDataUploadStatus(&nab.Status, dataUploads)
 
-	if updated || updatedPhase || updatedCondition || updatedQueueInfo || updatedPodVolumeBackupStatus || updatedDataUploadStatus {
+	if updated || updatedPhase || updatedCondition || updatedQueueInfo || updatedPodVolumeBackupStatus || updatedDataUploadStatus || updatedRetryCount || updatedSnapshotMoveDataStatus || updatedEnforcedFields {
 		if err := r.Status().Update(ctx, nab); err != nil {
 			logger.Error(err, statusUpdateError)
 			return false, err
 		}
 		logger.V(1).Info(statusUpdateExit)
+		if eventType, ok := notification.BackupEvent(nab.Status.Phase); ok && updatedPhase {
+			r.Notifier.Notify(ctx, logger, notification.Event{
+				Type:      eventType,
+				Kind:      nacmeta.KindNonAdminBackup,
+				Namespace: nab.Namespace,
+				Name:      nab.Name,
+				Phase:     nab.Status.Phase,
+				Message:   veleroBackup.Status.FailureReason,
+			})
+		}
 	} else {
 		logger.V(1).Info("NonAdminBackup status unchanged during VeleroBackup reconciliation")
 	}
@@ -810,6 +1681,404 @@ func (r *NonAdminBackupReconciler) createVeleroBackupAndSyncWithNonAdminBackup(c
 	return false, nil
 }
 
+// buildVeleroBackupSpec returns the spec of the VeleroBackup of the NonAdminBackup, which is the NonAdminBackup
+// spec.backupSpec with the enforced spec of the cluster admin and the restrictions of NAC applied, and the
+// spec.backupSpec fields set or overridden that way. It is shared with the NonAdminSchedule controller,
+// which builds the Velero Backup template of its Velero Schedule as a NonAdminBackup spec.
+func (r *NonAdminBackupReconciler) buildVeleroBackupSpec(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup, veleroBackupNACUUID string) (*velerov1.BackupSpec, []string, error) {
+	// enforcedFields lists the spec.backupSpec fields set or overridden by the admin user or NAC
+	var enforcedFields []string
+	backupSpec := nab.Spec.BackupSpec.DeepCopy()
+	enforcedSpec := reflect.ValueOf(r.EnforcedBackupSpec).Elem()
+	for index := range enforcedSpec.NumField() {
+		enforcedField := enforcedSpec.Field(index)
+		enforcedFieldName := enforcedSpec.Type().Field(index).Name
+		currentField := reflect.ValueOf(backupSpec).Elem().FieldByName(enforcedFieldName)
+		if !enforcedField.IsZero() && currentField.IsZero() {
+			currentField.Set(enforcedField)
+			tagName, _, _ := strings.Cut(enforcedSpec.Type().Field(index).Tag.Get(constant.JSONTagString), constant.CommaString)
+			enforcedFields = append(enforcedFields, tagName)
+		}
+	}
+
+	if r.DefaultSnapshotMoveData != nil && (backupSpec.SnapshotMoveData == nil || r.ForceSnapshotMoveData) {
+		if !reflect.DeepEqual(backupSpec.SnapshotMoveData, r.DefaultSnapshotMoveData) {
+			enforcedFields = appendEnforcedField(enforcedFields, "snapshotMoveData")
+		}
+		backupSpec.SnapshotMoveData = ptr.To(*r.DefaultSnapshotMoveData)
+	}
+
+	if r.MaxParallelFilesUpload > 0 && (backupSpec.UploaderConfig == nil || backupSpec.UploaderConfig.ParallelFilesUpload == 0) {
+		// otherwise the node-agent uploads as many files in parallel as it has CPUs
+		if backupSpec.UploaderConfig == nil {
+			backupSpec.UploaderConfig = &velerov1.UploaderConfigForBackup{}
+		}
+		backupSpec.UploaderConfig.ParallelFilesUpload = r.MaxParallelFilesUpload
+		enforcedFields = appendEnforcedField(enforcedFields, "uploaderConfig.parallelFilesUpload")
+	}
+
+	// Included Namespaces are set by the controller and can not be overridden by the user
+	// nor admin user, unless multi namespace backups are allowed and were validated
+	if !r.AllowMultiNamespaceBackups || len(backupSpec.IncludedNamespaces) == 0 {
+		if len(backupSpec.IncludedNamespaces) > 0 && !slices.Equal(backupSpec.IncludedNamespaces, []string{nab.Namespace}) {
+			enforcedFields = appendEnforcedField(enforcedFields, "includedNamespaces")
+		}
+		backupSpec.IncludedNamespaces = []string{nab.Namespace}
+	}
+	if backupSpec.StorageLocation == constant.EmptyString {
+		defaultNonAdminBsl, defaultErr := function.GetDefaultNonAdminBackupStorageLocation(ctx, r.Client, nab.Namespace)
+		if defaultErr != nil {
+			logger.Error(defaultErr, "Unable to get the default NonAdminBackupStorageLocation of the namespace")
+			return nil, nil, defaultErr
+		}
+		if defaultNonAdminBsl != nil {
+			backupSpec.StorageLocation = defaultNonAdminBsl.Name
+			enforcedFields = appendEnforcedField(enforcedFields, "storageLocation")
+		}
+	}
+	if backupSpec.StorageLocation != constant.EmptyString {
+		nonAdminBsl := &nacv1alpha1.NonAdminBackupStorageLocation{}
+
+		if nabslErr := r.Get(ctx, types.NamespacedName{Name: backupSpec.StorageLocation, Namespace: nab.Namespace}, nonAdminBsl); nabslErr != nil {
+			return nil, nil, nabslErr
+		}
+
+		backupSpec.StorageLocation = nonAdminBsl.Status.VeleroBackupStorageLocation.Name
+	}
+	// Volume snapshot locations enforced by the admin user already name VeleroVolumeSnapshotLocations
+	if len(nab.Spec.BackupSpec.VolumeSnapshotLocations) > 0 {
+		backupSpec.VolumeSnapshotLocations = make([]string, 0, len(nab.Spec.BackupSpec.VolumeSnapshotLocations))
+		for _, volumeSnapshotLocation := range nab.Spec.BackupSpec.VolumeSnapshotLocations {
+			nonAdminVsl := &nacv1alpha1.NonAdminVolumeSnapshotLocation{}
+			if navslErr := r.Get(ctx, types.NamespacedName{Name: volumeSnapshotLocation, Namespace: nab.Namespace}, nonAdminVsl); navslErr != nil {
+				return nil, nil, navslErr
+			}
+			backupSpec.VolumeSnapshotLocations = append(backupSpec.VolumeSnapshotLocations, nonAdminVsl.Status.VeleroVolumeSnapshotLocation.Name)
+		}
+	}
+
+	// Exclude NAC resources (NAB, NAR, NABSL, NAS) from Non-Admin backups
+	// Determine if any of the new-style resource filter parameters are set
+	haveNewResourceFilterParameters := len(backupSpec.IncludedClusterScopedResources) > 0 ||
+		len(backupSpec.ExcludedClusterScopedResources) > 0 ||
+		len(backupSpec.IncludedNamespaceScopedResources) > 0 ||
+		len(backupSpec.ExcludedNamespaceScopedResources) > 0
+
+	if haveNewResourceFilterParameters {
+		// Use the new-style exclusion list, unless it already excludes everything
+		if !slices.Equal(backupSpec.ExcludedNamespaceScopedResources, []string{"*"}) {
+			backupSpec.ExcludedNamespaceScopedResources = append(backupSpec.ExcludedNamespaceScopedResources,
+				r.excludedNamespacedResources()...)
+		}
+		if !slices.Equal(backupSpec.ExcludedClusterScopedResources, []string{"*"}) {
+			backupSpec.ExcludedClusterScopedResources = append(backupSpec.ExcludedClusterScopedResources,
+				r.excludedClusterResources()...)
+		}
+	} else {
+		// Fallback to the old-style exclusion list
+		backupSpec.ExcludedResources = append(backupSpec.ExcludedResources,
+			r.excludedNamespacedResources()...)
+		backupSpec.ExcludedResources = append(backupSpec.ExcludedResources,
+			r.excludedClusterResources()...)
+	}
+
+	if r.copiesResourcePolicy(nab) {
+		// Velero reads the resource policy ConfigMap from the OADP namespace, where syncResourcePolicy copied it
+		backupSpec.ResourcePolicy = &corev1.TypedLocalObjectReference{
+			Kind: constant.ResourcePolicyConfigMapKind,
+			Name: veleroBackupNACUUID,
+		}
+	}
+
+	return backupSpec, enforcedFields, nil
+}
+
+// recreatesMissingVeleroBackup returns true if the NonAdminBackup opted in to recreate its
+// VeleroBackup, which was deleted before it completed
+func recreatesMissingVeleroBackup(nab *nacv1alpha1.NonAdminBackup) bool {
+	return nab.Spec.RecreateOnMissingVeleroBackup &&
+		!function.CheckLabelAnnotationValueIsValid(nab.Labels, constant.NabSyncLabel) &&
+		nab.Status.Phase == nacv1alpha1.NonAdminPhaseCreated &&
+		(nab.Status.VeleroBackup.Status == nil || nab.Status.VeleroBackup.Status.CompletionTimestamp == nil)
+}
+
+// resetMissingVeleroBackup references a new VeleroBackup in the NonAdminBackup status, in place of
+// the one deleted before it completed, and requeues the NonAdminBackup so the new one is created.
+// The new VeleroBackup gets a new NACUUID, so it does not collide with the data of the deleted one.
+func (r *NonAdminBackupReconciler) resetMissingVeleroBackup(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	// The copy of the resource policy is named after the NACUUID of the deleted VeleroBackup
+	if _, err := r.deleteResourcePolicyConfigMap(ctx, logger, nab); err != nil {
+		return false, err
+	}
+
+	missingVeleroBackupName := nab.VeleroBackupName()
+	veleroBackupNACUUID := function.GenerateNacObjectUUID(nab.Namespace, nab.Name)
+	nab.Status.VeleroBackup = &nacv1alpha1.VeleroBackup{
+		NACUUID:   veleroBackupNACUUID,
+		Namespace: r.OADPNamespace,
+		Name:      function.RenderNacObjectName(r.VeleroBackupNameTemplate, nab.Namespace, nab.Name, veleroBackupNACUUID),
+	}
+	nab.Status.Phase = nacv1alpha1.NonAdminPhaseNew
+	nab.Status.QueueInfo = nil
+	nab.Status.DataMoverDataUploads = nil
+	nab.Status.FileSystemPodVolumeBackups = nil
+	meta.RemoveStatusCondition(&nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued))
+	meta.RemoveStatusCondition(&nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionDeadlineExceeded))
+	if err := r.Status().Update(ctx, nab); err != nil {
+		logger.Error(err, statusUpdateError)
+		return false, err
+	}
+
+	logger.Info("VeleroBackup deleted before it completed, creating a new one", constant.NameString, missingVeleroBackupName, constant.UUIDString, veleroBackupNACUUID)
+	r.recordEvent(nab, corev1.EventTypeWarning, "VeleroBackupRecreated",
+		fmt.Sprintf("Velero Backup %s was deleted before it completed, creating a new one", missingVeleroBackupName))
+	return true, nil
+}
+
+// handleVeleroBackupCreateError persists the retry count of a failed VeleroBackup creation and
+// returns the error to be returned by the reconcile step. Once transient errors were retried
+// maxVeleroObjectCreateRetries times, the NonAdminBackup is moved to the BackingOff phase
+// and a terminal error is returned.
+func (r *NonAdminBackupReconciler) handleVeleroBackupCreateError(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup, createErr error, updatedRetryCount bool) error {
+	if !function.IsTransientAPIError(createErr) {
+		return createErr
+	}
+
+	if nab.Status.RetryCount < maxVeleroObjectCreateRetries {
+		if updatedRetryCount {
+			if err := r.Status().Update(ctx, nab); err != nil {
+				logger.Error(err, statusUpdateError)
+			}
+		}
+		return createErr
+	}
+
+	updatePhase := updateNonAdminPhase(&nab.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+	updateCondition := meta.SetStatusCondition(&nab.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionQueued),
+			Status:  metav1.ConditionFalse,
+			Reason:  "VeleroBackupCreationFailed",
+			Message: fmt.Sprintf("giving up after %d retries: %v", nab.Status.RetryCount, createErr),
+		},
+	)
+	if updatePhase || updateCondition || updatedRetryCount {
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return err
+		}
+	}
+	return reconcile.TerminalError(createErr)
+}
+
+// validateIncludedResourcesNotExcluded returns an error if the NonAdminBackup explicitly
+// includes a resource that is excluded from every VeleroBackup, which Velero would reject
+func (r *NonAdminBackupReconciler) validateIncludedResourcesNotExcluded(nab *nacv1alpha1.NonAdminBackup) error {
+	included := append(slices.Clone(nab.Spec.BackupSpec.IncludedResources), nab.Spec.BackupSpec.IncludedNamespaceScopedResources...)
+	excluded := append(r.excludedNamespacedResources(), r.excludedClusterResources()...)
+	for _, resource := range included {
+		if slices.Contains(excluded, resource) {
+			return fmt.Errorf(constant.NABRestrictedErr+", can not include %s", "spec.backupSpec resource filter", resource)
+		}
+	}
+	return nil
+}
+
+// copiesResourcePolicy returns true if the resource policy ConfigMap referenced by the NonAdminBackup is
+// copied to the OADP namespace, which is not the case when the admin user enforces the resource policy
+func (r *NonAdminBackupReconciler) copiesResourcePolicy(nab *nacv1alpha1.NonAdminBackup) bool {
+	return nab.Spec.BackupSpec.ResourcePolicy != nil && r.EnforcedBackupSpec.ResourcePolicy == nil
+}
+
+// syncResourcePolicy copies the resource policy ConfigMap referenced by the NonAdminBackup from its namespace
+// to the OADP namespace, where Velero reads it, and keeps the copy in sync until the VeleroBackup completes.
+// The copy is named after the VeleroBackup NACUUID.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nab: NonAdminBackup referencing the resource policy ConfigMap
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminBackupReconciler) syncResourcePolicy(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if !r.copiesResourcePolicy(nab) || nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+	if nab.Status.VeleroBackup.Status != nil && nab.Status.VeleroBackup.Status.CompletionTimestamp != nil {
+		// Velero does not read the resource policy anymore
+		return false, nil
+	}
+
+	sourceResourcePolicy := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      nab.Spec.BackupSpec.ResourcePolicy.Name,
+		Namespace: nab.Namespace,
+	}, sourceResourcePolicy); err != nil {
+		logger.Error(err, "Failed to get resource policy ConfigMap", constant.NameString, nab.Spec.BackupSpec.ResourcePolicy.Name)
+		return false, err
+	}
+
+	veleroObjectsNACUUID := nab.Status.VeleroBackup.NACUUID
+	resourcePolicy := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      veleroObjectsNACUUID,
+			Namespace: r.OADPNamespace,
+		},
+	}
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, resourcePolicy, func() error {
+		resourcePolicy.Labels = function.GetNonAdminLabels()
+		resourcePolicy.Labels[constant.NabOriginNACUUIDLabel] = veleroObjectsNACUUID
+		resourcePolicy.Annotations = function.GetNonAdminBackupAnnotations(nab.ObjectMeta)
+		resourcePolicy.Data = sourceResourcePolicy.Data
+		resourcePolicy.BinaryData = sourceResourcePolicy.BinaryData
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "Failed to sync resource policy ConfigMap to the OADP namespace")
+		return false, err
+	}
+	if op != controllerutil.OperationResultNone {
+		logger.V(1).Info("Resource policy ConfigMap synced to the OADP namespace", "operation", op)
+	}
+	return false, nil
+}
+
+// deleteResourcePolicyConfigMap deletes the copy of the NonAdminBackup resource policy ConfigMap from the OADP namespace
+func (r *NonAdminBackupReconciler) deleteResourcePolicyConfigMap(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+	resourcePolicy := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nab.Status.VeleroBackup.NACUUID,
+			Namespace: r.OADPNamespace,
+		},
+	}
+	if err := r.Delete(ctx, resourcePolicy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		logger.Error(err, "Failed to delete resource policy ConfigMap")
+		return false, err
+	}
+	logger.V(1).Info("Resource policy ConfigMap deleted")
+	return false, nil
+}
+
+// requestMultiNamespaceBackupApproval requests the approval of the cluster admin before the VeleroBackup of a
+// multi namespace NonAdminBackup is created. The NonAdminBackup waits while the NonAdminApprovalRequest is pending,
+// and is set to BackingOff once it is rejected or expired.
+func (r *NonAdminBackupReconciler) requestMultiNamespaceBackupApproval(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if r.MultiNamespaceBackupApproval == nil || function.IsVeleroObjectCreatedPhase(nab.Status.Phase) || nab.Spec.BackupSpec == nil ||
+		nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+	includedNamespaces := slices.Clone(nab.Spec.BackupSpec.IncludedNamespaces)
+	if !slices.ContainsFunc(includedNamespaces, func(namespace string) bool { return namespace != nab.Namespace }) {
+		return false, nil
+	}
+	slices.Sort(includedNamespaces)
+
+	decision, err := r.MultiNamespaceBackupApproval.Request(ctx, approval.Request{
+		Origin: nacmeta.Origin{
+			Kind:      nacmeta.KindNonAdminBackup,
+			NACUUID:   nab.Status.VeleroBackup.NACUUID,
+			Namespace: nab.Namespace,
+			Name:      nab.Name,
+		},
+		Operation: nacv1alpha1.NonAdminApprovalOperationMultiNamespaceBackup,
+		Details:   "includedNamespaces: " + strings.Join(includedNamespaces, ","),
+	})
+	if err != nil {
+		logger.Error(err, "Failed to request multi namespace backup approval")
+		return false, err
+	}
+
+	condition := metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminConditionApproved),
+		Status:  metav1.ConditionFalse,
+		Reason:  "ApprovalPending",
+		Message: fmt.Sprintf("multi namespace backup waiting for the approval of the cluster admin in NonAdminApprovalRequest %s", decision.Name),
+	}
+	var terminalErr error
+	switch decision.Phase {
+	case nacv1alpha1.NonAdminApprovalRequestPhaseApproved:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Approved"
+		condition.Message = "multi namespace backup approved by the cluster admin"
+	case nacv1alpha1.NonAdminApprovalRequestPhaseRejected:
+		condition.Reason = "ApprovalRejected"
+		condition.Message = "multi namespace backup rejected by the cluster admin"
+	case nacv1alpha1.NonAdminApprovalRequestPhaseExpired:
+		condition.Reason = "ApprovalExpired"
+		condition.Message = "multi namespace backup was not approved by the cluster admin before the NonAdminApprovalRequest expired"
+	}
+	if decision.Reason != constant.EmptyString {
+		condition.Message += ": " + decision.Reason
+	}
+	updatedPhase := false
+	if condition.Status != metav1.ConditionTrue {
+		terminalErr = reconcile.TerminalError(errors.New(condition.Message))
+		if decision.Phase != nacv1alpha1.NonAdminApprovalRequestPhasePending {
+			updatedPhase = updateNonAdminPhase(&nab.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		}
+	}
+	if updatedCondition := meta.SetStatusCondition(&nab.Status.Conditions, condition); updatedCondition || updatedPhase {
+		if err := r.Status().Update(ctx, nab); err != nil {
+			logger.Error(err, statusUpdateError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackup condition set to " + condition.Reason)
+	}
+	return false, terminalErr
+}
+
+// deleteMultiNamespaceBackupApprovalRequest deletes the NonAdminApprovalRequest of a multi namespace NonAdminBackup
+func (r *NonAdminBackupReconciler) deleteMultiNamespaceBackupApprovalRequest(ctx context.Context, logger logr.Logger, nab *nacv1alpha1.NonAdminBackup) (bool, error) {
+	if nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+	if err := r.MultiNamespaceBackupApproval.Delete(ctx, nacv1alpha1.NonAdminApprovalOperationMultiNamespaceBackup, nab.Status.VeleroBackup.NACUUID); err != nil {
+		logger.Error(err, "Failed to delete NonAdminApprovalRequest")
+		return false, err
+	}
+	return false, nil
+}
+
+// validateActiveDeadline returns an error if the NonAdminBackup active deadline exceeds the maximum set by the cluster admin
+func (r *NonAdminBackupReconciler) validateActiveDeadline(nab *nacv1alpha1.NonAdminBackup) error {
+	if r.MaxActiveDeadline <= 0 || nab.Spec.ActiveDeadlineSeconds == nil {
+		return nil
+	}
+	if *nab.Spec.ActiveDeadlineSeconds > int64(r.MaxActiveDeadline/time.Second) {
+		return fmt.Errorf(constant.NABRestrictedErr+", can not exceed %s", "spec.activeDeadlineSeconds", r.MaxActiveDeadline)
+	}
+	return nil
+}
+
+// validateParallelFilesUpload returns an error if the NonAdminBackup parallel files upload exceeds the maximum set by the cluster admin
+func (r *NonAdminBackupReconciler) validateParallelFilesUpload(nab *nacv1alpha1.NonAdminBackup) error {
+	if r.MaxParallelFilesUpload <= 0 || nab.Spec.BackupSpec.UploaderConfig == nil {
+		return nil
+	}
+	if nab.Spec.BackupSpec.UploaderConfig.ParallelFilesUpload > r.MaxParallelFilesUpload {
+		return fmt.Errorf(constant.NABRestrictedErr+", can not exceed %d", "spec.backupSpec.uploaderConfig.parallelFilesUpload", r.MaxParallelFilesUpload)
+	}
+	return nil
+}
+
+// excludedNamespacedResources returns the namespaced resources excluded from every Velero Backup
+func (r *NonAdminBackupReconciler) excludedNamespacedResources() []string {
+	return append(slices.Clone(alwaysExcludedNamespacedResources), r.AdditionalExcludedNamespacedResources...)
+}
+
+// excludedClusterResources returns the cluster scoped resources excluded from every Velero Backup
+func (r *NonAdminBackupReconciler) excludedClusterResources() []string {
+	return append(slices.Clone(alwaysExcludedClusterResources), r.AdditionalExcludedClusterResources...)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *NonAdminBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -830,6 +2099,13 @@ func (r *NonAdminBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				Client:        r.Client,
 				OADPNamespace: r.OADPNamespace,
 			},
+			ResourcePolicyPredicate: predicate.NonAdminBackupResourcePolicyPredicate{
+				OADPNamespace: r.OADPNamespace,
+			},
+			ApprovalRequestPredicate: predicate.NonAdminApprovalRequestPredicate{
+				OADPNamespace: r.OADPNamespace,
+			},
+			DeleteBackupRequestPredicate: predicate.NonAdminDeleteBackupRequestPredicate{},
 		}).
 		// handler runs after predicate
 		Watches(&velerov1.Backup{}, &handler.VeleroBackupHandler{}).
@@ -845,6 +2121,14 @@ func (r *NonAdminBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			Client:        r.Client,
 			OADPNamespace: r.OADPNamespace,
 		}).
+		Watches(&corev1.ConfigMap{}, &handler.NonAdminBackupResourcePolicyHandler{
+			Client: r.Client,
+		}).
+		Watches(&nacv1alpha1.NonAdminApprovalRequest{}, &handler.NonAdminApprovalRequestHandler{
+			Kind: nacmeta.KindNonAdminBackup,
+		}).
+		Watches(&nacv1alpha1.NonAdminDeleteBackupRequest{}, &handler.NonAdminDeleteBackupRequestHandler{}).
+		WithOptions(r.StartupBackpressure.ControllerOptions()).
 		Complete(r)
 }
 
@@ -859,6 +2143,30 @@ func updateNonAdminPhase(phase *nacv1alpha1.NonAdminPhase, newPhase nacv1alpha1.
 	return true
 }
 
+// isVeleroBackupExpired returns true if the NonAdminBackup completed and the expiration of its VeleroBackup is
+// reached, that is if Velero garbage collected the VeleroBackup
+func isVeleroBackupExpired(nab *nacv1alpha1.NonAdminBackup, now time.Time) bool {
+	return (nab.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted || nab.Status.Phase == nacv1alpha1.NonAdminPhasePartiallyFailed) &&
+		nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.Status != nil &&
+		nab.Status.VeleroBackup.Status.Expiration != nil && !now.Before(nab.Status.VeleroBackup.Status.Expiration.Time)
+}
+
+// nonAdminPhaseForVeleroBackup maps the VeleroBackup phase to the NonAdminBackup phase.
+// Terminal VeleroBackup phases have their own NonAdminBackup phase, any other phase
+// is reported as Created.
+func nonAdminPhaseForVeleroBackup(veleroBackup *velerov1.Backup) nacv1alpha1.NonAdminPhase {
+	switch veleroBackup.Status.Phase {
+	case velerov1.BackupPhaseCompleted:
+		return nacv1alpha1.NonAdminPhaseCompleted
+	case velerov1.BackupPhasePartiallyFailed:
+		return nacv1alpha1.NonAdminPhasePartiallyFailed
+	case velerov1.BackupPhaseFailed, velerov1.BackupPhaseFailedValidation:
+		return nacv1alpha1.NonAdminPhaseFailed
+	default:
+		return nacv1alpha1.NonAdminPhaseCreated
+	}
+}
+
 // updateNonAdminBackupVeleroBackupSpecStatus sets the VeleroBackup spec and status fields in NonAdminBackup object status and returns true
 // if the VeleroBackup fields are changed by this call.
 func updateNonAdminBackupVeleroBackupSpecStatus(status *nacv1alpha1.NonAdminBackupStatus, veleroBackup *velerov1.Backup) bool {
@@ -877,16 +2185,66 @@ func updateNonAdminBackupVeleroBackupSpecStatus(status *nacv1alpha1.NonAdminBack
 		status.VeleroBackup.Status = &velerov1.BackupStatus{}
 	}
 
-	if reflect.DeepEqual(*status.VeleroBackup.Spec, veleroBackup.Spec) &&
+	if status.VeleroBackup.Name == veleroBackup.Name &&
+		reflect.DeepEqual(*status.VeleroBackup.Spec, veleroBackup.Spec) &&
 		reflect.DeepEqual(*status.VeleroBackup.Status, veleroBackup.Status) {
 		return false
 	}
 
+	status.VeleroBackup.Name = veleroBackup.Name
 	status.VeleroBackup.Spec = veleroBackup.Spec.DeepCopy()
 	status.VeleroBackup.Status = veleroBackup.Status.DeepCopy()
 	return true
 }
 
+// appendEnforcedField appends the spec.backupSpec field to the enforced fields, if not already listed
+func appendEnforcedField(enforcedFields []string, field string) []string {
+	if slices.Contains(enforcedFields, field) {
+		return enforcedFields
+	}
+	return append(enforcedFields, field)
+}
+
+// updateNonAdminBackupEnforcedFieldsStatus sets the EnforcedFields field and the SpecOverridden condition in NonAdminBackup
+// object status and returns true if they are changed by this call.
+func updateNonAdminBackupEnforcedFieldsStatus(status *nacv1alpha1.NonAdminBackupStatus, enforcedFields []string) bool {
+	if len(enforcedFields) == 0 {
+		updated := status.EnforcedFields != nil
+		status.EnforcedFields = nil
+		return meta.RemoveStatusCondition(&status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden)) || updated
+	}
+
+	updated := !slices.Equal(status.EnforcedFields, enforcedFields)
+	status.EnforcedFields = enforcedFields
+	return meta.SetStatusCondition(&status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionSpecOverridden),
+			Status:  metav1.ConditionTrue,
+			Reason:  "EnforcedFieldsApplied",
+			Message: "spec.backupSpec fields set or overridden in the Velero Backup: " + strings.Join(enforcedFields, ", "),
+		},
+	) || updated
+}
+
+// updateNonAdminBackupSnapshotMoveDataStatus sets the SnapshotMoveData field in NonAdminBackup object status and returns true
+// if it is changed by this call.
+func updateNonAdminBackupSnapshotMoveDataStatus(status *nacv1alpha1.NonAdminBackupStatus, backupSpec *velerov1.BackupSpec, veleroBackup *velerov1.Backup) bool {
+	if status == nil || backupSpec == nil || veleroBackup == nil {
+		return false
+	}
+
+	enabled := ptr.Deref(veleroBackup.Spec.SnapshotMoveData, false)
+	snapshotMoveData := &nacv1alpha1.SnapshotMoveData{
+		Enabled:    enabled,
+		Overridden: backupSpec.SnapshotMoveData != nil && *backupSpec.SnapshotMoveData != enabled,
+	}
+	if reflect.DeepEqual(status.SnapshotMoveData, snapshotMoveData) {
+		return false
+	}
+	status.SnapshotMoveData = snapshotMoveData
+	return true
+}
+
 // updateNonAdminBackupDeleteBackupRequestStatus sets the VeleroDeleteBackupRequest status field in NonAdminBackup object status and returns true
 // if the VeleroDeleteBackupRequest fields are changed by this call.
 func updateNonAdminBackupDeleteBackupRequestStatus(status *nacv1alpha1.NonAdminBackupStatus, veleroDeleteBackupRequest *velerov1.DeleteBackupRequest) bool {
@@ -902,14 +2260,46 @@ func updateNonAdminBackupDeleteBackupRequestStatus(status *nacv1alpha1.NonAdminB
 		status.VeleroDeleteBackupRequest.Status = &velerov1.DeleteBackupRequestStatus{}
 	}
 
-	if reflect.DeepEqual(*status.VeleroDeleteBackupRequest.Status, veleroDeleteBackupRequest.Status) {
+	// The reference is missing if the controller stopped between the DeleteBackupRequest creation and the status update
+	if status.VeleroDeleteBackupRequest.Name == veleroDeleteBackupRequest.Name &&
+		status.VeleroDeleteBackupRequest.Namespace == veleroDeleteBackupRequest.Namespace &&
+		status.VeleroDeleteBackupRequest.NACUUID == veleroDeleteBackupRequest.Labels[constant.NabOriginNACUUIDLabel] &&
+		reflect.DeepEqual(*status.VeleroDeleteBackupRequest.Status, veleroDeleteBackupRequest.Status) {
 		return false
 	}
 
+	status.VeleroDeleteBackupRequest.Name = veleroDeleteBackupRequest.Name
+	status.VeleroDeleteBackupRequest.Namespace = veleroDeleteBackupRequest.Namespace
+	status.VeleroDeleteBackupRequest.NACUUID = veleroDeleteBackupRequest.Labels[constant.NabOriginNACUUIDLabel]
 	status.VeleroDeleteBackupRequest.Status = veleroDeleteBackupRequest.Status.DeepCopy()
 	return true
 }
 
+// updateNonAdminBackupDeletionStage sets the deletion stage of the NonAdminBackup.
+// Returns true if the stage changed.
+func updateNonAdminBackupDeletionStage(status *nacv1alpha1.NonAdminBackupStatus, stage nacv1alpha1.NonAdminBackupDeletionStage) bool {
+	if status.DeletionStage == stage {
+		return false
+	}
+	status.DeletionStage = stage
+	return true
+}
+
+// deletionStageForDeleteBackupRequest maps the DeleteBackupRequest phase to the NonAdminBackup deletion stage.
+func deletionStageForDeleteBackupRequest(deleteBackupRequest *velerov1.DeleteBackupRequest) nacv1alpha1.NonAdminBackupDeletionStage {
+	switch deleteBackupRequest.Status.Phase {
+	case velerov1.DeleteBackupRequestPhaseInProgress:
+		return nacv1alpha1.NonAdminBackupDeletionStageBackupDataDeleting
+	case velerov1.DeleteBackupRequestPhaseProcessed:
+		if len(deleteBackupRequest.Status.Errors) > 0 {
+			return nacv1alpha1.NonAdminBackupDeletionStageBackupDataDeletionFailed
+		}
+		return nacv1alpha1.NonAdminBackupDeletionStageBackupDataDeleted
+	default:
+		return nacv1alpha1.NonAdminBackupDeletionStageDeleteBackupRequestCreated
+	}
+}
+
 func updateNonAdminBackupPodVolumeBackupStatus(status *nacv1alpha1.NonAdminBackupStatus, podVolumeBackupList *velerov1.PodVolumeBackupList) bool {
 	if status.FileSystemPodVolumeBackups == nil {
 		status.FileSystemPodVolumeBackups = &nacv1alpha1.FileSystemPodVolumeBackups{}
@@ -954,10 +2344,43 @@ func updateNonAdminBackupPodVolumeBackupStatus(status *nacv1alpha1.NonAdminBacku
 		status.FileSystemPodVolumeBackups.Completed = numberOfCompleted
 		updated = true
 	}
+	failures := podVolumeBackupFailures(podVolumeBackupList)
+	if !reflect.DeepEqual(status.FileSystemPodVolumeBackups.Failures, failures) {
+		status.FileSystemPodVolumeBackups.Failures = failures
+		updated = true
+	}
 
 	return updated
 }
 
+// podVolumeBackupFailures returns up to maxPodVolumeBackupFailures failed PodVolumeBackups,
+// ordered by pod namespace, pod name and volume name, so the list only changes with the failures.
+func podVolumeBackupFailures(podVolumeBackupList *velerov1.PodVolumeBackupList) []nacv1alpha1.PodVolumeBackupFailure {
+	var failures []nacv1alpha1.PodVolumeBackupFailure
+	for _, podVolumeBackup := range podVolumeBackupList.Items {
+		if podVolumeBackup.Status.Phase != velerov1.PodVolumeBackupPhaseFailed {
+			continue
+		}
+		failures = append(failures, nacv1alpha1.PodVolumeBackupFailure{
+			Namespace: podVolumeBackup.Spec.Pod.Namespace,
+			Pod:       podVolumeBackup.Spec.Pod.Name,
+			Volume:    podVolumeBackup.Spec.Volume,
+			Message:   podVolumeBackup.Status.Message,
+		})
+	}
+	slices.SortFunc(failures, func(a, b nacv1alpha1.PodVolumeBackupFailure) int {
+		return cmp.Or(
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Pod, b.Pod),
+			cmp.Compare(a.Volume, b.Volume),
+		)
+	})
+	if len(failures) > maxPodVolumeBackupFailures {
+		failures = failures[:maxPodVolumeBackupFailures]
+	}
+	return failures
+}
+
 func updateNonAdminBackupDataUploadStatus(status *nacv1alpha1.NonAdminBackupStatus, dataUploadList *velerov2alpha1.DataUploadList) bool {
 	if status.DataMoverDataUploads == nil {
 		status.DataMoverDataUploads = &nacv1alpha1.DataMoverDataUploads{}
@@ -976,7 +2399,10 @@ func updateNonAdminBackupDataUploadStatus(status *nacv1alpha1.NonAdminBackupStat
 	numberOfCanceled := 0
 	numberOfFailed := 0
 	numberOfCompleted := 0
+	var totalBytes, bytesDone int64
 	for _, dataUpload := range dataUploadList.Items {
+		totalBytes += dataUpload.Status.Progress.TotalBytes
+		bytesDone += dataUpload.Status.Progress.BytesDone
 		switch dataUpload.Status.Phase {
 		case velerov2alpha1.DataUploadPhaseNew:
 			numberOfNew++
@@ -1030,6 +2456,27 @@ func updateNonAdminBackupDataUploadStatus(status *nacv1alpha1.NonAdminBackupStat
 		status.DataMoverDataUploads.Completed = numberOfCompleted
 		updated = true
 	}
+	if status.DataMoverDataUploads.TotalBytes != totalBytes {
+		status.DataMoverDataUploads.TotalBytes = totalBytes
+		updated = true
+	}
+	if status.DataMoverDataUploads.BytesDone != bytesDone {
+		status.DataMoverDataUploads.BytesDone = bytesDone
+		updated = true
+	}
+	progressPercentage := dataMoverProgressPercentage(bytesDone, totalBytes)
+	if status.DataMoverDataUploads.ProgressPercentage != progressPercentage {
+		status.DataMoverDataUploads.ProgressPercentage = progressPercentage
+		updated = true
+	}
 
 	return updated
 }
+
+// dataMoverProgressPercentage returns the percentage of totalBytes already transferred, capped at 100
+func dataMoverProgressPercentage(bytesDone int64, totalBytes int64) int {
+	if totalBytes <= 0 {
+		return 0
+	}
+	return int(min(bytesDone*fullProgressPercentage/totalBytes, fullProgressPercentage))
+}
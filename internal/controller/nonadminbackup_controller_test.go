@@ -23,24 +23,32 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
+	"github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/approval"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
 	"github.com/migtools/oadp-non-admin/internal/common/function"
 )
@@ -185,6 +193,62 @@ func deleteTestNamespaces(ctx context.Context, nonAdminNamespaceName string, oad
 	return k8sClient.Delete(ctx, nonAdminNamespace)
 }
 
+// requesterWebhooksClient lists, in the test environment, the admission webhook configurations recording the
+// requester of an object, which can not be created there, as the test environment does not serve them
+type requesterWebhooksClient struct {
+	client.Client
+	mutatingWebhookConfiguration   admissionregistrationv1.MutatingWebhookConfiguration
+	validatingWebhookConfiguration admissionregistrationv1.ValidatingWebhookConfiguration
+}
+
+// withRequesterWebhookConfigurations returns a client of the test environment listing the admission webhook
+// configurations recording the requester of the objects of resource
+func withRequesterWebhookConfigurations(resource string, mutatingWebhookName string, validatingWebhookName string) client.Client {
+	failurePolicy := admissionregistrationv1.Fail
+	rules := func(operation admissionregistrationv1.OperationType) []admissionregistrationv1.RuleWithOperations {
+		return []admissionregistrationv1.RuleWithOperations{{
+			Operations: []admissionregistrationv1.OperationType{operation},
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{nacv1alpha1.GroupVersion.Group},
+				APIVersions: []string{nacv1alpha1.GroupVersion.Version},
+				Resources:   []string{resource},
+			},
+		}}
+	}
+	return &requesterWebhooksClient{
+		Client: k8sClient,
+		mutatingWebhookConfiguration: admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: mutatingWebhookName},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{{
+				Name:          mutatingWebhookName,
+				FailurePolicy: &failurePolicy,
+				Rules:         rules(admissionregistrationv1.Create),
+			}},
+		},
+		validatingWebhookConfiguration: admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: validatingWebhookName},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+				Name:          validatingWebhookName,
+				FailurePolicy: &failurePolicy,
+				Rules:         rules(admissionregistrationv1.Update),
+			}},
+		},
+	}
+}
+
+// List lists the admission webhook configurations recording the requester, and the other objects in the test environment
+func (c *requesterWebhooksClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	switch webhookConfigurations := list.(type) {
+	case *admissionregistrationv1.MutatingWebhookConfigurationList:
+		webhookConfigurations.Items = []admissionregistrationv1.MutatingWebhookConfiguration{c.mutatingWebhookConfiguration}
+		return nil
+	case *admissionregistrationv1.ValidatingWebhookConfigurationList:
+		webhookConfigurations.Items = []admissionregistrationv1.ValidatingWebhookConfiguration{c.validatingWebhookConfiguration}
+		return nil
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
 var _ = ginkgo.Describe("Test NonAdminBackup in cluster validation", func() {
 	var (
 		ctx                     context.Context
@@ -1317,6 +1381,15 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminBackup Controller",
 							nacv1alpha1.NonAdminBackups,
 							nacv1alpha1.NonAdminRestores,
 							nacv1alpha1.NonAdminBackupStorageLocations,
+							nacv1alpha1.NonAdminSchedules,
+							nacv1alpha1.NonAdminVolumeSnapshotLocations,
+							nacv1alpha1.NonAdminDataProtectionTests,
+							nacv1alpha1.NonAdminQuotaStatuses,
+							nacv1alpha1.NonAdminBackupVerifications,
+							nacv1alpha1.NonAdminNotifications,
+							nacv1alpha1.NonAdminGroupBackups,
+							nacv1alpha1.NonAdminDeleteBackupRequests,
+							nacv1alpha1.NonAdminRetentionPolicies,
 							"securitycontextconstraints",
 							"clusterroles",
 							"clusterrolebindings",
@@ -1445,6 +1518,12 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminBackup Controller",
 						Reason:  "BackupAccepted",
 						Message: "backup accepted",
 					},
+					{
+						Type:    "SpecOverridden",
+						Status:  metav1.ConditionTrue,
+						Reason:  "EnforcedFieldsApplied",
+						Message: "spec.backupSpec fields set or overridden in the Velero Backup",
+					},
 					{
 						Type:    "Queued",
 						Status:  metav1.ConditionTrue,
@@ -1695,7 +1774,7 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminBackup Controller",
 				},
 			},
 			status: nacv1alpha1.NonAdminBackupStatus{
-				Phase: nacv1alpha1.NonAdminPhaseCreated,
+				Phase: nacv1alpha1.NonAdminPhaseCompleted,
 				VeleroBackup: &nacv1alpha1.VeleroBackup{
 					Namespace: oadpNamespace,
 					Spec: &velerov1.BackupSpec{
@@ -1730,3 +1809,981 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminBackup Controller",
 		}),
 	)
 })
+
+var _ = ginkgo.Describe("deletionStageForDeleteBackupRequest", func() {
+	type deletionStageTestScenario struct {
+		status        velerov1.DeleteBackupRequestStatus
+		expectedStage nacv1alpha1.NonAdminBackupDeletionStage
+	}
+
+	ginkgo.DescribeTable("should map the DeleteBackupRequest status to the deletion stage",
+		func(sc deletionStageTestScenario) {
+			deleteBackupRequest := &velerov1.DeleteBackupRequest{Status: sc.status}
+			gomega.Expect(deletionStageForDeleteBackupRequest(deleteBackupRequest)).To(gomega.Equal(sc.expectedStage))
+		},
+		ginkgo.Entry("not picked up by Velero", deletionStageTestScenario{
+			expectedStage: nacv1alpha1.NonAdminBackupDeletionStageDeleteBackupRequestCreated,
+		}),
+		ginkgo.Entry("new", deletionStageTestScenario{
+			status:        velerov1.DeleteBackupRequestStatus{Phase: velerov1.DeleteBackupRequestPhaseNew},
+			expectedStage: nacv1alpha1.NonAdminBackupDeletionStageDeleteBackupRequestCreated,
+		}),
+		ginkgo.Entry("in progress", deletionStageTestScenario{
+			status:        velerov1.DeleteBackupRequestStatus{Phase: velerov1.DeleteBackupRequestPhaseInProgress},
+			expectedStage: nacv1alpha1.NonAdminBackupDeletionStageBackupDataDeleting,
+		}),
+		ginkgo.Entry("processed", deletionStageTestScenario{
+			status:        velerov1.DeleteBackupRequestStatus{Phase: velerov1.DeleteBackupRequestPhaseProcessed},
+			expectedStage: nacv1alpha1.NonAdminBackupDeletionStageBackupDataDeleted,
+		}),
+		ginkgo.Entry("processed with errors", deletionStageTestScenario{
+			status: velerov1.DeleteBackupRequestStatus{
+				Phase:  velerov1.DeleteBackupRequestPhaseProcessed,
+				Errors: []string{"error deleting snapshot"},
+			},
+			expectedStage: nacv1alpha1.NonAdminBackupDeletionStageBackupDataDeletionFailed,
+		}),
+	)
+})
+
+// maxFailoverWrites is larger than the number of writes of the reconciles tested for leader failover
+const maxFailoverWrites = 12
+
+var errFailover = fmt.Errorf("leader failover")
+
+// newFailoverClient returns a client of the same objects as baseClient that fails every write after
+// the first writesBeforeFailover ones, as a controller losing its leadership in the middle of a reconcile.
+// If applyFailedWrite is set, the first failed write is done before its error is returned, as a write
+// whose response was lost.
+func newFailoverClient(baseClient client.WithWatch, writesBeforeFailover int, applyFailedWrite bool) client.WithWatch {
+	writes := 0
+	failover := func(write func() error) error {
+		writes++
+		if writes <= writesBeforeFailover {
+			return write()
+		}
+		if writes == writesBeforeFailover+1 && applyFailedWrite {
+			if err := write(); err != nil {
+				return err
+			}
+		}
+		return errFailover
+	}
+	return interceptor.NewClient(baseClient, interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			return failover(func() error { return c.Create(ctx, obj, opts...) })
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			return failover(func() error { return c.Update(ctx, obj, opts...) })
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			return failover(func() error { return c.Patch(ctx, obj, patch, opts...) })
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			return failover(func() error { return c.Delete(ctx, obj, opts...) })
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			return failover(func() error { return c.SubResource(subResourceName).Update(ctx, obj, opts...) })
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			return failover(func() error { return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...) })
+		},
+	})
+}
+
+// reconcileAfterFailover reconciles the object with the reconciler of the previous leader, which fails
+// after writesBeforeFailover writes, and then with the reconciler of the new leader, until it succeeds.
+func reconcileAfterFailover(previousLeader, newLeader reconcile.Reconciler, key types.NamespacedName) {
+	ctx := context.Background()
+	for range maxFailoverWrites {
+		if _, err := previousLeader.Reconcile(ctx, reconcile.Request{NamespacedName: key}); err != nil {
+			break
+		}
+	}
+
+	var result reconcile.Result
+	var err error
+	for range maxFailoverWrites {
+		result, err = newLeader.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		if err == nil && !result.Requeue {
+			break
+		}
+	}
+	gomega.Expect(err).ToNot(gomega.HaveOccurred())
+	gomega.Expect(result.Requeue).To(gomega.BeFalse())
+}
+
+var _ = ginkgo.Describe("Test NonAdminBackup reconcile after leader failover", func() {
+	const (
+		failoverNamespace     = "test-non-admin-backup-failover"
+		failoverOADPNamespace = "test-non-admin-backup-failover-oadp"
+		failoverName          = "non-admin-backup-failover"
+	)
+	key := types.NamespacedName{Namespace: failoverNamespace, Name: failoverName}
+
+	newReconciler := func(c client.Client) *NonAdminBackupReconciler {
+		return &NonAdminBackupReconciler{
+			Client:             c,
+			Scheme:             c.Scheme(),
+			OADPNamespace:      failoverOADPNamespace,
+			EnforcedBackupSpec: &velerov1.BackupSpec{},
+		}
+	}
+
+	ginkgo.DescribeTable("should create a single VeleroBackup",
+		func(applyFailedWrite bool) {
+			for writesBeforeFailover := range maxFailoverWrites {
+				ginkgo.By(fmt.Sprintf("failing over after %v writes", writesBeforeFailover))
+				baseClient := fake.NewClientBuilder().
+					WithScheme(k8sClient.Scheme()).
+					WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}).
+					WithObjects(&nacv1alpha1.NonAdminBackup{
+						ObjectMeta: metav1.ObjectMeta{Name: failoverName, Namespace: failoverNamespace},
+						Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+					}).
+					Build()
+
+				reconcileAfterFailover(
+					newReconciler(newFailoverClient(baseClient, writesBeforeFailover, applyFailedWrite)),
+					newReconciler(baseClient),
+					key,
+				)
+
+				veleroBackups := &velerov1.BackupList{}
+				gomega.Expect(baseClient.List(context.Background(), veleroBackups, client.InNamespace(failoverOADPNamespace))).To(gomega.Succeed())
+				gomega.Expect(veleroBackups.Items).To(gomega.HaveLen(1))
+
+				nonAdminBackup := &nacv1alpha1.NonAdminBackup{}
+				gomega.Expect(baseClient.Get(context.Background(), key, nonAdminBackup)).To(gomega.Succeed())
+				gomega.Expect(nonAdminBackup.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+				gomega.Expect(nonAdminBackup.VeleroBackupName()).To(gomega.Equal(veleroBackups.Items[0].Name))
+				gomega.Expect(nonAdminBackup.Status.VeleroBackup.NACUUID).To(gomega.Equal(veleroBackups.Items[0].Labels[constant.NabOriginNACUUIDLabel]))
+				gomega.Expect(controllerutil.ContainsFinalizer(nonAdminBackup, constant.NabFinalizerName)).To(gomega.BeTrue())
+			}
+		},
+		ginkgo.Entry("when the failed write is not done", false),
+		ginkgo.Entry("when the failed write is done", true),
+	)
+
+	ginkgo.DescribeTable("should create a single DeleteBackupRequest",
+		func(applyFailedWrite bool) {
+			for writesBeforeFailover := range maxFailoverWrites {
+				ginkgo.By(fmt.Sprintf("failing over after %v writes", writesBeforeFailover))
+				veleroBackupNACUUID := function.GenerateNacObjectUUID(failoverNamespace, failoverName)
+				baseClient := fake.NewClientBuilder().
+					WithScheme(k8sClient.Scheme()).
+					WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}).
+					WithObjects(
+						&nacv1alpha1.NonAdminBackup{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:       failoverName,
+								Namespace:  failoverNamespace,
+								Finalizers: []string{constant.NabFinalizerName},
+							},
+							Spec: nacv1alpha1.NonAdminBackupSpec{
+								BackupSpec:   &velerov1.BackupSpec{},
+								DeleteBackup: true,
+							},
+							Status: nacv1alpha1.NonAdminBackupStatus{
+								Phase: nacv1alpha1.NonAdminPhaseCreated,
+								VeleroBackup: &nacv1alpha1.VeleroBackup{
+									NACUUID:   veleroBackupNACUUID,
+									Namespace: failoverOADPNamespace,
+									Name:      veleroBackupNACUUID,
+								},
+							},
+						},
+						&velerov1.Backup{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      veleroBackupNACUUID,
+								Namespace: failoverOADPNamespace,
+								Labels: map[string]string{
+									constant.NabOriginNACUUIDLabel: veleroBackupNACUUID,
+								},
+							},
+						},
+					).
+					Build()
+
+				reconcileAfterFailover(
+					newReconciler(newFailoverClient(baseClient, writesBeforeFailover, applyFailedWrite)),
+					newReconciler(baseClient),
+					key,
+				)
+
+				deleteBackupRequests := &velerov1.DeleteBackupRequestList{}
+				gomega.Expect(baseClient.List(context.Background(), deleteBackupRequests, client.InNamespace(failoverOADPNamespace))).To(gomega.Succeed())
+				gomega.Expect(deleteBackupRequests.Items).To(gomega.HaveLen(1))
+
+				nonAdminBackup := &nacv1alpha1.NonAdminBackup{}
+				gomega.Expect(baseClient.Get(context.Background(), key, nonAdminBackup)).To(gomega.Succeed())
+				gomega.Expect(nonAdminBackup.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseDeleting))
+				gomega.Expect(nonAdminBackup.Status.VeleroDeleteBackupRequest).ToNot(gomega.BeNil())
+				gomega.Expect(nonAdminBackup.Status.VeleroDeleteBackupRequest.Name).To(gomega.Equal(deleteBackupRequests.Items[0].Name))
+			}
+		},
+		ginkgo.Entry("when the failed write is not done", false),
+		ginkgo.Entry("when the failed write is done", true),
+	)
+})
+
+var _ = ginkgo.Describe("podVolumeBackupFailures", func() {
+	newPodVolumeBackup := func(podNamespace, pod, volume string, phase velerov1.PodVolumeBackupPhase) velerov1.PodVolumeBackup {
+		return velerov1.PodVolumeBackup{
+			Spec: velerov1.PodVolumeBackupSpec{
+				Pod:    corev1.ObjectReference{Namespace: podNamespace, Name: pod},
+				Volume: volume,
+			},
+			Status: velerov1.PodVolumeBackupStatus{
+				Phase:   phase,
+				Message: fmt.Sprintf("%s/%s %s", podNamespace, pod, phase),
+			},
+		}
+	}
+
+	ginkgo.It("should list only failed PodVolumeBackups, ordered", func() {
+		failures := podVolumeBackupFailures(&velerov1.PodVolumeBackupList{Items: []velerov1.PodVolumeBackup{
+			newPodVolumeBackup("ns-b", "pod-a", "data", velerov1.PodVolumeBackupPhaseFailed),
+			newPodVolumeBackup("ns-a", "pod-b", "data", velerov1.PodVolumeBackupPhaseCompleted),
+			newPodVolumeBackup("ns-a", "pod-a", "logs", velerov1.PodVolumeBackupPhaseFailed),
+			newPodVolumeBackup("ns-a", "pod-a", "cache", velerov1.PodVolumeBackupPhaseFailed),
+			newPodVolumeBackup("ns-a", "pod-c", "data", velerov1.PodVolumeBackupPhaseInProgress),
+		}})
+		gomega.Expect(failures).To(gomega.Equal([]nacv1alpha1.PodVolumeBackupFailure{
+			{Namespace: "ns-a", Pod: "pod-a", Volume: "cache", Message: "ns-a/pod-a Failed"},
+			{Namespace: "ns-a", Pod: "pod-a", Volume: "logs", Message: "ns-a/pod-a Failed"},
+			{Namespace: "ns-b", Pod: "pod-a", Volume: "data", Message: "ns-b/pod-a Failed"},
+		}))
+	})
+
+	ginkgo.It("should cap the number of listed failures", func() {
+		podVolumeBackups := &velerov1.PodVolumeBackupList{}
+		for index := range maxPodVolumeBackupFailures + 5 {
+			podVolumeBackups.Items = append(podVolumeBackups.Items,
+				newPodVolumeBackup("ns", fmt.Sprintf("pod-%02d", index), "data", velerov1.PodVolumeBackupPhaseFailed))
+		}
+		failures := podVolumeBackupFailures(podVolumeBackups)
+		gomega.Expect(failures).To(gomega.HaveLen(maxPodVolumeBackupFailures))
+		gomega.Expect(failures[0].Pod).To(gomega.Equal("pod-00"))
+	})
+
+	ginkgo.It("should return nil without failed PodVolumeBackups", func() {
+		gomega.Expect(podVolumeBackupFailures(&velerov1.PodVolumeBackupList{})).To(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.Describe("updateNonAdminBackupDataUploadStatus", func() {
+	newDataUpload := func(phase velerov2alpha1.DataUploadPhase, bytesDone int64, totalBytes int64) velerov2alpha1.DataUpload {
+		return velerov2alpha1.DataUpload{
+			Status: velerov2alpha1.DataUploadStatus{
+				Phase:    phase,
+				Progress: shared.DataMoveOperationProgress{BytesDone: bytesDone, TotalBytes: totalBytes},
+			},
+		}
+	}
+
+	ginkgo.It("should aggregate transferred bytes and progress of DataUploads", func() {
+		status := &nacv1alpha1.NonAdminBackupStatus{}
+		updated := updateNonAdminBackupDataUploadStatus(status, &velerov2alpha1.DataUploadList{Items: []velerov2alpha1.DataUpload{
+			newDataUpload(velerov2alpha1.DataUploadPhaseCompleted, 300, 300),
+			newDataUpload(velerov2alpha1.DataUploadPhaseInProgress, 100, 500),
+			newDataUpload(velerov2alpha1.DataUploadPhaseNew, 0, 0),
+		}})
+		gomega.Expect(updated).To(gomega.BeTrue())
+		gomega.Expect(*status.DataMoverDataUploads).To(gomega.Equal(nacv1alpha1.DataMoverDataUploads{
+			Total:              3,
+			New:                1,
+			InProgress:         1,
+			Completed:          1,
+			TotalBytes:         800,
+			BytesDone:          400,
+			ProgressPercentage: 50,
+		}))
+	})
+
+	ginkgo.It("should not report an update when progress did not change", func() {
+		dataUploads := &velerov2alpha1.DataUploadList{Items: []velerov2alpha1.DataUpload{
+			newDataUpload(velerov2alpha1.DataUploadPhaseInProgress, 1, 3),
+		}}
+		status := &nacv1alpha1.NonAdminBackupStatus{}
+		gomega.Expect(updateNonAdminBackupDataUploadStatus(status, dataUploads)).To(gomega.BeTrue())
+		gomega.Expect(status.DataMoverDataUploads.ProgressPercentage).To(gomega.Equal(33))
+		gomega.Expect(updateNonAdminBackupDataUploadStatus(status, dataUploads)).To(gomega.BeFalse())
+	})
+
+	ginkgo.DescribeTable("dataMoverProgressPercentage",
+		func(bytesDone int64, totalBytes int64, expected int) {
+			gomega.Expect(dataMoverProgressPercentage(bytesDone, totalBytes)).To(gomega.Equal(expected))
+		},
+		ginkgo.Entry("without bytes to transfer", int64(0), int64(0), 0),
+		ginkgo.Entry("with some bytes transferred", int64(1), int64(4), 25),
+		ginkgo.Entry("with all bytes transferred", int64(4), int64(4), 100),
+		ginkgo.Entry("with more bytes transferred than reported total", int64(5), int64(4), 100),
+	)
+})
+
+var _ = ginkgo.Describe("inProgressRequeueAfter", func() {
+	const requeueAfter = 30 * time.Second
+
+	newNonAdminBackup := func(phase velerov1.BackupPhase) *nacv1alpha1.NonAdminBackup {
+		return &nacv1alpha1.NonAdminBackup{
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				VeleroBackup: &nacv1alpha1.VeleroBackup{
+					Status: &velerov1.BackupStatus{Phase: phase},
+				},
+			},
+		}
+	}
+
+	ginkgo.DescribeTable("should requeue only while the VeleroBackup is running",
+		func(phase velerov1.BackupPhase, expected time.Duration) {
+			r := &NonAdminBackupReconciler{InProgressRequeueAfter: requeueAfter}
+			gomega.Expect(r.inProgressRequeueAfter(newNonAdminBackup(phase))).To(gomega.Equal(expected))
+		},
+		ginkgo.Entry("New", velerov1.BackupPhaseNew, time.Duration(0)),
+		ginkgo.Entry("InProgress", velerov1.BackupPhaseInProgress, requeueAfter),
+		ginkgo.Entry("WaitingForPluginOperations", velerov1.BackupPhaseWaitingForPluginOperations, requeueAfter),
+		ginkgo.Entry("Finalizing", velerov1.BackupPhaseFinalizing, requeueAfter),
+		ginkgo.Entry("Completed", velerov1.BackupPhaseCompleted, time.Duration(0)),
+		ginkgo.Entry("Failed", velerov1.BackupPhaseFailed, time.Duration(0)),
+	)
+
+	ginkgo.It("should not requeue when disabled", func() {
+		r := &NonAdminBackupReconciler{}
+		gomega.Expect(r.inProgressRequeueAfter(newNonAdminBackup(velerov1.BackupPhaseInProgress))).To(gomega.BeZero())
+	})
+
+	ginkgo.It("should not requeue without VeleroBackup status", func() {
+		r := &NonAdminBackupReconciler{InProgressRequeueAfter: requeueAfter}
+		gomega.Expect(r.inProgressRequeueAfter(&nacv1alpha1.NonAdminBackup{})).To(gomega.BeZero())
+	})
+
+	ginkgo.It("should not requeue a NonAdminBackup being deleted", func() {
+		r := &NonAdminBackupReconciler{InProgressRequeueAfter: requeueAfter}
+		nab := newNonAdminBackup(velerov1.BackupPhaseInProgress)
+		nab.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		gomega.Expect(r.inProgressRequeueAfter(nab)).To(gomega.BeZero())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackup active deadline", func() {
+	const (
+		deadlineNamespace = "test-nonadminbackup-deadline"
+		deadlineName      = "test-nonadminbackup-deadline"
+		deadlineOADP      = "test-nonadminbackup-deadline-oadp"
+		veleroBackupName  = "test-nonadminbackup-deadline-velero"
+	)
+
+	newNonAdminBackup := func(activeDeadlineSeconds *int64, phase velerov1.BackupPhase, started time.Time) *nacv1alpha1.NonAdminBackup {
+		return &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: deadlineName, Namespace: deadlineNamespace},
+			Spec: nacv1alpha1.NonAdminBackupSpec{
+				BackupSpec:            &velerov1.BackupSpec{},
+				ActiveDeadlineSeconds: activeDeadlineSeconds,
+			},
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				VeleroBackup: &nacv1alpha1.VeleroBackup{
+					Name:   veleroBackupName,
+					Status: &velerov1.BackupStatus{Phase: phase, StartTimestamp: &metav1.Time{Time: started}},
+				},
+			},
+		}
+	}
+	newDataUpload := func(name string, phase velerov2alpha1.DataUploadPhase) *velerov2alpha1.DataUpload {
+		return &velerov2alpha1.DataUpload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: deadlineOADP,
+				Labels:    map[string]string{velerov1.BackupNameLabel: veleroBackupName},
+			},
+			Status: velerov2alpha1.DataUploadStatus{Phase: phase},
+		}
+	}
+
+	ginkgo.DescribeTable("validateActiveDeadline",
+		func(maxActiveDeadline time.Duration, activeDeadlineSeconds *int64, expectError bool) {
+			r := &NonAdminBackupReconciler{MaxActiveDeadline: maxActiveDeadline}
+			err := r.validateActiveDeadline(newNonAdminBackup(activeDeadlineSeconds, velerov1.BackupPhaseNew, time.Now()))
+			if expectError {
+				gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("spec.activeDeadlineSeconds is restricted")))
+			} else {
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+		},
+		ginkgo.Entry("without maximum", time.Duration(0), ptr.To[int64](3600), false),
+		ginkgo.Entry("without deadline", time.Hour, nil, false),
+		ginkgo.Entry("within maximum", time.Hour, ptr.To[int64](3600), false),
+		ginkgo.Entry("exceeding maximum", time.Hour, ptr.To[int64](3601), true),
+	)
+
+	ginkgo.It("should use the admin maximum as default deadline", func() {
+		r := &NonAdminBackupReconciler{MaxActiveDeadline: time.Hour}
+		gomega.Expect(r.activeDeadline(newNonAdminBackup(nil, velerov1.BackupPhaseInProgress, time.Now()))).To(gomega.Equal(time.Hour))
+		gomega.Expect(r.activeDeadline(newNonAdminBackup(ptr.To[int64](60), velerov1.BackupPhaseInProgress, time.Now()))).To(gomega.Equal(time.Minute))
+	})
+
+	ginkgo.It("should requeue until the deadline of a running VeleroBackup", func() {
+		r := &NonAdminBackupReconciler{}
+		nab := newNonAdminBackup(ptr.To[int64](60), velerov1.BackupPhaseInProgress, time.Now().Add(-30*time.Second))
+		gomega.Expect(r.activeDeadlineRequeueAfter(nab)).To(gomega.BeNumerically("~", 30*time.Second, time.Second))
+
+		nab.Status.VeleroBackup.Status.Phase = velerov1.BackupPhaseCompleted
+		gomega.Expect(r.activeDeadlineRequeueAfter(nab)).To(gomega.BeZero())
+	})
+
+	ginkgo.It("should cancel running DataUploads and set DeadlineExceeded once the deadline is exceeded", func() {
+		nab := newNonAdminBackup(ptr.To[int64](60), velerov1.BackupPhaseWaitingForPluginOperations, time.Now().Add(-time.Hour))
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}).
+			WithObjects(
+				nab,
+				newDataUpload("in-progress", velerov2alpha1.DataUploadPhaseInProgress),
+				newDataUpload("completed", velerov2alpha1.DataUploadPhaseCompleted),
+			).
+			Build()
+		r := &NonAdminBackupReconciler{Client: fakeClient, OADPNamespace: deadlineOADP}
+
+		requeue, err := r.enforceActiveDeadline(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(meta.IsStatusConditionTrue(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionDeadlineExceeded))).To(gomega.BeTrue())
+
+		dataUploads := &velerov2alpha1.DataUploadList{}
+		gomega.Expect(fakeClient.List(context.Background(), dataUploads, client.InNamespace(deadlineOADP))).To(gomega.Succeed())
+		for _, dataUpload := range dataUploads.Items {
+			gomega.Expect(dataUpload.Spec.Cancel).To(gomega.Equal(dataUpload.Name == "in-progress"), dataUpload.Name)
+		}
+		gomega.Expect(r.activeDeadlineRequeueAfter(nab)).To(gomega.BeZero())
+	})
+
+	ginkgo.It("should not cancel DataUploads before the deadline", func() {
+		nab := newNonAdminBackup(ptr.To[int64](3600), velerov1.BackupPhaseInProgress, time.Now())
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}).
+			WithObjects(nab, newDataUpload("in-progress", velerov2alpha1.DataUploadPhaseInProgress)).
+			Build()
+		r := &NonAdminBackupReconciler{Client: fakeClient, OADPNamespace: deadlineOADP}
+
+		_, err := r.enforceActiveDeadline(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(nab.Status.Conditions).To(gomega.BeEmpty())
+
+		dataUpload := &velerov2alpha1.DataUpload{}
+		gomega.Expect(fakeClient.Get(context.Background(), types.NamespacedName{Namespace: deadlineOADP, Name: "in-progress"}, dataUpload)).To(gomega.Succeed())
+		gomega.Expect(dataUpload.Spec.Cancel).To(gomega.BeFalse())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackup restore verification", func() {
+	const (
+		verificationNamespace = "test-nonadminbackup-verification"
+		verificationName      = "test-nonadminbackup-verification"
+	)
+
+	newReconciler := func(objects ...client.Object) (*NonAdminBackupReconciler, *nacv1alpha1.NonAdminBackup) {
+		nab := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: verificationName, Namespace: verificationNamespace, UID: "test-uid"},
+			Spec: nacv1alpha1.NonAdminBackupSpec{
+				BackupSpec:    &velerov1.BackupSpec{},
+				VerifyRestore: true,
+			},
+			Status: nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseCompleted},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}, &nacv1alpha1.NonAdminRestore{}).
+			WithObjects(append(objects, nab)...).
+			Build()
+		return &NonAdminBackupReconciler{Client: fakeClient, Scheme: k8sClient.Scheme()}, nab
+	}
+	setNonAdminRestorePhase := func(r *NonAdminBackupReconciler, nab *nacv1alpha1.NonAdminBackup, phase nacv1alpha1.NonAdminPhase, conditions ...metav1.Condition) {
+		nar := &nacv1alpha1.NonAdminRestore{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{
+			Namespace: verificationNamespace, Name: nab.Status.RestoreVerification.NonAdminRestore,
+		}, nar)).To(gomega.Succeed())
+		nar.Status.Phase = phase
+		nar.Status.Conditions = conditions
+		gomega.Expect(r.Status().Update(context.Background(), nar)).To(gomega.Succeed())
+	}
+
+	ginkgo.It("should restore the backup into a temporary namespace and clean it up once it completed", func() {
+		r, nab := newReconciler()
+
+		requeue, err := r.verifyRestore(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		verification := nab.Status.RestoreVerification
+		gomega.Expect(verification).NotTo(gomega.BeNil())
+		gomega.Expect(verification.Namespace).To(gomega.HavePrefix("verify-" + verificationNamespace[:10]))
+		gomega.Expect(meta.IsStatusConditionPresentAndEqual(nab.Status.Conditions,
+			string(nacv1alpha1.NonAdminConditionRestoreVerified), metav1.ConditionUnknown)).To(gomega.BeTrue())
+
+		namespace := &corev1.Namespace{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Name: verification.Namespace}, namespace)).To(gomega.Succeed())
+		gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue(constant.RestoreVerificationNamespaceLabel, verificationNamespace))
+		gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("pod-security.kubernetes.io/enforce", "restricted"))
+		gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("pod-security.kubernetes.io/enforce-version", "latest"))
+		nar := &nacv1alpha1.NonAdminRestore{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: verificationNamespace, Name: verification.NonAdminRestore}, nar)).To(gomega.Succeed())
+		gomega.Expect(nar.Spec.RestoreSpec.BackupName).To(gomega.Equal(verificationName))
+		gomega.Expect(nar.Spec.RestoreSpec.NamespaceMapping).To(gomega.Equal(map[string]string{verificationNamespace: verification.Namespace}))
+		gomega.Expect(nar.OwnerReferences).To(gomega.HaveLen(1))
+
+		setNonAdminRestorePhase(r, nab, nacv1alpha1.NonAdminPhaseCreated)
+		requeue, err = r.verifyRestore(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(nab.Status.RestoreVerification.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+
+		setNonAdminRestorePhase(r, nab, nacv1alpha1.NonAdminPhaseCompleted)
+		requeue, err = r.verifyRestore(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(meta.IsStatusConditionTrue(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionRestoreVerified))).To(gomega.BeTrue())
+		gomega.Expect(nab.Status.RestoreVerification.CleanedUp).To(gomega.BeTrue())
+		gomega.Expect(errors.IsNotFound(r.Get(context.Background(), types.NamespacedName{Name: verification.Namespace}, namespace))).To(gomega.BeTrue())
+		gomega.Expect(errors.IsNotFound(r.Get(context.Background(), types.NamespacedName{Namespace: verificationNamespace, Name: verification.NonAdminRestore}, nar))).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should report why the restore verification failed", func() {
+		r, nab := newReconciler()
+
+		_, err := r.verifyRestore(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		setNonAdminRestorePhase(r, nab, nacv1alpha1.NonAdminPhaseBackingOff, metav1.Condition{
+			Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			Reason:             "InvalidRestoreSpec",
+			Message:            "restore is invalid",
+			LastTransitionTime: metav1.Now(),
+		})
+		_, err = r.verifyRestore(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		condition := meta.FindStatusCondition(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionRestoreVerified))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("VerificationRestoreFailed"))
+		gomega.Expect(condition.Message).To(gomega.HaveSuffix("ended in phase BackingOff: restore is invalid"))
+		gomega.Expect(nab.Status.RestoreVerification.CleanedUp).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should not verify a NonAdminBackup that did not complete", func() {
+		r, nab := newReconciler()
+		nab.Status.Phase = nacv1alpha1.NonAdminPhaseCreated
+
+		requeue, err := r.verifyRestore(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nab.Status.RestoreVerification).To(gomega.BeNil())
+	})
+
+	ginkgo.It("should only delete the temporary namespace created for the NonAdminBackup", func() {
+		r, nab := newReconciler(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "not-verification"}})
+		nab.Status.RestoreVerification = &nacv1alpha1.RestoreVerification{Namespace: "not-verification", NonAdminRestore: "not-verification"}
+
+		_, err := r.deleteRestoreVerification(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Name: "not-verification"}, &corev1.Namespace{})).To(gomega.Succeed())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackup multi namespace backup approval", func() {
+	const (
+		approvalNamespace     = "test-nonadminbackup-approval"
+		approvalOADPNamespace = "test-nonadminbackup-approval-oadp"
+	)
+
+	newReconciler := func() (*NonAdminBackupReconciler, *nacv1alpha1.NonAdminBackup) {
+		nab := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminbackup-approval", Namespace: approvalNamespace},
+			Spec: nacv1alpha1.NonAdminBackupSpec{
+				BackupSpec: &velerov1.BackupSpec{IncludedNamespaces: []string{approvalNamespace, "other"}},
+			},
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				Phase:        nacv1alpha1.NonAdminPhaseNew,
+				VeleroBackup: &nacv1alpha1.VeleroBackup{NACUUID: "test-nonadminbackup-approval-uuid"},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}, &nacv1alpha1.NonAdminApprovalRequest{}).
+			WithObjects(nab).
+			Build()
+		return &NonAdminBackupReconciler{
+			Client:        fakeClient,
+			Scheme:        k8sClient.Scheme(),
+			OADPNamespace: approvalOADPNamespace,
+			MultiNamespaceBackupApproval: &approval.Engine{
+				Client:        fakeClient,
+				OADPNamespace: approvalOADPNamespace,
+			},
+		}, nab
+	}
+	setApprovalDecision := func(r *NonAdminBackupReconciler, decision nacv1alpha1.NonAdminApprovalDecision) {
+		approvalRequest := &nacv1alpha1.NonAdminApprovalRequest{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{
+			Namespace: approvalOADPNamespace,
+			Name:      "multi-namespace-backup-test-nonadminbackup-approval-uuid",
+		}, approvalRequest)).To(gomega.Succeed())
+		approvalRequest.Spec.ApprovalDecision = decision
+		approvalRequest.Spec.Reason = "checked with the team"
+		gomega.Expect(r.Update(context.Background(), approvalRequest)).To(gomega.Succeed())
+	}
+
+	ginkgo.It("should wait for the approval of the cluster admin", func() {
+		r, nab := newReconciler()
+
+		_, err := r.requestMultiNamespaceBackupApproval(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).To(gomega.MatchError(reconcile.TerminalError(nil)))
+		gomega.Expect(meta.IsStatusConditionFalse(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionApproved))).To(gomega.BeTrue())
+		gomega.Expect(nab.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseNew))
+
+		setApprovalDecision(r, nacv1alpha1.NonAdminApprovalDecisionApprove)
+		_, err = r.requestMultiNamespaceBackupApproval(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		condition := meta.FindStatusCondition(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionApproved))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+		gomega.Expect(condition.Message).To(gomega.HaveSuffix(": checked with the team"))
+
+		_, err = r.deleteMultiNamespaceBackupApprovalRequest(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		approvalRequests := &nacv1alpha1.NonAdminApprovalRequestList{}
+		gomega.Expect(r.List(context.Background(), approvalRequests)).To(gomega.Succeed())
+		gomega.Expect(approvalRequests.Items).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should move the NonAdminBackup to BackingOff when the cluster admin rejects it", func() {
+		r, nab := newReconciler()
+
+		_, err := r.requestMultiNamespaceBackupApproval(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+		setApprovalDecision(r, nacv1alpha1.NonAdminApprovalDecisionReject)
+		_, err = r.requestMultiNamespaceBackupApproval(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+		gomega.Expect(nab.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+		condition := meta.FindStatusCondition(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionApproved))
+		gomega.Expect(condition.Reason).To(gomega.Equal("ApprovalRejected"))
+	})
+
+	ginkgo.It("should not request approval for a single namespace backup", func() {
+		r, nab := newReconciler()
+		nab.Spec.BackupSpec.IncludedNamespaces = []string{approvalNamespace}
+
+		_, err := r.requestMultiNamespaceBackupApproval(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(nab.Status.Conditions).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.DescribeTable("validateParallelFilesUpload",
+	func(maxParallelFilesUpload int, uploaderConfig *velerov1.UploaderConfigForBackup, expectError bool) {
+		r := &NonAdminBackupReconciler{MaxParallelFilesUpload: maxParallelFilesUpload}
+		err := r.validateParallelFilesUpload(&nacv1alpha1.NonAdminBackup{
+			Spec: nacv1alpha1.NonAdminBackupSpec{
+				BackupSpec: &velerov1.BackupSpec{UploaderConfig: uploaderConfig},
+			},
+		})
+		if expectError {
+			gomega.Expect(err).To(gomega.MatchError("NonAdminBackup spec.backupSpec.uploaderConfig.parallelFilesUpload is restricted, can not exceed 4"))
+		} else {
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		}
+	},
+	ginkgo.Entry("without maximum", 0, &velerov1.UploaderConfigForBackup{ParallelFilesUpload: 32}, false),
+	ginkgo.Entry("without uploader config", 4, nil, false),
+	ginkgo.Entry("without parallel files upload", 4, &velerov1.UploaderConfigForBackup{}, false),
+	ginkgo.Entry("within maximum", 4, &velerov1.UploaderConfigForBackup{ParallelFilesUpload: 4}, false),
+	ginkgo.Entry("exceeding maximum", 4, &velerov1.UploaderConfigForBackup{ParallelFilesUpload: 5}, true),
+)
+
+var _ = ginkgo.DescribeTable("updateNonAdminBackupSnapshotMoveDataStatus",
+	func(requested, used *bool, expected nacv1alpha1.SnapshotMoveData) {
+		status := &nacv1alpha1.NonAdminBackupStatus{}
+		veleroBackup := &velerov1.Backup{Spec: velerov1.BackupSpec{SnapshotMoveData: used}}
+		backupSpec := &velerov1.BackupSpec{SnapshotMoveData: requested}
+
+		gomega.Expect(updateNonAdminBackupSnapshotMoveDataStatus(status, backupSpec, veleroBackup)).To(gomega.BeTrue())
+		gomega.Expect(*status.SnapshotMoveData).To(gomega.Equal(expected))
+		gomega.Expect(updateNonAdminBackupSnapshotMoveDataStatus(status, backupSpec, veleroBackup)).To(gomega.BeFalse())
+	},
+	ginkgo.Entry("not set", nil, nil, nacv1alpha1.SnapshotMoveData{}),
+	ginkgo.Entry("set by the user", ptr.To(true), ptr.To(true), nacv1alpha1.SnapshotMoveData{Enabled: true}),
+	ginkgo.Entry("defaulted by the admin", nil, ptr.To(true), nacv1alpha1.SnapshotMoveData{Enabled: true}),
+	ginkgo.Entry("forced by the admin", ptr.To(true), ptr.To(false), nacv1alpha1.SnapshotMoveData{Overridden: true}),
+)
+
+var _ = ginkgo.Describe("updateNonAdminBackupEnforcedFieldsStatus", func() {
+	ginkgo.It("should list the enforced fields in the SpecOverridden condition", func() {
+		status := &nacv1alpha1.NonAdminBackupStatus{}
+		gomega.Expect(updateNonAdminBackupEnforcedFieldsStatus(status, []string{"ttl", "includedNamespaces"})).To(gomega.BeTrue())
+		gomega.Expect(status.EnforcedFields).To(gomega.Equal([]string{"ttl", "includedNamespaces"}))
+		condition := meta.FindStatusCondition(status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+		gomega.Expect(condition.Message).To(gomega.Equal("spec.backupSpec fields set or overridden in the Velero Backup: ttl, includedNamespaces"))
+
+		gomega.Expect(updateNonAdminBackupEnforcedFieldsStatus(status, []string{"ttl", "includedNamespaces"})).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("should clear the SpecOverridden condition without enforced fields", func() {
+		status := &nacv1alpha1.NonAdminBackupStatus{}
+		gomega.Expect(updateNonAdminBackupEnforcedFieldsStatus(status, nil)).To(gomega.BeFalse())
+
+		gomega.Expect(updateNonAdminBackupEnforcedFieldsStatus(status, []string{"ttl"})).To(gomega.BeTrue())
+		gomega.Expect(updateNonAdminBackupEnforcedFieldsStatus(status, nil)).To(gomega.BeTrue())
+		gomega.Expect(status.EnforcedFields).To(gomega.BeNil())
+		gomega.Expect(status.Conditions).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should not list a field twice", func() {
+		gomega.Expect(appendEnforcedField([]string{"snapshotMoveData"}, "snapshotMoveData")).To(gomega.Equal([]string{"snapshotMoveData"}))
+		gomega.Expect(appendEnforcedField([]string{"ttl"}, "snapshotMoveData")).To(gomega.Equal([]string{"ttl", "snapshotMoveData"}))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackup with a missing VeleroBackup", func() {
+	const (
+		missingNamespace = "test-nonadminbackup-missing"
+		missingOADP      = "test-nonadminbackup-missing-oadp"
+		missingNACUUID   = "test-nonadminbackup-missing-nacuuid"
+	)
+
+	newNonAdminBackup := func(recreate bool, completed bool) *nacv1alpha1.NonAdminBackup {
+		nab := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminbackup-missing", Namespace: missingNamespace},
+			Spec: nacv1alpha1.NonAdminBackupSpec{
+				BackupSpec:                    &velerov1.BackupSpec{},
+				RecreateOnMissingVeleroBackup: recreate,
+			},
+			Status: nacv1alpha1.NonAdminBackupStatus{
+				Phase: nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackup: &nacv1alpha1.VeleroBackup{
+					NACUUID:   missingNACUUID,
+					Name:      missingNACUUID,
+					Namespace: missingOADP,
+					Status:    &velerov1.BackupStatus{Phase: velerov1.BackupPhaseInProgress},
+				},
+				Conditions: []metav1.Condition{
+					{Type: string(nacv1alpha1.NonAdminConditionQueued), Status: metav1.ConditionTrue, Reason: "BackupScheduled"},
+				},
+			},
+		}
+		if completed {
+			nab.Status.VeleroBackup.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+		return nab
+	}
+
+	newReconciler := func(nab *nacv1alpha1.NonAdminBackup) *NonAdminBackupReconciler {
+		return &NonAdminBackupReconciler{
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackup{}).
+				WithObjects(nab).
+				Build(),
+			OADPNamespace: missingOADP,
+		}
+	}
+
+	ginkgo.It("should reference a new VeleroBackup when opted in", func() {
+		nab := newNonAdminBackup(true, false)
+		r := newReconciler(nab)
+
+		requeue, err := r.createVeleroBackupAndSyncWithNonAdminBackup(context.Background(), logr.Discard(), nab)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(nab.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseNew))
+		gomega.Expect(nab.Status.VeleroBackup.NACUUID).NotTo(gomega.BeEmpty())
+		gomega.Expect(nab.Status.VeleroBackup.NACUUID).NotTo(gomega.Equal(missingNACUUID))
+		gomega.Expect(nab.Status.VeleroBackup.Name).To(gomega.Equal(nab.Status.VeleroBackup.NACUUID))
+		gomega.Expect(nab.Status.VeleroBackup.Status).To(gomega.BeNil())
+		gomega.Expect(nab.Status.Conditions).To(gomega.BeEmpty())
+	})
+
+	ginkgo.DescribeTable("should move the NonAdminBackup to BackingOff",
+		func(recreate bool, completed bool) {
+			nab := newNonAdminBackup(recreate, completed)
+			r := newReconciler(nab)
+
+			_, err := r.createVeleroBackupAndSyncWithNonAdminBackup(context.Background(), logr.Discard(), nab)
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("Velero Backup has been removed")))
+			gomega.Expect(nab.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+			gomega.Expect(nab.Status.VeleroBackup.NACUUID).To(gomega.Equal(missingNACUUID))
+		},
+		ginkgo.Entry("when not opted in", false, false),
+		ginkgo.Entry("when the VeleroBackup completed", true, true),
+	)
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackup with a NonAdminControllerConfig", func() {
+	const configNamespace = "test-nonadminbackup-config"
+
+	newReconciler := func(objects ...client.Object) *NonAdminBackupReconciler {
+		return &NonAdminBackupReconciler{
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: configNamespace}}).
+				WithObjects(objects...).
+				Build(),
+			DeletionTimeout:  time.Hour,
+			DisableExecHooks: true,
+		}
+	}
+	config := &nacv1alpha1.NonAdminControllerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.NonAdminControllerConfigName},
+		Spec: nacv1alpha1.NonAdminControllerConfigSpec{
+			Backup: &nacv1alpha1.NonAdminControllerBackupConfig{
+				DeletionTimeout:                 &metav1.Duration{Duration: time.Minute},
+				RequireDeleteBackupConfirmation: ptr.To(true),
+			},
+			AllowedFeatures: &nacv1alpha1.NonAdminPolicyFeatures{
+				ExecHooks:           ptr.To(true),
+				RestoreVerification: ptr.To(true),
+			},
+		},
+	}
+
+	ginkgo.It("should keep the NAC flags without NonAdminControllerConfig", func() {
+		configReconciler, err := newReconciler().withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(configReconciler.DeletionTimeout).To(gomega.Equal(time.Hour))
+		gomega.Expect(configReconciler.DisableExecHooks).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should override the NAC flags with the NonAdminControllerConfig", func() {
+		configReconciler, err := newReconciler(config.DeepCopy()).withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(configReconciler.DeletionTimeout).To(gomega.Equal(time.Minute))
+		gomega.Expect(configReconciler.RequireDeleteBackupConfirmation).To(gomega.BeTrue())
+		gomega.Expect(configReconciler.DisableExecHooks).To(gomega.BeFalse())
+		gomega.Expect(configReconciler.AllowRestoreVerification).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should ignore a NonAdminControllerConfig of another name", func() {
+		otherConfig := config.DeepCopy()
+		otherConfig.Name = "other"
+		configReconciler, err := newReconciler(otherConfig).withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(configReconciler.DeletionTimeout).To(gomega.Equal(time.Hour))
+	})
+
+	ginkgo.It("should reject the multi namespace backups it allows when the NonAdminBackup webhooks are not served", func() {
+		multiNamespaceConfig := config.DeepCopy()
+		multiNamespaceConfig.Spec.AllowedFeatures.MultiNamespaceBackups = ptr.To(true)
+		configReconciler, err := newReconciler(multiNamespaceConfig).withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(configReconciler.AllowMultiNamespaceBackups).To(gomega.BeTrue())
+
+		err = configReconciler.validateBackupSpec(context.Background(), &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-nonadminbackup-config-multi-namespace",
+				Namespace:   configNamespace,
+				Annotations: map[string]string{constant.NabRequesterUsernameAnnotation: "cluster-admin"},
+			},
+			Spec: nacv1alpha1.NonAdminBackupSpec{
+				BackupSpec: &velerov1.BackupSpec{IncludedNamespaces: []string{configNamespace, "other-tenant"}},
+			},
+		})
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(
+			"multi namespace backups require the NonAdminBackup webhooks recording the requester")))
+	})
+
+	ginkgo.It("should let the NonAdminPolicy of the namespace override the NonAdminControllerConfig", func() {
+		policy := &nacv1alpha1.NonAdminPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminbackup-config-policy"},
+			Spec: nacv1alpha1.NonAdminPolicySpec{
+				AllowedFeatures: &nacv1alpha1.NonAdminPolicyFeatures{ExecHooks: ptr.To(false)},
+			},
+		}
+		policyReconciler, err := newReconciler(config.DeepCopy(), policy).withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(policyReconciler.DeletionTimeout).To(gomega.Equal(time.Minute))
+		gomega.Expect(policyReconciler.DisableExecHooks).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should merge the enforced backup spec fields of the NonAdminControllerConfig and NonAdminPolicy over the NAC flags", func() {
+		enforcedConfig := config.DeepCopy()
+		enforcedConfig.Spec.Backup.EnforceBackupSpec = &velerov1.BackupSpec{
+			DefaultVolumesToFsBackup: ptr.To(true),
+			TTL:                      metav1.Duration{Duration: 48 * time.Hour},
+		}
+		policy := &nacv1alpha1.NonAdminPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminbackup-config-enforced-policy"},
+			Spec: nacv1alpha1.NonAdminPolicySpec{
+				EnforceBackupSpec: &velerov1.BackupSpec{SnapshotVolumes: ptr.To(false)},
+			},
+		}
+		reconciler := newReconciler(enforcedConfig, policy)
+		reconciler.EnforcedBackupSpec = &velerov1.BackupSpec{
+			StorageLocation: "default",
+			TTL:             metav1.Duration{Duration: time.Hour},
+		}
+
+		policyReconciler, err := reconciler.withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(policyReconciler.EnforcedBackupSpec).To(gomega.Equal(&velerov1.BackupSpec{
+			StorageLocation:          "default",
+			TTL:                      metav1.Duration{Duration: 48 * time.Hour},
+			DefaultVolumesToFsBackup: ptr.To(true),
+			SnapshotVolumes:          ptr.To(false),
+		}))
+		gomega.Expect(reconciler.EnforcedBackupSpec).To(gomega.Equal(&velerov1.BackupSpec{
+			StorageLocation: "default",
+			TTL:             metav1.Duration{Duration: time.Hour},
+		}))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackup multi namespace backups allowed by a NonAdminPolicy", func() {
+	const policyNamespace = "test-nonadminbackup-policy-multi-namespace"
+
+	policy := &nacv1alpha1.NonAdminPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminbackup-policy-multi-namespace"},
+		Spec: nacv1alpha1.NonAdminPolicySpec{
+			AllowedFeatures: &nacv1alpha1.NonAdminPolicyFeatures{MultiNamespaceBackups: ptr.To(true)},
+		},
+	}
+	// requester annotations written by the user, as no webhook records them
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-nonadminbackup-policy-multi-namespace",
+			Namespace: policyNamespace,
+			Annotations: map[string]string{
+				constant.NabRequesterUsernameAnnotation: "cluster-admin",
+			},
+		},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: &velerov1.BackupSpec{IncludedNamespaces: []string{policyNamespace, "other-tenant"}},
+		},
+	}
+	newReconciler := func(requesterWebhookServed bool) *NonAdminBackupReconciler {
+		return &NonAdminBackupReconciler{
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: policyNamespace}}, policy.DeepCopy()).
+				Build(),
+			RequesterWebhookServed: requesterWebhookServed,
+		}
+	}
+
+	ginkgo.It("should reject multi namespace backups when the NonAdminBackup webhooks are not served", func() {
+		policyReconciler, err := newReconciler(false).withNonAdminPolicy(context.Background(), policyNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(policyReconciler.AllowMultiNamespaceBackups).To(gomega.BeTrue())
+
+		err = policyReconciler.validateBackupSpec(context.Background(), nab.DeepCopy())
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(
+			"multi namespace backups require the NonAdminBackup webhooks recording the requester")))
+	})
+
+	ginkgo.It("should not trust the requester annotations when the NonAdminBackup webhooks are not configured", func() {
+		policyReconciler, err := newReconciler(true).withNonAdminPolicy(context.Background(), policyNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		err = policyReconciler.validateBackupSpec(context.Background(), nab.DeepCopy())
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("requester identity can not be trusted")))
+	})
+})
@@ -19,7 +19,11 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -33,6 +37,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -44,6 +50,8 @@ import (
 	"github.com/migtools/oadp-non-admin/internal/common/function"
 	"github.com/migtools/oadp-non-admin/internal/handler"
 	"github.com/migtools/oadp-non-admin/internal/predicate"
+	"github.com/migtools/oadp-non-admin/internal/startup"
+	"github.com/migtools/oadp-non-admin/internal/validationhook"
 )
 
 const (
@@ -55,15 +63,54 @@ const (
 	failedUpdateConditionError  = "Failed to update status condition"
 )
 
+// maxDeletionBlockingReferences is the number of objects listed in the DeletionBlocked condition message
+const maxDeletionBlockingReferences = 10
+
 // NonAdminBackupStorageLocationReconciler reconciles a NonAdminBackupStorageLocation object
 type NonAdminBackupStorageLocationReconciler struct {
 	client.Client
-	Scheme                *runtime.Scheme
-	EnforcedBslSpec       *oadpv1alpha1.EnforceBackupStorageLocationSpec
-	DefaultSyncPeriod     *time.Duration
+	Scheme            *runtime.Scheme
+	Recorder          record.EventRecorder
+	EnforcedBslSpec   *oadpv1alpha1.EnforceBackupStorageLocationSpec
+	DefaultSyncPeriod *time.Duration
+	// ValidationHook adds the cluster admin site-specific rules to the spec validation, nil disables it
+	ValidationHook *validationhook.Hook
+	// StartupBackpressure staggers the initial reconciles when the controller starts, nil disables it
+	StartupBackpressure   *startup.Backpressure
 	OADPNamespace         string
 	RequireApprovalForBSL bool
 	SyncPeriod            time.Duration
+	// AutoApprovalConfigMap is the name of a ConfigMap, in the OADP namespace, listing the rules approving
+	// NonAdminBackupStorageLocationRequests without the cluster admin when RequireApprovalForBSL is set.
+	// Empty requires the cluster admin to approve every request.
+	AutoApprovalConfigMap string
+	// ValidationDeadline is the time Velero has to validate the VeleroBackupStorageLocation, after which the
+	// ObjectStorageAvailable condition is set to False. Zero waits for Velero indefinitely.
+	ValidationDeadline time.Duration
+	// StorageUsagePeriod is the period the backup summary of the NonAdminBackupStorageLocations, including their
+	// storage usage, is computed again at, even if their VeleroBackupStorageLocation did not change. Zero only
+	// computes it when the VeleroBackupStorageLocation changes.
+	StorageUsagePeriod time.Duration
+	// PrefixTemplate is the objectStorage prefix put in front of the NonAdminBackupStorageLocation prefix in the
+	// VeleroBackupStorageLocation, once its {namespace} and {name} placeholders are rendered. Empty uses the namespace.
+	PrefixTemplate string
+	// AllowedProviders restricts the providers NonAdminBackupStorageLocations may use, empty allows any of them
+	AllowedProviders []string
+	// AllowedS3URLs restricts, with shell patterns, the s3Url config of NonAdminBackupStorageLocations using the
+	// aws provider, empty allows any endpoint
+	AllowedS3URLs []string
+	// MinBackupSyncPeriod is the minimum backupSyncPeriod of the VeleroBackupStorageLocations, applied to the ones
+	// not setting it, so the object storage is not listed too often. Zero sets no minimum.
+	MinBackupSyncPeriod time.Duration
+	// MinValidationFrequency is the minimum validationFrequency of the VeleroBackupStorageLocations, applied to the
+	// ones not setting it. Zero sets no minimum.
+	MinValidationFrequency time.Duration
+	// AllowCloudIdentity allows NonAdminBackupStorageLocations to use short-lived credentials of the Velero
+	// workload identity, set in their spec.cloudIdentity, in place of a credential Secret
+	AllowCloudIdentity bool
+	// DisableDriftRevert keeps the fields of the VeleroBackupStorageLocations modified out-of-band, only reporting
+	// them in the ConfigDrift condition, instead of reverting them to the NonAdminBackupStorageLocation spec
+	DisableDriftRevert bool
 }
 
 type naBSLReconcileStepFunction func(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error)
@@ -71,6 +118,8 @@ type naBSLReconcileStepFunction func(ctx context.Context, logger logr.Logger, na
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupstoragelocations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupstoragelocations/status,verbs=get;update;patch
@@ -107,6 +156,8 @@ func (r *NonAdminBackupStorageLocationReconciler) Reconcile(ctx context.Context,
 		logger.V(1).Info("Executing direct deletion path")
 		reconcileSteps = []naBSLReconcileStepFunction{
 			r.initNaBSLDelete,
+			r.releaseTransferredNaBSL,
+			r.checkNaBSLReferences,
 			r.deleteNonAdminRequest,
 			r.deleteVeleroBSLSecret,
 			r.deleteVeleroBSL,
@@ -123,9 +174,11 @@ func (r *NonAdminBackupStorageLocationReconciler) Reconcile(ctx context.Context,
 			r.createNonAdminRequest,
 			r.setFinalizerOnNaBSL,
 			r.ensureNonAdminRequest,
+			r.transferNaBSL,
 			r.syncSecrets,
 			r.createVeleroBSL,
 			r.syncStatus,
+			r.checkObjectStorageAvailability,
 		}
 	}
 
@@ -140,17 +193,21 @@ func (r *NonAdminBackupStorageLocationReconciler) Reconcile(ctx context.Context,
 	}
 
 	logger.V(1).Info("NonAdminBackupStorageLocation Reconcile exit")
+	requeueAfter := r.validationDeadlineRequeueAfter(nabsl)
+	if storageUsageRequeueAfter := r.storageUsageRequeueAfter(nabsl); storageUsageRequeueAfter > 0 &&
+		(requeueAfter <= 0 || storageUsageRequeueAfter < requeueAfter) {
+		requeueAfter = storageUsageRequeueAfter
+	}
+	if requeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
-// Note: Adding Secret Watch within the namespace is being considered.
-// Challenges with Secret Watch:
-//   - Secret updates without NaBSL object updates would be missed
-//   - One secret can be used by multiple NaBSL objects
-//   - Would need to add VeleroBackupStorageLocation UUID labels/annotations
-//     to ensure correct Secret-to-NaBSL mapping or get all the NaBSL objects and check
-//     if that particular secret is being used by any of them.
+// Note: Secrets are watched within the NaBSL namespaces. On creation, or when their
+// data changes, every NaBSL of the namespace using the Secret as credential is reconciled,
+// which syncs the Secret to the OADP namespace and has Velero validate the VeleroBackupStorageLocation again.
 func (r *NonAdminBackupStorageLocationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&nacv1alpha1.NonAdminBackupStorageLocation{}).
@@ -161,12 +218,19 @@ func (r *NonAdminBackupStorageLocationReconciler) SetupWithManager(mgr ctrl.Mana
 					OADPNamespace: r.OADPNamespace,
 				},
 				NonAdminBslSecretPredicate: predicate.NonAdminBslSecretPredicate{},
+				NonAdminBslCACertPredicate: predicate.NonAdminBslCACertPredicate{
+					OADPNamespace: r.OADPNamespace,
+				},
 			}).
 		Watches(&velerov1.BackupStorageLocation{}, &handler.VeleroBackupStorageLocationHandler{}).
 		Watches(&nacv1alpha1.NonAdminBackupStorageLocationRequest{}, &handler.NonAdminBackupStorageLocationRequestHandler{}).
 		Watches(&corev1.Secret{}, &handler.NonAdminBslSecretHandler{
 			Client: r.Client,
 		}).
+		Watches(&corev1.ConfigMap{}, &handler.NonAdminBslCACertHandler{
+			Client: r.Client,
+		}).
+		WithOptions(r.StartupBackpressure.ControllerOptions()).
 		Complete(r)
 }
 
@@ -200,7 +264,7 @@ func (r *NonAdminBackupStorageLocationReconciler) deleteNonAdminBackups(ctx cont
 
 	for _, nonAdminBackup := range nonAdminBackupList.Items {
 		// Ensure it belongs to this StorageLocation
-		if nonAdminBackup.Spec.BackupSpec == nil || nonAdminBackup.Spec.BackupSpec.StorageLocation != nabsl.Name {
+		if !isNonAdminBackupUsingNaBSL(&nonAdminBackup, nabsl) {
 			continue
 		}
 
@@ -220,6 +284,119 @@ func (r *NonAdminBackupStorageLocationReconciler) deleteNonAdminBackups(ctx cont
 	return false, nil
 }
 
+// checkNaBSLReferences blocks the deletion of the NonAdminBackupStorageLocation while NonAdminBackups or
+// NonAdminRestores using it are running, unless the admin user forces it on the NonAdminBackupStorageLocationRequest
+func (r *NonAdminBackupStorageLocationReconciler) checkNaBSLReferences(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
+	references, err := r.activeNaBSLReferences(ctx, nabsl)
+	if err != nil {
+		logger.Error(err, "Failed to list the NonAdminBackups and NonAdminRestores using NonAdminBackupStorageLocation")
+		return false, err
+	}
+
+	if len(references) > 0 && nabsl.Status.VeleroBackupStorageLocation != nil {
+		nabslRequest, requestErr := function.GetNabslRequestByLabel(ctx, r.Client, r.OADPNamespace, nabsl.Status.VeleroBackupStorageLocation.NACUUID)
+		if requestErr != nil {
+			logger.Error(requestErr, findSingleNABSLRequestError)
+			return false, requestErr
+		}
+		if nabslRequest != nil && nabslRequest.Annotations[constant.NabslForceDeletionAnnotation] == constant.TrueString {
+			logger.Info("NonAdminBackupStorageLocation deletion forced by the admin user", "references", references)
+			references = nil
+		}
+	}
+
+	if len(references) == 0 {
+		if meta.RemoveStatusCondition(&nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionDeletionBlocked)) {
+			if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
+				logger.Error(updateErr, failedUpdateStatusError)
+				return false, updateErr
+			}
+		}
+		return false, nil
+	}
+
+	if meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionDeletionBlocked),
+		Status:  metav1.ConditionTrue,
+		Reason:  "ReferencedByRunningObjects",
+		Message: naBSLReferencesMessage("deletion", references),
+	}) {
+		if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
+			logger.Error(updateErr, failedUpdateStatusError)
+			return false, updateErr
+		}
+	}
+	logger.V(1).Info("NonAdminBackupStorageLocation deletion blocked", "references", references)
+	return true, nil
+}
+
+// naBSLReferencesMessage returns the message of the NonAdminBackupStorageLocation operation waiting for the references,
+// listing at most maxDeletionBlockingReferences of them
+func naBSLReferencesMessage(operation string, references []string) string {
+	if len(references) > maxDeletionBlockingReferences {
+		return fmt.Sprintf("NonAdminBackupStorageLocation %s waits for: %s and %d more", operation,
+			strings.Join(references[:maxDeletionBlockingReferences], constant.CommaString+" "), len(references)-maxDeletionBlockingReferences)
+	}
+	return fmt.Sprintf("NonAdminBackupStorageLocation %s waits for: %s", operation, strings.Join(references, constant.CommaString+" "))
+}
+
+// activeNaBSLReferences returns the NonAdminBackups, whose VeleroBackup is queued or running, and the NonAdminRestores,
+// whose VeleroRestore is queued or running, using the NonAdminBackupStorageLocation
+func (r *NonAdminBackupStorageLocationReconciler) activeNaBSLReferences(ctx context.Context, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) ([]string, error) {
+	nonAdminBackupList := &nacv1alpha1.NonAdminBackupList{}
+	if err := r.List(ctx, nonAdminBackupList, client.InNamespace(nabsl.Namespace)); err != nil {
+		return nil, err
+	}
+	var references []string
+	nonAdminBackupsUsingNaBSL := map[string]bool{}
+	for index := range nonAdminBackupList.Items {
+		nonAdminBackup := &nonAdminBackupList.Items[index]
+		if !isNonAdminBackupUsingNaBSL(nonAdminBackup, nabsl) {
+			continue
+		}
+		nonAdminBackupsUsingNaBSL[nonAdminBackup.Name] = true
+		if isVeleroBackupRunning(nonAdminBackup) ||
+			(nonAdminBackup.Status.VeleroBackup != nil && nonAdminBackup.Status.VeleroBackup.Status != nil &&
+				nonAdminBackup.Status.VeleroBackup.Status.Phase == velerov1.BackupPhaseNew) {
+			references = append(references, "NonAdminBackup/"+nonAdminBackup.Name)
+		}
+	}
+
+	nonAdminRestoreList := &nacv1alpha1.NonAdminRestoreList{}
+	if err := r.List(ctx, nonAdminRestoreList, client.InNamespace(nabsl.Namespace)); err != nil {
+		return nil, err
+	}
+	for _, nonAdminRestore := range nonAdminRestoreList.Items {
+		if nonAdminRestore.Spec.RestoreSpec == nil || !nonAdminBackupsUsingNaBSL[nonAdminRestore.Spec.RestoreSpec.BackupName] ||
+			function.IsSharedNonAdminBackupRestore(&nonAdminRestore) ||
+			nonAdminRestore.Status.VeleroRestore == nil || nonAdminRestore.Status.VeleroRestore.Status == nil {
+			continue
+		}
+		switch nonAdminRestore.Status.VeleroRestore.Status.Phase {
+		case velerov1.RestorePhaseNew,
+			velerov1.RestorePhaseInProgress,
+			velerov1.RestorePhaseWaitingForPluginOperations,
+			velerov1.RestorePhaseWaitingForPluginOperationsPartiallyFailed,
+			velerov1.RestorePhaseFinalizing,
+			velerov1.RestorePhaseFinalizingPartiallyFailed:
+			references = append(references, "NonAdminRestore/"+nonAdminRestore.Name)
+		}
+	}
+	return references, nil
+}
+
+// isNonAdminBackupUsingNaBSL returns true if the NonAdminBackup spec, or its VeleroBackup spec when the
+// NonAdminBackupStorageLocation is the default one of the namespace, uses the NonAdminBackupStorageLocation
+func isNonAdminBackupUsingNaBSL(nab *nacv1alpha1.NonAdminBackup, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) bool {
+	if nab.Spec.BackupSpec != nil && nab.Spec.BackupSpec.StorageLocation == nabsl.Name {
+		return true
+	}
+	return nabsl.Status.VeleroBackupStorageLocation != nil &&
+		nabsl.Status.VeleroBackupStorageLocation.Name != constant.EmptyString &&
+		nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.Spec != nil &&
+		nab.Status.VeleroBackup.Spec.StorageLocation == nabsl.Status.VeleroBackupStorageLocation.Name
+}
+
 // deleteNonAdminRequest deletes the NonAdminBackupStorageLocationRequest object associated with the NonAdminBackupStorageLocation object
 func (r *NonAdminBackupStorageLocationReconciler) deleteNonAdminRequest(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
 	veleroObjectsNACUUID := nabsl.Status.VeleroBackupStorageLocation.NACUUID
@@ -315,6 +492,30 @@ func (r *NonAdminBackupStorageLocationReconciler) removeNaBSLFinalizerUponVelero
 	return false, nil
 }
 
+// releaseTransferredNaBSL removes the finalizer of the NonAdminBackupStorageLocation transferred to another namespace,
+// without deleting the VeleroBackupStorageLocation, its Secret and the NonAdminBackupStorageLocationRequest, which the
+// NonAdminBackupStorageLocation of the other namespace took over
+func (r *NonAdminBackupStorageLocationReconciler) releaseTransferredNaBSL(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
+	if nabsl.Status.VeleroBackupStorageLocation == nil || nabsl.Status.VeleroBackupStorageLocation.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+
+	nabslRequest, err := function.GetNabslRequestByLabel(ctx, r.Client, r.OADPNamespace, nabsl.Status.VeleroBackupStorageLocation.NACUUID)
+	if err != nil {
+		logger.Error(err, findSingleNABSLRequestError)
+		return false, err
+	}
+	if nabslRequest == nil || nabslRequest.Status.SourceNonAdminBSL == nil ||
+		nabslRequest.Status.SourceNonAdminBSL.Namespace == nabsl.Namespace {
+		return false, nil
+	}
+
+	logger.V(1).Info("NonAdminBackupStorageLocation transferred, its Velero objects are kept",
+		"targetNamespace", nabslRequest.Status.SourceNonAdminBSL.Namespace)
+	_, err = r.removeNaBSLFinalizerUponVeleroBSLDeletion(ctx, logger, nabsl)
+	return true, err
+}
+
 // initNaBSLCreate initializes creation of the NonAdminBackupStorageLocation object
 func (r *NonAdminBackupStorageLocationReconciler) initNaBSLCreate(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
 	if nabsl.Status.Phase != constant.EmptyString {
@@ -338,13 +539,35 @@ func (r *NonAdminBackupStorageLocationReconciler) initNaBSLCreate(ctx context.Co
 // validateNaBSLSpec validates the NonAdminBackupStorageLocation spec
 func (r *NonAdminBackupStorageLocationReconciler) validateNaBSLSpec(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
 	err := function.ValidateBslSpec(ctx, r.Client, nabsl, r.EnforcedBslSpec, r.SyncPeriod, r.DefaultSyncPeriod)
+	if err == nil {
+		err = function.ValidateBslProvider(nabsl.Spec.BackupStorageLocationSpec, r.AllowedProviders, r.AllowedS3URLs)
+	}
+	if err == nil {
+		err = function.ValidateBslMinimumPeriods(nabsl.Spec.BackupStorageLocationSpec, r.MinBackupSyncPeriod, r.MinValidationFrequency)
+	}
+	if err == nil && nabsl.Spec.CloudIdentity != nil && !r.AllowCloudIdentity {
+		err = errors.New("NonAdminBackupStorageLocation spec.cloudIdentity is not allowed by the cluster admin")
+	}
+	if err == nil {
+		err = r.ValidationHook.Validate(ctx, nacv1alpha1.NonAdminBackupStorageLocations, nabsl, nabsl.Status.Conditions)
+		if err != nil && !validationhook.IsRejected(err) {
+			logger.Error(err, "Unable to validate NonAdminBackupStorageLocation with the validation hook")
+			return false, err
+		}
+	}
 	if err != nil {
+		reason := "BslSpecValidation"
+		if errors.Is(err, function.ErrBslProviderNotAllowed) {
+			reason = "ProviderNotAllowed"
+		} else if errors.Is(err, function.ErrBslCredentialsInvalid) {
+			reason = "InvalidCredentials"
+		}
 		updatedPhase := updateNonAdminPhase(&nabsl.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
 		updatedCondition := meta.SetStatusCondition(&nabsl.Status.Conditions,
 			metav1.Condition{
 				Type:    string(nacv1alpha1.NonAdminConditionAccepted),
 				Status:  metav1.ConditionFalse,
-				Reason:  "BslSpecValidation",
+				Reason:  reason,
 				Message: err.Error(),
 			},
 		)
@@ -359,10 +582,11 @@ func (r *NonAdminBackupStorageLocationReconciler) validateNaBSLSpec(ctx context.
 
 	// Validation successful, update condition
 	updatedCondition := meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
-		Type:    string(nacv1alpha1.NonAdminConditionAccepted),
-		Status:  metav1.ConditionTrue,
-		Reason:  "BslSpecValidation",
-		Message: "NonAdminBackupStorageLocation spec validation successful",
+		Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             "BslSpecValidation",
+		Message:            "NonAdminBackupStorageLocation spec validation successful",
+		ObservedGeneration: nabsl.Generation,
 	})
 
 	if updatedCondition {
@@ -387,7 +611,13 @@ func (r *NonAdminBackupStorageLocationReconciler) setVeleroBSLUUIDInNaBSLStatus(
 	}
 
 	if nabsl.Status.VeleroBackupStorageLocation == nil || nabsl.Status.VeleroBackupStorageLocation.NACUUID == constant.EmptyString {
-		veleroBslNACUUID := function.GenerateNacObjectUUID(nabsl.Namespace, nabsl.Name)
+		veleroBslNACUUID, err := r.transferredVeleroBSLNACUUID(ctx, logger, nabsl)
+		if err != nil {
+			return false, err
+		}
+		if veleroBslNACUUID == constant.EmptyString {
+			veleroBslNACUUID = function.GenerateNacObjectUUID(nabsl.Namespace, nabsl.Name)
+		}
 		nabsl.Status.VeleroBackupStorageLocation = &nacv1alpha1.VeleroBackupStorageLocation{
 			NACUUID:   veleroBslNACUUID,
 			Namespace: r.OADPNamespace,
@@ -404,6 +634,41 @@ func (r *NonAdminBackupStorageLocationReconciler) setVeleroBSLUUIDInNaBSLStatus(
 	return false, nil
 }
 
+// transferredVeleroBSLNACUUID returns the NACUUID of the VeleroBackupStorageLocation the NonAdminBackupStorageLocation
+// takes over, if the cluster admin transfers it to its namespace, and sets its Transferred condition; empty otherwise.
+// The NabslTransferredNACUUIDAnnotation is only trusted if the NonAdminBackupStorageLocationRequest agrees with it.
+func (r *NonAdminBackupStorageLocationReconciler) transferredVeleroBSLNACUUID(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (string, error) {
+	transferredNACUUID := nabsl.Annotations[constant.NabslTransferredNACUUIDAnnotation]
+	if transferredNACUUID == constant.EmptyString {
+		return constant.EmptyString, nil
+	}
+	if errs := validation.IsValidLabelValue(transferredNACUUID); len(errs) > 0 {
+		logger.Info("Ignoring invalid transferred NACUUID annotation", "errors", errs)
+		return constant.EmptyString, nil
+	}
+
+	nabslRequest, err := function.GetNabslRequestByLabel(ctx, r.Client, r.OADPNamespace, transferredNACUUID)
+	if err != nil {
+		logger.Error(err, findSingleNABSLRequestError)
+		return constant.EmptyString, err
+	}
+	if nabslRequest == nil || nabslRequest.Status.SourceNonAdminBSL == nil ||
+		nabslRequest.Annotations[constant.NabslTransferNamespaceAnnotation] != nabsl.Namespace ||
+		nabslRequest.Status.SourceNonAdminBSL.Name != nabsl.Name {
+		logger.Info("Ignoring transferred NACUUID annotation, no NonAdminBackupStorageLocationRequest is transferred to this NonAdminBackupStorageLocation")
+		return constant.EmptyString, nil
+	}
+
+	meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionTransferred),
+		Status:  metav1.ConditionTrue,
+		Reason:  "TransferredFromNamespace",
+		Message: fmt.Sprintf("NonAdminBackupStorageLocation transferred from namespace %s by the cluster admin", nabslRequest.Status.SourceNonAdminBSL.Namespace),
+	})
+	logger.V(1).Info("NonAdminBackupStorageLocation takes the transferred VeleroBackupStorageLocation over", constant.UUIDString, transferredNACUUID)
+	return transferredNACUUID, nil
+}
+
 // setFinalizerOnNaBSL sets the finalizer on the NonAdminBackupStorageLocation object
 func (r *NonAdminBackupStorageLocationReconciler) setFinalizerOnNaBSL(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
 	// If the object does not have the finalizer, add it before creating Velero BackupStorageLocation and relevant secret
@@ -437,6 +702,9 @@ func (r *NonAdminBackupStorageLocationReconciler) ensureNonAdminRequest(
 		err := errors.New("no NonAdminBackupStorageLocationRequest found")
 		logger.Error(err, findSingleNABSLRequestError)
 		return false, err
+	} else if nabslRequest.Status.SourceNonAdminBSL == nil {
+		logger.V(1).Info("NonAdminBackupStorageLocationRequest status not set yet")
+		return true, nil
 	}
 
 	var terminalErr error
@@ -447,9 +715,23 @@ func (r *NonAdminBackupStorageLocationReconciler) ensureNonAdminRequest(
 	expectedPhase := nacv1alpha1.NonAdminPhaseNew
 	updatedRejectedCondition := false
 	updatedApprovedCondition := false
+	updatedSpecCondition := false
+
+	specUpdated := !reflect.DeepEqual(nabslRequest.Status.SourceNonAdminBSL.DeepCopy().RequestedSpec, nabsl.Spec.BackupStorageLocationSpec) ||
+		!reflect.DeepEqual(nabslRequest.Status.SourceNonAdminBSL.RequestedCloudIdentity, nabsl.Spec.CloudIdentity)
+	// The spec update of an approved NonAdminBackupStorageLocation is applied if it does not need the approval of the cluster admin
+	if specUpdated && nabslRequest.Spec.ApprovalDecision == nacv1alpha1.NonAdminBSLRequestApproved &&
+		nabslRequest.Status.SourceNonAdminBSL.NACUUID == nabsl.Status.VeleroBackupStorageLocation.NACUUID &&
+		(!r.requiresApproval(nabsl) || r.isAutoApproved(ctx, logger, nabsl)) {
+		updatedSpecCondition, err = r.applyNaBSLSpecUpdate(ctx, logger, nabsl, nabslRequest)
+		if err != nil {
+			return false, err
+		}
+		specUpdated = false
+	}
 
-	if !reflect.DeepEqual(nabslRequest.Status.SourceNonAdminBSL.DeepCopy().RequestedSpec, nabsl.Spec.BackupStorageLocationSpec) {
-		message = "NaBSL Spec update not allowed. Changes will not be applied. Delete NaBSL and create new one with updated spec"
+	if specUpdated {
+		message = "NaBSL Spec update requires the approval of the cluster admin. Changes will not be applied. Delete NaBSL and create new one with updated spec"
 		updatedRejectedCondition = meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
 			Type:    string(nacv1alpha1.NonAdminBSLConditionSpecUpdateApproved),
 			Status:  metav1.ConditionFalse,
@@ -471,22 +753,27 @@ func (r *NonAdminBackupStorageLocationReconciler) ensureNonAdminRequest(
 		terminalErr = reconcile.TerminalError(errors.New(message))
 		expectedPhase = nacv1alpha1.NonAdminPhaseBackingOff
 	} else {
-		switch nabslRequest.Spec.ApprovalDecision {
-		case "pending", constant.EmptyString:
-			reason, message = "BslSpecApprovalPending", "NonAdminBackupStorageLocationRequest approval pending"
-			terminalErr = reconcile.TerminalError(errors.New(message))
-		case "approve":
-			adminApprovedCondition = metav1.ConditionTrue
-			reason, message = "BslSpecApproved", "NonAdminBackupStorageLocationRequest approval decision set to Approve"
-		case "reject":
-			reason, message = "BslSpecRejected", "NonAdminBackupStorageLocationRequest approval decision set to Reject"
-			expectedPhase = nacv1alpha1.NonAdminPhaseBackingOff
-			terminalErr = reconcile.TerminalError(errors.New(message))
-		default:
-			reason, message = "BslSpecInvalid", "NonAdminBackupStorageLocationRequest approval decision is invalid"
+		var backingOff bool
+		adminApprovedCondition, reason, message, backingOff = getLocationApproval(
+			"NonAdminBackupStorageLocationRequest", "Bsl", nabslRequest.Spec.ApprovalDecision)
+		if nabslRequest.Spec.ApprovalDecision == nacv1alpha1.NonAdminBSLRequestRejected && isNaBSLApprovalRevoked(nabsl) {
+			reason, message = constant.NabslApprovalRevokedReason, "NonAdminBackupStorageLocationRequest approval revoked by the cluster admin"
+			meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+				Type:    string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable),
+				Status:  metav1.ConditionFalse,
+				Reason:  "ApprovalRevoked",
+				Message: "backup storage location can not be used anymore, its approval was revoked by the cluster admin",
+			})
+		}
+		if backingOff {
 			expectedPhase = nacv1alpha1.NonAdminPhaseBackingOff
+		}
+		if adminApprovedCondition == metav1.ConditionFalse {
 			terminalErr = reconcile.TerminalError(errors.New(message))
 		}
+		if nabslRequest.Spec.Reason != constant.EmptyString {
+			message += ": " + nabslRequest.Spec.Reason
+		}
 		updatedApprovedCondition = meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
 			Type:    string(nacv1alpha1.NonAdminBSLConditionApproved),
 			Status:  adminApprovedCondition,
@@ -514,7 +801,7 @@ func (r *NonAdminBackupStorageLocationReconciler) ensureNonAdminRequest(
 		}
 	}
 
-	if updatePhase || updatedApprovedCondition || updatedRejectedCondition {
+	if updatePhase || updatedApprovedCondition || updatedRejectedCondition || updatedSpecCondition {
 		if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
 			logger.Error(updateErr, failedUpdateStatusError)
 			return false, updateErr
@@ -525,6 +812,404 @@ func (r *NonAdminBackupStorageLocationReconciler) ensureNonAdminRequest(
 	return false, terminalErr
 }
 
+// applyNaBSLSpecUpdate records the updated spec of the NonAdminBackupStorageLocation in its NonAdminBackupStorageLocationRequest,
+// so the next steps apply it to the VeleroBackupStorageLocation, and sets the SpecUpdateApproved condition listing the changed
+// fields. It returns true if the condition is changed by this call.
+func (r *NonAdminBackupStorageLocationReconciler) applyNaBSLSpecUpdate(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation, nabslRequest *nacv1alpha1.NonAdminBackupStorageLocationRequest) (bool, error) {
+	changedFields, err := function.GetBslSpecChangedFields(nabslRequest.Status.SourceNonAdminBSL.RequestedSpec, nabsl.Spec.BackupStorageLocationSpec)
+	if err != nil {
+		logger.Error(err, "Failed to compare NonAdminBackupStorageLocation spec")
+		return false, err
+	}
+	if !reflect.DeepEqual(nabslRequest.Status.SourceNonAdminBSL.RequestedCloudIdentity, nabsl.Spec.CloudIdentity) {
+		changedFields = append(changedFields, "cloudIdentity")
+	}
+
+	nabslRequest.Status.SourceNonAdminBSL.RequestedSpec = nabsl.Spec.BackupStorageLocationSpec.DeepCopy()
+	nabslRequest.Status.SourceNonAdminBSL.RequestedCloudIdentity = nabsl.Spec.CloudIdentity.DeepCopy()
+	if err := r.Status().Update(ctx, nabslRequest); err != nil {
+		logger.Error(err, failedUpdateStatusError)
+		return false, err
+	}
+
+	message := fmt.Sprintf("NaBSL Spec update applied to the Velero BackupStorageLocation, changed fields: %s", strings.Join(changedFields, ", "))
+	r.recordEvent(nabsl, corev1.EventTypeNormal, "NonAdminBackupStorageLocationSpecUpdated", message)
+	logger.V(1).Info("NonAdminBackupStorageLocation spec update approved", "changedFields", changedFields)
+	return meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionSpecUpdateApproved),
+		Status:  metav1.ConditionTrue,
+		Reason:  "BslSpecUpdateApplied",
+		Message: message,
+	}), nil
+}
+
+// getLocationApproval returns the status, reason and message of the Approved condition of a
+// NonAdminBackupStorageLocation or a NonAdminVolumeSnapshotLocation for the approval decision of the cluster admin on
+// its requestKind request, and whether the location is BackingOff, which is the case if it is rejected or the
+// decision is invalid. It is shared by the NonAdminBackupStorageLocation and NonAdminVolumeSnapshotLocation controllers.
+func getLocationApproval(requestKind string, reasonPrefix string, approvalDecision nacv1alpha1.NonAdminBSLRequest) (metav1.ConditionStatus, string, string, bool) {
+	switch approvalDecision {
+	case nacv1alpha1.NonAdminBSLRequestPending, constant.EmptyString:
+		return metav1.ConditionFalse, reasonPrefix + "SpecApprovalPending", requestKind + " approval pending", false
+	case nacv1alpha1.NonAdminBSLRequestApproved:
+		return metav1.ConditionTrue, reasonPrefix + "SpecApproved", requestKind + " approval decision set to Approve", false
+	case nacv1alpha1.NonAdminBSLRequestRejected:
+		return metav1.ConditionFalse, reasonPrefix + "SpecRejected", requestKind + " approval decision set to Reject", true
+	default:
+		return metav1.ConditionFalse, reasonPrefix + "SpecInvalid", requestKind + " approval decision is invalid", true
+	}
+}
+
+// isNaBSLApprovalRevoked returns true if the NonAdminBackupStorageLocation is or was approved by the cluster admin,
+// who then rejected it
+func isNaBSLApprovalRevoked(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) bool {
+	approved := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionApproved))
+	return approved != nil && (approved.Status == metav1.ConditionTrue || approved.Reason == constant.NabslApprovalRevokedReason)
+}
+
+// transferNaBSL moves the NonAdminBackupStorageLocation to the namespace the cluster admin set in the
+// NabslTransferNamespaceAnnotation of its NonAdminBackupStorageLocationRequest. The NonAdminBackupStorageLocation
+// created in that namespace takes the VeleroBackupStorageLocation and the request over, then the one of the
+// source namespace is deleted.
+func (r *NonAdminBackupStorageLocationReconciler) transferNaBSL(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
+	nabslRequest, err := function.GetNabslRequestByLabel(ctx, r.Client, r.OADPNamespace, nabsl.Status.VeleroBackupStorageLocation.NACUUID)
+	if err != nil {
+		logger.Error(err, findSingleNABSLRequestError)
+		return false, err
+	}
+	if nabslRequest == nil || nabslRequest.Status.SourceNonAdminBSL == nil {
+		return false, nil
+	}
+
+	targetNamespace := nabslRequest.Annotations[constant.NabslTransferNamespaceAnnotation]
+	switch {
+	case targetNamespace == nabsl.Namespace:
+		return false, r.completeNaBSLTransfer(ctx, logger, nabsl, nabslRequest)
+	case nabslRequest.Status.SourceNonAdminBSL.Namespace != nabsl.Namespace:
+		// The NonAdminBackupStorageLocation of the target namespace took the request over
+		transferredNamespace := nabslRequest.Status.SourceNonAdminBSL.Namespace
+		nonAdminBackups, _, err := r.transferredNonAdminBackups(ctx, nabsl, transferredNamespace)
+		if err != nil {
+			logger.Error(err, "Failed to list the NonAdminBackups using NonAdminBackupStorageLocation")
+			return false, err
+		}
+		if moved, err := r.moveNonAdminBackups(ctx, logger, nabsl, nonAdminBackups, transferredNamespace); err != nil || !moved {
+			return !moved, err
+		}
+		r.recordEvent(nabsl, corev1.EventTypeNormal, "NonAdminBackupStorageLocationTransferred",
+			fmt.Sprintf("NonAdminBackupStorageLocation transferred to namespace %s by the cluster admin", transferredNamespace))
+		if deleteErr := r.Delete(ctx, nabsl); deleteErr != nil && !apierrors.IsNotFound(deleteErr) {
+			logger.Error(deleteErr, "Failed to delete transferred NonAdminBackupStorageLocation")
+			return false, deleteErr
+		}
+		logger.Info("Transferred NonAdminBackupStorageLocation deleted", "targetNamespace", transferredNamespace)
+		return true, nil
+	case targetNamespace == constant.EmptyString:
+		return false, nil
+	}
+
+	reason, message, err := r.moveNaBSL(ctx, logger, nabsl, nabslRequest, targetNamespace)
+	if err != nil {
+		return false, err
+	}
+	if meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionTransferred),
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	}) {
+		if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
+			logger.Error(updateErr, failedUpdateStatusError)
+			return false, updateErr
+		}
+	}
+	logger.V(1).Info("NonAdminBackupStorageLocation transfer in progress", "targetNamespace", targetNamespace, "reason", reason)
+	return true, nil
+}
+
+// moveNaBSL copies the NonAdminBackupStorageLocation, with its credential Secret and CA bundle ConfigMap, to the
+// target namespace, then moves its NonAdminBackups once the copy took the VeleroBackupStorageLocation over.
+// It returns the reason and message of the Transferred condition of the NonAdminBackupStorageLocation.
+func (r *NonAdminBackupStorageLocationReconciler) moveNaBSL(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation,
+	nabslRequest *nacv1alpha1.NonAdminBackupStorageLocationRequest, targetNamespace string) (string, string, error) {
+	veleroObjectsNACUUID := nabsl.Status.VeleroBackupStorageLocation.NACUUID
+
+	// The objectStorage prefix is kept, so the backups stored under it are still synced
+	if nabslRequest.Annotations[constant.NabslPrefixNamespaceAnnotation] == constant.EmptyString {
+		patch := client.MergeFrom(nabslRequest.DeepCopy())
+		metav1.SetMetaDataAnnotation(&nabslRequest.ObjectMeta, constant.NabslPrefixNamespaceAnnotation, nabsl.Namespace)
+		if err := r.Patch(ctx, nabslRequest, patch); err != nil {
+			logger.Error(err, "Failed to patch NonAdminBackupStorageLocationRequest")
+			return constant.EmptyString, constant.EmptyString, err
+		}
+	}
+
+	if err := r.Get(ctx, types.NamespacedName{Name: targetNamespace}, &corev1.Namespace{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "TargetNamespaceNotFound", fmt.Sprintf("NonAdminBackupStorageLocation transfer namespace %s does not exist", targetNamespace), nil
+		}
+		logger.Error(err, "Failed to get NonAdminBackupStorageLocation transfer namespace")
+		return constant.EmptyString, constant.EmptyString, err
+	}
+
+	references, err := r.activeNaBSLReferences(ctx, nabsl)
+	if err != nil {
+		logger.Error(err, "Failed to list the NonAdminBackups and NonAdminRestores using NonAdminBackupStorageLocation")
+		return constant.EmptyString, constant.EmptyString, err
+	}
+	if len(references) > 0 {
+		return "ReferencedByRunningObjects", naBSLReferencesMessage("transfer", references), nil
+	}
+
+	targetNaBSL := &nacv1alpha1.NonAdminBackupStorageLocation{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: targetNamespace, Name: nabsl.Name}, targetNaBSL)
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to get transferred NonAdminBackupStorageLocation")
+		return constant.EmptyString, constant.EmptyString, err
+	}
+	targetNaBSLExists := err == nil
+	if targetNaBSLExists && targetNaBSL.Annotations[constant.NabslTransferredNACUUIDAnnotation] != veleroObjectsNACUUID {
+		return "TargetNameConflict", fmt.Sprintf("NonAdminBackupStorageLocation %s already exists in namespace %s", nabsl.Name, targetNamespace), nil
+	}
+
+	nonAdminBackups, conflicts, err := r.transferredNonAdminBackups(ctx, nabsl, targetNamespace)
+	if err != nil {
+		logger.Error(err, "Failed to list the NonAdminBackups using NonAdminBackupStorageLocation")
+		return constant.EmptyString, constant.EmptyString, err
+	}
+	if len(conflicts) > 0 {
+		return "TargetNameConflict", fmt.Sprintf("NonAdminBackups already exist in namespace %s: %s",
+			targetNamespace, strings.Join(conflicts, constant.CommaString+" ")), nil
+	}
+
+	if !targetNaBSLExists {
+		if err := r.copyNaBSLReferences(ctx, nabsl, targetNamespace); err != nil {
+			logger.Error(err, "Failed to copy NonAdminBackupStorageLocation credential Secret and CA bundle ConfigMap")
+			return constant.EmptyString, constant.EmptyString, err
+		}
+		targetNaBSL = &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        nabsl.Name,
+				Namespace:   targetNamespace,
+				Labels:      nabsl.Labels,
+				Annotations: map[string]string{},
+			},
+			Spec: *nabsl.Spec.DeepCopy(),
+		}
+		for key, value := range nabsl.Annotations {
+			targetNaBSL.Annotations[key] = value
+		}
+		targetNaBSL.Annotations[constant.NabslTransferredNACUUIDAnnotation] = veleroObjectsNACUUID
+		if err := r.Create(ctx, targetNaBSL); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create transferred NonAdminBackupStorageLocation")
+			return constant.EmptyString, constant.EmptyString, err
+		}
+		logger.Info("Transferred NonAdminBackupStorageLocation created", "targetNamespace", targetNamespace)
+	}
+
+	if targetNaBSL.Status.VeleroBackupStorageLocation == nil || targetNaBSL.Status.VeleroBackupStorageLocation.NACUUID != veleroObjectsNACUUID {
+		return "WaitingForTarget", fmt.Sprintf("waiting for NonAdminBackupStorageLocation %s of namespace %s to take the VeleroBackupStorageLocation over",
+			nabsl.Name, targetNamespace), nil
+	}
+
+	if _, err := r.moveNonAdminBackups(ctx, logger, nabsl, nonAdminBackups, targetNamespace); err != nil {
+		return constant.EmptyString, constant.EmptyString, err
+	}
+	return "Transferring", fmt.Sprintf("moving NonAdminBackups, waiting for NonAdminBackupStorageLocation %s of namespace %s to take the NonAdminBackupStorageLocationRequest over",
+		nabsl.Name, targetNamespace), nil
+}
+
+// transferredNonAdminBackups returns the NonAdminBackups using the NonAdminBackupStorageLocation, and the names of
+// the ones conflicting with a NonAdminBackup of the target namespace not moved from the source namespace
+func (r *NonAdminBackupStorageLocationReconciler) transferredNonAdminBackups(ctx context.Context, nabsl *nacv1alpha1.NonAdminBackupStorageLocation, targetNamespace string) ([]nacv1alpha1.NonAdminBackup, []string, error) {
+	nonAdminBackupList := &nacv1alpha1.NonAdminBackupList{}
+	if err := r.List(ctx, nonAdminBackupList, client.InNamespace(nabsl.Namespace)); err != nil {
+		return nil, nil, err
+	}
+	targetNonAdminBackupList := &nacv1alpha1.NonAdminBackupList{}
+	if err := r.List(ctx, targetNonAdminBackupList, client.InNamespace(targetNamespace)); err != nil {
+		return nil, nil, err
+	}
+	targetNonAdminBackups := map[string]*nacv1alpha1.NonAdminBackup{}
+	for index := range targetNonAdminBackupList.Items {
+		targetNonAdminBackups[targetNonAdminBackupList.Items[index].Name] = &targetNonAdminBackupList.Items[index]
+	}
+
+	var nonAdminBackups []nacv1alpha1.NonAdminBackup
+	var conflicts []string
+	for _, nonAdminBackup := range nonAdminBackupList.Items {
+		if !isNonAdminBackupUsingNaBSL(&nonAdminBackup, nabsl) {
+			continue
+		}
+		nonAdminBackups = append(nonAdminBackups, nonAdminBackup)
+		targetNonAdminBackup, exists := targetNonAdminBackups[nonAdminBackup.Name]
+		if exists && (nonAdminBackup.Status.VeleroBackup == nil ||
+			targetNonAdminBackup.Labels[constant.NabSyncLabel] != nonAdminBackup.Status.VeleroBackup.NACUUID) {
+			conflicts = append(conflicts, nonAdminBackup.Name)
+		}
+	}
+	return nonAdminBackups, conflicts, nil
+}
+
+// copyNaBSLReferences creates the credential Secret and the CA bundle ConfigMap of the NonAdminBackupStorageLocation
+// in the target namespace, unless they already exist there
+func (r *NonAdminBackupStorageLocationReconciler) copyNaBSLReferences(ctx context.Context, nabsl *nacv1alpha1.NonAdminBackupStorageLocation, targetNamespace string) error {
+	if nabsl.Spec.CloudIdentity == nil && nabsl.Spec.BackupStorageLocationSpec.Credential != nil &&
+		nabsl.Spec.BackupStorageLocationSpec.Credential.Name != constant.EmptyString {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: nabsl.Namespace, Name: nabsl.Spec.BackupStorageLocationSpec.Credential.Name}, secret); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secret.Name, Namespace: targetNamespace},
+			Type:       secret.Type,
+			Data:       secret.Data,
+		}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	if nabsl.Spec.CACertConfigMap != nil && nabsl.Spec.CACertConfigMap.Name != constant.EmptyString {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: nabsl.Namespace, Name: nabsl.Spec.CACertConfigMap.Name}, configMap); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: configMap.Name, Namespace: targetNamespace},
+			Data:       configMap.Data,
+			BinaryData: configMap.BinaryData,
+		}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveNonAdminBackups creates the NonAdminBackups in the target namespace, synced from their VeleroBackups, which are
+// then annotated with the target namespace, and deletes them from the source namespace. A NonAdminBackup is only
+// deleted once the cache shares the annotation of its VeleroBackup with the NonAdminBackup controller, which
+// then does not delete the VeleroBackup. It returns true if every NonAdminBackup was deleted.
+func (r *NonAdminBackupStorageLocationReconciler) moveNonAdminBackups(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation,
+	nonAdminBackups []nacv1alpha1.NonAdminBackup, targetNamespace string) (bool, error) {
+	moved := true
+	for index := range nonAdminBackups {
+		nonAdminBackup := &nonAdminBackups[index]
+		if nonAdminBackup.Status.VeleroBackup != nil && nonAdminBackup.Status.VeleroBackup.NACUUID != constant.EmptyString {
+			veleroBackupNACUUID := nonAdminBackup.Status.VeleroBackup.NACUUID
+			veleroBackup, err := function.GetVeleroBackupByLabel(ctx, r.Client, r.OADPNamespace, veleroBackupNACUUID)
+			if err != nil {
+				logger.Error(err, "Failed to get VeleroBackup", constant.UUIDString, veleroBackupNACUUID)
+				return false, err
+			}
+			if veleroBackup != nil {
+				backupSpec := veleroBackup.Spec.DeepCopy()
+				backupSpec.StorageLocation = nabsl.Name
+				err = r.Create(ctx, &nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      nonAdminBackup.Name,
+						Namespace: targetNamespace,
+						Labels: map[string]string{
+							constant.NabSyncLabel: veleroBackupNACUUID,
+						},
+					},
+					Spec: nacv1alpha1.NonAdminBackupSpec{
+						BackupSpec: backupSpec,
+					},
+				})
+				if err != nil && !apierrors.IsAlreadyExists(err) {
+					logger.Error(err, "Failed to create transferred NonAdminBackup", constant.NameString, nonAdminBackup.Name)
+					return false, err
+				}
+
+				if veleroBackup.Annotations[constant.NabOriginNamespaceAnnotation] != targetNamespace {
+					patch := client.MergeFrom(veleroBackup.DeepCopy())
+					if veleroBackup.Annotations[constant.NabSourceNamespaceAnnotation] == constant.EmptyString {
+						metav1.SetMetaDataAnnotation(&veleroBackup.ObjectMeta, constant.NabSourceNamespaceAnnotation, nonAdminBackup.Namespace)
+					}
+					metav1.SetMetaDataAnnotation(&veleroBackup.ObjectMeta, constant.NabOriginNamespaceAnnotation, targetNamespace)
+					if err = r.Patch(ctx, veleroBackup, patch); err != nil {
+						logger.Error(err, "Failed to patch VeleroBackup", constant.NameString, veleroBackup.Name)
+						return false, err
+					}
+					moved = false
+					continue
+				}
+			}
+		}
+
+		if err := r.Delete(ctx, nonAdminBackup); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete transferred NonAdminBackup", constant.NameString, nonAdminBackup.Name)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackup transferred", constant.NameString, nonAdminBackup.Name, "targetNamespace", targetNamespace)
+	}
+	return moved, nil
+}
+
+// completeNaBSLTransfer has the NonAdminBackupStorageLocation, created by the transfer, take the
+// NonAdminBackupStorageLocationRequest over, after pointing the VeleroBackupStorageLocation and its Secret to it
+func (r *NonAdminBackupStorageLocationReconciler) completeNaBSLTransfer(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation,
+	nabslRequest *nacv1alpha1.NonAdminBackupStorageLocationRequest) error {
+	veleroObjectsNACUUID := nabsl.Status.VeleroBackupStorageLocation.NACUUID
+	annotations := function.GetNonAdminBackupStorageLocationAnnotations(nabsl.ObjectMeta)
+
+	veleroBsl, err := function.GetVeleroBackupStorageLocationByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		logger.Error(err, "Failed to get VeleroBackupStorageLocation", constant.UUIDString, veleroObjectsNACUUID)
+		return err
+	}
+	veleroBslSecret, err := function.GetBslSecretByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		logger.Error(err, findSingleVBSLSecretError, constant.UUIDString, veleroObjectsNACUUID)
+		return err
+	}
+	var veleroObjects []client.Object
+	if veleroBsl != nil {
+		veleroObjects = append(veleroObjects, veleroBsl)
+	}
+	if veleroBslSecret != nil {
+		veleroObjects = append(veleroObjects, veleroBslSecret)
+	}
+	for _, object := range veleroObjects {
+		patch := client.MergeFrom(object.DeepCopyObject().(client.Object))
+		objectAnnotations := object.GetAnnotations()
+		if objectAnnotations == nil {
+			objectAnnotations = map[string]string{}
+		}
+		for key, value := range annotations {
+			objectAnnotations[key] = value
+		}
+		object.SetAnnotations(objectAnnotations)
+		if err := r.Patch(ctx, object, patch); err != nil {
+			logger.Error(err, "Failed to point Velero object to transferred NonAdminBackupStorageLocation", constant.NameString, object.GetName())
+			return err
+		}
+	}
+
+	// The source NonAdminBackupStorageLocation deletes itself once the request names another namespace
+	if nabslRequest.Status.SourceNonAdminBSL.Namespace != nabsl.Namespace {
+		nabslRequest.Status.SourceNonAdminBSL.Namespace = nabsl.Namespace
+		if err := r.Status().Update(ctx, nabslRequest); err != nil {
+			logger.Error(err, failedUpdateStatusError)
+			return err
+		}
+	}
+
+	patch := client.MergeFrom(nabslRequest.DeepCopy())
+	for key, value := range annotations {
+		nabslRequest.Annotations[key] = value
+	}
+	delete(nabslRequest.Annotations, constant.NabslTransferNamespaceAnnotation)
+	if err := r.Patch(ctx, nabslRequest, patch); err != nil {
+		logger.Error(err, "Failed to patch NonAdminBackupStorageLocationRequest")
+		return err
+	}
+	r.recordEvent(nabsl, corev1.EventTypeNormal, "NonAdminBackupStorageLocationTransferred",
+		"NonAdminBackupStorageLocation transfer completed, its VeleroBackupStorageLocation and NonAdminBackups moved to this namespace")
+	logger.Info("NonAdminBackupStorageLocation transfer completed")
+	return nil
+}
+
 // createNonAdminRequest should create NonAdminBackupStorageLocationRequest object
 // that contains NACUUID as well spec from the NonAdminBackupStorageLocation object
 func (r *NonAdminBackupStorageLocationReconciler) createNonAdminRequest(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
@@ -540,14 +1225,21 @@ func (r *NonAdminBackupStorageLocationReconciler) createNonAdminRequest(ctx cont
 		// We allow only to update the phase of the NonAdminBackupStorageLocationRequest
 		// and not the spec
 		logger.V(1).Info("NonAdminBackupStorageLocationRequest already exists")
-		if updatePhaseIfNeeded(&nabslRequest.Status.Phase, nabslRequest.Spec.ApprovalDecision) {
+		// The status is empty if the controller stopped between the request creation and its status update
+		updatedStatus := false
+		if nabslRequest.Status.SourceNonAdminBSL == nil {
+			updatedStatus = updateNonAdminRequestStatus(&nabslRequest.Status, nabsl, nabslRequest.Spec.ApprovalDecision)
+		} else {
+			updatedStatus = updatePhaseIfNeeded(&nabslRequest.Status.Phase, nabslRequest.Spec.ApprovalDecision)
+		}
+		if updatedStatus {
 			if updateErr := r.Status().Update(ctx, nabslRequest); updateErr != nil {
 				logger.Error(updateErr, failedUpdateStatusError)
 				return false, updateErr
 			}
 		}
 
-		if !r.RequireApprovalForBSL && nabslRequest.Spec.ApprovalDecision != nacv1alpha1.NonAdminBSLRequestApproved {
+		if !r.requiresApproval(nabsl) && nabslRequest.Spec.ApprovalDecision != nacv1alpha1.NonAdminBSLRequestApproved {
 			logger.V(1).Info("Unapproved NonAdminBackupStorageLocationRequest found; approving as requireApprovalForBSL on the DPA is not true.")
 			patch := client.MergeFrom(nabslRequest.DeepCopy())
 			nabslRequest.Spec.ApprovalDecision = nacv1alpha1.NonAdminBSLRequestApproved
@@ -555,13 +1247,28 @@ func (r *NonAdminBackupStorageLocationReconciler) createNonAdminRequest(ctx cont
 				logger.Error(errPatch, "Failed to patch NonAdminBackupStorageLocationRequest")
 				return false, errPatch
 			}
+		} else if r.requiresApproval(nabsl) &&
+			(nabslRequest.Spec.ApprovalDecision == nacv1alpha1.NonAdminBSLRequestPending || nabslRequest.Spec.ApprovalDecision == constant.EmptyString) &&
+			r.isAutoApproved(ctx, logger, nabsl) {
+			logger.V(1).Info("Pending NonAdminBackupStorageLocationRequest found; approving as it matches an auto approval rule.")
+			patch := client.MergeFrom(nabslRequest.DeepCopy())
+			nabslRequest.Spec.ApprovalDecision = nacv1alpha1.NonAdminBSLRequestApproved
+			metav1.SetMetaDataAnnotation(&nabslRequest.ObjectMeta, constant.NabslAutoApprovedAnnotation, constant.TrueString)
+			if errPatch := r.Patch(ctx, nabslRequest, patch); errPatch != nil {
+				logger.Error(errPatch, "Failed to patch NonAdminBackupStorageLocationRequest")
+				return false, errPatch
+			}
 		}
 		return false, nil
 	}
 
 	approvalDecision := nacv1alpha1.NonAdminBSLRequestPending
-	if !r.RequireApprovalForBSL {
+	annotations := function.GetNonAdminBackupStorageLocationAnnotations(nabsl.ObjectMeta)
+	if !r.requiresApproval(nabsl) {
 		approvalDecision = nacv1alpha1.NonAdminBSLRequestApproved
+	} else if r.isAutoApproved(ctx, logger, nabsl) {
+		approvalDecision = nacv1alpha1.NonAdminBSLRequestApproved
+		annotations[constant.NabslAutoApprovedAnnotation] = constant.TrueString
 	}
 
 	labels := function.GetNonAdminLabels()
@@ -572,18 +1279,26 @@ func (r *NonAdminBackupStorageLocationReconciler) createNonAdminRequest(ctx cont
 			Name:        veleroObjectsNACUUID,
 			Namespace:   r.OADPNamespace,
 			Labels:      labels,
-			Annotations: function.GetNonAdminBackupStorageLocationAnnotations(nabsl.ObjectMeta),
+			Annotations: annotations,
 		},
 		Spec: nacv1alpha1.NonAdminBackupStorageLocationRequestSpec{
 			ApprovalDecision: approvalDecision,
 		},
 	}
 
-	err = r.Create(ctx, &nonAdminBslRequest)
+	_, err = function.CreateWithRetry(ctx, r.Client, &nonAdminBslRequest, func() (bool, error) {
+		existing, getErr := function.GetNabslRequestByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+		return existing != nil, getErr
+	})
 	if err != nil {
 		logger.Error(err, "Failed to create NonAdminBackupStorageLocationRequest")
 		return false, err
 	}
+	if nonAdminBslRequest.ResourceVersion == constant.EmptyString {
+		// The request was created by a previous reconcile, its status is updated by the next one
+		logger.V(1).Info("NonAdminBackupStorageLocationRequest already exists")
+		return true, nil
+	}
 
 	if updated := updateNonAdminRequestStatus(&nonAdminBslRequest.Status, nabsl, approvalDecision); updated {
 		if updateErr := r.Status().Update(ctx, &nonAdminBslRequest); updateErr != nil {
@@ -597,19 +1312,49 @@ func (r *NonAdminBackupStorageLocationReconciler) createNonAdminRequest(ctx cont
 	return true, nil
 }
 
+// requiresApproval returns true if the NonAdminBackupStorageLocationRequest of the NonAdminBackupStorageLocation
+// needs the approval of the cluster admin, or of an auto approval rule. The roles of a cloud identity trust Velero,
+// not the namespace, so they always need the approval of the cluster admin, even if RequireApprovalForBSL is not set.
+func (r *NonAdminBackupStorageLocationReconciler) requiresApproval(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) bool {
+	return r.RequireApprovalForBSL || nabsl.Spec.CloudIdentity != nil
+}
+
+// isAutoApproved returns true if the NonAdminBackupStorageLocation matches a rule of the AutoApprovalConfigMap.
+// Errors are logged, and leave the approval to the cluster admin.
+func (r *NonAdminBackupStorageLocationReconciler) isAutoApproved(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) bool {
+	// The roles of a cloud identity trust Velero, not the namespace, so the cluster admin approves each of them
+	if nabsl.Spec.CloudIdentity != nil {
+		logger.V(1).Info("NonAdminBackupStorageLocation uses a cloud identity, approval is left to the cluster admin")
+		return false
+	}
+	autoApproved, err := function.IsBackupStorageLocationAutoApproved(ctx, r.Client, r.OADPNamespace, r.AutoApprovalConfigMap, nabsl.Namespace, nabsl.Spec.BackupStorageLocationSpec)
+	if err != nil {
+		logger.Error(err, "Failed to check NonAdminBackupStorageLocation auto approval rules, approval is left to the cluster admin")
+		return false
+	}
+	return autoApproved
+}
+
 // syncSecrets creates the VeleroBackupStorageLocation secret in the OADP namespace
 func (r *NonAdminBackupStorageLocationReconciler) syncSecrets(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
-	// Skip syncing if the VeleroBackupStorageLocation UUID is not set or the source secret is not set in the spec
+	// Skip syncing if the VeleroBackupStorageLocation UUID is not set or neither the source secret
+	// nor the cloud identity is set in the spec
 	if nabsl.Status.VeleroBackupStorageLocation == nil ||
 		nabsl.Status.VeleroBackupStorageLocation.NACUUID == constant.EmptyString ||
-		nabsl.Spec.BackupStorageLocationSpec.Credential == nil ||
-		nabsl.Spec.BackupStorageLocationSpec.Credential.Name == constant.EmptyString {
+		(nabsl.Spec.CloudIdentity == nil && (nabsl.Spec.BackupStorageLocationSpec.Credential == nil ||
+			nabsl.Spec.BackupStorageLocationSpec.Credential.Name == constant.EmptyString)) {
 		return false, nil
 	}
 
-	// Get the source secret from the NonAdminBackupStorageLocation namespace
+	// Get the source secret from the NonAdminBackupStorageLocation namespace, or render
+	// the credential file of the cloud identity, which has no source secret
 	sourceNaBSLSecret := &corev1.Secret{}
-	if err := r.Get(ctx, types.NamespacedName{
+	if nabsl.Spec.CloudIdentity != nil {
+		sourceNaBSLSecret.Type = corev1.SecretTypeOpaque
+		sourceNaBSLSecret.Data = map[string][]byte{
+			constant.CloudIdentityCredentialKey: function.GetBslCloudIdentityCredentials(nabsl.Spec.CloudIdentity),
+		}
+	} else if err := r.Get(ctx, types.NamespacedName{
 		Namespace: nabsl.Namespace,
 		Name:      nabsl.Spec.BackupStorageLocationSpec.Credential.Name,
 	}, sourceNaBSLSecret); err != nil {
@@ -628,6 +1373,11 @@ func (r *NonAdminBackupStorageLocationReconciler) syncSecrets(ctx context.Contex
 
 	if veleroBslSecret == nil {
 		logger.Info("Velero BSL Secret with label not found, creating one", "oadpnamespace", r.OADPNamespace, constant.UUIDString, veleroObjectsNACUUID)
+		// The Secret was synced before, so it was deleted out-of-band
+		if meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionSecretSynced)) != nil {
+			r.recordEvent(nabsl, corev1.EventTypeWarning, "VeleroBackupStorageLocationSecretRecreated",
+				"Velero BackupStorageLocation Secret was deleted from the OADP namespace, creating a new one")
+		}
 
 		veleroBslSecret = builder.ForSecret(r.OADPNamespace, veleroObjectsNACUUID).
 			ObjectMeta(
@@ -639,80 +1389,106 @@ func (r *NonAdminBackupStorageLocationReconciler) syncSecrets(ctx context.Contex
 			).Result()
 	}
 
-	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, veleroBslSecret, func() error {
-		// Do not Sync additional labels and annotations from source secret
-		// This could lead to unexpected behavior if the user specifies
-		// nac specific labels or annotations on the source secret
-
-		// Sync secret data
-		veleroBslSecret.Type = sourceNaBSLSecret.Type
-		veleroBslSecret.Data = make(map[string][]byte)
-		for k, v := range sourceNaBSLSecret.Data {
-			veleroBslSecret.Data[k] = v
-		}
-		return nil
-	})
-
+	// Only the selected profile of the credentials file is synced
+	sourceData := sourceNaBSLSecret.Data
+	var op controllerutil.OperationResult
+	if profile := function.GetBslCredentialProfile(nabsl); profile != constant.EmptyString {
+		credentialKey := nabsl.Spec.BackupStorageLocationSpec.Credential.Key
+		var credentials []byte
+		credentials, err = function.RenderBslCredentialProfile(sourceNaBSLSecret.Data[credentialKey], profile)
+		sourceData = map[string][]byte{credentialKey: credentials}
+	}
+	if err == nil {
+		op, err = syncLocationSecret(ctx, r.Client, veleroBslSecret, sourceNaBSLSecret.Type, sourceData)
+	}
 	if err != nil {
 		logger.Error(err, "Failed to sync secret to OADP namespace")
-		updatedCondition := meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
-			Type:    string(nacv1alpha1.NonAdminBSLConditionSecretSynced),
-			Status:  metav1.ConditionFalse,
-			Reason:  "SecretSyncFailed",
-			Message: "Failed to sync secret to OADP namespace",
-		})
-		if updatedCondition {
-			if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
-				logger.Error(updateErr, failedUpdateStatusError)
-				return false, updateErr
-			}
-		}
-		return false, err
-	}
-
-	secretSyncedCondition := false
-
-	switch op {
-	case controllerutil.OperationResultCreated:
-		logger.V(1).Info("VeleroBackupStorageLocation secret created successfully",
-			constant.NamespaceString, veleroBslSecret.Namespace,
-			constant.NameString, veleroBslSecret.Name)
-		// Use case where secret was removed from OADP instance and needs to be re-created
-		meta.RemoveStatusCondition(&nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionSecretSynced))
-		secretSyncedCondition = meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
-			Type:    string(nacv1alpha1.NonAdminBSLConditionSecretSynced),
-			Status:  metav1.ConditionTrue,
-			Reason:  "SecretCreated",
-			Message: "Secret successfully created in the OADP namespace",
-		})
-	case controllerutil.OperationResultUpdated:
-		logger.V(1).Info("VeleroBackupStorageLocation secret updated successfully",
-			constant.NamespaceString, veleroBslSecret.Namespace,
-			constant.NameString, veleroBslSecret.Name)
-		// Ensure last transition time is correctly showing last update
-		meta.RemoveStatusCondition(&nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionSecretSynced))
-		secretSyncedCondition = meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
-			Type:    string(nacv1alpha1.NonAdminBSLConditionSecretSynced),
-			Status:  metav1.ConditionTrue,
-			Reason:  "SecretUpdated",
-			Message: "Secret successfully updated in the OADP namespace",
-		})
-	case controllerutil.OperationResultNone:
-		logger.V(1).Info("VeleroBackupStorageLocation secret unchanged",
+	} else {
+		logger.V(1).Info("VeleroBackupStorageLocation secret synced", "operation", op,
 			constant.NamespaceString, veleroBslSecret.Namespace,
 			constant.NameString, veleroBslSecret.Name)
 	}
-
-	if secretSyncedCondition {
+	if setLocationSecretSyncedCondition(&nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionSecretSynced), op, err) {
 		if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
 			logger.Error(updateErr, failedUpdateStatusError)
 			return false, updateErr
 		}
 	}
+	if err != nil {
+		return false, err
+	}
 
+	// Rotated credentials are only used by Velero once it validates the VeleroBackupStorageLocation again
+	if op == controllerutil.OperationResultUpdated {
+		if err := r.revalidateVeleroBSL(ctx, logger, veleroObjectsNACUUID); err != nil {
+			return false, err
+		}
+	}
 	return false, nil
 }
 
+// syncLocationSecret creates or updates the Secret of a NonAdminBackupStorageLocation or a
+// NonAdminVolumeSnapshotLocation in the OADP namespace, with the type and data of its source Secret. Labels and
+// annotations of the source Secret are not synced, NAC ones set by the user could lead to unexpected behavior.
+// It is shared by the NonAdminBackupStorageLocation and NonAdminVolumeSnapshotLocation controllers.
+func syncLocationSecret(ctx context.Context, clientInstance client.Client, secret *corev1.Secret, secretType corev1.SecretType, data map[string][]byte) (controllerutil.OperationResult, error) {
+	return controllerutil.CreateOrUpdate(ctx, clientInstance, secret, func() error {
+		secret.Type = secretType
+		secret.Data = make(map[string][]byte, len(data))
+		for key, value := range data {
+			secret.Data[key] = value
+		}
+		return nil
+	})
+}
+
+// setLocationSecretSyncedCondition sets the SecretSynced condition of a NonAdminBackupStorageLocation or a
+// NonAdminVolumeSnapshotLocation from the result of syncLocationSecret, and returns true if it changed. A created
+// or updated Secret sets the condition again, so its last transition time shows the last sync.
+func setLocationSecretSyncedCondition(conditions *[]metav1.Condition, conditionType string, op controllerutil.OperationResult, syncErr error) bool {
+	condition := metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  "SecretSynced",
+		Message: "Secret successfully synced to the OADP namespace",
+	}
+	switch {
+	case syncErr != nil:
+		condition.Status, condition.Reason, condition.Message = metav1.ConditionFalse, "SecretSyncFailed", "Failed to sync secret to OADP namespace"
+	case op == controllerutil.OperationResultCreated:
+		condition.Reason, condition.Message = "SecretCreated", "Secret successfully created in the OADP namespace"
+		meta.RemoveStatusCondition(conditions, conditionType)
+	case op == controllerutil.OperationResultUpdated:
+		condition.Reason, condition.Message = "SecretUpdated", "Secret successfully updated in the OADP namespace"
+		meta.RemoveStatusCondition(conditions, conditionType)
+	case meta.FindStatusCondition(*conditions, conditionType) != nil:
+		return false
+	}
+	return meta.SetStatusCondition(conditions, condition)
+}
+
+// revalidateVeleroBSL clears the last validation time of the VeleroBackupStorageLocation, which makes
+// Velero validate it again right away instead of after its validation frequency
+func (r *NonAdminBackupStorageLocationReconciler) revalidateVeleroBSL(ctx context.Context, logger logr.Logger, veleroObjectsNACUUID string) error {
+	veleroBsl, err := function.GetVeleroBackupStorageLocationByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		logger.Error(err, "Failed to get VeleroBackupStorageLocation", constant.UUIDString, veleroObjectsNACUUID)
+		return err
+	}
+	if veleroBsl == nil || veleroBsl.Status.LastValidationTime == nil {
+		return nil
+	}
+
+	original := veleroBsl.DeepCopy()
+	veleroBsl.Status.LastValidationTime = nil
+	if err := r.Status().Patch(ctx, veleroBsl, client.MergeFrom(original)); err != nil {
+		logger.Error(err, "Failed to request VeleroBackupStorageLocation validation", constant.NameString, veleroBsl.Name)
+		return err
+	}
+	logger.V(1).Info("VeleroBackupStorageLocation validation requested", constant.NameString, veleroBsl.Name)
+	return nil
+}
+
 // createVeleroBSL creates a VeleroBackupStorageLocation and syncs its status with NonAdminBackupStorageLocation
 func (r *NonAdminBackupStorageLocationReconciler) createVeleroBSL(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
 	if nabsl.Status.VeleroBackupStorageLocation == nil ||
@@ -741,9 +1517,27 @@ func (r *NonAdminBackupStorageLocationReconciler) createVeleroBSL(ctx context.Co
 		return false, err
 	}
 
+	driftedFields := []string{}
+	if veleroBsl != nil {
+		driftedFields, err = function.GetBslDriftedFields(veleroBsl)
+		if err != nil {
+			// The spec is applied again, recording a valid last applied spec
+			logger.Error(err, "Failed to compare VeleroBackupStorageLocation with its last applied spec", constant.NameString, veleroBsl.Name)
+			driftedFields = []string{}
+		}
+	}
+	if len(driftedFields) > 0 && r.DisableDriftRevert {
+		return false, r.reportVeleroBSLDrift(ctx, logger, nabsl, driftedFields)
+	}
+
 	// Create VeleroBackupStorageLocation
 	if veleroBsl == nil {
 		logger.Info("Velero BSL with label not found, creating one", "oadpnamespace", r.OADPNamespace, constant.UUIDString, veleroObjectsNACUUID)
+		// The VeleroBackupStorageLocation was synced before, so it was deleted out-of-band
+		if meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionBSLSynced)) != nil {
+			r.recordEvent(nabsl, corev1.EventTypeWarning, "VeleroBackupStorageLocationRecreated",
+				"Velero BackupStorageLocation was deleted from the OADP namespace, creating a new one")
+		}
 
 		veleroBsl = builder.ForBackupStorageLocation(r.OADPNamespace, veleroObjectsNACUUID).
 			ObjectMeta(
@@ -755,7 +1549,15 @@ func (r *NonAdminBackupStorageLocationReconciler) createVeleroBSL(ctx context.Co
 			).Result()
 	}
 
-	enforcedBSLSpec := getEnforcedBSLSpec(nabsl, r.EnforcedBslSpec)
+	enforcedBSLSpec, enforcedFields := getEnforcedBSLSpec(nabsl, r.EnforcedBslSpec)
+	backupSyncPeriod, validationFrequency := enforcedBSLSpec.BackupSyncPeriod, enforcedBSLSpec.ValidationFrequency
+	function.ApplyBslMinimumPeriods(enforcedBSLSpec, r.MinBackupSyncPeriod, r.MinValidationFrequency)
+	if !reflect.DeepEqual(backupSyncPeriod, enforcedBSLSpec.BackupSyncPeriod) && !slices.Contains(enforcedFields, "backupSyncPeriod") {
+		enforcedFields = append(enforcedFields, "backupSyncPeriod")
+	}
+	if !reflect.DeepEqual(validationFrequency, enforcedBSLSpec.ValidationFrequency) && !slices.Contains(enforcedFields, "validationFrequency") {
+		enforcedFields = append(enforcedFields, "validationFrequency")
+	}
 
 	err = oadpcommon.UpdateBackupStorageLocation(veleroBsl, *enforcedBSLSpec)
 
@@ -769,23 +1571,69 @@ func (r *NonAdminBackupStorageLocationReconciler) createVeleroBSL(ctx context.Co
 	//    If an enforced spec prefix is set, the user must specify a prefix that matches the enforced spec. In such
 	//    case, the <non-admin-ns>/<enforced-spec-prefix> will be used
 	// 2. If none of the above, then we will use the non-admin user's namespace name as prefix
-	prefix := function.ComputePrefixForObjectStorage(nabsl.Namespace, enforcedBSLSpec.ObjectStorage.Prefix)
+	// The admin user can replace <non-admin-ns> with the rendered PrefixTemplate, for example tenants/<non-admin-ns>
+	// A transferred NonAdminBackupStorageLocation keeps the prefix of the namespace it was created in
+	prefixNamespace := nabsl.Namespace
+	nabslRequest, err := function.GetNabslRequestByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		logger.Error(err, findSingleNABSLRequestError)
+		return false, err
+	}
+	if nabslRequest != nil && nabslRequest.Annotations[constant.NabslPrefixNamespaceAnnotation] != constant.EmptyString {
+		prefixNamespace = nabslRequest.Annotations[constant.NabslPrefixNamespaceAnnotation]
+	}
+	prefix := function.ComputePrefixForObjectStorage(
+		function.RenderBslPrefixTemplate(r.PrefixTemplate, prefixNamespace, nabsl.Name), enforcedBSLSpec.ObjectStorage.Prefix)
+
+	caCert, err := function.GetBslCACert(ctx, r.Client, nabsl)
+	if err != nil {
+		logger.Error(err, "Failed to get VeleroBackupStorageLocation CA bundle")
+		return false, err
+	}
 
 	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, veleroBsl, func() error {
 		veleroBsl.Spec = *enforcedBSLSpec
 
 		// Set Credential separately
+		credentialKey := constant.CloudIdentityCredentialKey
+		if nabsl.Spec.CloudIdentity == nil {
+			credentialKey = nabsl.Spec.BackupStorageLocationSpec.Credential.Key
+		}
 		veleroBsl.Spec.Credential = &corev1.SecretKeySelector{
 			LocalObjectReference: corev1.LocalObjectReference{
 				Name: veleroBslSecret.Name,
 			},
-			Key: nabsl.Spec.BackupStorageLocationSpec.Credential.Key,
+			Key: credentialKey,
+		}
+
+		// Set the config the cloud identity requires
+		for key, value := range function.GetBslCloudIdentityConfig(nabsl.Spec.CloudIdentity) {
+			if veleroBsl.Spec.Config == nil {
+				veleroBsl.Spec.Config = map[string]string{}
+			}
+			veleroBsl.Spec.Config[key] = value
 		}
 
 		// Set prefix
 		veleroBsl.Spec.ObjectStorage.Prefix = prefix
 
-		return nil
+		// A read-only NonAdminBackupStorageLocation can not be made writable by the enforced spec
+		if nabsl.Spec.AccessMode == velerov1.BackupStorageLocationAccessModeReadOnly {
+			veleroBsl.Spec.AccessMode = velerov1.BackupStorageLocationAccessModeReadOnly
+		}
+
+		// A paused NonAdminBackupStorageLocation gets no new backups and its backups are not synced,
+		// Velero keeps validating it so its availability is still reported
+		if nabsl.Spec.Paused {
+			veleroBsl.Spec.AccessMode = velerov1.BackupStorageLocationAccessModeReadOnly
+			veleroBsl.Spec.BackupSyncPeriod = &metav1.Duration{}
+		}
+
+		if caCert != nil {
+			veleroBsl.Spec.ObjectStorage.CACert = caCert
+		}
+
+		return function.SetBslLastAppliedSpec(veleroBsl)
 	})
 
 	bslCondition := false
@@ -839,6 +1687,10 @@ func (r *NonAdminBackupStorageLocationReconciler) createVeleroBSL(ctx context.Co
 			Reason:  "BackupStorageLocationUpdated",
 			Message: "BackupStorageLocation successfully updated in the OADP namespace",
 		})
+		// Velero validates the VeleroBackupStorageLocation again with the updated spec, for example a new CA bundle
+		if revalidateErr := r.revalidateVeleroBSL(ctx, logger, veleroObjectsNACUUID); revalidateErr != nil {
+			return false, revalidateErr
+		}
 	case controllerutil.OperationResultNone:
 		logger.V(1).Info("VeleroBackupStorageLocation unchanged",
 			constant.NamespaceString, veleroBsl.Namespace,
@@ -846,7 +1698,31 @@ func (r *NonAdminBackupStorageLocationReconciler) createVeleroBSL(ctx context.Co
 	}
 	updatedPhase := updateNonAdminPhase(&nabsl.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
 
-	if bslCondition || updatedPhase {
+	updatedEnforcedFields := updateNaBSLEnforcedFieldsStatus(&nabsl.Status, enforcedFields)
+
+	updatedDrift := false
+	if len(driftedFields) > 0 {
+		message := fmt.Sprintf("BackupStorageLocation fields modified out-of-band were reverted: %s", strings.Join(driftedFields, ", "))
+		r.recordEvent(nabsl, corev1.EventTypeWarning, "VeleroBackupStorageLocationDriftReverted", message)
+		meta.RemoveStatusCondition(&nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionConfigDrift))
+		updatedDrift = meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminBSLConditionConfigDrift),
+			Status:  metav1.ConditionFalse,
+			Reason:  "DriftReverted",
+			Message: message,
+		})
+	} else if meta.IsStatusConditionTrue(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionConfigDrift)) {
+		updatedDrift = meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminBSLConditionConfigDrift),
+			Status:  metav1.ConditionFalse,
+			Reason:  "DriftResolved",
+			Message: "BackupStorageLocation matches the NonAdminBackupStorageLocation again",
+		})
+	}
+
+	updatedPaused := r.updateNaBSLPausedCondition(nabsl)
+
+	if bslCondition || updatedPhase || updatedEnforcedFields || updatedDrift || updatedPaused {
 		if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
 			logger.Error(updateErr, failedUpdateStatusError)
 			return false, updateErr
@@ -856,6 +1732,58 @@ func (r *NonAdminBackupStorageLocationReconciler) createVeleroBSL(ctx context.Co
 	return false, nil
 }
 
+// updateNaBSLPausedCondition sets the Paused condition of the NonAdminBackupStorageLocation from its spec.paused field,
+// recording an event when it is paused or resumed. It returns true if the condition changed.
+func (r *NonAdminBackupStorageLocationReconciler) updateNaBSLPausedCondition(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) bool {
+	if nabsl.Spec.Paused {
+		updated := meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminBSLConditionPaused),
+			Status:  metav1.ConditionTrue,
+			Reason:  "BackupStorageLocationPaused",
+			Message: "BackupStorageLocation is ReadOnly and its backups are not synced until spec.paused is unset",
+		})
+		if updated {
+			r.recordEvent(nabsl, corev1.EventTypeNormal, "NonAdminBackupStorageLocationPaused",
+				"NonAdminBackupStorageLocation taken out of service by spec.paused")
+		}
+		return updated
+	}
+	if !meta.IsStatusConditionTrue(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionPaused)) {
+		return false
+	}
+	r.recordEvent(nabsl, corev1.EventTypeNormal, "NonAdminBackupStorageLocationResumed",
+		"NonAdminBackupStorageLocation back in service")
+	return meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionPaused),
+		Status:  metav1.ConditionFalse,
+		Reason:  "BackupStorageLocationResumed",
+		Message: "BackupStorageLocation is back in service",
+	})
+}
+
+// reportVeleroBSLDrift sets the ConfigDrift condition of the NonAdminBackupStorageLocation with the fields of its
+// VeleroBackupStorageLocation modified out-of-band, which are kept as is
+func (r *NonAdminBackupStorageLocationReconciler) reportVeleroBSLDrift(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation, driftedFields []string) error {
+	message := fmt.Sprintf("BackupStorageLocation fields were modified out-of-band and differ from the NonAdminBackupStorageLocation: %s",
+		strings.Join(driftedFields, ", "))
+	logger.V(1).Info("VeleroBackupStorageLocation drift detected", "fields", driftedFields)
+	updated := meta.SetStatusCondition(&nabsl.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionConfigDrift),
+		Status:  metav1.ConditionTrue,
+		Reason:  "DriftDetected",
+		Message: message,
+	})
+	if !updated {
+		return nil
+	}
+	r.recordEvent(nabsl, corev1.EventTypeWarning, "VeleroBackupStorageLocationDriftDetected", message)
+	if updateErr := r.Status().Update(ctx, nabsl); updateErr != nil {
+		logger.Error(updateErr, failedUpdateStatusError)
+		return updateErr
+	}
+	return nil
+}
+
 // syncStatus
 func (r *NonAdminBackupStorageLocationReconciler) syncStatus(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
 	veleroObjectsNACUUID := nabsl.Status.VeleroBackupStorageLocation.NACUUID
@@ -871,6 +1799,18 @@ func (r *NonAdminBackupStorageLocationReconciler) syncStatus(ctx context.Context
 	// with the VeleroBackup. Any required updates to the NonAdminBackup
 	// Status will be applied based on the current state of the VeleroBackup.
 	updated := updateNaBSLVeleroBackupStorageLocationStatus(&nabsl.Status, veleroBsl)
+	updated = updateNaBSLLastSuccessfulValidationTime(&nabsl.Status, veleroBsl) || updated
+	updated = updateNaBSLLastSyncedTime(&nabsl.Status, veleroBsl) || updated
+
+	if veleroBsl != nil {
+		backupSummary, summaryErr := function.GetBackupSummaryForStorageLocation(ctx, r.Client, r.OADPNamespace, veleroBsl.Name)
+		if summaryErr != nil {
+			logger.Error(summaryErr, "Failed to summarize backups stored in VeleroBackupStorageLocation", constant.NameString, veleroBsl.Name)
+			return false, summaryErr
+		}
+		updated = updateNaBSLBackupSummaryStatus(&nabsl.Status, backupSummary, r.StorageUsagePeriod) || updated
+	}
+
 	if updated {
 		if err := r.Status().Update(ctx, nabsl); err != nil {
 			logger.Error(err, "Failed to update NonAdminBackupStorageLocation Status after VeleroBackupStorageLocation reconciliation")
@@ -884,6 +1824,151 @@ func (r *NonAdminBackupStorageLocationReconciler) syncStatus(ctx context.Context
 	return false, nil
 }
 
+// objectStorageUnavailableReasons classifies the validation error Velero reports for an unavailable
+// VeleroBackupStorageLocation, by error fragments of the object storage providers and Go networking
+var objectStorageUnavailableReasons = []struct {
+	reason    string
+	fragments []string
+}{
+	{
+		reason: "AuthenticationFailed",
+		fragments: []string{
+			"accessdenied", "invalidaccesskeyid", "signaturedoesnotmatch", "expiredtoken", "nocredentialproviders",
+			"authenticationfailed", "authorizationfailure", "authorizationpermissionmismatch", "unauthorized",
+			"invalid_grant", "forbidden", "status code: 403", "error 403", "statuscode=403",
+		},
+	},
+	{
+		reason: "BucketNotFound",
+		fragments: []string{
+			"nosuchbucket", "bucket does not exist", "bucket doesn't exist", "containernotfound",
+			"container does not exist", "status code: 404", "error 404", "statuscode=404",
+		},
+	},
+	{
+		reason: "NetworkUnreachable",
+		fragments: []string{
+			"dial tcp", "no such host", "connection refused", "connection reset", "i/o timeout",
+			"network is unreachable", "tls handshake", "x509:", "context deadline exceeded",
+		},
+	},
+}
+
+// objectStorageUnavailableReason returns the ObjectStorageAvailable condition reason of an unavailable
+// VeleroBackupStorageLocation, from the validation error reported by Velero
+func objectStorageUnavailableReason(message string) string {
+	message = strings.ToLower(message)
+	for _, unavailableReason := range objectStorageUnavailableReasons {
+		for _, fragment := range unavailableReason.fragments {
+			if strings.Contains(message, fragment) {
+				return unavailableReason.reason
+			}
+		}
+	}
+	return "ObjectStorageUnavailable"
+}
+
+// checkObjectStorageAvailability sets the ObjectStorageAvailable condition from the validation of the
+// VeleroBackupStorageLocation by Velero, with the reason Velero could not reach the bucket, and to False
+// once the VeleroBackupStorageLocation was not validated within the validation deadline
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nabsl: NonAdminBackupStorageLocation object
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminBackupStorageLocationReconciler) checkObjectStorageAvailability(ctx context.Context, logger logr.Logger, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) (bool, error) {
+	if !meta.IsStatusConditionTrue(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionBSLSynced)) {
+		return false, nil
+	}
+
+	condition := metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable),
+		Status:  metav1.ConditionUnknown,
+		Reason:  "ValidationPending",
+		Message: "waiting for Velero to validate the backup storage location",
+	}
+	veleroBslStatus := velerov1.BackupStorageLocationStatus{}
+	if nabsl.Status.VeleroBackupStorageLocation != nil && nabsl.Status.VeleroBackupStorageLocation.Status != nil {
+		veleroBslStatus = *nabsl.Status.VeleroBackupStorageLocation.Status
+	}
+	phase := veleroBslStatus.Phase
+	if veleroBslStatus.LastValidationTime == nil {
+		// not validated yet, or validated again after its credentials were rotated
+		phase = constant.EmptyString
+	}
+	switch phase {
+	case velerov1.BackupStorageLocationPhaseAvailable:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ObjectStorageAvailable"
+		condition.Message = "Velero reached the bucket of the backup storage location"
+	case velerov1.BackupStorageLocationPhaseUnavailable:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = objectStorageUnavailableReason(veleroBslStatus.Message)
+		condition.Message = veleroBslStatus.Message
+		if condition.Message == constant.EmptyString {
+			condition.Message = "Velero could not reach the bucket of the backup storage location"
+		}
+	default:
+		if frequency := nabsl.Spec.BackupStorageLocationSpec.ValidationFrequency; frequency != nil && frequency.Duration == 0 {
+			condition.Reason = "ValidationDisabled"
+			condition.Message = "Velero validation of the backup storage location is disabled by its validationFrequency"
+			break
+		}
+		pending := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable))
+		if r.ValidationDeadline > 0 && pending != nil &&
+			(pending.Reason == "ValidationTimeout" ||
+				(pending.Reason == condition.Reason && time.Since(pending.LastTransitionTime.Time) >= r.ValidationDeadline)) {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "ValidationTimeout"
+			condition.Message = fmt.Sprintf("Velero did not validate the backup storage location within %s", r.ValidationDeadline)
+		}
+	}
+
+	if meta.SetStatusCondition(&nabsl.Status.Conditions, condition) {
+		if err := r.Status().Update(ctx, nabsl); err != nil {
+			logger.Error(err, failedUpdateConditionError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackupStorageLocation condition set to ObjectStorageAvailable", "status", condition.Status, "reason", condition.Reason)
+	}
+	return false, nil
+}
+
+// storageUsageRequeueAfter returns when the backup summary of the NonAdminBackupStorageLocation has to be
+// computed again, zero if the StorageUsagePeriod is not set or the NonAdminBackupStorageLocation is not created
+func (r *NonAdminBackupStorageLocationReconciler) storageUsageRequeueAfter(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) time.Duration {
+	if r.StorageUsagePeriod <= 0 || !nabsl.DeletionTimestamp.IsZero() || nabsl.Status.Phase != nacv1alpha1.NonAdminPhaseCreated {
+		return 0
+	}
+	if nabsl.Status.BackupSummary == nil || nabsl.Status.BackupSummary.UpdateTime == nil {
+		return r.StorageUsagePeriod
+	}
+	remaining := time.Until(nabsl.Status.BackupSummary.UpdateTime.Add(r.StorageUsagePeriod))
+	if remaining <= 0 {
+		return time.Second
+	}
+	return remaining
+}
+
+// validationDeadlineRequeueAfter returns when the validation deadline of the VeleroBackupStorageLocation
+// is exceeded, zero if it has none or Velero already validated it
+func (r *NonAdminBackupStorageLocationReconciler) validationDeadlineRequeueAfter(nabsl *nacv1alpha1.NonAdminBackupStorageLocation) time.Duration {
+	pending := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable))
+	if r.ValidationDeadline <= 0 || !nabsl.DeletionTimestamp.IsZero() || pending == nil || pending.Reason != "ValidationPending" {
+		return 0
+	}
+	remaining := time.Until(pending.LastTransitionTime.Add(r.ValidationDeadline))
+	if remaining <= 0 {
+		// expired between the check and now, come back right away
+		return time.Second
+	}
+	return remaining
+}
+
 // updateNaBSLVeleroBackupStorageLocationStatus sets the VeleroBackupStorageLocation status field in NonAdminBackupStorageLocation object status and returns true
 // if the VeleroBackupStorageLocation fields are changed by this call.
 func updateNaBSLVeleroBackupStorageLocationStatus(status *nacv1alpha1.NonAdminBackupStorageLocationStatus, veleroBackupStorageLocation *velerov1.BackupStorageLocation) bool {
@@ -910,15 +1995,63 @@ func updateNaBSLVeleroBackupStorageLocationStatus(status *nacv1alpha1.NonAdminBa
 	return true
 }
 
+// updateNaBSLLastSuccessfulValidationTime sets the LastSuccessfulValidationTime field in NonAdminBackupStorageLocation
+// object status to the last validation time of the VeleroBackupStorageLocation, if it is available, and returns true
+// if the LastSuccessfulValidationTime is changed by this call.
+func updateNaBSLLastSuccessfulValidationTime(status *nacv1alpha1.NonAdminBackupStorageLocationStatus, veleroBackupStorageLocation *velerov1.BackupStorageLocation) bool {
+	if status == nil || veleroBackupStorageLocation == nil ||
+		veleroBackupStorageLocation.Status.Phase != velerov1.BackupStorageLocationPhaseAvailable ||
+		veleroBackupStorageLocation.Status.LastValidationTime == nil ||
+		veleroBackupStorageLocation.Status.LastValidationTime.Equal(status.LastSuccessfulValidationTime) {
+		return false
+	}
+	status.LastSuccessfulValidationTime = veleroBackupStorageLocation.Status.LastValidationTime.DeepCopy()
+	return true
+}
+
+// updateNaBSLLastSyncedTime sets the LastSyncedTime field in NonAdminBackupStorageLocation object status to the
+// last sync time of the VeleroBackupStorageLocation and returns true if the LastSyncedTime is changed by this call.
+func updateNaBSLLastSyncedTime(status *nacv1alpha1.NonAdminBackupStorageLocationStatus, veleroBackupStorageLocation *velerov1.BackupStorageLocation) bool {
+	if status == nil || veleroBackupStorageLocation == nil ||
+		veleroBackupStorageLocation.Status.LastSyncedTime == nil ||
+		veleroBackupStorageLocation.Status.LastSyncedTime.Equal(status.LastSyncedTime) {
+		return false
+	}
+	status.LastSyncedTime = veleroBackupStorageLocation.Status.LastSyncedTime.DeepCopy()
+	return true
+}
+
+// updateNaBSLBackupSummaryStatus sets the BackupSummary field in NonAdminBackupStorageLocation object status and returns true
+// if the BackupSummary is changed by this call. An unchanged BackupSummary older than the storage usage period also gets
+// a new update time, so the status shows when it was last computed.
+func updateNaBSLBackupSummaryStatus(status *nacv1alpha1.NonAdminBackupStorageLocationStatus, backupSummary *nacv1alpha1.BackupSummary, storageUsagePeriod time.Duration) bool {
+	if status == nil || backupSummary == nil {
+		return false
+	}
+	if status.BackupSummary != nil {
+		previous := status.BackupSummary.DeepCopy()
+		previous.UpdateTime = nil
+		if reflect.DeepEqual(previous, backupSummary) && (storageUsagePeriod <= 0 ||
+			(status.BackupSummary.UpdateTime != nil && time.Since(status.BackupSummary.UpdateTime.Time) < storageUsagePeriod)) {
+			return false
+		}
+	}
+	now := metav1.Now()
+	backupSummary.UpdateTime = &now
+	status.BackupSummary = backupSummary
+	return true
+}
+
 // updateNonAdminRequestStatus updates the NonAdminBackupStorageLocationRequest status field
 // in NonAdminBackupStorageLocationRequest object status and returns true if the fields are changed.
 func updateNonAdminRequestStatus(status *nacv1alpha1.NonAdminBackupStorageLocationRequestStatus, nabsl *nacv1alpha1.NonAdminBackupStorageLocation, nabslApprovalDecision nacv1alpha1.NonAdminBSLRequest) bool {
 	updatedStatus := nacv1alpha1.NonAdminBackupStorageLocationRequestStatus{
 		SourceNonAdminBSL: &nacv1alpha1.SourceNonAdminBSL{
-			NACUUID:       nabsl.Status.VeleroBackupStorageLocation.NACUUID,
-			Name:          nabsl.Name,
-			Namespace:     nabsl.Namespace,
-			RequestedSpec: nabsl.Spec.BackupStorageLocationSpec.DeepCopy(),
+			NACUUID:                nabsl.Status.VeleroBackupStorageLocation.NACUUID,
+			Name:                   nabsl.Name,
+			Namespace:              nabsl.Namespace,
+			RequestedSpec:          nabsl.Spec.BackupStorageLocationSpec.DeepCopy(),
+			RequestedCloudIdentity: nabsl.Spec.CloudIdentity.DeepCopy(),
 		},
 	}
 
@@ -933,21 +2066,88 @@ func updateNonAdminRequestStatus(status *nacv1alpha1.NonAdminBackupStorageLocati
 	return false
 }
 
-// getEnforcedBSLSpec returns a deep copy of the NonAdminBackupStorageLocation's spec with the enforced fields from the enforcedBSLSpec
-func getEnforcedBSLSpec(nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation, enforcedBSLSpec *oadpv1alpha1.EnforceBackupStorageLocationSpec) *velerov1.BackupStorageLocationSpec {
+// getEnforcedBSLSpec returns a deep copy of the NonAdminBackupStorageLocation's spec with the enforced fields from the enforcedBSLSpec,
+// and the fields it sets. The enforced config keys and objectStorage fields are merged one by one.
+func getEnforcedBSLSpec(nonAdminBsl *nacv1alpha1.NonAdminBackupStorageLocation, enforcedBSLSpec *oadpv1alpha1.EnforceBackupStorageLocationSpec) (*velerov1.BackupStorageLocationSpec, []string) {
 	resultingBslSpec := nonAdminBsl.Spec.BackupStorageLocationSpec.DeepCopy()
-	enforcedSpec := reflect.ValueOf(enforcedBSLSpec).Elem()
+	var enforcedFields []string
+	if enforcedBSLSpec == nil {
+		return resultingBslSpec, enforcedFields
+	}
+	enforcedSpec := reflect.ValueOf(enforcedBSLSpec.DeepCopy()).Elem()
 
 	for index := range enforcedSpec.NumField() {
 		enforcedField := enforcedSpec.Field(index)
 		enforcedFieldName := enforcedSpec.Type().Field(index).Name
-		currentField := reflect.ValueOf(resultingBslSpec).Elem().FieldByName(enforcedFieldName)
-		if !enforcedField.IsZero() && currentField.IsZero() {
-			currentField.Set(enforcedField)
+		if enforcedField.IsZero() {
+			continue
+		}
+		switch enforcedFieldName {
+		case "Config":
+			for _, key := range slices.Sorted(maps.Keys(enforcedBSLSpec.Config)) {
+				value, ok := resultingBslSpec.Config[key]
+				if ok && value == enforcedBSLSpec.Config[key] {
+					continue
+				}
+				if resultingBslSpec.Config == nil {
+					resultingBslSpec.Config = map[string]string{}
+				}
+				resultingBslSpec.Config[key] = enforcedBSLSpec.Config[key]
+				enforcedFields = append(enforcedFields, "config."+key)
+			}
+		case "StorageType":
+			// The enforced objectStorage has its own type, so it is copied field by field
+			enforcedObjectStorage := enforcedBSLSpec.ObjectStorage
+			if enforcedObjectStorage == nil {
+				continue
+			}
+			if resultingBslSpec.ObjectStorage == nil {
+				resultingBslSpec.ObjectStorage = &velerov1.ObjectStorageLocation{}
+			}
+			if resultingBslSpec.ObjectStorage.Bucket == constant.EmptyString && enforcedObjectStorage.Bucket != constant.EmptyString {
+				resultingBslSpec.ObjectStorage.Bucket = enforcedObjectStorage.Bucket
+				enforcedFields = append(enforcedFields, "objectStorage.bucket")
+			}
+			if resultingBslSpec.ObjectStorage.Prefix == constant.EmptyString && enforcedObjectStorage.Prefix != constant.EmptyString {
+				resultingBslSpec.ObjectStorage.Prefix = enforcedObjectStorage.Prefix
+				enforcedFields = append(enforcedFields, "objectStorage.prefix")
+			}
+			if len(resultingBslSpec.ObjectStorage.CACert) == 0 && len(enforcedObjectStorage.CACert) > 0 {
+				resultingBslSpec.ObjectStorage.CACert = slices.Clone(enforcedObjectStorage.CACert)
+				enforcedFields = append(enforcedFields, "objectStorage.caCert")
+			}
+		default:
+			currentField := reflect.ValueOf(resultingBslSpec).Elem().FieldByName(enforcedFieldName)
+			if currentField.IsZero() {
+				currentField.Set(enforcedField)
+				tagName, _, _ := strings.Cut(enforcedSpec.Type().Field(index).Tag.Get(constant.JSONTagString), constant.CommaString)
+				enforcedFields = append(enforcedFields, tagName)
+			}
 		}
 	}
 
-	return resultingBslSpec
+	return resultingBslSpec, enforcedFields
+}
+
+// updateNaBSLEnforcedFieldsStatus sets the EnforcedFields field and the SpecOverridden condition in
+// NonAdminBackupStorageLocation object status and returns true if they are changed by this call.
+func updateNaBSLEnforcedFieldsStatus(status *nacv1alpha1.NonAdminBackupStorageLocationStatus, enforcedFields []string) bool {
+	if len(enforcedFields) == 0 {
+		updated := status.EnforcedFields != nil
+		status.EnforcedFields = nil
+		return meta.RemoveStatusCondition(&status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden)) || updated
+	}
+
+	updated := !slices.Equal(status.EnforcedFields, enforcedFields)
+	status.EnforcedFields = enforcedFields
+	return meta.SetStatusCondition(&status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionSpecOverridden),
+			Status:  metav1.ConditionTrue,
+			Reason:  "EnforcedFieldsApplied",
+			Message: "spec.backupStorageLocationSpec fields set or overridden in the Velero BackupStorageLocation: " + strings.Join(enforcedFields, ", "),
+		},
+	) || updated
 }
 
 // updatePhaseIfNeeded sets the phase based on the approval decision and returns true if the phase changes.
@@ -967,3 +2167,11 @@ func updatePhaseIfNeeded(currentPhase *nacv1alpha1.NonAdminBSLRequestPhase, nabs
 	}
 	return false
 }
+
+// recordEvent emits an event for the NonAdminBackupStorageLocation if an event recorder is configured
+func (r *NonAdminBackupStorageLocationReconciler) recordEvent(nabsl *nacv1alpha1.NonAdminBackupStorageLocation, eventType, reason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(nabsl, eventType, reason, message)
+}
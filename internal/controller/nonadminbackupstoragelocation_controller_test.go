@@ -23,18 +23,24 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
@@ -55,7 +61,7 @@ type nonAdminBackupStorageLocationFullReconcileScenario struct {
 }
 
 func buildTestNonAdminSecretForBsl(nonAdminBslNamespace, nonAdminBslName, awsAccessKeyID, awsSecretAccessKey string) *corev1.Secret {
-	cloudFileContent := fmt.Sprintf(`[default]\naws_access_key_id = %s\naws_secret_access_key = %s\n`, awsAccessKeyID, awsSecretAccessKey)
+	cloudFileContent := fmt.Sprintf("[default]\naws_access_key_id = %s\naws_secret_access_key = %s\n", awsAccessKeyID, awsSecretAccessKey)
 
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -540,3 +546,1412 @@ var _ = ginkgo.Describe("ComputePrefixForObjectStorage", func() {
 		}),
 	)
 })
+
+var _ = ginkgo.DescribeTable("objectStorageUnavailableReason",
+	func(message, expected string) {
+		gomega.Expect(objectStorageUnavailableReason(message)).To(gomega.Equal(expected))
+	},
+	ginkgo.Entry("AWS invalid credentials",
+		"BackupStorageLocation \"test\" is unavailable: rpc error: code = Unknown desc = InvalidAccessKeyId: The AWS Access Key Id you provided does not exist in our records.",
+		"AuthenticationFailed"),
+	ginkgo.Entry("Azure authorization failure",
+		"BackupStorageLocation \"test\" is unavailable: rpc error: code = Unknown desc = AuthorizationFailure",
+		"AuthenticationFailed"),
+	ginkgo.Entry("AWS missing bucket",
+		"BackupStorageLocation \"test\" is unavailable: rpc error: code = Unknown desc = NoSuchBucket: The specified bucket does not exist",
+		"BucketNotFound"),
+	ginkgo.Entry("GCP missing bucket",
+		"BackupStorageLocation \"test\" is unavailable: rpc error: code = Unknown desc = storage: bucket doesn't exist",
+		"BucketNotFound"),
+	ginkgo.Entry("unknown host",
+		"BackupStorageLocation \"test\" is unavailable: rpc error: code = Unknown desc = dial tcp: lookup s3.example.com: no such host",
+		"NetworkUnreachable"),
+	ginkgo.Entry("unknown error",
+		"BackupStorageLocation \"test\" is unavailable: rpc error: code = Unknown desc = something went wrong",
+		"ObjectStorageUnavailable"),
+)
+
+var _ = ginkgo.DescribeTable("getLocationApproval",
+	func(approvalDecision nacv1alpha1.NonAdminBSLRequest, expectedStatus metav1.ConditionStatus, expectedReason string, expectedMessage string, expectedBackingOff bool) {
+		status, reason, message, backingOff := getLocationApproval("NonAdminVolumeSnapshotLocationRequest", "Vsl", approvalDecision)
+		gomega.Expect(status).To(gomega.Equal(expectedStatus))
+		gomega.Expect(reason).To(gomega.Equal(expectedReason))
+		gomega.Expect(message).To(gomega.Equal(expectedMessage))
+		gomega.Expect(backingOff).To(gomega.Equal(expectedBackingOff))
+	},
+	ginkgo.Entry("no decision", nacv1alpha1.NonAdminBSLRequest(constant.EmptyString),
+		metav1.ConditionFalse, "VslSpecApprovalPending", "NonAdminVolumeSnapshotLocationRequest approval pending", false),
+	ginkgo.Entry("approved", nacv1alpha1.NonAdminBSLRequestApproved,
+		metav1.ConditionTrue, "VslSpecApproved", "NonAdminVolumeSnapshotLocationRequest approval decision set to Approve", false),
+	ginkgo.Entry("rejected", nacv1alpha1.NonAdminBSLRequestRejected,
+		metav1.ConditionFalse, "VslSpecRejected", "NonAdminVolumeSnapshotLocationRequest approval decision set to Reject", true),
+	ginkgo.Entry("invalid decision", nacv1alpha1.NonAdminBSLRequest("maybe"),
+		metav1.ConditionFalse, "VslSpecInvalid", "NonAdminVolumeSnapshotLocationRequest approval decision is invalid", true),
+)
+
+var _ = ginkgo.DescribeTable("setLocationSecretSyncedCondition",
+	func(conditions []metav1.Condition, op controllerutil.OperationResult, syncErr error, expectedUpdated bool, expectedStatus metav1.ConditionStatus, expectedReason string) {
+		updated := setLocationSecretSyncedCondition(&conditions, string(nacv1alpha1.NonAdminBSLConditionSecretSynced), op, syncErr)
+		gomega.Expect(updated).To(gomega.Equal(expectedUpdated))
+		condition := meta.FindStatusCondition(conditions, string(nacv1alpha1.NonAdminBSLConditionSecretSynced))
+		gomega.Expect(condition).ToNot(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(expectedStatus))
+		gomega.Expect(condition.Reason).To(gomega.Equal(expectedReason))
+	},
+	ginkgo.Entry("Secret created", nil, controllerutil.OperationResultCreated, nil,
+		true, metav1.ConditionTrue, "SecretCreated"),
+	ginkgo.Entry("Secret updated", []metav1.Condition{{
+		Type: string(nacv1alpha1.NonAdminBSLConditionSecretSynced), Status: metav1.ConditionTrue, Reason: "SecretCreated",
+	}}, controllerutil.OperationResultUpdated, nil, true, metav1.ConditionTrue, "SecretUpdated"),
+	ginkgo.Entry("Secret unchanged", []metav1.Condition{{
+		Type: string(nacv1alpha1.NonAdminBSLConditionSecretSynced), Status: metav1.ConditionTrue, Reason: "SecretCreated",
+	}}, controllerutil.OperationResultNone, nil, false, metav1.ConditionTrue, "SecretCreated"),
+	ginkgo.Entry("Secret unchanged without condition", nil, controllerutil.OperationResultNone, nil,
+		true, metav1.ConditionTrue, "SecretSynced"),
+	ginkgo.Entry("Secret sync failed", []metav1.Condition{{
+		Type: string(nacv1alpha1.NonAdminBSLConditionSecretSynced), Status: metav1.ConditionTrue, Reason: "SecretCreated",
+	}}, controllerutil.OperationResultNone, fmt.Errorf("secrets is forbidden"), true, metav1.ConditionFalse, "SecretSyncFailed"),
+)
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation object storage availability", func() {
+	newNonAdminBackupStorageLocation := func(veleroBslStatus *velerov1.BackupStorageLocationStatus) *nacv1alpha1.NonAdminBackupStorageLocation {
+		return &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-availability", Namespace: "test-nabsl-availability"},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{Status: veleroBslStatus},
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(nacv1alpha1.NonAdminBSLConditionBSLSynced),
+						Status:             metav1.ConditionTrue,
+						Reason:             "BackupStorageLocationCreated",
+						LastTransitionTime: metav1.Now(),
+					},
+				},
+			},
+		}
+	}
+	check := func(r *NonAdminBackupStorageLocationReconciler, nabsl *nacv1alpha1.NonAdminBackupStorageLocation) *metav1.Condition {
+		r.Client = fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+			WithObjects(nabsl).
+			Build()
+		requeue, err := r.checkObjectStorageAvailability(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		return meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable))
+	}
+
+	ginkgo.It("should report the reason Velero could not reach the bucket", func() {
+		condition := check(&NonAdminBackupStorageLocationReconciler{}, newNonAdminBackupStorageLocation(&velerov1.BackupStorageLocationStatus{
+			Phase:              velerov1.BackupStorageLocationPhaseUnavailable,
+			Message:            "rpc error: code = Unknown desc = NoSuchBucket: The specified bucket does not exist",
+			LastValidationTime: ptr.To(metav1.Now()),
+		}))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("BucketNotFound"))
+		gomega.Expect(condition.Message).To(gomega.ContainSubstring("NoSuchBucket"))
+	})
+
+	ginkgo.It("should report an available bucket", func() {
+		condition := check(&NonAdminBackupStorageLocationReconciler{}, newNonAdminBackupStorageLocation(&velerov1.BackupStorageLocationStatus{
+			Phase:              velerov1.BackupStorageLocationPhaseAvailable,
+			LastValidationTime: ptr.To(metav1.Now()),
+		}))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+	})
+
+	ginkgo.It("should wait for Velero to validate the bucket again", func() {
+		condition := check(&NonAdminBackupStorageLocationReconciler{}, newNonAdminBackupStorageLocation(&velerov1.BackupStorageLocationStatus{
+			Phase: velerov1.BackupStorageLocationPhaseAvailable,
+		}))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionUnknown))
+		gomega.Expect(condition.Reason).To(gomega.Equal("ValidationPending"))
+	})
+
+	ginkgo.It("should sync rotated credentials and request the validation of the VeleroBackupStorageLocation", func() {
+		const (
+			namespace = "test-nabsl-rotation"
+			oadp      = "test-nabsl-rotation-oadp"
+			nacUUID   = "test-nabsl-rotation-uuid"
+		)
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-rotation", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+				},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace: oadp,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}, &velerov1.BackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: namespace},
+						Type:       corev1.SecretTypeOpaque,
+						Data:       map[string][]byte{"cloud": []byte("rotated")},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Type: corev1.SecretTypeOpaque,
+						Data: map[string][]byte{"cloud": []byte("stale")},
+					},
+					&velerov1.BackupStorageLocation{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Status: velerov1.BackupStorageLocationStatus{
+							Phase:              velerov1.BackupStorageLocationPhaseAvailable,
+							LastValidationTime: ptr.To(metav1.Now()),
+						},
+					},
+				).
+				Build(),
+		}
+
+		_, err := r.syncSecrets(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		secret := &corev1.Secret{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, secret)).To(gomega.Succeed())
+		gomega.Expect(secret.Data).To(gomega.HaveKeyWithValue("cloud", []byte("rotated")))
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Status.LastValidationTime).To(gomega.BeNil())
+		gomega.Expect(veleroBsl.Status.Phase).To(gomega.Equal(velerov1.BackupStorageLocationPhaseAvailable))
+	})
+
+	ginkgo.It("should sync only the selected profile of the credentials", func() {
+		const (
+			namespace = "test-nabsl-profile"
+			oadp      = "test-nabsl-profile-oadp"
+			nacUUID   = "test-nabsl-profile-uuid"
+		)
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-profile", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider:   "aws",
+					Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+				},
+				Credential: &nacv1alpha1.CredentialOptions{Profile: "tenant"},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace: oadp,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: namespace},
+						Type:       corev1.SecretTypeOpaque,
+						Data: map[string][]byte{
+							"cloud": []byte("[default]\naws_access_key_id = id\naws_secret_access_key = secret\n" +
+								"[tenant]\naws_access_key_id = tenant-id\naws_secret_access_key = tenant-secret\n"),
+							"other": []byte("other"),
+						},
+					},
+				).
+				Build(),
+		}
+
+		_, err := r.syncSecrets(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		secret := &corev1.Secret{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, secret)).To(gomega.Succeed())
+		gomega.Expect(secret.Data).To(gomega.Equal(map[string][]byte{
+			"cloud": []byte("[default]\naws_access_key_id = tenant-id\naws_secret_access_key = tenant-secret\n"),
+		}))
+	})
+
+	ginkgo.It("should record the last successful validation of the VeleroBackupStorageLocation", func() {
+		status := &nacv1alpha1.NonAdminBackupStorageLocationStatus{}
+		available := metav1.NewTime(time.Now().Add(-time.Hour))
+		gomega.Expect(updateNaBSLLastSuccessfulValidationTime(status, &velerov1.BackupStorageLocation{
+			Status: velerov1.BackupStorageLocationStatus{
+				Phase:              velerov1.BackupStorageLocationPhaseAvailable,
+				LastValidationTime: &available,
+			},
+		})).To(gomega.BeTrue())
+		gomega.Expect(status.LastSuccessfulValidationTime.Equal(&available)).To(gomega.BeTrue())
+
+		gomega.Expect(updateNaBSLLastSuccessfulValidationTime(status, &velerov1.BackupStorageLocation{
+			Status: velerov1.BackupStorageLocationStatus{
+				Phase:              velerov1.BackupStorageLocationPhaseUnavailable,
+				LastValidationTime: ptr.To(metav1.Now()),
+				Message:            "BackupStorageLocation is unavailable",
+			},
+		})).To(gomega.BeFalse())
+		gomega.Expect(status.LastSuccessfulValidationTime.Equal(&available)).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should record the last sync of the VeleroBackupStorageLocation", func() {
+		status := &nacv1alpha1.NonAdminBackupStorageLocationStatus{}
+		gomega.Expect(updateNaBSLLastSyncedTime(status, &velerov1.BackupStorageLocation{})).To(gomega.BeFalse())
+		gomega.Expect(status.LastSyncedTime).To(gomega.BeNil())
+
+		synced := metav1.NewTime(time.Now().Add(-time.Minute))
+		gomega.Expect(updateNaBSLLastSyncedTime(status, &velerov1.BackupStorageLocation{
+			Status: velerov1.BackupStorageLocationStatus{LastSyncedTime: &synced},
+		})).To(gomega.BeTrue())
+		gomega.Expect(status.LastSyncedTime.Equal(&synced)).To(gomega.BeTrue())
+
+		gomega.Expect(updateNaBSLLastSyncedTime(status, &velerov1.BackupStorageLocation{
+			Status: velerov1.BackupStorageLocationStatus{LastSyncedTime: synced.DeepCopy()},
+		})).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("should compute the storage usage again after the storage usage period", func() {
+		status := &nacv1alpha1.NonAdminBackupStorageLocationStatus{}
+		gomega.Expect(updateNaBSLBackupSummaryStatus(status, &nacv1alpha1.BackupSummary{BackupCount: 1, TotalBytes: 1024}, time.Hour)).To(gomega.BeTrue())
+		gomega.Expect(status.BackupSummary.UpdateTime).NotTo(gomega.BeNil())
+
+		gomega.Expect(updateNaBSLBackupSummaryStatus(status, &nacv1alpha1.BackupSummary{BackupCount: 1, TotalBytes: 1024}, time.Hour)).To(gomega.BeFalse())
+
+		status.BackupSummary.UpdateTime = ptr.To(metav1.NewTime(time.Now().Add(-2 * time.Hour)))
+		gomega.Expect(updateNaBSLBackupSummaryStatus(status, &nacv1alpha1.BackupSummary{BackupCount: 1, TotalBytes: 1024}, time.Hour)).To(gomega.BeTrue())
+		gomega.Expect(time.Since(status.BackupSummary.UpdateTime.Time)).To(gomega.BeNumerically("<", time.Minute))
+
+		r := &NonAdminBackupStorageLocationReconciler{StorageUsagePeriod: time.Hour}
+		gomega.Expect(r.storageUsageRequeueAfter(&nacv1alpha1.NonAdminBackupStorageLocation{
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{Phase: nacv1alpha1.NonAdminPhaseCreated, BackupSummary: status.BackupSummary},
+		})).To(gomega.BeNumerically("~", time.Hour, time.Minute))
+		gomega.Expect(r.storageUsageRequeueAfter(&nacv1alpha1.NonAdminBackupStorageLocation{
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{Phase: nacv1alpha1.NonAdminPhaseBackingOff},
+		})).To(gomega.BeZero())
+	})
+
+	ginkgo.It("should wait for Velero until the validation deadline", func() {
+		r := &NonAdminBackupStorageLocationReconciler{ValidationDeadline: time.Minute}
+		nabsl := newNonAdminBackupStorageLocation(nil)
+		condition := check(r, nabsl)
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionUnknown))
+		gomega.Expect(condition.Reason).To(gomega.Equal("ValidationPending"))
+		gomega.Expect(r.validationDeadlineRequeueAfter(nabsl)).To(gomega.BeNumerically("~", time.Minute, time.Second))
+
+		meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable)).LastTransitionTime =
+			metav1.NewTime(time.Now().Add(-2 * time.Minute))
+		condition = check(r, nabsl)
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("ValidationTimeout"))
+		gomega.Expect(r.validationDeadlineRequeueAfter(nabsl)).To(gomega.BeZero())
+	})
+
+	ginkgo.It("should not check a NonAdminBackupStorageLocation without VeleroBackupStorageLocation", func() {
+		nabsl := newNonAdminBackupStorageLocation(nil)
+		nabsl.Status.Conditions = nil
+		gomega.Expect(check(&NonAdminBackupStorageLocationReconciler{}, nabsl)).To(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation auto approval", func() {
+	const (
+		namespace = "test-nabsl-auto-approval"
+		oadp      = "test-nabsl-auto-approval-oadp"
+		nacUUID   = "test-nabsl-auto-approval-uuid"
+	)
+
+	ginkgo.DescribeTable("should approve the NonAdminBackupStorageLocationRequests matching an auto approval rule",
+		func(bucket string, expectedDecision nacv1alpha1.NonAdminBSLRequest) {
+			nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-auto-approval", Namespace: namespace},
+				Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						Provider: "aws",
+						StorageType: velerov1.StorageType{
+							ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: bucket},
+						},
+					},
+				},
+				Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+					VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+				},
+			}
+			r := &NonAdminBackupStorageLocationReconciler{
+				OADPNamespace:         oadp,
+				RequireApprovalForBSL: true,
+				AutoApprovalConfigMap: "bsl-auto-approval",
+				Client: fake.NewClientBuilder().
+					WithScheme(k8sClient.Scheme()).
+					WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocationRequest{}).
+					WithObjects(
+						nabsl,
+						&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+						&corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{Name: "bsl-auto-approval", Namespace: oadp},
+							Data: map[string]string{"rules.yaml": `- namespaceSelector: {}
+  buckets: ["approved-*"]
+`},
+						},
+					).
+					Build(),
+			}
+
+			_, err := r.createNonAdminRequest(context.Background(), logr.Discard(), nabsl)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			nabslRequest, err := function.GetNabslRequestByLabel(context.Background(), r.Client, oadp, nacUUID)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(nabslRequest.Spec.ApprovalDecision).To(gomega.Equal(expectedDecision))
+			if expectedDecision == nacv1alpha1.NonAdminBSLRequestApproved {
+				gomega.Expect(nabslRequest.Annotations).To(gomega.HaveKeyWithValue(constant.NabslAutoApprovedAnnotation, constant.TrueString))
+			} else {
+				gomega.Expect(nabslRequest.Annotations).NotTo(gomega.HaveKey(constant.NabslAutoApprovedAnnotation))
+			}
+		},
+		ginkgo.Entry("matching bucket", "approved-backups", nacv1alpha1.NonAdminBSLRequestApproved),
+		ginkgo.Entry("other bucket", "other-backups", nacv1alpha1.NonAdminBSLRequestPending),
+	)
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation approval revocation", func() {
+	const (
+		namespace = "test-nabsl-revocation"
+		oadp      = "test-nabsl-revocation-oadp"
+		nacUUID   = "test-nabsl-revocation-uuid"
+	)
+
+	ginkgo.It("should make a revoked NonAdminBackupStorageLocation unusable and surface the revocation reason", func() {
+		bslSpec := &velerov1.BackupStorageLocationSpec{
+			Provider: "aws",
+			StorageType: velerov1.StorageType{
+				ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "decommissioned"},
+			},
+		}
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-revocation", Namespace: namespace},
+			Spec:       nacv1alpha1.NonAdminBackupStorageLocationSpec{BackupStorageLocationSpec: bslSpec},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID, Name: nacUUID, Namespace: oadp},
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(nacv1alpha1.NonAdminBSLConditionApproved),
+						Status:             metav1.ConditionTrue,
+						Reason:             "BslSpecApproved",
+						Message:            "NonAdminBackupStorageLocationRequest approval decision set to Approve",
+						LastTransitionTime: metav1.Now(),
+					},
+					{
+						Type:               string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable),
+						Status:             metav1.ConditionTrue,
+						Reason:             "Available",
+						Message:            "Velero validated the access to the object storage",
+						LastTransitionTime: metav1.Now(),
+					},
+				},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace: oadp,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&nacv1alpha1.NonAdminBackupStorageLocationRequest{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Spec: nacv1alpha1.NonAdminBackupStorageLocationRequestSpec{
+							ApprovalDecision: nacv1alpha1.NonAdminBSLRequestRejected,
+							Reason:           "bucket is decommissioned",
+						},
+						Status: nacv1alpha1.NonAdminBackupStorageLocationRequestStatus{
+							SourceNonAdminBSL: &nacv1alpha1.SourceNonAdminBSL{
+								RequestedSpec: bslSpec,
+								NACUUID:       nacUUID,
+								Name:          nabsl.Name,
+								Namespace:     namespace,
+							},
+						},
+					},
+					&velerov1.BackupStorageLocation{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+					},
+				).
+				Build(),
+		}
+
+		for range 2 {
+			_, err := r.ensureNonAdminRequest(context.Background(), logr.Discard(), nabsl)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+
+			gomega.Expect(nabsl.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+			approved := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionApproved))
+			gomega.Expect(approved.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(approved.Reason).To(gomega.Equal(constant.NabslApprovalRevokedReason))
+			gomega.Expect(approved.Message).To(gomega.HaveSuffix(": bucket is decommissioned"))
+			available := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionObjectStorageAvailable))
+			gomega.Expect(available.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(available.Reason).To(gomega.Equal("ApprovalRevoked"))
+		}
+
+		veleroBsls := &velerov1.BackupStorageLocationList{}
+		gomega.Expect(r.List(context.Background(), veleroBsls, client.InNamespace(oadp))).To(gomega.Succeed())
+		gomega.Expect(veleroBsls.Items).To(gomega.BeEmpty())
+
+		err := function.ValidateBackupSpec(context.Background(), r.Client, oadp, &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nab-revocation", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupSpec{
+				BackupSpec: &velerov1.BackupSpec{StorageLocation: nabsl.Name},
+			},
+		}, &velerov1.BackupSpec{}, false)
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("approval revoked by the cluster admin: bucket is decommissioned")))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation CA bundle", func() {
+	const (
+		namespace = "test-nabsl-ca-bundle"
+		oadp      = "test-nabsl-ca-bundle-oadp"
+		nacUUID   = "test-nabsl-ca-bundle-uuid"
+	)
+
+	ginkgo.It("should copy the renewed CA bundle to the VeleroBackupStorageLocation and request its validation", func() {
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-ca-bundle", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "internal"},
+					},
+					Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+				},
+				CACertConfigMap: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "s3-ca"}, Key: "ca.crt"},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:   oadp,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}, &velerov1.BackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "s3-ca", Namespace: namespace},
+						Data:       map[string]string{"ca.crt": "renewed-ca"},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Type: corev1.SecretTypeOpaque,
+					},
+					&velerov1.BackupStorageLocation{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Spec: velerov1.BackupStorageLocationSpec{
+							StorageType: velerov1.StorageType{
+								ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "internal", CACert: []byte("expired-ca")},
+							},
+						},
+						Status: velerov1.BackupStorageLocationStatus{
+							Phase:              velerov1.BackupStorageLocationPhaseUnavailable,
+							LastValidationTime: ptr.To(metav1.Now()),
+						},
+					},
+				).
+				Build(),
+		}
+
+		_, err := r.createVeleroBSL(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.ObjectStorage.CACert).To(gomega.Equal([]byte("renewed-ca")))
+		gomega.Expect(veleroBsl.Status.LastValidationTime).To(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation spec update", func() {
+	const (
+		namespace = "test-nabsl-spec-update"
+		oadp      = "test-nabsl-spec-update-oadp"
+		nacUUID   = "test-nabsl-spec-update-uuid"
+	)
+
+	newReconciler := func(requireApproval bool) (*NonAdminBackupStorageLocationReconciler, *nacv1alpha1.NonAdminBackupStorageLocation) {
+		approvedSpec := &velerov1.BackupStorageLocationSpec{
+			Provider: "aws",
+			StorageType: velerov1.StorageType{
+				ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "internal"},
+			},
+			Config:     map[string]string{"region": "us-east-1", "s3Url": "https://old.example.com"},
+			Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+		}
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-spec-update", Namespace: namespace},
+			Spec:       nacv1alpha1.NonAdminBackupStorageLocationSpec{BackupStorageLocationSpec: approvedSpec.DeepCopy()},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID, Name: nacUUID, Namespace: oadp},
+			},
+		}
+		nabsl.Spec.BackupStorageLocationSpec.ObjectStorage.Prefix = "velero"
+		nabsl.Spec.BackupStorageLocationSpec.Config["s3Url"] = "https://new.example.com"
+		nabsl.Spec.BackupStorageLocationSpec.Config["checksumAlgorithm"] = ""
+		veleroBslSpec := approvedSpec.DeepCopy()
+		veleroBslSpec.ObjectStorage.Prefix = namespace
+		return &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:         oadp,
+			RequireApprovalForBSL: requireApproval,
+			EnforcedBslSpec:       &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}, &nacv1alpha1.NonAdminBackupStorageLocationRequest{}, &velerov1.BackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&nacv1alpha1.NonAdminBackupStorageLocationRequest{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Spec: nacv1alpha1.NonAdminBackupStorageLocationRequestSpec{ApprovalDecision: nacv1alpha1.NonAdminBSLRequestApproved},
+						Status: nacv1alpha1.NonAdminBackupStorageLocationRequestStatus{
+							Phase: nacv1alpha1.NonAdminBSLRequestPhaseApproved,
+							SourceNonAdminBSL: &nacv1alpha1.SourceNonAdminBSL{
+								RequestedSpec: approvedSpec,
+								NACUUID:       nacUUID,
+								Name:          nabsl.Name,
+								Namespace:     namespace,
+							},
+						},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+					},
+					&velerov1.BackupStorageLocation{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Spec: *veleroBslSpec,
+						Status: velerov1.BackupStorageLocationStatus{
+							Phase:              velerov1.BackupStorageLocationPhaseAvailable,
+							LastValidationTime: ptr.To(metav1.Now()),
+						},
+					},
+				).
+				Build(),
+		}, nabsl
+	}
+
+	ginkgo.It("should apply the spec update to the VeleroBackupStorageLocation and request its validation", func() {
+		r, nabsl := newReconciler(false)
+
+		_, err := r.ensureNonAdminRequest(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		specUpdate := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionSpecUpdateApproved))
+		gomega.Expect(specUpdate.Status).To(gomega.Equal(metav1.ConditionTrue))
+		gomega.Expect(specUpdate.Reason).To(gomega.Equal("BslSpecUpdateApplied"))
+		gomega.Expect(specUpdate.Message).To(gomega.HaveSuffix("changed fields: config.checksumAlgorithm, config.s3Url, objectStorage.prefix"))
+		nabslRequest := &nacv1alpha1.NonAdminBackupStorageLocationRequest{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, nabslRequest)).To(gomega.Succeed())
+		gomega.Expect(nabslRequest.Status.SourceNonAdminBSL.RequestedSpec).To(gomega.Equal(nabsl.Spec.BackupStorageLocationSpec))
+
+		_, err = r.createVeleroBSL(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.ObjectStorage.Prefix).To(gomega.Equal(namespace + "/velero"))
+		gomega.Expect(veleroBsl.Spec.Config).To(gomega.HaveKeyWithValue("s3Url", "https://new.example.com"))
+		gomega.Expect(veleroBsl.Status.LastValidationTime).To(gomega.BeNil())
+	})
+
+	ginkgo.It("should not apply the spec update requiring the approval of the cluster admin", func() {
+		r, nabsl := newReconciler(true)
+
+		_, err := r.ensureNonAdminRequest(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+		specUpdate := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionSpecUpdateApproved))
+		gomega.Expect(specUpdate.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(specUpdate.Reason).To(gomega.Equal("BslSpecUpdateRejected"))
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.Config).To(gomega.HaveKeyWithValue("s3Url", "https://old.example.com"))
+		gomega.Expect(veleroBsl.Status.LastValidationTime).NotTo(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation enforced spec", func() {
+	ginkgo.It("should merge the enforced fields over the spec and report them in the status", func() {
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					Config:   map[string]string{"profile": "tenant", "s3ForcePathStyle": "true"},
+				},
+			},
+		}
+		enforcedSpec := &oadpv1alpha1.EnforceBackupStorageLocationSpec{
+			Provider:            "gcp",
+			Config:              map[string]string{"region": "us-east-1", "s3ForcePathStyle": "true"},
+			StorageType:         oadpv1alpha1.StorageType{ObjectStorage: &oadpv1alpha1.ObjectStorageLocation{Bucket: "tenants"}},
+			ValidationFrequency: &metav1.Duration{Duration: time.Hour},
+		}
+
+		bslSpec, enforcedFields := getEnforcedBSLSpec(nabsl, enforcedSpec)
+		gomega.Expect(bslSpec.Provider).To(gomega.Equal("aws"))
+		gomega.Expect(bslSpec.Config).To(gomega.Equal(map[string]string{"profile": "tenant", "region": "us-east-1", "s3ForcePathStyle": "true"}))
+		gomega.Expect(bslSpec.ObjectStorage.Bucket).To(gomega.Equal("tenants"))
+		gomega.Expect(bslSpec.ValidationFrequency.Duration).To(gomega.Equal(time.Hour))
+		gomega.Expect(enforcedFields).To(gomega.Equal([]string{"config.region", "objectStorage.bucket", "validationFrequency"}))
+		gomega.Expect(nabsl.Spec.BackupStorageLocationSpec.Config).NotTo(gomega.HaveKey("region"))
+
+		status := &nacv1alpha1.NonAdminBackupStorageLocationStatus{}
+		gomega.Expect(updateNaBSLEnforcedFieldsStatus(status, enforcedFields)).To(gomega.BeTrue())
+		gomega.Expect(status.EnforcedFields).To(gomega.Equal(enforcedFields))
+		gomega.Expect(meta.IsStatusConditionTrue(status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden))).To(gomega.BeTrue())
+		gomega.Expect(updateNaBSLEnforcedFieldsStatus(status, enforcedFields)).To(gomega.BeFalse())
+		gomega.Expect(updateNaBSLEnforcedFieldsStatus(status, nil)).To(gomega.BeTrue())
+		gomega.Expect(meta.FindStatusCondition(status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden))).To(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation drift", func() {
+	const (
+		namespace = "test-nabsl-drift"
+		oadp      = "test-nabsl-drift-oadp"
+		nacUUID   = "test-nabsl-drift-uuid"
+	)
+
+	ginkgo.It("should recreate the VeleroBackupStorageLocation and its Secret deleted out-of-band", func() {
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-drift", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "internal"},
+					},
+					Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+				},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(nacv1alpha1.NonAdminBSLConditionSecretSynced),
+						Status:             metav1.ConditionTrue,
+						Reason:             "SecretCreated",
+						LastTransitionTime: metav1.Now(),
+					},
+					{
+						Type:               string(nacv1alpha1.NonAdminBSLConditionBSLSynced),
+						Status:             metav1.ConditionTrue,
+						Reason:             "BackupStorageLocationCreated",
+						LastTransitionTime: metav1.Now(),
+					},
+				},
+			},
+		}
+		recorder := record.NewFakeRecorder(10)
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:   oadp,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			Recorder:        recorder,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: namespace},
+						Type:       corev1.SecretTypeOpaque,
+						Data:       map[string][]byte{"cloud": []byte("credentials")},
+					},
+				).
+				Build(),
+		}
+
+		_, err := r.syncSecrets(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = r.createVeleroBSL(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, &corev1.Secret{})).To(gomega.Succeed())
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, &velerov1.BackupStorageLocation{})).To(gomega.Succeed())
+		gomega.Expect(recorder.Events).To(gomega.HaveLen(2))
+		gomega.Expect(<-recorder.Events).To(gomega.ContainSubstring("VeleroBackupStorageLocationSecretRecreated"))
+		gomega.Expect(<-recorder.Events).To(gomega.ContainSubstring("VeleroBackupStorageLocationRecreated"))
+	})
+
+	ginkgo.It("should report, and revert unless disabled, the VeleroBackupStorageLocation fields modified out-of-band", func() {
+		ctx := context.Background()
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-drift", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "internal"},
+					},
+					Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+				},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		recorder := record.NewFakeRecorder(10)
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:   oadp,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			Recorder:        recorder,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: namespace},
+						Type:       corev1.SecretTypeOpaque,
+						Data:       map[string][]byte{"cloud": []byte("credentials")},
+					},
+				).
+				Build(),
+		}
+		_, err := r.syncSecrets(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = r.createVeleroBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionConfigDrift))).To(gomega.BeNil())
+
+		modifyBucket := func(bucket string) {
+			veleroBsl := &velerov1.BackupStorageLocation{}
+			gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+			veleroBsl.Spec.ObjectStorage.Bucket = bucket
+			gomega.Expect(r.Update(ctx, veleroBsl)).To(gomega.Succeed())
+		}
+		expectBucket := func(bucket string) {
+			veleroBsl := &velerov1.BackupStorageLocation{}
+			gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+			gomega.Expect(veleroBsl.Spec.ObjectStorage.Bucket).To(gomega.Equal(bucket))
+		}
+
+		modifyBucket("other")
+		_, err = r.createVeleroBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		expectBucket("internal")
+		condition := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionConfigDrift))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("DriftReverted"))
+		gomega.Expect(condition.Message).To(gomega.ContainSubstring("objectStorage.bucket"))
+		gomega.Expect(<-recorder.Events).To(gomega.ContainSubstring("VeleroBackupStorageLocationDriftReverted"))
+
+		r.DisableDriftRevert = true
+		modifyBucket("other")
+		_, err = r.createVeleroBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		expectBucket("other")
+		condition = meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionConfigDrift))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+		gomega.Expect(condition.Reason).To(gomega.Equal("DriftDetected"))
+		gomega.Expect(<-recorder.Events).To(gomega.ContainSubstring("VeleroBackupStorageLocationDriftDetected"))
+
+		modifyBucket("internal")
+		_, err = r.createVeleroBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		condition = meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionConfigDrift))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("DriftResolved"))
+		gomega.Expect(recorder.Events).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation pause", func() {
+	const (
+		namespace = "test-nabsl-pause"
+		oadp      = "test-nabsl-pause-oadp"
+		nacUUID   = "test-nabsl-pause-uuid"
+	)
+
+	ginkgo.It("should make the VeleroBackupStorageLocation ReadOnly without backup sync while paused", func() {
+		ctx := context.Background()
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-pause", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "internal"},
+					},
+					Credential:       &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+					BackupSyncPeriod: &metav1.Duration{Duration: time.Minute},
+				},
+				Paused: true,
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		recorder := record.NewFakeRecorder(10)
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:   oadp,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			Recorder:        recorder,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "cloud-credentials", Namespace: namespace},
+						Type:       corev1.SecretTypeOpaque,
+						Data:       map[string][]byte{"cloud": []byte("credentials")},
+					},
+				).
+				Build(),
+		}
+		_, err := r.syncSecrets(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = r.createVeleroBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.AccessMode).To(gomega.Equal(velerov1.BackupStorageLocationAccessModeReadOnly))
+		gomega.Expect(veleroBsl.Spec.BackupSyncPeriod.Duration).To(gomega.BeZero())
+		gomega.Expect(meta.IsStatusConditionTrue(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionPaused))).To(gomega.BeTrue())
+		gomega.Expect(<-recorder.Events).To(gomega.ContainSubstring("NonAdminBackupStorageLocationPaused"))
+
+		nabsl.Spec.Paused = false
+		_, err = r.createVeleroBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.AccessMode).To(gomega.BeEmpty())
+		gomega.Expect(veleroBsl.Spec.BackupSyncPeriod.Duration).To(gomega.Equal(time.Minute))
+		condition := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionPaused))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("BackupStorageLocationResumed"))
+		gomega.Expect(<-recorder.Events).To(gomega.ContainSubstring("NonAdminBackupStorageLocationResumed"))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation transfer", func() {
+	const (
+		namespace       = "test-nabsl-transfer"
+		targetNamespace = "test-nabsl-transfer-target"
+		oadp            = "test-nabsl-transfer-oadp"
+		name            = "test-nabsl-transfer"
+		nacUUID         = "test-nabsl-transfer-uuid"
+		backupNACUUID   = "test-nabsl-transfer-backup-uuid"
+	)
+
+	ginkgo.It("should move the NonAdminBackupStorageLocation and its NonAdminBackups to the target namespace", func() {
+		ctx := context.Background()
+		nabslAnnotations := map[string]string{
+			constant.NabslOriginNamespaceAnnotation: namespace,
+			constant.NabslOriginNameAnnotation:      name,
+		}
+		nabslLabels := map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID}
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Finalizers: []string{constant.NabslFinalizerName}},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "internal"},
+					},
+					Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+				},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				Phase:                       nacv1alpha1.NonAdminPhaseCreated,
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID, Namespace: oadp, Name: nacUUID},
+			},
+		}
+		nabslRequest := &nacv1alpha1.NonAdminBackupStorageLocationRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nacUUID,
+				Namespace: oadp,
+				Labels:    nabslLabels,
+				Annotations: map[string]string{
+					constant.NabslOriginNamespaceAnnotation:   namespace,
+					constant.NabslOriginNameAnnotation:        name,
+					constant.NabslTransferNamespaceAnnotation: targetNamespace,
+				},
+			},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationRequestSpec{ApprovalDecision: nacv1alpha1.NonAdminBSLRequestApproved},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationRequestStatus{
+				Phase: nacv1alpha1.NonAdminBSLRequestPhaseApproved,
+				SourceNonAdminBSL: &nacv1alpha1.SourceNonAdminBSL{
+					RequestedSpec: nabsl.Spec.BackupStorageLocationSpec,
+					NACUUID:       nacUUID,
+					Name:          name,
+					Namespace:     namespace,
+				},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:   oadp,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}, &nacv1alpha1.NonAdminBackupStorageLocationRequest{}).
+				WithObjects(
+					nabsl,
+					nabslRequest,
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: targetNamespace}},
+					buildTestNonAdminSecretForBsl(namespace, "cloud-credentials", "id", "secret"),
+					&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: nacUUID, Namespace: oadp, Labels: nabslLabels, Annotations: nabslAnnotations}},
+					&velerov1.BackupStorageLocation{ObjectMeta: metav1.ObjectMeta{Name: nacUUID, Namespace: oadp, Labels: nabslLabels, Annotations: nabslAnnotations}},
+					&nacv1alpha1.NonAdminBackup{
+						ObjectMeta: metav1.ObjectMeta{Name: "test-nab-transfer", Namespace: namespace},
+						Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{StorageLocation: name}},
+						Status: nacv1alpha1.NonAdminBackupStatus{
+							Phase:        nacv1alpha1.NonAdminPhaseCreated,
+							VeleroBackup: &nacv1alpha1.VeleroBackup{NACUUID: backupNACUUID},
+						},
+					},
+					&velerov1.Backup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      backupNACUUID,
+							Namespace: oadp,
+							Labels: map[string]string{
+								constant.OadpLabel:             constant.OadpLabelValue,
+								constant.ManagedByLabel:        constant.ManagedByLabelValue,
+								constant.NabOriginNACUUIDLabel: backupNACUUID,
+							},
+							Annotations: map[string]string{
+								constant.NabOriginNamespaceAnnotation: namespace,
+								constant.NabOriginNameAnnotation:      "test-nab-transfer",
+							},
+						},
+						Spec:   velerov1.BackupSpec{IncludedNamespaces: []string{namespace}, StorageLocation: nacUUID},
+						Status: velerov1.BackupStatus{Phase: velerov1.BackupPhaseCompleted},
+					},
+				).
+				Build(),
+		}
+
+		// the source NonAdminBackupStorageLocation is copied to the target namespace
+		requeue, err := r.transferNaBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionTransferred)).Reason).To(gomega.Equal("WaitingForTarget"))
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: targetNamespace, Name: "cloud-credentials"}, &corev1.Secret{})).To(gomega.Succeed())
+		targetNaBSL := &nacv1alpha1.NonAdminBackupStorageLocation{}
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: targetNamespace, Name: name}, targetNaBSL)).To(gomega.Succeed())
+		gomega.Expect(targetNaBSL.Annotations).To(gomega.HaveKeyWithValue(constant.NabslTransferredNACUUIDAnnotation, nacUUID))
+
+		// the target NonAdminBackupStorageLocation takes the VeleroBackupStorageLocation over
+		_, err = r.setVeleroBSLUUIDInNaBSLStatus(ctx, logr.Discard(), targetNaBSL)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(targetNaBSL.Status.VeleroBackupStorageLocation.NACUUID).To(gomega.Equal(nacUUID))
+		gomega.Expect(meta.IsStatusConditionTrue(targetNaBSL.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionTransferred))).To(gomega.BeTrue())
+
+		// the NonAdminBackups are moved
+		_, err = r.transferNaBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionTransferred)).Reason).To(gomega.Equal("Transferring"))
+		targetNab := &nacv1alpha1.NonAdminBackup{}
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: targetNamespace, Name: "test-nab-transfer"}, targetNab)).To(gomega.Succeed())
+		gomega.Expect(targetNab.Labels).To(gomega.HaveKeyWithValue(constant.NabSyncLabel, backupNACUUID))
+		gomega.Expect(targetNab.Spec.BackupSpec.StorageLocation).To(gomega.Equal(name))
+		veleroBackup := &velerov1.Backup{}
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: backupNACUUID}, veleroBackup)).To(gomega.Succeed())
+		gomega.Expect(veleroBackup.Annotations).To(gomega.HaveKeyWithValue(constant.NabOriginNamespaceAnnotation, targetNamespace))
+		gomega.Expect(function.GetVeleroBackupSourceNamespace(veleroBackup, targetNamespace)).To(gomega.Equal(namespace))
+
+		// the target NonAdminBackupStorageLocation takes the request over
+		requeue, err = r.transferNaBSL(ctx, logr.Discard(), targetNaBSL)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, nabslRequest)).To(gomega.Succeed())
+		gomega.Expect(nabslRequest.Status.SourceNonAdminBSL.Namespace).To(gomega.Equal(targetNamespace))
+		gomega.Expect(nabslRequest.Annotations).To(gomega.HaveKeyWithValue(constant.NabslOriginNamespaceAnnotation, targetNamespace))
+		gomega.Expect(nabslRequest.Annotations).To(gomega.HaveKeyWithValue(constant.NabslPrefixNamespaceAnnotation, namespace))
+		gomega.Expect(nabslRequest.Annotations).NotTo(gomega.HaveKey(constant.NabslTransferNamespaceAnnotation))
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Annotations).To(gomega.HaveKeyWithValue(constant.NabslOriginNamespaceAnnotation, targetNamespace))
+
+		// the source NonAdminBackupStorageLocation and NonAdminBackups are deleted, keeping the Velero objects
+		requeue, err = r.transferNaBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(apierrors.IsNotFound(r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "test-nab-transfer"}, &nacv1alpha1.NonAdminBackup{}))).To(gomega.BeTrue())
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, nabsl)).To(gomega.Succeed())
+		gomega.Expect(nabsl.DeletionTimestamp).NotTo(gomega.BeNil())
+		requeue, err = r.releaseTransferredNaBSL(ctx, logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(apierrors.IsNotFound(r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, nabsl))).To(gomega.BeTrue())
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+
+		// the target keeps the objectStorage prefix of the source namespace
+		_, err = r.createVeleroBSL(ctx, logr.Discard(), targetNaBSL)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(r.Get(ctx, types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.ObjectStorage.Prefix).To(gomega.Equal(namespace))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation cloud identity", func() {
+	const (
+		namespace = "test-nabsl-cloud-identity"
+		oadp      = "test-nabsl-cloud-identity-oadp"
+		nacUUID   = "test-nabsl-cloud-identity-uuid"
+		azureID   = "00000000-0000-0000-0000-000000000000"
+	)
+
+	ginkgo.It("should render the credential file and config of the cloud identity in the VeleroBackupStorageLocation", func() {
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-cloud-identity", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "azure",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "backups"},
+					},
+					Config: map[string]string{"storageAccount": "tenant"},
+				},
+				CloudIdentity: &nacv1alpha1.CloudIdentity{
+					Azure: &nacv1alpha1.AzureCloudIdentity{SubscriptionID: azureID, TenantID: azureID, ClientID: azureID},
+				},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:   oadp,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			SyncPeriod:      2 * time.Minute,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(nabsl).
+				Build(),
+		}
+
+		_, err := r.validateNaBSLSpec(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("spec.cloudIdentity is not allowed by the cluster admin")))
+
+		r.AllowCloudIdentity = true
+		_, err = r.syncSecrets(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = r.createVeleroBSL(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		secret := &corev1.Secret{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, secret)).To(gomega.Succeed())
+		gomega.Expect(string(secret.Data[constant.CloudIdentityCredentialKey])).To(gomega.ContainSubstring("AZURE_CLIENT_ID=" + azureID))
+
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.Credential.Name).To(gomega.Equal(nacUUID))
+		gomega.Expect(veleroBsl.Spec.Credential.Key).To(gomega.Equal(constant.CloudIdentityCredentialKey))
+		gomega.Expect(veleroBsl.Spec.Config).To(gomega.Equal(map[string]string{"storageAccount": "tenant", "useAAD": "true"}))
+	})
+
+	ginkgo.It("should require the approval of the cluster admin even if requireApprovalForBSL is not set", func() {
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-cloud-identity-approval", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "azure",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "backups"},
+					},
+				},
+				CloudIdentity: &nacv1alpha1.CloudIdentity{
+					Azure: &nacv1alpha1.AzureCloudIdentity{SubscriptionID: azureID, TenantID: azureID, ClientID: azureID},
+				},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace:      oadp,
+			AllowCloudIdentity: true,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocationRequest{}).
+				WithObjects(nabsl).
+				Build(),
+		}
+
+		requeue, err := r.createNonAdminRequest(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		nabslRequest := &nacv1alpha1.NonAdminBackupStorageLocationRequest{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, nabslRequest)).To(gomega.Succeed())
+		gomega.Expect(nabslRequest.Spec.ApprovalDecision).To(gomega.Equal(nacv1alpha1.NonAdminBSLRequestPending))
+
+		// the pending request is not approved by the next reconcile either
+		_, err = r.createNonAdminRequest(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, nabslRequest)).To(gomega.Succeed())
+		gomega.Expect(nabslRequest.Spec.ApprovalDecision).To(gomega.Equal(nacv1alpha1.NonAdminBSLRequestPending))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation access mode", func() {
+	const (
+		namespace = "test-nabsl-access-mode"
+		oadp      = "test-nabsl-access-mode-oadp"
+		nacUUID   = "test-nabsl-access-mode-uuid"
+	)
+
+	ginkgo.It("should create a ReadOnly VeleroBackupStorageLocation for a ReadOnly NonAdminBackupStorageLocation", func() {
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-access-mode", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Provider: "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "historical"},
+					},
+					Credential: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cloud-credentials"}, Key: "cloud"},
+				},
+				AccessMode: velerov1.BackupStorageLocationAccessModeReadOnly,
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace: oadp,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{
+				AccessMode: velerov1.BackupStorageLocationAccessModeReadWrite,
+			},
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nacUUID,
+							Namespace: oadp,
+							Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+						},
+						Type: corev1.SecretTypeOpaque,
+					},
+				).
+				Build(),
+		}
+
+		_, err := r.createVeleroBSL(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		veleroBsl := &velerov1.BackupStorageLocation{}
+		gomega.Expect(r.Get(context.Background(), types.NamespacedName{Namespace: oadp, Name: nacUUID}, veleroBsl)).To(gomega.Succeed())
+		gomega.Expect(veleroBsl.Spec.AccessMode).To(gomega.Equal(velerov1.BackupStorageLocationAccessModeReadOnly))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation deletion references", func() {
+	const (
+		namespace = "test-nabsl-references"
+		oadp      = "test-nabsl-references-oadp"
+		nacUUID   = "test-nabsl-references-uuid"
+	)
+
+	ginkgo.It("should block the deletion while NonAdminBackups or NonAdminRestores using it are running, until forced", func() {
+		nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nabsl-references", Namespace: namespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{Provider: "aws"},
+			},
+			Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+				VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{NACUUID: nacUUID, Name: nacUUID, Namespace: oadp},
+			},
+		}
+		newNonAdminBackup := func(name string, storageLocation string, veleroStorageLocation string, phase velerov1.BackupPhase) *nacv1alpha1.NonAdminBackup {
+			return &nacv1alpha1.NonAdminBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: nacv1alpha1.NonAdminBackupSpec{
+					BackupSpec: &velerov1.BackupSpec{StorageLocation: storageLocation},
+				},
+				Status: nacv1alpha1.NonAdminBackupStatus{
+					VeleroBackup: &nacv1alpha1.VeleroBackup{
+						Spec:   &velerov1.BackupSpec{StorageLocation: veleroStorageLocation},
+						Status: &velerov1.BackupStatus{Phase: phase},
+					},
+				},
+			}
+		}
+		nabslRequest := &nacv1alpha1.NonAdminBackupStorageLocationRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nacUUID,
+				Namespace: oadp,
+				Labels:    map[string]string{constant.NabslOriginNACUUIDLabel: nacUUID},
+			},
+		}
+		r := &NonAdminBackupStorageLocationReconciler{
+			OADPNamespace: oadp,
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}).
+				WithObjects(
+					nabsl,
+					nabslRequest,
+					newNonAdminBackup("running", nabsl.Name, nacUUID, velerov1.BackupPhaseInProgress),
+					newNonAdminBackup("default-location", constant.EmptyString, nacUUID, velerov1.BackupPhaseNew),
+					newNonAdminBackup("completed", nabsl.Name, nacUUID, velerov1.BackupPhaseCompleted),
+					newNonAdminBackup("other-location", "other", "other-uuid", velerov1.BackupPhaseInProgress),
+					&nacv1alpha1.NonAdminRestore{
+						ObjectMeta: metav1.ObjectMeta{Name: "restoring", Namespace: namespace},
+						Spec: nacv1alpha1.NonAdminRestoreSpec{
+							RestoreSpec: &velerov1.RestoreSpec{BackupName: "completed"},
+						},
+						Status: nacv1alpha1.NonAdminRestoreStatus{
+							VeleroRestore: &nacv1alpha1.VeleroRestore{
+								Status: &velerov1.RestoreStatus{Phase: velerov1.RestorePhaseInProgress},
+							},
+						},
+					},
+				).
+				Build(),
+		}
+
+		requeue, err := r.checkNaBSLReferences(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		condition := meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionDeletionBlocked))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+		gomega.Expect(condition.Message).To(gomega.Equal(
+			"NonAdminBackupStorageLocation deletion waits for: NonAdminBackup/default-location, NonAdminBackup/running, NonAdminRestore/restoring"))
+
+		gomega.Expect(r.Get(context.Background(), client.ObjectKeyFromObject(nabslRequest), nabslRequest)).To(gomega.Succeed())
+		nabslRequest.Annotations = map[string]string{constant.NabslForceDeletionAnnotation: constant.TrueString}
+		gomega.Expect(r.Update(context.Background(), nabslRequest)).To(gomega.Succeed())
+
+		requeue, err = r.checkNaBSLReferences(context.Background(), logr.Discard(), nabsl)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(meta.FindStatusCondition(nabsl.Status.Conditions, string(nacv1alpha1.NonAdminBSLConditionDeletionBlocked))).To(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupStorageLocation reconcile after leader failover", func() {
+	const (
+		failoverNamespace     = "test-non-admin-bsl-failover"
+		failoverOADPNamespace = "test-non-admin-bsl-failover-oadp"
+		failoverName          = "non-admin-bsl-failover"
+	)
+	key := types.NamespacedName{Namespace: failoverNamespace, Name: failoverName}
+
+	newReconciler := func(c client.Client) *NonAdminBackupStorageLocationReconciler {
+		return &NonAdminBackupStorageLocationReconciler{
+			Client:          c,
+			Scheme:          c.Scheme(),
+			OADPNamespace:   failoverOADPNamespace,
+			EnforcedBslSpec: &oadpv1alpha1.EnforceBackupStorageLocationSpec{},
+			SyncPeriod:      2 * time.Minute,
+		}
+	}
+
+	ginkgo.DescribeTable("should create a single NonAdminBackupStorageLocationRequest, Secret and VeleroBackupStorageLocation",
+		func(applyFailedWrite bool) {
+			for writesBeforeFailover := range maxFailoverWrites {
+				ginkgo.By(fmt.Sprintf("failing over after %v writes", writesBeforeFailover))
+				baseClient := fake.NewClientBuilder().
+					WithScheme(k8sClient.Scheme()).
+					WithStatusSubresource(&nacv1alpha1.NonAdminBackupStorageLocation{}, &nacv1alpha1.NonAdminBackupStorageLocationRequest{}).
+					WithObjects(
+						buildTestNonAdminSecretForBsl(failoverNamespace, failoverName, "access-key", "secret-key"),
+						buildTestNonAdminBackupStorageLocation(failoverNamespace, failoverName, nacv1alpha1.NonAdminBackupStorageLocationSpec{
+							BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+								Credential: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: failoverName},
+									Key:                  "cloud",
+								},
+								Provider: "aws",
+								StorageType: velerov1.StorageType{
+									ObjectStorage: &velerov1.ObjectStorageLocation{
+										Bucket: "test",
+										Prefix: "test",
+									},
+								},
+							},
+						}),
+					).
+					Build()
+
+				reconcileAfterFailover(
+					newReconciler(newFailoverClient(baseClient, writesBeforeFailover, applyFailedWrite)),
+					newReconciler(baseClient),
+					key,
+				)
+
+				nonAdminBsl := &nacv1alpha1.NonAdminBackupStorageLocation{}
+				gomega.Expect(baseClient.Get(context.Background(), key, nonAdminBsl)).To(gomega.Succeed())
+				gomega.Expect(nonAdminBsl.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+				gomega.Expect(nonAdminBsl.Status.VeleroBackupStorageLocation).ToNot(gomega.BeNil())
+
+				nonAdminBslRequests := &nacv1alpha1.NonAdminBackupStorageLocationRequestList{}
+				gomega.Expect(baseClient.List(context.Background(), nonAdminBslRequests, client.InNamespace(failoverOADPNamespace))).To(gomega.Succeed())
+				gomega.Expect(nonAdminBslRequests.Items).To(gomega.HaveLen(1))
+				gomega.Expect(nonAdminBslRequests.Items[0].Status.SourceNonAdminBSL).ToNot(gomega.BeNil())
+				gomega.Expect(nonAdminBslRequests.Items[0].Status.SourceNonAdminBSL.NACUUID).To(gomega.Equal(nonAdminBsl.Status.VeleroBackupStorageLocation.NACUUID))
+
+				secrets := &corev1.SecretList{}
+				gomega.Expect(baseClient.List(context.Background(), secrets, client.InNamespace(failoverOADPNamespace))).To(gomega.Succeed())
+				gomega.Expect(secrets.Items).To(gomega.HaveLen(1))
+
+				veleroBsls := &velerov1.BackupStorageLocationList{}
+				gomega.Expect(baseClient.List(context.Background(), veleroBsls, client.InNamespace(failoverOADPNamespace))).To(gomega.Succeed())
+				gomega.Expect(veleroBsls.Items).To(gomega.HaveLen(1))
+				gomega.Expect(veleroBsls.Items[0].Name).To(gomega.Equal(nonAdminBsl.Status.VeleroBackupStorageLocation.Name))
+			}
+		},
+		ginkgo.Entry("when the failed write is not done", false),
+		ginkgo.Entry("when the failed write is done", true),
+	)
+})
@@ -0,0 +1,201 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// NonAdminBackupSummaryReconciler reconciles a NonAdminBackupSummary object
+type NonAdminBackupSummaryReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// SummaryPeriod is how often the NonAdminBackupSummaries are computed again
+	SummaryPeriod time.Duration
+}
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupsummaries,verbs=get;list;watch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupsummaries/status,verbs=get;update;patch
+
+// Reconcile computes the status of a NonAdminBackupSummary from the NonAdminBackups, NonAdminRestores
+// and NonAdminBackupStorageLocations of every namespace, and computes it again after the summary period.
+func (r *NonAdminBackupSummaryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminBackupSummary Reconcile start")
+
+	summary := &nacv1alpha1.NonAdminBackupSummary{}
+	if err := r.Get(ctx, req.NamespacedName, summary); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info("NonAdminBackupSummary not found")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminBackupSummary")
+		return ctrl.Result{}, err
+	}
+	if !summary.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	nabList := &nacv1alpha1.NonAdminBackupList{}
+	if err := r.List(ctx, nabList); err != nil {
+		logger.Error(err, "Unable to list NonAdminBackups")
+		return ctrl.Result{}, err
+	}
+	narList := &nacv1alpha1.NonAdminRestoreList{}
+	if err := r.List(ctx, narList); err != nil {
+		logger.Error(err, "Unable to list NonAdminRestores")
+		return ctrl.Result{}, err
+	}
+	nabslList := &nacv1alpha1.NonAdminBackupStorageLocationList{}
+	if err := r.List(ctx, nabslList); err != nil {
+		logger.Error(err, "Unable to list NonAdminBackupStorageLocations")
+		return ctrl.Result{}, err
+	}
+
+	status := summarizeNonAdminObjects(nabList.Items, narList.Items, nabslList.Items)
+	status.UpdateTime = &metav1.Time{Time: time.Now()}
+	summary.Status = *status
+	if err := r.Status().Update(ctx, summary); err != nil {
+		logger.Error(err, "Failed to update NonAdminBackupSummary Status")
+		return ctrl.Result{}, err
+	}
+
+	logger.V(1).Info("NonAdminBackupSummary Reconcile exit")
+	return ctrl.Result{RequeueAfter: r.SummaryPeriod}, nil
+}
+
+// summarizeNonAdminObjects returns the NonAdminBackupSummary status of the given non admin objects
+func summarizeNonAdminObjects(
+	nabs []nacv1alpha1.NonAdminBackup,
+	nars []nacv1alpha1.NonAdminRestore,
+	nabsls []nacv1alpha1.NonAdminBackupStorageLocation,
+) *nacv1alpha1.NonAdminBackupSummaryStatus {
+	namespaces := map[string]*nacv1alpha1.NamespaceBackupSummary{}
+	namespaceSummary := func(namespace string) *nacv1alpha1.NamespaceBackupSummary {
+		if _, exists := namespaces[namespace]; !exists {
+			namespaces[namespace] = &nacv1alpha1.NamespaceBackupSummary{Namespace: namespace}
+		}
+		return namespaces[namespace]
+	}
+
+	for i := range nabs {
+		nab := &nabs[i]
+		summary := namespaceSummary(nab.Namespace)
+		countNonAdminObject(&summary.NonAdminBackups, nab.Status.Phase)
+		if nab.Status.DataMoverDataUploads != nil {
+			summary.DataMoverBytes += nab.Status.DataMoverDataUploads.BytesDone
+		}
+		if nab.Status.Phase != nacv1alpha1.NonAdminPhaseCompleted ||
+			nab.Status.VeleroBackup == nil || nab.Status.VeleroBackup.Status == nil ||
+			nab.Status.VeleroBackup.Status.CompletionTimestamp == nil {
+			continue
+		}
+		completion := nab.Status.VeleroBackup.Status.CompletionTimestamp
+		if summary.OldestSuccessfulBackup == nil || completion.Before(summary.OldestSuccessfulBackup.CompletionTimestamp) {
+			summary.OldestSuccessfulBackup = &nacv1alpha1.SuccessfulBackup{Name: nab.Name, CompletionTimestamp: completion.DeepCopy()}
+		}
+		if summary.LatestSuccessfulBackup == nil || summary.LatestSuccessfulBackup.CompletionTimestamp.Before(completion) {
+			summary.LatestSuccessfulBackup = &nacv1alpha1.SuccessfulBackup{Name: nab.Name, CompletionTimestamp: completion.DeepCopy()}
+		}
+	}
+	for i := range nars {
+		summary := namespaceSummary(nars[i].Namespace)
+		countNonAdminObject(&summary.NonAdminRestores, nars[i].Status.Phase)
+	}
+	for i := range nabsls {
+		summary := namespaceSummary(nabsls[i].Namespace)
+		summary.NonAdminBackupStorageLocations++
+		if nabsls[i].Status.BackupSummary != nil {
+			summary.StoredBytes += nabsls[i].Status.BackupSummary.TotalBytes
+		}
+	}
+
+	status := &nacv1alpha1.NonAdminBackupSummaryStatus{NamespaceCount: len(namespaces)}
+	for _, summary := range namespaces {
+		setFailureRate(&summary.NonAdminBackups)
+		setFailureRate(&summary.NonAdminRestores)
+		addNonAdminObjectCounts(&status.NonAdminBackups, summary.NonAdminBackups)
+		addNonAdminObjectCounts(&status.NonAdminRestores, summary.NonAdminRestores)
+		status.NonAdminBackupStorageLocations += summary.NonAdminBackupStorageLocations
+		status.StoredBytes += summary.StoredBytes
+		status.DataMoverBytes += summary.DataMoverBytes
+		status.Namespaces = append(status.Namespaces, *summary)
+	}
+	setFailureRate(&status.NonAdminBackups)
+	setFailureRate(&status.NonAdminRestores)
+	slices.SortFunc(status.Namespaces, func(a, b nacv1alpha1.NamespaceBackupSummary) int {
+		return strings.Compare(a.Namespace, b.Namespace)
+	})
+	return status
+}
+
+// countNonAdminObject counts a non admin object in the given phase
+func countNonAdminObject(counts *nacv1alpha1.NonAdminObjectCounts, phase nacv1alpha1.NonAdminPhase) {
+	counts.Total++
+	switch phase {
+	case nacv1alpha1.NonAdminPhaseCompleted:
+		counts.Completed++
+	case nacv1alpha1.NonAdminPhasePartiallyFailed:
+		counts.PartiallyFailed++
+	case nacv1alpha1.NonAdminPhaseFailed:
+		counts.Failed++
+	default:
+		counts.Other++
+	}
+}
+
+// addNonAdminObjectCounts adds the counts of a namespace to the total counts
+func addNonAdminObjectCounts(total *nacv1alpha1.NonAdminObjectCounts, counts nacv1alpha1.NonAdminObjectCounts) {
+	total.Total += counts.Total
+	total.Completed += counts.Completed
+	total.PartiallyFailed += counts.PartiallyFailed
+	total.Failed += counts.Failed
+	total.Other += counts.Other
+}
+
+// setFailureRate sets the percentage of the finished non admin objects which are PartiallyFailed or Failed
+func setFailureRate(counts *nacv1alpha1.NonAdminObjectCounts) {
+	finished := counts.Completed + counts.PartiallyFailed + counts.Failed
+	if finished == 0 {
+		counts.FailureRate = 0
+		return
+	}
+	counts.FailureRate = (counts.PartiallyFailed + counts.Failed) * 100 / finished
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminBackupSummaryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates of the NonAdminBackupSummary do not compute it again, the summary period does
+		For(&nacv1alpha1.NonAdminBackupSummary{}, ctrlbuilder.WithPredicates(ctrlpredicate.GenerationChangedPredicate{})).
+		Named("nonadminbackupsummary").
+		Complete(r)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+func buildSummaryTestNonAdminBackup(namespace string, name string, phase nacv1alpha1.NonAdminPhase, completion *metav1.Time) nacv1alpha1.NonAdminBackup {
+	nab := nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     nacv1alpha1.NonAdminBackupStatus{Phase: phase},
+	}
+	if completion != nil {
+		nab.Status.VeleroBackup = &nacv1alpha1.VeleroBackup{Status: &velerov1.BackupStatus{CompletionTimestamp: completion}}
+	}
+	return nab
+}
+
+var _ = ginkgo.Describe("Test summarizeNonAdminObjects function of NonAdminBackupSummary Controller", func() {
+	ginkgo.It("Should summarize non admin objects per namespace", func() {
+		oldest := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		latest := metav1.NewTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+		nabs := []nacv1alpha1.NonAdminBackup{
+			buildSummaryTestNonAdminBackup("team-b", "nab-1", nacv1alpha1.NonAdminPhaseCompleted, &latest),
+			buildSummaryTestNonAdminBackup("team-b", "nab-2", nacv1alpha1.NonAdminPhaseCompleted, &oldest),
+			buildSummaryTestNonAdminBackup("team-b", "nab-3", nacv1alpha1.NonAdminPhaseFailed, nil),
+			buildSummaryTestNonAdminBackup("team-b", "nab-4", nacv1alpha1.NonAdminPhaseCreated, nil),
+			buildSummaryTestNonAdminBackup("team-a", "nab-1", nacv1alpha1.NonAdminPhasePartiallyFailed, &latest),
+		}
+		nabs[0].Status.DataMoverDataUploads = &nacv1alpha1.DataMoverDataUploads{BytesDone: 100}
+		nars := []nacv1alpha1.NonAdminRestore{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "nar-1", Namespace: "team-a"},
+				Status:     nacv1alpha1.NonAdminRestoreStatus{Phase: nacv1alpha1.NonAdminPhaseCompleted},
+			},
+		}
+		nabsls := []nacv1alpha1.NonAdminBackupStorageLocation{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "nabsl-1", Namespace: "team-c"},
+				Status: nacv1alpha1.NonAdminBackupStorageLocationStatus{
+					BackupSummary: &nacv1alpha1.BackupSummary{TotalBytes: 1000},
+				},
+			},
+		}
+
+		status := summarizeNonAdminObjects(nabs, nars, nabsls)
+		gomega.Expect(status.NamespaceCount).To(gomega.Equal(3))
+		gomega.Expect(status.Namespaces).To(gomega.HaveLen(3))
+		gomega.Expect(status.Namespaces[0].Namespace).To(gomega.Equal("team-a"))
+		gomega.Expect(status.Namespaces[0].OldestSuccessfulBackup).To(gomega.BeNil())
+		gomega.Expect(status.Namespaces[0].NonAdminBackups.FailureRate).To(gomega.Equal(100))
+		gomega.Expect(status.Namespaces[0].NonAdminRestores).To(gomega.Equal(nacv1alpha1.NonAdminObjectCounts{Total: 1, Completed: 1}))
+
+		teamB := status.Namespaces[1]
+		gomega.Expect(teamB.Namespace).To(gomega.Equal("team-b"))
+		gomega.Expect(teamB.NonAdminBackups).To(gomega.Equal(nacv1alpha1.NonAdminObjectCounts{
+			Total: 4, Completed: 2, Failed: 1, Other: 1, FailureRate: 33,
+		}))
+		gomega.Expect(teamB.OldestSuccessfulBackup.Name).To(gomega.Equal("nab-2"))
+		gomega.Expect(teamB.LatestSuccessfulBackup.Name).To(gomega.Equal("nab-1"))
+		gomega.Expect(teamB.DataMoverBytes).To(gomega.Equal(int64(100)))
+
+		gomega.Expect(status.Namespaces[2].Namespace).To(gomega.Equal("team-c"))
+		gomega.Expect(status.Namespaces[2].NonAdminBackupStorageLocations).To(gomega.Equal(1))
+
+		gomega.Expect(status.NonAdminBackups).To(gomega.Equal(nacv1alpha1.NonAdminObjectCounts{
+			Total: 5, Completed: 2, PartiallyFailed: 1, Failed: 1, Other: 1, FailureRate: 50,
+		}))
+		gomega.Expect(status.StoredBytes).To(gomega.Equal(int64(1000)))
+		gomega.Expect(status.DataMoverBytes).To(gomega.Equal(int64(100)))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminBackupSummary Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectNamespace = fmt.Sprintf("test-nabsum-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should report the NonAdminBackups of the namespace and requeue after the summary period", func() {
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nab", Namespace: nonAdminObjectNamespace},
+			Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+		})).To(gomega.Succeed())
+		summary := &nacv1alpha1.NonAdminBackupSummary{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectNamespace},
+		}
+		gomega.Expect(k8sClient.Create(ctx, summary)).To(gomega.Succeed())
+
+		result, err := (&NonAdminBackupSummaryReconciler{
+			Client:        k8sClient,
+			Scheme:        testEnv.Scheme,
+			SummaryPeriod: time.Minute,
+		}).Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: summary.Name}})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: summary.Name}, summary)).To(gomega.Succeed())
+		gomega.Expect(summary.Status.UpdateTime).NotTo(gomega.BeNil())
+		gomega.Expect(summary.Status.Namespaces).To(gomega.ContainElement(gomega.And(
+			gomega.HaveField("Namespace", nonAdminObjectNamespace),
+			gomega.HaveField("NonAdminBackups.Total", 1),
+		)))
+
+		gomega.Expect(k8sClient.Delete(ctx, summary)).To(gomega.Succeed())
+	})
+})
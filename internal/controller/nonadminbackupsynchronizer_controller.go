@@ -19,7 +19,6 @@ package controller
 import (
 	"context"
 	"fmt"
-	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -30,8 +29,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
@@ -46,6 +49,8 @@ type NonAdminBackupSynchronizerReconciler struct {
 	Scheme        *runtime.Scheme
 	OADPNamespace string
 	SyncPeriod    time.Duration
+	// AdoptOrphanBackups syncs the Velero Backups again as soon as a NonAdminBackup is deleted
+	AdoptOrphanBackups bool
 }
 
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
@@ -64,11 +69,13 @@ func (r *NonAdminBackupSynchronizerReconciler) Reconcile(ctx context.Context, _
 		return ctrl.Result{}, err
 	}
 
-	var watchedBackupStorageLocations []string
+	// The default BackupStorageLocation is watched for every namespace, the BackupStorageLocation of a
+	// NonAdminBackupStorageLocation only for the namespace of the NonAdminBackupStorageLocation
+	watchedBackupStorageLocations := map[string]string{}
 	relatedNonAdminBackupStorageLocations := map[string]string{}
 	for _, backupStorageLocation := range veleroBackupStorageLocationList.Items {
 		if backupStorageLocation.Spec.Default {
-			watchedBackupStorageLocations = append(watchedBackupStorageLocations, backupStorageLocation.Name)
+			watchedBackupStorageLocations[backupStorageLocation.Name] = constant.EmptyString
 		}
 		if function.CheckVeleroBackupStorageLocationMetadata(&backupStorageLocation) {
 			err := r.Get(ctx, types.NamespacedName{
@@ -82,7 +89,7 @@ func (r *NonAdminBackupSynchronizerReconciler) Reconcile(ctx context.Context, _
 				logger.Error(err, "Unable to fetch NonAdminBackupStorageLocation")
 				return ctrl.Result{}, err
 			}
-			watchedBackupStorageLocations = append(watchedBackupStorageLocations, backupStorageLocation.Name)
+			watchedBackupStorageLocations[backupStorageLocation.Name] = backupStorageLocation.Annotations[constant.NabslOriginNamespaceAnnotation]
 			relatedNonAdminBackupStorageLocations[backupStorageLocation.Name] = backupStorageLocation.Annotations[constant.NabslOriginNameAnnotation]
 		}
 	}
@@ -95,10 +102,12 @@ func (r *NonAdminBackupSynchronizerReconciler) Reconcile(ctx context.Context, _
 	var backupsToSync []velerov1.Backup
 	var possibleBackupsToSync []velerov1.Backup
 	for _, backup := range veleroBackupList.Items {
+		backupStorageLocationNamespace, watched := watchedBackupStorageLocations[backup.Spec.StorageLocation]
 		if function.CheckVeleroBackupAnnotations(&backup) &&
 			function.CheckLabelAnnotationValueIsValid(backup.GetLabels(), constant.NabOriginNACUUIDLabel) &&
-			backup.Status.CompletionTimestamp != nil &&
-			slices.Contains(watchedBackupStorageLocations, backup.Spec.StorageLocation) {
+			backup.Status.CompletionTimestamp != nil && backup.DeletionTimestamp.IsZero() && watched &&
+			(backupStorageLocationNamespace == constant.EmptyString ||
+				backupStorageLocationNamespace == backup.Annotations[constant.NabOriginNamespaceAnnotation]) {
 			possibleBackupsToSync = append(possibleBackupsToSync, backup)
 		}
 	}
@@ -164,11 +173,27 @@ func (r *NonAdminBackupSynchronizerReconciler) Reconcile(ctx context.Context, _
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *NonAdminBackupSynchronizerReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		Named("nonadminbackupsynchronizer").
 		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
 			return logr.New(ctrl.Log.GetSink().WithValues("controller", "nonadminbackupsynchronizer"))
-		}).
-		WatchesRawSource(&source.PeriodicalSource{Frequency: r.SyncPeriod}).
-		Complete(r)
+		})
+	if r.SyncPeriod > 0 {
+		controllerBuilder = controllerBuilder.WatchesRawSource(&source.PeriodicalSource{Frequency: r.SyncPeriod})
+	}
+	if r.AdoptOrphanBackups {
+		// A NonAdminBackup deleted while its Velero Backup is kept, for example after its finalizer was removed,
+		// is recreated without waiting for the next sync period
+		controllerBuilder = controllerBuilder.Watches(&nacv1alpha1.NonAdminBackup{},
+			handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+				return []reconcile.Request{{}}
+			}),
+			ctrlbuilder.WithPredicates(ctrlpredicate.Funcs{
+				CreateFunc:  func(event.CreateEvent) bool { return false },
+				UpdateFunc:  func(event.UpdateEvent) bool { return false },
+				DeleteFunc:  func(event.DeleteEvent) bool { return true },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+			}))
+	}
+	return controllerBuilder.Complete(r)
 }
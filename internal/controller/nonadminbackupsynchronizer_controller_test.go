@@ -25,12 +25,15 @@ import (
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
@@ -42,6 +45,14 @@ type nonAdminBackupSynchronizerFullReconcileScenario struct {
 	errorLogs       int
 }
 
+type nonAdminBackupSynchronizerScenario struct {
+	released          bool
+	otherNamespaceBSL bool
+	finished          bool
+	existingNab       bool
+	synced            bool
+}
+
 type backupToCreate struct {
 	nonAdminBSL                   bool
 	namespaceExist                bool
@@ -197,7 +208,7 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminBackup Synchronizer
 
 			time.Sleep(8 * time.Second)
 			gomega.Expect(strings.Count(ginkgo.CurrentSpecReport().CapturedGinkgoWriterOutput, "NonAdminBackup Synchronization start")).Should(gomega.Equal(5))
-			gomega.Expect(strings.Count(ginkgo.CurrentSpecReport().CapturedGinkgoWriterOutput, "4 possible Backup(s) to be synced to NonAdmin namespaces")).Should(gomega.Equal(5))
+			gomega.Expect(strings.Count(ginkgo.CurrentSpecReport().CapturedGinkgoWriterOutput, "3 possible Backup(s) to be synced to NonAdmin namespaces")).Should(gomega.Equal(5))
 			gomega.Expect(strings.Count(ginkgo.CurrentSpecReport().CapturedGinkgoWriterOutput, "2 Backup(s) to sync to NonAdmin namespaces")).Should(gomega.Equal(1))
 			gomega.Expect(strings.Count(ginkgo.CurrentSpecReport().CapturedGinkgoWriterOutput, "0 Backup(s) to sync to NonAdmin namespaces")).Should(gomega.Equal(4))
 			gomega.Expect(strings.Count(ginkgo.CurrentSpecReport().CapturedGinkgoWriterOutput, "ERROR")).Should(gomega.Equal(scenario.errorLogs))
@@ -259,3 +270,125 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminBackup Synchronizer
 		}),
 	)
 })
+
+var _ = ginkgo.Describe("Test single reconciles of NonAdminBackup Synchronizer Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      = "test-nab-synchronizer"
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectNamespace = fmt.Sprintf("test-non-admin-backup-synchronizer-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.DescribeTable("Reconcile triggered by NonAdminBackup deletion",
+		func(scenario nonAdminBackupSynchronizerScenario) {
+			backupStorageLocation := &velerov1.BackupStorageLocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-default-bsl",
+					Namespace: oadpNamespace,
+				},
+				Spec: velerov1.BackupStorageLocationSpec{
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{
+							Bucket: "example-bucket",
+						},
+					},
+					Default: true,
+				},
+			}
+			if scenario.otherNamespaceBSL {
+				// a NonAdminBackupStorageLocation of another namespace than the origin namespace of the Velero Backup
+				nonAdminBSL := buildTestNonAdminBackupStorageLocation(oadpNamespace, "test-non-admin-bsl", nacv1alpha1.NonAdminBackupStorageLocationSpec{
+					BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+						StorageType: velerov1.StorageType{
+							ObjectStorage: &velerov1.ObjectStorageLocation{
+								Bucket: "another-bucket",
+							},
+						},
+					},
+				})
+				gomega.Expect(k8sClient.Create(ctx, nonAdminBSL)).To(gomega.Succeed())
+				backupStorageLocation.Name = fakeUUID
+				backupStorageLocation.Labels = function.GetNonAdminLabels()
+				backupStorageLocation.Labels[constant.NabslOriginNACUUIDLabel] = fakeUUID
+				backupStorageLocation.Annotations = function.GetNonAdminBackupStorageLocationAnnotations(nonAdminBSL.ObjectMeta)
+				backupStorageLocation.Spec.Default = false
+			}
+			gomega.Expect(k8sClient.Create(ctx, backupStorageLocation)).To(gomega.Succeed())
+
+			if scenario.existingNab {
+				gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminBackup{
+					ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace},
+					Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+				})).To(gomega.Succeed())
+			}
+			veleroBackup := buildTestBackup(oadpNamespace, "test-backup", nonAdminObjectNamespace)
+			veleroBackup.Annotations[constant.NabOriginNameAnnotation] = nonAdminObjectName
+			veleroBackup.Spec.StorageLocation = backupStorageLocation.Name
+			veleroBackup.Spec.IncludedNamespaces = []string{nonAdminObjectNamespace}
+			if scenario.released {
+				delete(veleroBackup.Labels, constant.ManagedByLabel)
+			}
+			gomega.Expect(k8sClient.Create(ctx, veleroBackup)).To(gomega.Succeed())
+			if scenario.finished {
+				veleroBackup.Status = velerov1.BackupStatus{
+					Phase:               velerov1.BackupPhaseCompleted,
+					CompletionTimestamp: &metav1.Time{Time: time.Now()},
+				}
+				// can not call .Status().Update() for veleroBackup object https://github.com/vmware-tanzu/velero/issues/8285
+				gomega.Expect(k8sClient.Update(ctx, veleroBackup)).To(gomega.Succeed())
+			}
+
+			result, err := (&NonAdminBackupSynchronizerReconciler{
+				Client:             k8sClient,
+				Scheme:             testEnv.Scheme,
+				OADPNamespace:      oadpNamespace,
+				AdoptOrphanBackups: true,
+			}).Reconcile(ctx, reconcile.Request{})
+			gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+			gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+			nab := &nacv1alpha1.NonAdminBackup{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}, nab)
+			switch {
+			case scenario.synced:
+				gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+				gomega.Expect(nab.Labels).To(gomega.HaveKeyWithValue(constant.NabSyncLabel, fakeUUID))
+				gomega.Expect(nab.Spec.BackupSpec.IncludedNamespaces).To(gomega.Equal([]string{nonAdminObjectNamespace}))
+			case scenario.existingNab:
+				gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+				gomega.Expect(nab.Labels).NotTo(gomega.HaveKey(constant.NabSyncLabel))
+			default:
+				gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+			}
+		},
+		ginkgo.Entry("Should recreate NonAdminBackup of orphan Velero Backup", nonAdminBackupSynchronizerScenario{
+			finished: true,
+			synced:   true,
+		}),
+		ginkgo.Entry("Should not recreate NonAdminBackup of Velero Backup released with retainBackupOnDelete", nonAdminBackupSynchronizerScenario{
+			finished: true,
+			released: true,
+		}),
+		ginkgo.Entry("Should not recreate NonAdminBackup of Velero Backup not completed", nonAdminBackupSynchronizerScenario{}),
+		ginkgo.Entry("Should not recreate NonAdminBackup of Velero Backup stored in NonAdminBackupStorageLocation of another namespace", nonAdminBackupSynchronizerScenario{
+			finished:          true,
+			otherNamespaceBSL: true,
+		}),
+		ginkgo.Entry("Should not change existing NonAdminBackup", nonAdminBackupSynchronizerScenario{
+			finished:    true,
+			existingNab: true,
+		}),
+	)
+})
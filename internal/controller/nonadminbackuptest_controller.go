@@ -0,0 +1,590 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+// NonAdminBackupTestReconciler reconciles a NonAdminBackupTest object
+type NonAdminBackupTestReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+type nonAdminBackupTestReconcileStepFunction func(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error)
+
+const (
+	nonAdminBackupTestStatusUpdateFailureMessage = "Failed to update NonAdminBackupTest Status"
+	// nonAdminBackupTestVolumeSize is the size of the canary PersistentVolumeClaim
+	nonAdminBackupTestVolumeSize = "1Mi"
+	// nonAdminBackupTestVolumeBindTimeout is the time the canary PersistentVolumeClaim may take to be bound,
+	// after which the test fails
+	nonAdminBackupTestVolumeBindTimeout = 5 * time.Minute
+)
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackuptests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackuptests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackuptests/finalizers,verbs=update
+
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state,
+// defined in NonAdminBackupTest object Spec.
+//
+// The test creates a canary ConfigMap, and with spec.volume a canary PersistentVolumeClaim, backs them up
+// with a NonAdminBackup and, when requested, deletes the canary and restores it with a NonAdminRestore. Once the outcome is known, the
+// objects created by the test are removed and the NonAdminBackupTest ends as Completed or Failed.
+func (r *NonAdminBackupTestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminBackupTest Reconcile start")
+
+	nabt := &nacv1alpha1.NonAdminBackupTest{}
+	err := r.Get(ctx, req.NamespacedName, nabt)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminBackupTest")
+		return ctrl.Result{}, err
+	}
+
+	var reconcileSteps []nonAdminBackupTestReconcileStepFunction
+
+	switch {
+	case !nabt.DeletionTimestamp.IsZero():
+		// objects created by the test are owned by the NonAdminBackupTest and garbage collected with it
+		logger.V(1).Info("NonAdminBackupTest is being deleted")
+	case nabt.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted || nabt.Status.Phase == nacv1alpha1.NonAdminPhaseFailed:
+		logger.V(1).Info("NonAdminBackupTest already finished")
+	default:
+		logger.V(1).Info("Executing test path")
+		reconcileSteps = []nonAdminBackupTestReconcileStepFunction{
+			r.init,
+			r.createCanary,
+			r.waitForCanaryVolume,
+			r.createNonAdminBackup,
+			r.verifyNonAdminBackup,
+			r.createNonAdminRestore,
+			r.verifyNonAdminRestore,
+			r.cleanupAndFinish,
+		}
+	}
+
+	// Execute the selected reconciliation steps
+	for _, step := range reconcileSteps {
+		requeue, err := step(ctx, logger, nabt)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if requeue {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	logger.V(1).Info("NonAdminBackupTest Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// init initializes the Status.Phase from the NonAdminBackupTest.
+func (r *NonAdminBackupTestReconciler) init(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	if nabt.Status.Phase == constant.EmptyString {
+		if updated := updateNonAdminPhase(&nabt.Status.Phase, nacv1alpha1.NonAdminPhaseNew); updated {
+			if err := r.Status().Update(ctx, nabt); err != nil {
+				logger.Error(err, nonAdminBackupTestStatusUpdateFailureMessage)
+				return false, err
+			}
+			logger.V(1).Info("NonAdminBackupTest Phase set to New")
+		}
+	}
+	return false, nil
+}
+
+// createCanary creates the ConfigMap, and with spec.volume the PersistentVolumeClaim, that are backed up, and optionally
+// restored, by the test. It is skipped once the NonAdminBackup exists, so a canary deleted for the restore is not recreated.
+func (r *NonAdminBackupTestReconciler) createCanary(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	if nabt.Status.NonAdminBackupName != constant.EmptyString ||
+		meta.FindStatusCondition(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified)) != nil {
+		return false, nil
+	}
+	if nabt.Spec.Volume != nil {
+		if err := r.createCanaryVolume(ctx, logger, nabt); err != nil {
+			return false, err
+		}
+	}
+	canary := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nabt.CanaryName(),
+			Namespace: nabt.Namespace,
+			Labels: map[string]string{
+				constant.NabtCanaryLabel: nabt.Name,
+			},
+		},
+		Data: map[string]string{
+			"canary": string(nabt.UID),
+		},
+	}
+	if err := controllerutil.SetControllerReference(nabt, canary, r.Scheme); err != nil {
+		return false, err
+	}
+	if err := r.Create(ctx, canary); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return false, nil
+		}
+		logger.Error(err, "Failed to create canary ConfigMap")
+		return false, err
+	}
+	logger.V(1).Info("Canary ConfigMap created", constant.NameString, canary.Name)
+	return false, nil
+}
+
+// createCanaryVolume creates the canary PersistentVolumeClaim
+func (r *NonAdminBackupTestReconciler) createCanaryVolume(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) error {
+	canaryVolume := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nabt.CanaryName(),
+			Namespace: nabt.Namespace,
+			Labels: map[string]string{
+				constant.NabtCanaryLabel: nabt.Name,
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: nabt.Spec.Volume.StorageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(nonAdminBackupTestVolumeSize)},
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(nabt, canaryVolume, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, canaryVolume); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		logger.Error(err, "Failed to create canary PersistentVolumeClaim")
+		return err
+	}
+	logger.V(1).Info("Canary PersistentVolumeClaim created", constant.NameString, canaryVolume.Name)
+	return nil
+}
+
+// waitForCanaryVolume waits for the canary PersistentVolumeClaim to be bound before it is backed up, so its volume
+// is backed up too. If it is not bound in time, the BackupVerified condition is set to false and the test fails.
+func (r *NonAdminBackupTestReconciler) waitForCanaryVolume(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	if nabt.Spec.Volume == nil || nabt.Status.NonAdminBackupName != constant.EmptyString ||
+		meta.FindStatusCondition(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified)) != nil {
+		return false, nil
+	}
+	canaryVolume := &corev1.PersistentVolumeClaim{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: nabt.Namespace, Name: nabt.CanaryName()}, canaryVolume); err != nil {
+		if apierrors.IsNotFound(err) {
+			// not in the cache yet
+			return true, nil
+		}
+		logger.Error(err, "Unable to fetch canary PersistentVolumeClaim")
+		return false, err
+	}
+	if canaryVolume.Status.Phase == corev1.ClaimBound {
+		return false, nil
+	}
+	if canaryVolume.CreationTimestamp.IsZero() || time.Since(canaryVolume.CreationTimestamp.Time) < nonAdminBackupTestVolumeBindTimeout {
+		logger.V(1).Info("Waiting for canary PersistentVolumeClaim to be bound", constant.NameString, canaryVolume.Name)
+		return true, nil
+	}
+
+	meta.SetStatusCondition(&nabt.Status.Conditions,
+		metav1.Condition{
+			Type:   string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified),
+			Status: metav1.ConditionFalse,
+			Reason: "CanaryVolumeNotBound",
+			Message: fmt.Sprintf("canary PersistentVolumeClaim was not bound after %v, its storage class must have the Immediate binding mode",
+				nonAdminBackupTestVolumeBindTimeout),
+		},
+	)
+	if err := r.Status().Update(ctx, nabt); err != nil {
+		logger.Error(err, nonAdminBackupTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackupTest canary PersistentVolumeClaim not bound")
+	return false, nil
+}
+
+// createNonAdminBackup creates the NonAdminBackup of the canary ConfigMap, and PersistentVolumeClaim.
+func (r *NonAdminBackupTestReconciler) createNonAdminBackup(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	if nabt.Status.NonAdminBackupName != constant.EmptyString ||
+		meta.FindStatusCondition(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified)) != nil {
+		return false, nil
+	}
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nabt.NonAdminBackupName(),
+			Namespace: nabt.Namespace,
+		},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: &velerov1.BackupSpec{
+				IncludedResources: []string{"configmaps"},
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						constant.NabtCanaryLabel: nabt.Name,
+					},
+				},
+				StorageLocation: nabt.Spec.StorageLocation,
+			},
+		},
+	}
+	if nabt.Spec.Volume != nil {
+		// the volume of the claim, and its snapshots, are backed up as additional items of the claim,
+		// which must not be filtered out by resource
+		nab.Spec.BackupSpec.IncludedResources = nil
+	}
+	if err := controllerutil.SetControllerReference(nabt, nab, r.Scheme); err != nil {
+		return false, err
+	}
+	if err := r.Create(ctx, nab); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create NonAdminBackup")
+			return false, err
+		}
+		// Created by a previous reconcile, unless it belongs to something else
+		if err := r.checkControlledObject(ctx, nabt, nab); err != nil {
+			logger.Error(err, "Unable to use existing NonAdminBackup")
+			return false, err
+		}
+	}
+
+	nabt.Status.NonAdminBackupName = nab.Name
+	updateNonAdminPhase(&nabt.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+	if err := r.Status().Update(ctx, nabt); err != nil {
+		logger.Error(err, nonAdminBackupTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackup created", constant.NameString, nab.Name)
+	return false, nil
+}
+
+// checkControlledObject gets the object with the name and namespace of obj and returns an error
+// if it is not controlled by the NonAdminBackupTest.
+func (r *NonAdminBackupTestReconciler) checkControlledObject(ctx context.Context, nabt *nacv1alpha1.NonAdminBackupTest, obj client.Object) error {
+	if err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(obj, nabt) {
+		return reconcile.TerminalError(fmt.Errorf("%s already exists and is not controlled by the NonAdminBackupTest", obj.GetName()))
+	}
+	return nil
+}
+
+// verifyNonAdminBackup sets the BackupVerified condition once the NonAdminBackup reaches a final phase.
+// Until then, the NonAdminBackupTest is reconciled again on NonAdminBackup status changes.
+func (r *NonAdminBackupTestReconciler) verifyNonAdminBackup(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	if meta.FindStatusCondition(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified)) != nil {
+		return false, nil
+	}
+	nab := &nacv1alpha1.NonAdminBackup{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: nabt.Namespace, Name: nabt.Status.NonAdminBackupName}, nab); err != nil {
+		logger.Error(err, "Unable to fetch NonAdminBackup")
+		return false, err
+	}
+
+	condition := metav1.Condition{
+		Type: string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified),
+	}
+	switch nab.Status.Phase {
+	case nacv1alpha1.NonAdminPhaseCompleted:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "BackupCompleted"
+		condition.Message = "NonAdminBackup completed successfully"
+	case nacv1alpha1.NonAdminPhaseBackingOff, nacv1alpha1.NonAdminPhasePartiallyFailed, nacv1alpha1.NonAdminPhaseFailed:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "BackupFailed"
+		condition.Message = fmt.Sprintf("NonAdminBackup ended in %s phase, check its status for details", nab.Status.Phase)
+	default:
+		logger.V(1).Info("Waiting for NonAdminBackup to finish", constant.NameString, nab.Name)
+		return false, nil
+	}
+
+	meta.SetStatusCondition(&nabt.Status.Conditions, condition)
+	if err := r.Status().Update(ctx, nabt); err != nil {
+		logger.Error(err, nonAdminBackupTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackupTest BackupVerified condition set", "status", condition.Status)
+	return false, nil
+}
+
+// createNonAdminRestore deletes the canary ConfigMap and creates the NonAdminRestore that should recreate it.
+// It runs only when spec.restore is set and the backup was verified.
+func (r *NonAdminBackupTestReconciler) createNonAdminRestore(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	if !nabt.Spec.Restore ||
+		nabt.Status.NonAdminRestoreName != constant.EmptyString ||
+		!meta.IsStatusConditionTrue(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified)) {
+		return false, nil
+	}
+	nar := &nacv1alpha1.NonAdminRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nabt.NonAdminRestoreName(),
+			Namespace: nabt.Namespace,
+		},
+		Spec: nacv1alpha1.NonAdminRestoreSpec{
+			RestoreSpec: &velerov1.RestoreSpec{
+				BackupName: nabt.Status.NonAdminBackupName,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(nabt, nar, r.Scheme); err != nil {
+		return false, err
+	}
+	// If the NonAdminRestore was created by a previous reconcile, the canary may already be restored
+	// and must not be deleted again
+	err := r.checkControlledObject(ctx, nabt, nar.DeepCopy())
+	switch {
+	case apierrors.IsNotFound(err):
+		if err := r.deleteCanary(ctx, nabt); err != nil {
+			logger.Error(err, "Failed to delete canary")
+			return false, err
+		}
+		// Velero does not restore a claim which still exists
+		if exists, err := r.canaryVolumeExists(ctx, nabt); err != nil || exists {
+			if err != nil {
+				logger.Error(err, "Unable to fetch canary PersistentVolumeClaim")
+			}
+			return exists, err
+		}
+		if err := r.Create(ctx, nar); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create NonAdminRestore")
+			return false, err
+		}
+	case err != nil:
+		logger.Error(err, "Unable to use existing NonAdminRestore")
+		return false, err
+	}
+
+	nabt.Status.NonAdminRestoreName = nar.Name
+	if err := r.Status().Update(ctx, nabt); err != nil {
+		logger.Error(err, nonAdminBackupTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminRestore created", constant.NameString, nar.Name)
+	return false, nil
+}
+
+// verifyNonAdminRestore sets the RestoreVerified condition once the VeleroRestore reaches a final phase,
+// checking that the canary ConfigMap, and PersistentVolumeClaim, were recreated.
+func (r *NonAdminBackupTestReconciler) verifyNonAdminRestore(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	if nabt.Status.NonAdminRestoreName == constant.EmptyString ||
+		meta.FindStatusCondition(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionRestoreVerified)) != nil {
+		return false, nil
+	}
+	nar := &nacv1alpha1.NonAdminRestore{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: nabt.Namespace, Name: nabt.Status.NonAdminRestoreName}, nar); err != nil {
+		logger.Error(err, "Unable to fetch NonAdminRestore")
+		return false, err
+	}
+
+	condition := metav1.Condition{
+		Type: string(nacv1alpha1.NonAdminBackupTestConditionRestoreVerified),
+	}
+	var veleroRestorePhase velerov1.RestorePhase
+	if nar.Status.VeleroRestore != nil && nar.Status.VeleroRestore.Status != nil {
+		veleroRestorePhase = nar.Status.VeleroRestore.Status.Phase
+	}
+	switch {
+	case nar.Status.Phase == nacv1alpha1.NonAdminPhaseBackingOff:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RestoreFailed"
+		condition.Message = "NonAdminRestore is in BackingOff phase, check its status for details"
+	case veleroRestorePhase == velerov1.RestorePhaseCompleted:
+		canary := &corev1.ConfigMap{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: nabt.Namespace, Name: nabt.CanaryName()}, canary)
+		canaryVolumeRestored := true
+		if err == nil && nabt.Spec.Volume != nil {
+			canaryVolumeRestored, err = r.canaryVolumeExists(ctx, nabt)
+		}
+		switch {
+		case err == nil && !canaryVolumeRestored:
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "CanaryNotRestored"
+			condition.Message = "NonAdminRestore completed, but canary PersistentVolumeClaim was not restored"
+		case err == nil:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "RestoreCompleted"
+			condition.Message = "Canary ConfigMap was restored"
+			if nabt.Spec.Volume != nil {
+				condition.Message = "Canary ConfigMap and PersistentVolumeClaim were restored"
+			}
+		case apierrors.IsNotFound(err):
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "CanaryNotRestored"
+			condition.Message = "NonAdminRestore completed, but canary ConfigMap was not restored"
+		default:
+			logger.Error(err, "Unable to fetch canary")
+			return false, err
+		}
+	case veleroRestorePhase == velerov1.RestorePhasePartiallyFailed ||
+		veleroRestorePhase == velerov1.RestorePhaseFailed ||
+		veleroRestorePhase == velerov1.RestorePhaseFailedValidation:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RestoreFailed"
+		condition.Message = fmt.Sprintf("VeleroRestore ended in %s phase, check NonAdminRestore status for details", veleroRestorePhase)
+	default:
+		logger.V(1).Info("Waiting for NonAdminRestore to finish", constant.NameString, nar.Name)
+		return false, nil
+	}
+
+	meta.SetStatusCondition(&nabt.Status.Conditions, condition)
+	if err := r.Status().Update(ctx, nabt); err != nil {
+		logger.Error(err, nonAdminBackupTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackupTest RestoreVerified condition set", "status", condition.Status)
+	return false, nil
+}
+
+// cleanupAndFinish removes the objects created by the test once its outcome is known, and sets the
+// NonAdminBackupTest phase to Completed if every check passed, Failed otherwise. The NonAdminBackup
+// is removed with spec.deleteBackup, so the test backup data is also deleted from object storage.
+func (r *NonAdminBackupTestReconciler) cleanupAndFinish(ctx context.Context, logger logr.Logger, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	backupVerified := meta.FindStatusCondition(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified))
+	if backupVerified == nil {
+		return false, nil
+	}
+	passed := backupVerified.Status == metav1.ConditionTrue
+	if passed && nabt.Spec.Restore {
+		restoreVerified := meta.FindStatusCondition(nabt.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionRestoreVerified))
+		if restoreVerified == nil {
+			return false, nil
+		}
+		passed = restoreVerified.Status == metav1.ConditionTrue
+	}
+
+	if nabt.Status.NonAdminRestoreName != constant.EmptyString {
+		nar := &nacv1alpha1.NonAdminRestore{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: nabt.Namespace, Name: nabt.Status.NonAdminRestoreName}, nar); err == nil {
+			if err := r.Delete(ctx, nar); err != nil && !apierrors.IsNotFound(err) {
+				logger.Error(err, "Failed to delete NonAdminRestore")
+				return false, err
+			}
+		} else if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Unable to fetch NonAdminRestore")
+			return false, err
+		}
+	}
+
+	// the NonAdminBackup is not created when the canary PersistentVolumeClaim is not bound
+	if nabt.Status.NonAdminBackupName != constant.EmptyString {
+		nab := &nacv1alpha1.NonAdminBackup{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: nabt.Namespace, Name: nabt.Status.NonAdminBackupName}, nab); err == nil {
+			if !nab.Spec.DeleteBackup {
+				nab.Spec.DeleteBackup = true
+				nab.Spec.DeleteBackupConfirmation = nab.Name
+				if err := r.Update(ctx, nab); err != nil {
+					logger.Error(err, "Failed to request NonAdminBackup deletion")
+					return false, err
+				}
+			}
+		} else if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Unable to fetch NonAdminBackup")
+			return false, err
+		}
+	}
+
+	if err := r.deleteCanary(ctx, nabt); err != nil {
+		logger.Error(err, "Failed to delete canary")
+		return false, err
+	}
+
+	meta.SetStatusCondition(&nabt.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminBackupTestConditionCleanedUp),
+			Status:  metav1.ConditionTrue,
+			Reason:  "TestObjectsRemoved",
+			Message: "Objects created by the test were removed",
+		},
+	)
+	if passed {
+		updateNonAdminPhase(&nabt.Status.Phase, nacv1alpha1.NonAdminPhaseCompleted)
+	} else {
+		updateNonAdminPhase(&nabt.Status.Phase, nacv1alpha1.NonAdminPhaseFailed)
+	}
+	if err := r.Status().Update(ctx, nabt); err != nil {
+		logger.Error(err, nonAdminBackupTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackupTest finished", "phase", nabt.Status.Phase)
+	return false, nil
+}
+
+// deleteCanary deletes the canary ConfigMap, and PersistentVolumeClaim
+func (r *NonAdminBackupTestReconciler) deleteCanary(ctx context.Context, nabt *nacv1alpha1.NonAdminBackupTest) error {
+	canaryObjectMeta := metav1.ObjectMeta{
+		Name:      nabt.CanaryName(),
+		Namespace: nabt.Namespace,
+	}
+	if err := r.Delete(ctx, &corev1.ConfigMap{ObjectMeta: canaryObjectMeta}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if nabt.Spec.Volume == nil {
+		return nil
+	}
+	if err := r.Delete(ctx, &corev1.PersistentVolumeClaim{ObjectMeta: canaryObjectMeta}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// canaryVolumeExists returns true if the canary PersistentVolumeClaim exists, even if it is being deleted
+func (r *NonAdminBackupTestReconciler) canaryVolumeExists(ctx context.Context, nabt *nacv1alpha1.NonAdminBackupTest) (bool, error) {
+	canaryVolume := &corev1.PersistentVolumeClaim{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: nabt.Namespace, Name: nabt.CanaryName()}, canaryVolume)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminBackupTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminBackupTest{}).
+		Owns(&nacv1alpha1.NonAdminBackup{}).
+		Owns(&nacv1alpha1.NonAdminRestore{}).
+		Complete(r)
+}
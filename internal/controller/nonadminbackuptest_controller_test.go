@@ -0,0 +1,214 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+type nonAdminBackupTestReconcileScenario struct {
+	spec                   nacv1alpha1.NonAdminBackupTestSpec
+	nonAdminBackupPhase    nacv1alpha1.NonAdminPhase
+	expectedPhase          nacv1alpha1.NonAdminPhase
+	expectedBackupVerified metav1.ConditionStatus
+}
+
+var _ = ginkgo.Describe("Test NonAdminBackupTest Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nabt-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nabt-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.DescribeTable("Reconcile runs the backup test until it finishes",
+		func(scenario nonAdminBackupTestReconcileScenario) {
+			reconciler := &NonAdminBackupTestReconciler{
+				Client: k8sClient,
+				Scheme: testEnv.Scheme,
+			}
+			request := reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+			}}
+
+			nonAdminBackupTest := &nacv1alpha1.NonAdminBackupTest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      nonAdminObjectName,
+					Namespace: nonAdminObjectNamespace,
+				},
+				Spec: scenario.spec,
+			}
+			gomega.Expect(k8sClient.Create(ctx, nonAdminBackupTest)).To(gomega.Succeed())
+
+			ginkgo.By("Creating the canary ConfigMap and the NonAdminBackup")
+			result, err := reconciler.Reconcile(ctx, request)
+			gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+			gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+			gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminBackupTest)).To(gomega.Succeed())
+			gomega.Expect(nonAdminBackupTest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+			gomega.Expect(nonAdminBackupTest.Status.NonAdminBackupName).To(gomega.Equal(nonAdminBackupTest.NonAdminBackupName()))
+
+			canary := &corev1.ConfigMap{}
+			gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupTest.CanaryName(), Namespace: nonAdminObjectNamespace}, canary)).To(gomega.Succeed())
+
+			nonAdminBackup := &nacv1alpha1.NonAdminBackup{}
+			gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupTest.NonAdminBackupName(), Namespace: nonAdminObjectNamespace}, nonAdminBackup)).To(gomega.Succeed())
+			gomega.Expect(nonAdminBackup.Spec.BackupSpec.StorageLocation).To(gomega.Equal(scenario.spec.StorageLocation))
+			gomega.Expect(metav1.IsControlledBy(nonAdminBackup, nonAdminBackupTest)).To(gomega.BeTrue())
+
+			ginkgo.By("Finishing the NonAdminBackup")
+			nonAdminBackup.Status.Phase = scenario.nonAdminBackupPhase
+			gomega.Expect(k8sClient.Status().Update(ctx, nonAdminBackup)).To(gomega.Succeed())
+
+			result, err = reconciler.Reconcile(ctx, request)
+			gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+			gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+			gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminBackupTest)).To(gomega.Succeed())
+			gomega.Expect(nonAdminBackupTest.Status.Phase).To(gomega.Equal(scenario.expectedPhase))
+			backupVerified := meta.FindStatusCondition(nonAdminBackupTest.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified))
+			gomega.Expect(backupVerified).NotTo(gomega.BeNil())
+			gomega.Expect(backupVerified.Status).To(gomega.Equal(scenario.expectedBackupVerified))
+			gomega.Expect(meta.IsStatusConditionTrue(nonAdminBackupTest.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionCleanedUp))).To(gomega.BeTrue())
+
+			ginkgo.By("Checking the test objects were removed")
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupTest.CanaryName(), Namespace: nonAdminObjectNamespace}, canary)
+			gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupTest.NonAdminBackupName(), Namespace: nonAdminObjectNamespace}, nonAdminBackup)).To(gomega.Succeed())
+			gomega.Expect(nonAdminBackup.Spec.DeleteBackup).To(gomega.BeTrue())
+		},
+		ginkgo.Entry("Should pass when NonAdminBackup completes", nonAdminBackupTestReconcileScenario{
+			spec: nacv1alpha1.NonAdminBackupTestSpec{
+				StorageLocation: "tenant-bsl",
+			},
+			nonAdminBackupPhase:    nacv1alpha1.NonAdminPhaseCompleted,
+			expectedPhase:          nacv1alpha1.NonAdminPhaseCompleted,
+			expectedBackupVerified: metav1.ConditionTrue,
+		}),
+		ginkgo.Entry("Should fail when NonAdminBackup fails", nonAdminBackupTestReconcileScenario{
+			nonAdminBackupPhase:    nacv1alpha1.NonAdminPhaseFailed,
+			expectedPhase:          nacv1alpha1.NonAdminPhaseFailed,
+			expectedBackupVerified: metav1.ConditionFalse,
+		}),
+	)
+
+	ginkgo.It("Should back up the canary PersistentVolumeClaim once it is bound", func() {
+		reconciler := &NonAdminBackupTestReconciler{
+			Client: k8sClient,
+			Scheme: testEnv.Scheme,
+		}
+		request := reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      nonAdminObjectName,
+			Namespace: nonAdminObjectNamespace,
+		}}
+		nonAdminBackupTest := &nacv1alpha1.NonAdminBackupTest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+			},
+			Spec: nacv1alpha1.NonAdminBackupTestSpec{Volume: &nacv1alpha1.NonAdminBackupTestVolume{}},
+		}
+		gomega.Expect(k8sClient.Create(ctx, nonAdminBackupTest)).To(gomega.Succeed())
+
+		ginkgo.By("Waiting for the canary PersistentVolumeClaim to be bound")
+		result, err := reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{Requeue: true}))
+		canaryVolume := &corev1.PersistentVolumeClaim{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupTest.CanaryName(), Namespace: nonAdminObjectNamespace}, canaryVolume)).To(gomega.Succeed())
+		gomega.Expect(canaryVolume.Labels).To(gomega.HaveKeyWithValue(constant.NabtCanaryLabel, nonAdminObjectName))
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupTest.NonAdminBackupName(), Namespace: nonAdminObjectNamespace}, &nacv1alpha1.NonAdminBackup{})
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+		ginkgo.By("Creating the NonAdminBackup once the canary PersistentVolumeClaim is bound")
+		canaryVolume.Status.Phase = corev1.ClaimBound
+		gomega.Expect(k8sClient.Status().Update(ctx, canaryVolume)).To(gomega.Succeed())
+		result, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+		nonAdminBackup := &nacv1alpha1.NonAdminBackup{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupTest.NonAdminBackupName(), Namespace: nonAdminObjectNamespace}, nonAdminBackup)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackup.Spec.BackupSpec.IncludedResources).To(gomega.BeEmpty())
+		gomega.Expect(nonAdminBackup.Spec.BackupSpec.LabelSelector.MatchLabels).To(gomega.HaveKeyWithValue(constant.NabtCanaryLabel, nonAdminObjectName))
+	})
+
+	ginkgo.It("Should fail when the canary PersistentVolumeClaim is not bound in time", func() {
+		nonAdminBackupTest := &nacv1alpha1.NonAdminBackupTest{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace},
+			Spec:       nacv1alpha1.NonAdminBackupTestSpec{Volume: &nacv1alpha1.NonAdminBackupTestVolume{}},
+		}
+		canaryVolume := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              nonAdminBackupTest.CanaryName(),
+				Namespace:         nonAdminObjectNamespace,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-nonAdminBackupTestVolumeBindTimeout)),
+			},
+			Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminBackupTest{}).
+			WithObjects(nonAdminBackupTest, canaryVolume).Build()
+		reconciler := &NonAdminBackupTestReconciler{Client: fakeClient, Scheme: k8sClient.Scheme()}
+
+		requeue, err := reconciler.waitForCanaryVolume(ctx, logr.Discard(), nonAdminBackupTest)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		backupVerified := meta.FindStatusCondition(nonAdminBackupTest.Status.Conditions, string(nacv1alpha1.NonAdminBackupTestConditionBackupVerified))
+		gomega.Expect(backupVerified).NotTo(gomega.BeNil())
+		gomega.Expect(backupVerified.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(backupVerified.Reason).To(gomega.Equal("CanaryVolumeNotBound"))
+
+		ginkgo.By("Finishing the test without NonAdminBackup")
+		gomega.Expect(reconciler.createNonAdminBackup(ctx, logr.Discard(), nonAdminBackupTest)).To(gomega.BeFalse())
+		gomega.Expect(reconciler.cleanupAndFinish(ctx, logr.Discard(), nonAdminBackupTest)).To(gomega.BeFalse())
+		gomega.Expect(nonAdminBackupTest.Status.NonAdminBackupName).To(gomega.BeEmpty())
+		gomega.Expect(nonAdminBackupTest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseFailed))
+		err = fakeClient.Get(ctx, client.ObjectKeyFromObject(canaryVolume), canaryVolume)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	})
+})
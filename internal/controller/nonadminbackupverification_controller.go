@@ -0,0 +1,729 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const nonAdminBackupVerificationStatusUpdateFailureMessage = "Failed to update NonAdminBackupVerification Status"
+
+// backupVerificationJobName is the name of the validation Job in the temporary namespace of a verification
+const backupVerificationJobName = "validation"
+
+// backupVerificationServiceAccountName is the name of the ServiceAccount, without any permission, NAC creates in the
+// temporary namespace of a verification to run the validation Job
+const backupVerificationServiceAccountName = "nac-validation"
+
+// validationJobRestrictedErr holds an error message template for a validation Job breaking the restricted Pod Security
+// Standard enforced in the temporary namespace
+const validationJobRestrictedErr = "spec.validationJob does not meet the restricted Pod Security Standard: %s"
+
+var errNabvNoBackupToVerify = errors.New("no Completed or PartiallyFailed NonAdminBackup to verify")
+
+// NonAdminBackupVerificationReconciler reconciles a NonAdminBackupVerification object
+type NonAdminBackupVerificationReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// AllowRestoreVerification lets NonAdminBackupVerifications be created, like NonAdminBackup spec.verifyRestore
+	AllowRestoreVerification bool
+	// AllowValidationJobs lets NonAdminBackupVerifications set spec.validationJob
+	AllowValidationJobs bool
+}
+
+type nonAdminBackupVerificationReconcileStepFunction func(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error)
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupverifications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupverifications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupverifications/finalizers,verbs=update
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminrestores,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;create
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state,
+// defined in NonAdminBackupVerification object Spec.
+//
+// Each verification restores the NonAdminBackup into a temporary namespace, with a NonAdminRestore, runs the
+// validation Job there once the restore completed, records the result in the NonAdminBackupVerification and
+// NonAdminBackup status, and deletes the NonAdminRestore and the temporary namespace. With spec.interval set,
+// the next verification starts the interval after the previous one finished.
+func (r *NonAdminBackupVerificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminBackupVerification Reconcile start")
+
+	nabv := &nacv1alpha1.NonAdminBackupVerification{}
+	err := r.Get(ctx, req.NamespacedName, nabv)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminBackupVerification")
+		return ctrl.Result{}, err
+	}
+
+	policyReconciler, err := r.withNonAdminPolicy(ctx, nabv.Namespace)
+	if err != nil {
+		logger.Error(err, "Unable to get NonAdminPolicy of NonAdminBackupVerification namespace")
+		return ctrl.Result{}, err
+	}
+
+	var reconcileSteps []nonAdminBackupVerificationReconcileStepFunction
+	if !nabv.DeletionTimestamp.IsZero() {
+		logger.V(1).Info("Executing direct deletion path")
+		reconcileSteps = []nonAdminBackupVerificationReconcileStepFunction{
+			policyReconciler.deleteBackupVerificationObjects,
+			policyReconciler.removeNabvFinalizer,
+		}
+	} else {
+		logger.V(1).Info("Executing verification path")
+		reconcileSteps = []nonAdminBackupVerificationReconcileStepFunction{
+			policyReconciler.initNabv,
+			policyReconciler.validateNabvSpec,
+			policyReconciler.setFinalizerOnNabv,
+			policyReconciler.startBackupVerification,
+			policyReconciler.runBackupVerification,
+		}
+	}
+
+	for _, step := range reconcileSteps {
+		requeue, err := step(ctx, logger, nabv)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if requeue {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	logger.V(1).Info("NonAdminBackupVerification Reconcile exit")
+	if requeueAfter := nextBackupVerificationRequeueAfter(nabv, time.Now()); requeueAfter > 0 {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// withNonAdminPolicy returns a copy of the reconciler enforcing the NonAdminControllerConfig, if any,
+// and the NonAdminPolicy of namespace, if any, instead of the cluster admin NAC flags
+func (r *NonAdminBackupVerificationReconciler) withNonAdminPolicy(ctx context.Context, namespace string) (*NonAdminBackupVerificationReconciler, error) {
+	configReconciler := *r
+	config, err := function.GetNonAdminControllerConfig(ctx, r.Client)
+	if err != nil {
+		return nil, err
+	}
+	if config != nil && config.Spec.AllowedFeatures != nil && config.Spec.AllowedFeatures.RestoreVerification != nil {
+		configReconciler.AllowRestoreVerification = *config.Spec.AllowedFeatures.RestoreVerification
+	}
+	policy, err := function.GetNamespaceNonAdminPolicy(ctx, r.Client, namespace)
+	if err != nil || policy == nil {
+		return &configReconciler, err
+	}
+	policyReconciler := configReconciler
+	if features := policy.Spec.AllowedFeatures; features != nil && features.RestoreVerification != nil {
+		policyReconciler.AllowRestoreVerification = *features.RestoreVerification
+	}
+	return &policyReconciler, nil
+}
+
+// initNabv initializes the Status.Phase from the NonAdminBackupVerification.
+func (r *NonAdminBackupVerificationReconciler) initNabv(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	if nabv.Status.Phase != constant.EmptyString {
+		return false, nil
+	}
+	if updated := updateNonAdminPhase(&nabv.Status.Phase, nacv1alpha1.NonAdminPhaseNew); updated {
+		if err := r.Status().Update(ctx, nabv); err != nil {
+			logger.Error(err, nonAdminBackupVerificationStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackupVerification Phase set to New")
+	}
+	return false, nil
+}
+
+// validateNabvSpec checks the NonAdminBackupVerification is allowed by the cluster admin and references a single
+// NonAdminBackup or NonAdminSchedule, and that its validation Job, if any, meets the restricted Pod Security Standard
+// and is requested by a user allowed to create Jobs in the namespace. Otherwise the NonAdminBackupVerification is
+// BackingOff until its spec is fixed.
+func (r *NonAdminBackupVerificationReconciler) validateNabvSpec(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	var err error
+	switch {
+	case !r.AllowRestoreVerification:
+		err = fmt.Errorf(constant.NABVRestrictedErr, "creation")
+	case nabv.Spec.ValidationJob != nil && !r.AllowValidationJobs:
+		err = fmt.Errorf(constant.NABVRestrictedErr, "spec.validationJob")
+	case (nabv.Spec.BackupName == constant.EmptyString) == (nabv.Spec.NonAdminScheduleName == constant.EmptyString):
+		err = errors.New("exactly one of spec.backupName and spec.nonAdminScheduleName must be set")
+	case nabv.Spec.Interval != nil && nabv.Spec.Interval.Duration <= 0:
+		err = errors.New("spec.interval must be positive")
+	case nabv.Spec.ValidationJob != nil:
+		if err = validateValidationJobPodSpec(&nabv.Spec.ValidationJob.Template.Spec); err == nil {
+			err = function.CheckRequesterCanRunValidationJobs(ctx, r.Client, nabv)
+		}
+	}
+	if err != nil {
+		updatedPhase := updateNonAdminPhase(&nabv.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		updatedCondition := meta.SetStatusCondition(&nabv.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidNonAdminBackupVerificationSpec",
+			Message: err.Error(),
+		})
+		if updatedPhase || updatedCondition {
+			if updateErr := r.Status().Update(ctx, nabv); updateErr != nil {
+				logger.Error(updateErr, nonAdminBackupVerificationStatusUpdateFailureMessage)
+				return false, updateErr
+			}
+		}
+		return false, reconcile.TerminalError(err)
+	}
+
+	if updated := meta.SetStatusCondition(&nabv.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  "NonAdminBackupVerificationAccepted",
+		Message: "NonAdminBackupVerification accepted",
+	}); updated {
+		if nabv.Status.Phase == nacv1alpha1.NonAdminPhaseBackingOff {
+			updateNonAdminPhase(&nabv.Status.Phase, nacv1alpha1.NonAdminPhaseNew)
+		}
+		if err := r.Status().Update(ctx, nabv); err != nil {
+			logger.Error(err, nonAdminBackupVerificationStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackupVerification Accepted condition set")
+	}
+	return false, nil
+}
+
+// setFinalizerOnNabv adds the finalizer which deletes the temporary namespace with the NonAdminBackupVerification
+func (r *NonAdminBackupVerificationReconciler) setFinalizerOnNabv(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	if controllerutil.ContainsFinalizer(nabv, constant.NabvFinalizerName) {
+		return false, nil
+	}
+	controllerutil.AddFinalizer(nabv, constant.NabvFinalizerName)
+	if err := r.Update(ctx, nabv); err != nil {
+		logger.Error(err, "Failed to add finalizer")
+		return false, err
+	}
+	logger.V(1).Info("Finalizer added to NonAdminBackupVerification", "finalizer", constant.NabvFinalizerName)
+	return false, nil
+}
+
+// startBackupVerification starts a verification of the NonAdminBackup, or of the latest NonAdminBackup of the
+// NonAdminSchedule, if none ran yet, or if spec.interval is set and the next verification time is reached
+func (r *NonAdminBackupVerificationReconciler) startBackupVerification(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	if verification := nabv.Status.Verification; verification != nil {
+		if !verification.CleanedUp || nabv.Spec.Interval == nil ||
+			(nabv.Status.NextVerificationTime != nil && time.Now().Before(nabv.Status.NextVerificationTime.Time)) {
+			return false, nil
+		}
+	}
+
+	nab, err := r.getBackupToVerify(ctx, nabv)
+	if err != nil {
+		if !apierrors.IsNotFound(err) && !errors.Is(err, errNabvNoBackupToVerify) {
+			logger.Error(err, "Unable to get NonAdminBackup to verify")
+			return false, err
+		}
+		if updated := meta.SetStatusCondition(&nabv.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionBackupVerified),
+			Status:  metav1.ConditionUnknown,
+			Reason:  "WaitingForBackup",
+			Message: err.Error(),
+		}); updated {
+			if updateErr := r.Status().Update(ctx, nabv); updateErr != nil {
+				logger.Error(updateErr, nonAdminBackupVerificationStatusUpdateFailureMessage)
+				return false, updateErr
+			}
+		}
+		logger.V(1).Info("Waiting for a NonAdminBackup to verify")
+		return false, nil
+	}
+
+	name := function.GenerateNacObjectUUID(restoreVerificationNamePrefix, nabv.Namespace)
+	nabv.Status.Verification = &nacv1alpha1.BackupVerificationRun{
+		StartTime:       &metav1.Time{Time: time.Now()},
+		BackupName:      nab.Name,
+		Namespace:       name,
+		NonAdminRestore: name,
+	}
+	nabv.Status.NextVerificationTime = nil
+	updateNonAdminPhase(&nabv.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+	meta.SetStatusCondition(&nabv.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminConditionBackupVerified),
+		Status:  metav1.ConditionUnknown,
+		Reason:  "VerificationInProgress",
+		Message: fmt.Sprintf("restoring NonAdminBackup %s into temporary namespace %s", nab.Name, name),
+	})
+	if err = r.Status().Update(ctx, nabv); err != nil {
+		logger.Error(err, nonAdminBackupVerificationStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackup verification started", constant.NameString, nab.Name)
+	return false, nil
+}
+
+// getBackupToVerify returns the NonAdminBackup of spec.backupName, or the NonAdminBackup of the NonAdminSchedule of
+// spec.nonAdminScheduleName whose VeleroBackup completed last, if it is Completed or PartiallyFailed
+func (r *NonAdminBackupVerificationReconciler) getBackupToVerify(ctx context.Context, nabv *nacv1alpha1.NonAdminBackupVerification) (*nacv1alpha1.NonAdminBackup, error) {
+	if nabv.Spec.BackupName != constant.EmptyString {
+		nab := &nacv1alpha1.NonAdminBackup{}
+		if err := r.Get(ctx, types.NamespacedName{Name: nabv.Spec.BackupName, Namespace: nabv.Namespace}, nab); err != nil {
+			return nil, err
+		}
+		if !isVerifiableNonAdminBackup(nab) {
+			return nil, errNabvNoBackupToVerify
+		}
+		return nab, nil
+	}
+
+	nabList := &nacv1alpha1.NonAdminBackupList{}
+	if err := r.List(ctx, nabList, client.InNamespace(nabv.Namespace),
+		client.MatchingLabels{constant.NabScheduleNameLabel: nabv.Spec.NonAdminScheduleName}); err != nil {
+		return nil, err
+	}
+	var latest *nacv1alpha1.NonAdminBackup
+	for index := range nabList.Items {
+		nab := &nabList.Items[index]
+		if !isVerifiableNonAdminBackup(nab) {
+			continue
+		}
+		if latest == nil || latest.Status.VeleroBackup.Status.CompletionTimestamp.Before(nab.Status.VeleroBackup.Status.CompletionTimestamp) {
+			latest = nab
+		}
+	}
+	if latest == nil {
+		return nil, errNabvNoBackupToVerify
+	}
+	return latest, nil
+}
+
+// isVerifiableNonAdminBackup returns true if the NonAdminBackup is Completed or PartiallyFailed, and not being deleted
+func isVerifiableNonAdminBackup(nab *nacv1alpha1.NonAdminBackup) bool {
+	return (nab.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted || nab.Status.Phase == nacv1alpha1.NonAdminPhasePartiallyFailed) &&
+		nab.DeletionTimestamp.IsZero() && !nab.Spec.DeleteBackup &&
+		nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.Status != nil &&
+		nab.Status.VeleroBackup.Status.CompletionTimestamp != nil
+}
+
+// runBackupVerification restores the NonAdminBackup into the temporary namespace, then runs the validation Job,
+// if any, and finishes the verification once they ended
+func (r *NonAdminBackupVerificationReconciler) runBackupVerification(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	verification := nabv.Status.Verification
+	if verification == nil || verification.CleanedUp {
+		return false, nil
+	}
+	if verification.Result != constant.EmptyString {
+		// the result was recorded, but the clean up failed
+		return false, r.cleanUpBackupVerification(ctx, logger, nabv)
+	}
+
+	if err := r.ensureBackupVerificationNamespace(ctx, logger, nabv); err != nil {
+		return false, err
+	}
+
+	nar := &nacv1alpha1.NonAdminRestore{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: nabv.Namespace, Name: verification.NonAdminRestore}, nar)
+	if apierrors.IsNotFound(err) {
+		nar = newRestoreVerificationNonAdminRestore(verification.NonAdminRestore, nabv.Namespace, verification.BackupName, verification.Namespace)
+		if err = controllerutil.SetControllerReference(nabv, nar, r.Scheme); err != nil {
+			return false, err
+		}
+		if err = r.Create(ctx, nar); err != nil {
+			logger.Error(err, "Failed to create backup verification NonAdminRestore", constant.NameString, verification.NonAdminRestore)
+			return false, err
+		}
+		logger.V(1).Info("Backup verification NonAdminRestore created")
+		return false, nil
+	}
+	if err != nil {
+		logger.Error(err, "Failed to get backup verification NonAdminRestore", constant.NameString, verification.NonAdminRestore)
+		return false, err
+	}
+
+	switch nar.Status.Phase {
+	case nacv1alpha1.NonAdminPhaseCompleted:
+	case nacv1alpha1.NonAdminPhasePartiallyFailed, nacv1alpha1.NonAdminPhaseFailed, nacv1alpha1.NonAdminPhaseCanceled:
+		return false, r.finishBackupVerification(ctx, logger, nabv, nacv1alpha1.NonAdminBackupVerificationFailed,
+			fmt.Sprintf("restore into temporary namespace %s ended in phase %s", verification.Namespace, nar.Status.Phase))
+	case nacv1alpha1.NonAdminPhaseBackingOff:
+		message := fmt.Sprintf("restore into temporary namespace %s ended in phase %s", verification.Namespace, nar.Status.Phase)
+		if accepted := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted)); accepted != nil {
+			message += ": " + accepted.Message
+		}
+		return false, r.finishBackupVerification(ctx, logger, nabv, nacv1alpha1.NonAdminBackupVerificationFailed, message)
+	default:
+		logger.V(1).Info("Waiting for backup verification NonAdminRestore to finish", constant.NameString, nar.Name)
+		return false, nil
+	}
+
+	if nabv.Spec.ValidationJob == nil {
+		return false, r.finishBackupVerification(ctx, logger, nabv, nacv1alpha1.NonAdminBackupVerificationPassed,
+			fmt.Sprintf("NonAdminBackup %s was restored into temporary namespace %s", verification.BackupName, verification.Namespace))
+	}
+	return r.runBackupVerificationJob(ctx, logger, nabv)
+}
+
+// ensureBackupVerificationNamespace creates the temporary namespace of the verification, like the one of a NonAdminBackup
+// restore verification, enforcing the restricted Pod Security Standard
+func (r *NonAdminBackupVerificationReconciler) ensureBackupVerificationNamespace(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) error {
+	verification := nabv.Status.Verification
+	namespace := newRestoreVerificationNamespace(verification.Namespace, nabv.Namespace)
+	if err := r.Create(ctx, namespace); err != nil && !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to create backup verification namespace", constant.NameString, verification.Namespace)
+		return err
+	}
+	return nil
+}
+
+// runBackupVerificationJob creates the validation Job in the temporary namespace, run by a dedicated ServiceAccount
+// with the restricted Pod Security Standard defaults, and finishes the verification once the Job succeeded or failed
+func (r *NonAdminBackupVerificationReconciler) runBackupVerificationJob(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	verification := nabv.Status.Verification
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: verification.Namespace, Name: backupVerificationJobName}, job)
+	if apierrors.IsNotFound(err) {
+		created, err := r.ensureBackupVerificationServiceAccount(ctx, logger, nabv)
+		if err != nil {
+			return false, err
+		}
+		if !created {
+			return false, r.finishBackupVerification(ctx, logger, nabv, nacv1alpha1.NonAdminBackupVerificationFailed,
+				fmt.Sprintf("ServiceAccount %s restored into temporary namespace %s conflicts with the validation Job ServiceAccount",
+					backupVerificationServiceAccountName, verification.Namespace))
+		}
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        backupVerificationJobName,
+				Namespace:   verification.Namespace,
+				Labels:      function.GetNonAdminLabels(),
+				Annotations: function.GetNonAdminBackupVerificationAnnotations(nabv.ObjectMeta),
+			},
+			Spec: *nabv.Spec.ValidationJob.DeepCopy(),
+		}
+		job.Labels[constant.NabvOriginNACUUIDLabel] = string(nabv.UID)
+		job.Spec.Template.Spec.ServiceAccountName = backupVerificationServiceAccountName
+		job.Spec.Template.Spec.DeprecatedServiceAccount = constant.EmptyString
+		applyRestrictedPodSecurity(&job.Spec.Template.Spec)
+		if err = r.Create(ctx, job); err != nil {
+			if !apierrors.IsInvalid(err) {
+				logger.Error(err, "Failed to create backup verification Job")
+				return false, err
+			}
+			return false, r.finishBackupVerification(ctx, logger, nabv, nacv1alpha1.NonAdminBackupVerificationFailed,
+				fmt.Sprintf("validation Job is invalid: %v", err))
+		}
+		verification.Job = job.Name
+		meta.SetStatusCondition(&nabv.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionBackupVerified),
+			Status:  metav1.ConditionUnknown,
+			Reason:  "ValidationJobRunning",
+			Message: fmt.Sprintf("running validation Job in temporary namespace %s", verification.Namespace),
+		})
+		if err = r.Status().Update(ctx, nabv); err != nil {
+			logger.Error(err, nonAdminBackupVerificationStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("Backup verification Job created")
+		return false, nil
+	}
+	if err != nil {
+		logger.Error(err, "Failed to get backup verification Job")
+		return false, err
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return false, r.finishBackupVerification(ctx, logger, nabv, nacv1alpha1.NonAdminBackupVerificationPassed,
+				fmt.Sprintf("NonAdminBackup %s was restored into temporary namespace %s and the validation Job succeeded",
+					verification.BackupName, verification.Namespace))
+		case batchv1.JobFailed:
+			message := "validation Job failed"
+			if condition.Message != constant.EmptyString {
+				message += ": " + condition.Message
+			}
+			return false, r.finishBackupVerification(ctx, logger, nabv, nacv1alpha1.NonAdminBackupVerificationFailed, message)
+		}
+	}
+	logger.V(1).Info("Waiting for backup verification Job to finish")
+	return false, nil
+}
+
+// ensureBackupVerificationServiceAccount creates the ServiceAccount of the validation Job in the temporary namespace,
+// without any permission nor mounted token. It returns false if a ServiceAccount of the same name, restored from the
+// backup, was not created by NAC for this NonAdminBackupVerification.
+func (r *NonAdminBackupVerificationReconciler) ensureBackupVerificationServiceAccount(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	verification := nabv.Status.Verification
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupVerificationServiceAccountName,
+			Namespace: verification.Namespace,
+			Labels:    function.GetNonAdminLabels(),
+		},
+		AutomountServiceAccountToken: ptr.To(false),
+	}
+	serviceAccount.Labels[constant.NabvOriginNACUUIDLabel] = string(nabv.UID)
+	err := r.Create(ctx, serviceAccount)
+	if err == nil {
+		logger.V(1).Info("Backup verification ServiceAccount created")
+		return true, nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to create backup verification ServiceAccount")
+		return false, err
+	}
+	if err = r.Get(ctx, types.NamespacedName{Namespace: verification.Namespace, Name: backupVerificationServiceAccountName}, serviceAccount); err != nil {
+		logger.Error(err, "Failed to get backup verification ServiceAccount")
+		return false, err
+	}
+	return serviceAccount.Labels[constant.NabvOriginNACUUIDLabel] == string(nabv.UID), nil
+}
+
+// validateValidationJobPodSpec returns an error if the Pods of the validation Job break the restricted Pod Security
+// Standard enforced in the temporary namespace, by sharing the host namespaces, mounting host paths, using host ports,
+// running privileged or allowing privilege escalation, so the verification does not wait for Pods never admitted
+func validateValidationJobPodSpec(podSpec *corev1.PodSpec) error {
+	if podSpec.HostNetwork || podSpec.HostPID || podSpec.HostIPC {
+		return fmt.Errorf(validationJobRestrictedErr, "host namespaces can not be shared")
+	}
+	for _, volume := range podSpec.Volumes {
+		if volume.HostPath != nil {
+			return fmt.Errorf(validationJobRestrictedErr, fmt.Sprintf("volume %s can not be a hostPath volume", volume.Name))
+		}
+	}
+	for _, container := range slices.Concat(podSpec.InitContainers, podSpec.Containers) {
+		if securityContext := container.SecurityContext; securityContext != nil {
+			if ptr.Deref(securityContext.Privileged, false) {
+				return fmt.Errorf(validationJobRestrictedErr, fmt.Sprintf("container %s can not be privileged", container.Name))
+			}
+			if ptr.Deref(securityContext.AllowPrivilegeEscalation, false) {
+				return fmt.Errorf(validationJobRestrictedErr, fmt.Sprintf("container %s can not allow privilege escalation", container.Name))
+			}
+		}
+		for _, port := range container.Ports {
+			if port.HostPort != 0 {
+				return fmt.Errorf(validationJobRestrictedErr, fmt.Sprintf("container %s can not use host ports", container.Name))
+			}
+		}
+	}
+	return nil
+}
+
+// applyRestrictedPodSecurity sets the security context fields the restricted Pod Security Standard requires, when
+// they are not set by the validation Job: non root user, RuntimeDefault seccomp profile, no privilege escalation and
+// all capabilities dropped
+func applyRestrictedPodSecurity(podSpec *corev1.PodSpec) {
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	if podSpec.SecurityContext.RunAsNonRoot == nil {
+		podSpec.SecurityContext.RunAsNonRoot = ptr.To(true)
+	}
+	if podSpec.SecurityContext.SeccompProfile == nil {
+		podSpec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	}
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for index := range containers {
+			container := &containers[index]
+			if container.SecurityContext == nil {
+				container.SecurityContext = &corev1.SecurityContext{}
+			}
+			if container.SecurityContext.AllowPrivilegeEscalation == nil {
+				container.SecurityContext.AllowPrivilegeEscalation = ptr.To(false)
+			}
+			if container.SecurityContext.Capabilities == nil {
+				container.SecurityContext.Capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+			}
+		}
+	}
+}
+
+// finishBackupVerification records the result of the verification in the NonAdminBackupVerification and the
+// verified NonAdminBackup status, and cleans the verification up
+func (r *NonAdminBackupVerificationReconciler) finishBackupVerification(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification, result nacv1alpha1.NonAdminBackupVerificationResult, message string) error {
+	now := time.Now()
+	verification := nabv.Status.Verification
+	verification.Result = result
+	verification.Message = message
+	verification.CompletionTime = &metav1.Time{Time: now}
+	if nabv.Spec.Interval != nil {
+		nabv.Status.NextVerificationTime = &metav1.Time{Time: now.Add(nabv.Spec.Interval.Duration)}
+	}
+	condition := metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminConditionBackupVerified),
+		Status:  metav1.ConditionTrue,
+		Reason:  "VerificationPassed",
+		Message: message,
+	}
+	if result == nacv1alpha1.NonAdminBackupVerificationFailed {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "VerificationFailed"
+	}
+	meta.SetStatusCondition(&nabv.Status.Conditions, condition)
+	updateNonAdminPhase(&nabv.Status.Phase, nacv1alpha1.NonAdminPhaseCompleted)
+	if err := r.Status().Update(ctx, nabv); err != nil {
+		logger.Error(err, nonAdminBackupVerificationStatusUpdateFailureMessage)
+		return err
+	}
+	logger.V(1).Info("NonAdminBackup verification finished", "result", result)
+
+	nab := &nacv1alpha1.NonAdminBackup{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: nabv.Namespace, Name: verification.BackupName}, nab); err == nil {
+		condition.Message = fmt.Sprintf("NonAdminBackupVerification %s: %s", nabv.Name, message)
+		if meta.SetStatusCondition(&nab.Status.Conditions, condition) {
+			if err = r.Status().Update(ctx, nab); err != nil {
+				logger.Error(err, statusUpdateError)
+				return err
+			}
+		}
+	} else if !apierrors.IsNotFound(err) {
+		logger.Error(err, "Unable to fetch verified NonAdminBackup")
+		return err
+	}
+
+	return r.cleanUpBackupVerification(ctx, logger, nabv)
+}
+
+// cleanUpBackupVerification deletes the NonAdminRestore and the temporary namespace of the finished verification
+func (r *NonAdminBackupVerificationReconciler) cleanUpBackupVerification(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) error {
+	if _, err := r.deleteBackupVerificationObjects(ctx, logger, nabv); err != nil {
+		return err
+	}
+	nabv.Status.Verification.CleanedUp = true
+	if err := r.Status().Update(ctx, nabv); err != nil {
+		logger.Error(err, nonAdminBackupVerificationStatusUpdateFailureMessage)
+		return err
+	}
+	logger.V(1).Info("NonAdminBackup verification cleaned up")
+	return nil
+}
+
+// deleteBackupVerificationObjects deletes the NonAdminRestore and the temporary namespace, with the validation Job,
+// of the verification
+func (r *NonAdminBackupVerificationReconciler) deleteBackupVerificationObjects(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	verification := nabv.Status.Verification
+	if verification == nil || verification.CleanedUp {
+		return false, nil
+	}
+	return false, deleteTemporaryVerificationObjects(ctx, r.Client, logger, nabv.Namespace, verification.NonAdminRestore, verification.Namespace)
+}
+
+// removeNabvFinalizer removes the finalizer of the NonAdminBackupVerification
+func (r *NonAdminBackupVerificationReconciler) removeNabvFinalizer(ctx context.Context, logger logr.Logger, nabv *nacv1alpha1.NonAdminBackupVerification) (bool, error) {
+	if !controllerutil.ContainsFinalizer(nabv, constant.NabvFinalizerName) {
+		return false, nil
+	}
+	controllerutil.RemoveFinalizer(nabv, constant.NabvFinalizerName)
+	if err := r.Update(ctx, nabv); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return false, err
+	}
+	logger.V(1).Info("NonAdminBackupVerification finalizer removed")
+	return false, nil
+}
+
+// nextBackupVerificationRequeueAfter returns when the next verification of the NonAdminBackupVerification starts,
+// zero if none is planned
+func nextBackupVerificationRequeueAfter(nabv *nacv1alpha1.NonAdminBackupVerification, now time.Time) time.Duration {
+	if nabv.Status.NextVerificationTime == nil || nabv.Status.Verification == nil || !nabv.Status.Verification.CleanedUp {
+		return 0
+	}
+	if requeueAfter := nabv.Status.NextVerificationTime.Sub(now); requeueAfter > 0 {
+		return requeueAfter
+	}
+	return 0
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// The validation Jobs are mapped to their NonAdminBackupVerification by their NAC annotations, and the
+// NonAdminBackups finishing to the NonAdminBackupVerifications of their namespace.
+func (r *NonAdminBackupVerificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminBackupVerification{}).
+		Named("nonadminbackupverification").
+		Owns(&nacv1alpha1.NonAdminRestore{}).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(mapToNabv),
+			ctrlbuilder.WithPredicates(ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+				return function.CheckLabelAnnotationValueIsValid(object.GetLabels(), constant.NabvOriginNACUUIDLabel)
+			}))).
+		Watches(&nacv1alpha1.NonAdminBackup{}, handler.EnqueueRequestsFromMapFunc(r.mapToNamespaceNabvs),
+			ctrlbuilder.WithPredicates(ctrlpredicate.Funcs{
+				CreateFunc:  func(event.CreateEvent) bool { return false },
+				DeleteFunc:  func(event.DeleteEvent) bool { return false },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+				UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+					oldNab, okOld := updateEvent.ObjectOld.(*nacv1alpha1.NonAdminBackup)
+					newNab, okNew := updateEvent.ObjectNew.(*nacv1alpha1.NonAdminBackup)
+					return okOld && okNew && !isVerifiableNonAdminBackup(oldNab) && isVerifiableNonAdminBackup(newNab)
+				},
+			})).
+		Complete(r)
+}
+
+// mapToNabv returns the NonAdminBackupVerification a validation Job was created for
+func mapToNabv(_ context.Context, object client.Object) []reconcile.Request {
+	annotations := object.GetAnnotations()
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      annotations[constant.NabvOriginNameAnnotation],
+		Namespace: annotations[constant.NabvOriginNamespaceAnnotation],
+	}}}
+}
+
+// mapToNamespaceNabvs returns the NonAdminBackupVerifications of the namespace of a NonAdminBackup
+func (r *NonAdminBackupVerificationReconciler) mapToNamespaceNabvs(ctx context.Context, object client.Object) []reconcile.Request {
+	nabvList := &nacv1alpha1.NonAdminBackupVerificationList{}
+	if err := r.List(ctx, nabvList, client.InNamespace(object.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to list NonAdminBackupVerifications")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(nabvList.Items))
+	for _, nabv := range nabvList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: nabv.Name, Namespace: nabv.Namespace}})
+	}
+	return requests
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+var _ = ginkgo.Describe("Test NonAdminBackupVerification Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+	const nonAdminBackupName = "test-nab"
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nabv-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nabv-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminBackupVerification{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace},
+			Spec:       nacv1alpha1.NonAdminBackupVerificationSpec{BackupName: nonAdminBackupName},
+		})).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should set the NonAdminBackupVerification BackingOff when restore verification is not allowed", func() {
+		reconciler := &NonAdminBackupVerificationReconciler{Client: k8sClient, Scheme: testEnv.Scheme}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.MatchError(reconcile.TerminalError(nil)))
+
+		nonAdminBackupVerification := &nacv1alpha1.NonAdminBackupVerification{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminBackupVerification)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackupVerification.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+		gomega.Expect(meta.IsStatusConditionFalse(nonAdminBackupVerification.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))).To(gomega.BeTrue())
+		gomega.Expect(nonAdminBackupVerification.Finalizers).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("Should set the NonAdminBackupVerification BackingOff when the requester of the validation Job is not recorded", func() {
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+		nonAdminBackupVerification := &nacv1alpha1.NonAdminBackupVerification{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminBackupVerification)).To(gomega.Succeed())
+		nonAdminBackupVerification.Spec.ValidationJob = &batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				RestartPolicy: corev1.RestartPolicyNever,
+				Containers:    []corev1.Container{{Name: "validate", Image: "registry.example.com/validate"}},
+			}},
+		}
+		gomega.Expect(k8sClient.Update(ctx, nonAdminBackupVerification)).To(gomega.Succeed())
+
+		reconciler := &NonAdminBackupVerificationReconciler{
+			Client:                   k8sClient,
+			Scheme:                   testEnv.Scheme,
+			AllowRestoreVerification: true,
+			AllowValidationJobs:      true,
+		}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.MatchError(reconcile.TerminalError(nil)))
+
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminBackupVerification)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackupVerification.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+		condition := meta.FindStatusCondition(nonAdminBackupVerification.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))
+		gomega.Expect(condition).To(gomega.Not(gomega.BeNil()))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Message).To(gomega.Equal(fmt.Sprintf(constant.NABVRestrictedErr+", requester identity is not recorded", "spec.validationJob")))
+	})
+
+	ginkgo.It("Should run the validation Job with a dedicated ServiceAccount and refuse a restored ServiceAccount of the same name", func() {
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+		nonAdminBackupVerification := &nacv1alpha1.NonAdminBackupVerification{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminBackupVerification)).To(gomega.Succeed())
+		nonAdminBackupVerification.Spec.ValidationJob = &batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				RestartPolicy:      corev1.RestartPolicyNever,
+				ServiceAccountName: "default",
+				Containers:         []corev1.Container{{Name: "validate", Image: "registry.example.com/validate"}},
+			}},
+		}
+		nonAdminBackupVerification.Status.Verification = &nacv1alpha1.BackupVerificationRun{
+			BackupName: nonAdminBackupName,
+			Namespace:  oadpNamespace,
+		}
+		reconciler := &NonAdminBackupVerificationReconciler{Client: k8sClient, Scheme: testEnv.Scheme}
+
+		created, err := reconciler.ensureBackupVerificationServiceAccount(ctx, logr.Discard(), nonAdminBackupVerification)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(created).To(gomega.BeTrue())
+		serviceAccount := &corev1.ServiceAccount{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: backupVerificationServiceAccountName, Namespace: oadpNamespace}, serviceAccount)).To(gomega.Succeed())
+		gomega.Expect(serviceAccount.Labels).To(gomega.HaveKeyWithValue(constant.NabvOriginNACUUIDLabel, string(nonAdminBackupVerification.UID)))
+		gomega.Expect(serviceAccount.AutomountServiceAccountToken).To(gomega.Equal(ptr.To(false)))
+
+		_, err = reconciler.runBackupVerificationJob(ctx, logr.Discard(), nonAdminBackupVerification)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		job := &batchv1.Job{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: backupVerificationJobName, Namespace: oadpNamespace}, job)).To(gomega.Succeed())
+		podSpec := job.Spec.Template.Spec
+		gomega.Expect(podSpec.ServiceAccountName).To(gomega.Equal(backupVerificationServiceAccountName))
+		gomega.Expect(podSpec.SecurityContext.RunAsNonRoot).To(gomega.Equal(ptr.To(true)))
+		gomega.Expect(podSpec.SecurityContext.SeccompProfile.Type).To(gomega.Equal(corev1.SeccompProfileTypeRuntimeDefault))
+		gomega.Expect(podSpec.Containers[0].SecurityContext.AllowPrivilegeEscalation).To(gomega.Equal(ptr.To(false)))
+		gomega.Expect(podSpec.Containers[0].SecurityContext.Capabilities.Drop).To(gomega.Equal([]corev1.Capability{"ALL"}))
+
+		delete(serviceAccount.Labels, constant.NabvOriginNACUUIDLabel)
+		gomega.Expect(k8sClient.Update(ctx, serviceAccount)).To(gomega.Succeed())
+		created, err = reconciler.ensureBackupVerificationServiceAccount(ctx, logr.Discard(), nonAdminBackupVerification)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(created).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("Should restore the NonAdminBackup into a temporary namespace, record the result and clean up", func() {
+		nonAdminBackup := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminBackupName, Namespace: nonAdminObjectNamespace},
+			Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+		}
+		gomega.Expect(k8sClient.Create(ctx, nonAdminBackup)).To(gomega.Succeed())
+		nonAdminBackup.Status = nacv1alpha1.NonAdminBackupStatus{
+			Phase: nacv1alpha1.NonAdminPhaseCompleted,
+			VeleroBackup: &nacv1alpha1.VeleroBackup{
+				Status: &velerov1.BackupStatus{CompletionTimestamp: &metav1.Time{Time: time.Now()}},
+			},
+		}
+		gomega.Expect(k8sClient.Status().Update(ctx, nonAdminBackup)).To(gomega.Succeed())
+
+		reconciler := &NonAdminBackupVerificationReconciler{
+			Client:                   k8sClient,
+			Scheme:                   testEnv.Scheme,
+			AllowRestoreVerification: true,
+		}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		nonAdminBackupVerification := &nacv1alpha1.NonAdminBackupVerification{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminBackupVerification)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackupVerification.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+		gomega.Expect(nonAdminBackupVerification.Finalizers).To(gomega.ContainElement(constant.NabvFinalizerName))
+		verification := nonAdminBackupVerification.Status.Verification
+		gomega.Expect(verification).To(gomega.Not(gomega.BeNil()))
+		gomega.Expect(verification.BackupName).To(gomega.Equal(nonAdminBackupName))
+
+		temporaryNamespace := &corev1.Namespace{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: verification.Namespace}, temporaryNamespace)).To(gomega.Succeed())
+		gomega.Expect(temporaryNamespace.Labels).To(gomega.HaveKeyWithValue(constant.RestoreVerificationNamespaceLabel, nonAdminObjectNamespace))
+
+		nonAdminRestore := &nacv1alpha1.NonAdminRestore{}
+		narKey := types.NamespacedName{Name: verification.NonAdminRestore, Namespace: nonAdminObjectNamespace}
+		gomega.Expect(k8sClient.Get(ctx, narKey, nonAdminRestore)).To(gomega.Succeed())
+		gomega.Expect(nonAdminRestore.Spec.RestoreSpec.BackupName).To(gomega.Equal(nonAdminBackupName))
+		gomega.Expect(nonAdminRestore.Spec.RestoreSpec.NamespaceMapping).To(gomega.Equal(map[string]string{nonAdminObjectNamespace: verification.Namespace}))
+		gomega.Expect(metav1.IsControlledBy(nonAdminRestore, nonAdminBackupVerification)).To(gomega.BeTrue())
+
+		nonAdminRestore.Status.Phase = nacv1alpha1.NonAdminPhaseCompleted
+		gomega.Expect(k8sClient.Status().Update(ctx, nonAdminRestore)).To(gomega.Succeed())
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminBackupVerification)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackupVerification.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCompleted))
+		gomega.Expect(nonAdminBackupVerification.Status.Verification.Result).To(gomega.Equal(nacv1alpha1.NonAdminBackupVerificationPassed))
+		gomega.Expect(nonAdminBackupVerification.Status.Verification.CleanedUp).To(gomega.BeTrue())
+		gomega.Expect(meta.IsStatusConditionTrue(nonAdminBackupVerification.Status.Conditions, string(nacv1alpha1.NonAdminConditionBackupVerified))).To(gomega.BeTrue())
+
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupName, Namespace: nonAdminObjectNamespace}, nonAdminBackup)).To(gomega.Succeed())
+		gomega.Expect(meta.IsStatusConditionTrue(nonAdminBackup.Status.Conditions, string(nacv1alpha1.NonAdminConditionBackupVerified))).To(gomega.BeTrue())
+
+		err = k8sClient.Get(ctx, narKey, nonAdminRestore)
+		gomega.Expect(apierrors.IsNotFound(err) || !nonAdminRestore.DeletionTimestamp.IsZero()).To(gomega.BeTrue())
+	})
+})
+
+var _ = ginkgo.Describe("Test validateValidationJobPodSpec function", func() {
+	ginkgo.DescribeTable("Should reject validation Jobs breaking the restricted Pod Security Standard",
+		func(podSpec corev1.PodSpec, errMessage string) {
+			err := validateValidationJobPodSpec(&podSpec)
+			if errMessage == constant.EmptyString {
+				gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+			} else {
+				gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf(validationJobRestrictedErr, errMessage)))
+			}
+		},
+		ginkgo.Entry("restricted Pods", corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "validate", SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(false)}}},
+		}, constant.EmptyString),
+		ginkgo.Entry("host network", corev1.PodSpec{
+			HostNetwork: true,
+		}, "host namespaces can not be shared"),
+		ginkgo.Entry("hostPath volume", corev1.PodSpec{
+			Volumes: []corev1.Volume{{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}}}},
+		}, "volume host can not be a hostPath volume"),
+		ginkgo.Entry("privileged init container", corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init", SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true)}}},
+		}, "container init can not be privileged"),
+		ginkgo.Entry("privilege escalation", corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "validate", SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(true)}}},
+		}, "container validate can not allow privilege escalation"),
+		ginkgo.Entry("host port", corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "validate", Ports: []corev1.ContainerPort{{ContainerPort: 8080, HostPort: 8080}}}},
+		}, "container validate can not use host ports"),
+	)
+})
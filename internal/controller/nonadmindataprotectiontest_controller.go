@@ -0,0 +1,430 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// Phases of the OADP DataProtectionTest, which the OADP API does not define as constants
+const (
+	dataProtectionTestPhaseComplete = "Complete"
+	dataProtectionTestPhaseFailed   = "Failed"
+	snapshotTestStatusReady         = "Ready"
+)
+
+const nonAdminDataProtectionTestStatusUpdateFailureMessage = "Failed to update NonAdminDataProtectionTest Status"
+
+var errNadptBackupLocationNotCreated = errors.New("NonAdminBackupStorageLocation of the NonAdminDataProtectionTest has no VeleroBackupStorageLocation")
+
+// NonAdminDataProtectionTestReconciler reconciles a NonAdminDataProtectionTest object
+type NonAdminDataProtectionTestReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	OADPNamespace string
+}
+
+type nonAdminDataProtectionTestReconcileStepFunction func(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error)
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmindataprotectiontests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmindataprotectiontests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmindataprotectiontests/finalizers,verbs=update
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=dataprotectiontests,verbs=get;list;watch;create;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state,
+// defined in NonAdminDataProtectionTest object Spec.
+//
+// An OADP DataProtectionTest is created in the OADP namespace for each NonAdminDataProtectionTest, against the
+// VeleroBackupStorageLocation of its NonAdminBackupStorageLocation and the PersistentVolumeClaims of its namespace.
+// Once the OADP operator ran it, its status is copied to the NonAdminDataProtectionTest, which ends as Completed
+// or Failed, and it is deleted. A new NonAdminDataProtectionTest must be created to run the tests again.
+func (r *NonAdminDataProtectionTestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminDataProtectionTest Reconcile start")
+
+	nadpt := &nacv1alpha1.NonAdminDataProtectionTest{}
+	err := r.Get(ctx, req.NamespacedName, nadpt)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminDataProtectionTest")
+		return ctrl.Result{}, err
+	}
+
+	var reconcileSteps []nonAdminDataProtectionTestReconcileStepFunction
+
+	switch {
+	case !nadpt.DeletionTimestamp.IsZero():
+		logger.V(1).Info("Executing direct deletion path")
+		reconcileSteps = []nonAdminDataProtectionTestReconcileStepFunction{
+			r.deleteDataProtectionTest,
+			r.removeNadptFinalizer,
+		}
+	case nadpt.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted || nadpt.Status.Phase == nacv1alpha1.NonAdminPhaseFailed:
+		logger.V(1).Info("NonAdminDataProtectionTest already finished")
+	default:
+		logger.V(1).Info("Executing test path")
+		reconcileSteps = []nonAdminDataProtectionTestReconcileStepFunction{
+			r.initNadpt,
+			r.validateNadptSpec,
+			r.setFinalizerOnNadpt,
+			r.createDataProtectionTest,
+			r.syncDataProtectionTestStatus,
+		}
+	}
+
+	for _, step := range reconcileSteps {
+		requeue, err := step(ctx, logger, nadpt)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if requeue {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	logger.V(1).Info("NonAdminDataProtectionTest Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// initNadpt initializes the Status.Phase from the NonAdminDataProtectionTest.
+func (r *NonAdminDataProtectionTestReconciler) initNadpt(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	if nadpt.Status.Phase != constant.EmptyString {
+		return false, nil
+	}
+	if updated := updateNonAdminPhase(&nadpt.Status.Phase, nacv1alpha1.NonAdminPhaseNew); updated {
+		if err := r.Status().Update(ctx, nadpt); err != nil {
+			logger.Error(err, nonAdminDataProtectionTestStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminDataProtectionTest Phase set to New")
+	}
+	return false, nil
+}
+
+// validateNadptSpec checks the NonAdminBackupStorageLocation of the NonAdminDataProtectionTest is Created.
+// Otherwise the NonAdminDataProtectionTest is BackingOff, and must be created again once it is.
+func (r *NonAdminDataProtectionTestReconciler) validateNadptSpec(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	if nadpt.Status.DataProtectionTest != nil {
+		return false, nil
+	}
+	_, err := r.getVeleroBackupStorageLocationName(ctx, nadpt)
+	if err != nil {
+		if !apierrors.IsNotFound(err) && !errors.Is(err, errNadptBackupLocationNotCreated) {
+			logger.Error(err, "Unable to fetch NonAdminBackupStorageLocation")
+			return false, err
+		}
+		updatedPhase := updateNonAdminPhase(&nadpt.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		updatedCondition := meta.SetStatusCondition(&nadpt.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidNonAdminDataProtectionTestSpec",
+			Message: err.Error(),
+		})
+		if updatedPhase || updatedCondition {
+			if updateErr := r.Status().Update(ctx, nadpt); updateErr != nil {
+				logger.Error(updateErr, nonAdminDataProtectionTestStatusUpdateFailureMessage)
+				return false, updateErr
+			}
+		}
+		return false, reconcile.TerminalError(err)
+	}
+
+	if updated := meta.SetStatusCondition(&nadpt.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  "NonAdminDataProtectionTestAccepted",
+		Message: "NonAdminDataProtectionTest accepted",
+	}); updated {
+		if err := r.Status().Update(ctx, nadpt); err != nil {
+			logger.Error(err, nonAdminDataProtectionTestStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminDataProtectionTest Accepted condition set")
+	}
+	return false, nil
+}
+
+// getVeleroBackupStorageLocationName returns the name of the VeleroBackupStorageLocation of the
+// NonAdminBackupStorageLocation of the NonAdminDataProtectionTest
+func (r *NonAdminDataProtectionTestReconciler) getVeleroBackupStorageLocationName(ctx context.Context, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (string, error) {
+	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nadpt.Spec.BackupLocationName, Namespace: nadpt.Namespace}, nabsl); err != nil {
+		return constant.EmptyString, err
+	}
+	if nabsl.Status.Phase != nacv1alpha1.NonAdminPhaseCreated || nabsl.Status.VeleroBackupStorageLocation == nil ||
+		nabsl.Status.VeleroBackupStorageLocation.Name == constant.EmptyString {
+		return constant.EmptyString, errNadptBackupLocationNotCreated
+	}
+	return nabsl.Status.VeleroBackupStorageLocation.Name, nil
+}
+
+// setFinalizerOnNadpt adds the finalizer which deletes the OADP DataProtectionTest with the NonAdminDataProtectionTest
+func (r *NonAdminDataProtectionTestReconciler) setFinalizerOnNadpt(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	if controllerutil.ContainsFinalizer(nadpt, constant.NadptFinalizerName) {
+		return false, nil
+	}
+	controllerutil.AddFinalizer(nadpt, constant.NadptFinalizerName)
+	if err := r.Update(ctx, nadpt); err != nil {
+		logger.Error(err, "Failed to add finalizer")
+		return false, err
+	}
+	logger.V(1).Info("Finalizer added to NonAdminDataProtectionTest", "finalizer", constant.NadptFinalizerName)
+	return false, nil
+}
+
+// createDataProtectionTest creates the OADP DataProtectionTest of the NonAdminDataProtectionTest in the OADP namespace
+func (r *NonAdminDataProtectionTestReconciler) createDataProtectionTest(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	if nadpt.Status.DataProtectionTest != nil {
+		return false, nil
+	}
+	backupLocationName, err := r.getVeleroBackupStorageLocationName(ctx, nadpt)
+	if err != nil {
+		logger.Error(err, "Unable to fetch NonAdminBackupStorageLocation")
+		return false, err
+	}
+
+	dataProtectionTest := &oadpv1alpha1.DataProtectionTest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nadpt.DataProtectionTestName(),
+			Namespace:   r.OADPNamespace,
+			Labels:      function.GetNonAdminLabels(),
+			Annotations: function.GetNonAdminDataProtectionTestAnnotations(nadpt.ObjectMeta),
+		},
+		Spec: oadpv1alpha1.DataProtectionTestSpec{
+			BackupLocationName:    backupLocationName,
+			UploadSpeedTestConfig: nadpt.Spec.UploadSpeedTestConfig.DeepCopy(),
+		},
+	}
+	dataProtectionTest.Labels[constant.NadptOriginNACUUIDLabel] = string(nadpt.UID)
+	for _, config := range nadpt.Spec.CSIVolumeSnapshotTestConfigs {
+		dataProtectionTest.Spec.CSIVolumeSnapshotTestConfigs = append(dataProtectionTest.Spec.CSIVolumeSnapshotTestConfigs,
+			oadpv1alpha1.CSIVolumeSnapshotTestConfig{
+				SnapshotClassName: config.SnapshotClassName,
+				Timeout:           config.Timeout,
+				VolumeSnapshotSource: oadpv1alpha1.VolumeSnapshotSource{
+					PersistentVolumeClaimName:      config.PersistentVolumeClaimName,
+					PersistentVolumeClaimNamespace: nadpt.Namespace,
+				},
+			})
+	}
+	if err = r.Create(ctx, dataProtectionTest); err != nil && !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to create DataProtectionTest")
+		return false, err
+	}
+
+	nadpt.Status.DataProtectionTest = &nacv1alpha1.OADPDataProtectionTest{
+		Name:      dataProtectionTest.Name,
+		Namespace: dataProtectionTest.Namespace,
+	}
+	updateNonAdminPhase(&nadpt.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+	if err = r.Status().Update(ctx, nadpt); err != nil {
+		logger.Error(err, nonAdminDataProtectionTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("DataProtectionTest created", constant.NameString, dataProtectionTest.Name)
+	return false, nil
+}
+
+// syncDataProtectionTestStatus copies the status of the OADP DataProtectionTest, and the availability of the
+// VeleroBackupStorageLocation, to the NonAdminDataProtectionTest. Once the OADP DataProtectionTest finished,
+// the NonAdminDataProtectionTest phase is set to Completed if every test passed, Failed otherwise, and the
+// OADP DataProtectionTest is deleted.
+func (r *NonAdminDataProtectionTestReconciler) syncDataProtectionTestStatus(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	dataProtectionTest := &oadpv1alpha1.DataProtectionTest{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nadpt.Status.DataProtectionTest.Name, Namespace: r.OADPNamespace}, dataProtectionTest); err != nil {
+		logger.Error(err, "Unable to fetch DataProtectionTest")
+		return false, err
+	}
+	nadpt.Status.DataProtectionTest.Status = dataProtectionTest.Status.DeepCopy()
+
+	backupLocationAvailable, err := r.setBackupLocationAvailableCondition(ctx, nadpt)
+	if err != nil {
+		logger.Error(err, "Unable to fetch NonAdminBackupStorageLocation")
+		return false, err
+	}
+
+	finished := dataProtectionTest.Status.Phase == dataProtectionTestPhaseComplete ||
+		dataProtectionTest.Status.Phase == dataProtectionTestPhaseFailed
+	if finished {
+		message := dataProtectionTestFailure(nadpt, &dataProtectionTest.Status)
+		if message == constant.EmptyString && !backupLocationAvailable {
+			message = "VeleroBackupStorageLocation is not available"
+		}
+		condition := metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminDataProtectionTestConditionTested),
+			Status:  metav1.ConditionTrue,
+			Reason:  "TestsPassed",
+			Message: "Every requested test passed",
+		}
+		phase := nacv1alpha1.NonAdminPhaseCompleted
+		if message != constant.EmptyString {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "TestsFailed"
+			condition.Message = message
+			phase = nacv1alpha1.NonAdminPhaseFailed
+		}
+		meta.SetStatusCondition(&nadpt.Status.Conditions, condition)
+		updateNonAdminPhase(&nadpt.Status.Phase, phase)
+	}
+	if err = r.Status().Update(ctx, nadpt); err != nil {
+		logger.Error(err, nonAdminDataProtectionTestStatusUpdateFailureMessage)
+		return false, err
+	}
+	if !finished {
+		logger.V(1).Info("Waiting for DataProtectionTest to finish", constant.NameString, dataProtectionTest.Name)
+		return false, nil
+	}
+	logger.V(1).Info("NonAdminDataProtectionTest finished", "phase", nadpt.Status.Phase)
+	return r.deleteDataProtectionTest(ctx, logger, nadpt)
+}
+
+// setBackupLocationAvailableCondition sets the BackupLocationAvailable condition from the phase of the
+// VeleroBackupStorageLocation of the NonAdminBackupStorageLocation. It returns false only if Velero
+// found the VeleroBackupStorageLocation unavailable.
+func (r *NonAdminDataProtectionTestReconciler) setBackupLocationAvailableCondition(ctx context.Context, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	nabsl := &nacv1alpha1.NonAdminBackupStorageLocation{}
+	err := r.Get(ctx, types.NamespacedName{Name: nadpt.Spec.BackupLocationName, Namespace: nadpt.Namespace}, nabsl)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+
+	condition := metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminDataProtectionTestConditionBackupLocationAvailable),
+		Status:  metav1.ConditionUnknown,
+		Reason:  "BackupLocationNotValidated",
+		Message: "Velero did not validate the VeleroBackupStorageLocation yet",
+	}
+	if err == nil && nabsl.Status.VeleroBackupStorageLocation != nil && nabsl.Status.VeleroBackupStorageLocation.Status != nil {
+		switch nabsl.Status.VeleroBackupStorageLocation.Status.Phase {
+		case velerov1.BackupStorageLocationPhaseAvailable:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "BackupLocationAvailable"
+			condition.Message = "Velero validated the VeleroBackupStorageLocation"
+		case velerov1.BackupStorageLocationPhaseUnavailable:
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "BackupLocationUnavailable"
+			condition.Message = nabsl.Status.VeleroBackupStorageLocation.Status.Message
+			if condition.Message == constant.EmptyString {
+				condition.Message = "Velero can not access the VeleroBackupStorageLocation"
+			}
+		}
+	}
+	meta.SetStatusCondition(&nadpt.Status.Conditions, condition)
+	return condition.Status != metav1.ConditionFalse, nil
+}
+
+// dataProtectionTestFailure returns why the finished OADP DataProtectionTest failed, or an empty string if every
+// test requested by the NonAdminDataProtectionTest passed
+func dataProtectionTestFailure(nadpt *nacv1alpha1.NonAdminDataProtectionTest, status *oadpv1alpha1.DataProtectionTestStatus) string {
+	if status.Phase == dataProtectionTestPhaseFailed {
+		if status.ErrorMessage != constant.EmptyString {
+			return status.ErrorMessage
+		}
+		return "DataProtectionTest failed"
+	}
+	if nadpt.Spec.UploadSpeedTestConfig != nil && !status.UploadTest.Success {
+		return fmt.Sprintf("upload test failed: %s", status.UploadTest.ErrorMessage)
+	}
+	for _, snapshotTest := range status.SnapshotTests {
+		if snapshotTest.Status != snapshotTestStatusReady {
+			return fmt.Sprintf("snapshot test of PersistentVolumeClaim %s failed: %s", snapshotTest.PersistentVolumeClaimName, snapshotTest.ErrorMessage)
+		}
+	}
+	return constant.EmptyString
+}
+
+// deleteDataProtectionTest deletes the OADP DataProtectionTest of the NonAdminDataProtectionTest
+func (r *NonAdminDataProtectionTestReconciler) deleteDataProtectionTest(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	dataProtectionTest := &oadpv1alpha1.DataProtectionTest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nadpt.DataProtectionTestName(),
+			Namespace: r.OADPNamespace,
+		},
+	}
+	if err := r.Delete(ctx, dataProtectionTest); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		logger.Error(err, "Failed to delete DataProtectionTest")
+		return false, err
+	}
+	logger.V(1).Info("DataProtectionTest deleted", constant.NameString, dataProtectionTest.Name)
+	return false, nil
+}
+
+// removeNadptFinalizer removes the finalizer of the NonAdminDataProtectionTest
+func (r *NonAdminDataProtectionTestReconciler) removeNadptFinalizer(ctx context.Context, logger logr.Logger, nadpt *nacv1alpha1.NonAdminDataProtectionTest) (bool, error) {
+	if !controllerutil.ContainsFinalizer(nadpt, constant.NadptFinalizerName) {
+		return false, nil
+	}
+	controllerutil.RemoveFinalizer(nadpt, constant.NadptFinalizerName)
+	if err := r.Update(ctx, nadpt); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return false, err
+	}
+	logger.V(1).Info("NonAdminDataProtectionTest finalizer removed")
+	return false, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// The OADP DataProtectionTests of the OADP namespace are mapped to their NonAdminDataProtectionTest by their NAC annotations.
+func (r *NonAdminDataProtectionTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminDataProtectionTest{}).
+		Named("nonadmindataprotectiontest").
+		Watches(&oadpv1alpha1.DataProtectionTest{}, handler.EnqueueRequestsFromMapFunc(mapToNadpt),
+			ctrlbuilder.WithPredicates(ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+				return object.GetNamespace() == r.OADPNamespace &&
+					function.CheckLabelAnnotationValueIsValid(object.GetLabels(), constant.NadptOriginNACUUIDLabel)
+			}))).
+		Complete(r)
+}
+
+// mapToNadpt returns the NonAdminDataProtectionTest an OADP DataProtectionTest was created for
+func mapToNadpt(_ context.Context, object client.Object) []reconcile.Request {
+	annotations := object.GetAnnotations()
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      annotations[constant.NadptOriginNameAnnotation],
+		Namespace: annotations[constant.NadptOriginNamespaceAnnotation],
+	}}}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+var _ = ginkgo.Describe("Test NonAdminDataProtectionTest Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+	const (
+		nonAdminBackupStorageLocationName = "test-nabsl"
+		veleroBackupStorageLocationName   = "test-bsl"
+	)
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nadpt-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nadpt-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminDataProtectionTest{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace},
+			Spec: nacv1alpha1.NonAdminDataProtectionTestSpec{
+				BackupLocationName:    nonAdminBackupStorageLocationName,
+				UploadSpeedTestConfig: &oadpv1alpha1.UploadSpeedTestConfig{FileSize: "1MB"},
+				CSIVolumeSnapshotTestConfigs: []nacv1alpha1.NonAdminCSIVolumeSnapshotTestConfig{
+					{SnapshotClassName: "csi-snapclass", PersistentVolumeClaimName: "data"},
+				},
+			},
+		})).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should run the DataProtectionTest against the VeleroBackupStorageLocation and report its results", func() {
+		nonAdminBackupStorageLocation := &nacv1alpha1.NonAdminBackupStorageLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminBackupStorageLocationName, Namespace: nonAdminObjectNamespace},
+			Spec: nacv1alpha1.NonAdminBackupStorageLocationSpec{
+				BackupStorageLocationSpec: &velerov1.BackupStorageLocationSpec{
+					Credential: &corev1.SecretKeySelector{Key: "cloud"},
+					Provider:   "aws",
+					StorageType: velerov1.StorageType{
+						ObjectStorage: &velerov1.ObjectStorageLocation{Bucket: "test", Prefix: "test"},
+					},
+				},
+			},
+		}
+		gomega.Expect(k8sClient.Create(ctx, nonAdminBackupStorageLocation)).To(gomega.Succeed())
+		nonAdminBackupStorageLocation.Status = nacv1alpha1.NonAdminBackupStorageLocationStatus{
+			Phase: nacv1alpha1.NonAdminPhaseCreated,
+			VeleroBackupStorageLocation: &nacv1alpha1.VeleroBackupStorageLocation{
+				Name:      veleroBackupStorageLocationName,
+				Namespace: oadpNamespace,
+				Status:    &velerov1.BackupStorageLocationStatus{Phase: velerov1.BackupStorageLocationPhaseAvailable},
+			},
+		}
+		gomega.Expect(k8sClient.Status().Update(ctx, nonAdminBackupStorageLocation)).To(gomega.Succeed())
+
+		reconciler := &NonAdminDataProtectionTestReconciler{
+			Client:        k8sClient,
+			Scheme:        testEnv.Scheme,
+			OADPNamespace: oadpNamespace,
+		}
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}}
+
+		ginkgo.By("Creating the DataProtectionTest")
+		result, err := reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+		nadpt := &nacv1alpha1.NonAdminDataProtectionTest{}
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nadpt)).To(gomega.Succeed())
+		gomega.Expect(nadpt.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+		gomega.Expect(nadpt.Finalizers).To(gomega.ContainElement(constant.NadptFinalizerName))
+		gomega.Expect(meta.IsStatusConditionTrue(nadpt.Status.Conditions, string(nacv1alpha1.NonAdminDataProtectionTestConditionBackupLocationAvailable))).To(gomega.BeTrue())
+
+		dataProtectionTest := &oadpv1alpha1.DataProtectionTest{}
+		dataProtectionTestKey := types.NamespacedName{Name: nadpt.DataProtectionTestName(), Namespace: oadpNamespace}
+		gomega.Expect(k8sClient.Get(ctx, dataProtectionTestKey, dataProtectionTest)).To(gomega.Succeed())
+		gomega.Expect(dataProtectionTest.Spec.BackupLocationName).To(gomega.Equal(veleroBackupStorageLocationName))
+		gomega.Expect(dataProtectionTest.Spec.UploadSpeedTestConfig.FileSize).To(gomega.Equal("1MB"))
+		gomega.Expect(dataProtectionTest.Spec.CSIVolumeSnapshotTestConfigs).To(gomega.HaveLen(1))
+		gomega.Expect(dataProtectionTest.Spec.CSIVolumeSnapshotTestConfigs[0].VolumeSnapshotSource.PersistentVolumeClaimNamespace).To(gomega.Equal(nonAdminObjectNamespace))
+		gomega.Expect(dataProtectionTest.Labels).To(gomega.HaveKeyWithValue(constant.NadptOriginNACUUIDLabel, string(nadpt.UID)))
+
+		ginkgo.By("Reporting the DataProtectionTest results")
+		dataProtectionTest.Status = oadpv1alpha1.DataProtectionTestStatus{
+			Phase:           dataProtectionTestPhaseComplete,
+			UploadTest:      oadpv1alpha1.UploadTestStatus{Success: true, SpeedMbps: 100},
+			SnapshotTests:   []oadpv1alpha1.SnapshotTestStatus{{PersistentVolumeClaimName: "data", Status: snapshotTestStatusReady}},
+			SnapshotSummary: "1/1 passed",
+		}
+		gomega.Expect(k8sClient.Status().Update(ctx, dataProtectionTest)).To(gomega.Succeed())
+
+		result, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nadpt)).To(gomega.Succeed())
+		gomega.Expect(nadpt.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCompleted))
+		gomega.Expect(meta.IsStatusConditionTrue(nadpt.Status.Conditions, string(nacv1alpha1.NonAdminDataProtectionTestConditionTested))).To(gomega.BeTrue())
+		gomega.Expect(nadpt.Status.DataProtectionTest.Status.UploadTest.SpeedMbps).To(gomega.Equal(int64(100)))
+		gomega.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, dataProtectionTestKey, dataProtectionTest))).To(gomega.BeTrue())
+
+		ginkgo.By("Deleting the NonAdminDataProtectionTest")
+		gomega.Expect(k8sClient.Delete(ctx, nadpt)).To(gomega.Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, request.NamespacedName, nadpt))).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("Should back off when the NonAdminBackupStorageLocation does not exist", func() {
+		reconciler := &NonAdminDataProtectionTestReconciler{
+			Client:        k8sClient,
+			Scheme:        testEnv.Scheme,
+			OADPNamespace: oadpNamespace,
+		}
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}}
+
+		_, err := reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+
+		nadpt := &nacv1alpha1.NonAdminDataProtectionTest{}
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nadpt)).To(gomega.Succeed())
+		gomega.Expect(nadpt.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+		gomega.Expect(meta.IsStatusConditionFalse(nadpt.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))).To(gomega.BeTrue())
+		gomega.Expect(nadpt.Status.DataProtectionTest).To(gomega.BeNil())
+	})
+})
@@ -0,0 +1,248 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const nonAdminDeleteBackupRequestStatusUpdateFailureMessage = "Failed to update NonAdminDeleteBackupRequest Status"
+
+// NonAdminDeleteBackupRequestReconciler reconciles a NonAdminDeleteBackupRequest object
+type NonAdminDeleteBackupRequestReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+type nonAdminDeleteBackupRequestReconcileStepFunction func(ctx context.Context, logger logr.Logger, nadbr *nacv1alpha1.NonAdminDeleteBackupRequest) (bool, error)
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmindeletebackuprequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmindeletebackuprequests/status,verbs=get;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state,
+// defined in NonAdminDeleteBackupRequest object Spec.
+//
+// The NonAdminBackup of spec.backupName is recorded in the status once the NonAdminDeleteBackupRequest is
+// accepted, and NAC sets its spec.deleteBackup, so it is removed with its VeleroBackup and backup data.
+// The NonAdminDeleteBackupRequest is Completed once the NonAdminBackup is removed, and is kept afterwards
+// as the record of who requested the deletion.
+func (r *NonAdminDeleteBackupRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminDeleteBackupRequest Reconcile start")
+
+	nadbr := &nacv1alpha1.NonAdminDeleteBackupRequest{}
+	err := r.Get(ctx, req.NamespacedName, nadbr)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminDeleteBackupRequest")
+		return ctrl.Result{}, err
+	}
+
+	if !nadbr.DeletionTimestamp.IsZero() || nadbr.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted {
+		logger.V(1).Info("NonAdminDeleteBackupRequest is finished")
+		return ctrl.Result{}, nil
+	}
+
+	reconcileSteps := []nonAdminDeleteBackupRequestReconcileStepFunction{
+		r.initNadbr,
+		r.validateNadbrSpec,
+		r.requestNonAdminBackupDeletion,
+	}
+	for _, step := range reconcileSteps {
+		requeue, err := step(ctx, logger, nadbr)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if requeue {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	logger.V(1).Info("NonAdminDeleteBackupRequest Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// initNadbr initializes the Status.Phase from the NonAdminDeleteBackupRequest.
+func (r *NonAdminDeleteBackupRequestReconciler) initNadbr(ctx context.Context, logger logr.Logger, nadbr *nacv1alpha1.NonAdminDeleteBackupRequest) (bool, error) {
+	if nadbr.Status.Phase != constant.EmptyString {
+		return false, nil
+	}
+	if updated := updateNonAdminPhase(&nadbr.Status.Phase, nacv1alpha1.NonAdminPhaseNew); updated {
+		if err := r.Status().Update(ctx, nadbr); err != nil {
+			logger.Error(err, nonAdminDeleteBackupRequestStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminDeleteBackupRequest Phase set to New")
+	}
+	return false, nil
+}
+
+// validateNadbrSpec checks the NonAdminBackup of spec.backupName exists. Otherwise the NonAdminDeleteBackupRequest
+// is BackingOff until its spec is fixed. Once accepted, the NonAdminBackup and the requester are recorded in the
+// status, and later changes to the spec are ignored.
+func (r *NonAdminDeleteBackupRequestReconciler) validateNadbrSpec(ctx context.Context, logger logr.Logger, nadbr *nacv1alpha1.NonAdminDeleteBackupRequest) (bool, error) {
+	if nadbr.Status.Backup != nil {
+		return false, nil
+	}
+
+	nab := &nacv1alpha1.NonAdminBackup{}
+	err := r.Get(ctx, types.NamespacedName{Name: nadbr.Spec.BackupName, Namespace: nadbr.Namespace}, nab)
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Unable to fetch NonAdminBackup")
+		return false, err
+	}
+	if err != nil {
+		validationErr := fmt.Errorf("NonAdminBackup %s not found", nadbr.Spec.BackupName)
+		updatedPhase := updateNonAdminPhase(&nadbr.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		updatedCondition := meta.SetStatusCondition(&nadbr.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidNonAdminDeleteBackupRequestSpec",
+			Message: validationErr.Error(),
+		})
+		if updatedPhase || updatedCondition {
+			if updateErr := r.Status().Update(ctx, nadbr); updateErr != nil {
+				logger.Error(updateErr, nonAdminDeleteBackupRequestStatusUpdateFailureMessage)
+				return false, updateErr
+			}
+		}
+		return false, reconcile.TerminalError(validationErr)
+	}
+
+	nadbr.Status.Backup = &nacv1alpha1.DeleteBackupRequestBackup{
+		Name: nab.Name,
+		UID:  nab.UID,
+	}
+	if nab.Status.VeleroBackup != nil {
+		nadbr.Status.Backup.NACUUID = nab.Status.VeleroBackup.NACUUID
+	}
+	nadbr.Status.RequestedBy = function.GetNonAdminDeleteBackupRequestRequester(nadbr).Username
+	updateNonAdminPhase(&nadbr.Status.Phase, nacv1alpha1.NonAdminPhaseNew)
+	meta.SetStatusCondition(&nadbr.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  "NonAdminDeleteBackupRequestAccepted",
+		Message: "NonAdminBackup accepted for deletion",
+	})
+	if err = r.Status().Update(ctx, nadbr); err != nil {
+		logger.Error(err, nonAdminDeleteBackupRequestStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminDeleteBackupRequest accepted", constant.NameString, nab.Name)
+	return false, nil
+}
+
+// requestNonAdminBackupDeletion sets spec.deleteBackup, confirmed, of the NonAdminBackup accepted for deletion,
+// and reports its deletion stage. The NonAdminDeleteBackupRequest is Completed once the NonAdminBackup is removed.
+func (r *NonAdminDeleteBackupRequestReconciler) requestNonAdminBackupDeletion(ctx context.Context, logger logr.Logger, nadbr *nacv1alpha1.NonAdminDeleteBackupRequest) (bool, error) {
+	nab := &nacv1alpha1.NonAdminBackup{}
+	err := r.Get(ctx, types.NamespacedName{Name: nadbr.Status.Backup.Name, Namespace: nadbr.Namespace}, nab)
+	if err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Unable to fetch NonAdminBackup")
+		return false, err
+	}
+	if apierrors.IsNotFound(err) || nab.UID != nadbr.Status.Backup.UID {
+		updateNonAdminPhase(&nadbr.Status.Phase, nacv1alpha1.NonAdminPhaseCompleted)
+		nadbr.Status.CompletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+		if err = r.Status().Update(ctx, nadbr); err != nil {
+			logger.Error(err, nonAdminDeleteBackupRequestStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminDeleteBackupRequest Completed")
+		return false, nil
+	}
+
+	if !nab.Spec.DeleteBackup || nab.Spec.DeleteBackupConfirmation != nab.Name {
+		original := nab.DeepCopy()
+		nab.Spec.DeleteBackup = true
+		nab.Spec.DeleteBackupConfirmation = nab.Name
+		if err = r.Patch(ctx, nab, client.MergeFrom(original)); err != nil {
+			logger.Error(err, "Failed to set NonAdminBackup spec.deleteBackup")
+			return false, err
+		}
+		logger.V(1).Info("NonAdminBackup spec.deleteBackup set", constant.NameString, nab.Name)
+	}
+
+	updatedPhase := updateNonAdminPhase(&nadbr.Status.Phase, nacv1alpha1.NonAdminPhaseDeleting)
+	updatedStage := nadbr.Status.DeletionStage != nab.Status.DeletionStage
+	nadbr.Status.DeletionStage = nab.Status.DeletionStage
+	if updatedPhase || updatedStage {
+		if err = r.Status().Update(ctx, nadbr); err != nil {
+			logger.Error(err, nonAdminDeleteBackupRequestStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminDeleteBackupRequest status updated", "deletionStage", nadbr.Status.DeletionStage)
+	}
+	return false, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminDeleteBackupRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminDeleteBackupRequest{}, ctrlbuilder.WithPredicates(ctrlpredicate.GenerationChangedPredicate{})).
+		Named("nonadmindeletebackuprequest").
+		Watches(&nacv1alpha1.NonAdminBackup{}, handler.EnqueueRequestsFromMapFunc(r.mapToNadbrs),
+			ctrlbuilder.WithPredicates(ctrlpredicate.Funcs{
+				CreateFunc: func(event.CreateEvent) bool { return false },
+				UpdateFunc: func(evt event.UpdateEvent) bool {
+					oldNab, okOld := evt.ObjectOld.(*nacv1alpha1.NonAdminBackup)
+					newNab, okNew := evt.ObjectNew.(*nacv1alpha1.NonAdminBackup)
+					return okOld && okNew && oldNab.Status.DeletionStage != newNab.Status.DeletionStage
+				},
+				GenericFunc: func(event.GenericEvent) bool { return false },
+			})).
+		Complete(r)
+}
+
+// mapToNadbrs returns the NonAdminDeleteBackupRequests of a NonAdminBackup
+func (r *NonAdminDeleteBackupRequestReconciler) mapToNadbrs(ctx context.Context, object client.Object) []reconcile.Request {
+	deleteBackupRequests := &nacv1alpha1.NonAdminDeleteBackupRequestList{}
+	if err := r.List(ctx, deleteBackupRequests, client.InNamespace(object.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to list NonAdminDeleteBackupRequests")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, deleteBackupRequest := range deleteBackupRequests.Items {
+		if deleteBackupRequest.Spec.BackupName == object.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&deleteBackupRequest)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+var _ = ginkgo.Describe("Test NonAdminDeleteBackupRequest Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+	const nonAdminBackupName = "nab-to-delete"
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nadbr-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nadbr-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminDeleteBackupRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+				// the NonAdminDeleteBackupRequest webhook is not served by the test environment
+				Annotations: function.GetRequesterAnnotations(authenticationv1.UserInfo{
+					Username: "tenant",
+				}, function.NonAdminDeleteBackupRequestRequesterAnnotations),
+			},
+			Spec: nacv1alpha1.NonAdminDeleteBackupRequestSpec{BackupName: nonAdminBackupName},
+		})).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should set the NonAdminDeleteBackupRequest BackingOff when the NonAdminBackup does not exist", func() {
+		reconciler := &NonAdminDeleteBackupRequestReconciler{Client: k8sClient, Scheme: testEnv.Scheme}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.MatchError(reconcile.TerminalError(nil)))
+
+		nonAdminDeleteBackupRequest := &nacv1alpha1.NonAdminDeleteBackupRequest{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminDeleteBackupRequest)).To(gomega.Succeed())
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+		gomega.Expect(meta.IsStatusConditionFalse(nonAdminDeleteBackupRequest.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))).To(gomega.BeTrue())
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.Backup).To(gomega.BeNil())
+	})
+
+	ginkgo.It("Should request the NonAdminBackup deletion and complete once it is removed", func() {
+		nonAdminBackup := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminBackupName, Namespace: nonAdminObjectNamespace},
+			Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+		}
+		gomega.Expect(k8sClient.Create(ctx, nonAdminBackup)).To(gomega.Succeed())
+
+		nonAdminBackupReconciler := &NonAdminBackupReconciler{
+			Client:                     k8sClient,
+			Scheme:                     testEnv.Scheme,
+			OADPNamespace:              oadpNamespace,
+			RequireDeleteBackupRequest: true,
+		}
+		nonAdminBackupKey := types.NamespacedName{Name: nonAdminBackupName, Namespace: nonAdminObjectNamespace}
+		nonAdminBackup.Spec.DeleteBackup = true
+		gomega.Expect(k8sClient.Update(ctx, nonAdminBackup)).To(gomega.Succeed())
+		_, err := nonAdminBackupReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: nonAdminBackupKey})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(k8sClient.Get(ctx, nonAdminBackupKey, nonAdminBackup)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackup.DeletionTimestamp).To(gomega.BeNil())
+		deletingCondition := meta.FindStatusCondition(nonAdminBackup.Status.Conditions, string(nacv1alpha1.NonAdminConditionDeleting))
+		gomega.Expect(deletingCondition).NotTo(gomega.BeNil())
+		gomega.Expect(deletingCondition.Reason).To(gomega.Equal("DeleteBackupRequestRequired"))
+
+		reconciler := &NonAdminDeleteBackupRequestReconciler{Client: k8sClient, Scheme: testEnv.Scheme}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		nonAdminDeleteBackupRequest := &nacv1alpha1.NonAdminDeleteBackupRequest{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminDeleteBackupRequest)).To(gomega.Succeed())
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseDeleting))
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.RequestedBy).To(gomega.Equal("tenant"))
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.Backup).NotTo(gomega.BeNil())
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.Backup.UID).To(gomega.Equal(nonAdminBackup.UID))
+
+		gomega.Expect(k8sClient.Get(ctx, nonAdminBackupKey, nonAdminBackup)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackup.Spec.DeleteBackupConfirmation).To(gomega.Equal(nonAdminBackupName))
+		deleteBackupRequested, err := nonAdminBackupReconciler.isDeleteBackupRequested(ctx, nonAdminBackup)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(deleteBackupRequested).To(gomega.BeTrue())
+
+		gomega.Expect(k8sClient.Delete(ctx, nonAdminBackup)).To(gomega.Succeed())
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminDeleteBackupRequest)).To(gomega.Succeed())
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCompleted))
+		gomega.Expect(nonAdminDeleteBackupRequest.Status.CompletionTimestamp).NotTo(gomega.BeNil())
+	})
+})
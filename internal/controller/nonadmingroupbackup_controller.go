@@ -0,0 +1,522 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
+)
+
+const nonAdminGroupBackupStatusUpdateFailureMessage = "Failed to update NonAdminGroupBackup Status"
+
+// groupBackupMemberDeletedMessage is the message of a NonAdminBackup of a group deleted before it finished
+const groupBackupMemberDeletedMessage = "NonAdminBackup was deleted"
+
+// NonAdminGroupBackupReconciler reconciles a NonAdminGroupBackup object
+type NonAdminGroupBackupReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	OADPNamespace string
+	// AllowGroupBackups lets NonAdminGroupBackups be created
+	AllowGroupBackups bool
+	// AllowMultiNamespaceBackups lets NonAdminGroupBackups use mode Single, like NonAdminBackups
+	// spec.backupSpec.includedNamespaces
+	AllowMultiNamespaceBackups bool
+}
+
+type nonAdminGroupBackupReconcileStepFunction func(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error)
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmingroupbackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmingroupbackups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadmingroupbackups/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state,
+// defined in NonAdminGroupBackup object Spec.
+//
+// The namespaces selected by spec.namespaceSelector are frozen in the status once the NonAdminGroupBackup is
+// accepted, which requires its requester to be allowed to create NonAdminBackups in each of them. NAC then creates
+// the NonAdminBackups of the group and aggregates their phases. Deleting the NonAdminGroupBackup deletes them.
+func (r *NonAdminGroupBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminGroupBackup Reconcile start")
+
+	nagb := &nacv1alpha1.NonAdminGroupBackup{}
+	err := r.Get(ctx, req.NamespacedName, nagb)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminGroupBackup")
+		return ctrl.Result{}, err
+	}
+
+	policyReconciler, err := r.withNonAdminPolicy(ctx, nagb.Namespace)
+	if err != nil {
+		logger.Error(err, "Unable to get NonAdminPolicy of NonAdminGroupBackup namespace")
+		return ctrl.Result{}, err
+	}
+
+	var reconcileSteps []nonAdminGroupBackupReconcileStepFunction
+	if !nagb.DeletionTimestamp.IsZero() {
+		logger.V(1).Info("Executing direct deletion path")
+		reconcileSteps = []nonAdminGroupBackupReconcileStepFunction{
+			policyReconciler.deleteGroupBackupMembers,
+			policyReconciler.removeNagbFinalizer,
+		}
+	} else {
+		logger.V(1).Info("Executing group backup path")
+		reconcileSteps = []nonAdminGroupBackupReconcileStepFunction{
+			policyReconciler.initNagb,
+			policyReconciler.validateNagbSpec,
+			policyReconciler.setFinalizerOnNagb,
+			policyReconciler.createGroupBackupMembers,
+			policyReconciler.syncGroupBackupMembers,
+		}
+	}
+
+	for _, step := range reconcileSteps {
+		requeue, err := step(ctx, logger, nagb)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if requeue {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	logger.V(1).Info("NonAdminGroupBackup Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// withNonAdminPolicy returns a copy of the reconciler allowing the features allowed by the NonAdminControllerConfig,
+// if any, and the NonAdminPolicy of namespace, if any, over the cluster admin NAC flags
+func (r *NonAdminGroupBackupReconciler) withNonAdminPolicy(ctx context.Context, namespace string) (*NonAdminGroupBackupReconciler, error) {
+	configReconciler, err := r.withNonAdminControllerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := function.GetNamespaceNonAdminPolicy(ctx, r.Client, namespace)
+	if err != nil || policy == nil {
+		return configReconciler, err
+	}
+	policyReconciler := *configReconciler
+	if features := policy.Spec.AllowedFeatures; features != nil && features.MultiNamespaceBackups != nil {
+		policyReconciler.AllowMultiNamespaceBackups = *features.MultiNamespaceBackups
+	}
+	return &policyReconciler, nil
+}
+
+// withNonAdminControllerConfig returns a copy of the reconciler with the NonAdminControllerConfig, if any,
+// overriding the cluster admin NAC flags
+func (r *NonAdminGroupBackupReconciler) withNonAdminControllerConfig(ctx context.Context) (*NonAdminGroupBackupReconciler, error) {
+	config, err := function.GetNonAdminControllerConfig(ctx, r.Client)
+	if err != nil || config == nil {
+		return r, err
+	}
+	configReconciler := *r
+	if features := config.Spec.AllowedFeatures; features != nil && features.MultiNamespaceBackups != nil {
+		configReconciler.AllowMultiNamespaceBackups = *features.MultiNamespaceBackups
+	}
+	return &configReconciler, nil
+}
+
+// initNagb initializes the Status.Phase from the NonAdminGroupBackup.
+func (r *NonAdminGroupBackupReconciler) initNagb(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error) {
+	if nagb.Status.Phase != constant.EmptyString {
+		return false, nil
+	}
+	if updated := updateNonAdminPhase(&nagb.Status.Phase, nacv1alpha1.NonAdminPhaseNew); updated {
+		if err := r.Status().Update(ctx, nagb); err != nil {
+			logger.Error(err, nonAdminGroupBackupStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminGroupBackup Phase set to New")
+	}
+	return false, nil
+}
+
+// validateNagbSpec checks the NonAdminGroupBackup is allowed by the cluster admin, and that its requester can create
+// NonAdminBackups in every selected namespace. Otherwise the NonAdminGroupBackup is BackingOff until its spec is
+// fixed. Once accepted, the selected namespaces and the NonAdminBackups of the group are recorded in the status,
+// and later changes to the spec or to the namespace labels are ignored.
+func (r *NonAdminGroupBackupReconciler) validateNagbSpec(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error) {
+	if len(nagb.Status.Backups) > 0 {
+		return false, nil
+	}
+
+	namespaces, err := r.selectGroupBackupNamespaces(ctx, nagb)
+	if err == nil {
+		err = function.CheckRequesterCanGroupBackupNamespaces(ctx, r.Client, nagb, namespaces)
+	}
+	var listErr *groupBackupNamespaceListError
+	if errors.As(err, &listErr) {
+		logger.Error(err, "Unable to list NonAdminGroupBackup namespaces")
+		return false, err
+	}
+	if err != nil {
+		updatedPhase := updateNonAdminPhase(&nagb.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		updatedCondition := meta.SetStatusCondition(&nagb.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  "InvalidNonAdminGroupBackupSpec",
+			Message: err.Error(),
+		})
+		if updatedPhase || updatedCondition {
+			if updateErr := r.Status().Update(ctx, nagb); updateErr != nil {
+				logger.Error(updateErr, nonAdminGroupBackupStatusUpdateFailureMessage)
+				return false, updateErr
+			}
+		}
+		return false, reconcile.TerminalError(err)
+	}
+
+	nagb.Status.Namespaces = namespaces
+	nagb.Status.Backups = planGroupBackupMembers(nagb, namespaces)
+	nagb.Status.StartTimestamp = &metav1.Time{Time: metav1.Now().Time}
+	updateNonAdminPhase(&nagb.Status.Phase, nacv1alpha1.NonAdminPhaseNew)
+	meta.SetStatusCondition(&nagb.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  "NonAdminGroupBackupAccepted",
+		Message: fmt.Sprintf("NonAdminGroupBackup accepted for %d namespaces", len(namespaces)),
+	})
+	if err = r.Status().Update(ctx, nagb); err != nil {
+		logger.Error(err, nonAdminGroupBackupStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminGroupBackup accepted", "namespaces", namespaces)
+	return false, nil
+}
+
+// groupBackupNamespaceListError is returned by selectGroupBackupNamespaces when the namespaces can not be listed,
+// which is retried instead of backing off
+type groupBackupNamespaceListError struct {
+	err error
+}
+
+func (e *groupBackupNamespaceListError) Error() string {
+	return e.err.Error()
+}
+
+// selectGroupBackupNamespaces returns the sorted namespaces selected by the NonAdminGroupBackup, or an error if
+// its spec is not allowed
+func (r *NonAdminGroupBackupReconciler) selectGroupBackupNamespaces(ctx context.Context, nagb *nacv1alpha1.NonAdminGroupBackup) ([]string, error) {
+	if !r.AllowGroupBackups {
+		return nil, fmt.Errorf(constant.NAGBRestrictedErr, "creation")
+	}
+	if nagb.Spec.NamespaceSelector == nil {
+		return nil, errors.New("spec.namespaceSelector must be set")
+	}
+	if nagb.Spec.BackupSpec != nil && len(nagb.Spec.BackupSpec.IncludedNamespaces) > 0 {
+		return nil, fmt.Errorf(constant.NAGBRestrictedErr+", it is set by NAC from spec.namespaceSelector", "spec.backupSpec.includedNamespaces")
+	}
+	if nagb.Spec.BackupSpec != nil && len(nagb.Spec.BackupSpec.ExcludedNamespaces) > 0 {
+		return nil, fmt.Errorf(constant.NAGBRestrictedErr, "spec.backupSpec.excludedNamespaces")
+	}
+	if nagb.Spec.Mode != nacv1alpha1.NonAdminGroupBackupModePerNamespace && !r.AllowMultiNamespaceBackups {
+		return nil, fmt.Errorf(constant.NAGBRestrictedErr+", multi namespace backups are not allowed, use mode %s",
+			"spec.mode "+string(nacv1alpha1.NonAdminGroupBackupModeSingle), nacv1alpha1.NonAdminGroupBackupModePerNamespace)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(nagb.Spec.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("spec.namespaceSelector is invalid: %w", err)
+	}
+	if selector.Empty() {
+		return nil, errors.New("spec.namespaceSelector must not select every namespace")
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err = r.List(ctx, namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, &groupBackupNamespaceListError{err: err}
+	}
+	namespaces := make([]string, 0, len(namespaceList.Items))
+	for _, namespace := range namespaceList.Items {
+		if namespace.Name == r.OADPNamespace {
+			return nil, fmt.Errorf("spec.namespaceSelector can not select the OADP namespace %s", r.OADPNamespace)
+		}
+		namespaces = append(namespaces, namespace.Name)
+	}
+	if len(namespaces) == 0 {
+		return nil, errors.New("spec.namespaceSelector does not select any namespace")
+	}
+	slices.Sort(namespaces)
+	return namespaces, nil
+}
+
+// planGroupBackupMembers returns the NonAdminBackups backing up the namespaces of the NonAdminGroupBackup:
+// a single one in the NonAdminGroupBackup namespace, or one in each namespace with mode PerNamespace
+func planGroupBackupMembers(nagb *nacv1alpha1.NonAdminGroupBackup, namespaces []string) []nacv1alpha1.GroupBackupMember {
+	if nagb.Spec.Mode != nacv1alpha1.NonAdminGroupBackupModePerNamespace {
+		return []nacv1alpha1.GroupBackupMember{{
+			Namespace: nagb.Namespace,
+			Name:      function.GenerateNacObjectUUID(constant.EmptyString, nagb.Name),
+		}}
+	}
+	members := make([]nacv1alpha1.GroupBackupMember, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		members = append(members, nacv1alpha1.GroupBackupMember{
+			Namespace: namespace,
+			Name:      function.GenerateNacObjectUUID(constant.EmptyString, nagb.Name),
+		})
+	}
+	return members
+}
+
+// setFinalizerOnNagb adds the finalizer which deletes the NonAdminBackups of the group with the NonAdminGroupBackup
+func (r *NonAdminGroupBackupReconciler) setFinalizerOnNagb(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error) {
+	if controllerutil.ContainsFinalizer(nagb, constant.NagbFinalizerName) {
+		return false, nil
+	}
+	controllerutil.AddFinalizer(nagb, constant.NagbFinalizerName)
+	if err := r.Update(ctx, nagb); err != nil {
+		logger.Error(err, "Failed to add finalizer")
+		return false, err
+	}
+	logger.V(1).Info("Finalizer added to NonAdminGroupBackup", "finalizer", constant.NagbFinalizerName)
+	return false, nil
+}
+
+// createGroupBackupMembers creates the NonAdminBackups of the group not created yet
+func (r *NonAdminGroupBackupReconciler) createGroupBackupMembers(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error) {
+	updated := false
+	for index := range nagb.Status.Backups {
+		member := &nagb.Status.Backups[index]
+		if member.Phase != constant.EmptyString {
+			continue
+		}
+		nab, err := r.buildGroupBackupMember(nagb, member)
+		if err != nil {
+			return false, err
+		}
+		if err = r.Create(ctx, nab); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create NonAdminBackup of NonAdminGroupBackup", constant.NamespaceString, member.Namespace, constant.NameString, member.Name)
+			return false, err
+		}
+		member.Phase = nacv1alpha1.NonAdminPhaseNew
+		updated = true
+		logger.V(1).Info("NonAdminBackup of NonAdminGroupBackup created", constant.NamespaceString, member.Namespace, constant.NameString, member.Name)
+	}
+	if updated {
+		updateNonAdminPhase(&nagb.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+		if err := r.Status().Update(ctx, nagb); err != nil {
+			logger.Error(err, nonAdminGroupBackupStatusUpdateFailureMessage)
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// buildGroupBackupMember returns the NonAdminBackup of a member of the group, labeled with its NonAdminGroupBackup.
+// With mode Single, it records the NonAdminGroupBackup requester, whose access to the other namespaces the
+// NonAdminBackup controller verifies again.
+func (r *NonAdminGroupBackupReconciler) buildGroupBackupMember(nagb *nacv1alpha1.NonAdminGroupBackup, member *nacv1alpha1.GroupBackupMember) (*nacv1alpha1.NonAdminBackup, error) {
+	backupSpec := &velerov1.BackupSpec{}
+	if nagb.Spec.BackupSpec != nil {
+		backupSpec = nagb.Spec.BackupSpec.DeepCopy()
+	}
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member.Name,
+			Namespace: member.Namespace,
+		},
+		Spec: nacv1alpha1.NonAdminBackupSpec{BackupSpec: backupSpec},
+	}
+	if nagb.Spec.Mode == nacv1alpha1.NonAdminGroupBackupModePerNamespace {
+		backupSpec.IncludedNamespaces = []string{member.Namespace}
+	} else {
+		backupSpec.IncludedNamespaces = slices.Clone(nagb.Status.Namespaces)
+		nab.Annotations = function.GetRequesterAnnotations(function.GetNonAdminGroupBackupRequester(nagb), function.NonAdminBackupRequesterAnnotations)
+	}
+	if err := nacmeta.SetOrigin(nab, nacmeta.Origin{
+		Kind:      nacmeta.KindNonAdminGroupBackup,
+		NACUUID:   string(nagb.UID),
+		Namespace: nagb.Namespace,
+		Name:      nagb.Name,
+	}); err != nil {
+		return nil, err
+	}
+	return nab, nil
+}
+
+// syncGroupBackupMembers records the phases of the NonAdminBackups of the group, and aggregates them in the
+// NonAdminGroupBackup phase
+func (r *NonAdminGroupBackupReconciler) syncGroupBackupMembers(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error) {
+	if len(nagb.Status.Backups) == 0 || nagb.Status.CompletionTimestamp != nil {
+		return false, nil
+	}
+	updated := false
+	for index := range nagb.Status.Backups {
+		member := &nagb.Status.Backups[index]
+		if isGroupBackupMemberFinished(member.Phase) {
+			continue
+		}
+		nab := &nacv1alpha1.NonAdminBackup{}
+		phase, message := member.Phase, member.Message
+		err := r.Get(ctx, types.NamespacedName{Namespace: member.Namespace, Name: member.Name}, nab)
+		switch {
+		case apierrors.IsNotFound(err):
+			phase, message = nacv1alpha1.NonAdminPhaseFailed, groupBackupMemberDeletedMessage
+		case err != nil:
+			logger.Error(err, "Failed to get NonAdminBackup of NonAdminGroupBackup", constant.NamespaceString, member.Namespace, constant.NameString, member.Name)
+			return false, err
+		case nab.Status.Phase != constant.EmptyString:
+			phase, message = nab.Status.Phase, constant.EmptyString
+			if nab.Status.Phase == nacv1alpha1.NonAdminPhaseBackingOff {
+				if accepted := meta.FindStatusCondition(nab.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted)); accepted != nil {
+					message = accepted.Message
+				}
+			}
+		}
+		if phase != member.Phase || message != member.Message {
+			member.Phase, member.Message = phase, message
+			updated = true
+		}
+	}
+
+	phase := aggregateGroupBackupPhase(nagb.Status.Backups)
+	if updateNonAdminPhase(&nagb.Status.Phase, phase) {
+		updated = true
+	}
+	if isGroupBackupMemberFinished(phase) {
+		nagb.Status.CompletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+		updated = true
+	}
+	if updated {
+		if err := r.Status().Update(ctx, nagb); err != nil {
+			logger.Error(err, nonAdminGroupBackupStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminGroupBackup status updated", "phase", nagb.Status.Phase)
+	}
+	return false, nil
+}
+
+// isGroupBackupMemberFinished returns true if phase is a final phase of a NonAdminBackup
+func isGroupBackupMemberFinished(phase nacv1alpha1.NonAdminPhase) bool {
+	return phase == nacv1alpha1.NonAdminPhaseCompleted || phase == nacv1alpha1.NonAdminPhasePartiallyFailed ||
+		phase == nacv1alpha1.NonAdminPhaseFailed || phase == nacv1alpha1.NonAdminPhaseCanceled
+}
+
+// aggregateGroupBackupPhase returns the phase of a NonAdminGroupBackup from the phases of its NonAdminBackups:
+// Created while one of them runs, BackingOff while one of them is BackingOff, then Completed if all of them
+// completed, Failed if all of them failed, and PartiallyFailed otherwise
+func aggregateGroupBackupPhase(members []nacv1alpha1.GroupBackupMember) nacv1alpha1.NonAdminPhase {
+	completed, failed, backingOff := 0, 0, false
+	for _, member := range members {
+		switch member.Phase {
+		case nacv1alpha1.NonAdminPhaseCompleted:
+			completed++
+		case nacv1alpha1.NonAdminPhaseFailed, nacv1alpha1.NonAdminPhaseCanceled:
+			failed++
+		case nacv1alpha1.NonAdminPhasePartiallyFailed:
+		case nacv1alpha1.NonAdminPhaseBackingOff:
+			backingOff = true
+		default:
+			return nacv1alpha1.NonAdminPhaseCreated
+		}
+	}
+	switch {
+	case backingOff:
+		return nacv1alpha1.NonAdminPhaseBackingOff
+	case completed == len(members):
+		return nacv1alpha1.NonAdminPhaseCompleted
+	case failed == len(members):
+		return nacv1alpha1.NonAdminPhaseFailed
+	default:
+		return nacv1alpha1.NonAdminPhasePartiallyFailed
+	}
+}
+
+// deleteGroupBackupMembers deletes the NonAdminBackups of the group
+func (r *NonAdminGroupBackupReconciler) deleteGroupBackupMembers(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error) {
+	for _, member := range nagb.Status.Backups {
+		nab := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      member.Name,
+				Namespace: member.Namespace,
+			},
+		}
+		if err := r.Delete(ctx, nab); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete NonAdminBackup of NonAdminGroupBackup", constant.NamespaceString, member.Namespace, constant.NameString, member.Name)
+			return false, err
+		}
+	}
+	logger.V(1).Info("NonAdminBackups of NonAdminGroupBackup deleted")
+	return false, nil
+}
+
+// removeNagbFinalizer removes the finalizer of the NonAdminGroupBackup
+func (r *NonAdminGroupBackupReconciler) removeNagbFinalizer(ctx context.Context, logger logr.Logger, nagb *nacv1alpha1.NonAdminGroupBackup) (bool, error) {
+	if !controllerutil.ContainsFinalizer(nagb, constant.NagbFinalizerName) {
+		return false, nil
+	}
+	controllerutil.RemoveFinalizer(nagb, constant.NagbFinalizerName)
+	if err := r.Update(ctx, nagb); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return false, err
+	}
+	logger.V(1).Info("NonAdminGroupBackup finalizer removed")
+	return false, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// The NonAdminBackups of a group are mapped to their NonAdminGroupBackup by their NAC annotations.
+func (r *NonAdminGroupBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminGroupBackup{}).
+		Named("nonadmingroupbackup").
+		Watches(&nacv1alpha1.NonAdminBackup{}, handler.EnqueueRequestsFromMapFunc(mapToNagb),
+			ctrlbuilder.WithPredicates(ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+				return function.CheckLabelAnnotationValueIsValid(object.GetLabels(), constant.NagbOriginNACUUIDLabel)
+			}))).
+		Complete(r)
+}
+
+// mapToNagb returns the NonAdminGroupBackup a NonAdminBackup was created for
+func mapToNagb(_ context.Context, object client.Object) []reconcile.Request {
+	annotations := object.GetAnnotations()
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      annotations[constant.NagbOriginNameAnnotation],
+		Namespace: annotations[constant.NagbOriginNamespaceAnnotation],
+	}}}
+}
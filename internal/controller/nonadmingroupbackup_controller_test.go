@@ -0,0 +1,211 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+var _ = ginkgo.Describe("Test NonAdminGroupBackup Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		groupLabelValue         string
+		counter                 = 0
+	)
+	const groupLabel = "app.kubernetes.io/part-of"
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nagb-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nagb-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+		groupLabelValue = fmt.Sprintf("group-%v", counter)
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminGroupBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+				// the NonAdminGroupBackup webhook is not served by the test environment
+				Annotations: function.GetRequesterAnnotations(authenticationv1.UserInfo{
+					Username: "tenant",
+					Groups:   []string{"system:masters"},
+				}, function.NonAdminGroupBackupRequesterAnnotations),
+			},
+			Spec: nacv1alpha1.NonAdminGroupBackupSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{groupLabel: groupLabelValue}},
+				Mode:              nacv1alpha1.NonAdminGroupBackupModePerNamespace,
+			},
+		})).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should set the NonAdminGroupBackup BackingOff when group backups are not allowed", func() {
+		reconciler := &NonAdminGroupBackupReconciler{Client: k8sClient, Scheme: testEnv.Scheme, OADPNamespace: oadpNamespace}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.MatchError(reconcile.TerminalError(nil)))
+
+		nonAdminGroupBackup := &nacv1alpha1.NonAdminGroupBackup{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminGroupBackup)).To(gomega.Succeed())
+		gomega.Expect(nonAdminGroupBackup.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+		gomega.Expect(meta.IsStatusConditionFalse(nonAdminGroupBackup.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))).To(gomega.BeTrue())
+		gomega.Expect(nonAdminGroupBackup.Status.Backups).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("Should create a NonAdminBackup in each selected namespace and aggregate their phases", func() {
+		memberNamespace := nonAdminObjectNamespace + "-member"
+		for _, name := range []string{nonAdminObjectNamespace, memberNamespace} {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			if name == memberNamespace {
+				gomega.Expect(k8sClient.Create(ctx, namespace)).To(gomega.Succeed())
+			} else {
+				gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name}, namespace)).To(gomega.Succeed())
+			}
+			namespace.Labels = map[string]string{groupLabel: groupLabelValue}
+			gomega.Expect(k8sClient.Update(ctx, namespace)).To(gomega.Succeed())
+		}
+
+		reconciler := &NonAdminGroupBackupReconciler{
+			Client:            withRequesterWebhookConfigurations(nacv1alpha1.NonAdminGroupBackups, constant.NagbMutatingWebhookName, constant.NagbValidatingWebhookName),
+			Scheme:            testEnv.Scheme,
+			OADPNamespace:     oadpNamespace,
+			AllowGroupBackups: true,
+		}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		nonAdminGroupBackup := &nacv1alpha1.NonAdminGroupBackup{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminGroupBackup)).To(gomega.Succeed())
+		gomega.Expect(nonAdminGroupBackup.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+		gomega.Expect(nonAdminGroupBackup.Finalizers).To(gomega.ContainElement(constant.NagbFinalizerName))
+		gomega.Expect(nonAdminGroupBackup.Status.Namespaces).To(gomega.Equal([]string{nonAdminObjectNamespace, memberNamespace}))
+		gomega.Expect(nonAdminGroupBackup.Status.Backups).To(gomega.HaveLen(2))
+
+		for index, member := range nonAdminGroupBackup.Status.Backups {
+			gomega.Expect(member.Namespace).To(gomega.Equal(nonAdminGroupBackup.Status.Namespaces[index]))
+			gomega.Expect(member.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseNew))
+
+			nonAdminBackup := &nacv1alpha1.NonAdminBackup{}
+			gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: member.Name, Namespace: member.Namespace}, nonAdminBackup)).To(gomega.Succeed())
+			gomega.Expect(nonAdminBackup.Spec.BackupSpec.IncludedNamespaces).To(gomega.Equal([]string{member.Namespace}))
+			gomega.Expect(nonAdminBackup.Labels).To(gomega.HaveKeyWithValue(constant.NagbOriginNACUUIDLabel, string(nonAdminGroupBackup.UID)))
+			gomega.Expect(mapToNagb(ctx, nonAdminBackup)).To(gomega.Equal([]reconcile.Request{{NamespacedName: key}}))
+
+			nonAdminBackup.Status.Phase = nacv1alpha1.NonAdminPhaseCompleted
+			if member.Namespace == memberNamespace {
+				nonAdminBackup.Status.Phase = nacv1alpha1.NonAdminPhaseFailed
+			}
+			gomega.Expect(k8sClient.Status().Update(ctx, nonAdminBackup)).To(gomega.Succeed())
+		}
+
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminGroupBackup)).To(gomega.Succeed())
+		gomega.Expect(nonAdminGroupBackup.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhasePartiallyFailed))
+		gomega.Expect(nonAdminGroupBackup.Status.CompletionTimestamp).To(gomega.Not(gomega.BeNil()))
+
+		gomega.Expect(k8sClient.Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: memberNamespace}})).To(gomega.Succeed())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminGroupBackup with a NonAdminControllerConfig and a NonAdminPolicy", func() {
+	const (
+		configNamespace = "test-nonadmingroupbackup-config"
+		policyNamespace = "test-nonadmingroupbackup-policy"
+	)
+
+	newReconciler := func(objects ...client.Object) *NonAdminGroupBackupReconciler {
+		return &NonAdminGroupBackupReconciler{
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithObjects(
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: configNamespace}},
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: policyNamespace, Labels: map[string]string{"policy": "true"}}},
+				).
+				WithObjects(objects...).
+				Build(),
+			AllowGroupBackups: true,
+		}
+	}
+	config := &nacv1alpha1.NonAdminControllerConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: constant.NonAdminControllerConfigName},
+		Spec: nacv1alpha1.NonAdminControllerConfigSpec{
+			AllowedFeatures: &nacv1alpha1.NonAdminPolicyFeatures{MultiNamespaceBackups: ptr.To(true)},
+		},
+	}
+	policy := &nacv1alpha1.NonAdminPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nonadmingroupbackup-policy"},
+		Spec: nacv1alpha1.NonAdminPolicySpec{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"policy": "true"}},
+			AllowedFeatures:   &nacv1alpha1.NonAdminPolicyFeatures{MultiNamespaceBackups: ptr.To(false)},
+		},
+	}
+
+	ginkgo.It("should keep the NAC flags without NonAdminControllerConfig nor NonAdminPolicy", func() {
+		policyReconciler, err := newReconciler().withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(policyReconciler.AllowMultiNamespaceBackups).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("should allow the multi namespace backups allowed by the NonAdminControllerConfig", func() {
+		policyReconciler, err := newReconciler(config.DeepCopy(), policy.DeepCopy()).withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(policyReconciler.AllowMultiNamespaceBackups).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should let the NonAdminPolicy of the namespace override the NonAdminControllerConfig", func() {
+		reconciler := newReconciler(config.DeepCopy(), policy.DeepCopy())
+		policyReconciler, err := reconciler.withNonAdminPolicy(context.Background(), policyNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(policyReconciler.AllowMultiNamespaceBackups).To(gomega.BeFalse())
+
+		_, err = policyReconciler.selectGroupBackupNamespaces(context.Background(), &nacv1alpha1.NonAdminGroupBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadmingroupbackup-policy", Namespace: policyNamespace},
+			Spec: nacv1alpha1.NonAdminGroupBackupSpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"policy": "true"}},
+				Mode:              nacv1alpha1.NonAdminGroupBackupModeSingle,
+			},
+		})
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("multi namespace backups are not allowed")))
+	})
+})
@@ -0,0 +1,112 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/notification"
+)
+
+// NonAdminNotificationReconciler reconciles a NonAdminNotification object
+type NonAdminNotificationReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// Notifier posts the notifications, nil restricts NonAdminNotifications
+	Notifier *notification.Notifier
+}
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminnotifications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminnotifications/status,verbs=get;update;patch
+
+// Reconcile validates the spec of a NonAdminNotification. The NonAdminBackup and NonAdminRestore controllers
+// only post notifications to the NonAdminNotifications whose current generation is accepted.
+func (r *NonAdminNotificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminNotification Reconcile start")
+
+	nan := &nacv1alpha1.NonAdminNotification{}
+	if err := r.Get(ctx, req.NamespacedName, nan); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminNotification")
+		return ctrl.Result{}, err
+	}
+
+	validationErr := r.validateSpec(nan)
+	condition := metav1.Condition{
+		Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             "NonAdminNotificationAccepted",
+		Message:            "NonAdminNotification accepted",
+		ObservedGeneration: nan.Generation,
+	}
+	phase := nacv1alpha1.NonAdminPhaseCreated
+	if validationErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "InvalidNonAdminNotificationSpec"
+		condition.Message = validationErr.Error()
+		phase = nacv1alpha1.NonAdminPhaseBackingOff
+	}
+	updatedPhase := updateNonAdminPhase(&nan.Status.Phase, phase)
+	updatedCondition := meta.SetStatusCondition(&nan.Status.Conditions, condition)
+	if updatedPhase || updatedCondition {
+		if err := r.Status().Update(ctx, nan); err != nil {
+			logger.Error(err, statusUpdateError)
+			return ctrl.Result{}, err
+		}
+		logger.V(1).Info("NonAdminNotification status updated", "phase", phase)
+	}
+	if validationErr != nil {
+		return ctrl.Result{}, reconcile.TerminalError(validationErr)
+	}
+
+	logger.V(1).Info("NonAdminNotification Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// validateSpec returns an error if NonAdminNotifications are restricted, or if the webhook URL is not allowed
+func (r *NonAdminNotificationReconciler) validateSpec(nan *nacv1alpha1.NonAdminNotification) error {
+	if r.Notifier == nil {
+		return fmt.Errorf(constant.NANRestrictedErr, "creation")
+	}
+	return r.Notifier.ValidateURL(nan.Spec.URL)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminNotificationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminNotification{}, ctrlbuilder.WithPredicates(ctrlpredicate.GenerationChangedPredicate{})).
+		Named("nonadminnotification").
+		Complete(r)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/notification"
+)
+
+var _ = ginkgo.Describe("Test NonAdminNotification Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nan-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nan-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminNotification{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace},
+			Spec:       nacv1alpha1.NonAdminNotificationSpec{URL: "https://hooks.slack.com/services/T0"},
+		})).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.DescribeTable("Reconcile should validate the NonAdminNotification",
+		func(notifier *notification.Notifier, expectedPhase nacv1alpha1.NonAdminPhase) {
+			reconciler := &NonAdminNotificationReconciler{
+				Client:   k8sClient,
+				Scheme:   testEnv.Scheme,
+				Notifier: notifier,
+			}
+			key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+
+			nonAdminNotification := &nacv1alpha1.NonAdminNotification{}
+			gomega.Expect(k8sClient.Get(ctx, key, nonAdminNotification)).To(gomega.Succeed())
+			gomega.Expect(nonAdminNotification.Status.Phase).To(gomega.Equal(expectedPhase))
+			gomega.Expect(nonAdminNotification.Spec.Format).To(gomega.Equal(nacv1alpha1.NonAdminNotificationFormatGeneric))
+			if expectedPhase == nacv1alpha1.NonAdminPhaseCreated {
+				gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+				gomega.Expect(notification.IsAccepted(nonAdminNotification)).To(gomega.BeTrue())
+			} else {
+				gomega.Expect(err).To(gomega.MatchError(reconcile.TerminalError(nil)))
+				gomega.Expect(meta.IsStatusConditionFalse(nonAdminNotification.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))).To(gomega.BeTrue())
+			}
+		},
+		ginkgo.Entry("When notifications are restricted", nil, nacv1alpha1.NonAdminPhaseBackingOff),
+		ginkgo.Entry("When the webhook host is not allowed",
+			&notification.Notifier{AllowedHosts: []string{"*.webhook.office.com"}}, nacv1alpha1.NonAdminPhaseBackingOff),
+		ginkgo.Entry("When the webhook host is allowed",
+			&notification.Notifier{AllowedHosts: []string{"hooks.slack.com"}}, nacv1alpha1.NonAdminPhaseCreated),
+	)
+})
@@ -0,0 +1,263 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// NonAdminQuotaStatusReconciler reconciles a NonAdminQuotaStatus object
+type NonAdminQuotaStatusReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	OADPNamespace string
+}
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminquotastatuses,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminquotastatuses/status,verbs=get;update;patch
+
+// Reconcile creates the NonAdminQuotaStatus of a namespace a NonAdminPolicy applies to, and updates its status
+// with the quota usage of the namespace. The NonAdminQuotaStatus is deleted once no NonAdminPolicy applies to
+// the namespace anymore.
+func (r *NonAdminQuotaStatusReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminQuotaStatus Reconcile start")
+
+	if req.Namespace == r.OADPNamespace {
+		return ctrl.Result{}, nil
+	}
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch Namespace")
+		return ctrl.Result{}, err
+	}
+	if !namespace.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	quotaStatus := &nacv1alpha1.NonAdminQuotaStatus{}
+	exists := true
+	if err := r.Get(ctx, types.NamespacedName{Name: constant.NonAdminQuotaStatusName, Namespace: req.Namespace}, quotaStatus); err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Unable to fetch NonAdminQuotaStatus")
+			return ctrl.Result{}, err
+		}
+		exists = false
+	}
+
+	policy, err := function.GetNamespaceNonAdminPolicy(ctx, r.Client, req.Namespace)
+	if err != nil {
+		logger.Error(err, "Unable to get NonAdminPolicy of namespace")
+		return ctrl.Result{}, err
+	}
+	if policy == nil {
+		if exists {
+			if err = r.Delete(ctx, quotaStatus); client.IgnoreNotFound(err) != nil {
+				logger.Error(err, "Failed to delete NonAdminQuotaStatus")
+				return ctrl.Result{}, err
+			}
+			logger.V(1).Info("NonAdminQuotaStatus deleted, no NonAdminPolicy applies to namespace")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	status, err := r.getQuotaStatus(ctx, req.Namespace, policy)
+	if err != nil {
+		logger.Error(err, "Unable to compute NonAdminQuotaStatus")
+		return ctrl.Result{}, err
+	}
+
+	if !exists {
+		quotaStatus = &nacv1alpha1.NonAdminQuotaStatus{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      constant.NonAdminQuotaStatusName,
+				Namespace: req.Namespace,
+				Labels:    function.GetNonAdminLabels(),
+			},
+		}
+		if err = r.Create(ctx, quotaStatus); err != nil {
+			logger.Error(err, "Failed to create NonAdminQuotaStatus")
+			return ctrl.Result{}, err
+		}
+		logger.V(1).Info("NonAdminQuotaStatus created")
+	}
+	if !equality.Semantic.DeepEqual(quotaStatus.Status, *status) {
+		quotaStatus.Status = *status
+		if err = r.Status().Update(ctx, quotaStatus); err != nil {
+			logger.Error(err, "Failed to update NonAdminQuotaStatus Status")
+			return ctrl.Result{}, err
+		}
+		logger.V(1).Info("NonAdminQuotaStatus Status updated")
+	}
+
+	logger.V(1).Info("NonAdminQuotaStatus Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// getQuotaStatus returns the quota usage of namespace, with the quotas of policy
+func (r *NonAdminQuotaStatusReconciler) getQuotaStatus(ctx context.Context, namespace string, policy *nacv1alpha1.NonAdminPolicy) (*nacv1alpha1.NonAdminQuotaStatusStatus, error) {
+	nabList := &nacv1alpha1.NonAdminBackupList{}
+	if err := r.List(ctx, nabList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	narList := &nacv1alpha1.NonAdminRestoreList{}
+	if err := r.List(ctx, narList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	nasList := &nacv1alpha1.NonAdminScheduleList{}
+	if err := r.List(ctx, nasList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	nabslList := &nacv1alpha1.NonAdminBackupStorageLocationList{}
+	if err := r.List(ctx, nabslList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var nabs, nars, schedules int32
+	for index := range nabList.Items {
+		if nabList.Items[index].DeletionTimestamp.IsZero() {
+			nabs++
+		}
+	}
+	for index := range narList.Items {
+		if narList.Items[index].DeletionTimestamp.IsZero() {
+			nars++
+		}
+	}
+	for index := range nasList.Items {
+		if nasList.Items[index].DeletionTimestamp.IsZero() {
+			schedules++
+		}
+	}
+	var storedBytes int64
+	for index := range nabslList.Items {
+		if backupSummary := nabslList.Items[index].Status.BackupSummary; backupSummary != nil {
+			storedBytes += backupSummary.TotalBytes
+		}
+	}
+
+	var maxNonAdminBackups, maxNonAdminRestores *int32
+	if policy.Spec.Quotas != nil {
+		maxNonAdminBackups = policy.Spec.Quotas.MaxNonAdminBackups
+		maxNonAdminRestores = policy.Spec.Quotas.MaxNonAdminRestores
+	}
+	return &nacv1alpha1.NonAdminQuotaStatusStatus{
+		NonAdminPolicy:    policy.Name,
+		NonAdminBackups:   getQuotaUsage(nabs, maxNonAdminBackups),
+		NonAdminRestores:  getQuotaUsage(nars, maxNonAdminRestores),
+		NonAdminSchedules: schedules,
+		StoredBytes:       storedBytes,
+	}, nil
+}
+
+// getQuotaUsage returns the usage of a quota of limit objects, used of them existing
+func getQuotaUsage(used int32, limit *int32) nacv1alpha1.NonAdminQuotaUsage {
+	usage := nacv1alpha1.NonAdminQuotaUsage{Used: used}
+	if limit != nil {
+		usage.Limit = ptr.To(*limit)
+		usage.Remaining = ptr.To(max(*limit-used, 0))
+	}
+	return usage
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminQuotaStatusReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// the quota usage only changes when non admin objects are created, start being deleted or are deleted
+	usagePredicate := ctrlpredicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(updateEvent event.UpdateEvent) bool {
+			return updateEvent.ObjectOld.GetDeletionTimestamp().IsZero() != updateEvent.ObjectNew.GetDeletionTimestamp().IsZero()
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+	// the stored bytes change with the backup summary of the NonAdminBackupStorageLocations
+	storedBytesPredicate := usagePredicate
+	storedBytesPredicate.UpdateFunc = func(updateEvent event.UpdateEvent) bool {
+		oldNabsl, okOld := updateEvent.ObjectOld.(*nacv1alpha1.NonAdminBackupStorageLocation)
+		newNabsl, okNew := updateEvent.ObjectNew.(*nacv1alpha1.NonAdminBackupStorageLocation)
+		return usagePredicate.Update(updateEvent) ||
+			(okOld && okNew && !equality.Semantic.DeepEqual(oldNabsl.Status.BackupSummary, newNabsl.Status.BackupSummary))
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminQuotaStatus{}, ctrlbuilder.WithPredicates(ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+			return object.GetName() == constant.NonAdminQuotaStatusName
+		}))).
+		Named("nonadminquotastatus").
+		Watches(&nacv1alpha1.NonAdminBackup{}, handler.EnqueueRequestsFromMapFunc(mapToNonAdminQuotaStatus),
+			ctrlbuilder.WithPredicates(usagePredicate)).
+		Watches(&nacv1alpha1.NonAdminRestore{}, handler.EnqueueRequestsFromMapFunc(mapToNonAdminQuotaStatus),
+			ctrlbuilder.WithPredicates(usagePredicate)).
+		Watches(&nacv1alpha1.NonAdminSchedule{}, handler.EnqueueRequestsFromMapFunc(mapToNonAdminQuotaStatus),
+			ctrlbuilder.WithPredicates(usagePredicate)).
+		Watches(&nacv1alpha1.NonAdminBackupStorageLocation{}, handler.EnqueueRequestsFromMapFunc(mapToNonAdminQuotaStatus),
+			ctrlbuilder.WithPredicates(storedBytesPredicate)).
+		// the NonAdminPolicy applying to a namespace changes with the NonAdminPolicies and the namespace labels
+		Watches(&nacv1alpha1.NonAdminPolicy{}, handler.EnqueueRequestsFromMapFunc(r.mapToAllNonAdminQuotaStatuses)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: constant.NonAdminQuotaStatusName, Namespace: object.GetName()}}}
+		}), ctrlbuilder.WithPredicates(ctrlpredicate.LabelChangedPredicate{})).
+		Complete(r)
+}
+
+// mapToNonAdminQuotaStatus returns the NonAdminQuotaStatus of the namespace of a non admin object
+func mapToNonAdminQuotaStatus(_ context.Context, object client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      constant.NonAdminQuotaStatusName,
+		Namespace: object.GetNamespace(),
+	}}}
+}
+
+// mapToAllNonAdminQuotaStatuses returns the NonAdminQuotaStatus of every namespace
+func (r *NonAdminQuotaStatusReconciler) mapToAllNonAdminQuotaStatuses(ctx context.Context, _ client.Object) []reconcile.Request {
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaceList); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to list Namespaces")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(namespaceList.Items))
+	for _, namespace := range namespaceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      constant.NonAdminQuotaStatusName,
+			Namespace: namespace.Name,
+		}})
+	}
+	return requests
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+var _ = ginkgo.Describe("Test NonAdminQuotaStatus Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectNamespace = fmt.Sprintf("test-naqs-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should publish the quota usage while a NonAdminPolicy applies to the namespace", func() {
+		policy := &nacv1alpha1.NonAdminPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectNamespace},
+			Spec: nacv1alpha1.NonAdminPolicySpec{
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{
+					corev1.LabelMetadataName: nonAdminObjectNamespace,
+				}},
+				Quotas: &nacv1alpha1.NonAdminPolicyQuotas{MaxNonAdminBackups: ptr.To[int32](2)},
+			},
+		}
+		gomega.Expect(k8sClient.Create(ctx, policy)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nab", Namespace: nonAdminObjectNamespace},
+			Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+		})).To(gomega.Succeed())
+
+		reconciler := &NonAdminQuotaStatusReconciler{
+			Client:        k8sClient,
+			Scheme:        testEnv.Scheme,
+			OADPNamespace: oadpNamespace,
+		}
+		key := types.NamespacedName{Name: constant.NonAdminQuotaStatusName, Namespace: nonAdminObjectNamespace}
+
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+		quotaStatus := &nacv1alpha1.NonAdminQuotaStatus{}
+		gomega.Expect(k8sClient.Get(ctx, key, quotaStatus)).To(gomega.Succeed())
+		gomega.Expect(quotaStatus.Status.NonAdminPolicy).To(gomega.Equal(policy.Name))
+		gomega.Expect(quotaStatus.Status.NonAdminBackups).To(gomega.Equal(nacv1alpha1.NonAdminQuotaUsage{
+			Used: 1, Limit: ptr.To[int32](2), Remaining: ptr.To[int32](1),
+		}))
+		gomega.Expect(quotaStatus.Status.NonAdminRestores).To(gomega.Equal(nacv1alpha1.NonAdminQuotaUsage{}))
+
+		gomega.Expect(k8sClient.Delete(ctx, policy)).To(gomega.Succeed())
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, quotaStatus))).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("Should not publish the quota usage when no NonAdminPolicy applies to the namespace", func() {
+		key := types.NamespacedName{Name: constant.NonAdminQuotaStatusName, Namespace: nonAdminObjectNamespace}
+		_, err := (&NonAdminQuotaStatusReconciler{
+			Client:        k8sClient,
+			Scheme:        testEnv.Scheme,
+			OADPNamespace: oadpNamespace,
+		}).Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, key, &nacv1alpha1.NonAdminQuotaStatus{}))).To(gomega.BeTrue())
+	})
+})
@@ -17,20 +17,33 @@ limitations under the License.
 package controller
 
 import (
+	"cmp"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"maps"
+	"net/http"
 	"reflect"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -41,7 +54,11 @@ import (
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
 	"github.com/migtools/oadp-non-admin/internal/common/function"
 	"github.com/migtools/oadp-non-admin/internal/handler"
+	"github.com/migtools/oadp-non-admin/internal/notification"
 	"github.com/migtools/oadp-non-admin/internal/predicate"
+	"github.com/migtools/oadp-non-admin/internal/startup"
+	"github.com/migtools/oadp-non-admin/internal/validationhook"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
 )
 
 // NonAdminRestoreReconciler reconciles a NonAdminRestore object
@@ -49,11 +66,106 @@ type NonAdminRestoreReconciler struct {
 	client.Client
 	Scheme              *runtime.Scheme
 	EnforcedRestoreSpec *velerov1.RestoreSpec
-	OADPNamespace       string
+	// ValidationHook adds the cluster admin site-specific rules to the spec validation, nil disables it
+	ValidationHook *validationhook.Hook
+	// StartupBackpressure staggers the initial reconciles when the controller starts, nil disables it
+	StartupBackpressure *startup.Backpressure
+	// Notifier posts the notifications of the NonAdminRestores completing or failing, nil disables them
+	Notifier      *notification.Notifier
+	OADPNamespace string
+	// RestoreQuotaCheck is the policy applied when restoring the backup volumes would exceed
+	// the namespace ResourceQuotas or LimitRanges, one of constant.RestoreQuotaCheckWarn or
+	// constant.RestoreQuotaCheckFail. Empty disables the check.
+	RestoreQuotaCheck string
+	// FetchRestoreResults summarizes the Velero Restore error messages, failed item operations and restored resources in the
+	// NonAdminRestore status, reading them from the Velero Restore results, item operations and resource list in object storage
+	FetchRestoreResults bool
+	// AllowedExecHookCommands restricts the executables the NonAdminRestore exec hooks may run, empty allows any of them
+	AllowedExecHookCommands []string
+	// AllowedInitHookImages restricts the images, without tag or digest, of the NonAdminRestore init hook
+	// containers, empty allows any of them
+	AllowedInitHookImages []string
+	// AllowNamespaceMapping lets spec.restoreSpec.namespaceMapping map the NonAdminRestore namespace
+	// to another one, if the requester may create NonAdminRestores in it
+	AllowNamespaceMapping bool
+	// RequesterWebhookServed is set when NAC serves the NonAdminRestore webhooks recording the requester. Namespace
+	// mappings allowed by a NonAdminPolicy or the NonAdminControllerConfig without it are rejected.
+	RequesterWebhookServed bool
+	// DisableHooks rejects NonAdminRestores with exec or init hooks
+	DisableHooks bool
+	// RestoreEnforcementConfigMap is the name of a ConfigMap, in the OADP namespace, listing restore spec fields enforced
+	// on the NonAdminRestores of the namespaces selected by a namespace selector, see function.GetSelectorEnforcedRestoreSpec.
+	// Empty only enforces EnforcedRestoreSpec.
+	RestoreEnforcementConfigMap string
+	// DeniedResources may not be restored into any namespace, in addition to the ones listed in the namespace
+	// constant.RestoreDeniedResourcesAnnotation. They are excluded from the Velero Restores, and NonAdminRestores
+	// including them in spec.restoreSpec.includedResources are rejected.
+	DeniedResources []string
+	// MaxParallelFilesDownload is the maximum spec.restoreSpec.uploaderConfig.parallelFilesDownload of a NonAdminRestore,
+	// and the value of NonAdminRestores not setting it. Zero allows any value and sets none by default.
+	MaxParallelFilesDownload int
+	// BlockConcurrentRestores holds the Velero Restore of a NonAdminRestore until the other Velero Restores into its
+	// namespace finished. Otherwise they are only reported in the ConcurrentRestore condition.
+	BlockConcurrentRestores bool
+	// httpClient downloads the Velero Restore results and item operations, nil uses a client with restoreResultsTimeout
+	httpClient *http.Client
+	// maxNonAdminRestores is the quota of the NonAdminPolicy of the reconciled namespace, nil means no quota
+	maxNonAdminRestores *int32
 }
 
 type nonAdminRestoreReconcileStepFunction func(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error)
 
+// volumeSelectorIncludedResources are the resources restored by the Velero Restore of a NonAdminRestore with spec.volumeSelector
+var volumeSelectorIncludedResources = []string{
+	"persistentvolumeclaims",
+	"persistentvolumes",
+	"volumesnapshots.snapshot.storage.k8s.io",
+	"volumesnapshotcontents.snapshot.storage.k8s.io",
+}
+
+// maxRestoreErrorMessages is the maximum number of Velero Restore error messages listed in the NonAdminRestore status
+const maxRestoreErrorMessages = 10
+
+// maxRestoreErrorMessageLength is the length after which a Velero Restore error message listed in the NonAdminRestore status is truncated
+const maxRestoreErrorMessageLength = 256
+
+// restoreResultsTimeout bounds the download of the Velero Restore results
+const restoreResultsTimeout = 30 * time.Second
+
+// itemOperationsDownloadRequestSuffix is appended to the VeleroRestore NACUUID to name the DownloadRequest of its item operations
+const itemOperationsDownloadRequestSuffix = "-itemoperations"
+
+// resourceListDownloadRequestSuffix is appended to the VeleroRestore NACUUID to name the DownloadRequest of its restored resource list
+const resourceListDownloadRequestSuffix = "-resourcelist"
+
+// maxInventorySkippedResources is the maximum number of skipped resources listed in the NonAdminRestore inventory
+const maxInventorySkippedResources = 100
+
+// previewDownloadRequestSuffix is appended to the VeleroRestore NACUUID to name the DownloadRequest of the backup resource list previewed
+const previewDownloadRequestSuffix = "-preview"
+
+// maxPreviewResources is the maximum number of resources listed in the NonAdminRestore preview
+const maxPreviewResources = 1000
+
+// previewNonRestorableResources are never restored by Velero, copied from the Velero restore controller
+var previewNonRestorableResources = []string{
+	"nodes",
+	"events",
+	"events.events.k8s.io",
+	"backups.velero.io",
+	"restores.velero.io",
+	"resticrepositories.velero.io",
+	"csinodes.storage.k8s.io",
+	"volumeattachments.storage.k8s.io",
+	"backuprepositories.velero.io",
+}
+
+// itemOperationPhaseFailed is the phase of the failed Velero item operations
+const itemOperationPhaseFailed = "Failed"
+
+// defaultRestoreRetryBackoff is the time waited before retrying a failed VeleroRestore, when the retry policy does not set it
+const defaultRestoreRetryBackoff = time.Minute
+
 const (
 	nonAdminRestoreStatusUpdateFailureMessage = "Failed to update NonAdminRestore Status"
 	veleroRestoreReferenceUpdated             = "NonAdminRestore - Status Updated with UUID reference"
@@ -65,8 +177,13 @@ const (
 // +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminrestores/finalizers,verbs=update
 
 // +kubebuilder:rbac:groups=velero.io,resources=restores,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=velero.io,resources=podvolumerestores,verbs=get;list;watch
-// +kubebuilder:rbac:groups=velero.io,resources=datadownloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=velero.io,resources=podvolumerestores,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=datadownloads,verbs=get;list;watch;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=downloadrequests,verbs=get;list;watch;create;delete
+
+// +kubebuilder:rbac:groups=core,resources=resourcequotas;limitranges,verbs=get;list;watch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminbackupshares,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state,
@@ -86,6 +203,78 @@ func (r *NonAdminRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
+	policyReconciler, err := r.withNonAdminPolicy(ctx, nar.Namespace)
+	if err != nil {
+		logger.Error(err, "Unable to get NonAdminPolicy of NonAdminRestore namespace")
+		return ctrl.Result{}, err
+	}
+	return policyReconciler.reconcile(ctx, logger, nar)
+}
+
+// withNonAdminPolicy returns a copy of the reconciler enforcing the NonAdminControllerConfig, if any,
+// and the NonAdminPolicy of namespace, if any, instead of the cluster admin NAC flags
+func (r *NonAdminRestoreReconciler) withNonAdminPolicy(ctx context.Context, namespace string) (*NonAdminRestoreReconciler, error) {
+	configReconciler, err := r.withNonAdminControllerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := function.GetNamespaceNonAdminPolicy(ctx, r.Client, namespace)
+	if err != nil || policy == nil {
+		return configReconciler, err
+	}
+	policyReconciler := *configReconciler
+	if policy.Spec.EnforceRestoreSpec != nil {
+		policyReconciler.EnforcedRestoreSpec = policy.Spec.EnforceRestoreSpec
+	}
+	if policy.Spec.Quotas != nil {
+		policyReconciler.maxNonAdminRestores = policy.Spec.Quotas.MaxNonAdminRestores
+	}
+	if features := policy.Spec.AllowedFeatures; features != nil {
+		if features.NamespaceMapping != nil {
+			policyReconciler.AllowNamespaceMapping = *features.NamespaceMapping
+		}
+		if features.RestoreHooks != nil {
+			policyReconciler.DisableHooks = !*features.RestoreHooks
+		}
+	}
+	return &policyReconciler, nil
+}
+
+// withNonAdminControllerConfig returns a copy of the reconciler with the NonAdminControllerConfig, if any,
+// overriding the cluster admin NAC flags
+func (r *NonAdminRestoreReconciler) withNonAdminControllerConfig(ctx context.Context) (*NonAdminRestoreReconciler, error) {
+	config, err := function.GetNonAdminControllerConfig(ctx, r.Client)
+	if err != nil || config == nil {
+		return r, err
+	}
+	configReconciler := *r
+	if restoreConfig := config.Spec.Restore; restoreConfig != nil {
+		if restoreConfig.EnforceRestoreSpec != nil {
+			configReconciler.EnforcedRestoreSpec = restoreConfig.EnforceRestoreSpec
+		}
+		if restoreConfig.MaxParallelFilesDownload != nil {
+			configReconciler.MaxParallelFilesDownload = int(*restoreConfig.MaxParallelFilesDownload)
+		}
+		if restoreConfig.BlockConcurrentRestores != nil {
+			configReconciler.BlockConcurrentRestores = *restoreConfig.BlockConcurrentRestores
+		}
+		if restoreConfig.ResultsErrorSummary != nil {
+			configReconciler.FetchRestoreResults = *restoreConfig.ResultsErrorSummary
+		}
+	}
+	if features := config.Spec.AllowedFeatures; features != nil {
+		if features.NamespaceMapping != nil {
+			configReconciler.AllowNamespaceMapping = *features.NamespaceMapping
+		}
+		if features.RestoreHooks != nil {
+			configReconciler.DisableHooks = !*features.RestoreHooks
+		}
+	}
+	return &configReconciler, nil
+}
+
+// reconcile runs the reconcile steps of the path of the NonAdminRestore
+func (r *NonAdminRestoreReconciler) reconcile(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (ctrl.Result, error) {
 	var reconcileSteps []nonAdminRestoreReconcileStepFunction
 
 	switch {
@@ -93,8 +282,25 @@ func (r *NonAdminRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		logger.V(1).Info("Executing delete path")
 		reconcileSteps = []nonAdminRestoreReconcileStepFunction{
 			r.setStatusAndConditionForDeletion,
+			r.deleteVeleroRestoreDependents,
+			r.deleteResourceModifierConfigMap,
+			r.removeDuplicateVeleroRestores,
 			r.deleteVeleroRestoreAndRemoveFinalizer,
 		}
+	case isNonAdminRestoreCanceled(nar):
+		logger.V(1).Info("Executing cancel path")
+		reconcileSteps = []nonAdminRestoreReconcileStepFunction{
+			r.cancelVeleroRestore,
+		}
+	case nar.Spec.Preview && !meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)):
+		logger.V(1).Info("Executing preview path")
+		reconcileSteps = []nonAdminRestoreReconcileStepFunction{
+			r.init,
+			r.validateSpec,
+			r.setUUID,
+			r.setFinalizer,
+			r.previewVeleroRestore,
+		}
 	default:
 		logger.V(1).Info("Executing creation/update path")
 		reconcileSteps = []nonAdminRestoreReconcileStepFunction{
@@ -102,7 +308,17 @@ func (r *NonAdminRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			r.validateSpec,
 			r.setUUID,
 			r.setFinalizer,
+			r.removeDuplicateVeleroRestores,
+			r.waitForBackupCompletion,
+			r.checkNamespaceQuota,
+			r.syncResourceModifier,
+			r.waitForHigherPriorityRestores,
+			r.checkConcurrentRestores,
 			r.createVeleroRestore,
+			r.fetchRestoreErrorMessages,
+			r.fetchFailedItemOperations,
+			r.fetchRestoredResourceInventory,
+			r.retryFailedVeleroRestore,
 		}
 	}
 
@@ -117,6 +333,9 @@ func (r *NonAdminRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	logger.V(1).Info("NonAdminRestore Reconcile exit")
+	if retryAfter, retries := restoreRetryAfter(nar, time.Now()); retries && nar.DeletionTimestamp.IsZero() {
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -152,36 +371,249 @@ func (r *NonAdminRestoreReconciler) setStatusAndConditionForDeletion(ctx context
 	return false, nil
 }
 
-func (r *NonAdminRestoreReconciler) deleteVeleroRestoreAndRemoveFinalizer(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+// deleteVeleroRestoreDependents deletes the objects created in the OADP namespace for the VeleroRestore of the
+// NonAdminRestore: its PodVolumeRestores and DataDownloads, running DataDownloads being cancelled first, and the
+// DownloadRequests of its results, item operations and preview. The NonAdminRestore is requeued until they are removed, so its finalizer is
+// only removed afterwards.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore being deleted
+//
+// Returns:
+//   - bool: whether to requeue, true while dependent objects remain
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) deleteVeleroRestoreDependents(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
 	if nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.NACUUID == constant.EmptyString {
 		return false, nil
 	}
+	listOptions := &client.ListOptions{
+		Namespace:     r.OADPNamespace,
+		LabelSelector: labels.SelectorFromSet(labels.Set{velerov1.RestoreNameLabel: label.GetValidName(nar.Status.VeleroRestore.Name)}),
+	}
 
-	veleroRestoreNACUUID := nar.Status.VeleroRestore.NACUUID
-
-	veleroRestore, err := function.GetVeleroRestoreByLabel(ctx, r.Client, r.OADPNamespace, veleroRestoreNACUUID)
+	dataDownloads := &velerov2alpha1.DataDownloadList{}
+	if err := r.List(ctx, dataDownloads, listOptions); err != nil {
+		logger.Error(err, "Failed to list DataDownloads in OADP namespace")
+		return false, err
+	}
+	for index := range dataDownloads.Items {
+		dataDownload := &dataDownloads.Items[index]
+		if !dataDownload.Spec.Cancel && !isDataDownloadFinished(dataDownload.Status.Phase) {
+			original := dataDownload.DeepCopy()
+			dataDownload.Spec.Cancel = true
+			if err := r.Patch(ctx, dataDownload, client.MergeFrom(original)); err != nil && !apierrors.IsNotFound(err) {
+				logger.Error(err, "Failed to cancel DataDownload", constant.NameString, dataDownload.Name)
+				return false, err
+			}
+		}
+		if err := r.Delete(ctx, dataDownload); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete DataDownload", constant.NameString, dataDownload.Name)
+			return false, err
+		}
+	}
 
-	if err != nil {
-		// Case in which more then one VeleroRestore is found with the same label NACUUID
-		logger.Error(err, findSingleVRError, constant.UUIDString, veleroRestoreNACUUID)
+	podVolumeRestores := &velerov1.PodVolumeRestoreList{}
+	if err := r.List(ctx, podVolumeRestores, listOptions); err != nil {
+		logger.Error(err, "Failed to list PodVolumeRestores in OADP namespace")
 		return false, err
 	}
+	for index := range podVolumeRestores.Items {
+		if err := r.Delete(ctx, &podVolumeRestores.Items[index]); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete PodVolumeRestore", constant.NameString, podVolumeRestores.Items[index].Name)
+			return false, err
+		}
+	}
 
-	if veleroRestore != nil {
-		// All the data within VeleroRestore is stored in object storage, so veleroRestore deletion is not blocking
-		// and it will get removed by the Velero cleanup process when the restore object gets deleted
-		// https://github.com/vmware-tanzu/velero/blob/074f26539d3eb06c7b1a6af9b4975254e61b956c/pkg/cmd/cli/restore/delete.go#L122
-		if err = r.Delete(ctx, veleroRestore); err != nil {
-			logger.Error(err, "Failed to delete VeleroRestore", constant.NameString, veleroRestore.Name)
+	for _, name := range []string{
+		nar.Status.VeleroRestore.NACUUID,
+		nar.Status.VeleroRestore.NACUUID + itemOperationsDownloadRequestSuffix,
+		nar.Status.VeleroRestore.NACUUID + resourceListDownloadRequestSuffix,
+		nar.Status.VeleroRestore.NACUUID + previewDownloadRequestSuffix,
+	} {
+		downloadRequest := &velerov1.DownloadRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: r.OADPNamespace,
+			},
+		}
+		if err := r.Delete(ctx, downloadRequest); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete Velero Restore DownloadRequest", constant.NameString, name)
+			return false, err
+		}
+	}
+
+	if remaining := len(dataDownloads.Items) + len(podVolumeRestores.Items); remaining > 0 {
+		logger.V(1).Info("Waiting for the VeleroRestore dependent objects removal", "count", remaining)
+		return true, nil
+	}
+	return false, nil
+}
+
+// isNonAdminRestoreCanceled returns true if the NonAdminRestore was canceled with spec.cancel, even if it was unset afterwards
+func isNonAdminRestoreCanceled(nar *nacv1alpha1.NonAdminRestore) bool {
+	return nar.Spec.Cancel || nar.Status.Phase == nacv1alpha1.NonAdminPhaseCanceled
+}
+
+// cancelVeleroRestore cancels the running DataDownloads of the VeleroRestore of the NonAdminRestore, if it was
+// created, and sets the NonAdminRestore phase to Canceled. The VeleroRestore is not created, nor retried, afterwards.
+// A NonAdminRestore whose VeleroRestore already finished keeps its phase.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore being canceled
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) cancelVeleroRestore(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	switch nar.Status.Phase {
+	case nacv1alpha1.NonAdminPhaseCompleted, nacv1alpha1.NonAdminPhasePartiallyFailed, nacv1alpha1.NonAdminPhaseFailed:
+		// the outcome of the finished VeleroRestore is kept, it is only not retried anymore
+		logger.V(1).Info("NonAdminRestore VeleroRestore already finished, nothing to cancel")
+		return false, nil
+	}
+	message := "restore canceled before its Velero Restore was created"
+	if meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+		message = "restore canceled, the data downloads of its Velero Restore were cancelled"
+		dataDownloads := &velerov2alpha1.DataDownloadList{}
+		if err := r.List(ctx, dataDownloads, &client.ListOptions{
+			Namespace:     r.OADPNamespace,
+			LabelSelector: labels.SelectorFromSet(labels.Set{velerov1.RestoreNameLabel: label.GetValidName(nar.Status.VeleroRestore.Name)}),
+		}); err != nil {
+			logger.Error(err, "Failed to list DataDownloads in OADP namespace")
+			return false, err
+		}
+		cancelled := 0
+		for index := range dataDownloads.Items {
+			dataDownload := &dataDownloads.Items[index]
+			if dataDownload.Spec.Cancel || isDataDownloadFinished(dataDownload.Status.Phase) {
+				continue
+			}
+			original := dataDownload.DeepCopy()
+			dataDownload.Spec.Cancel = true
+			if err := r.Patch(ctx, dataDownload, client.MergeFrom(original)); err != nil {
+				logger.Error(err, "Failed to cancel DataDownload", constant.NameString, dataDownload.Name)
+				return false, err
+			}
+			cancelled++
+		}
+		if cancelled > 0 {
+			logger.Info("Cancelled DataDownloads of canceled NonAdminRestore VeleroRestore", "count", cancelled)
+		}
+	}
+
+	updatedPhase := updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseCanceled)
+	updatedCondition := meta.SetStatusCondition(&nar.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionCanceled),
+			Status:  metav1.ConditionTrue,
+			Reason:  "RestoreCanceled",
+			Message: message,
+		},
+	)
+	if updatedPhase || updatedCondition {
+		if err := r.Status().Update(ctx, nar); err != nil {
+			logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
 			return false, err
 		}
-		logger.V(1).Info("VeleroRestore deletion initiated", constant.NameString, veleroRestore.Name)
+		logger.V(1).Info("NonAdminRestore phase set to Canceled")
+	}
+	return false, nil
+}
+
+// isDataDownloadFinished returns true if Velero does not process the DataDownload anymore
+func isDataDownloadFinished(phase velerov2alpha1.DataDownloadPhase) bool {
+	return phase == velerov2alpha1.DataDownloadPhaseCompleted ||
+		phase == velerov2alpha1.DataDownloadPhaseCanceled ||
+		phase == velerov2alpha1.DataDownloadPhaseFailed
+}
+
+// removeDuplicateVeleroRestores keeps the oldest of the Velero Restores labeled with the NACUUID of the NonAdminRestore,
+// and deletes the others, so the NonAdminRestore follows a single Velero Restore.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore whose duplicate Velero Restores are deleted
+//
+// Returns:
+//   - bool: whether to requeue, true until the duplicate Velero Restores are gone
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) removeDuplicateVeleroRestores(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+
+	veleroRestores := &velerov1.RestoreList{}
+	if err := function.ListObjectsByLabel(ctx, r.Client, r.OADPNamespace, constant.NarOriginNACUUIDLabel, nar.Status.VeleroRestore.NACUUID, veleroRestores); err != nil {
+		logger.Error(err, "Failed to list VeleroRestores of NonAdminRestore", constant.UUIDString, nar.Status.VeleroRestore.NACUUID)
+		return false, err
+	}
+	if len(veleroRestores.Items) < 2 {
 		return false, nil
 	}
 
+	// the Velero Restores being deleted are never kept
+	deleting := func(veleroRestore velerov1.Restore) int {
+		if veleroRestore.DeletionTimestamp.IsZero() {
+			return 0
+		}
+		return 1
+	}
+	slices.SortFunc(veleroRestores.Items, func(a, b velerov1.Restore) int {
+		return cmp.Or(
+			cmp.Compare(deleting(a), deleting(b)),
+			a.CreationTimestamp.Compare(b.CreationTimestamp.Time),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	for index := range veleroRestores.Items[1:] {
+		duplicate := &veleroRestores.Items[index+1]
+		if !duplicate.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, duplicate); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete duplicate VeleroRestore", constant.NameString, duplicate.Name)
+			return false, err
+		}
+		logger.Info("Duplicate VeleroRestore deleted", constant.NameString, duplicate.Name, "kept", veleroRestores.Items[0].Name)
+	}
+	return true, nil
+}
+
+func (r *NonAdminRestoreReconciler) deleteVeleroRestoreAndRemoveFinalizer(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if nar.Status.VeleroRestore != nil && nar.Status.VeleroRestore.NACUUID != constant.EmptyString {
+		veleroRestoreNACUUID := nar.Status.VeleroRestore.NACUUID
+
+		veleroRestore, err := function.GetVeleroRestoreByLabel(ctx, r.Client, r.OADPNamespace, veleroRestoreNACUUID)
+
+		if err != nil {
+			// Case in which more then one VeleroRestore is found with the same label NACUUID
+			logger.Error(err, findSingleVRError, constant.UUIDString, veleroRestoreNACUUID)
+			return false, err
+		}
+
+		if veleroRestore != nil {
+			// All the data within VeleroRestore is stored in object storage, so veleroRestore deletion is not blocking
+			// and it will get removed by the Velero cleanup process when the restore object gets deleted
+			// https://github.com/vmware-tanzu/velero/blob/074f26539d3eb06c7b1a6af9b4975254e61b956c/pkg/cmd/cli/restore/delete.go#L122
+			if err = r.Delete(ctx, veleroRestore); err != nil {
+				logger.Error(err, "Failed to delete VeleroRestore", constant.NameString, veleroRestore.Name)
+				return false, err
+			}
+			logger.V(1).Info("VeleroRestore deletion initiated", constant.NameString, veleroRestore.Name)
+			return false, nil
+		}
+	}
+
 	logger.V(1).Info("VeleroRestore deleted, removing NonAdminRestore finalizer")
 
-	controllerutil.RemoveFinalizer(nar, constant.NarFinalizerName)
+	if !controllerutil.RemoveFinalizer(nar, constant.NarFinalizerName) {
+		return false, nil
+	}
 
 	if err := r.Update(ctx, nar); err != nil {
 		logger.Error(err, "Failed to remove finalizer from NonAdminRestore")
@@ -209,14 +641,89 @@ func (r *NonAdminRestoreReconciler) init(ctx context.Context, logger logr.Logger
 }
 
 func (r *NonAdminRestoreReconciler) validateSpec(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
-	err := function.ValidateRestoreSpec(ctx, r.Client, nar, r.EnforcedRestoreSpec)
+	if acceptedGeneration, changed := nonAdminRestoreSpecChanged(nar); changed {
+		// the Velero Restore keeps running, its status is still reported
+		updated := meta.SetStatusCondition(&nar.Status.Conditions,
+			metav1.Condition{
+				Type:   string(nacv1alpha1.NonAdminConditionAccepted),
+				Status: metav1.ConditionFalse,
+				Reason: "SpecChangedAfterRestoreCreated",
+				Message: fmt.Sprintf("NonAdminRestore spec can not be changed after its Velero Restore was created, "+
+					"the Velero Restore runs the spec of generation %d", acceptedGeneration),
+				ObservedGeneration: acceptedGeneration,
+			},
+		)
+		if updated {
+			if err := r.Status().Update(ctx, nar); err != nil {
+				logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+				return false, err
+			}
+			logger.V(1).Info("NonAdminRestore spec changed after its VeleroRestore was created")
+		}
+		return false, nil
+	}
+
+	enforcedRestoreSpec, err := r.selectorEnforcedRestoreSpec(ctx, nar)
+	if err != nil {
+		logger.Error(err, "Failed to get enforced restore spec of NonAdminRestore namespace")
+		return false, err
+	}
+	err = r.validateRequesterWebhookServed(ctx, nar)
+	if err == nil {
+		err = function.ValidateRestoreSpec(ctx, r.Client, r.OADPNamespace, nar, enforcedRestoreSpec, r.AllowNamespaceMapping)
+	}
+	if err == nil {
+		err = r.validateParallelFilesDownload(nar)
+	}
+	if err == nil && r.maxNonAdminRestores != nil && nar.Status.VeleroRestore == nil {
+		narList := &nacv1alpha1.NonAdminRestoreList{}
+		if listErr := r.List(ctx, narList, client.InNamespace(nar.Namespace)); listErr != nil {
+			logger.Error(listErr, "Unable to list NonAdminRestores of NonAdminRestore namespace")
+			return false, listErr
+		}
+		nars := make([]metav1.Object, 0, len(narList.Items))
+		for index := range narList.Items {
+			nars = append(nars, &narList.Items[index])
+		}
+		err = function.ValidateNonAdminQuota(nacv1alpha1.NonAdminRestores, nar, nars, r.maxNonAdminRestores)
+	}
+	if err == nil {
+		err = function.ValidateRestoreVolumeSelector(nar)
+	}
+	if err == nil {
+		err = function.ValidateRestoreHooks(nar.Spec.RestoreSpec, r.DisableHooks, r.AllowedExecHookCommands, r.AllowedInitHookImages)
+	}
+	if err == nil {
+		deniedResources, deniedErr := function.GetRestoreDeniedResources(ctx, r.Client, restoreTargetNamespace(nar), r.DeniedResources)
+		if deniedErr != nil {
+			logger.Error(deniedErr, "Failed to get resources denied in NonAdminRestore target namespace")
+			return false, deniedErr
+		}
+		err = function.ValidateRestoreResourceFilters(nar.Spec.RestoreSpec, deniedResources, restoreTargetNamespace(nar))
+	}
+	if err == nil {
+		err = r.ValidationHook.Validate(ctx, nacv1alpha1.NonAdminRestores, nar, nar.Status.Conditions)
+		if err != nil && !validationhook.IsRejected(err) {
+			logger.Error(err, "Unable to validate NonAdminRestore with the validation hook")
+			return false, err
+		}
+	}
 	if err != nil {
+		reason := "InvalidRestoreSpec"
+		switch {
+		case errors.Is(err, function.ErrNamespaceMappingRejected):
+			reason = "NamespaceMappingRejected"
+		case errors.Is(err, function.ErrResourceFilterRejected):
+			reason = "ResourceFilterRejected"
+		case errors.Is(err, function.ErrNonAdminQuotaExceeded):
+			reason = "QuotaExceeded"
+		}
 		updatedPhase := updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
 		updatedCondition := meta.SetStatusCondition(&nar.Status.Conditions,
 			metav1.Condition{
 				Type:    string(nacv1alpha1.NonAdminConditionAccepted),
 				Status:  metav1.ConditionFalse,
-				Reason:  "InvalidRestoreSpec",
+				Reason:  reason,
 				Message: err.Error(),
 			},
 		)
@@ -232,10 +739,11 @@ func (r *NonAdminRestoreReconciler) validateSpec(ctx context.Context, logger log
 
 	updated := meta.SetStatusCondition(&nar.Status.Conditions,
 		metav1.Condition{
-			Type:    string(nacv1alpha1.NonAdminConditionAccepted),
-			Status:  metav1.ConditionTrue,
-			Reason:  "RestoreAccepted",
-			Message: "restore accepted",
+			Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             "RestoreAccepted",
+			Message:            "restore accepted",
+			ObservedGeneration: nar.Generation,
 		},
 	)
 	if updated {
@@ -289,69 +797,483 @@ func (r *NonAdminRestoreReconciler) setFinalizer(ctx context.Context, logger log
 	return false, nil
 }
 
-func (r *NonAdminRestoreReconciler) createVeleroRestore(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
-	if nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.NACUUID == constant.EmptyString {
-		return false, errors.New("unable to get Velero Restore UUID from NonAdminRestore Status")
+// restoreTargetNamespace returns the namespace the NonAdminRestore namespace is restored to,
+// which spec.restoreSpec.namespaceMapping may change
+func restoreTargetNamespace(nar *nacv1alpha1.NonAdminRestore) string {
+	if target, ok := nar.Spec.RestoreSpec.NamespaceMapping[nar.Namespace]; ok {
+		return target
 	}
+	return nar.Namespace
+}
 
-	veleroRestoreNACUUID := nar.Status.VeleroRestore.NACUUID
+// getVeleroBackupToRestore returns the name of the Velero Backup restored by the NonAdminRestore, empty if its
+// NonAdminBackup has none yet, and the backed up namespace restored into the NonAdminRestore namespace.
+// spec.restoreSpec.backupName is a NonAdminBackup, a NonAdminBackup of spec.backupNamespace shared with the
+// NonAdminRestore namespace, see function.GetSharedNonAdminBackup, or a Velero Backup restorable without one, see
+// function.GetRestorableVeleroBackup.
+func (r *NonAdminRestoreReconciler) getVeleroBackupToRestore(ctx context.Context, nar *nacv1alpha1.NonAdminRestore) (string, string, error) {
+	if function.IsSharedNonAdminBackupRestore(nar) {
+		sharedNab, err := function.GetSharedNonAdminBackup(ctx, r.Client, nar)
+		if err != nil {
+			return constant.EmptyString, constant.EmptyString, err
+		}
+		if sharedNab.Status.VeleroBackup == nil {
+			return constant.EmptyString, sharedNab.Namespace, nil
+		}
+		return sharedNab.Status.VeleroBackup.Name, sharedNab.Namespace, nil
+	}
+	nab := &nacv1alpha1.NonAdminBackup{}
+	err := r.Get(ctx, types.NamespacedName{Name: nar.Spec.RestoreSpec.BackupName, Namespace: nar.Namespace}, nab)
+	if err == nil {
+		if nab.Status.VeleroBackup == nil {
+			return constant.EmptyString, nar.Namespace, nil
+		}
+		return nab.Status.VeleroBackup.Name, nar.Namespace, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return constant.EmptyString, constant.EmptyString, err
+	}
+	veleroBackup, restorableErr := function.GetRestorableVeleroBackup(ctx, r.Client, r.OADPNamespace, nar.Namespace, nar.Spec.RestoreSpec.BackupName)
+	if restorableErr != nil {
+		return constant.EmptyString, constant.EmptyString, restorableErr
+	}
+	return veleroBackup.Name, function.GetVeleroBackupSourceNamespace(veleroBackup, nar.Namespace), nil
+}
 
-	veleroRestore, err := function.GetVeleroRestoreByLabel(ctx, r.Client, r.OADPNamespace, veleroRestoreNACUUID)
+// waitForBackupCompletion holds the NonAdminRestore with spec.waitForBackupCompletion in the Pending phase, until
+// the NonAdminBackup it restores is completed, so its Velero Restore is not created from a running Velero Backup.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore waiting for its NonAdminBackup
+//
+// Returns:
+//   - bool: whether to requeue, true while the NonAdminBackup is not completed
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) waitForBackupCompletion(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	// a shared NonAdminBackup is validated to be completed
+	if !nar.Spec.WaitForBackupCompletion || function.IsSharedNonAdminBackupRestore(nar) ||
+		meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+		return false, nil
+	}
 
-	if err != nil {
-		// Case in which more then one VeleroBackup is found with the same label UUID
-		logger.Error(err, findSingleVRError, constant.UUIDString, veleroRestoreNACUUID)
+	nab := &nacv1alpha1.NonAdminBackup{}
+	err := r.Get(ctx, types.NamespacedName{Name: nar.Spec.RestoreSpec.BackupName, Namespace: nar.Namespace}, nab)
+	if apierrors.IsNotFound(err) {
+		// a shared Velero Backup is validated to be completed
+		return false, nil
+	} else if err != nil {
+		logger.Error(err, "Failed to get NonAdminBackup referenced by NonAdminRestore")
 		return false, err
 	}
+	if nab.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted || nab.Status.Phase == nacv1alpha1.NonAdminPhasePartiallyFailed {
+		return false, nil
+	}
 
-	if veleroRestore == nil {
-		if meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
-			err = errors.New("NonAdminRestore is finalized and its associated Velero Restore has been removed. Please create a new NonAdminRestore to initiate a new Restore")
-			logger.Error(err, "related Velero Restore not found")
-			updatedPhase := updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
-			updatedCondition := meta.SetStatusCondition(&nar.Status.Conditions,
-				metav1.Condition{
-					// TODO create new condition?
-					Type:    string(nacv1alpha1.NonAdminConditionAccepted),
-					Status:  metav1.ConditionFalse,
-					Reason:  "VeleroRestoreNotFound",
-					Message: err.Error(),
-				},
-			)
-			if updatedPhase || updatedCondition {
-				if updateErr := r.Status().Update(ctx, nar); updateErr != nil {
-					logger.Error(updateErr, nonAdminRestoreStatusUpdateFailureMessage)
-					return false, updateErr
-				}
-			}
-			return false, reconcile.TerminalError(err)
-		}
-		logger.Info("VeleroRestore with label not found, creating one", constant.UUIDString, veleroRestoreNACUUID)
-		nab := &nacv1alpha1.NonAdminBackup{}
-		err = r.Get(ctx, types.NamespacedName{Name: nar.Spec.RestoreSpec.BackupName, Namespace: nar.Namespace}, nab)
-		if err != nil {
-			logger.Error(err, "Failed to get NonAdminBackup referenced by NonAdminRestore")
+	updatedPhase := updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhasePending)
+	updatedCondition := meta.SetStatusCondition(&nar.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionQueued),
+			Status:  metav1.ConditionFalse,
+			Reason:  "WaitingForBackupCompletion",
+			Message: fmt.Sprintf("waiting for NonAdminBackup %s to complete", nab.Name),
+		},
+	)
+	if updatedPhase || updatedCondition {
+		if err = r.Status().Update(ctx, nar); err != nil {
+			logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
 			return false, err
 		}
+	}
+	logger.V(1).Info("Waiting for NonAdminBackup to complete", constant.NameString, nab.Name)
+	return true, nil
+}
 
-		restoreSpec := nar.Spec.RestoreSpec.DeepCopy()
-		restoreSpec.BackupName = nab.Status.VeleroBackup.Name
-		restoreSpec.IncludedNamespaces = []string{nar.Namespace}
+// checkNamespaceQuota verifies, before the Velero Restore is created, that restoring the backup
+// volumes fits in the ResourceQuotas and LimitRanges of the namespace the backup is restored to.
+// If it does not, the QuotaWouldBeExceeded condition is set, and with the Fail policy
+// the NonAdminRestore is moved to the BackingOff phase.
+func (r *NonAdminRestoreReconciler) checkNamespaceQuota(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if r.RestoreQuotaCheck == constant.EmptyString ||
+		meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+		return false, nil
+	}
 
-		enforcedSpec := reflect.ValueOf(r.EnforcedRestoreSpec).Elem()
-		for index := range enforcedSpec.NumField() {
-			enforcedField := enforcedSpec.Field(index)
-			enforcedFieldName := enforcedSpec.Type().Field(index).Name
-			currentField := reflect.ValueOf(restoreSpec).Elem().FieldByName(enforcedFieldName)
-			if !enforcedField.IsZero() && currentField.IsZero() {
-				currentField.Set(enforcedField)
-			}
-		}
+	veleroBackupName, _, err := r.getVeleroBackupToRestore(ctx, nar)
+	if err != nil {
+		logger.Error(err, "Failed to get backup referenced by NonAdminRestore")
+		return false, err
+	}
+	if veleroBackupName == constant.EmptyString {
+		return false, nil
+	}
 
-		restoreSpec.ExcludedResources = append(restoreSpec.ExcludedResources,
-			"volumesnapshotclasses")
+	exceeded, err := function.CheckNamespaceQuotaForRestore(ctx, r.Client, r.OADPNamespace, restoreTargetNamespace(nar), veleroBackupName)
+	if err != nil {
+		logger.Error(err, "Failed to check namespace quota for NonAdminRestore")
+		return false, err
+	}
 
-		veleroRestore = &velerov1.Restore{
+	if exceeded == constant.EmptyString {
+		updated := meta.SetStatusCondition(&nar.Status.Conditions,
+			metav1.Condition{
+				Type:    string(nacv1alpha1.NonAdminConditionQuotaWouldBeExceeded),
+				Status:  metav1.ConditionFalse,
+				Reason:  "QuotaSufficient",
+				Message: "restored volumes fit in the namespace quota",
+			},
+		)
+		if updated {
+			if err := r.Status().Update(ctx, nar); err != nil {
+				logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+				return false, err
+			}
+		}
+		return false, nil
+	}
+
+	updatedPhase := false
+	if r.RestoreQuotaCheck == constant.RestoreQuotaCheckFail {
+		updatedPhase = updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+	}
+	updatedCondition := meta.SetStatusCondition(&nar.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionQuotaWouldBeExceeded),
+			Status:  metav1.ConditionTrue,
+			Reason:  "QuotaWouldBeExceeded",
+			Message: exceeded,
+		},
+	)
+	if updatedPhase || updatedCondition {
+		if err := r.Status().Update(ctx, nar); err != nil {
+			logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminRestore condition set to QuotaWouldBeExceeded")
+	}
+
+	if r.RestoreQuotaCheck == constant.RestoreQuotaCheckFail {
+		return false, reconcile.TerminalError(errors.New("restore would exceed the namespace quota: " + exceeded))
+	}
+	return false, nil
+}
+
+// selectorEnforcedRestoreSpec returns the spec enforced by the admin user on the NonAdminRestore: EnforcedRestoreSpec,
+// or the override of the NonAdminRestore namespace, with the fields set by the RestoreEnforcementConfigMap rules
+func (r *NonAdminRestoreReconciler) selectorEnforcedRestoreSpec(ctx context.Context, nar *nacv1alpha1.NonAdminRestore) (*velerov1.RestoreSpec, error) {
+	enforcedRestoreSpec, err := function.GetOverriddenEnforcedRestoreSpec(ctx, r.Client, r.OADPNamespace, nar.Namespace, r.EnforcedRestoreSpec)
+	if err != nil {
+		return nil, err
+	}
+	return function.GetSelectorEnforcedRestoreSpec(ctx, r.Client, r.OADPNamespace, r.RestoreEnforcementConfigMap, nar.Namespace, enforcedRestoreSpec)
+}
+
+// copiesResourceModifier returns true if the resource modifiers ConfigMap referenced by the NonAdminRestore is
+// copied to the OADP namespace, which is not the case when the admin user enforces the resource modifiers
+func (r *NonAdminRestoreReconciler) copiesResourceModifier(nar *nacv1alpha1.NonAdminRestore) bool {
+	return nar.Spec.RestoreSpec.ResourceModifier != nil && r.EnforcedRestoreSpec.ResourceModifier == nil
+}
+
+// syncResourceModifier copies the resource modifiers ConfigMap referenced by the NonAdminRestore from its namespace
+// to the OADP namespace, where Velero reads it, and keeps the copy in sync until the VeleroRestore completes,
+// when the copy is deleted. The copy is named after the VeleroRestore NACUUID.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore referencing the resource modifiers ConfigMap
+//
+// Returns:
+//   - bool: whether to requeue (always false)
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) syncResourceModifier(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if !r.copiesResourceModifier(nar) || nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+	if nar.Status.VeleroRestore.Status != nil && nar.Status.VeleroRestore.Status.CompletionTimestamp != nil {
+		// Velero does not read the resource modifiers anymore
+		return r.deleteResourceModifierConfigMap(ctx, logger, nar)
+	}
+
+	sourceResourceModifier := &corev1.ConfigMap{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      nar.Spec.RestoreSpec.ResourceModifier.Name,
+		Namespace: nar.Namespace,
+	}, sourceResourceModifier); err != nil {
+		logger.Error(err, "Failed to get resource modifiers ConfigMap", constant.NameString, nar.Spec.RestoreSpec.ResourceModifier.Name)
+		return false, err
+	}
+	// the ConfigMap may have changed since validateSpec, its copy must be valid as well
+	if err := function.ValidateResourceModifiers(sourceResourceModifier, function.GetResourceModifierNamespaces(nar)); err != nil {
+		logger.Error(err, "Invalid resource modifiers ConfigMap", constant.NameString, sourceResourceModifier.Name)
+		return false, reconcile.TerminalError(err)
+	}
+
+	veleroRestoreNACUUID := nar.Status.VeleroRestore.NACUUID
+	resourceModifier := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      veleroRestoreNACUUID,
+			Namespace: r.OADPNamespace,
+		},
+	}
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, resourceModifier, func() error {
+		resourceModifier.Labels = function.GetNonAdminLabels()
+		resourceModifier.Labels[constant.NarOriginNACUUIDLabel] = veleroRestoreNACUUID
+		resourceModifier.Annotations = function.GetNonAdminRestoreAnnotations(nar.ObjectMeta)
+		resourceModifier.Data = sourceResourceModifier.Data
+		resourceModifier.BinaryData = sourceResourceModifier.BinaryData
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "Failed to sync resource modifiers ConfigMap to the OADP namespace")
+		return false, err
+	}
+	if op != controllerutil.OperationResultNone {
+		logger.V(1).Info("Resource modifiers ConfigMap synced to the OADP namespace", "operation", op)
+	}
+	return false, nil
+}
+
+// deleteResourceModifierConfigMap deletes the copy of the NonAdminRestore resource modifiers ConfigMap from the OADP namespace
+func (r *NonAdminRestoreReconciler) deleteResourceModifierConfigMap(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+	resourceModifier := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nar.Status.VeleroRestore.NACUUID,
+			Namespace: r.OADPNamespace,
+		},
+	}
+	if err := r.Delete(ctx, resourceModifier); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		logger.Error(err, "Failed to delete resource modifiers ConfigMap")
+		return false, err
+	}
+	logger.V(1).Info("Resource modifiers ConfigMap deleted")
+	return false, nil
+}
+
+// waitForHigherPriorityRestores holds the creation of the VeleroRestore of the NonAdminRestore while the VeleroRestores
+// of NonAdminRestores of namespaces with a higher priority, set by the admin user with the
+// constant.RestorePriorityAnnotation, are not finished, so Velero processes them first.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore whose VeleroRestore is not created yet
+//
+// Returns:
+//   - bool: whether to requeue, true while VeleroRestores of higher priority are not finished
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) waitForHigherPriorityRestores(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+		return false, nil
+	}
+	priority, err := function.GetRestorePriority(ctx, r.Client, nar.Namespace)
+	if err != nil {
+		logger.Error(err, "Failed to get restore priority of NonAdminRestore namespace")
+		return false, err
+	}
+
+	veleroRestores := &velerov1.RestoreList{}
+	if err = r.List(ctx, veleroRestores, client.InNamespace(r.OADPNamespace), client.MatchingLabels(function.GetNonAdminLabels())); err != nil {
+		logger.Error(err, "Failed to list VeleroRestores in OADP namespace")
+		return false, err
+	}
+	namespacePriorities := map[string]int{}
+	higherPriorityRestores := 0
+	for index := range veleroRestores.Items {
+		veleroRestore := &veleroRestores.Items[index]
+		if nonAdminPhaseForVeleroRestore(veleroRestore) != nacv1alpha1.NonAdminPhaseCreated {
+			continue
+		}
+		namespace := veleroRestore.Annotations[constant.NarOriginNamespaceAnnotation]
+		namespacePriority, ok := namespacePriorities[namespace]
+		if !ok {
+			namespacePriority, err = function.GetRestorePriority(ctx, r.Client, namespace)
+			if err != nil {
+				// an invalid priority of another namespace does not hold the NonAdminRestore
+				logger.Error(err, "Failed to get restore priority of VeleroRestore namespace", constant.NameString, veleroRestore.Name)
+			}
+			namespacePriorities[namespace] = namespacePriority
+		}
+		if namespacePriority > priority {
+			higherPriorityRestores++
+		}
+	}
+	if higherPriorityRestores == 0 {
+		return false, nil
+	}
+
+	updated := meta.SetStatusCondition(&nar.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionQueued),
+			Status:  metav1.ConditionFalse,
+			Reason:  "WaitingForHigherPriorityRestores",
+			Message: fmt.Sprintf("waiting for %d Velero Restores of higher priority namespaces to finish", higherPriorityRestores),
+		},
+	)
+	if updated {
+		if err = r.Status().Update(ctx, nar); err != nil {
+			logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+			return false, err
+		}
+	}
+	logger.V(1).Info("Waiting for VeleroRestores of higher priority namespaces", "count", higherPriorityRestores)
+	return true, nil
+}
+
+// checkConcurrentRestores sets the ConcurrentRestore condition, before the Velero Restore of the NonAdminRestore is created,
+// when other Velero Restores into its namespace are not finished, since overlapping restores may corrupt the application
+// state. With BlockConcurrentRestores, the Velero Restore is only created once they finished.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore checked for concurrent restores
+//
+// Returns:
+//   - bool: whether to requeue, true while concurrent VeleroRestores are not finished and BlockConcurrentRestores is set
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) checkConcurrentRestores(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+		return false, nil
+	}
+
+	veleroRestores := &velerov1.RestoreList{}
+	if err := r.List(ctx, veleroRestores, client.InNamespace(r.OADPNamespace), client.MatchingLabels(function.GetNonAdminLabels())); err != nil {
+		logger.Error(err, "Failed to list VeleroRestores in OADP namespace")
+		return false, err
+	}
+	targetNamespace := restoreTargetNamespace(nar)
+	concurrentRestores := 0
+	for index := range veleroRestores.Items {
+		veleroRestore := &veleroRestores.Items[index]
+		if veleroRestore.Labels[constant.NarOriginNACUUIDLabel] == nar.Status.VeleroRestore.NACUUID ||
+			nonAdminPhaseForVeleroRestore(veleroRestore) != nacv1alpha1.NonAdminPhaseCreated {
+			continue
+		}
+		if slices.Contains(veleroRestoreTargetNamespaces(veleroRestore), targetNamespace) {
+			concurrentRestores++
+		}
+	}
+
+	var updated bool
+	if concurrentRestores == 0 {
+		if meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionConcurrentRestore)) == nil {
+			return false, nil
+		}
+		updated = meta.SetStatusCondition(&nar.Status.Conditions,
+			metav1.Condition{
+				Type:    string(nacv1alpha1.NonAdminConditionConcurrentRestore),
+				Status:  metav1.ConditionFalse,
+				Reason:  "ConcurrentRestoresFinished",
+				Message: fmt.Sprintf("no other restore into namespace %s is in progress", targetNamespace),
+			},
+		)
+	} else {
+		updated = meta.SetStatusCondition(&nar.Status.Conditions,
+			metav1.Condition{
+				Type:    string(nacv1alpha1.NonAdminConditionConcurrentRestore),
+				Status:  metav1.ConditionTrue,
+				Reason:  "ConcurrentRestoreInProgress",
+				Message: fmt.Sprintf("%d other Velero Restores into namespace %s are in progress", concurrentRestores, targetNamespace),
+			},
+		)
+		if r.BlockConcurrentRestores {
+			updated = meta.SetStatusCondition(&nar.Status.Conditions,
+				metav1.Condition{
+					Type:    string(nacv1alpha1.NonAdminConditionQueued),
+					Status:  metav1.ConditionFalse,
+					Reason:  "WaitingForConcurrentRestores",
+					Message: fmt.Sprintf("waiting for %d other Velero Restores into namespace %s to finish", concurrentRestores, targetNamespace),
+				},
+			) || updated
+		}
+	}
+	if updated {
+		if err := r.Status().Update(ctx, nar); err != nil {
+			logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+			return false, err
+		}
+	}
+	if concurrentRestores > 0 && r.BlockConcurrentRestores {
+		logger.V(1).Info("Waiting for concurrent VeleroRestores", "count", concurrentRestores)
+		return true, nil
+	}
+	return false, nil
+}
+
+// veleroRestoreTargetNamespaces returns the namespaces a Velero Restore restores into
+func veleroRestoreTargetNamespaces(veleroRestore *velerov1.Restore) []string {
+	namespaces := []string{}
+	for _, namespace := range veleroRestore.Spec.IncludedNamespaces {
+		if target, ok := veleroRestore.Spec.NamespaceMapping[namespace]; ok {
+			namespace = target
+		}
+		namespaces = append(namespaces, namespace)
+	}
+	return namespaces
+}
+
+func (r *NonAdminRestoreReconciler) createVeleroRestore(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.NACUUID == constant.EmptyString {
+		return false, errors.New("unable to get Velero Restore UUID from NonAdminRestore Status")
+	}
+
+	veleroRestoreNACUUID := nar.Status.VeleroRestore.NACUUID
+
+	veleroRestore, err := function.GetVeleroRestoreByLabel(ctx, r.Client, r.OADPNamespace, veleroRestoreNACUUID)
+
+	if err != nil {
+		// Case in which more then one VeleroBackup is found with the same label UUID
+		logger.Error(err, findSingleVRError, constant.UUIDString, veleroRestoreNACUUID)
+		return false, err
+	}
+
+	updatedRetryCount := false
+	updatedEnforcedFields := false
+	if veleroRestore == nil {
+		if meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+			err = errors.New("NonAdminRestore is finalized and its associated Velero Restore has been removed. Please create a new NonAdminRestore to initiate a new Restore")
+			logger.Error(err, "related Velero Restore not found")
+			updatedPhase := updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+			updatedCondition := meta.SetStatusCondition(&nar.Status.Conditions,
+				metav1.Condition{
+					// TODO create new condition?
+					Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  "VeleroRestoreNotFound",
+					Message: err.Error(),
+				},
+			)
+			if updatedPhase || updatedCondition {
+				if updateErr := r.Status().Update(ctx, nar); updateErr != nil {
+					logger.Error(updateErr, nonAdminRestoreStatusUpdateFailureMessage)
+					return false, updateErr
+				}
+			}
+			return false, reconcile.TerminalError(err)
+		}
+		logger.Info("VeleroRestore with label not found, creating one", constant.UUIDString, veleroRestoreNACUUID)
+		veleroBackupName, sourceNamespace, err := r.getVeleroBackupToRestore(ctx, nar)
+		if err != nil {
+			logger.Error(err, "Failed to get backup referenced by NonAdminRestore")
+			return false, err
+		}
+
+		restoreSpec, enforcedFields, err := r.veleroRestoreSpec(ctx, nar, veleroBackupName, sourceNamespace)
+		if err != nil {
+			logger.Error(err, "Failed to get Velero Restore spec of NonAdminRestore")
+			return false, err
+		}
+		updatedEnforcedFields = updateNonAdminRestoreEnforcedFieldsStatus(&nar.Status, enforcedFields)
+
+		veleroRestore = &velerov1.Restore{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        veleroRestoreNACUUID,
 				Namespace:   r.OADPNamespace,
@@ -361,13 +1283,16 @@ func (r *NonAdminRestoreReconciler) createVeleroRestore(ctx context.Context, log
 			Spec: *restoreSpec,
 		}
 
-		err = r.Create(ctx, veleroRestore)
-
-		if err != nil {
-			// We do not retry here as the veleroRestoreNACUUID
-			// should be guaranteed to be unique
-			logger.Error(err, "Failed to create VeleroRestore")
-			return false, err
+		// The veleroRestoreNACUUID is guaranteed to be unique, only transient errors are retried
+		retries, createErr := function.CreateWithRetry(ctx, r.Client, veleroRestore, func() (bool, error) {
+			existing, getErr := function.GetVeleroRestoreByLabel(ctx, r.Client, r.OADPNamespace, veleroRestoreNACUUID)
+			return existing != nil, getErr
+		})
+		updatedRetryCount = retries > 0
+		nar.Status.RetryCount += retries
+		if createErr != nil {
+			logger.Error(createErr, "Failed to create VeleroRestore", "retryCount", nar.Status.RetryCount)
+			return false, r.handleVeleroRestoreCreateError(ctx, logger, nar, createErr, updatedRetryCount)
 		}
 		logger.Info("VeleroRestore successfully created")
 	}
@@ -385,7 +1310,7 @@ func (r *NonAdminRestoreReconciler) createVeleroRestore(ctx context.Context, log
 		updatedQueueInfo = true
 	}
 
-	updatedPhase := updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+	updatedPhase := updateNonAdminPhase(&nar.Status.Phase, nonAdminPhaseForVeleroRestore(veleroRestore))
 
 	updatedCondition := meta.SetStatusCondition(&nar.Status.Conditions,
 		metav1.Condition{
@@ -397,6 +1322,11 @@ func (r *NonAdminRestoreReconciler) createVeleroRestore(ctx context.Context, log
 	)
 
 	updatedVeleroStatus := updateVeleroRestoreStatus(&nar.Status, veleroRestore)
+	updatedProgress := updateNonAdminRestoreProgressStatus(&nar.Status, veleroRestore)
+	updatedResults := updateNonAdminRestoreResultsStatus(&nar.Status, veleroRestore)
+	updatedExistingResourcePolicy := updateNonAdminRestoreExistingResourcePolicyStatus(&nar.Status, nar.Spec.RestoreSpec, veleroRestore)
+	updatedItemOperations := updateNonAdminRestoreItemOperationsStatus(&nar.Status, veleroRestore)
+	updatedAppliedOptions := updateNonAdminRestoreAppliedOptionsStatus(&nar.Status, veleroRestore)
 
 	podVolumeRestores := &velerov1.PodVolumeRestoreList{}
 	err = r.List(ctx, podVolumeRestores, &client.ListOptions{
@@ -420,12 +1350,22 @@ func (r *NonAdminRestoreReconciler) createVeleroRestore(ctx context.Context, log
 	}
 	updatedDataDownloadStatus := updateNonAdminBackupDataDownloadStatus(&nar.Status, dataDownloads)
 
-	if updatedPhase || updatedCondition || updatedVeleroStatus || updatedQueueInfo || updatedPodVolumeRestoreStatus || updatedDataDownloadStatus {
+	if updatedPhase || updatedCondition || updatedVeleroStatus || updatedProgress || updatedResults || updatedExistingResourcePolicy || updatedItemOperations || updatedAppliedOptions || updatedEnforcedFields || updatedQueueInfo || updatedPodVolumeRestoreStatus || updatedDataDownloadStatus || updatedRetryCount {
 		if err := r.Status().Update(ctx, nar); err != nil {
 			logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
 			return false, err
 		}
 		logger.V(1).Info("NonAdminRestore Status updated successfully")
+		if eventType, ok := notification.RestoreEvent(nar.Status.Phase); ok && updatedPhase {
+			r.Notifier.Notify(ctx, logger, notification.Event{
+				Type:      eventType,
+				Kind:      nacmeta.KindNonAdminRestore,
+				Namespace: nar.Namespace,
+				Name:      nar.Name,
+				Phase:     nar.Status.Phase,
+				Message:   veleroRestore.Status.FailureReason,
+			})
+		}
 	} else {
 		logger.V(1).Info("NonAdminRestore Status unchanged during Velero Restore reconciliation")
 	}
@@ -433,6 +1373,277 @@ func (r *NonAdminRestoreReconciler) createVeleroRestore(ctx context.Context, log
 	return false, nil
 }
 
+// veleroRestoreSpec returns the spec of the Velero Restore of the NonAdminRestore, which restores the backed up
+// sourceNamespace of the Velero Backup veleroBackupName, with the fields enforced and the resources denied by the admin user,
+// and the spec.restoreSpec fields the admin user set
+func (r *NonAdminRestoreReconciler) veleroRestoreSpec(ctx context.Context, nar *nacv1alpha1.NonAdminRestore, veleroBackupName string, sourceNamespace string) (*velerov1.RestoreSpec, []string, error) {
+	restoreSpec := nar.Spec.RestoreSpec.DeepCopy()
+	restoreSpec.BackupName = veleroBackupName
+	restoreSpec.IncludedNamespaces = []string{sourceNamespace}
+	if nar.Spec.VolumeSelector != nil {
+		// Velero restores the volumes of the selected PersistentVolumeClaims as their additional items,
+		// which are not filtered by the label selector
+		restoreSpec.IncludedResources = slices.Clone(volumeSelectorIncludedResources)
+		restoreSpec.LabelSelector = nar.Spec.VolumeSelector.DeepCopy()
+	}
+	if sourceNamespace != nar.Namespace {
+		// a shared Velero Backup is restored into the NonAdminRestore namespace, or the one it is mapped to
+		restoreSpec.NamespaceMapping = map[string]string{sourceNamespace: restoreTargetNamespace(nar)}
+	}
+
+	enforcedRestoreSpec, err := r.selectorEnforcedRestoreSpec(ctx, nar)
+	if err != nil {
+		return nil, nil, err
+	}
+	enforcedRestoreSpec, err = function.GetNamespaceEnforcedRestoreSpec(ctx, r.Client, nar.Namespace, enforcedRestoreSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	// enforcedFields lists the spec.restoreSpec fields set by the admin user
+	var enforcedFields []string
+	enforcedSpec := reflect.ValueOf(enforcedRestoreSpec).Elem()
+	for index := range enforcedSpec.NumField() {
+		enforcedField := enforcedSpec.Field(index)
+		enforcedFieldName := enforcedSpec.Type().Field(index).Name
+		currentField := reflect.ValueOf(restoreSpec).Elem().FieldByName(enforcedFieldName)
+		if !enforcedField.IsZero() && currentField.IsZero() {
+			currentField.Set(enforcedField)
+			tagName, _, _ := strings.Cut(enforcedSpec.Type().Field(index).Tag.Get(constant.JSONTagString), constant.CommaString)
+			if enforcedFieldName == "UploaderConfig" {
+				// the node-agent options are listed one by one, like the parallel files download set below
+				enforcedFields = append(enforcedFields, enforcedSubfields(tagName, enforcedField.Elem())...)
+				continue
+			}
+			enforcedFields = append(enforcedFields, tagName)
+		}
+	}
+
+	if r.MaxParallelFilesDownload > 0 && (restoreSpec.UploaderConfig == nil || restoreSpec.UploaderConfig.ParallelFilesDownload == 0) {
+		// otherwise the node-agent downloads as many files in parallel as it has CPUs;
+		// the uploader config may be the enforced one, so it is copied before being changed
+		uploaderConfig := &velerov1.UploaderConfigForRestore{}
+		if restoreSpec.UploaderConfig != nil {
+			uploaderConfig = restoreSpec.UploaderConfig.DeepCopy()
+		}
+		uploaderConfig.ParallelFilesDownload = r.MaxParallelFilesDownload
+		restoreSpec.UploaderConfig = uploaderConfig
+		enforcedFields = appendEnforcedField(enforcedFields, "uploaderConfig.parallelFilesDownload")
+	}
+
+	restoreSpec.ExcludedResources = append(restoreSpec.ExcludedResources,
+		"volumesnapshotclasses")
+	deniedResources, err := function.GetRestoreDeniedResources(ctx, r.Client, restoreTargetNamespace(nar), r.DeniedResources)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, deniedResource := range deniedResources {
+		if !slices.Contains(restoreSpec.ExcludedResources, deniedResource) {
+			restoreSpec.ExcludedResources = append(restoreSpec.ExcludedResources, deniedResource)
+			enforcedFields = appendEnforcedField(enforcedFields, "excludedResources")
+		}
+	}
+
+	if r.copiesResourceModifier(nar) {
+		// Velero reads the resource modifiers ConfigMap from the OADP namespace, where syncResourceModifier copied it
+		restoreSpec.ResourceModifier = &corev1.TypedLocalObjectReference{
+			Kind: constant.ResourceModifierConfigMapKind,
+			Name: nar.Status.VeleroRestore.NACUUID,
+		}
+	}
+	return restoreSpec, enforcedFields, nil
+}
+
+// enforcedSubfields returns the set fields of the enforced struct value, prefixed by the parent field tag
+func enforcedSubfields(parent string, value reflect.Value) []string {
+	var subfields []string
+	for index := range value.NumField() {
+		if value.Field(index).IsZero() {
+			continue
+		}
+		tagName, _, _ := strings.Cut(value.Type().Field(index).Tag.Get(constant.JSONTagString), constant.CommaString)
+		subfields = append(subfields, parent+"."+tagName)
+	}
+	return subfields
+}
+
+// validateParallelFilesDownload returns an error if the NonAdminRestore parallel files download exceeds the maximum set by the cluster admin
+// validateRequesterWebhookServed returns an error if the NonAdminRestore maps its namespace to another one while
+// namespace mappings are allowed, by a NonAdminPolicy or the NonAdminControllerConfig, without NAC serving the
+// NonAdminRestore webhooks recording the requester
+func (r *NonAdminRestoreReconciler) validateRequesterWebhookServed(ctx context.Context, nar *nacv1alpha1.NonAdminRestore) error {
+	if !r.AllowNamespaceMapping || r.RequesterWebhookServed || nar.Spec.RestoreSpec.NamespaceMapping == nil ||
+		function.IsRestoreVerificationNamespaceMapping(ctx, r.Client, nar) {
+		return nil
+	}
+	return fmt.Errorf("%w: namespace mappings require the NonAdminRestore webhooks recording the requester, "+
+		"served with --allow-restore-namespace-mapping or --serve-requester-webhooks", function.ErrNamespaceMappingRejected)
+}
+
+func (r *NonAdminRestoreReconciler) validateParallelFilesDownload(nar *nacv1alpha1.NonAdminRestore) error {
+	if r.MaxParallelFilesDownload <= 0 || nar.Spec.RestoreSpec.UploaderConfig == nil {
+		return nil
+	}
+	if nar.Spec.RestoreSpec.UploaderConfig.ParallelFilesDownload > r.MaxParallelFilesDownload {
+		return fmt.Errorf(constant.NARRestrictedErr+", can not exceed %d", "spec.restoreSpec.uploaderConfig.parallelFilesDownload", r.MaxParallelFilesDownload)
+	}
+	return nil
+}
+
+// handleVeleroRestoreCreateError persists the retry count of a failed VeleroRestore creation and
+// returns the error to be returned by the reconcile step. Once transient errors were retried
+// maxVeleroObjectCreateRetries times, the NonAdminRestore is moved to the BackingOff phase
+// and a terminal error is returned.
+func (r *NonAdminRestoreReconciler) handleVeleroRestoreCreateError(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore, createErr error, updatedRetryCount bool) error {
+	if !function.IsTransientAPIError(createErr) {
+		return createErr
+	}
+
+	if nar.Status.RetryCount < maxVeleroObjectCreateRetries {
+		if updatedRetryCount {
+			if err := r.Status().Update(ctx, nar); err != nil {
+				logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+			}
+		}
+		return createErr
+	}
+
+	updatePhase := updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+	updateCondition := meta.SetStatusCondition(&nar.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionQueued),
+			Status:  metav1.ConditionFalse,
+			Reason:  "VeleroRestoreCreationFailed",
+			Message: fmt.Sprintf("giving up after %d retries: %v", nar.Status.RetryCount, createErr),
+		},
+	)
+	if updatePhase || updateCondition || updatedRetryCount {
+		if err := r.Status().Update(ctx, nar); err != nil {
+			logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+			return err
+		}
+	}
+	return reconcile.TerminalError(createErr)
+}
+
+// restoreRetryAfter returns whether the failed VeleroRestore of the NonAdminRestore is retried, according to its
+// retry policy, and the time left before it is retried
+func restoreRetryAfter(nar *nacv1alpha1.NonAdminRestore, now time.Time) (time.Duration, bool) {
+	retryPolicy := nar.Spec.RetryPolicy
+	if retryPolicy == nil || isNonAdminRestoreCanceled(nar) || nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.Status == nil {
+		return 0, false
+	}
+	if _, changed := nonAdminRestoreSpecChanged(nar); changed {
+		return 0, false
+	}
+	veleroRestoreStatus := nar.Status.VeleroRestore.Status
+	if veleroRestoreStatus.Phase != velerov1.RestorePhaseFailed && veleroRestoreStatus.Phase != velerov1.RestorePhaseFailedValidation {
+		return 0, false
+	}
+	if nar.Status.Retries != nil && nar.Status.Retries.Attempts >= retryPolicy.MaxRetries {
+		return 0, false
+	}
+	backoff := defaultRestoreRetryBackoff
+	if retryPolicy.BackoffSeconds != nil {
+		backoff = time.Duration(*retryPolicy.BackoffSeconds) * time.Second
+	}
+	failedAt := now
+	if veleroRestoreStatus.CompletionTimestamp != nil {
+		failedAt = veleroRestoreStatus.CompletionTimestamp.Time
+	}
+	return max(failedAt.Add(backoff).Sub(now), 0), true
+}
+
+// nonAdminRestoreSpecChanged returns the generation of the NonAdminRestore spec accepted, and true if the spec
+// changed after its Velero Restore was created from it. spec.cancel changes are handled before validating the spec.
+func nonAdminRestoreSpecChanged(nar *nacv1alpha1.NonAdminRestore) (int64, bool) {
+	if !meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+		return 0, false
+	}
+	accepted := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))
+	if accepted == nil || accepted.ObservedGeneration == 0 {
+		return 0, false
+	}
+	return accepted.ObservedGeneration, accepted.ObservedGeneration != nar.Generation
+}
+
+// restoreFailureMessage returns why the VeleroRestore failed
+func restoreFailureMessage(veleroRestoreStatus *velerov1.RestoreStatus) string {
+	switch {
+	case veleroRestoreStatus.FailureReason != constant.EmptyString:
+		return veleroRestoreStatus.FailureReason
+	case len(veleroRestoreStatus.ValidationErrors) > 0:
+		return strings.Join(veleroRestoreStatus.ValidationErrors, "; ")
+	default:
+		return fmt.Sprintf("Velero Restore phase %s", veleroRestoreStatus.Phase)
+	}
+}
+
+// retryFailedVeleroRestore deletes the failed VeleroRestore of the NonAdminRestore, once its retry policy backoff
+// elapsed, and references a new VeleroRestore in its status, with a new NACUUID. The NonAdminRestore is requeued
+// so the new VeleroRestore is created.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore whose VeleroRestore failed
+//
+// Returns:
+//   - bool: whether to requeue, true when a new VeleroRestore is referenced
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) retryFailedVeleroRestore(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if retryAfter, retries := restoreRetryAfter(nar, time.Now()); !retries || retryAfter > 0 {
+		return false, nil
+	}
+
+	failedVeleroRestoreNACUUID := nar.Status.VeleroRestore.NACUUID
+	veleroRestore, err := function.GetVeleroRestoreByLabel(ctx, r.Client, r.OADPNamespace, failedVeleroRestoreNACUUID)
+	if err != nil {
+		logger.Error(err, findSingleVRError, constant.UUIDString, failedVeleroRestoreNACUUID)
+		return false, err
+	}
+	if veleroRestore != nil {
+		if err = r.Delete(ctx, veleroRestore); err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete failed VeleroRestore", constant.NameString, veleroRestore.Name)
+			return false, err
+		}
+	}
+	// The copy of the resource modifiers is named after the NACUUID of the failed VeleroRestore
+	if _, err = r.deleteResourceModifierConfigMap(ctx, logger, nar); err != nil {
+		return false, err
+	}
+
+	attempts := 1
+	if nar.Status.Retries != nil {
+		attempts = nar.Status.Retries.Attempts + 1
+	}
+	nar.Status.Retries = &nacv1alpha1.RestoreRetries{
+		Attempts:        attempts,
+		LastError:       restoreFailureMessage(nar.Status.VeleroRestore.Status),
+		LastAttemptTime: &metav1.Time{Time: time.Now()},
+	}
+	veleroRestoreNACUUID := function.GenerateNacObjectUUID(nar.Namespace, nar.Name)
+	nar.Status.VeleroRestore = &nacv1alpha1.VeleroRestore{
+		NACUUID:   veleroRestoreNACUUID,
+		Namespace: r.OADPNamespace,
+		Name:      veleroRestoreNACUUID,
+	}
+	nar.Status.Progress = nil
+	nar.Status.Results = nil
+	nar.Status.Inventory = nil
+	nar.Status.ItemOperations = nil
+	nar.Status.QueueInfo = nil
+	nar.Status.DataMoverDataDownloads = nil
+	nar.Status.FileSystemPodVolumeRestores = nil
+	meta.RemoveStatusCondition(&nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued))
+	if err = r.Status().Update(ctx, nar); err != nil {
+		logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+		return false, err
+	}
+
+	logger.Info("VeleroRestore failed, creating a new one", constant.UUIDString, veleroRestoreNACUUID, "attempts", attempts)
+	return true, nil
+}
+
 // updateVeleroRestoreStatus sets the VeleroRestore status field in NonAdminRestore object status and returns true
 // if the VeleroRestore fields are changed by this call.
 func updateVeleroRestoreStatus(status *nacv1alpha1.NonAdminRestoreStatus, veleroRestore *velerov1.Restore) bool {
@@ -456,6 +1667,581 @@ func updateVeleroRestoreStatus(status *nacv1alpha1.NonAdminRestoreStatus, velero
 	return true
 }
 
+// updateNonAdminRestoreProgressStatus sets the Progress field in NonAdminRestore object status and returns true
+// if it is changed by this call.
+func updateNonAdminRestoreProgressStatus(status *nacv1alpha1.NonAdminRestoreStatus, veleroRestore *velerov1.Restore) bool {
+	if status == nil || veleroRestore == nil || veleroRestore.Status.Progress == nil {
+		return false
+	}
+
+	progress := &nacv1alpha1.RestoreProgress{
+		TotalItems:    veleroRestore.Status.Progress.TotalItems,
+		ItemsRestored: veleroRestore.Status.Progress.ItemsRestored,
+	}
+	if reflect.DeepEqual(status.Progress, progress) {
+		return false
+	}
+	status.Progress = progress
+	return true
+}
+
+// updateNonAdminRestoreExistingResourcePolicyStatus sets the ExistingResourcePolicy field in NonAdminRestore object
+// status and returns true if it is changed by this call.
+func updateNonAdminRestoreExistingResourcePolicyStatus(status *nacv1alpha1.NonAdminRestoreStatus, restoreSpec *velerov1.RestoreSpec, veleroRestore *velerov1.Restore) bool {
+	if status == nil || restoreSpec == nil || veleroRestore == nil || veleroRestore.Spec.ExistingResourcePolicy == constant.EmptyString {
+		return false
+	}
+
+	existingResourcePolicy := &nacv1alpha1.ExistingResourcePolicy{
+		Policy:   veleroRestore.Spec.ExistingResourcePolicy,
+		Enforced: restoreSpec.ExistingResourcePolicy == constant.EmptyString,
+	}
+	if reflect.DeepEqual(status.ExistingResourcePolicy, existingResourcePolicy) {
+		return false
+	}
+	status.ExistingResourcePolicy = existingResourcePolicy
+	return true
+}
+
+// updateNonAdminRestoreEnforcedFieldsStatus sets the EnforcedFields field and the SpecOverridden condition in NonAdminRestore
+// object status and returns true if they are changed by this call.
+func updateNonAdminRestoreEnforcedFieldsStatus(status *nacv1alpha1.NonAdminRestoreStatus, enforcedFields []string) bool {
+	if len(enforcedFields) == 0 {
+		updated := status.EnforcedFields != nil
+		status.EnforcedFields = nil
+		return meta.RemoveStatusCondition(&status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden)) || updated
+	}
+
+	updated := !slices.Equal(status.EnforcedFields, enforcedFields)
+	status.EnforcedFields = enforcedFields
+	return meta.SetStatusCondition(&status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionSpecOverridden),
+			Status:  metav1.ConditionTrue,
+			Reason:  "EnforcedFieldsApplied",
+			Message: "spec.restoreSpec fields set or overridden in the Velero Restore: " + strings.Join(enforcedFields, ", "),
+		},
+	) || updated
+}
+
+// updateNonAdminRestoreAppliedOptionsStatus sets the AppliedOptions field in NonAdminRestore object status, from the
+// Velero Restore spec with the Velero defaults, and returns true if it is changed by this call.
+func updateNonAdminRestoreAppliedOptionsStatus(status *nacv1alpha1.NonAdminRestoreStatus, veleroRestore *velerov1.Restore) bool {
+	appliedOptions := &nacv1alpha1.AppliedRestoreOptions{
+		RestorePVs:        ptr.Deref(veleroRestore.Spec.RestorePVs, true),
+		PreserveNodePorts: ptr.Deref(veleroRestore.Spec.PreserveNodePorts, false),
+	}
+	if uploaderConfig := veleroRestore.Spec.UploaderConfig; uploaderConfig != nil {
+		appliedOptions.WriteSparseFiles = ptr.Deref(uploaderConfig.WriteSparseFiles, false)
+		appliedOptions.ParallelFilesDownload = uploaderConfig.ParallelFilesDownload
+	}
+	if reflect.DeepEqual(status.AppliedOptions, appliedOptions) {
+		return false
+	}
+	status.AppliedOptions = appliedOptions
+	return true
+}
+
+// updateNonAdminRestoreItemOperationsStatus sets the ItemOperations field in NonAdminRestore object status and returns
+// true if it is changed by this call.
+func updateNonAdminRestoreItemOperationsStatus(status *nacv1alpha1.NonAdminRestoreStatus, veleroRestore *velerov1.Restore) bool {
+	if status == nil || veleroRestore == nil || veleroRestore.Status.RestoreItemOperationsAttempted == 0 {
+		return false
+	}
+
+	itemOperations := &nacv1alpha1.RestoreItemOperations{
+		Attempted: veleroRestore.Status.RestoreItemOperationsAttempted,
+		Completed: veleroRestore.Status.RestoreItemOperationsCompleted,
+		Failed:    veleroRestore.Status.RestoreItemOperationsFailed,
+	}
+	if status.ItemOperations != nil {
+		// the failed operations are read from the Velero Restore item operations once it completed
+		itemOperations.FailedOperations = status.ItemOperations.FailedOperations
+	}
+	if reflect.DeepEqual(status.ItemOperations, itemOperations) {
+		return false
+	}
+	status.ItemOperations = itemOperations
+	return true
+}
+
+// nonAdminPhaseForVeleroRestore maps the VeleroRestore phase to the NonAdminRestore phase.
+// Terminal VeleroRestore phases have their own NonAdminRestore phase, any other phase
+// is reported as Created.
+func nonAdminPhaseForVeleroRestore(veleroRestore *velerov1.Restore) nacv1alpha1.NonAdminPhase {
+	switch veleroRestore.Status.Phase {
+	case velerov1.RestorePhaseCompleted:
+		return nacv1alpha1.NonAdminPhaseCompleted
+	case velerov1.RestorePhasePartiallyFailed:
+		return nacv1alpha1.NonAdminPhasePartiallyFailed
+	case velerov1.RestorePhaseFailed, velerov1.RestorePhaseFailedValidation:
+		return nacv1alpha1.NonAdminPhaseFailed
+	default:
+		return nacv1alpha1.NonAdminPhaseCreated
+	}
+}
+
+// updateNonAdminRestoreResultsStatus sets the Results field and the RestoreCompletedWithWarnings condition in
+// NonAdminRestore object status and returns true if they are changed by this call.
+func updateNonAdminRestoreResultsStatus(status *nacv1alpha1.NonAdminRestoreStatus, veleroRestore *velerov1.Restore) bool {
+	if status == nil || veleroRestore == nil {
+		return false
+	}
+
+	updated := false
+	if veleroRestore.Status.Warnings > 0 || veleroRestore.Status.Errors > 0 || veleroRestore.Status.FailureReason != constant.EmptyString {
+		restoreResults := &nacv1alpha1.RestoreResults{
+			FailureReason: veleroRestore.Status.FailureReason,
+			Warnings:      veleroRestore.Status.Warnings,
+			Errors:        veleroRestore.Status.Errors,
+		}
+		if status.Results != nil {
+			// the error messages are read from the Velero Restore results once it completed
+			restoreResults.ErrorMessages = status.Results.ErrorMessages
+		}
+		if !reflect.DeepEqual(status.Results, restoreResults) {
+			status.Results = restoreResults
+			updated = true
+		}
+	}
+
+	if veleroRestore.Status.Phase == velerov1.RestorePhaseCompleted && veleroRestore.Status.Warnings > 0 {
+		updated = meta.SetStatusCondition(&status.Conditions,
+			metav1.Condition{
+				Type:    string(nacv1alpha1.NonAdminConditionRestoreCompletedWithWarnings),
+				Status:  metav1.ConditionTrue,
+				Reason:  "RestoreWarnings",
+				Message: fmt.Sprintf("Velero Restore completed with %d warnings", veleroRestore.Status.Warnings),
+			},
+		) || updated
+	}
+	return updated
+}
+
+// fetchRestoreErrorMessages lists a summary of the error messages of the completed Velero Restore in the
+// NonAdminRestore status, read from the Velero Restore results with downloadVeleroFile.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore whose Velero Restore error messages are listed
+//
+// Returns:
+//   - bool: whether to requeue, while Velero processes the DownloadRequest
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) fetchRestoreErrorMessages(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if !r.FetchRestoreResults || nar.Status.Results == nil || nar.Status.Results.Errors == 0 || nar.Status.Results.ErrorMessages != nil ||
+		nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.Status == nil || nar.Status.VeleroRestore.Status.CompletionTimestamp == nil {
+		return false, nil
+	}
+
+	restoreResults := map[string]results.Result{}
+	downloaded, err := r.downloadVeleroFile(ctx, logger, nar, nar.Status.VeleroRestore.NACUUID,
+		velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindRestoreResults, Name: nar.VeleroRestoreName()}, &restoreResults)
+	if err != nil || !downloaded {
+		return err == nil, err
+	}
+
+	nar.Status.Results.ErrorMessages = restoreErrorMessages(restoreResults["errors"])
+	if err = r.Status().Update(ctx, nar); err != nil {
+		logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminRestore error messages updated from the VeleroRestore results")
+	return false, nil
+}
+
+// fetchFailedItemOperations lists the failed item operations of the completed Velero Restore in the
+// NonAdminRestore status, read from the Velero Restore item operations with downloadVeleroFile.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore whose Velero Restore failed item operations are listed
+//
+// Returns:
+//   - bool: whether to requeue, while Velero processes the DownloadRequest
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) fetchFailedItemOperations(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if !r.FetchRestoreResults || nar.Status.ItemOperations == nil || nar.Status.ItemOperations.Failed == 0 || nar.Status.ItemOperations.FailedOperations != nil ||
+		nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.Status == nil || nar.Status.VeleroRestore.Status.CompletionTimestamp == nil {
+		return false, nil
+	}
+
+	itemOperations := []restoreItemOperation{}
+	name := nar.Status.VeleroRestore.NACUUID + itemOperationsDownloadRequestSuffix
+	downloaded, err := r.downloadVeleroFile(ctx, logger, nar, name,
+		velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindRestoreItemOperations, Name: nar.VeleroRestoreName()}, &itemOperations)
+	if err != nil || !downloaded {
+		return err == nil, err
+	}
+
+	nar.Status.ItemOperations.FailedOperations = failedItemOperations(itemOperations)
+	if err = r.Status().Update(ctx, nar); err != nil {
+		logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminRestore failed item operations updated from the VeleroRestore item operations")
+	return false, nil
+}
+
+// fetchRestoredResourceInventory summarizes the resources restored by the completed Velero Restore in the
+// NonAdminRestore status inventory, read from the Velero Restore resource list with downloadVeleroFile.
+// Velero only writes the resource list of the restores it ran, which completed or partially failed.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore whose Velero Restore restored resources are summarized
+//
+// Returns:
+//   - bool: whether to requeue, while Velero processes the DownloadRequest
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) fetchRestoredResourceInventory(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if !r.FetchRestoreResults || nar.Status.Inventory != nil ||
+		nar.Status.VeleroRestore == nil || nar.Status.VeleroRestore.Status == nil || nar.Status.VeleroRestore.Status.CompletionTimestamp == nil ||
+		(nar.Status.VeleroRestore.Status.Phase != velerov1.RestorePhaseCompleted && nar.Status.VeleroRestore.Status.Phase != velerov1.RestorePhasePartiallyFailed) {
+		return false, nil
+	}
+
+	resourceList := map[string][]string{}
+	name := nar.Status.VeleroRestore.NACUUID + resourceListDownloadRequestSuffix
+	downloaded, err := r.downloadVeleroFile(ctx, logger, nar, name,
+		velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindRestoreResourceList, Name: nar.VeleroRestoreName()}, &resourceList)
+	if err != nil || !downloaded {
+		return err == nil, err
+	}
+
+	nar.Status.Inventory = restoredResourceInventory(resourceList)
+	if err = r.Status().Update(ctx, nar); err != nil {
+		logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminRestore inventory updated from the VeleroRestore resource list")
+	return false, nil
+}
+
+// downloadVeleroFile decodes a gzipped JSON file of the Velero Restore of the NonAdminRestore, or of its Velero Backup,
+// into target. It creates a Velero DownloadRequest named name for downloadTarget, returns false until Velero processed it,
+// downloads the file and deletes the DownloadRequest.
+func (r *NonAdminRestoreReconciler) downloadVeleroFile(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore, name string, downloadTarget velerov1.DownloadTarget, target any) (bool, error) {
+	kind := downloadTarget.Kind
+	downloadRequest := &velerov1.DownloadRequest{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: r.OADPNamespace}, downloadRequest)
+	if apierrors.IsNotFound(err) {
+		downloadRequest = &velerov1.DownloadRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   r.OADPNamespace,
+				Labels:      function.GetNonAdminRestoreLabels(nar.Status.VeleroRestore.NACUUID),
+				Annotations: function.GetNonAdminRestoreAnnotations(nar.ObjectMeta),
+			},
+			Spec: velerov1.DownloadRequestSpec{
+				Target: downloadTarget,
+			},
+		}
+		if err = r.Create(ctx, downloadRequest); err != nil {
+			logger.Error(err, "Failed to create DownloadRequest for the Velero file", "kind", kind)
+			return false, err
+		}
+		logger.V(1).Info("DownloadRequest for the Velero file created", "kind", kind)
+		return false, nil
+	} else if err != nil {
+		logger.Error(err, "Failed to get DownloadRequest for the Velero file", "kind", kind)
+		return false, err
+	}
+	if downloadRequest.Status.Phase != velerov1.DownloadRequestPhaseProcessed || downloadRequest.Status.DownloadURL == constant.EmptyString {
+		return false, nil
+	}
+
+	fetchErr := r.downloadRestoreFile(ctx, downloadRequest.Status.DownloadURL, target)
+	// the download URL expires, a new DownloadRequest is created on retry
+	if err = r.Delete(ctx, downloadRequest); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete DownloadRequest for the Velero file", "kind", kind)
+		return false, err
+	}
+	if fetchErr != nil {
+		logger.Error(fetchErr, "Failed to download the Velero file", "kind", kind)
+		return false, fetchErr
+	}
+	return true, nil
+}
+
+// downloadRestoreFile downloads a gzipped JSON file of the Velero Restore, like its results, or of its Velero Backup,
+// and decodes it into target
+func (r *NonAdminRestoreReconciler) downloadRestoreFile(ctx context.Context, downloadURL string, target any) error {
+	httpClient := r.httpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: restoreResultsTimeout}
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return err
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d downloading the Velero file", response.StatusCode)
+	}
+
+	reader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return json.NewDecoder(reader).Decode(target)
+}
+
+// previewVeleroRestore lists, in the NonAdminRestore status, the resources the Velero Restore would restore, without
+// creating it. They are read from the resource list of the Velero Backup with downloadVeleroFile, and the preview
+// is computed again when the NonAdminRestore spec changes.
+//
+// Parameters:
+//   - ctx: Context for managing request lifetime
+//   - logger: Logger instance
+//   - nar: NonAdminRestore being previewed
+//
+// Returns:
+//   - bool: whether to requeue, while Velero processes the DownloadRequest
+//   - error: any error encountered
+func (r *NonAdminRestoreReconciler) previewVeleroRestore(ctx context.Context, logger logr.Logger, nar *nacv1alpha1.NonAdminRestore) (bool, error) {
+	if nar.Status.Preview != nil && nar.Status.Preview.ObservedGeneration == nar.Generation {
+		return false, nil
+	}
+
+	veleroBackupName, sourceNamespace, err := r.getVeleroBackupToRestore(ctx, nar)
+	if err != nil {
+		logger.Error(err, "Failed to get backup referenced by NonAdminRestore")
+		return false, err
+	}
+	if veleroBackupName == constant.EmptyString {
+		return false, errors.New("NonAdminBackup referenced by NonAdminRestore has no Velero Backup yet")
+	}
+	restoreSpec, _, err := r.veleroRestoreSpec(ctx, nar, veleroBackupName, sourceNamespace)
+	if err != nil {
+		logger.Error(err, "Failed to get Velero Restore spec of NonAdminRestore")
+		return false, err
+	}
+
+	resourceList := map[string][]string{}
+	downloaded, err := r.downloadVeleroFile(ctx, logger, nar, nar.Status.VeleroRestore.NACUUID+previewDownloadRequestSuffix,
+		velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindBackupResourceList, Name: veleroBackupName}, &resourceList)
+	if err != nil || !downloaded {
+		return err == nil, err
+	}
+
+	nar.Status.Preview = previewRestoreResources(resourceList, restoreSpec, r.RESTMapper())
+	nar.Status.Preview.ObservedGeneration = nar.Generation
+	updateNonAdminPhase(&nar.Status.Phase, nacv1alpha1.NonAdminPhaseCompleted)
+	meta.SetStatusCondition(&nar.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionPreviewed),
+			Status:  metav1.ConditionTrue,
+			Reason:  "RestorePreviewed",
+			Message: fmt.Sprintf("%d resources would be restored", nar.Status.Preview.Total),
+		},
+	)
+	if err = r.Status().Update(ctx, nar); err != nil {
+		logger.Error(err, nonAdminRestoreStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminRestore preview updated from the VeleroBackup resource list")
+	return false, nil
+}
+
+// previewRestoreResources returns the resources of the Velero Backup resource list, keyed by group version kind, restored
+// by restoreSpec. Only the namespaced resources of the included namespaces are restored, to the namespaces they are mapped to.
+func previewRestoreResources(resourceList map[string][]string, restoreSpec *velerov1.RestoreSpec, mapper meta.RESTMapper) *nacv1alpha1.RestorePreview {
+	preview := &nacv1alpha1.RestorePreview{}
+	for _, key := range slices.Sorted(maps.Keys(resourceList)) {
+		groupResource, kind := previewGroupResource(key, mapper)
+		if !restoreIncludesResource(restoreSpec, groupResource, kind) {
+			continue
+		}
+		for _, item := range resourceList[key] {
+			namespace, name, namespaced := strings.Cut(item, "/")
+			if !namespaced || !slices.Contains(restoreSpec.IncludedNamespaces, namespace) || slices.Contains(restoreSpec.ExcludedNamespaces, namespace) {
+				continue
+			}
+			if target, ok := restoreSpec.NamespaceMapping[namespace]; ok {
+				namespace = target
+			}
+			preview.Total++
+			if len(preview.Resources) < maxPreviewResources {
+				preview.Resources = append(preview.Resources, nacv1alpha1.PreviewResource{
+					Resource:  groupResource.String(),
+					Namespace: namespace,
+					Name:      name,
+				})
+			}
+		}
+	}
+	return preview
+}
+
+// previewGroupResource returns the group resource and kind of a Velero Backup resource list key, like apps/v1/Deployment.
+// The resource is guessed from the kind when mapper does not know it.
+func previewGroupResource(key string, mapper meta.RESTMapper) (schema.GroupResource, string) {
+	index := strings.LastIndex(key, "/")
+	groupVersion, err := schema.ParseGroupVersion(key[:max(index, 0)])
+	if err != nil {
+		groupVersion = schema.GroupVersion{}
+	}
+	groupVersionKind := groupVersion.WithKind(key[index+1:])
+	if mapping, err := mapper.RESTMapping(groupVersionKind.GroupKind(), groupVersionKind.Version); err == nil {
+		return mapping.Resource.GroupResource(), groupVersionKind.Kind
+	}
+	plural, _ := meta.UnsafeGuessKindToResource(groupVersionKind)
+	return plural.GroupResource(), groupVersionKind.Kind
+}
+
+// restoreIncludesResource returns true if the resource filters of restoreSpec, and Velero, restore groupResource
+func restoreIncludesResource(restoreSpec *velerov1.RestoreSpec, groupResource schema.GroupResource, kind string) bool {
+	for _, filter := range append(slices.Clone(previewNonRestorableResources), restoreSpec.ExcludedResources...) {
+		if resourceFilterMatches(filter, groupResource, kind) {
+			return false
+		}
+	}
+	if len(restoreSpec.IncludedResources) == 0 {
+		return true
+	}
+	for _, filter := range restoreSpec.IncludedResources {
+		if resourceFilterMatches(filter, groupResource, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceFilterMatches returns true if filter, '*', a resource or its kind, optionally followed by its group,
+// matches groupResource. Resource short names are not resolved.
+func resourceFilterMatches(filter string, groupResource schema.GroupResource, kind string) bool {
+	if filter == "*" {
+		return true
+	}
+	filterResource := schema.ParseGroupResource(strings.ToLower(filter))
+	if filterResource.Resource != groupResource.Resource && filterResource.Resource != strings.ToLower(kind) {
+		return false
+	}
+	return filterResource.Group == constant.EmptyString || filterResource.Group == groupResource.Group
+}
+
+// restoreItemOperation is the part of a Velero restore item operation listed in the NonAdminRestore status
+type restoreItemOperation struct {
+	Spec struct {
+		ResourceIdentifier struct {
+			Group     string
+			Resource  string
+			Namespace string
+			Name      string
+		} `json:"resourceIdentifier"`
+	} `json:"spec"`
+	Status struct {
+		Phase string `json:"phase,omitempty"`
+		Error string `json:"error,omitempty"`
+	} `json:"status"`
+}
+
+// failedItemOperations returns up to maxRestoreErrorMessages of the failed Velero Restore item operations, their error
+// truncated to maxRestoreErrorMessageLength. It never returns an empty list, so the item operations are downloaded only once.
+func failedItemOperations(itemOperations []restoreItemOperation) []nacv1alpha1.FailedItemOperation {
+	failedOperations := []nacv1alpha1.FailedItemOperation{}
+	for _, itemOperation := range itemOperations {
+		if itemOperation.Status.Phase != itemOperationPhaseFailed {
+			continue
+		}
+		resourceIdentifier := itemOperation.Spec.ResourceIdentifier
+		failedOperations = append(failedOperations, nacv1alpha1.FailedItemOperation{
+			Resource:  schema.GroupResource{Group: resourceIdentifier.Group, Resource: resourceIdentifier.Resource}.String(),
+			Namespace: resourceIdentifier.Namespace,
+			Name:      resourceIdentifier.Name,
+			Error:     truncateRestoreErrorMessage(itemOperation.Status.Error),
+		})
+		if len(failedOperations) == maxRestoreErrorMessages {
+			break
+		}
+	}
+	if len(failedOperations) == 0 {
+		failedOperations = append(failedOperations, nacv1alpha1.FailedItemOperation{Error: "Velero Restore item operations have no failed operation"})
+	}
+	return failedOperations
+}
+
+// restoredResourceInventory counts the items of each kind of a Velero Restore resource list, whose entries are
+// namespace/name(action), and lists up to maxInventorySkippedResources skipped items and maxRestoreErrorMessages failed items.
+// It never returns nil, so the resource list is downloaded only once.
+func restoredResourceInventory(resourceList map[string][]string) *nacv1alpha1.RestoreInventory {
+	inventory := &nacv1alpha1.RestoreInventory{}
+	for _, kind := range slices.Sorted(maps.Keys(resourceList)) {
+		count := nacv1alpha1.RestoredResourceCount{Kind: kind}
+		for _, entry := range resourceList[kind] {
+			item, action := entry, constant.EmptyString
+			if index := strings.LastIndex(entry, "("); index != -1 && strings.HasSuffix(entry, ")") {
+				item, action = entry[:index], entry[index+1:len(entry)-1]
+			}
+			resource := nacv1alpha1.InventoryResource{Kind: kind, Name: item}
+			if namespace, name, namespaced := strings.Cut(item, "/"); namespaced {
+				resource.Namespace, resource.Name = namespace, name
+			}
+			switch action {
+			case "created":
+				count.Created++
+			case "updated":
+				count.Updated++
+			case "skipped":
+				count.Skipped++
+				if len(inventory.SkippedResources) < maxInventorySkippedResources {
+					inventory.SkippedResources = append(inventory.SkippedResources, resource)
+				}
+			case "failed":
+				count.Failed++
+				if len(inventory.FailedResources) < maxRestoreErrorMessages {
+					inventory.FailedResources = append(inventory.FailedResources, resource)
+				}
+			}
+		}
+		inventory.Resources = append(inventory.Resources, count)
+	}
+	return inventory
+}
+
+// restoreErrorMessages returns up to maxRestoreErrorMessages of the Velero Restore error messages, prefixed by their scope
+// and truncated to maxRestoreErrorMessageLength. It never returns an empty list, so the results are downloaded only once.
+func restoreErrorMessages(restoreErrors results.Result) []string {
+	messages := []string{}
+	for _, message := range restoreErrors.Velero {
+		messages = append(messages, "velero: "+message)
+	}
+	for _, message := range restoreErrors.Cluster {
+		messages = append(messages, "cluster: "+message)
+	}
+	for _, namespace := range slices.Sorted(maps.Keys(restoreErrors.Namespaces)) {
+		for _, message := range restoreErrors.Namespaces[namespace] {
+			messages = append(messages, "namespace "+namespace+": "+message)
+		}
+	}
+	if len(messages) == 0 {
+		return []string{"Velero Restore results have no error messages"}
+	}
+
+	messages = messages[:min(len(messages), maxRestoreErrorMessages)]
+	for index, message := range messages {
+		messages[index] = truncateRestoreErrorMessage(message)
+	}
+	return messages
+}
+
+// truncateRestoreErrorMessage truncates a Velero Restore error message to maxRestoreErrorMessageLength
+func truncateRestoreErrorMessage(message string) string {
+	if runes := []rune(message); len(runes) > maxRestoreErrorMessageLength {
+		return string(runes[:maxRestoreErrorMessageLength-len("...")]) + "..."
+	}
+	return message
+}
+
 func updateNonAdminBackupPodVolumeRestoreStatus(status *nacv1alpha1.NonAdminRestoreStatus, podVolumeRestoreList *velerov1.PodVolumeRestoreList) bool {
 	if status.FileSystemPodVolumeRestores == nil {
 		status.FileSystemPodVolumeRestores = &nacv1alpha1.FileSystemPodVolumeRestores{}
@@ -608,5 +2394,6 @@ func (r *NonAdminRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			Client:        r.Client,
 			OADPNamespace: r.OADPNamespace,
 		}).
+		WithOptions(r.StartupBackpressure.ControllerOptions()).
 		Complete(r)
 }
@@ -17,28 +17,37 @@ limitations under the License.
 package controller
 
 import (
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 
 	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
 	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
 )
 
 type nonAdminRestoreClusterValidationScenario struct {
@@ -409,6 +418,7 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminRestore Controller"
 							return false, nil
 						}
 						return nonAdminRestore.Status.VeleroRestore.Status.Phase == velerov1.RestorePhaseCompleted &&
+							nonAdminRestore.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted &&
 							nonAdminRestore.Status.FileSystemPodVolumeRestores.Completed == 1 &&
 							nonAdminRestore.Status.DataMoverDataDownloads.Completed == 1, nil
 					}, 5*time.Second, 1*time.Second).Should(gomega.BeTrue())
@@ -494,6 +504,12 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminRestore Controller"
 						Reason:  "RestoreAccepted",
 						Message: "restore accepted",
 					},
+					{
+						Type:    "SpecOverridden",
+						Status:  metav1.ConditionTrue,
+						Reason:  "EnforcedFieldsApplied",
+						Message: "spec.restoreSpec fields set or overridden in the Velero Restore: restorePVs, itemOperationTimeout, uploaderConfig.writeSparseFiles",
+					},
 					{
 						Type:    "Queued",
 						Status:  metav1.ConditionTrue,
@@ -607,3 +623,1377 @@ var _ = ginkgo.Describe("Test full reconcile loop of NonAdminRestore Controller"
 		}),
 	)
 })
+
+var _ = ginkgo.DescribeTable("updateNonAdminRestoreProgressStatus",
+	func(progress *velerov1.RestoreProgress, expected *nacv1alpha1.RestoreProgress, expectedUpdated bool) {
+		status := &nacv1alpha1.NonAdminRestoreStatus{Progress: &nacv1alpha1.RestoreProgress{TotalItems: 10, ItemsRestored: 5}}
+		veleroRestore := &velerov1.Restore{Status: velerov1.RestoreStatus{Progress: progress}}
+
+		gomega.Expect(updateNonAdminRestoreProgressStatus(status, veleroRestore)).To(gomega.Equal(expectedUpdated))
+		gomega.Expect(status.Progress).To(gomega.Equal(expected))
+	},
+	ginkgo.Entry("without Velero Restore progress", nil, &nacv1alpha1.RestoreProgress{TotalItems: 10, ItemsRestored: 5}, false),
+	ginkgo.Entry("with unchanged progress", &velerov1.RestoreProgress{TotalItems: 10, ItemsRestored: 5}, &nacv1alpha1.RestoreProgress{TotalItems: 10, ItemsRestored: 5}, false),
+	ginkgo.Entry("with changed progress", &velerov1.RestoreProgress{TotalItems: 12, ItemsRestored: 8}, &nacv1alpha1.RestoreProgress{TotalItems: 12, ItemsRestored: 8}, true),
+)
+
+var _ = ginkgo.DescribeTable("updateNonAdminRestoreExistingResourcePolicyStatus",
+	func(specPolicy velerov1.PolicyType, veleroRestorePolicy velerov1.PolicyType, expected *nacv1alpha1.ExistingResourcePolicy) {
+		status := &nacv1alpha1.NonAdminRestoreStatus{}
+		restoreSpec := &velerov1.RestoreSpec{ExistingResourcePolicy: specPolicy}
+		veleroRestore := &velerov1.Restore{Spec: velerov1.RestoreSpec{ExistingResourcePolicy: veleroRestorePolicy}}
+
+		gomega.Expect(updateNonAdminRestoreExistingResourcePolicyStatus(status, restoreSpec, veleroRestore)).To(gomega.Equal(expected != nil))
+		gomega.Expect(status.ExistingResourcePolicy).To(gomega.Equal(expected))
+		gomega.Expect(updateNonAdminRestoreExistingResourcePolicyStatus(status, restoreSpec, veleroRestore)).To(gomega.BeFalse())
+	},
+	ginkgo.Entry("without policy", velerov1.PolicyType(""), velerov1.PolicyType(""), nil),
+	ginkgo.Entry("with policy set by the user", velerov1.PolicyTypeUpdate, velerov1.PolicyTypeUpdate, &nacv1alpha1.ExistingResourcePolicy{Policy: velerov1.PolicyTypeUpdate}),
+	ginkgo.Entry("with policy enforced by the admin", velerov1.PolicyType(""), velerov1.PolicyTypeNone, &nacv1alpha1.ExistingResourcePolicy{Policy: velerov1.PolicyTypeNone, Enforced: true}),
+)
+
+var _ = ginkgo.Describe("Test NonAdminRestore results", func() {
+	const (
+		resultsNamespace = "test-nonadminrestore-results"
+		resultsOADP      = "test-nonadminrestore-results-oadp"
+		resultsNACUUID   = "test-nonadminrestore-results-nacuuid"
+	)
+
+	ginkgo.It("should copy the Velero Restore warnings, errors and failure reason", func() {
+		status := &nacv1alpha1.NonAdminRestoreStatus{}
+		veleroRestore := &velerov1.Restore{Status: velerov1.RestoreStatus{Phase: velerov1.RestorePhaseCompleted, Warnings: 2}}
+
+		gomega.Expect(updateNonAdminRestoreResultsStatus(status, veleroRestore)).To(gomega.BeTrue())
+		gomega.Expect(status.Results).To(gomega.Equal(&nacv1alpha1.RestoreResults{Warnings: 2}))
+		gomega.Expect(meta.IsStatusConditionTrue(status.Conditions, string(nacv1alpha1.NonAdminConditionRestoreCompletedWithWarnings))).To(gomega.BeTrue())
+		gomega.Expect(updateNonAdminRestoreResultsStatus(status, veleroRestore)).To(gomega.BeFalse())
+
+		status.Results.ErrorMessages = []string{"velero: error"}
+		veleroRestore.Status = velerov1.RestoreStatus{Phase: velerov1.RestorePhaseFailed, Errors: 1, FailureReason: "failure"}
+		gomega.Expect(updateNonAdminRestoreResultsStatus(status, veleroRestore)).To(gomega.BeTrue())
+		gomega.Expect(status.Results).To(gomega.Equal(&nacv1alpha1.RestoreResults{Errors: 1, FailureReason: "failure", ErrorMessages: []string{"velero: error"}}))
+	})
+
+	ginkgo.It("should not set results for a Velero Restore without warnings nor errors", func() {
+		status := &nacv1alpha1.NonAdminRestoreStatus{}
+		veleroRestore := &velerov1.Restore{Status: velerov1.RestoreStatus{Phase: velerov1.RestorePhaseCompleted}}
+
+		gomega.Expect(updateNonAdminRestoreResultsStatus(status, veleroRestore)).To(gomega.BeFalse())
+		gomega.Expect(status.Results).To(gomega.BeNil())
+		gomega.Expect(status.Conditions).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should summarize and truncate the Velero Restore error messages", func() {
+		gomega.Expect(restoreErrorMessages(results.Result{})).To(gomega.Equal([]string{"Velero Restore results have no error messages"}))
+
+		messages := restoreErrorMessages(results.Result{
+			Velero:  []string{"velero error"},
+			Cluster: []string{"cluster error"},
+			Namespaces: map[string][]string{
+				"b": {strings.Repeat("b", maxRestoreErrorMessageLength+1)},
+				"a": {"1", "2", "3", "4", "5", "6", "7", "8", "9"},
+			},
+		})
+		gomega.Expect(messages).To(gomega.HaveLen(maxRestoreErrorMessages))
+		gomega.Expect(messages[0]).To(gomega.Equal("velero: velero error"))
+		gomega.Expect(messages[1]).To(gomega.Equal("cluster: cluster error"))
+		gomega.Expect(messages[2]).To(gomega.Equal("namespace a: 1"))
+		gomega.Expect(messages[9]).To(gomega.Equal("namespace a: 8"))
+
+		messages = restoreErrorMessages(results.Result{Velero: []string{strings.Repeat("v", maxRestoreErrorMessageLength)}})
+		gomega.Expect(messages[0]).To(gomega.HaveLen(maxRestoreErrorMessageLength))
+		gomega.Expect(messages[0]).To(gomega.HaveSuffix("..."))
+	})
+
+	ginkgo.It("should list the error messages read from the Velero Restore results", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			gzipWriter := gzip.NewWriter(writer)
+			gomega.Expect(json.NewEncoder(gzipWriter).Encode(map[string]results.Result{
+				"errors": {Namespaces: map[string][]string{resultsNamespace: {"error restoring pods/test"}}},
+			})).To(gomega.Succeed())
+			gomega.Expect(gzipWriter.Close()).To(gomega.Succeed())
+		}))
+		defer server.Close()
+
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-results", Namespace: resultsNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{
+					NACUUID: resultsNACUUID,
+					Name:    resultsNACUUID,
+					Status:  &velerov1.RestoreStatus{Phase: velerov1.RestorePhasePartiallyFailed, CompletionTimestamp: &metav1.Time{Time: time.Now()}},
+				},
+				Results: &nacv1alpha1.RestoreResults{Errors: 1},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nar).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: resultsOADP, FetchRestoreResults: true, httpClient: server.Client()}
+
+		ginkgo.By("Creating a DownloadRequest for the Velero Restore results")
+		requeue, err := r.fetchRestoreErrorMessages(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		downloadRequest := &velerov1.DownloadRequest{}
+		gomega.Expect(fakeClient.Get(context.Background(), types.NamespacedName{Name: resultsNACUUID, Namespace: resultsOADP}, downloadRequest)).To(gomega.Succeed())
+		gomega.Expect(downloadRequest.Spec.Target).To(gomega.Equal(velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindRestoreResults, Name: resultsNACUUID}))
+
+		ginkgo.By("Waiting for Velero to process the DownloadRequest")
+		requeue, err = r.fetchRestoreErrorMessages(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+
+		ginkgo.By("Downloading the Velero Restore results")
+		downloadRequest.Status = velerov1.DownloadRequestStatus{Phase: velerov1.DownloadRequestPhaseProcessed, DownloadURL: server.URL}
+		gomega.Expect(fakeClient.Update(context.Background(), downloadRequest)).To(gomega.Succeed())
+		requeue, err = r.fetchRestoreErrorMessages(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.Results.ErrorMessages).To(gomega.Equal([]string{"namespace " + resultsNamespace + ": error restoring pods/test"}))
+		err = fakeClient.Get(context.Background(), types.NamespacedName{Name: resultsNACUUID, Namespace: resultsOADP}, downloadRequest)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	})
+})
+
+var _ = ginkgo.DescribeTable("updateNonAdminRestoreItemOperationsStatus",
+	func(status *nacv1alpha1.NonAdminRestoreStatus, veleroRestoreStatus velerov1.RestoreStatus, expected *nacv1alpha1.RestoreItemOperations, expectedUpdated bool) {
+		veleroRestore := &velerov1.Restore{Status: veleroRestoreStatus}
+
+		gomega.Expect(updateNonAdminRestoreItemOperationsStatus(status, veleroRestore)).To(gomega.Equal(expectedUpdated))
+		gomega.Expect(status.ItemOperations).To(gomega.Equal(expected))
+	},
+	ginkgo.Entry("without item operations", &nacv1alpha1.NonAdminRestoreStatus{}, velerov1.RestoreStatus{}, nil, false),
+	ginkgo.Entry("with item operations in progress", &nacv1alpha1.NonAdminRestoreStatus{},
+		velerov1.RestoreStatus{Phase: velerov1.RestorePhaseWaitingForPluginOperations, RestoreItemOperationsAttempted: 3, RestoreItemOperationsCompleted: 1},
+		&nacv1alpha1.RestoreItemOperations{Attempted: 3, Completed: 1}, true),
+	ginkgo.Entry("with failed operations already read",
+		&nacv1alpha1.NonAdminRestoreStatus{ItemOperations: &nacv1alpha1.RestoreItemOperations{Attempted: 3, Completed: 2, FailedOperations: []nacv1alpha1.FailedItemOperation{{Name: "pvc"}}}},
+		velerov1.RestoreStatus{RestoreItemOperationsAttempted: 3, RestoreItemOperationsCompleted: 2, RestoreItemOperationsFailed: 1},
+		&nacv1alpha1.RestoreItemOperations{Attempted: 3, Completed: 2, Failed: 1, FailedOperations: []nacv1alpha1.FailedItemOperation{{Name: "pvc"}}}, true),
+)
+
+var _ = ginkgo.Describe("Test NonAdminRestore item operations", func() {
+	const (
+		operationsNamespace = "test-nonadminrestore-operations"
+		operationsOADP      = "test-nonadminrestore-operations-oadp"
+		operationsNACUUID   = "test-nonadminrestore-operations-nacuuid"
+	)
+
+	ginkgo.It("should list the failed item operations", func() {
+		failedOperation := func(name string, message string) restoreItemOperation {
+			itemOperation := restoreItemOperation{}
+			itemOperation.Spec.ResourceIdentifier.Resource = "persistentvolumeclaims"
+			itemOperation.Spec.ResourceIdentifier.Namespace = operationsNamespace
+			itemOperation.Spec.ResourceIdentifier.Name = name
+			itemOperation.Status.Phase = itemOperationPhaseFailed
+			itemOperation.Status.Error = message
+			return itemOperation
+		}
+		completedOperation := restoreItemOperation{}
+		completedOperation.Status.Phase = "Completed"
+
+		gomega.Expect(failedItemOperations([]restoreItemOperation{completedOperation})).To(gomega.Equal(
+			[]nacv1alpha1.FailedItemOperation{{Error: "Velero Restore item operations have no failed operation"}}))
+		gomega.Expect(failedItemOperations([]restoreItemOperation{completedOperation, failedOperation("data", strings.Repeat("e", maxRestoreErrorMessageLength+1))})).To(gomega.Equal(
+			[]nacv1alpha1.FailedItemOperation{{
+				Resource:  "persistentvolumeclaims",
+				Namespace: operationsNamespace,
+				Name:      "data",
+				Error:     strings.Repeat("e", maxRestoreErrorMessageLength-len("...")) + "...",
+			}}))
+		manyOperations := []restoreItemOperation{}
+		for index := range maxRestoreErrorMessages + 1 {
+			manyOperations = append(manyOperations, failedOperation(fmt.Sprintf("data-%d", index), "failed"))
+		}
+		gomega.Expect(failedItemOperations(manyOperations)).To(gomega.HaveLen(maxRestoreErrorMessages))
+	})
+
+	ginkgo.It("should read the failed item operations from the Velero Restore item operations", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			gzipWriter := gzip.NewWriter(writer)
+			_, err := gzipWriter.Write([]byte(`[{"spec":{"resourceIdentifier":{"Group":"","Resource":"persistentvolumeclaims","Namespace":"` + operationsNamespace +
+				`","Name":"data"}},"status":{"phase":"Failed","error":"data mover restore failed"}}]`))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(gzipWriter.Close()).To(gomega.Succeed())
+		}))
+		defer server.Close()
+
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-operations", Namespace: operationsNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{
+					NACUUID: operationsNACUUID,
+					Name:    operationsNACUUID,
+					Status:  &velerov1.RestoreStatus{Phase: velerov1.RestorePhasePartiallyFailed, CompletionTimestamp: &metav1.Time{Time: time.Now()}},
+				},
+				ItemOperations: &nacv1alpha1.RestoreItemOperations{Attempted: 1, Failed: 1},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nar).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: operationsOADP, FetchRestoreResults: true, httpClient: server.Client()}
+		downloadRequestName := types.NamespacedName{Name: operationsNACUUID + itemOperationsDownloadRequestSuffix, Namespace: operationsOADP}
+
+		ginkgo.By("Creating a DownloadRequest for the Velero Restore item operations")
+		requeue, err := r.fetchFailedItemOperations(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		downloadRequest := &velerov1.DownloadRequest{}
+		gomega.Expect(fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)).To(gomega.Succeed())
+		gomega.Expect(downloadRequest.Spec.Target).To(gomega.Equal(velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindRestoreItemOperations, Name: operationsNACUUID}))
+
+		ginkgo.By("Downloading the Velero Restore item operations")
+		downloadRequest.Status = velerov1.DownloadRequestStatus{Phase: velerov1.DownloadRequestPhaseProcessed, DownloadURL: server.URL}
+		gomega.Expect(fakeClient.Update(context.Background(), downloadRequest)).To(gomega.Succeed())
+		requeue, err = r.fetchFailedItemOperations(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.ItemOperations.FailedOperations).To(gomega.Equal([]nacv1alpha1.FailedItemOperation{{
+			Resource:  "persistentvolumeclaims",
+			Namespace: operationsNamespace,
+			Name:      "data",
+			Error:     "data mover restore failed",
+		}}))
+		err = fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore restored resource inventory", func() {
+	const (
+		inventoryNamespace = "test-nonadminrestore-inventory"
+		inventoryOADP      = "test-nonadminrestore-inventory-oadp"
+		inventoryNACUUID   = "test-nonadminrestore-inventory-nacuuid"
+	)
+
+	ginkgo.It("should count the restored resources per kind", func() {
+		gomega.Expect(restoredResourceInventory(map[string][]string{})).To(gomega.Equal(&nacv1alpha1.RestoreInventory{}))
+		gomega.Expect(restoredResourceInventory(map[string][]string{
+			"v1/ConfigMap": {
+				inventoryNamespace + "/config(created)",
+				inventoryNamespace + "/existing(skipped)",
+				inventoryNamespace + "/patched(updated)",
+			},
+			"apps/v1/Deployment":  {inventoryNamespace + "/app(failed)"},
+			"v1/PersistentVolume": {"pv-data(created)"},
+		})).To(gomega.Equal(&nacv1alpha1.RestoreInventory{
+			Resources: []nacv1alpha1.RestoredResourceCount{
+				{Kind: "apps/v1/Deployment", Failed: 1},
+				{Kind: "v1/ConfigMap", Created: 1, Updated: 1, Skipped: 1},
+				{Kind: "v1/PersistentVolume", Created: 1},
+			},
+			SkippedResources: []nacv1alpha1.InventoryResource{{Kind: "v1/ConfigMap", Namespace: inventoryNamespace, Name: "existing"}},
+			FailedResources:  []nacv1alpha1.InventoryResource{{Kind: "apps/v1/Deployment", Namespace: inventoryNamespace, Name: "app"}},
+		}))
+
+		manyResources := []string{}
+		for index := range maxInventorySkippedResources + 1 {
+			manyResources = append(manyResources, fmt.Sprintf("%s/config-%d(skipped)", inventoryNamespace, index),
+				fmt.Sprintf("%s/secret-%d(failed)", inventoryNamespace, index))
+		}
+		inventory := restoredResourceInventory(map[string][]string{"v1/ConfigMap": manyResources})
+		gomega.Expect(inventory.Resources).To(gomega.Equal([]nacv1alpha1.RestoredResourceCount{
+			{Kind: "v1/ConfigMap", Skipped: maxInventorySkippedResources + 1, Failed: maxInventorySkippedResources + 1},
+		}))
+		gomega.Expect(inventory.SkippedResources).To(gomega.HaveLen(maxInventorySkippedResources))
+		gomega.Expect(inventory.FailedResources).To(gomega.HaveLen(maxRestoreErrorMessages))
+	})
+
+	ginkgo.It("should read the inventory from the Velero Restore resource list", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			gzipWriter := gzip.NewWriter(writer)
+			_, err := gzipWriter.Write([]byte(`{"v1/ConfigMap":["` + inventoryNamespace + `/config(created)","` + inventoryNamespace + `/existing(skipped)"]}`))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(gzipWriter.Close()).To(gomega.Succeed())
+		}))
+		defer server.Close()
+
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-inventory", Namespace: inventoryNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{
+					NACUUID: inventoryNACUUID,
+					Name:    inventoryNACUUID,
+					Status:  &velerov1.RestoreStatus{Phase: velerov1.RestorePhaseFailed, CompletionTimestamp: &metav1.Time{Time: time.Now()}},
+				},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nar).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: inventoryOADP, FetchRestoreResults: true, httpClient: server.Client()}
+		downloadRequestName := types.NamespacedName{Name: inventoryNACUUID + resourceListDownloadRequestSuffix, Namespace: inventoryOADP}
+
+		ginkgo.By("Skipping the Velero Restore that failed before restoring resources")
+		requeue, err := r.fetchRestoredResourceInventory(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		downloadRequest := &velerov1.DownloadRequest{}
+		err = fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+		ginkgo.By("Creating a DownloadRequest for the Velero Restore resource list")
+		nar.Status.VeleroRestore.Status.Phase = velerov1.RestorePhaseCompleted
+		requeue, err = r.fetchRestoredResourceInventory(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)).To(gomega.Succeed())
+		gomega.Expect(downloadRequest.Spec.Target).To(gomega.Equal(velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindRestoreResourceList, Name: inventoryNACUUID}))
+
+		ginkgo.By("Downloading the Velero Restore resource list")
+		downloadRequest.Status = velerov1.DownloadRequestStatus{Phase: velerov1.DownloadRequestPhaseProcessed, DownloadURL: server.URL}
+		gomega.Expect(fakeClient.Update(context.Background(), downloadRequest)).To(gomega.Succeed())
+		requeue, err = r.fetchRestoredResourceInventory(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.Inventory).To(gomega.Equal(&nacv1alpha1.RestoreInventory{
+			Resources:        []nacv1alpha1.RestoredResourceCount{{Kind: "v1/ConfigMap", Created: 1, Skipped: 1}},
+			SkippedResources: []nacv1alpha1.InventoryResource{{Kind: "v1/ConfigMap", Namespace: inventoryNamespace, Name: "existing"}},
+		}))
+		err = fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore of a shared Velero Backup", func() {
+	const (
+		sharedNamespace = "test-nonadminrestore-shared"
+		sharedOADP      = "test-nonadminrestore-shared-oadp"
+		sharedBackup    = "test-nonadminrestore-shared-backup"
+	)
+
+	ginkgo.It("should restore the shared Velero Backup source namespace into the NonAdminRestore namespace", func() {
+		veleroBackup := &velerov1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        sharedBackup,
+				Namespace:   sharedOADP,
+				Labels:      map[string]string{constant.SharedWithNamespaceLabel: sharedNamespace},
+				Annotations: map[string]string{constant.SharedSourceNamespaceAnnotation: "source"},
+			},
+		}
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-shared", Namespace: sharedNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{
+				BackupName:       sharedBackup,
+				NamespaceMapping: map[string]string{sharedNamespace: "target"},
+			}},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(veleroBackup).Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: sharedOADP}
+
+		veleroBackupName, sourceNamespace, err := r.getVeleroBackupToRestore(context.Background(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(veleroBackupName).To(gomega.Equal(sharedBackup))
+		gomega.Expect(sourceNamespace).To(gomega.Equal("source"))
+		gomega.Expect(restoreTargetNamespace(nar)).To(gomega.Equal("target"))
+
+		ginkgo.By("Not resolving a Velero Backup shared with another namespace")
+		veleroBackup.Labels[constant.SharedWithNamespaceLabel] = "other"
+		gomega.Expect(fakeClient.Update(context.Background(), veleroBackup)).To(gomega.Succeed())
+		_, _, err = r.getVeleroBackupToRestore(context.Background(), nar)
+		gomega.Expect(err).To(gomega.MatchError("Velero Backup with NACUUID " + sharedBackup + " not found"))
+
+		ginkgo.By("Not resolving a Velero Backup created for another namespace")
+		labels := function.GetNonAdminLabels()
+		labels[constant.NabOriginNACUUIDLabel] = sharedBackup
+		otherVeleroBackup := &velerov1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-nonadminrestore-other-backup",
+				Namespace:   sharedOADP,
+				Labels:      labels,
+				Annotations: map[string]string{constant.NabOriginNamespaceAnnotation: "other"},
+			},
+		}
+		gomega.Expect(fakeClient.Create(context.Background(), otherVeleroBackup)).To(gomega.Succeed())
+		_, _, err = r.getVeleroBackupToRestore(context.Background(), nar)
+		gomega.Expect(err).To(gomega.MatchError("Velero Backup with NACUUID " + sharedBackup + " was not created for namespace " + sharedNamespace))
+	})
+})
+
+var _ = ginkgo.DescribeTable("restoreRetryAfter",
+	func(retryPolicy *nacv1alpha1.RestoreRetryPolicy, phase velerov1.RestorePhase, attempts int, expectedRetryAfter time.Duration, expectedRetries bool) {
+		now := time.Now()
+		nar := &nacv1alpha1.NonAdminRestore{
+			Spec: nacv1alpha1.NonAdminRestoreSpec{RetryPolicy: retryPolicy},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{
+					Status: &velerov1.RestoreStatus{Phase: phase, CompletionTimestamp: &metav1.Time{Time: now.Add(-10 * time.Second)}},
+				},
+				Retries: &nacv1alpha1.RestoreRetries{Attempts: attempts},
+			},
+		}
+
+		retryAfter, retries := restoreRetryAfter(nar, now)
+		gomega.Expect(retries).To(gomega.Equal(expectedRetries))
+		gomega.Expect(retryAfter).To(gomega.Equal(expectedRetryAfter))
+	},
+	ginkgo.Entry("without retry policy", nil, velerov1.RestorePhaseFailed, 0, time.Duration(0), false),
+	ginkgo.Entry("with a completed Velero Restore", &nacv1alpha1.RestoreRetryPolicy{MaxRetries: 1}, velerov1.RestorePhaseCompleted, 0, time.Duration(0), false),
+	ginkgo.Entry("with a failed Velero Restore and the default backoff", &nacv1alpha1.RestoreRetryPolicy{MaxRetries: 1}, velerov1.RestorePhaseFailed, 0, 50*time.Second, true),
+	ginkgo.Entry("with a Velero Restore failing validation after the backoff", &nacv1alpha1.RestoreRetryPolicy{MaxRetries: 2, BackoffSeconds: ptr.To[int64](5)}, velerov1.RestorePhaseFailedValidation, 1, time.Duration(0), true),
+	ginkgo.Entry("with all retries attempted", &nacv1alpha1.RestoreRetryPolicy{MaxRetries: 2}, velerov1.RestorePhaseFailed, 2, time.Duration(0), false),
+)
+
+var _ = ginkgo.DescribeTable("nonAdminPhaseForVeleroRestore",
+	func(veleroPhase velerov1.RestorePhase, expected nacv1alpha1.NonAdminPhase) {
+		veleroRestore := &velerov1.Restore{Status: velerov1.RestoreStatus{Phase: veleroPhase}}
+		gomega.Expect(nonAdminPhaseForVeleroRestore(veleroRestore)).To(gomega.Equal(expected))
+	},
+	ginkgo.Entry("without phase", velerov1.RestorePhase(""), nacv1alpha1.NonAdminPhaseCreated),
+	ginkgo.Entry("in progress", velerov1.RestorePhaseInProgress, nacv1alpha1.NonAdminPhaseCreated),
+	ginkgo.Entry("waiting for plugin operations", velerov1.RestorePhaseWaitingForPluginOperations, nacv1alpha1.NonAdminPhaseCreated),
+	ginkgo.Entry("completed", velerov1.RestorePhaseCompleted, nacv1alpha1.NonAdminPhaseCompleted),
+	ginkgo.Entry("partially failed", velerov1.RestorePhasePartiallyFailed, nacv1alpha1.NonAdminPhasePartiallyFailed),
+	ginkgo.Entry("failed", velerov1.RestorePhaseFailed, nacv1alpha1.NonAdminPhaseFailed),
+	ginkgo.Entry("failed validation", velerov1.RestorePhaseFailedValidation, nacv1alpha1.NonAdminPhaseFailed),
+)
+
+var _ = ginkgo.DescribeTable("updateNonAdminRestoreAppliedOptionsStatus",
+	func(restoreSpec velerov1.RestoreSpec, expected *nacv1alpha1.AppliedRestoreOptions) {
+		status := &nacv1alpha1.NonAdminRestoreStatus{AppliedOptions: &nacv1alpha1.AppliedRestoreOptions{RestorePVs: true}}
+		veleroRestore := &velerov1.Restore{Spec: restoreSpec}
+
+		gomega.Expect(updateNonAdminRestoreAppliedOptionsStatus(status, veleroRestore)).To(gomega.Equal(!reflect.DeepEqual(expected, &nacv1alpha1.AppliedRestoreOptions{RestorePVs: true})))
+		gomega.Expect(status.AppliedOptions).To(gomega.Equal(expected))
+	},
+	ginkgo.Entry("with Velero defaults", velerov1.RestoreSpec{}, &nacv1alpha1.AppliedRestoreOptions{RestorePVs: true}),
+	ginkgo.Entry("with set options",
+		velerov1.RestoreSpec{
+			RestorePVs:        ptr.To(false),
+			PreserveNodePorts: ptr.To(true),
+			UploaderConfig:    &velerov1.UploaderConfigForRestore{WriteSparseFiles: ptr.To(true), ParallelFilesDownload: 4},
+		},
+		&nacv1alpha1.AppliedRestoreOptions{PreserveNodePorts: true, WriteSparseFiles: true, ParallelFilesDownload: 4}),
+)
+
+var _ = ginkgo.Describe("Test NonAdminRestore enforced fields", func() {
+	ginkgo.It("should set the fields enforced on the namespace selected by the restore enforcement ConfigMap", func() {
+		const (
+			enforcedNamespace = "test-nonadminrestore-enforced"
+			enforcedOADP      = "test-nonadminrestore-enforced-oadp"
+		)
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: enforcedNamespace, Labels: map[string]string{"tier": "production"}},
+		}
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "restore-enforcement", Namespace: enforcedOADP},
+			Data: map[string]string{"rules.yaml": `- namespaceSelector:
+    matchLabels:
+      tier: production
+  restoreSpec:
+    restorePVs: false
+`},
+		}
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-enforced", Namespace: enforcedNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{PreserveNodePorts: ptr.To(false)},
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: "test-nonadminrestore-enforced-nacuuid"},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(namespace, configMap).Build()
+		r := &NonAdminRestoreReconciler{
+			Client:                      fakeClient,
+			OADPNamespace:               enforcedOADP,
+			EnforcedRestoreSpec:         &velerov1.RestoreSpec{PreserveNodePorts: ptr.To(true)},
+			RestoreEnforcementConfigMap: configMap.Name,
+		}
+
+		restoreSpec, enforcedFields, err := r.veleroRestoreSpec(context.Background(), nar, "test-backup", enforcedNamespace)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(restoreSpec.RestorePVs).To(gomega.Equal(ptr.To(false)))
+		gomega.Expect(restoreSpec.PreserveNodePorts).To(gomega.Equal(ptr.To(false)))
+		gomega.Expect(enforcedFields).To(gomega.Equal([]string{"restorePVs"}))
+
+		ginkgo.By("Listing the enforced fields in the NonAdminRestore status")
+		gomega.Expect(updateNonAdminRestoreEnforcedFieldsStatus(&nar.Status, enforcedFields)).To(gomega.BeTrue())
+		gomega.Expect(nar.Status.EnforcedFields).To(gomega.Equal([]string{"restorePVs"}))
+		condition := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Message).To(gomega.Equal("spec.restoreSpec fields set or overridden in the Velero Restore: restorePVs"))
+		gomega.Expect(updateNonAdminRestoreEnforcedFieldsStatus(&nar.Status, nil)).To(gomega.BeTrue())
+		gomega.Expect(meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden))).To(gomega.BeNil())
+	})
+})
+
+var _ = ginkgo.DescribeTable("validateParallelFilesDownload",
+	func(maxParallelFilesDownload int, uploaderConfig *velerov1.UploaderConfigForRestore, expectError bool) {
+		r := &NonAdminRestoreReconciler{MaxParallelFilesDownload: maxParallelFilesDownload}
+		err := r.validateParallelFilesDownload(&nacv1alpha1.NonAdminRestore{
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{UploaderConfig: uploaderConfig},
+			},
+		})
+		if expectError {
+			gomega.Expect(err).To(gomega.MatchError("NonAdminRestore spec.restoreSpec.uploaderConfig.parallelFilesDownload is restricted, can not exceed 4"))
+		} else {
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		}
+	},
+	ginkgo.Entry("without maximum", 0, &velerov1.UploaderConfigForRestore{ParallelFilesDownload: 32}, false),
+	ginkgo.Entry("without uploader config", 4, nil, false),
+	ginkgo.Entry("without parallel files download", 4, &velerov1.UploaderConfigForRestore{WriteSparseFiles: ptr.To(true)}, false),
+	ginkgo.Entry("within maximum", 4, &velerov1.UploaderConfigForRestore{ParallelFilesDownload: 4}, false),
+	ginkgo.Entry("exceeding maximum", 4, &velerov1.UploaderConfigForRestore{ParallelFilesDownload: 5}, true),
+)
+
+var _ = ginkgo.Describe("Test NonAdminRestore parallel files download", func() {
+	ginkgo.It("should set the maximum parallel files download on NonAdminRestores not setting it", func() {
+		const parallelNamespace = "test-nonadminrestore-parallel"
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-parallel", Namespace: parallelNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{},
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: "test-nonadminrestore-parallel-nacuuid"},
+			},
+		}
+		enforcedRestoreSpec := &velerov1.RestoreSpec{
+			UploaderConfig: &velerov1.UploaderConfigForRestore{WriteSparseFiles: ptr.To(true)},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).Build()
+		r := &NonAdminRestoreReconciler{
+			Client:                   fakeClient,
+			EnforcedRestoreSpec:      enforcedRestoreSpec,
+			MaxParallelFilesDownload: 4,
+		}
+
+		restoreSpec, enforcedFields, err := r.veleroRestoreSpec(context.Background(), nar, "test-backup", parallelNamespace)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(restoreSpec.UploaderConfig).To(gomega.Equal(&velerov1.UploaderConfigForRestore{WriteSparseFiles: ptr.To(true), ParallelFilesDownload: 4}))
+		gomega.Expect(enforcedFields).To(gomega.Equal([]string{"uploaderConfig.writeSparseFiles", "uploaderConfig.parallelFilesDownload"}))
+		gomega.Expect(enforcedRestoreSpec.UploaderConfig.ParallelFilesDownload).To(gomega.BeZero())
+
+		ginkgo.By("Keeping the parallel files download set by the NonAdminRestore")
+		nar.Spec.RestoreSpec.UploaderConfig = &velerov1.UploaderConfigForRestore{ParallelFilesDownload: 2}
+		restoreSpec, enforcedFields, err = r.veleroRestoreSpec(context.Background(), nar, "test-backup", parallelNamespace)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(restoreSpec.UploaderConfig).To(gomega.Equal(&velerov1.UploaderConfigForRestore{ParallelFilesDownload: 2}))
+		gomega.Expect(enforcedFields).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore denied resources", func() {
+	ginkgo.It("should exclude the denied resources from the Velero Restore", func() {
+		const deniedNamespace = "test-nonadminrestore-denied"
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        deniedNamespace,
+				Annotations: map[string]string{constant.RestoreDeniedResourcesAnnotation: "serviceaccounts"},
+			},
+		}
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-denied", Namespace: deniedNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{ExcludedResources: []string{"secrets"}},
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: "test-nonadminrestore-denied-nacuuid"},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(namespace).Build()
+		r := &NonAdminRestoreReconciler{
+			Client:              fakeClient,
+			EnforcedRestoreSpec: &velerov1.RestoreSpec{},
+			DeniedResources:     []string{"secrets"},
+		}
+
+		restoreSpec, enforcedFields, err := r.veleroRestoreSpec(context.Background(), nar, "test-backup", deniedNamespace)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(restoreSpec.ExcludedResources).To(gomega.Equal([]string{"secrets", "volumesnapshotclasses", "serviceaccounts"}))
+		gomega.Expect(enforcedFields).To(gomega.Equal([]string{"excludedResources"}))
+		gomega.Expect(nar.Spec.RestoreSpec.ExcludedResources).To(gomega.Equal([]string{"secrets"}))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore volume selector", func() {
+	ginkgo.It("should only restore the selected PersistentVolumeClaims and their volumes", func() {
+		const volumeNamespace = "test-nonadminrestore-volume"
+		volumeSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "database"}}
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-volume", Namespace: volumeNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec:    &velerov1.RestoreSpec{},
+				VolumeSelector: volumeSelector,
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: "test-nonadminrestore-volume-nacuuid"},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).Build()
+		r := &NonAdminRestoreReconciler{
+			Client:              fakeClient,
+			EnforcedRestoreSpec: &velerov1.RestoreSpec{},
+		}
+
+		restoreSpec, _, err := r.veleroRestoreSpec(context.Background(), nar, "test-backup", volumeNamespace)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(restoreSpec.IncludedNamespaces).To(gomega.Equal([]string{volumeNamespace}))
+		gomega.Expect(restoreSpec.IncludedResources).To(gomega.Equal([]string{
+			"persistentvolumeclaims",
+			"persistentvolumes",
+			"volumesnapshots.snapshot.storage.k8s.io",
+			"volumesnapshotcontents.snapshot.storage.k8s.io",
+		}))
+		gomega.Expect(restoreSpec.LabelSelector).To(gomega.Equal(volumeSelector))
+		gomega.Expect(nar.Spec.RestoreSpec.IncludedResources).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore preview", func() {
+	const (
+		previewNamespace = "test-nonadminrestore-preview"
+		previewOADP      = "test-nonadminrestore-preview-oadp"
+		previewNACUUID   = "test-nonadminrestore-preview-nacuuid"
+		previewBackup    = "test-nonadminrestore-preview-backup"
+	)
+
+	ginkgo.It("should list the resources the Velero Restore would restore", func() {
+		resourceList := map[string][]string{
+			"apps/v1/Deployment":       {previewNamespace + "/app", "other/app"},
+			"v1/Event":                 {previewNamespace + "/app.1"},
+			"v1/PersistentVolume":      {"pv"},
+			"v1/PersistentVolumeClaim": {previewNamespace + "/data"},
+			"v1/Secret":                {previewNamespace + "/credentials"},
+			"snapshot.storage.k8s.io/v1/VolumeSnapshot": {previewNamespace + "/snapshot"},
+		}
+		mapper := meta.NewDefaultRESTMapper(nil)
+		restoreSpec := &velerov1.RestoreSpec{
+			IncludedNamespaces: []string{previewNamespace},
+			ExcludedResources:  []string{"secrets", "volumesnapshots.snapshot.storage.k8s.io"},
+			NamespaceMapping:   map[string]string{previewNamespace: "mapped"},
+		}
+		gomega.Expect(previewRestoreResources(resourceList, restoreSpec, mapper)).To(gomega.Equal(&nacv1alpha1.RestorePreview{
+			Resources: []nacv1alpha1.PreviewResource{
+				{Resource: "deployments.apps", Namespace: "mapped", Name: "app"},
+				{Resource: "persistentvolumeclaims", Namespace: "mapped", Name: "data"},
+			},
+			Total: 2,
+		}))
+
+		restoreSpec = &velerov1.RestoreSpec{
+			IncludedNamespaces: []string{previewNamespace},
+			IncludedResources:  []string{"PersistentVolumeClaim", "deployments.extensions"},
+		}
+		gomega.Expect(previewRestoreResources(resourceList, restoreSpec, mapper)).To(gomega.Equal(&nacv1alpha1.RestorePreview{
+			Resources: []nacv1alpha1.PreviewResource{
+				{Resource: "persistentvolumeclaims", Namespace: previewNamespace, Name: "data"},
+			},
+			Total: 1,
+		}))
+
+		manyResources := map[string][]string{"v1/ConfigMap": {}}
+		for index := range maxPreviewResources + 1 {
+			manyResources["v1/ConfigMap"] = append(manyResources["v1/ConfigMap"], fmt.Sprintf("%s/config-%d", previewNamespace, index))
+		}
+		preview := previewRestoreResources(manyResources, &velerov1.RestoreSpec{IncludedNamespaces: []string{previewNamespace}}, mapper)
+		gomega.Expect(preview.Resources).To(gomega.HaveLen(maxPreviewResources))
+		gomega.Expect(preview.Total).To(gomega.Equal(maxPreviewResources + 1))
+	})
+
+	ginkgo.It("should read the preview from the Velero Backup resource list", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			gzipWriter := gzip.NewWriter(writer)
+			_, err := gzipWriter.Write([]byte(`{"v1/ConfigMap":["` + previewNamespace + `/config"],"v1/Namespace":["` + previewNamespace + `"]}`))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(gzipWriter.Close()).To(gomega.Succeed())
+		}))
+		defer server.Close()
+
+		nab := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminbackup-preview", Namespace: previewNamespace},
+			Status:     nacv1alpha1.NonAdminBackupStatus{VeleroBackup: &nacv1alpha1.VeleroBackup{Name: previewBackup}},
+		}
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-preview", Namespace: previewNamespace, Generation: 1},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{BackupName: nab.Name},
+				Preview:     true,
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: previewNACUUID, Name: previewNACUUID},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nab, nar).
+			Build()
+		r := &NonAdminRestoreReconciler{
+			Client:              fakeClient,
+			OADPNamespace:       previewOADP,
+			EnforcedRestoreSpec: &velerov1.RestoreSpec{},
+			httpClient:          server.Client(),
+		}
+		downloadRequestName := types.NamespacedName{Name: previewNACUUID + previewDownloadRequestSuffix, Namespace: previewOADP}
+
+		ginkgo.By("Creating a DownloadRequest for the Velero Backup resource list")
+		requeue, err := r.previewVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		downloadRequest := &velerov1.DownloadRequest{}
+		gomega.Expect(fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)).To(gomega.Succeed())
+		gomega.Expect(downloadRequest.Spec.Target).To(gomega.Equal(velerov1.DownloadTarget{Kind: velerov1.DownloadTargetKindBackupResourceList, Name: previewBackup}))
+
+		ginkgo.By("Downloading the Velero Backup resource list")
+		downloadRequest.Status = velerov1.DownloadRequestStatus{Phase: velerov1.DownloadRequestPhaseProcessed, DownloadURL: server.URL}
+		gomega.Expect(fakeClient.Update(context.Background(), downloadRequest)).To(gomega.Succeed())
+		requeue, err = r.previewVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.Preview).To(gomega.Equal(&nacv1alpha1.RestorePreview{
+			Resources:          []nacv1alpha1.PreviewResource{{Resource: "configmaps", Namespace: previewNamespace, Name: "config"}},
+			Total:              1,
+			ObservedGeneration: 1,
+		}))
+		gomega.Expect(nar.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCompleted))
+		gomega.Expect(meta.IsStatusConditionTrue(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionPreviewed))).To(gomega.BeTrue())
+		err = fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+		ginkgo.By("Not previewing again until the spec changes")
+		requeue, err = r.previewVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		err = fakeClient.Get(context.Background(), downloadRequestName, downloadRequest)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore retry policy", func() {
+	const (
+		retryNamespace = "test-nonadminrestore-retry"
+		retryOADP      = "test-nonadminrestore-retry-oadp"
+		retryNACUUID   = "test-nonadminrestore-retry-nacuuid"
+	)
+
+	ginkgo.It("should replace the failed Velero Restore with a new one", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-retry", Namespace: retryNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{},
+				RetryPolicy: &nacv1alpha1.RestoreRetryPolicy{MaxRetries: 1, BackoffSeconds: ptr.To[int64](0)},
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{
+					NACUUID: retryNACUUID,
+					Name:    retryNACUUID,
+					Status: &velerov1.RestoreStatus{
+						Phase:               velerov1.RestorePhaseFailed,
+						FailureReason:       "backup storage location is unavailable",
+						CompletionTimestamp: &metav1.Time{Time: time.Now()},
+					},
+				},
+				Conditions: []metav1.Condition{{Type: string(nacv1alpha1.NonAdminConditionQueued), Status: metav1.ConditionTrue, Reason: "RestoreScheduled"}},
+			},
+		}
+		veleroRestore := &velerov1.Restore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      retryNACUUID,
+				Namespace: retryOADP,
+				Labels:    function.GetNonAdminRestoreLabels(retryNACUUID),
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nar, veleroRestore).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: retryOADP}
+
+		requeue, err := r.retryFailedVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(nar.Status.VeleroRestore.NACUUID).NotTo(gomega.Equal(retryNACUUID))
+		gomega.Expect(nar.Status.VeleroRestore.Status).To(gomega.BeNil())
+		gomega.Expect(nar.Status.Retries.Attempts).To(gomega.Equal(1))
+		gomega.Expect(nar.Status.Retries.LastError).To(gomega.Equal("backup storage location is unavailable"))
+		gomega.Expect(meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued))).To(gomega.BeNil())
+		err = fakeClient.Get(context.Background(), types.NamespacedName{Name: retryNACUUID, Namespace: retryOADP}, veleroRestore)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+		ginkgo.By("Not retrying once all retries were attempted")
+		nar.Status.VeleroRestore.Status = &velerov1.RestoreStatus{Phase: velerov1.RestorePhaseFailed, CompletionTimestamp: &metav1.Time{Time: time.Now()}}
+		requeue, err = r.retryFailedVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.Retries.Attempts).To(gomega.Equal(1))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore deletion", func() {
+	const (
+		deletionNamespace = "test-nonadminrestore-deletion"
+		deletionOADP      = "test-nonadminrestore-deletion-oadp"
+		deletionNACUUID   = "test-nonadminrestore-deletion-nacuuid"
+	)
+
+	ginkgo.It("should delete the Velero Restore dependent objects before removing the finalizer", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-nonadminrestore-deletion",
+				Namespace:  deletionNamespace,
+				Finalizers: []string{constant.NarFinalizerName},
+			},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: deletionNACUUID, Name: deletionNACUUID},
+			},
+		}
+		restoreLabels := map[string]string{velerov1.RestoreNameLabel: deletionNACUUID}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithObjects(
+				nar,
+				&velerov2alpha1.DataDownload{
+					ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: deletionOADP, Labels: restoreLabels},
+					Status:     velerov2alpha1.DataDownloadStatus{Phase: velerov2alpha1.DataDownloadPhaseInProgress},
+				},
+				&velerov2alpha1.DataDownload{
+					ObjectMeta: metav1.ObjectMeta{Name: "completed", Namespace: deletionOADP, Labels: restoreLabels},
+					Status:     velerov2alpha1.DataDownloadStatus{Phase: velerov2alpha1.DataDownloadPhaseCompleted},
+				},
+				&velerov1.PodVolumeRestore{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-volume", Namespace: deletionOADP, Labels: restoreLabels},
+				},
+				&velerov1.DownloadRequest{
+					ObjectMeta: metav1.ObjectMeta{Name: deletionNACUUID, Namespace: deletionOADP},
+				},
+			).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: deletionOADP}
+
+		ginkgo.By("Deleting the dependent objects")
+		requeue, err := r.deleteVeleroRestoreDependents(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		dataDownloads := &velerov2alpha1.DataDownloadList{}
+		gomega.Expect(fakeClient.List(context.Background(), dataDownloads)).To(gomega.Succeed())
+		gomega.Expect(dataDownloads.Items).To(gomega.BeEmpty())
+		podVolumeRestores := &velerov1.PodVolumeRestoreList{}
+		gomega.Expect(fakeClient.List(context.Background(), podVolumeRestores)).To(gomega.Succeed())
+		gomega.Expect(podVolumeRestores.Items).To(gomega.BeEmpty())
+		err = fakeClient.Get(context.Background(), types.NamespacedName{Name: deletionNACUUID, Namespace: deletionOADP}, &velerov1.DownloadRequest{})
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+		ginkgo.By("Not requeueing once the dependent objects are removed")
+		requeue, err = r.deleteVeleroRestoreDependents(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+
+		ginkgo.By("Removing the finalizer once the Velero Restore is removed")
+		_, err = r.deleteVeleroRestoreAndRemoveFinalizer(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(nar.Finalizers).To(gomega.BeEmpty())
+	})
+
+	ginkgo.It("should remove the finalizer of a NonAdminRestore without Velero Restore", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-nonadminrestore-deletion-without-uuid",
+				Namespace:  deletionNamespace,
+				Finalizers: []string{constant.NarFinalizerName},
+			},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+		}
+		fakeClient := fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).WithObjects(nar).Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: deletionOADP}
+
+		_, err := r.deleteVeleroRestoreAndRemoveFinalizer(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(nar.Finalizers).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore cancel", func() {
+	const (
+		cancelNamespace = "test-nonadminrestore-cancel"
+		cancelOADP      = "test-nonadminrestore-cancel-oadp"
+		cancelNACUUID   = "test-nonadminrestore-cancel-nacuuid"
+	)
+
+	ginkgo.It("should cancel the running DataDownloads of the Velero Restore", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-cancel", Namespace: cancelNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}, Cancel: true},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				Phase:         nacv1alpha1.NonAdminPhaseCreated,
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: cancelNACUUID, Name: cancelNACUUID},
+				Conditions: []metav1.Condition{
+					{Type: string(nacv1alpha1.NonAdminConditionQueued), Status: metav1.ConditionTrue, Reason: "RestoreScheduled"},
+				},
+			},
+		}
+		restoreLabels := map[string]string{velerov1.RestoreNameLabel: cancelNACUUID}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(
+				nar,
+				&velerov2alpha1.DataDownload{
+					ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: cancelOADP, Labels: restoreLabels},
+					Status:     velerov2alpha1.DataDownloadStatus{Phase: velerov2alpha1.DataDownloadPhaseInProgress},
+				},
+				&velerov2alpha1.DataDownload{
+					ObjectMeta: metav1.ObjectMeta{Name: "completed", Namespace: cancelOADP, Labels: restoreLabels},
+					Status:     velerov2alpha1.DataDownloadStatus{Phase: velerov2alpha1.DataDownloadPhaseCompleted},
+				},
+			).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: cancelOADP}
+
+		_, err := r.cancelVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(nar.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCanceled))
+		condition := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionCanceled))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Message).To(gomega.Equal("restore canceled, the data downloads of its Velero Restore were cancelled"))
+
+		dataDownload := &velerov2alpha1.DataDownload{}
+		gomega.Expect(fakeClient.Get(context.Background(), types.NamespacedName{Name: "running", Namespace: cancelOADP}, dataDownload)).To(gomega.Succeed())
+		gomega.Expect(dataDownload.Spec.Cancel).To(gomega.BeTrue())
+		gomega.Expect(fakeClient.Get(context.Background(), types.NamespacedName{Name: "completed", Namespace: cancelOADP}, dataDownload)).To(gomega.Succeed())
+		gomega.Expect(dataDownload.Spec.Cancel).To(gomega.BeFalse())
+
+		ginkgo.By("Staying canceled once spec.cancel is unset")
+		nar.Spec.Cancel = false
+		gomega.Expect(isNonAdminRestoreCanceled(nar)).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("should cancel a NonAdminRestore whose Velero Restore was not created", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-cancel-not-created", Namespace: cancelNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}, Cancel: true},
+			Status:     nacv1alpha1.NonAdminRestoreStatus{Phase: nacv1alpha1.NonAdminPhaseNew},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nar).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: cancelOADP}
+
+		_, err := r.cancelVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(nar.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCanceled))
+		condition := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionCanceled))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Message).To(gomega.Equal("restore canceled before its Velero Restore was created"))
+	})
+
+	ginkgo.It("should keep the phase of a NonAdminRestore whose Velero Restore finished", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-cancel-finished", Namespace: cancelNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{},
+				RetryPolicy: &nacv1alpha1.RestoreRetryPolicy{MaxRetries: 1},
+				Cancel:      true,
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				Phase: nacv1alpha1.NonAdminPhaseFailed,
+				VeleroRestore: &nacv1alpha1.VeleroRestore{
+					NACUUID: cancelNACUUID,
+					Name:    cancelNACUUID,
+					Status:  &velerov1.RestoreStatus{Phase: velerov1.RestorePhaseFailed},
+				},
+			},
+		}
+		r := &NonAdminRestoreReconciler{OADPNamespace: cancelOADP}
+
+		_, err := r.cancelVeleroRestore(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(nar.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseFailed))
+		gomega.Expect(nar.Status.Conditions).To(gomega.BeEmpty())
+		_, retries := restoreRetryAfter(nar, time.Now())
+		gomega.Expect(retries).To(gomega.BeFalse())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore priority", func() {
+	const (
+		productionNamespace  = "test-nonadminrestore-priority-production"
+		developmentNamespace = "test-nonadminrestore-priority-development"
+		priorityOADP         = "test-nonadminrestore-priority-oadp"
+	)
+
+	ginkgo.It("should wait for the Velero Restores of higher priority namespaces", func() {
+		veleroRestore := func(name string, phase velerov1.RestorePhase) *velerov1.Restore {
+			return &velerov1.Restore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   priorityOADP,
+					Labels:      function.GetNonAdminLabels(),
+					Annotations: map[string]string{constant.NarOriginNamespaceAnnotation: productionNamespace},
+				},
+				Status: velerov1.RestoreStatus{Phase: phase},
+			}
+		}
+		developmentRestore := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-priority", Namespace: developmentNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+		}
+		productionRestore := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-priority", Namespace: productionNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:        productionNamespace,
+					Annotations: map[string]string{constant.RestorePriorityAnnotation: "10"},
+				}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: developmentNamespace}},
+				veleroRestore("in-progress", velerov1.RestorePhaseInProgress),
+				veleroRestore("completed", velerov1.RestorePhaseCompleted),
+				developmentRestore,
+				productionRestore,
+			).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: priorityOADP}
+
+		requeue, err := r.waitForHigherPriorityRestores(context.Background(), logr.Discard(), developmentRestore)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		condition := meta.FindStatusCondition(developmentRestore.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("WaitingForHigherPriorityRestores"))
+		gomega.Expect(condition.Message).To(gomega.Equal("waiting for 1 Velero Restores of higher priority namespaces to finish"))
+
+		ginkgo.By("Not waiting for the Velero Restores of the same priority")
+		requeue, err = r.waitForHigherPriorityRestores(context.Background(), logr.Discard(), productionRestore)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(productionRestore.Status.Conditions).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore waiting for backup completion", func() {
+	const (
+		waitNamespace = "test-nonadminrestore-wait"
+		waitBackup    = "test-nonadminrestore-wait-backup"
+	)
+
+	ginkgo.It("should be Pending until the NonAdminBackup completes", func() {
+		nab := &nacv1alpha1.NonAdminBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: waitBackup, Namespace: waitNamespace},
+			Status:     nacv1alpha1.NonAdminBackupStatus{Phase: nacv1alpha1.NonAdminPhaseCreated},
+		}
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-wait", Namespace: waitNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec:             &velerov1.RestoreSpec{BackupName: waitBackup},
+				WaitForBackupCompletion: true,
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{Phase: nacv1alpha1.NonAdminPhaseNew},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}, &nacv1alpha1.NonAdminBackup{}).
+			WithObjects(nab, nar).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient}
+
+		requeue, err := r.waitForBackupCompletion(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		gomega.Expect(nar.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhasePending))
+		condition := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued))
+		gomega.Expect(condition).NotTo(gomega.BeNil())
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("WaitingForBackupCompletion"))
+		gomega.Expect(condition.Message).To(gomega.Equal("waiting for NonAdminBackup " + waitBackup + " to complete"))
+
+		ginkgo.By("Continuing once the NonAdminBackup is completed")
+		nab.Status.Phase = nacv1alpha1.NonAdminPhaseCompleted
+		gomega.Expect(fakeClient.Status().Update(context.Background(), nab)).To(gomega.Succeed())
+		requeue, err = r.waitForBackupCompletion(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("should not wait without spec.waitForBackupCompletion", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-wait", Namespace: waitNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{BackupName: waitBackup}},
+		}
+		r := &NonAdminRestoreReconciler{}
+
+		requeue, err := r.waitForBackupCompletion(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.Phase).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore spec changed after its Velero Restore was created", func() {
+	const specChangedNamespace = "test-nonadminrestore-spec-changed"
+
+	ginkgo.It("should not accept the changed spec, nor retry with it", func() {
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-spec-changed", Namespace: specChangedNamespace, Generation: 2},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{BackupName: "changed"},
+				RetryPolicy: &nacv1alpha1.RestoreRetryPolicy{MaxRetries: 1},
+			},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				Phase: nacv1alpha1.NonAdminPhaseFailed,
+				VeleroRestore: &nacv1alpha1.VeleroRestore{
+					Status: &velerov1.RestoreStatus{Phase: velerov1.RestorePhaseFailed},
+				},
+				Conditions: []metav1.Condition{
+					{Type: string(nacv1alpha1.NonAdminConditionAccepted), Status: metav1.ConditionTrue, Reason: "RestoreAccepted", ObservedGeneration: 1},
+					{Type: string(nacv1alpha1.NonAdminConditionQueued), Status: metav1.ConditionTrue, Reason: "RestoreScheduled"},
+				},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nar).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient}
+
+		requeue, err := r.validateSpec(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseFailed))
+		condition := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))
+		gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+		gomega.Expect(condition.Reason).To(gomega.Equal("SpecChangedAfterRestoreCreated"))
+		gomega.Expect(condition.ObservedGeneration).To(gomega.Equal(int64(1)))
+		_, retries := restoreRetryAfter(nar, time.Now())
+		gomega.Expect(retries).To(gomega.BeFalse())
+
+		ginkgo.By("Retrying the Velero Restore of the accepted spec")
+		nar.Generation = 1
+		_, retries = restoreRetryAfter(nar, time.Now())
+		gomega.Expect(retries).To(gomega.BeTrue())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore duplicate Velero Restores", func() {
+	const (
+		duplicateNamespace = "test-nonadminrestore-duplicate"
+		duplicateOADP      = "test-nonadminrestore-duplicate-oadp"
+		duplicateNACUUID   = "test-nonadminrestore-duplicate-nacuuid"
+	)
+
+	ginkgo.It("should keep the oldest Velero Restore and delete the others", func() {
+		veleroRestore := func(name string, created time.Time) *velerov1.Restore {
+			return &velerov1.Restore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         duplicateOADP,
+					Labels:            function.GetNonAdminRestoreLabels(duplicateNACUUID),
+					CreationTimestamp: metav1.Time{Time: created},
+				},
+			}
+		}
+		now := time.Now().Truncate(time.Second)
+		nar := &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-duplicate", Namespace: duplicateNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: duplicateNACUUID, Name: duplicateNACUUID, Namespace: duplicateOADP},
+			},
+		}
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithObjects(
+				veleroRestore(duplicateNACUUID, now.Add(time.Minute)),
+				veleroRestore("oldest", now),
+				veleroRestore("newest", now.Add(2*time.Minute)),
+			).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: duplicateOADP}
+
+		requeue, err := r.removeDuplicateVeleroRestores(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeTrue())
+		kept, err := function.GetVeleroRestoreByLabel(context.Background(), fakeClient, duplicateOADP, duplicateNACUUID)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(kept.Name).To(gomega.Equal("oldest"))
+
+		ginkgo.By("Continuing once a single Velero Restore is left")
+		requeue, err = r.removeDuplicateVeleroRestores(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore concurrent restores", func() {
+	const (
+		concurrentNamespace = "test-nonadminrestore-concurrent"
+		concurrentOADP      = "test-nonadminrestore-concurrent-oadp"
+		concurrentNACUUID   = "test-nonadminrestore-concurrent-nacuuid"
+	)
+
+	veleroRestore := func(name string, phase velerov1.RestorePhase, namespaceMapping map[string]string) *velerov1.Restore {
+		return &velerov1.Restore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: concurrentOADP,
+				Labels:    function.GetNonAdminRestoreLabels(name),
+			},
+			Spec: velerov1.RestoreSpec{
+				IncludedNamespaces: []string{"source"},
+				NamespaceMapping:   namespaceMapping,
+			},
+			Status: velerov1.RestoreStatus{Phase: phase},
+		}
+	}
+	newNonAdminRestore := func() *nacv1alpha1.NonAdminRestore {
+		return &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-concurrent", Namespace: concurrentNamespace},
+			Spec:       nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{}},
+			Status: nacv1alpha1.NonAdminRestoreStatus{
+				VeleroRestore: &nacv1alpha1.VeleroRestore{NACUUID: concurrentNACUUID, Name: concurrentNACUUID, Namespace: concurrentOADP},
+			},
+		}
+	}
+
+	ginkgo.DescribeTable("should report the Velero Restores into the same namespace in progress",
+		func(blockConcurrentRestores bool, expectedRequeue bool) {
+			nar := newNonAdminRestore()
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+				WithObjects(
+					nar,
+					veleroRestore(concurrentNACUUID, velerov1.RestorePhaseNew, map[string]string{"source": concurrentNamespace}),
+					veleroRestore("in-progress", velerov1.RestorePhaseInProgress, map[string]string{"source": concurrentNamespace}),
+					veleroRestore("completed", velerov1.RestorePhaseCompleted, map[string]string{"source": concurrentNamespace}),
+					veleroRestore("other-namespace", velerov1.RestorePhaseInProgress, nil),
+				).
+				Build()
+			r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: concurrentOADP, BlockConcurrentRestores: blockConcurrentRestores}
+
+			requeue, err := r.checkConcurrentRestores(context.Background(), logr.Discard(), nar)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(requeue).To(gomega.Equal(expectedRequeue))
+			condition := meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionConcurrentRestore))
+			gomega.Expect(condition).NotTo(gomega.BeNil())
+			gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionTrue))
+			gomega.Expect(condition.Message).To(gomega.Equal("1 other Velero Restores into namespace " + concurrentNamespace + " are in progress"))
+			gomega.Expect(meta.FindStatusCondition(nar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) != nil).To(gomega.Equal(blockConcurrentRestores))
+		},
+		ginkgo.Entry("only reporting them", false, false),
+		ginkgo.Entry("waiting for them to finish", true, true),
+	)
+
+	ginkgo.It("should not set the condition without concurrent restores", func() {
+		nar := newNonAdminRestore()
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(k8sClient.Scheme()).
+			WithStatusSubresource(&nacv1alpha1.NonAdminRestore{}).
+			WithObjects(nar, veleroRestore("completed", velerov1.RestorePhaseCompleted, map[string]string{"source": concurrentNamespace})).
+			Build()
+		r := &NonAdminRestoreReconciler{Client: fakeClient, OADPNamespace: concurrentOADP, BlockConcurrentRestores: true}
+
+		requeue, err := r.checkConcurrentRestores(context.Background(), logr.Discard(), nar)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(requeue).To(gomega.BeFalse())
+		gomega.Expect(nar.Status.Conditions).To(gomega.BeEmpty())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore namespace mapping allowed by a NonAdminPolicy", func() {
+	const policyNamespace = "test-nonadminrestore-policy-namespace-mapping"
+
+	policy := &nacv1alpha1.NonAdminPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-policy-namespace-mapping"},
+		Spec: nacv1alpha1.NonAdminPolicySpec{
+			AllowedFeatures: &nacv1alpha1.NonAdminPolicyFeatures{NamespaceMapping: ptr.To(true)},
+		},
+	}
+	// requester annotations written by the user, as no webhook records them
+	nar := &nacv1alpha1.NonAdminRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-nonadminrestore-policy-namespace-mapping",
+			Namespace: policyNamespace,
+			Annotations: map[string]string{
+				constant.NarRequesterUsernameAnnotation: "cluster-admin",
+			},
+		},
+		Spec: nacv1alpha1.NonAdminRestoreSpec{
+			RestoreSpec: &velerov1.RestoreSpec{
+				BackupName:       "backup",
+				NamespaceMapping: map[string]string{policyNamespace: "other-tenant"},
+			},
+		},
+	}
+	newReconciler := func(requesterWebhookServed bool) *NonAdminRestoreReconciler {
+		return &NonAdminRestoreReconciler{
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: policyNamespace}}, policy.DeepCopy()).
+				Build(),
+			RequesterWebhookServed: requesterWebhookServed,
+		}
+	}
+
+	ginkgo.It("should reject namespace mappings when the NonAdminRestore webhooks are not served", func() {
+		policyReconciler, err := newReconciler(false).withNonAdminPolicy(context.Background(), policyNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(policyReconciler.AllowNamespaceMapping).To(gomega.BeTrue())
+
+		err = policyReconciler.validateRequesterWebhookServed(context.Background(), nar.DeepCopy())
+		gomega.Expect(err).To(gomega.MatchError(function.ErrNamespaceMappingRejected))
+	})
+
+	ginkgo.It("should accept namespace mappings when the NonAdminRestore webhooks are served", func() {
+		policyReconciler, err := newReconciler(true).withNonAdminPolicy(context.Background(), policyNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		gomega.Expect(policyReconciler.validateRequesterWebhookServed(context.Background(), nar.DeepCopy())).To(gomega.Succeed())
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRestore namespace mapping allowed by the NonAdminControllerConfig", func() {
+	const configNamespace = "test-nonadminrestore-config-namespace-mapping"
+
+	ginkgo.It("should reject the namespace mappings it allows when the NonAdminRestore webhooks are not served", func() {
+		reconciler := &NonAdminRestoreReconciler{
+			Client: fake.NewClientBuilder().
+				WithScheme(k8sClient.Scheme()).
+				WithObjects(&nacv1alpha1.NonAdminControllerConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: constant.NonAdminControllerConfigName},
+					Spec: nacv1alpha1.NonAdminControllerConfigSpec{
+						AllowedFeatures: &nacv1alpha1.NonAdminPolicyFeatures{NamespaceMapping: ptr.To(true)},
+					},
+				}).
+				Build(),
+		}
+		configReconciler, err := reconciler.withNonAdminPolicy(context.Background(), configNamespace)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(configReconciler.AllowNamespaceMapping).To(gomega.BeTrue())
+
+		err = configReconciler.validateRequesterWebhookServed(context.Background(), &nacv1alpha1.NonAdminRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-nonadminrestore-config-namespace-mapping", Namespace: configNamespace},
+			Spec: nacv1alpha1.NonAdminRestoreSpec{
+				RestoreSpec: &velerov1.RestoreSpec{
+					BackupName:       "backup",
+					NamespaceMapping: map[string]string{configNamespace: "other-tenant"},
+				},
+			},
+		})
+		gomega.Expect(err).To(gomega.MatchError(function.ErrNamespaceMappingRejected))
+	})
+})
@@ -0,0 +1,273 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const (
+	// retentionDeleteBackupRequestNamePrefix prefixes the name of the NonAdminDeleteBackupRequests of NonAdminRetentionPolicies
+	retentionDeleteBackupRequestNamePrefix = "retention"
+	// retentionMinMaxAge is the minimum spec.maxAge of NonAdminRetentionPolicies, so a mistyped maxAge does not delete
+	// every NonAdminBackup of the namespace as soon as it finishes
+	retentionMinMaxAge = time.Hour
+)
+
+// NonAdminRetentionPolicyReconciler reconciles a NonAdminRetentionPolicy object
+type NonAdminRetentionPolicyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// AllowRetentionPolicies lets NonAdminRetentionPolicies delete the NonAdminBackups of their namespace
+	AllowRetentionPolicies bool
+}
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminretentionpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminretentionpolicies/status,verbs=get;update;patch
+
+// Reconcile validates the spec of a NonAdminRetentionPolicy and enforces it: a NonAdminDeleteBackupRequest is
+// created for each finished NonAdminBackup it selects that is older than the last spec.keepLast Completed ones,
+// or than spec.maxAge. The NonAdminRetentionPolicy is enforced again when a NonAdminBackup of its namespace
+// changes phase, and when the next retained NonAdminBackup reaches spec.maxAge.
+func (r *NonAdminRetentionPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminRetentionPolicy Reconcile start")
+
+	narp := &nacv1alpha1.NonAdminRetentionPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, narp); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminRetentionPolicy")
+		return ctrl.Result{}, err
+	}
+	if !narp.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	selector, validationErr := r.validateSpec(ctx, narp)
+	if validationErr != nil {
+		updatedPhase := updateNonAdminPhase(&narp.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		updatedCondition := meta.SetStatusCondition(&narp.Status.Conditions, metav1.Condition{
+			Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:             metav1.ConditionFalse,
+			Reason:             "InvalidNonAdminRetentionPolicySpec",
+			Message:            validationErr.Error(),
+			ObservedGeneration: narp.Generation,
+		})
+		if updatedPhase || updatedCondition {
+			if err := r.Status().Update(ctx, narp); err != nil {
+				logger.Error(err, statusUpdateError)
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, reconcile.TerminalError(validationErr)
+	}
+
+	requeueAfter, err := r.enforceRetention(ctx, logger, narp, selector)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.V(1).Info("NonAdminRetentionPolicy Reconcile exit")
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// validateSpec returns the selector of the NonAdminBackups of the NonAdminRetentionPolicy, or an error if
+// NonAdminRetentionPolicies are restricted, its spec is invalid, or its requester can not delete the backups
+func (r *NonAdminRetentionPolicyReconciler) validateSpec(ctx context.Context, narp *nacv1alpha1.NonAdminRetentionPolicy) (labels.Selector, error) {
+	if !r.AllowRetentionPolicies {
+		return nil, fmt.Errorf(constant.NARPRestrictedErr, "creation")
+	}
+	if narp.Spec.KeepLast == nil && narp.Spec.MaxAge == nil {
+		return nil, errors.New("NonAdminRetentionPolicy spec.keepLast or spec.maxAge must be set")
+	}
+	if narp.Spec.MaxAge != nil && narp.Spec.MaxAge.Duration < retentionMinMaxAge {
+		return nil, fmt.Errorf("NonAdminRetentionPolicy spec.maxAge must be at least %v", retentionMinMaxAge)
+	}
+	selector := labels.Everything()
+	if narp.Spec.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(narp.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("NonAdminRetentionPolicy spec.selector is invalid: %v", err)
+		}
+	}
+	if err := function.CheckRequesterCanDeleteBackups(ctx, r.Client, narp); err != nil {
+		return nil, err
+	}
+	return selector, nil
+}
+
+// enforceRetention requests the deletion of the expired NonAdminBackups of the NonAdminRetentionPolicy and
+// updates its status. It returns the time after which the next retained NonAdminBackup expires, zero if none does.
+func (r *NonAdminRetentionPolicyReconciler) enforceRetention(ctx context.Context, logger logr.Logger, narp *nacv1alpha1.NonAdminRetentionPolicy, selector labels.Selector) (time.Duration, error) {
+	nabList := &nacv1alpha1.NonAdminBackupList{}
+	if err := r.List(ctx, nabList, client.InNamespace(narp.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		logger.Error(err, "Unable to list NonAdminBackups")
+		return 0, err
+	}
+
+	expired, retained, requeueAfter := expiredNonAdminBackups(narp, nabList.Items, time.Now())
+	expiredNames := make([]string, 0, len(expired))
+	for _, nab := range expired {
+		// the NonAdminDeleteBackupRequest is requested by the requester of the NonAdminRetentionPolicy
+		annotations := function.GetRequesterAnnotations(function.GetNonAdminRetentionPolicyRequester(narp), function.NonAdminDeleteBackupRequestRequesterAnnotations)
+		annotations[constant.NarpOriginNameAnnotation] = narp.Name
+		deleteBackupRequest := &nacv1alpha1.NonAdminDeleteBackupRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        fmt.Sprintf("%s-%s", retentionDeleteBackupRequestNamePrefix, nab.UID),
+				Namespace:   narp.Namespace,
+				Annotations: annotations,
+			},
+			Spec: nacv1alpha1.NonAdminDeleteBackupRequestSpec{BackupName: nab.Name},
+		}
+		if err := r.Create(ctx, deleteBackupRequest); err != nil && !apierrors.IsAlreadyExists(err) {
+			logger.Error(err, "Failed to create NonAdminDeleteBackupRequest", constant.NameString, nab.Name)
+			return 0, err
+		}
+		logger.V(1).Info("NonAdminBackup expired", constant.NameString, nab.Name)
+		expiredNames = append(expiredNames, nab.Name)
+	}
+
+	updateNonAdminPhase(&narp.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+	meta.SetStatusCondition(&narp.Status.Conditions, metav1.Condition{
+		Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             "NonAdminRetentionPolicyAccepted",
+		Message:            "NonAdminRetentionPolicy accepted",
+		ObservedGeneration: narp.Generation,
+	})
+	narp.Status.RetainedBackups = retained
+	narp.Status.ExpiredBackups = expiredNames
+	narp.Status.LastEnforcementTimestamp = &metav1.Time{Time: time.Now()}
+	if err := r.Status().Update(ctx, narp); err != nil {
+		logger.Error(err, statusUpdateError)
+		return 0, err
+	}
+	return requeueAfter, nil
+}
+
+// expiredNonAdminBackups returns the finished NonAdminBackups of nabs expired by the NonAdminRetentionPolicy, newest first,
+// the number of the retained ones, and the time after which the next retained NonAdminBackup expires, zero if none does.
+// NonAdminBackups being deleted are ignored.
+func expiredNonAdminBackups(narp *nacv1alpha1.NonAdminRetentionPolicy, nabs []nacv1alpha1.NonAdminBackup, now time.Time) ([]*nacv1alpha1.NonAdminBackup, int32, time.Duration) {
+	finished := make([]*nacv1alpha1.NonAdminBackup, 0, len(nabs))
+	for index := range nabs {
+		nab := &nabs[index]
+		if !nab.DeletionTimestamp.IsZero() || nab.Spec.DeleteBackup {
+			continue
+		}
+		switch nab.Status.Phase {
+		case nacv1alpha1.NonAdminPhaseCompleted, nacv1alpha1.NonAdminPhasePartiallyFailed,
+			nacv1alpha1.NonAdminPhaseFailed, nacv1alpha1.NonAdminPhaseCanceled:
+			finished = append(finished, nab)
+		}
+	}
+	sort.SliceStable(finished, func(i, j int) bool {
+		return nonAdminBackupStartTime(finished[i]).After(nonAdminBackupStartTime(finished[j]))
+	})
+
+	var expired []*nacv1alpha1.NonAdminBackup
+	var retained, completed int32
+	var requeueAfter time.Duration
+	for _, nab := range finished {
+		startTime := nonAdminBackupStartTime(nab)
+		keepLastExceeded := narp.Spec.KeepLast != nil && completed >= *narp.Spec.KeepLast
+		maxAgeExceeded := narp.Spec.MaxAge != nil && !now.Before(startTime.Add(narp.Spec.MaxAge.Duration))
+		if keepLastExceeded || maxAgeExceeded {
+			expired = append(expired, nab)
+			continue
+		}
+		retained++
+		if nab.Status.Phase == nacv1alpha1.NonAdminPhaseCompleted {
+			completed++
+		}
+		if narp.Spec.MaxAge != nil {
+			if remaining := startTime.Add(narp.Spec.MaxAge.Duration).Sub(now); requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		}
+	}
+	return expired, retained, requeueAfter
+}
+
+// nonAdminBackupStartTime returns when the VeleroBackup of the NonAdminBackup started, or when the NonAdminBackup
+// was created if it is unknown
+func nonAdminBackupStartTime(nab *nacv1alpha1.NonAdminBackup) time.Time {
+	if nab.Status.VeleroBackup != nil && nab.Status.VeleroBackup.Status != nil && nab.Status.VeleroBackup.Status.StartTimestamp != nil {
+		return nab.Status.VeleroBackup.Status.StartTimestamp.Time
+	}
+	return nab.CreationTimestamp.Time
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminRetentionPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminRetentionPolicy{}, ctrlbuilder.WithPredicates(ctrlpredicate.GenerationChangedPredicate{})).
+		Named("nonadminretentionpolicy").
+		Watches(&nacv1alpha1.NonAdminBackup{}, handler.EnqueueRequestsFromMapFunc(r.mapToNarps),
+			ctrlbuilder.WithPredicates(ctrlpredicate.Funcs{
+				CreateFunc: func(event.CreateEvent) bool { return false },
+				UpdateFunc: func(evt event.UpdateEvent) bool {
+					oldNab, okOld := evt.ObjectOld.(*nacv1alpha1.NonAdminBackup)
+					newNab, okNew := evt.ObjectNew.(*nacv1alpha1.NonAdminBackup)
+					return okOld && okNew && oldNab.Status.Phase != newNab.Status.Phase
+				},
+				DeleteFunc:  func(event.DeleteEvent) bool { return false },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+			})).
+		Complete(r)
+}
+
+// mapToNarps returns the NonAdminRetentionPolicies of the namespace of a NonAdminBackup
+func (r *NonAdminRetentionPolicyReconciler) mapToNarps(ctx context.Context, object client.Object) []reconcile.Request {
+	retentionPolicies := &nacv1alpha1.NonAdminRetentionPolicyList{}
+	if err := r.List(ctx, retentionPolicies, client.InNamespace(object.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Unable to list NonAdminRetentionPolicies")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(retentionPolicies.Items))
+	for index := range retentionPolicies.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&retentionPolicies.Items[index])})
+	}
+	return requests
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+func testRetainedNonAdminBackup(name string, phase nacv1alpha1.NonAdminPhase, startTime time.Time) nacv1alpha1.NonAdminBackup {
+	return nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: nacv1alpha1.NonAdminBackupStatus{
+			Phase: phase,
+			VeleroBackup: &nacv1alpha1.VeleroBackup{
+				Status: &velerov1.BackupStatus{StartTimestamp: &metav1.Time{Time: startTime}},
+			},
+		},
+	}
+}
+
+var _ = ginkgo.Describe("Test expiredNonAdminBackups function", func() {
+	now := time.Now()
+	nonAdminBackups := func() []nacv1alpha1.NonAdminBackup {
+		return []nacv1alpha1.NonAdminBackup{
+			testRetainedNonAdminBackup("oldest", nacv1alpha1.NonAdminPhaseCompleted, now.Add(-72*time.Hour)),
+			testRetainedNonAdminBackup("newest", nacv1alpha1.NonAdminPhaseCompleted, now.Add(-time.Hour)),
+			testRetainedNonAdminBackup("failed", nacv1alpha1.NonAdminPhaseFailed, now.Add(-2*time.Hour)),
+			testRetainedNonAdminBackup("older", nacv1alpha1.NonAdminPhaseCompleted, now.Add(-48*time.Hour)),
+			testRetainedNonAdminBackup("running", nacv1alpha1.NonAdminPhaseCreated, now.Add(-96*time.Hour)),
+		}
+	}
+
+	ginkgo.DescribeTable("Should return the NonAdminBackups expired by the NonAdminRetentionPolicy",
+		func(spec nacv1alpha1.NonAdminRetentionPolicySpec, expectedExpired []string, expectedRetained int32, expectedRequeueAfter time.Duration) {
+			expired, retained, requeueAfter := expiredNonAdminBackups(
+				&nacv1alpha1.NonAdminRetentionPolicy{Spec: spec}, nonAdminBackups(), now)
+			expiredNames := []string{}
+			for _, nab := range expired {
+				expiredNames = append(expiredNames, nab.Name)
+			}
+			gomega.Expect(expiredNames).To(gomega.Equal(expectedExpired))
+			gomega.Expect(retained).To(gomega.Equal(expectedRetained))
+			gomega.Expect(requeueAfter).To(gomega.Equal(expectedRequeueAfter))
+		},
+		ginkgo.Entry("When keepLast is not exceeded",
+			nacv1alpha1.NonAdminRetentionPolicySpec{KeepLast: ptr.To[int32](3)}, []string{}, int32(4), time.Duration(0)),
+		ginkgo.Entry("When keepLast is exceeded, only counting Completed NonAdminBackups",
+			nacv1alpha1.NonAdminRetentionPolicySpec{KeepLast: ptr.To[int32](2)}, []string{"oldest"}, int32(3), time.Duration(0)),
+		ginkgo.Entry("When maxAge is exceeded",
+			nacv1alpha1.NonAdminRetentionPolicySpec{MaxAge: &metav1.Duration{Duration: 24 * time.Hour}},
+			[]string{"older", "oldest"}, int32(2), 22*time.Hour),
+		ginkgo.Entry("When keepLast and maxAge are set",
+			nacv1alpha1.NonAdminRetentionPolicySpec{KeepLast: ptr.To[int32](1), MaxAge: &metav1.Duration{Duration: 60 * time.Hour}},
+			[]string{"failed", "older", "oldest"}, int32(1), 59*time.Hour),
+	)
+})
+
+var _ = ginkgo.Describe("Test NonAdminRetentionPolicy Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("narp-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-narp-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminRetentionPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+				// the NonAdminRetentionPolicy webhook is not served by the test environment
+				Annotations: function.GetRequesterAnnotations(authenticationv1.UserInfo{
+					Username: "tenant",
+					Groups:   []string{"system:masters"},
+				}, function.NonAdminRetentionPolicyRequesterAnnotations),
+			},
+			Spec: nacv1alpha1.NonAdminRetentionPolicySpec{KeepLast: ptr.To[int32](1)},
+		})).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should set the NonAdminRetentionPolicy BackingOff when retention policies are restricted", func() {
+		reconciler := &NonAdminRetentionPolicyReconciler{Client: k8sClient, Scheme: testEnv.Scheme}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.MatchError(reconcile.TerminalError(nil)))
+
+		nonAdminRetentionPolicy := &nacv1alpha1.NonAdminRetentionPolicy{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminRetentionPolicy)).To(gomega.Succeed())
+		gomega.Expect(nonAdminRetentionPolicy.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseBackingOff))
+		gomega.Expect(meta.IsStatusConditionFalse(nonAdminRetentionPolicy.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("Should create a NonAdminDeleteBackupRequest for the NonAdminBackups exceeding keepLast", func() {
+		now := time.Now()
+		for name, startTime := range map[string]time.Time{"nab-older": now.Add(-2 * time.Hour), "nab-newer": now.Add(-time.Hour)} {
+			nonAdminBackup := &nacv1alpha1.NonAdminBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: nonAdminObjectNamespace},
+				Spec:       nacv1alpha1.NonAdminBackupSpec{BackupSpec: &velerov1.BackupSpec{}},
+			}
+			gomega.Expect(k8sClient.Create(ctx, nonAdminBackup)).To(gomega.Succeed())
+			retainedNonAdminBackup := testRetainedNonAdminBackup(name, nacv1alpha1.NonAdminPhaseCompleted, startTime)
+			nonAdminBackup.Status = retainedNonAdminBackup.Status
+			gomega.Expect(k8sClient.Status().Update(ctx, nonAdminBackup)).To(gomega.Succeed())
+		}
+
+		reconciler := &NonAdminRetentionPolicyReconciler{
+			Client:                 withRequesterWebhookConfigurations(nacv1alpha1.NonAdminRetentionPolicies, constant.NarpMutatingWebhookName, constant.NarpValidatingWebhookName),
+			Scheme:                 testEnv.Scheme,
+			AllowRetentionPolicies: true,
+		}
+		key := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		nonAdminRetentionPolicy := &nacv1alpha1.NonAdminRetentionPolicy{}
+		gomega.Expect(k8sClient.Get(ctx, key, nonAdminRetentionPolicy)).To(gomega.Succeed())
+		gomega.Expect(nonAdminRetentionPolicy.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+		gomega.Expect(nonAdminRetentionPolicy.Status.RetainedBackups).To(gomega.Equal(int32(1)))
+		gomega.Expect(nonAdminRetentionPolicy.Status.ExpiredBackups).To(gomega.Equal([]string{"nab-older"}))
+		gomega.Expect(nonAdminRetentionPolicy.Status.LastEnforcementTimestamp).NotTo(gomega.BeNil())
+
+		nonAdminDeleteBackupRequests := &nacv1alpha1.NonAdminDeleteBackupRequestList{}
+		gomega.Expect(k8sClient.List(ctx, nonAdminDeleteBackupRequests)).To(gomega.Succeed())
+		requestedBackups := []string{}
+		for _, nonAdminDeleteBackupRequest := range nonAdminDeleteBackupRequests.Items {
+			if nonAdminDeleteBackupRequest.Namespace != nonAdminObjectNamespace {
+				continue
+			}
+			gomega.Expect(nonAdminDeleteBackupRequest.Annotations).To(gomega.HaveKeyWithValue(constant.NarpOriginNameAnnotation, nonAdminObjectName))
+			gomega.Expect(function.GetNonAdminDeleteBackupRequestRequester(&nonAdminDeleteBackupRequest)).To(gomega.Equal(authenticationv1.UserInfo{
+				Username: "tenant",
+				Groups:   []string{"system:masters"},
+			}))
+			requestedBackups = append(requestedBackups, nonAdminDeleteBackupRequest.Spec.BackupName)
+		}
+		gomega.Expect(requestedBackups).To(gomega.Equal([]string{"nab-older"}))
+
+		// enforcing the policy again does not fail on the existing NonAdminDeleteBackupRequest
+		_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+	})
+})
+
+var _ = ginkgo.Describe("Test NonAdminRetentionPolicy spec validation", func() {
+	const retentionNamespace = "test-narp-validation"
+
+	newNonAdminRetentionPolicy := func(annotations map[string]string, maxAge time.Duration) *nacv1alpha1.NonAdminRetentionPolicy {
+		return &nacv1alpha1.NonAdminRetentionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-narp-validation", Namespace: retentionNamespace, Annotations: annotations},
+			Spec:       nacv1alpha1.NonAdminRetentionPolicySpec{MaxAge: &metav1.Duration{Duration: maxAge}},
+		}
+	}
+	newReconciler := func() *NonAdminRetentionPolicyReconciler {
+		return &NonAdminRetentionPolicyReconciler{
+			Client:                 fake.NewClientBuilder().WithScheme(k8sClient.Scheme()).Build(),
+			AllowRetentionPolicies: true,
+		}
+	}
+	requesterAnnotations := function.GetRequesterAnnotations(authenticationv1.UserInfo{Username: "tenant"}, function.NonAdminRetentionPolicyRequesterAnnotations)
+
+	ginkgo.It("should reject a maxAge lower than the minimum", func() {
+		_, err := newReconciler().validateSpec(context.Background(), newNonAdminRetentionPolicy(requesterAnnotations, time.Second))
+		gomega.Expect(err).To(gomega.MatchError("NonAdminRetentionPolicy spec.maxAge must be at least 1h0m0s"))
+	})
+
+	ginkgo.It("should reject a NonAdminRetentionPolicy without recorded requester", func() {
+		_, err := newReconciler().validateSpec(context.Background(), newNonAdminRetentionPolicy(nil, 24*time.Hour))
+		gomega.Expect(err).To(gomega.MatchError(fmt.Sprintf(constant.NARPRestrictedErr+", requester identity is not recorded", "creation")))
+	})
+
+	ginkgo.It("should not trust the requester annotations when the NonAdminRetentionPolicy webhooks are not configured", func() {
+		_, err := newReconciler().validateSpec(context.Background(), newNonAdminRetentionPolicy(requesterAnnotations, 24*time.Hour))
+		gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("requester identity can not be trusted")))
+	})
+})
@@ -0,0 +1,595 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+	"github.com/migtools/oadp-non-admin/internal/handler"
+	"github.com/migtools/oadp-non-admin/internal/predicate"
+	"github.com/migtools/oadp-non-admin/internal/validationhook"
+)
+
+// NonAdminScheduleReconciler reconciles a NonAdminSchedule object
+type NonAdminScheduleReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	// BackupReconciler validates and builds the Velero Backup template of the VeleroSchedule
+	// the same way as the spec.backupSpec of a NonAdminBackup
+	BackupReconciler *NonAdminBackupReconciler
+	// ValidationHook adds the cluster admin site-specific rules to the spec validation, nil disables it
+	ValidationHook *validationhook.Hook
+	OADPNamespace  string
+}
+
+type nonAdminScheduleReconcileStepFunction func(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error)
+
+const nonAdminScheduleStatusUpdateFailureMessage = "Failed to update NonAdminSchedule Status"
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminschedules/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminschedules/finalizers,verbs=update
+
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state,
+// defined in NonAdminSchedule object Spec.
+//
+// The NonAdminSchedule creates a VeleroSchedule in the OADP namespace, whose Velero Backups are
+// surfaced in the NonAdminSchedule namespace as NonAdminBackups, which are kept when the
+// NonAdminSchedule is deleted.
+func (r *NonAdminScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminSchedule Reconcile start")
+
+	nas := &nacv1alpha1.NonAdminSchedule{}
+	err := r.Get(ctx, req.NamespacedName, nas)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminSchedule")
+		return ctrl.Result{}, err
+	}
+
+	// the Velero Backup template is validated and enforced with the NonAdminPolicy of the namespace
+	backupReconciler, err := r.BackupReconciler.withNonAdminPolicy(ctx, nas.Namespace)
+	if err != nil {
+		logger.Error(err, "Unable to get NonAdminPolicy of NonAdminSchedule namespace")
+		return ctrl.Result{}, err
+	}
+	policyReconciler := *r
+	policyReconciler.BackupReconciler = backupReconciler
+	return policyReconciler.reconcile(ctx, logger, nas)
+}
+
+// reconcile runs the reconcile steps of the path of the NonAdminSchedule
+func (r *NonAdminScheduleReconciler) reconcile(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (ctrl.Result, error) {
+	var reconcileSteps []nonAdminScheduleReconcileStepFunction
+
+	switch {
+	case !nas.DeletionTimestamp.IsZero():
+		logger.V(1).Info("Executing direct deletion path")
+		reconcileSteps = []nonAdminScheduleReconcileStepFunction{
+			r.setStatusForDirectKubernetesAPIDeletion,
+			r.deleteVeleroScheduleObjects,
+		}
+
+	default:
+		logger.V(1).Info("Executing nas creation/update path")
+		reconcileSteps = []nonAdminScheduleReconcileStepFunction{
+			r.initNasCreate,
+			r.validateSpec,
+			r.setScheduleUUIDInStatus,
+			r.setFinalizerOnNonAdminSchedule,
+			r.createVeleroScheduleAndSyncWithNonAdminSchedule,
+			r.syncScheduledNonAdminBackups,
+		}
+	}
+
+	// Execute the selected reconciliation steps
+	for _, step := range reconcileSteps {
+		requeue, err := step(ctx, logger, nas)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if requeue {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	logger.V(1).Info("NonAdminSchedule Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// setStatusForDirectKubernetesAPIDeletion sets the NonAdminSchedule phase and condition to Deleting.
+func (r *NonAdminScheduleReconciler) setStatusForDirectKubernetesAPIDeletion(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	updatedPhase := updateNonAdminPhase(&nas.Status.Phase, nacv1alpha1.NonAdminPhaseDeleting)
+	updatedCondition := meta.SetStatusCondition(&nas.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionDeleting),
+			Status:  metav1.ConditionTrue,
+			Reason:  "DeletionPending",
+			Message: "the Velero Schedule is being deleted, the NonAdminBackups it created are kept",
+		},
+	)
+	if updatedPhase || updatedCondition {
+		if err := r.Status().Update(ctx, nas); err != nil {
+			logger.Error(err, nonAdminScheduleStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminSchedule status marked for deletion during direct API deletion")
+	}
+	return false, nil
+}
+
+// deleteVeleroScheduleObjects deletes the VeleroSchedule of the NonAdminSchedule, and removes the
+// NonAdminSchedule finalizer once it is gone. The Velero Backups of the VeleroSchedule are not deleted.
+func (r *NonAdminScheduleReconciler) deleteVeleroScheduleObjects(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	if nas.Status.VeleroSchedule != nil && nas.Status.VeleroSchedule.NACUUID != constant.EmptyString {
+		veleroScheduleNACUUID := nas.Status.VeleroSchedule.NACUUID
+		veleroSchedule, err := function.GetVeleroScheduleByLabel(ctx, r.Client, r.OADPNamespace, veleroScheduleNACUUID)
+		if err != nil {
+			logger.Error(err, "Unable to fetch VeleroSchedule", constant.UUIDString, veleroScheduleNACUUID)
+			return false, err
+		}
+		if veleroSchedule != nil {
+			if err = r.Delete(ctx, veleroSchedule); err != nil && !apierrors.IsNotFound(err) {
+				logger.Error(err, "Failed to delete VeleroSchedule", constant.NameString, veleroSchedule.Name)
+				return false, err
+			}
+			// the finalizer is removed once the VeleroSchedule Delete event is received
+			logger.V(1).Info("VeleroSchedule deletion initiated", constant.NameString, veleroSchedule.Name)
+			return false, nil
+		}
+	}
+
+	controllerutil.RemoveFinalizer(nas, constant.NasFinalizerName)
+	if err := r.Update(ctx, nas); err != nil {
+		logger.Error(err, "Failed to remove finalizer from NonAdminSchedule")
+		return false, err
+	}
+	logger.V(1).Info("NonAdminSchedule finalizer removed and object deleted")
+	return false, nil
+}
+
+// initNasCreate initializes the Status.Phase from the NonAdminSchedule.
+func (r *NonAdminScheduleReconciler) initNasCreate(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	if nas.Status.Phase != constant.EmptyString {
+		logger.V(1).Info("NonAdminSchedule Phase already initialized", constant.CurrentPhaseString, nas.Status.Phase)
+		return false, nil
+	}
+
+	if updated := updateNonAdminPhase(&nas.Status.Phase, nacv1alpha1.NonAdminPhaseNew); updated {
+		if err := r.Status().Update(ctx, nas); err != nil {
+			logger.Error(err, nonAdminScheduleStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminSchedule Phase set to New")
+	}
+	return false, nil
+}
+
+// templateNonAdminBackup returns a NonAdminBackup, in the NonAdminSchedule namespace, whose spec.backupSpec
+// is the Velero Backup template of the NonAdminSchedule. It is used to validate and build the template
+// as the spec.backupSpec of a NonAdminBackup, so it does not carry the NonAdminSchedule annotations,
+// which are set by the user, unlike the NonAdminBackup requester annotations.
+func templateNonAdminBackup(nas *nacv1alpha1.NonAdminSchedule) *nacv1alpha1.NonAdminBackup {
+	return &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       nas.Name,
+			Namespace:  nas.Namespace,
+			Generation: nas.Generation,
+		},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: nas.Spec.ScheduleSpec.Template.DeepCopy(),
+		},
+	}
+}
+
+// validateSpec validates the Spec from the NonAdminSchedule.
+// Its Velero Backup template is validated as the spec.backupSpec of a NonAdminBackup.
+// If the Spec is invalid, the function sets the NonAdminSchedule phase to "BackingOff"
+// and its condition Accepted to "False", otherwise its condition Accepted to "True".
+func (r *NonAdminScheduleReconciler) validateSpec(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	err := function.ValidateScheduleSpec(nas, r.BackupReconciler.EnforcedBackupSpec)
+	if err == nil {
+		err = r.BackupReconciler.validateBackupSpec(ctx, templateNonAdminBackup(nas))
+	}
+	if err == nil {
+		err = r.ValidationHook.Validate(ctx, nacv1alpha1.NonAdminSchedules, nas, nas.Status.Conditions)
+		if err != nil && !validationhook.IsRejected(err) {
+			logger.Error(err, "Unable to validate NonAdminSchedule with the validation hook")
+			return false, err
+		}
+	}
+	if err != nil {
+		updatedPhase := updateNonAdminPhase(&nas.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		updatedCondition := meta.SetStatusCondition(&nas.Status.Conditions,
+			metav1.Condition{
+				Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+				Status:  metav1.ConditionFalse,
+				Reason:  "InvalidScheduleSpec",
+				Message: err.Error(),
+			},
+		)
+		if updatedPhase || updatedCondition {
+			if updateErr := r.Status().Update(ctx, nas); updateErr != nil {
+				logger.Error(updateErr, nonAdminScheduleStatusUpdateFailureMessage)
+				return false, updateErr
+			}
+			logger.V(1).Info("NonAdminSchedule Phase set to BackingOff")
+		}
+		return false, reconcile.TerminalError(err)
+	}
+
+	logger.V(1).Info("NonAdminSchedule Spec is valid")
+
+	updated := meta.SetStatusCondition(&nas.Status.Conditions,
+		metav1.Condition{
+			Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             "ScheduleAccepted",
+			Message:            "schedule accepted",
+			ObservedGeneration: nas.Generation,
+		},
+	)
+	if updated {
+		if err := r.Status().Update(ctx, nas); err != nil {
+			logger.Error(err, nonAdminScheduleStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminSchedule condition set to Accepted")
+	}
+	return false, nil
+}
+
+// setScheduleUUIDInStatus generates a UUID for VeleroSchedule and stores it in the NonAdminSchedule status.
+// The VeleroSchedule is named after it.
+func (r *NonAdminScheduleReconciler) setScheduleUUIDInStatus(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	if nas.Status.VeleroSchedule != nil && nas.Status.VeleroSchedule.NACUUID != constant.EmptyString {
+		logger.V(1).Info("NonAdminSchedule already contains VeleroSchedule UUID reference")
+		return false, nil
+	}
+
+	veleroScheduleNACUUID := function.GenerateNacObjectUUID(nas.Namespace, nas.Name)
+	nas.Status.VeleroSchedule = &nacv1alpha1.VeleroSchedule{
+		NACUUID:   veleroScheduleNACUUID,
+		Namespace: r.OADPNamespace,
+		Name:      veleroScheduleNACUUID,
+	}
+	if err := r.Status().Update(ctx, nas); err != nil {
+		logger.Error(err, nonAdminScheduleStatusUpdateFailureMessage)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminSchedule - Status Updated with UUID reference")
+	return false, nil
+}
+
+func (r *NonAdminScheduleReconciler) setFinalizerOnNonAdminSchedule(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	// Added before creating the VeleroSchedule, so it is not left orphan
+	if !controllerutil.ContainsFinalizer(nas, constant.NasFinalizerName) {
+		controllerutil.AddFinalizer(nas, constant.NasFinalizerName)
+		if err := r.Update(ctx, nas); err != nil {
+			logger.Error(err, "Failed to add finalizer")
+			return false, err
+		}
+		logger.V(1).Info("Finalizer added to NonAdminSchedule", "finalizer", constant.NasFinalizerName)
+	}
+	return false, nil
+}
+
+// createVeleroScheduleAndSyncWithNonAdminSchedule creates the VeleroSchedule of the NonAdminSchedule, or updates
+// it with the current NonAdminSchedule spec, and reflects its state in the NonAdminSchedule status.
+//
+// The Velero Backups created by the VeleroSchedule get its labels and annotations, so they can be related
+// to the NonAdminSchedule. Velero uses the template metadata labels instead, when set, which is why the
+// NAC labels are added to them as well.
+func (r *NonAdminScheduleReconciler) createVeleroScheduleAndSyncWithNonAdminSchedule(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	if nas.Status.VeleroSchedule == nil || nas.Status.VeleroSchedule.NACUUID == constant.EmptyString {
+		return false, errors.New("unable to get Velero Schedule UUID from NonAdminSchedule Status")
+	}
+	veleroScheduleNACUUID := nas.Status.VeleroSchedule.NACUUID
+
+	template, enforcedFields, err := r.BackupReconciler.buildVeleroBackupSpec(ctx, logger, templateNonAdminBackup(nas), veleroScheduleNACUUID)
+	if err != nil {
+		return false, err
+	}
+
+	nacLabels := function.GetNonAdminLabels()
+	nacLabels[constant.NasOriginNACUUIDLabel] = veleroScheduleNACUUID
+	if template.Metadata.Labels != nil {
+		for key, value := range nacLabels {
+			template.Metadata.Labels[key] = value
+		}
+	}
+
+	veleroSchedule := &velerov1.Schedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nas.VeleroScheduleName(),
+			Namespace: r.OADPNamespace,
+		},
+	}
+	operation, err := controllerutil.CreateOrUpdate(ctx, r.Client, veleroSchedule, func() error {
+		if !veleroSchedule.CreationTimestamp.IsZero() && veleroSchedule.Labels[constant.NasOriginNACUUIDLabel] != veleroScheduleNACUUID {
+			return reconcile.TerminalError(errors.New("related Velero Schedule does not point to NonAdminSchedule"))
+		}
+		if veleroSchedule.Labels == nil {
+			veleroSchedule.Labels = map[string]string{}
+		}
+		for key, value := range nacLabels {
+			veleroSchedule.Labels[key] = value
+		}
+		if veleroSchedule.Annotations == nil {
+			veleroSchedule.Annotations = map[string]string{}
+		}
+		for key, value := range function.GetNonAdminScheduleAnnotations(nas.ObjectMeta) {
+			veleroSchedule.Annotations[key] = value
+		}
+		veleroSchedule.Spec = velerov1.ScheduleSpec{
+			Template:        *template,
+			Schedule:        nas.Spec.ScheduleSpec.Schedule,
+			Paused:          nas.Spec.ScheduleSpec.Paused,
+			SkipImmediately: nas.Spec.ScheduleSpec.SkipImmediately,
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "Failed to create or update VeleroSchedule")
+		return false, err
+	}
+	if operation != controllerutil.OperationResultNone {
+		logger.Info("VeleroSchedule successfully "+string(operation), constant.NameString, veleroSchedule.Name)
+	}
+
+	updatedEnforcedFields := updateNonAdminScheduleEnforcedFieldsStatus(&nas.Status, enforcedFields)
+	updatedPhase := updateNonAdminPhase(&nas.Status.Phase, nonAdminPhaseForVeleroSchedule(veleroSchedule))
+	updatedCondition := meta.SetStatusCondition(&nas.Status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionQueued),
+			Status:  metav1.ConditionTrue,
+			Reason:  "ScheduleCreated",
+			Message: "Created Velero Schedule object",
+		},
+	)
+	updated := updateNonAdminScheduleVeleroScheduleStatus(&nas.Status, veleroSchedule)
+
+	if updated || updatedPhase || updatedCondition || updatedEnforcedFields {
+		if err := r.Status().Update(ctx, nas); err != nil {
+			logger.Error(err, nonAdminScheduleStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminSchedule - Exit after Status Update")
+	}
+	return false, nil
+}
+
+// syncScheduledNonAdminBackups creates a NonAdminBackup, in the NonAdminSchedule namespace, for each Velero
+// Backup created by the VeleroSchedule, and references the most recent one in the NonAdminSchedule status.
+//
+// The Velero Backup gets the NonAdminBackup labels and annotations, so the NonAdminBackup takes it over
+// like the ones created by the NonAdminBackup synchronizer. Velero Backups released to the cluster admin,
+// which lost the managed-by label, are not considered.
+func (r *NonAdminScheduleReconciler) syncScheduledNonAdminBackups(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule) (bool, error) {
+	veleroBackupList := &velerov1.BackupList{}
+	labelSelector := client.MatchingLabels(function.GetNonAdminLabels())
+	labelSelector[constant.NasOriginNACUUIDLabel] = nas.Status.VeleroSchedule.NACUUID
+	if err := r.List(ctx, veleroBackupList, client.InNamespace(r.OADPNamespace), labelSelector); err != nil {
+		logger.Error(err, "Unable to fetch Backups of VeleroSchedule in OADP namespace")
+		return false, err
+	}
+
+	var lastVeleroBackup *velerov1.Backup
+	var lastNonAdminBackup string
+	for index := range veleroBackupList.Items {
+		veleroBackup := &veleroBackupList.Items[index]
+		if !veleroBackup.DeletionTimestamp.IsZero() {
+			continue
+		}
+		nabName, err := r.adoptScheduledVeleroBackup(ctx, logger, nas, veleroBackup)
+		if err != nil {
+			return false, err
+		}
+		if lastVeleroBackup == nil || lastVeleroBackup.CreationTimestamp.Before(&veleroBackup.CreationTimestamp) {
+			lastVeleroBackup = veleroBackup
+			lastNonAdminBackup = nabName
+		}
+	}
+
+	updated := false
+	if nas.Status.LastNonAdminBackup != lastNonAdminBackup {
+		nas.Status.LastNonAdminBackup = lastNonAdminBackup
+		updated = true
+	}
+	var queueInfo *nacv1alpha1.QueueInfo
+	if lastVeleroBackup != nil {
+		lastQueueInfo, err := function.GetBackupQueueInfo(ctx, r.Client, r.OADPNamespace, lastVeleroBackup)
+		if err != nil {
+			// Log error and continue with the reconciliation, this is not critical error as it's just
+			// about the Velero Backup queue position information
+			logger.Error(err, "Failed to get the queue position for the VeleroBackup")
+			queueInfo = nas.Status.QueueInfo
+		} else {
+			queueInfo = &lastQueueInfo
+		}
+	}
+	if !reflect.DeepEqual(nas.Status.QueueInfo, queueInfo) {
+		nas.Status.QueueInfo = queueInfo
+		updated = true
+	}
+
+	if updated {
+		if err := r.Status().Update(ctx, nas); err != nil {
+			logger.Error(err, nonAdminScheduleStatusUpdateFailureMessage)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminSchedule - Exit after Status Update")
+	}
+	return false, nil
+}
+
+// adoptScheduledVeleroBackup labels the Velero Backup created by the VeleroSchedule of the NonAdminSchedule for a
+// NonAdminBackup, if not done yet, and creates the NonAdminBackup if it does not exist. It returns the name of
+// the NonAdminBackup.
+func (r *NonAdminScheduleReconciler) adoptScheduledVeleroBackup(ctx context.Context, logger logr.Logger, nas *nacv1alpha1.NonAdminSchedule, veleroBackup *velerov1.Backup) (string, error) {
+	nabName := scheduledNonAdminBackupName(nas, veleroBackup)
+	veleroBackupNACUUID := veleroBackup.Labels[constant.NabOriginNACUUIDLabel]
+	if veleroBackupNACUUID == constant.EmptyString {
+		veleroBackupNACUUID = function.GenerateNacObjectUUID(nas.Namespace, nabName)
+		original := veleroBackup.DeepCopy()
+		veleroBackup.Labels[constant.NabOriginNACUUIDLabel] = veleroBackupNACUUID
+		if veleroBackup.Annotations == nil {
+			veleroBackup.Annotations = map[string]string{}
+		}
+		for key, value := range function.GetNonAdminBackupAnnotations(metav1.ObjectMeta{Namespace: nas.Namespace, Name: nabName}) {
+			veleroBackup.Annotations[key] = value
+		}
+		if err := r.Patch(ctx, veleroBackup, client.MergeFrom(original)); err != nil {
+			logger.Error(err, "Failed to label VeleroBackup for NonAdminBackup", constant.NameString, veleroBackup.Name)
+			return constant.EmptyString, err
+		}
+		logger.V(1).Info("VeleroBackup labeled for NonAdminBackup", constant.NameString, veleroBackup.Name)
+	} else {
+		nabName = veleroBackup.Annotations[constant.NabOriginNameAnnotation]
+	}
+
+	err := r.Get(ctx, types.NamespacedName{Name: nabName, Namespace: nas.Namespace}, &nacv1alpha1.NonAdminBackup{})
+	if err == nil {
+		return nabName, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		logger.Error(err, "Unable to fetch NonAdminBackup")
+		return constant.EmptyString, err
+	}
+
+	nab := &nacv1alpha1.NonAdminBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nabName,
+			Namespace: nas.Namespace,
+			Labels: map[string]string{
+				constant.NabSyncLabel:         veleroBackupNACUUID,
+				constant.NabScheduleNameLabel: nas.Name,
+			},
+		},
+		Spec: nacv1alpha1.NonAdminBackupSpec{
+			BackupSpec: nas.Spec.ScheduleSpec.Template.DeepCopy(),
+		},
+	}
+	if err = r.Create(ctx, nab); err != nil && !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to create NonAdminBackup", constant.NameString, nabName)
+		return constant.EmptyString, err
+	}
+	logger.V(1).Info("NonAdminBackup created for VeleroBackup", constant.NameString, nabName)
+	return nabName, nil
+}
+
+// scheduledNonAdminBackupName returns the name of the NonAdminBackup of a Velero Backup created by the
+// VeleroSchedule of the NonAdminSchedule, which is the NonAdminSchedule name followed by the timestamp
+// Velero suffixed the VeleroSchedule name with
+func scheduledNonAdminBackupName(nas *nacv1alpha1.NonAdminSchedule, veleroBackup *velerov1.Backup) string {
+	suffix := strings.TrimPrefix(veleroBackup.Name, veleroBackup.Labels[velerov1.ScheduleNameLabel])
+	name := nas.Name
+	if len(name)+len(suffix) > validation.DNS1123SubdomainMaxLength {
+		name = name[:validation.DNS1123SubdomainMaxLength-len(suffix)]
+	}
+	return name + suffix
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NonAdminScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminSchedule{}).
+		WithEventFilter(predicate.CompositeSchedulePredicate{
+			NonAdminSchedulePredicate: predicate.NonAdminSchedulePredicate{},
+			VeleroSchedulePredicate: predicate.VeleroSchedulePredicate{
+				OADPNamespace: r.OADPNamespace,
+			},
+		}).
+		// handler runs after predicate
+		Watches(&velerov1.Schedule{}, &handler.VeleroScheduleHandler{}).
+		Watches(&velerov1.Backup{}, &handler.VeleroScheduleHandler{}).
+		Complete(r)
+}
+
+// nonAdminPhaseForVeleroSchedule returns the NonAdminSchedule phase matching the VeleroSchedule phase
+func nonAdminPhaseForVeleroSchedule(veleroSchedule *velerov1.Schedule) nacv1alpha1.NonAdminPhase {
+	if veleroSchedule.Status.Phase == velerov1.SchedulePhaseFailedValidation {
+		return nacv1alpha1.NonAdminPhaseFailed
+	}
+	return nacv1alpha1.NonAdminPhaseCreated
+}
+
+// updateNonAdminScheduleVeleroScheduleStatus sets the VeleroSchedule spec and status in the NonAdminSchedule
+// status and returns true if they changed
+func updateNonAdminScheduleVeleroScheduleStatus(status *nacv1alpha1.NonAdminScheduleStatus, veleroSchedule *velerov1.Schedule) bool {
+	if status.VeleroSchedule == nil {
+		status.VeleroSchedule = &nacv1alpha1.VeleroSchedule{}
+	}
+	if status.VeleroSchedule.Name == veleroSchedule.Name &&
+		reflect.DeepEqual(status.VeleroSchedule.Spec, &veleroSchedule.Spec) &&
+		reflect.DeepEqual(status.VeleroSchedule.Status, &veleroSchedule.Status) {
+		return false
+	}
+
+	status.VeleroSchedule.Name = veleroSchedule.Name
+	status.VeleroSchedule.Spec = veleroSchedule.Spec.DeepCopy()
+	status.VeleroSchedule.Status = veleroSchedule.Status.DeepCopy()
+	return true
+}
+
+// updateNonAdminScheduleEnforcedFieldsStatus sets the enforced fields, and the SpecOverridden condition,
+// in the NonAdminSchedule status and returns true if they changed
+func updateNonAdminScheduleEnforcedFieldsStatus(status *nacv1alpha1.NonAdminScheduleStatus, enforcedFields []string) bool {
+	if len(enforcedFields) == 0 {
+		updated := status.EnforcedFields != nil
+		status.EnforcedFields = nil
+		return meta.RemoveStatusCondition(&status.Conditions, string(nacv1alpha1.NonAdminConditionSpecOverridden)) || updated
+	}
+
+	updated := !slices.Equal(status.EnforcedFields, enforcedFields)
+	status.EnforcedFields = enforcedFields
+	return meta.SetStatusCondition(&status.Conditions,
+		metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionSpecOverridden),
+			Status:  metav1.ConditionTrue,
+			Reason:  "EnforcedFieldsApplied",
+			Message: "spec.scheduleSpec.template fields set or overridden in the Velero Schedule: " + strings.Join(enforcedFields, ", "),
+		},
+	) || updated
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+type nonAdminScheduleReconcileScenario struct {
+	spec          *velerov1.ScheduleSpec
+	expectedPhase nacv1alpha1.NonAdminPhase
+	errMessage    string
+}
+
+var _ = ginkgo.Describe("Test NonAdminSchedule Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nas-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nas-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	newReconciler := func() *NonAdminScheduleReconciler {
+		return &NonAdminScheduleReconciler{
+			Client: k8sClient,
+			Scheme: testEnv.Scheme,
+			BackupReconciler: &NonAdminBackupReconciler{
+				Client:             k8sClient,
+				Scheme:             testEnv.Scheme,
+				OADPNamespace:      oadpNamespace,
+				EnforcedBackupSpec: &velerov1.BackupSpec{},
+			},
+			OADPNamespace: oadpNamespace,
+		}
+	}
+
+	ginkgo.DescribeTable("Reconcile validates the NonAdminSchedule spec",
+		func(scenario nonAdminScheduleReconcileScenario) {
+			nonAdminSchedule := &nacv1alpha1.NonAdminSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      nonAdminObjectName,
+					Namespace: nonAdminObjectNamespace,
+				},
+				Spec: nacv1alpha1.NonAdminScheduleSpec{
+					ScheduleSpec: scenario.spec,
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, nonAdminSchedule)).To(gomega.Succeed())
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+			}}
+			_, err := newReconciler().Reconcile(ctx, request)
+			if scenario.errMessage == constant.EmptyString {
+				gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+			} else {
+				gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(scenario.errMessage)))
+			}
+
+			gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminSchedule)).To(gomega.Succeed())
+			gomega.Expect(nonAdminSchedule.Status.Phase).To(gomega.Equal(scenario.expectedPhase))
+			gomega.Expect(meta.IsStatusConditionTrue(nonAdminSchedule.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))).To(gomega.Equal(scenario.errMessage == constant.EmptyString))
+		},
+		ginkgo.Entry("Should accept NonAdminSchedule with valid spec", nonAdminScheduleReconcileScenario{
+			spec:          &velerov1.ScheduleSpec{Schedule: "0 1 * * *"},
+			expectedPhase: nacv1alpha1.NonAdminPhaseCreated,
+		}),
+		ginkgo.Entry("Should not accept NonAdminSchedule with useOwnerReferencesInBackup set to true", nonAdminScheduleReconcileScenario{
+			spec: &velerov1.ScheduleSpec{
+				Schedule:                   "0 1 * * *",
+				UseOwnerReferencesInBackup: ptr.To(true),
+			},
+			expectedPhase: nacv1alpha1.NonAdminPhaseBackingOff,
+			errMessage:    "spec.scheduleSpec.useOwnerReferencesInBackup",
+		}),
+		ginkgo.Entry("Should not accept NonAdminSchedule whose template includes other namespaces", nonAdminScheduleReconcileScenario{
+			spec: &velerov1.ScheduleSpec{
+				Schedule: "0 1 * * *",
+				Template: velerov1.BackupSpec{IncludedNamespaces: []string{"other-namespace"}},
+			},
+			expectedPhase: nacv1alpha1.NonAdminPhaseBackingOff,
+			errMessage:    "spec.backupSpec.includedNamespaces",
+		}),
+	)
+
+	ginkgo.It("Should surface the Velero Backups of the Velero Schedule as NonAdminBackups", func() {
+		reconciler := newReconciler()
+		request := reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      nonAdminObjectName,
+			Namespace: nonAdminObjectNamespace,
+		}}
+
+		nonAdminSchedule := &nacv1alpha1.NonAdminSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+			},
+			Spec: nacv1alpha1.NonAdminScheduleSpec{
+				ScheduleSpec: &velerov1.ScheduleSpec{Schedule: "0 1 * * *"},
+			},
+		}
+		gomega.Expect(k8sClient.Create(ctx, nonAdminSchedule)).To(gomega.Succeed())
+
+		ginkgo.By("Creating the Velero Schedule")
+		_, err := reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminSchedule)).To(gomega.Succeed())
+		veleroSchedule := &velerov1.Schedule{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminSchedule.VeleroScheduleName(), Namespace: oadpNamespace}, veleroSchedule)).To(gomega.Succeed())
+		gomega.Expect(function.CheckVeleroScheduleMetadata(veleroSchedule)).To(gomega.BeTrue())
+		gomega.Expect(veleroSchedule.Spec.Schedule).To(gomega.Equal("0 1 * * *"))
+		gomega.Expect(veleroSchedule.Spec.Template.IncludedNamespaces).To(gomega.Equal([]string{nonAdminObjectNamespace}))
+
+		ginkgo.By("Simulating a Velero Backup created by the Velero Schedule")
+		veleroBackupLabels := map[string]string{velerov1.ScheduleNameLabel: veleroSchedule.Name}
+		for key, value := range veleroSchedule.Labels {
+			veleroBackupLabels[key] = value
+		}
+		veleroBackup := &velerov1.Backup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        veleroSchedule.Name + "-20240101010000",
+				Namespace:   oadpNamespace,
+				Labels:      veleroBackupLabels,
+				Annotations: veleroSchedule.Annotations,
+			},
+			Spec: veleroSchedule.Spec.Template,
+		}
+		gomega.Expect(k8sClient.Create(ctx, veleroBackup)).To(gomega.Succeed())
+
+		_, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		nonAdminBackupName := nonAdminObjectName + "-20240101010000"
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminSchedule)).To(gomega.Succeed())
+		gomega.Expect(nonAdminSchedule.Status.LastNonAdminBackup).To(gomega.Equal(nonAdminBackupName))
+
+		nonAdminBackup := &nacv1alpha1.NonAdminBackup{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupName, Namespace: nonAdminObjectNamespace}, nonAdminBackup)).To(gomega.Succeed())
+		gomega.Expect(nonAdminBackup.Labels[constant.NabScheduleNameLabel]).To(gomega.Equal(nonAdminObjectName))
+
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: veleroBackup.Name, Namespace: oadpNamespace}, veleroBackup)).To(gomega.Succeed())
+		gomega.Expect(function.CheckVeleroBackupMetadata(veleroBackup)).To(gomega.BeTrue())
+		gomega.Expect(veleroBackup.Labels[constant.NabOriginNACUUIDLabel]).To(gomega.Equal(nonAdminBackup.Labels[constant.NabSyncLabel]))
+
+		ginkgo.By("Deleting the NonAdminSchedule")
+		gomega.Expect(k8sClient.Delete(ctx, nonAdminSchedule)).To(gomega.Succeed())
+		_, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+
+		err = k8sClient.Get(ctx, types.NamespacedName{Name: veleroSchedule.Name, Namespace: oadpNamespace}, veleroSchedule)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+
+		_, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		err = k8sClient.Get(ctx, request.NamespacedName, nonAdminSchedule)
+		gomega.Expect(apierrors.IsNotFound(err)).To(gomega.BeTrue())
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminBackupName, Namespace: nonAdminObjectNamespace}, nonAdminBackup)).To(gomega.Succeed())
+	})
+})
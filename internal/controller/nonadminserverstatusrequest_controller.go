@@ -0,0 +1,184 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// NonAdminServerStatusRequestReconciler reconciles a NonAdminServerStatusRequest object
+type NonAdminServerStatusRequestReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	OADPNamespace string
+	// VeleroFeatureFlags are the Velero feature flags enabled in the DPA, which Velero does not report
+	VeleroFeatureFlags []string
+}
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminserverstatusrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminserverstatusrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminserverstatusrequests/finalizers,verbs=update
+// +kubebuilder:rbac:groups=velero.io,resources=serverstatusrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=serverstatusrequests/status,verbs=get
+
+// Reconcile the NonAdminServerStatusRequest object against the actual cluster state, and then
+// perform operations to make the cluster state reflect the state specified by
+// the user.
+//
+// A Velero ServerStatusRequest is created in the OADP namespace for each NonAdminServerStatusRequest,
+// and its status is copied to the NonAdminServerStatusRequest once Velero processed it. Velero deletes
+// processed ServerStatusRequests by itself, the NonAdminServerStatusRequest is kept until the user deletes it.
+// Notes for test, this function expect req to come in with k8s UID already populated like real cluster
+func (r *NonAdminServerStatusRequestReconciler) Reconcile(ctx context.Context, req *nacv1alpha1.NonAdminServerStatusRequest) (reconcile.Result, error) {
+	if req == nil || !req.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	logger := log.FromContext(ctx)
+	logger.Info("Reconciling NonAdminServerStatusRequest")
+	// once processed, the NonAdminServerStatusRequest status is not refreshed, a new one must be created
+	if req.Status.VeleroServerStatusRequest.Status != nil &&
+		req.Status.VeleroServerStatusRequest.Status.Phase == velerov1.ServerStatusRequestPhaseProcessed {
+		return ctrl.Result{}, nil
+	}
+	veleroSSR := velerov1.ServerStatusRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.VeleroServerStatusRequestName(),
+			Namespace: r.OADPNamespace,
+			Labels: func() map[string]string {
+				nal := function.GetNonAdminLabels()
+				nal[constant.NassrOriginNACUUIDLabel] = string(req.GetUID())
+				return nal
+			}(),
+			Annotations: function.GetNonAdminServerStatusRequestAnnotations(req),
+		},
+	}
+	// try get veleroSSR if exists, then update status
+	if err := r.Get(ctx, types.NamespacedName{Namespace: veleroSSR.Namespace, Name: veleroSSR.Name}, &veleroSSR); err == nil {
+		return reconcile.Result{}, r.updateNASSRWithServerStatus(ctx, &veleroSSR, req)
+	} else if !apierrors.IsNotFound(err) {
+		// some other errors, requeue to retry get
+		return reconcile.Result{}, err
+	}
+	// veleroSSR is not found, so we create one
+	if err := r.Create(ctx, &veleroSSR); err != nil && !apierrors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to create Velero ServerStatusRequest")
+		// requeue so Get can update nassr status (if exists) or recreate
+		return reconcile.Result{}, err
+	}
+	if req.Status.Phase == constant.EmptyString {
+		prePatch := req.DeepCopy()
+		req.Status.Phase = nacv1alpha1.NonAdminPhaseNew
+		if patchErr := r.Status().Patch(ctx, req, client.MergeFrom(prePatch)); patchErr != nil {
+			logger.Error(patchErr, statusPatchErr)
+			return reconcile.Result{}, patchErr
+		}
+	}
+	//  veleroSSR is created, when veleroSSR status is updated, the watch will trigger reconcile
+	return reconcile.Result{}, nil
+}
+
+// if velero server status request is processed, then copy its status and set status to created
+func (r *NonAdminServerStatusRequestReconciler) updateNASSRWithServerStatus(ctx context.Context, veleroSSR *velerov1.ServerStatusRequest, req *nacv1alpha1.NonAdminServerStatusRequest) error {
+	if veleroSSR.Status.Phase != velerov1.ServerStatusRequestPhaseProcessed {
+		return nil
+	}
+	prePatch := req.DeepCopy()
+	req.Status.VeleroServerStatusRequest.Status = veleroSSR.Status.DeepCopy()
+	req.Status.FeatureFlags = r.VeleroFeatureFlags
+	req.Status.Phase = nacv1alpha1.NonAdminPhaseCreated
+	req.Status.Conditions = []metav1.Condition{
+		{
+			Type:               string(nacv1alpha1.ConditionNonAdminProcessed),
+			Status:             metav1.ConditionTrue,
+			Reason:             "Success",
+			LastTransitionTime: metav1.Time{Time: time.Now()},
+		},
+	}
+	if patchErr := r.Status().Patch(ctx, req, client.MergeFrom(prePatch)); patchErr != nil {
+		log.FromContext(ctx).Error(patchErr, "unable to patch status")
+		return patchErr
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// Like the NonAdminDownloadRequest controller, predicates are defined within For and Watches.
+func (r *NonAdminServerStatusRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminServerStatusRequest{}, builder.WithPredicates(ctrlpredicate.Funcs{
+			CreateFunc: func(_ event.TypedCreateEvent[client.Object]) bool {
+				return true // spec is empty, every request is processed
+			},
+			UpdateFunc: func(_ event.TypedUpdateEvent[client.Object]) bool {
+				return false // spec is empty, status updates are done by this controller
+			},
+			DeleteFunc: func(_ event.TypedDeleteEvent[client.Object]) bool {
+				return false // Velero processes and then deletes the ServerStatusRequest anyway
+			},
+			GenericFunc: func(_ event.TypedGenericEvent[client.Object]) bool {
+				return false
+			},
+		})).
+		Named("nonadminserverstatusrequest").
+		Watches(&velerov1.ServerStatusRequest{}, handler.Funcs{
+			UpdateFunc: func(ctx context.Context, tue event.TypedUpdateEvent[client.Object], rli workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+				if ssr, ok := tue.ObjectNew.(*velerov1.ServerStatusRequest); ok &&
+					ssr.Status.Phase == velerov1.ServerStatusRequestPhaseProcessed { // only reconcile on updates when serverstatusrequests is processed
+					log := function.GetLogger(ctx, ssr, "VeleroServerStatusRequestHandler")
+					log.V(1).Info("ServerStatusRequest processed")
+					rli.Add(reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: ssr.Annotations[constant.NassrOriginNamespaceAnnotation],
+							Name:      ssr.Annotations[constant.NassrOriginNameAnnotation],
+						},
+					})
+				}
+			},
+		}, builder.WithPredicates(
+			ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+				// only watch OADP NS
+				if object.GetNamespace() != r.OADPNamespace {
+					return false
+				}
+				// only watch server status requests with our label
+				_, hasUID := object.GetLabels()[constant.NassrOriginNACUUIDLabel]
+				return hasUID
+			}),
+		),
+		).
+		Complete(reconcile.AsReconciler(r.Client, r))
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+var _ = ginkgo.Describe("Test NonAdminServerStatusRequest Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("nassr-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-nassr-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should report the Velero server status and feature flags once the ServerStatusRequest is processed", func() {
+		reconciler := &NonAdminServerStatusRequestReconciler{
+			Client:             k8sClient,
+			Scheme:             testEnv.Scheme,
+			OADPNamespace:      oadpNamespace,
+			VeleroFeatureFlags: []string{"EnableCSI"},
+		}
+		nonAdminServerStatusRequest := &nacv1alpha1.NonAdminServerStatusRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nonAdminObjectName,
+				Namespace: nonAdminObjectNamespace,
+			},
+		}
+		gomega.Expect(k8sClient.Create(ctx, nonAdminServerStatusRequest)).To(gomega.Succeed())
+		request := types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}
+
+		ginkgo.By("Creating the Velero ServerStatusRequest")
+		result, err := reconciler.Reconcile(ctx, nonAdminServerStatusRequest)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+		gomega.Expect(k8sClient.Get(ctx, request, nonAdminServerStatusRequest)).To(gomega.Succeed())
+		gomega.Expect(nonAdminServerStatusRequest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseNew))
+
+		veleroSSR := &velerov1.ServerStatusRequest{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: nonAdminServerStatusRequest.VeleroServerStatusRequestName(), Namespace: oadpNamespace}, veleroSSR)).To(gomega.Succeed())
+		gomega.Expect(veleroSSR.Labels[constant.NassrOriginNACUUIDLabel]).To(gomega.Equal(string(nonAdminServerStatusRequest.UID)))
+		gomega.Expect(function.CheckLabelAnnotationValueIsValid(veleroSSR.Annotations, constant.NassrOriginNameAnnotation)).To(gomega.BeTrue())
+
+		ginkgo.By("Processing the Velero ServerStatusRequest")
+		veleroSSR.Status = velerov1.ServerStatusRequestStatus{
+			Phase:              velerov1.ServerStatusRequestPhaseProcessed,
+			ProcessedTimestamp: &metav1.Time{Time: metav1.Now().Time},
+			ServerVersion:      "v1.16.0",
+			Plugins: []velerov1.PluginInfo{
+				{Name: "velero.io/aws", Kind: "ObjectStore"},
+			},
+		}
+		gomega.Expect(k8sClient.Status().Update(ctx, veleroSSR)).To(gomega.Succeed())
+
+		result, err = reconciler.Reconcile(ctx, nonAdminServerStatusRequest)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+		gomega.Expect(k8sClient.Get(ctx, request, nonAdminServerStatusRequest)).To(gomega.Succeed())
+		gomega.Expect(nonAdminServerStatusRequest.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+		gomega.Expect(meta.IsStatusConditionTrue(nonAdminServerStatusRequest.Status.Conditions, string(nacv1alpha1.ConditionNonAdminProcessed))).To(gomega.BeTrue())
+		gomega.Expect(nonAdminServerStatusRequest.Status.FeatureFlags).To(gomega.Equal([]string{"EnableCSI"}))
+		gomega.Expect(nonAdminServerStatusRequest.Status.VeleroServerStatusRequest.Status).NotTo(gomega.BeNil())
+		gomega.Expect(nonAdminServerStatusRequest.Status.VeleroServerStatusRequest.Status.ServerVersion).To(gomega.Equal("v1.16.0"))
+		gomega.Expect(nonAdminServerStatusRequest.Status.VeleroServerStatusRequest.Status.Plugins).To(gomega.HaveLen(1))
+	})
+})
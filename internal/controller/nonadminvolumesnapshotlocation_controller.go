@@ -0,0 +1,626 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const (
+	statusVslUpdateError        = "Failed to update NonAdminVolumeSnapshotLocation Status"
+	findSingleNAVSLRequestError = "Error encountered while retrieving NonAdminVolumeSnapshotLocationRequest for NAVSL"
+	findSingleVVSLSecretError   = "Error encountered while retrieving Velero VSL Secret for NAVSL"
+)
+
+// NonAdminVolumeSnapshotLocationReconciler reconciles a NonAdminVolumeSnapshotLocation object
+type NonAdminVolumeSnapshotLocationReconciler struct {
+	client.Client
+	Scheme        *runtime.Scheme
+	OADPNamespace string
+	// RequireApprovalForBSL requires the cluster admin to approve the NonAdminVolumeSnapshotLocationRequests,
+	// like the NonAdminBackupStorageLocationRequests. Otherwise they are approved by NAC.
+	RequireApprovalForBSL bool
+}
+
+type naVSLReconcileStepFunction func(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error)
+
+// +kubebuilder:rbac:groups=velero.io,resources=volumesnapshotlocations,verbs=get;list;watch;create;update;patch;delete
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminvolumesnapshotlocations,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminvolumesnapshotlocations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminvolumesnapshotlocations/finalizers,verbs=update
+
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminvolumesnapshotlocationrequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=oadp.openshift.io,resources=nonadminvolumesnapshotlocationrequests/status,verbs=get;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *NonAdminVolumeSnapshotLocationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.V(1).Info("NonAdminVolumeSnapshotLocation Reconcile start")
+
+	navsl := &nacv1alpha1.NonAdminVolumeSnapshotLocation{}
+	err := r.Get(ctx, req.NamespacedName, navsl)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.V(1).Info(err.Error())
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Unable to fetch NonAdminVolumeSnapshotLocation")
+		return ctrl.Result{}, err
+	}
+
+	var reconcileSteps []naVSLReconcileStepFunction
+	if !navsl.DeletionTimestamp.IsZero() {
+		logger.V(1).Info("Executing direct deletion path")
+		reconcileSteps = []naVSLReconcileStepFunction{
+			r.initNaVSLDelete,
+			r.deleteNonAdminVSLRequest,
+			r.deleteVeleroVSLSecret,
+			r.deleteVeleroVSL,
+			r.removeNaVSLFinalizer,
+		}
+	} else {
+		logger.V(1).Info("Executing navsl creation/update path")
+		reconcileSteps = []naVSLReconcileStepFunction{
+			r.initNaVSLCreate,
+			r.validateNaVSLSpec,
+			r.setVeleroVSLUUIDInNaVSLStatus,
+			r.createNonAdminVSLRequest,
+			r.setFinalizerOnNaVSL,
+			r.ensureNonAdminVSLRequest,
+			r.syncVSLSecret,
+			r.createVeleroVSL,
+			r.syncNaVSLStatus,
+		}
+	}
+
+	for _, step := range reconcileSteps {
+		requeue, err := step(ctx, logger, navsl)
+		if err != nil {
+			return ctrl.Result{}, err
+		} else if requeue {
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	logger.V(1).Info("NonAdminVolumeSnapshotLocation Reconcile exit")
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// The VeleroVolumeSnapshotLocations and NonAdminVolumeSnapshotLocationRequests of the OADP namespace
+// are mapped to their NonAdminVolumeSnapshotLocation by their NAC annotations.
+func (r *NonAdminVolumeSnapshotLocationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isNaVSLObject := ctrlpredicate.NewPredicateFuncs(func(object client.Object) bool {
+		return object.GetNamespace() == r.OADPNamespace &&
+			function.CheckLabelAnnotationValueIsValid(object.GetLabels(), constant.NavslOriginNACUUIDLabel)
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nacv1alpha1.NonAdminVolumeSnapshotLocation{}, ctrlbuilder.WithPredicates(ctrlpredicate.Or(
+			ctrlpredicate.GenerationChangedPredicate{},
+			ctrlpredicate.AnnotationChangedPredicate{},
+		))).
+		Named("nonadminvolumesnapshotlocation").
+		Watches(&velerov1.VolumeSnapshotLocation{}, handler.EnqueueRequestsFromMapFunc(mapToNaVSL),
+			ctrlbuilder.WithPredicates(isNaVSLObject)).
+		Watches(&nacv1alpha1.NonAdminVolumeSnapshotLocationRequest{}, handler.EnqueueRequestsFromMapFunc(mapToNaVSL),
+			ctrlbuilder.WithPredicates(isNaVSLObject)).
+		Complete(r)
+}
+
+// mapToNaVSL returns the NonAdminVolumeSnapshotLocation an object of the OADP namespace was created for
+func mapToNaVSL(_ context.Context, object client.Object) []reconcile.Request {
+	annotations := object.GetAnnotations()
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Name:      annotations[constant.NavslOriginNameAnnotation],
+		Namespace: annotations[constant.NavslOriginNamespaceAnnotation],
+	}}}
+}
+
+// initNaVSLDelete initializes deletion of the NonAdminVolumeSnapshotLocation object
+func (r *NonAdminVolumeSnapshotLocationReconciler) initNaVSLDelete(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	logger.V(1).Info("NonAdminVolumeSnapshotLocation deletion initialized")
+
+	if updated := updateNonAdminPhase(&navsl.Status.Phase, nacv1alpha1.NonAdminPhaseDeleting); updated {
+		if err := r.Status().Update(ctx, navsl); err != nil {
+			logger.Error(err, statusVslUpdateError)
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// deleteNonAdminVSLRequest deletes the NonAdminVolumeSnapshotLocationRequest of the NonAdminVolumeSnapshotLocation
+func (r *NonAdminVolumeSnapshotLocationReconciler) deleteNonAdminVSLRequest(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	if navsl.Status.VeleroVolumeSnapshotLocation == nil || navsl.Status.VeleroVolumeSnapshotLocation.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+
+	navslRequest, err := function.GetNavslRequestByLabel(ctx, r.Client, r.OADPNamespace, navsl.Status.VeleroVolumeSnapshotLocation.NACUUID)
+	if err != nil {
+		logger.Error(err, findSingleNAVSLRequestError)
+		return false, err
+	}
+	if navslRequest == nil {
+		logger.V(1).Info("NonAdminVolumeSnapshotLocationRequest not found")
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, navslRequest); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete NonAdminVolumeSnapshotLocationRequest")
+		return false, err
+	}
+	logger.V(1).Info("NonAdminVolumeSnapshotLocationRequest deleted")
+	return false, nil
+}
+
+// deleteVeleroVSLSecret deletes the Secret synced to the OADP namespace for the VeleroVolumeSnapshotLocation
+func (r *NonAdminVolumeSnapshotLocationReconciler) deleteVeleroVSLSecret(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	if navsl.Status.VeleroVolumeSnapshotLocation == nil || navsl.Status.VeleroVolumeSnapshotLocation.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+
+	veleroVslSecret, err := function.GetVslSecretByLabel(ctx, r.Client, r.OADPNamespace, navsl.Status.VeleroVolumeSnapshotLocation.NACUUID)
+	if err != nil {
+		logger.Error(err, findSingleVVSLSecretError)
+		return false, err
+	}
+	if veleroVslSecret == nil {
+		logger.V(1).Info("Velero VolumeSnapshotLocation Secret not found")
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, veleroVslSecret); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete Velero VolumeSnapshotLocation Secret")
+		return false, err
+	}
+	logger.V(1).Info("Velero VolumeSnapshotLocation Secret deleted")
+	return false, nil
+}
+
+// deleteVeleroVSL deletes the VeleroVolumeSnapshotLocation of the NonAdminVolumeSnapshotLocation
+func (r *NonAdminVolumeSnapshotLocationReconciler) deleteVeleroVSL(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	if navsl.Status.VeleroVolumeSnapshotLocation == nil || navsl.Status.VeleroVolumeSnapshotLocation.NACUUID == constant.EmptyString {
+		return false, nil
+	}
+
+	veleroVsl, err := function.GetVeleroVolumeSnapshotLocationByLabel(ctx, r.Client, r.OADPNamespace, navsl.Status.VeleroVolumeSnapshotLocation.NACUUID)
+	if err != nil {
+		logger.Error(err, "Failed to get Velero VolumeSnapshotLocation")
+		return false, err
+	}
+	if veleroVsl == nil {
+		logger.V(1).Info("Velero VolumeSnapshotLocation not found")
+		return false, nil
+	}
+
+	if err := r.Delete(ctx, veleroVsl); err != nil && !apierrors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete Velero VolumeSnapshotLocation")
+		return false, err
+	}
+	logger.V(1).Info("Velero VolumeSnapshotLocation deleted")
+	return false, nil
+}
+
+// removeNaVSLFinalizer removes the finalizer from the NonAdminVolumeSnapshotLocation once its Velero objects are deleted
+func (r *NonAdminVolumeSnapshotLocationReconciler) removeNaVSLFinalizer(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	if !controllerutil.ContainsFinalizer(navsl, constant.NavslFinalizerName) {
+		logger.V(1).Info("NonAdminVolumeSnapshotLocation finalizer not found")
+		return false, nil
+	}
+
+	controllerutil.RemoveFinalizer(navsl, constant.NavslFinalizerName)
+	if err := r.Update(ctx, navsl); err != nil {
+		logger.Error(err, "Failed to remove finalizer")
+		return false, err
+	}
+	logger.V(1).Info("NonAdminVolumeSnapshotLocation finalizer removed")
+	return false, nil
+}
+
+// initNaVSLCreate initializes creation of the NonAdminVolumeSnapshotLocation object
+func (r *NonAdminVolumeSnapshotLocationReconciler) initNaVSLCreate(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	if navsl.Status.Phase != constant.EmptyString {
+		logger.V(1).Info("NonAdminVolumeSnapshotLocation Phase already initialized", constant.CurrentPhaseString, navsl.Status.Phase)
+		return false, nil
+	}
+
+	if updated := updateNonAdminPhase(&navsl.Status.Phase, nacv1alpha1.NonAdminPhaseNew); updated {
+		if err := r.Status().Update(ctx, navsl); err != nil {
+			logger.Error(err, statusVslUpdateError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminVolumeSnapshotLocation Phase set to New")
+	}
+	return false, nil
+}
+
+// validateNaVSLSpec validates the NonAdminVolumeSnapshotLocation spec
+func (r *NonAdminVolumeSnapshotLocationReconciler) validateNaVSLSpec(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	err := function.ValidateVslSpec(ctx, r.Client, navsl)
+	if err != nil {
+		updatedPhase := updateNonAdminPhase(&navsl.Status.Phase, nacv1alpha1.NonAdminPhaseBackingOff)
+		updatedCondition := meta.SetStatusCondition(&navsl.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  "VslSpecValidation",
+			Message: err.Error(),
+		})
+		if updatedPhase || updatedCondition {
+			if updateErr := r.Status().Update(ctx, navsl); updateErr != nil {
+				logger.Error(updateErr, statusVslUpdateError)
+				return false, updateErr
+			}
+		}
+		return false, reconcile.TerminalError(err)
+	}
+
+	if updated := meta.SetStatusCondition(&navsl.Status.Conditions, metav1.Condition{
+		Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             "VslSpecValidation",
+		Message:            "NonAdminVolumeSnapshotLocation spec validation successful",
+		ObservedGeneration: navsl.Generation,
+	}); updated {
+		if updateErr := r.Status().Update(ctx, navsl); updateErr != nil {
+			logger.Error(updateErr, statusVslUpdateError)
+			return false, updateErr
+		}
+		logger.V(1).Info("NonAdminVolumeSnapshotLocation Condition set to Validated")
+	}
+	return false, nil
+}
+
+// setVeleroVSLUUIDInNaVSLStatus sets the UUID of the VeleroVolumeSnapshotLocation in the NonAdminVolumeSnapshotLocation status
+func (r *NonAdminVolumeSnapshotLocationReconciler) setVeleroVSLUUIDInNaVSLStatus(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	if navsl.Status.VeleroVolumeSnapshotLocation != nil && navsl.Status.VeleroVolumeSnapshotLocation.NACUUID != constant.EmptyString {
+		logger.V(1).Info("NonAdminVolumeSnapshotLocation already contains VeleroVolumeSnapshotLocation UUID reference")
+		return false, nil
+	}
+
+	veleroVslNACUUID := function.GenerateNacObjectUUID(navsl.Namespace, navsl.Name)
+	navsl.Status.VeleroVolumeSnapshotLocation = &nacv1alpha1.VeleroVolumeSnapshotLocation{
+		NACUUID:   veleroVslNACUUID,
+		Namespace: r.OADPNamespace,
+		Name:      veleroVslNACUUID,
+	}
+	if err := r.Status().Update(ctx, navsl); err != nil {
+		logger.Error(err, statusVslUpdateError)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminVolumeSnapshotLocation - Status Updated with UUID reference")
+	return false, nil
+}
+
+// createNonAdminVSLRequest creates the NonAdminVolumeSnapshotLocationRequest of the NonAdminVolumeSnapshotLocation
+// in the OADP namespace, approved right away unless RequireApprovalForBSL is set
+func (r *NonAdminVolumeSnapshotLocationReconciler) createNonAdminVSLRequest(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	veleroObjectsNACUUID := navsl.Status.VeleroVolumeSnapshotLocation.NACUUID
+
+	navslRequest, err := function.GetNavslRequestByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		logger.Error(err, findSingleNAVSLRequestError)
+		return false, err
+	}
+	if navslRequest != nil {
+		return false, nil
+	}
+
+	approvalDecision := nacv1alpha1.NonAdminBSLRequestPending
+	if !r.RequireApprovalForBSL {
+		approvalDecision = nacv1alpha1.NonAdminBSLRequestApproved
+	}
+
+	labels := function.GetNonAdminLabels()
+	labels[constant.NavslOriginNACUUIDLabel] = veleroObjectsNACUUID
+
+	navslRequest = &nacv1alpha1.NonAdminVolumeSnapshotLocationRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        veleroObjectsNACUUID,
+			Namespace:   r.OADPNamespace,
+			Labels:      labels,
+			Annotations: function.GetNonAdminVolumeSnapshotLocationAnnotations(navsl.ObjectMeta),
+		},
+		Spec: nacv1alpha1.NonAdminVolumeSnapshotLocationRequestSpec{
+			ApprovalDecision: approvalDecision,
+		},
+	}
+	if err := r.Create(ctx, navslRequest); err != nil {
+		logger.Error(err, "Failed to create NonAdminVolumeSnapshotLocationRequest")
+		return false, err
+	}
+
+	updateNonAdminVSLRequestStatus(&navslRequest.Status, navsl, approvalDecision)
+	if err := r.Status().Update(ctx, navslRequest); err != nil {
+		logger.Error(err, failedUpdateStatusError)
+		return false, err
+	}
+
+	logger.V(1).Info("NonAdminVolumeSnapshotLocationRequest created successfully")
+	return true, nil
+}
+
+// setFinalizerOnNaVSL sets the finalizer on the NonAdminVolumeSnapshotLocation object, before its
+// VeleroVolumeSnapshotLocation and Secret are created, so they are not left behind
+func (r *NonAdminVolumeSnapshotLocationReconciler) setFinalizerOnNaVSL(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	if controllerutil.ContainsFinalizer(navsl, constant.NavslFinalizerName) {
+		return false, nil
+	}
+
+	controllerutil.AddFinalizer(navsl, constant.NavslFinalizerName)
+	if err := r.Update(ctx, navsl); err != nil {
+		logger.Error(err, "Failed to add finalizer")
+		return false, err
+	}
+	logger.V(1).Info("Finalizer added to NonAdminVolumeSnapshotLocation", "finalizer", constant.NavslFinalizerName)
+	return false, nil
+}
+
+// ensureNonAdminVSLRequest updates the NonAdminVolumeSnapshotLocation based on the cluster admin's approval
+// decision on its NonAdminVolumeSnapshotLocationRequest. An updated spec is requested again, and needs the approval
+// of the cluster admin if RequireApprovalForBSL is set. The VeleroVolumeSnapshotLocation and its Secret are deleted
+// while the request is not approved.
+func (r *NonAdminVolumeSnapshotLocationReconciler) ensureNonAdminVSLRequest(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	navslRequest, err := function.GetNavslRequestByLabel(ctx, r.Client, r.OADPNamespace, navsl.Status.VeleroVolumeSnapshotLocation.NACUUID)
+	if err != nil {
+		logger.Error(err, findSingleNAVSLRequestError)
+		return false, err
+	} else if navslRequest == nil {
+		err = errors.New("no NonAdminVolumeSnapshotLocationRequest found")
+		logger.Error(err, findSingleNAVSLRequestError)
+		return false, err
+	}
+
+	approvalDecision := navslRequest.Spec.ApprovalDecision
+	if navslRequest.Status.SourceNonAdminVSL != nil &&
+		!reflect.DeepEqual(navslRequest.Status.SourceNonAdminVSL.RequestedSpec, navsl.Spec.VolumeSnapshotLocationSpec) {
+		logger.V(1).Info("NonAdminVolumeSnapshotLocation spec updated, requesting it again")
+		if r.RequireApprovalForBSL && approvalDecision != nacv1alpha1.NonAdminBSLRequestPending {
+			approvalDecision = nacv1alpha1.NonAdminBSLRequestPending
+		}
+	}
+	if !r.RequireApprovalForBSL {
+		approvalDecision = nacv1alpha1.NonAdminBSLRequestApproved
+	}
+	if approvalDecision != navslRequest.Spec.ApprovalDecision {
+		patch := client.MergeFrom(navslRequest.DeepCopy())
+		navslRequest.Spec.ApprovalDecision = approvalDecision
+		if err := r.Patch(ctx, navslRequest, patch); err != nil {
+			logger.Error(err, "Failed to patch NonAdminVolumeSnapshotLocationRequest")
+			return false, err
+		}
+	}
+	if updateNonAdminVSLRequestStatus(&navslRequest.Status, navsl, approvalDecision) {
+		if err := r.Status().Update(ctx, navslRequest); err != nil {
+			logger.Error(err, failedUpdateStatusError)
+			return false, err
+		}
+	}
+
+	var terminalErr error
+	approvedCondition, reason, message, backingOff := getLocationApproval("NonAdminVolumeSnapshotLocationRequest", "Vsl", approvalDecision)
+	expectedPhase := navsl.Status.Phase
+	if backingOff {
+		expectedPhase = nacv1alpha1.NonAdminPhaseBackingOff
+	} else if approvedCondition == metav1.ConditionFalse {
+		expectedPhase = nacv1alpha1.NonAdminPhaseNew
+	}
+	if approvedCondition == metav1.ConditionFalse {
+		terminalErr = reconcile.TerminalError(errors.New(message))
+	}
+	if navslRequest.Spec.Reason != constant.EmptyString {
+		message += ": " + navslRequest.Spec.Reason
+	}
+	updated := meta.SetStatusCondition(&navsl.Status.Conditions, metav1.Condition{
+		Type:    string(nacv1alpha1.NonAdminVSLConditionApproved),
+		Status:  approvedCondition,
+		Reason:  reason,
+		Message: message,
+	})
+	updated = updateNonAdminPhase(&navsl.Status.Phase, expectedPhase) || updated
+
+	if approvedCondition == metav1.ConditionFalse {
+		if _, err := r.deleteVeleroVSLSecret(ctx, logger, navsl); err != nil {
+			return false, err
+		}
+		if _, err := r.deleteVeleroVSL(ctx, logger, navsl); err != nil {
+			return false, err
+		}
+		updated = meta.RemoveStatusCondition(&navsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionSecretSynced)) || updated
+		updated = meta.RemoveStatusCondition(&navsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionVSLSynced)) || updated
+	}
+
+	if updated {
+		if err := r.Status().Update(ctx, navsl); err != nil {
+			logger.Error(err, statusVslUpdateError)
+			return false, err
+		}
+		logger.V(1).Info("NonAdminVolumeSnapshotLocation condition updated", "Reason", reason)
+	}
+	return false, terminalErr
+}
+
+// syncVSLSecret copies the credential Secret of the NonAdminVolumeSnapshotLocation to the OADP namespace
+func (r *NonAdminVolumeSnapshotLocationReconciler) syncVSLSecret(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	credential := navsl.Spec.VolumeSnapshotLocationSpec.Credential
+	if credential == nil {
+		// The credential was removed from the spec, or never set
+		return r.deleteVeleroVSLSecret(ctx, logger, navsl)
+	}
+
+	sourceSecret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: navsl.Namespace, Name: credential.Name}, sourceSecret); err != nil {
+		logger.Error(err, "Failed to get secret", "secretName", credential.Name)
+		return false, err
+	}
+
+	veleroObjectsNACUUID := navsl.Status.VeleroVolumeSnapshotLocation.NACUUID
+	veleroVslSecret, err := function.GetVslSecretByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		logger.Error(err, findSingleVVSLSecretError, constant.UUIDString, veleroObjectsNACUUID)
+		return false, err
+	}
+	if veleroVslSecret == nil {
+		veleroVslSecret = builder.ForSecret(r.OADPNamespace, veleroObjectsNACUUID).
+			ObjectMeta(
+				builder.WithLabels(constant.NavslOriginNACUUIDLabel, veleroObjectsNACUUID),
+				builder.WithLabelsMap(function.GetNonAdminLabels()),
+				builder.WithAnnotationsMap(function.GetNonAdminVolumeSnapshotLocationAnnotations(navsl.ObjectMeta)),
+			).Result()
+	}
+
+	op, err := syncLocationSecret(ctx, r.Client, veleroVslSecret, sourceSecret.Type, sourceSecret.Data)
+	if err != nil {
+		logger.Error(err, "Failed to sync secret to OADP namespace")
+	}
+	if setLocationSecretSyncedCondition(&navsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionSecretSynced), op, err) {
+		if updateErr := r.Status().Update(ctx, navsl); updateErr != nil {
+			logger.Error(updateErr, statusVslUpdateError)
+			return false, updateErr
+		}
+	}
+	return false, err
+}
+
+// createVeleroVSL creates or updates the VeleroVolumeSnapshotLocation of the NonAdminVolumeSnapshotLocation,
+// with its credential pointing to the Secret synced to the OADP namespace
+func (r *NonAdminVolumeSnapshotLocationReconciler) createVeleroVSL(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	veleroObjectsNACUUID := navsl.Status.VeleroVolumeSnapshotLocation.NACUUID
+
+	veleroVsl, err := function.GetVeleroVolumeSnapshotLocationByLabel(ctx, r.Client, r.OADPNamespace, veleroObjectsNACUUID)
+	if err != nil {
+		logger.Error(err, "Failed to get VeleroVolumeSnapshotLocation", constant.UUIDString, veleroObjectsNACUUID)
+		return false, err
+	}
+	if veleroVsl == nil {
+		veleroVsl = builder.ForVolumeSnapshotLocation(r.OADPNamespace, veleroObjectsNACUUID).
+			ObjectMeta(
+				builder.WithLabels(constant.NavslOriginNACUUIDLabel, veleroObjectsNACUUID),
+				builder.WithLabelsMap(function.GetNonAdminLabels()),
+				builder.WithAnnotationsMap(function.GetNonAdminVolumeSnapshotLocationAnnotations(navsl.ObjectMeta)),
+			).Result()
+	}
+
+	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, veleroVsl, func() error {
+		veleroVsl.Spec = *navsl.Spec.VolumeSnapshotLocationSpec.DeepCopy()
+		if veleroVsl.Spec.Credential != nil {
+			veleroVsl.Spec.Credential.Name = veleroObjectsNACUUID
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "Failed to create or update VeleroVolumeSnapshotLocation")
+		if meta.SetStatusCondition(&navsl.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminVSLConditionVSLSynced),
+			Status:  metav1.ConditionFalse,
+			Reason:  "VolumeSnapshotLocationSyncFailed",
+			Message: "Failed to sync VolumeSnapshotLocation to the OADP namespace",
+		}) {
+			if updateErr := r.Status().Update(ctx, navsl); updateErr != nil {
+				logger.Error(updateErr, statusVslUpdateError)
+				return false, updateErr
+			}
+		}
+		return false, err
+	}
+	logger.V(1).Info("VeleroVolumeSnapshotLocation synced", "operation", op, constant.NameString, veleroVsl.Name)
+
+	updatedPhase := updateNonAdminPhase(&navsl.Status.Phase, nacv1alpha1.NonAdminPhaseCreated)
+	updatedCondition := false
+	if op != controllerutil.OperationResultNone || meta.FindStatusCondition(navsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionVSLSynced)) == nil {
+		meta.RemoveStatusCondition(&navsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionVSLSynced))
+		updatedCondition = meta.SetStatusCondition(&navsl.Status.Conditions, metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminVSLConditionVSLSynced),
+			Status:  metav1.ConditionTrue,
+			Reason:  "VolumeSnapshotLocationSynced",
+			Message: "VolumeSnapshotLocation successfully synced to the OADP namespace",
+		})
+	}
+	if updatedPhase || updatedCondition {
+		if err := r.Status().Update(ctx, navsl); err != nil {
+			logger.Error(err, statusVslUpdateError)
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// syncNaVSLStatus copies the status of the VeleroVolumeSnapshotLocation to the NonAdminVolumeSnapshotLocation status
+func (r *NonAdminVolumeSnapshotLocationReconciler) syncNaVSLStatus(ctx context.Context, logger logr.Logger, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation) (bool, error) {
+	veleroVsl, err := function.GetVeleroVolumeSnapshotLocationByLabel(ctx, r.Client, r.OADPNamespace, navsl.Status.VeleroVolumeSnapshotLocation.NACUUID)
+	if err != nil {
+		logger.Error(err, "Failed to get VeleroVolumeSnapshotLocation")
+		return false, err
+	}
+	if veleroVsl == nil || reflect.DeepEqual(navsl.Status.VeleroVolumeSnapshotLocation.Status, &veleroVsl.Status) {
+		return false, nil
+	}
+
+	navsl.Status.VeleroVolumeSnapshotLocation.Status = veleroVsl.Status.DeepCopy()
+	if err := r.Status().Update(ctx, navsl); err != nil {
+		logger.Error(err, statusVslUpdateError)
+		return false, err
+	}
+	logger.V(1).Info("NonAdminVolumeSnapshotLocation Status updated successfully")
+	return false, nil
+}
+
+// updateNonAdminVSLRequestStatus sets the NonAdminVolumeSnapshotLocation and the phase matching the approval
+// decision in the NonAdminVolumeSnapshotLocationRequest status, and returns true if they changed
+func updateNonAdminVSLRequestStatus(status *nacv1alpha1.NonAdminVolumeSnapshotLocationRequestStatus, navsl *nacv1alpha1.NonAdminVolumeSnapshotLocation, approvalDecision nacv1alpha1.NonAdminBSLRequest) bool {
+	updatedStatus := nacv1alpha1.NonAdminVolumeSnapshotLocationRequestStatus{
+		SourceNonAdminVSL: &nacv1alpha1.SourceNonAdminVSL{
+			NACUUID:       navsl.Status.VeleroVolumeSnapshotLocation.NACUUID,
+			Name:          navsl.Name,
+			Namespace:     navsl.Namespace,
+			RequestedSpec: navsl.Spec.VolumeSnapshotLocationSpec.DeepCopy(),
+		},
+		Phase: status.Phase,
+	}
+	updatePhaseIfNeeded(&updatedStatus.Phase, approvalDecision)
+
+	if reflect.DeepEqual(*status, updatedStatus) {
+		return false
+	}
+	*status = updatedStatus
+	return true
+}
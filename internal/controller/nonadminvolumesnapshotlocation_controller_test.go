@@ -0,0 +1,168 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+var _ = ginkgo.Describe("Test NonAdminVolumeSnapshotLocation Reconcile function", func() {
+	var (
+		ctx                     = context.Background()
+		nonAdminObjectName      string
+		nonAdminObjectNamespace string
+		oadpNamespace           string
+		counter                 = 0
+	)
+	ginkgo.BeforeEach(func() {
+		counter++
+		nonAdminObjectName = fmt.Sprintf("navsl-object-%v", counter)
+		nonAdminObjectNamespace = fmt.Sprintf("test-navsl-reconcile-%v", counter)
+		oadpNamespace = nonAdminObjectNamespace + "-oadp"
+
+		gomega.Expect(createTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot-credentials", Namespace: nonAdminObjectNamespace},
+			Data:       map[string][]byte{"cloud": []byte("[default]")},
+		})).To(gomega.Succeed())
+		gomega.Expect(k8sClient.Create(ctx, &nacv1alpha1.NonAdminVolumeSnapshotLocation{
+			ObjectMeta: metav1.ObjectMeta{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace},
+			Spec: nacv1alpha1.NonAdminVolumeSnapshotLocationSpec{
+				VolumeSnapshotLocationSpec: &velerov1.VolumeSnapshotLocationSpec{
+					Provider: "aws",
+					Config:   map[string]string{"region": "us-east-1"},
+					Credential: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "snapshot-credentials"},
+						Key:                  "cloud",
+					},
+				},
+			},
+		})).To(gomega.Succeed())
+	})
+	ginkgo.AfterEach(func() {
+		gomega.Expect(deleteTestNamespaces(ctx, nonAdminObjectNamespace, oadpNamespace)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should create the Velero VolumeSnapshotLocation and its Secret, and delete them with the NonAdminVolumeSnapshotLocation", func() {
+		reconciler := &NonAdminVolumeSnapshotLocationReconciler{
+			Client:        k8sClient,
+			Scheme:        testEnv.Scheme,
+			OADPNamespace: oadpNamespace,
+		}
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}}
+
+		ginkgo.By("Creating the approved NonAdminVolumeSnapshotLocationRequest")
+		result, err := reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{Requeue: true}))
+
+		nonAdminVsl := &nacv1alpha1.NonAdminVolumeSnapshotLocation{}
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminVsl)).To(gomega.Succeed())
+		veleroObjectsNACUUID := nonAdminVsl.Status.VeleroVolumeSnapshotLocation.NACUUID
+		navslRequest, err := function.GetNavslRequestByLabel(ctx, k8sClient, oadpNamespace, veleroObjectsNACUUID)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(navslRequest.Spec.ApprovalDecision).To(gomega.Equal(nacv1alpha1.NonAdminBSLRequestApproved))
+		gomega.Expect(navslRequest.Status.SourceNonAdminVSL.Name).To(gomega.Equal(nonAdminObjectName))
+
+		ginkgo.By("Creating the Velero VolumeSnapshotLocation and its Secret")
+		result, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminVsl)).To(gomega.Succeed())
+		gomega.Expect(nonAdminVsl.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+		gomega.Expect(meta.IsStatusConditionTrue(nonAdminVsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionApproved))).To(gomega.BeTrue())
+		gomega.Expect(meta.IsStatusConditionTrue(nonAdminVsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionSecretSynced))).To(gomega.BeTrue())
+		gomega.Expect(meta.IsStatusConditionTrue(nonAdminVsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionVSLSynced))).To(gomega.BeTrue())
+
+		veleroVsl := &velerov1.VolumeSnapshotLocation{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: veleroObjectsNACUUID, Namespace: oadpNamespace}, veleroVsl)).To(gomega.Succeed())
+		gomega.Expect(veleroVsl.Spec.Provider).To(gomega.Equal("aws"))
+		gomega.Expect(veleroVsl.Spec.Credential.Name).To(gomega.Equal(veleroObjectsNACUUID))
+		gomega.Expect(veleroVsl.Annotations[constant.NavslOriginNameAnnotation]).To(gomega.Equal(nonAdminObjectName))
+
+		veleroVslSecret := &corev1.Secret{}
+		gomega.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: veleroObjectsNACUUID, Namespace: oadpNamespace}, veleroVslSecret)).To(gomega.Succeed())
+		gomega.Expect(veleroVslSecret.Data["cloud"]).To(gomega.Equal([]byte("[default]")))
+
+		ginkgo.By("Deleting the NonAdminVolumeSnapshotLocation")
+		gomega.Expect(k8sClient.Delete(ctx, nonAdminVsl)).To(gomega.Succeed())
+		result, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{}))
+
+		gomega.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, request.NamespacedName, nonAdminVsl))).To(gomega.BeTrue())
+		gomega.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{Name: veleroObjectsNACUUID, Namespace: oadpNamespace}, veleroVsl))).To(gomega.BeTrue())
+		gomega.Expect(apierrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{Name: veleroObjectsNACUUID, Namespace: oadpNamespace}, veleroVslSecret))).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("Should not create the Velero VolumeSnapshotLocation until the cluster admin approves it", func() {
+		reconciler := &NonAdminVolumeSnapshotLocationReconciler{
+			Client:                k8sClient,
+			Scheme:                testEnv.Scheme,
+			OADPNamespace:         oadpNamespace,
+			RequireApprovalForBSL: true,
+		}
+		request := reconcile.Request{NamespacedName: types.NamespacedName{Name: nonAdminObjectName, Namespace: nonAdminObjectNamespace}}
+
+		result, err := reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(result).To(gomega.Equal(reconcile.Result{Requeue: true}))
+
+		ginkgo.By("Waiting for the approval of the cluster admin")
+		_, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+
+		nonAdminVsl := &nacv1alpha1.NonAdminVolumeSnapshotLocation{}
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminVsl)).To(gomega.Succeed())
+		gomega.Expect(nonAdminVsl.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseNew))
+		gomega.Expect(meta.FindStatusCondition(nonAdminVsl.Status.Conditions, string(nacv1alpha1.NonAdminVSLConditionApproved)).Reason).To(gomega.Equal("VslSpecApprovalPending"))
+		veleroObjectsNACUUID := nonAdminVsl.Status.VeleroVolumeSnapshotLocation.NACUUID
+		veleroVsl, err := function.GetVeleroVolumeSnapshotLocationByLabel(ctx, k8sClient, oadpNamespace, veleroObjectsNACUUID)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(veleroVsl).To(gomega.BeNil())
+
+		ginkgo.By("Approving the NonAdminVolumeSnapshotLocationRequest")
+		navslRequest, err := function.GetNavslRequestByLabel(ctx, k8sClient, oadpNamespace, veleroObjectsNACUUID)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		navslRequest.Spec.ApprovalDecision = nacv1alpha1.NonAdminBSLRequestApproved
+		gomega.Expect(k8sClient.Update(ctx, navslRequest)).To(gomega.Succeed())
+
+		_, err = reconciler.Reconcile(ctx, request)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(k8sClient.Get(ctx, request.NamespacedName, nonAdminVsl)).To(gomega.Succeed())
+		gomega.Expect(nonAdminVsl.Status.Phase).To(gomega.Equal(nacv1alpha1.NonAdminPhaseCreated))
+		veleroVsl, err = function.GetVeleroVolumeSnapshotLocationByLabel(ctx, k8sClient, oadpNamespace, veleroObjectsNACUUID)
+		gomega.Expect(err).To(gomega.Not(gomega.HaveOccurred()))
+		gomega.Expect(veleroVsl).NotTo(gomega.BeNil())
+	})
+})
@@ -0,0 +1,70 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
+)
+
+// NonAdminApprovalRequestHandler contains event handlers for NonAdminApprovalRequest objects
+type NonAdminApprovalRequestHandler struct {
+	// Kind of the non admin objects of the controller
+	Kind nacmeta.Kind
+}
+
+// Create event handler
+func (NonAdminApprovalRequestHandler) Create(_ context.Context, _ event.CreateEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Create event handler for the NonAdminApprovalRequest object
+}
+
+// Update event handler adds the non admin object requesting the approval to controller queue
+func (h NonAdminApprovalRequestHandler) Update(ctx context.Context, evt event.TypedUpdateEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueOrigin(ctx, evt.ObjectNew, q)
+}
+
+// Delete event handler adds the non admin object requesting the approval to controller queue
+func (h NonAdminApprovalRequestHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueOrigin(ctx, evt.Object, q)
+}
+
+// Generic event handler
+func (NonAdminApprovalRequestHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Generic event handler for the NonAdminApprovalRequest object
+}
+
+func (h NonAdminApprovalRequestHandler) enqueueOrigin(ctx context.Context, object client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, object, "NonAdminApprovalRequestHandler")
+
+	origin, err := nacmeta.GetOrigin(object)
+	if err != nil || origin.Kind != h.Kind {
+		return
+	}
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+		Name:      origin.Name,
+		Namespace: origin.Namespace,
+	}})
+	logger.V(1).Info("Handled event")
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// NonAdminBackupResourcePolicyHandler contains event handlers for the resource policy ConfigMaps of NonAdminBackups
+type NonAdminBackupResourcePolicyHandler struct {
+	Client client.Client
+}
+
+// Create event handler adds the NonAdminBackups referencing the ConfigMap to controller queue
+func (h NonAdminBackupResourcePolicyHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.addReferencingNonAdminBackups(ctx, evt.Object, q)
+}
+
+// Update event handler adds the NonAdminBackups referencing the ConfigMap to controller queue
+func (h NonAdminBackupResourcePolicyHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.addReferencingNonAdminBackups(ctx, evt.ObjectNew, q)
+}
+
+// Delete event handler
+func (NonAdminBackupResourcePolicyHandler) Delete(_ context.Context, _ event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Delete event handler for the ConfigMap object
+}
+
+// Generic event handler
+func (NonAdminBackupResourcePolicyHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Generic event handler for the ConfigMap object
+}
+
+func (h NonAdminBackupResourcePolicyHandler) addReferencingNonAdminBackups(ctx context.Context, configMap client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, configMap, "NonAdminBackupResourcePolicyHandler")
+
+	var nabList nacv1alpha1.NonAdminBackupList
+	if err := h.Client.List(ctx, &nabList, client.InNamespace(configMap.GetNamespace())); err != nil {
+		logger.Error(err, "Failed to list NonAdminBackup objects")
+		return
+	}
+
+	for _, nab := range nabList.Items {
+		resourcePolicy := nab.Spec.BackupSpec.ResourcePolicy
+		if resourcePolicy != nil && resourcePolicy.Name == configMap.GetName() &&
+			strings.EqualFold(resourcePolicy.Kind, constant.ResourcePolicyConfigMapKind) {
+			logger.V(1).Info("Matching NonAdminBackup found", "NonAdminBackup", nab.Name)
+			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      nab.Name,
+				Namespace: nab.Namespace,
+			}})
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// NonAdminBslCACertHandler contains event handlers for the CA bundle ConfigMaps of NonAdminBackupStorageLocations
+type NonAdminBslCACertHandler struct {
+	Client client.Client
+}
+
+// Create event handler adds the NonAdminBackupStorageLocations referencing the ConfigMap to controller queue
+func (h NonAdminBslCACertHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.addReferencingNonAdminBackupStorageLocations(ctx, evt.Object, q)
+}
+
+// Update event handler adds the NonAdminBackupStorageLocations referencing the ConfigMap to controller queue
+func (h NonAdminBslCACertHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.addReferencingNonAdminBackupStorageLocations(ctx, evt.ObjectNew, q)
+}
+
+// Delete event handler
+func (NonAdminBslCACertHandler) Delete(_ context.Context, _ event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Delete event handler for the ConfigMap object
+}
+
+// Generic event handler
+func (NonAdminBslCACertHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Generic event handler for the ConfigMap object
+}
+
+func (h NonAdminBslCACertHandler) addReferencingNonAdminBackupStorageLocations(ctx context.Context, configMap client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, configMap, "NonAdminBslCACertHandler")
+
+	var nabslList nacv1alpha1.NonAdminBackupStorageLocationList
+	if err := h.Client.List(ctx, &nabslList, client.InNamespace(configMap.GetNamespace())); err != nil {
+		logger.Error(err, "Failed to list NonAdminBackupStorageLocation objects")
+		return
+	}
+
+	for _, nabsl := range nabslList.Items {
+		if nabsl.Spec.CACertConfigMap != nil && nabsl.Spec.CACertConfigMap.Name == configMap.GetName() {
+			logger.V(1).Info("Matching NaBSL found", "NaBSL", nabsl.Name, "ConfigMap", configMap.GetName())
+			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      nabsl.Name,
+				Namespace: nabsl.Namespace,
+			}})
+		}
+	}
+}
@@ -20,6 +20,7 @@ package handler
 import (
 	"context"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
@@ -47,6 +48,25 @@ func (h NonAdminBslSecretHandler) Create(ctx context.Context, evt event.CreateEv
 		return
 	}
 
+	h.enqueueNonAdminBackupStorageLocations(ctx, logger, secret, q)
+}
+
+// Update event handler
+func (h NonAdminBslSecretHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Update event handler for the Secret object, so rotated credentials are synced to the OADP namespace
+	logger := function.GetLogger(ctx, evt.ObjectNew, "NonAdminBslSecretHandler")
+
+	secret, ok := evt.ObjectNew.(*corev1.Secret)
+	if !ok {
+		logger.Error(nil, "Failed to cast event object to Secret")
+		return
+	}
+
+	h.enqueueNonAdminBackupStorageLocations(ctx, logger, secret, q)
+}
+
+// enqueueNonAdminBackupStorageLocations enqueues the NonAdminBackupStorageLocations using the Secret as credential
+func (h NonAdminBslSecretHandler) enqueueNonAdminBackupStorageLocations(ctx context.Context, logger logr.Logger, secret *corev1.Secret, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	var nabslList nacv1alpha1.NonAdminBackupStorageLocationList
 	if err := h.Client.List(ctx, &nabslList, client.InNamespace(secret.Namespace)); err != nil {
 		logger.Error(err, "Failed to list NonAdminBackupStorageLocation objects")
@@ -54,7 +74,9 @@ func (h NonAdminBslSecretHandler) Create(ctx context.Context, evt event.CreateEv
 	}
 
 	for _, nabsl := range nabslList.Items {
-		if nabsl.Spec.BackupStorageLocationSpec.Credential.Name == secret.Name {
+		if nabsl.Spec.BackupStorageLocationSpec != nil &&
+			nabsl.Spec.BackupStorageLocationSpec.Credential != nil &&
+			nabsl.Spec.BackupStorageLocationSpec.Credential.Name == secret.Name {
 			logger.V(1).Info("Matching NaBSL found", "NaBSL", nabsl.Name, "Secret", secret.Name)
 			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
 				Name:      nabsl.Name,
@@ -64,11 +86,6 @@ func (h NonAdminBslSecretHandler) Create(ctx context.Context, evt event.CreateEv
 	}
 }
 
-// Update event handler
-func (NonAdminBslSecretHandler) Update(_ context.Context, _ event.UpdateEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
-	// Update event handler for the Secret object
-}
-
 // Delete event handler
 func (NonAdminBslSecretHandler) Delete(_ context.Context, _ event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	// Delete event handler for the Secret object
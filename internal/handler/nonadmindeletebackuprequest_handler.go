@@ -0,0 +1,63 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// NonAdminDeleteBackupRequestHandler contains event handlers for NonAdminDeleteBackupRequest objects
+type NonAdminDeleteBackupRequestHandler struct{}
+
+// Create event handler
+func (NonAdminDeleteBackupRequestHandler) Create(_ context.Context, _ event.CreateEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Create event handler for the NonAdminDeleteBackupRequest object
+}
+
+// Update event handler adds the NonAdminBackup requested for deletion to controller queue
+func (NonAdminDeleteBackupRequestHandler) Update(ctx context.Context, evt event.TypedUpdateEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, evt.ObjectNew, "NonAdminDeleteBackupRequestHandler")
+
+	deleteBackupRequest, ok := evt.ObjectNew.(*nacv1alpha1.NonAdminDeleteBackupRequest)
+	if !ok {
+		return
+	}
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+		Name:      deleteBackupRequest.Spec.BackupName,
+		Namespace: deleteBackupRequest.Namespace,
+	}})
+	logger.V(1).Info("Handled event")
+}
+
+// Delete event handler
+func (NonAdminDeleteBackupRequestHandler) Delete(_ context.Context, _ event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Delete event handler for the NonAdminDeleteBackupRequest object
+}
+
+// Generic event handler
+func (NonAdminDeleteBackupRequestHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Generic event handler for the NonAdminDeleteBackupRequest object
+}
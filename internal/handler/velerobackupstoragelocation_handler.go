@@ -53,9 +53,20 @@ func (VeleroBackupStorageLocationHandler) Update(ctx context.Context, evt event.
 	logger.V(1).Info("Handled Update event")
 }
 
-// Delete event handler
-func (VeleroBackupStorageLocationHandler) Delete(_ context.Context, _ event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
-	// Delete event handler for the BackupStorageLocation object
+// Delete event handler adds Velero BackupStorageLocation's NonAdminBackupStorageLocation to controller queue,
+// so a Velero BackupStorageLocation deleted out-of-band is created again
+func (VeleroBackupStorageLocationHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, evt.Object, "VeleroBackupStorageLocationHandler")
+
+	annotations := evt.Object.GetAnnotations()
+	nabslOriginNamespace := annotations[constant.NabslOriginNamespaceAnnotation]
+	nabslOriginName := annotations[constant.NabslOriginNameAnnotation]
+
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+		Name:      nabslOriginName,
+		Namespace: nabslOriginNamespace,
+	}})
+	logger.V(1).Info("Handled Delete event")
 }
 
 // Generic event handler
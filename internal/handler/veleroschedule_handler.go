@@ -0,0 +1,72 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// VeleroScheduleHandler contains event handlers for Velero Schedule objects, and for the Velero Backup
+// objects they create, which carry the same Non Admin annotations
+type VeleroScheduleHandler struct{}
+
+// Create event handler adds the NonAdminSchedule of the Velero Backup created by its Velero Schedule to controller queue
+func (VeleroScheduleHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, evt.Object, "VeleroScheduleHandler")
+
+	addNonAdminSchedule(evt.Object, q)
+	logger.V(1).Info("Handled Create event")
+}
+
+// Update event handler adds Velero Schedule's NonAdminSchedule to controller queue
+func (VeleroScheduleHandler) Update(ctx context.Context, evt event.TypedUpdateEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, evt.ObjectNew, "VeleroScheduleHandler")
+
+	addNonAdminSchedule(evt.ObjectNew, q)
+	logger.V(1).Info("Handled Update event")
+}
+
+// Delete event handler adds Velero Schedule's NonAdminSchedule to controller queue
+func (VeleroScheduleHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	logger := function.GetLogger(ctx, evt.Object, "VeleroScheduleHandler")
+
+	addNonAdminSchedule(evt.Object, q)
+	logger.V(1).Info("Handled Delete event")
+}
+
+// Generic event handler
+func (VeleroScheduleHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	// Generic event handler for the Schedule object
+}
+
+// addNonAdminSchedule adds the NonAdminSchedule referenced by the Non Admin annotations of obj to controller queue
+func addNonAdminSchedule(obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	annotations := obj.GetAnnotations()
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+		Name:      annotations[constant.NasOriginNameAnnotation],
+		Namespace: annotations[constant.NasOriginNamespaceAnnotation],
+	}})
+}
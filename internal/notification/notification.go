@@ -0,0 +1,520 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notification posts the notifications of the NonAdminNotifications, the webhooks non admin
+// users configure to be told when their NonAdminBackups and NonAdminRestores complete, fail or expire
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/pkg/nacmeta"
+)
+
+const (
+	// DefaultTimeout is the default timeout of webhook calls
+	DefaultTimeout = 10 * time.Second
+	// DefaultMaxPendingPerNamespace is the default number of notifications of a namespace waiting to be posted,
+	// after which the new notifications of the namespace are dropped
+	DefaultMaxPendingPerNamespace = 20
+	// SignatureHeader is the header holding the HMAC-SHA256 signature of the payload, prefixed with sha256=
+	SignatureHeader = "X-Nac-Signature"
+	// EventHeader is the header holding the event the notification is posted for
+	EventHeader = "X-Nac-Event"
+	// maxResponseBytes limits the size of the webhook response read by the controller
+	maxResponseBytes = 1 << 20
+	// maxPending is the number of notifications of all namespaces waiting to be posted, after which the new
+	// notifications are dropped
+	maxPending = 1000
+	// maxAttempts is the number of times a notification is posted, when the webhook is unavailable, before
+	// it is recorded as failed
+	maxAttempts = 5
+	// workers is the number of notifications posted concurrently
+	workers = 4
+	// retryBaseDelay is the delay before a notification is posted again the first time, doubled at each attempt
+	retryBaseDelay = 2 * time.Second
+	// retryMaxDelay is the maximum delay before a notification is posted again
+	retryMaxDelay = time.Minute
+)
+
+// Event is an event of a non admin object, posted to the NonAdminNotifications of its namespace
+type Event struct {
+	// Type is the event, used to filter the NonAdminNotifications
+	Type nacv1alpha1.NonAdminNotificationEvent
+	// Kind is the kind of the non admin object, for example NonAdminBackup
+	Kind      nacmeta.Kind
+	Namespace string
+	Name      string
+	Phase     nacv1alpha1.NonAdminPhase
+	// Message gives details on the event, like the failure reason
+	Message string
+}
+
+// Payload is the body posted to the webhooks of the Generic format
+type Payload struct {
+	Time      metav1.Time                           `json:"time"`
+	Event     nacv1alpha1.NonAdminNotificationEvent `json:"event"`
+	Kind      nacmeta.Kind                          `json:"kind"`
+	Namespace string                                `json:"namespace"`
+	Name      string                                `json:"name"`
+	Phase     nacv1alpha1.NonAdminPhase             `json:"phase,omitempty"`
+	Message   string                                `json:"message,omitempty"`
+}
+
+// slackPayload is the body posted to the webhooks of the Slack format
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// teamsPayload is the body posted to the webhooks of the Teams format, a legacy message card
+type teamsPayload struct {
+	Type    string `json:"@type"`
+	Context string `json:"@context"`
+	Summary string `json:"summary"`
+	Title   string `json:"title"`
+	Text    string `json:"text"`
+}
+
+// delivery is a notification of an event waiting to be posted to a NonAdminNotification
+type delivery struct {
+	notification types.NamespacedName
+	event        Event
+	// id tells apart the deliveries of the same event, so the queue does not merge them
+	id uint64
+}
+
+// temporaryError is returned by post when the webhook is unavailable, and posting the notification again may succeed
+type temporaryError struct {
+	error
+}
+
+// Unwrap returns the error of the failed post
+func (e temporaryError) Unwrap() error {
+	return e.error
+}
+
+// Notifier posts events to the NonAdminNotifications. The notifications are queued and posted by the Notifier
+// workers, so the webhooks do not slow down the reconciles, and posted again with a backoff when the webhook
+// is unavailable.
+type Notifier struct {
+	Client     client.Client
+	HTTPClient *http.Client
+	queue      workqueue.TypedRateLimitingInterface[delivery]
+	// pending counts the queued notifications of each namespace
+	pending map[string]int
+	// AllowedHosts lists the shell patterns the host of the NonAdminNotification URLs must match,
+	// an empty one allows no host
+	AllowedHosts []string
+	// MaxPendingPerNamespace is the number of notifications of a namespace waiting to be posted, after which
+	// the new notifications of the namespace are dropped, so a namespace can not delay the others
+	MaxPendingPerNamespace int
+	totalPending           int
+	lastID                 uint64
+	lock                   sync.Mutex
+}
+
+// New returns a Notifier posting with the given timeout. Redirects are not followed, so the notifications
+// are only posted to allowed hosts. The Notifier must be started, for example by adding it to the manager.
+func New(clientInstance client.Client, allowedHosts []string, timeout time.Duration, maxPendingPerNamespace int) *Notifier {
+	return &Notifier{
+		Client: clientInstance,
+		HTTPClient: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		queue:                  newQueue(retryBaseDelay),
+		pending:                map[string]int{},
+		AllowedHosts:           allowedHosts,
+		MaxPendingPerNamespace: maxPendingPerNamespace,
+	}
+}
+
+// newQueue returns the queue of the notifications, posting them again after baseDelay, doubled at each attempt
+func newQueue(baseDelay time.Duration) workqueue.TypedRateLimitingInterface[delivery] {
+	return workqueue.NewTypedRateLimitingQueueWithConfig(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[delivery](baseDelay, retryMaxDelay),
+		workqueue.TypedRateLimitingQueueConfig[delivery]{Name: "nonadminnotification"},
+	)
+}
+
+// ValidateAllowedHosts returns an error if one of the allowed host patterns is malformed
+func ValidateAllowedHosts(allowedHosts []string) error {
+	for _, pattern := range allowedHosts {
+		if _, err := path.Match(pattern, constant.EmptyString); err != nil {
+			return fmt.Errorf("notification host pattern %q is invalid: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// ValidateURL returns nil if notifications may be posted to rawURL, an https URL whose host is allowed
+func (n *Notifier) ValidateURL(rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("spec.url is invalid: %w", err)
+	}
+	if parsedURL.Scheme != "https" || parsedURL.Hostname() == constant.EmptyString {
+		return errors.New("spec.url must be an https URL")
+	}
+	if len(n.AllowedHosts) == 0 {
+		return errors.New("spec.url host is not allowed, the cluster admin allows no notification host")
+	}
+	if !slices.ContainsFunc(n.AllowedHosts, func(pattern string) bool {
+		matched, _ := path.Match(pattern, parsedURL.Hostname())
+		return matched
+	}) {
+		return fmt.Errorf("spec.url host must match one of: %s", strings.Join(n.AllowedHosts, constant.CommaString+" "))
+	}
+	return nil
+}
+
+// Notify queues event for the accepted NonAdminNotifications of its namespace whose events include it. The Notifier
+// workers post it, and record the delivery in their status. A notification dropped because too many notifications
+// are waiting is recorded as failed right away. Failing to post is recorded in the NonAdminNotification status and
+// logged, it does not fail the caller.
+func (n *Notifier) Notify(ctx context.Context, logger logr.Logger, event Event) {
+	if n == nil {
+		return
+	}
+	notifications := &nacv1alpha1.NonAdminNotificationList{}
+	if err := n.Client.List(ctx, notifications, client.InNamespace(event.Namespace)); err != nil {
+		logger.Error(err, "Unable to list NonAdminNotifications")
+		return
+	}
+	for index := range notifications.Items {
+		notification := &notifications.Items[index]
+		if !IsAccepted(notification) || !Matches(notification, event.Type) {
+			continue
+		}
+		if err := n.enqueue(client.ObjectKeyFromObject(notification), event); err != nil {
+			logger.Error(err, "Unable to queue notification", "nonAdminNotification", notification.Name, "event", event.Type)
+			delivery := nacv1alpha1.NonAdminNotificationDelivery{
+				Time:    &metav1.Time{Time: time.Now()},
+				Event:   event.Type,
+				Name:    event.Name,
+				Message: err.Error(),
+			}
+			if err := n.recordDelivery(ctx, client.ObjectKeyFromObject(notification), delivery); err != nil {
+				logger.Error(err, "Unable to record notification delivery", "nonAdminNotification", notification.Name)
+			}
+		}
+	}
+}
+
+// enqueue queues the notification of event to the NonAdminNotification, unless too many notifications of its
+// namespace, or of all namespaces, are waiting to be posted
+func (n *Notifier) enqueue(notification types.NamespacedName, event Event) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.pending[notification.Namespace] >= n.MaxPendingPerNamespace {
+		return fmt.Errorf("notification dropped, %d notifications of namespace %s are already waiting to be posted",
+			n.pending[notification.Namespace], notification.Namespace)
+	}
+	if n.totalPending >= maxPending {
+		return fmt.Errorf("notification dropped, %d notifications are already waiting to be posted", n.totalPending)
+	}
+	n.pending[notification.Namespace]++
+	n.totalPending++
+	n.lastID++
+	n.queue.Add(delivery{notification: notification, event: event, id: n.lastID})
+	return nil
+}
+
+// release removes the notification from the queue and the pending notifications of its namespace
+func (n *Notifier) release(item delivery) {
+	n.queue.Forget(item)
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.pending[item.notification.Namespace]--
+	if n.pending[item.notification.Namespace] <= 0 {
+		delete(n.pending, item.notification.Namespace)
+	}
+	n.totalPending--
+}
+
+// Start posts the queued notifications until ctx is done
+func (n *Notifier) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("notifier")
+	var workerGroup sync.WaitGroup
+	for range workers {
+		workerGroup.Add(1)
+		go func() {
+			defer workerGroup.Done()
+			for n.processNext(ctx, logger) {
+			}
+		}()
+	}
+	<-ctx.Done()
+	n.queue.ShutDown()
+	workerGroup.Wait()
+	return nil
+}
+
+// processNext posts the next queued notification, and records its delivery. A notification the webhook is unavailable
+// for is queued again with a backoff, until it was posted maxAttempts times. It returns false once the queue is shut down.
+func (n *Notifier) processNext(ctx context.Context, logger logr.Logger) bool {
+	item, shutdown := n.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer n.queue.Done(item)
+	notificationLogger := logger.WithValues("nonAdminNotification", item.notification, "event", item.event.Type)
+
+	notification := &nacv1alpha1.NonAdminNotification{}
+	if err := n.Client.Get(ctx, item.notification, notification); err != nil {
+		if !apierrors.IsNotFound(err) {
+			notificationLogger.Error(err, "Unable to fetch NonAdminNotification")
+		}
+		n.release(item)
+		return true
+	}
+	if !IsAccepted(notification) {
+		notificationLogger.V(1).Info("NonAdminNotification not accepted anymore, notification dropped")
+		n.release(item)
+		return true
+	}
+
+	delivery := nacv1alpha1.NonAdminNotificationDelivery{
+		Time:      &metav1.Time{Time: time.Now()},
+		Event:     item.event.Type,
+		Name:      item.event.Name,
+		Succeeded: true,
+	}
+	if err := n.post(ctx, notification, item.event, delivery.Time.Time); err != nil {
+		var temporaryErr temporaryError
+		if errors.As(err, &temporaryErr) && n.queue.NumRequeues(item) < maxAttempts-1 && ctx.Err() == nil {
+			notificationLogger.V(1).Info("Unable to post notification, posting it again later", "error", err.Error())
+			n.queue.AddRateLimited(item)
+			return true
+		}
+		notificationLogger.Error(err, "Unable to post notification")
+		delivery.Succeeded = false
+		delivery.Message = err.Error()
+	} else {
+		notificationLogger.V(1).Info("Notification posted")
+	}
+	n.release(item)
+	if err := n.recordDelivery(ctx, item.notification, delivery); err != nil {
+		notificationLogger.Error(err, "Unable to record notification delivery")
+	}
+	return true
+}
+
+// IsAccepted returns true if the current generation of the NonAdminNotification was accepted by its controller
+func IsAccepted(notification *nacv1alpha1.NonAdminNotification) bool {
+	accepted := meta.FindStatusCondition(notification.Status.Conditions, string(nacv1alpha1.NonAdminConditionAccepted))
+	return accepted != nil && accepted.Status == metav1.ConditionTrue && accepted.ObservedGeneration == notification.Generation
+}
+
+// Matches returns true if the NonAdminNotification events filter includes eventType
+func Matches(notification *nacv1alpha1.NonAdminNotification, eventType nacv1alpha1.NonAdminNotificationEvent) bool {
+	return len(notification.Spec.Events) == 0 || slices.Contains(notification.Spec.Events, eventType)
+}
+
+// post sends event to the webhook of the NonAdminNotification, in its format, signed with its signing key if any.
+// The errors of an unavailable webhook are temporaryErrors.
+func (n *Notifier) post(ctx context.Context, notification *nacv1alpha1.NonAdminNotification, event Event, now time.Time) error {
+	if err := n.ValidateURL(notification.Spec.URL); err != nil {
+		return err
+	}
+	body, err := encode(notification.Spec.Format, event, now)
+	if err != nil {
+		return fmt.Errorf("unable to encode notification: %w", err)
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, notification.Spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to create notification request: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set(EventHeader, string(event.Type))
+	if notification.Spec.SigningKeySecretRef != nil {
+		key, keyErr := n.getSigningKey(ctx, notification)
+		if keyErr != nil {
+			return keyErr
+		}
+		httpRequest.Header.Set(SignatureHeader, Sign(key, body))
+	}
+
+	httpResponse, err := n.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return temporaryError{fmt.Errorf("unable to post notification: %w", err)}
+	}
+	_, err = io.Copy(io.Discard, io.LimitReader(httpResponse.Body, maxResponseBytes))
+	closeErr := httpResponse.Body.Close()
+	if httpResponse.StatusCode == http.StatusTooManyRequests || httpResponse.StatusCode >= http.StatusInternalServerError {
+		return temporaryError{fmt.Errorf("webhook returned HTTP status %d", httpResponse.StatusCode)}
+	}
+	if httpResponse.StatusCode < http.StatusOK || httpResponse.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook returned HTTP status %d", httpResponse.StatusCode)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read webhook response: %w", err)
+	}
+	return closeErr
+}
+
+// getSigningKey returns the signing key of the NonAdminNotification, from the Secret of its namespace
+func (n *Notifier) getSigningKey(ctx context.Context, notification *nacv1alpha1.NonAdminNotification) ([]byte, error) {
+	secretRef := notification.Spec.SigningKeySecretRef
+	secret := &corev1.Secret{}
+	if err := n.Client.Get(ctx, types.NamespacedName{Namespace: notification.Namespace, Name: secretRef.Name}, secret); err != nil {
+		return nil, fmt.Errorf("unable to get signing key Secret %s: %w", secretRef.Name, err)
+	}
+	key, ok := secret.Data[secretRef.Key]
+	if !ok || len(key) == 0 {
+		return nil, fmt.Errorf("signing key Secret %s has no %s key", secretRef.Name, secretRef.Key)
+	}
+	return key, nil
+}
+
+// recordDelivery sets the last delivery of the NonAdminNotification and increments its counters
+func (n *Notifier) recordDelivery(ctx context.Context, notification types.NamespacedName, delivery nacv1alpha1.NonAdminNotificationDelivery) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := &nacv1alpha1.NonAdminNotification{}
+		if err := n.Client.Get(ctx, notification, current); err != nil {
+			return err
+		}
+		current.Status.LastDelivery = delivery.DeepCopy()
+		condition := metav1.Condition{
+			Type:    string(nacv1alpha1.NonAdminConditionDelivered),
+			Status:  metav1.ConditionTrue,
+			Reason:  "NotificationDelivered",
+			Message: fmt.Sprintf("%s notification of %s delivered", delivery.Event, delivery.Name),
+		}
+		if delivery.Succeeded {
+			current.Status.DeliveredCount++
+		} else {
+			current.Status.FailedCount++
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "NotificationFailed"
+			condition.Message = delivery.Message
+		}
+		meta.SetStatusCondition(&current.Status.Conditions, condition)
+		return n.Client.Status().Update(ctx, current)
+	})
+}
+
+// Sign returns the value of the SignatureHeader for body, the hex encoded HMAC-SHA256 of body prefixed with sha256=
+func Sign(key []byte, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// encode returns the body of the notification of event in format
+func encode(format nacv1alpha1.NonAdminNotificationFormat, event Event, now time.Time) ([]byte, error) {
+	title := fmt.Sprintf("%s %s/%s %s", event.Kind, event.Namespace, event.Name, describe(event.Type))
+	text := title
+	if event.Message != constant.EmptyString {
+		text += ": " + event.Message
+	}
+	switch format {
+	case nacv1alpha1.NonAdminNotificationFormatSlack:
+		return json.Marshal(slackPayload{Text: text})
+	case nacv1alpha1.NonAdminNotificationFormatTeams:
+		return json.Marshal(teamsPayload{
+			Type:    "MessageCard",
+			Context: "https://schema.org/extensions",
+			Summary: title,
+			Title:   title,
+			Text:    text,
+		})
+	default:
+		return json.Marshal(Payload{
+			Time:      metav1.Time{Time: now},
+			Event:     event.Type,
+			Kind:      event.Kind,
+			Namespace: event.Namespace,
+			Name:      event.Name,
+			Phase:     event.Phase,
+			Message:   event.Message,
+		})
+	}
+}
+
+// describe returns what happened to the object for eventType, as a human readable text
+func describe(eventType nacv1alpha1.NonAdminNotificationEvent) string {
+	switch eventType {
+	case nacv1alpha1.NonAdminNotificationEventBackupCompleted, nacv1alpha1.NonAdminNotificationEventRestoreCompleted:
+		return "completed"
+	case nacv1alpha1.NonAdminNotificationEventBackupPartiallyFailed, nacv1alpha1.NonAdminNotificationEventRestorePartiallyFailed:
+		return "partially failed"
+	case nacv1alpha1.NonAdminNotificationEventBackupFailed, nacv1alpha1.NonAdminNotificationEventRestoreFailed:
+		return "failed"
+	case nacv1alpha1.NonAdminNotificationEventBackupExpired:
+		return "expired"
+	default:
+		return string(eventType)
+	}
+}
+
+// BackupEvent returns the event of a NonAdminBackup entering phase, false if no notification is posted for phase
+func BackupEvent(phase nacv1alpha1.NonAdminPhase) (nacv1alpha1.NonAdminNotificationEvent, bool) {
+	switch phase {
+	case nacv1alpha1.NonAdminPhaseCompleted:
+		return nacv1alpha1.NonAdminNotificationEventBackupCompleted, true
+	case nacv1alpha1.NonAdminPhasePartiallyFailed:
+		return nacv1alpha1.NonAdminNotificationEventBackupPartiallyFailed, true
+	case nacv1alpha1.NonAdminPhaseFailed:
+		return nacv1alpha1.NonAdminNotificationEventBackupFailed, true
+	default:
+		return constant.EmptyString, false
+	}
+}
+
+// RestoreEvent returns the event of a NonAdminRestore entering phase, false if no notification is posted for phase
+func RestoreEvent(phase nacv1alpha1.NonAdminPhase) (nacv1alpha1.NonAdminNotificationEvent, bool) {
+	switch phase {
+	case nacv1alpha1.NonAdminPhaseCompleted:
+		return nacv1alpha1.NonAdminNotificationEventRestoreCompleted, true
+	case nacv1alpha1.NonAdminPhasePartiallyFailed:
+		return nacv1alpha1.NonAdminNotificationEventRestorePartiallyFailed, true
+	case nacv1alpha1.NonAdminPhaseFailed:
+		return nacv1alpha1.NonAdminNotificationEventRestoreFailed, true
+	default:
+		return constant.EmptyString, false
+	}
+}
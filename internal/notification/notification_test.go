@@ -0,0 +1,300 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		allowedHosts []string
+		expectError  bool
+	}{
+		{
+			name:         "https URL",
+			url:          "https://hooks.example.com/services/T0",
+			allowedHosts: []string{"hooks.example.com"},
+		},
+		{
+			name:         "http URL",
+			url:          "http://hooks.example.com/services/T0",
+			allowedHosts: []string{"hooks.example.com"},
+			expectError:  true,
+		},
+		{
+			name:         "no host",
+			url:          "https:///services/T0",
+			allowedHosts: []string{"*"},
+			expectError:  true,
+		},
+		{
+			name:        "no allowed host",
+			url:         "https://hooks.example.com/services/T0",
+			expectError: true,
+		},
+		{
+			name:         "allowed host",
+			url:          "https://team.webhook.office.com/webhookb2/1",
+			allowedHosts: []string{"hooks.slack.com", "*.webhook.office.com"},
+		},
+		{
+			name:         "not allowed host",
+			url:          "https://internal.cluster.local/hook",
+			allowedHosts: []string{"hooks.slack.com", "*.webhook.office.com"},
+			expectError:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			notifier := &Notifier{AllowedHosts: test.allowedHosts}
+			err := notifier.ValidateURL(test.url)
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAllowedHosts(t *testing.T) {
+	assert.NoError(t, ValidateAllowedHosts([]string{"hooks.slack.com", "*.webhook.office.com"}))
+	assert.Error(t, ValidateAllowedHosts([]string{"[hooks.slack.com"}))
+}
+
+func TestEncode(t *testing.T) {
+	event := Event{
+		Type:      nacv1alpha1.NonAdminNotificationEventBackupFailed,
+		Kind:      "NonAdminBackup",
+		Namespace: "team",
+		Name:      "daily",
+		Phase:     nacv1alpha1.NonAdminPhaseFailed,
+		Message:   "velero backup failed",
+	}
+	tests := []struct {
+		format   nacv1alpha1.NonAdminNotificationFormat
+		key      string
+		expected string
+	}{
+		{format: nacv1alpha1.NonAdminNotificationFormatGeneric, key: "event", expected: "BackupFailed"},
+		{format: nacv1alpha1.NonAdminNotificationFormatSlack, key: "text", expected: "NonAdminBackup team/daily failed: velero backup failed"},
+		{format: nacv1alpha1.NonAdminNotificationFormatTeams, key: "summary", expected: "NonAdminBackup team/daily failed"},
+	}
+	for _, test := range tests {
+		t.Run(string(test.format), func(t *testing.T) {
+			body, err := encode(test.format, event, metav1.Now().Time)
+			assert.NoError(t, err)
+			decoded := map[string]any{}
+			assert.NoError(t, json.Unmarshal(body, &decoded))
+			assert.Equal(t, test.expected, decoded[test.key])
+		})
+	}
+}
+
+func TestNotify(t *testing.T) {
+	const namespace = "team"
+	var (
+		receivedBody      []byte
+		receivedSignature string
+		calls             int
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		receivedBody, _ = io.ReadAll(request.Body)
+		receivedSignature = request.Header.Get(SignatureHeader)
+		assert.Equal(t, string(nacv1alpha1.NonAdminNotificationEventBackupCompleted), request.Header.Get(EventHeader))
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	accepted := []metav1.Condition{{
+		Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             "NonAdminNotificationAccepted",
+		ObservedGeneration: 1,
+	}}
+	signed := &nacv1alpha1.NonAdminNotification{
+		ObjectMeta: metav1.ObjectMeta{Name: "signed", Namespace: namespace, Generation: 1},
+		Spec: nacv1alpha1.NonAdminNotificationSpec{
+			URL: server.URL,
+			SigningKeySecretRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "signing-key"},
+				Key:                  "key",
+			},
+		},
+		Status: nacv1alpha1.NonAdminNotificationStatus{Conditions: accepted},
+	}
+	filtered := &nacv1alpha1.NonAdminNotification{
+		ObjectMeta: metav1.ObjectMeta{Name: "filtered", Namespace: namespace, Generation: 1},
+		Spec: nacv1alpha1.NonAdminNotificationSpec{
+			URL:    server.URL,
+			Events: []nacv1alpha1.NonAdminNotificationEvent{nacv1alpha1.NonAdminNotificationEventBackupFailed},
+		},
+		Status: nacv1alpha1.NonAdminNotificationStatus{Conditions: accepted},
+	}
+	notAccepted := &nacv1alpha1.NonAdminNotification{
+		ObjectMeta: metav1.ObjectMeta{Name: "not-accepted", Namespace: namespace, Generation: 2},
+		Spec:       nacv1alpha1.NonAdminNotificationSpec{URL: server.URL},
+		Status:     nacv1alpha1.NonAdminNotificationStatus{Conditions: accepted},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "signing-key", Namespace: namespace},
+		Data:       map[string][]byte{"key": []byte("secret")},
+	}
+
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	if err := corev1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register corev1 type: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).
+		WithStatusSubresource(&nacv1alpha1.NonAdminNotification{}).
+		WithObjects(signed, filtered, notAccepted, secret).Build()
+	notifier := New(fakeClient, []string{"127.0.0.1"}, DefaultTimeout, DefaultMaxPendingPerNamespace)
+	notifier.HTTPClient.Transport = server.Client().Transport
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = notifier.Start(ctx)
+	}()
+
+	notifier.Notify(context.Background(), logr.Discard(), Event{
+		Type:      nacv1alpha1.NonAdminNotificationEventBackupCompleted,
+		Kind:      "NonAdminBackup",
+		Namespace: namespace,
+		Name:      "daily",
+		Phase:     nacv1alpha1.NonAdminPhaseCompleted,
+	})
+
+	updated := &nacv1alpha1.NonAdminNotification{}
+	assert.Eventually(t, func() bool {
+		return fakeClient.Get(context.Background(), types.NamespacedName{Name: "signed", Namespace: namespace}, updated) == nil &&
+			updated.Status.LastDelivery != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, Sign([]byte("secret"), receivedBody), receivedSignature)
+	payload := Payload{}
+	assert.NoError(t, json.Unmarshal(receivedBody, &payload))
+	assert.Equal(t, "daily", payload.Name)
+	assert.Equal(t, nacv1alpha1.NonAdminPhaseCompleted, payload.Phase)
+
+	assert.Equal(t, 1, updated.Status.DeliveredCount)
+	assert.True(t, updated.Status.LastDelivery.Succeeded)
+	assert.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "filtered", Namespace: namespace}, updated))
+	assert.Nil(t, updated.Status.LastDelivery)
+
+	// a webhook refusing the notification is recorded as failed
+	server.Config.Handler = http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusForbidden)
+	})
+	notifier.Notify(context.Background(), logr.Discard(), Event{
+		Type:      nacv1alpha1.NonAdminNotificationEventBackupCompleted,
+		Kind:      "NonAdminBackup",
+		Namespace: namespace,
+		Name:      "weekly",
+	})
+	assert.Eventually(t, func() bool {
+		return fakeClient.Get(context.Background(), types.NamespacedName{Name: "signed", Namespace: namespace}, updated) == nil &&
+			updated.Status.FailedCount == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, updated.Status.DeliveredCount)
+	assert.Equal(t, 1, updated.Status.FailedCount)
+	assert.False(t, updated.Status.LastDelivery.Succeeded)
+	assert.Equal(t, "weekly", updated.Status.LastDelivery.Name)
+}
+
+func TestNotifyRetryAndLimit(t *testing.T) {
+	const namespace = "team"
+	var calls atomic.Int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		// the webhook is unavailable for the first two notifications
+		if calls.Add(1) <= 2 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notification := &nacv1alpha1.NonAdminNotification{
+		ObjectMeta: metav1.ObjectMeta{Name: "hook", Namespace: namespace, Generation: 1},
+		Spec:       nacv1alpha1.NonAdminNotificationSpec{URL: server.URL},
+		Status: nacv1alpha1.NonAdminNotificationStatus{Conditions: []metav1.Condition{{
+			Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             "NonAdminNotificationAccepted",
+			ObservedGeneration: 1,
+		}}},
+	}
+	fakeScheme := runtime.NewScheme()
+	if err := nacv1alpha1.AddToScheme(fakeScheme); err != nil {
+		t.Fatalf("Failed to register NAC type: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).
+		WithStatusSubresource(&nacv1alpha1.NonAdminNotification{}).
+		WithObjects(notification).Build()
+	notifier := New(fakeClient, []string{"127.0.0.1"}, DefaultTimeout, 1)
+	notifier.HTTPClient.Transport = server.Client().Transport
+	notifier.queue = newQueue(time.Millisecond)
+
+	event := Event{Type: nacv1alpha1.NonAdminNotificationEventBackupCompleted, Kind: "NonAdminBackup", Namespace: namespace, Name: "daily"}
+	notifier.Notify(context.Background(), logr.Discard(), event)
+
+	// the namespace already has one notification waiting, the next one is dropped
+	event.Name = "weekly"
+	notifier.Notify(context.Background(), logr.Discard(), event)
+	updated := &nacv1alpha1.NonAdminNotification{}
+	assert.NoError(t, fakeClient.Get(context.Background(), types.NamespacedName{Name: "hook", Namespace: namespace}, updated))
+	assert.Equal(t, 1, updated.Status.FailedCount)
+	assert.Equal(t, "weekly", updated.Status.LastDelivery.Name)
+	assert.Contains(t, updated.Status.LastDelivery.Message, "notifications of namespace team are already waiting to be posted")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = notifier.Start(ctx)
+	}()
+	assert.Eventually(t, func() bool {
+		return fakeClient.Get(context.Background(), types.NamespacedName{Name: "hook", Namespace: namespace}, updated) == nil &&
+			updated.Status.DeliveredCount == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, "daily", updated.Status.LastDelivery.Name)
+	assert.True(t, updated.Status.LastDelivery.Succeeded)
+}
@@ -32,6 +32,7 @@ import (
 type CompositeNaBSLPredicate struct {
 	Context                                       context.Context
 	NonAdminBslSecretPredicate                    NonAdminBslSecretPredicate
+	NonAdminBslCACertPredicate                    NonAdminBslCACertPredicate
 	NonAdminBackupStorageLocationPredicate        NonAdminBackupStorageLocationPredicate
 	NonAdminBackupStorageLocationRequestPredicate NonAdminBackupStorageLocationRequestPredicate
 	VeleroBackupStorageLocationPredicate          VeleroBackupStorageLocationPredicate
@@ -44,12 +45,15 @@ func (p CompositeNaBSLPredicate) Create(evt event.CreateEvent) bool {
 		return p.NonAdminBackupStorageLocationPredicate.Create(p.Context, evt)
 	case *corev1.Secret:
 		return p.NonAdminBslSecretPredicate.Create(p.Context, evt)
+	case *corev1.ConfigMap:
+		return p.NonAdminBslCACertPredicate.Create(p.Context, evt)
 	default:
 		return false
 	}
 }
 
-// Update event filter accepts both NonAdminBackupStorageLocation and Velero BackupStorageLocation update events
+// Update event filter accepts NonAdminBackupStorageLocation, Velero BackupStorageLocation,
+// NonAdminBackupStorageLocationRequest, Secret and ConfigMap update events
 func (p CompositeNaBSLPredicate) Update(evt event.TypedUpdateEvent[client.Object]) bool {
 	switch evt.ObjectNew.(type) {
 	case *nacv1alpha1.NonAdminBackupStorageLocation:
@@ -58,16 +62,23 @@ func (p CompositeNaBSLPredicate) Update(evt event.TypedUpdateEvent[client.Object
 		return p.VeleroBackupStorageLocationPredicate.Update(p.Context, evt)
 	case *nacv1alpha1.NonAdminBackupStorageLocationRequest:
 		return p.NonAdminBackupStorageLocationRequestPredicate.Update(p.Context, evt)
+	case *corev1.Secret:
+		return p.NonAdminBslSecretPredicate.Update(p.Context, evt)
+	case *corev1.ConfigMap:
+		return p.NonAdminBslCACertPredicate.Update(p.Context, evt)
 	default:
 		return false
 	}
 }
 
-// Delete event filter only accepts NonAdminBackupStorageLocation delete events
+// Delete event filter accepts NonAdminBackupStorageLocation, Velero BackupStorageLocation and
+// NonAdminBackupStorageLocationRequest delete events
 func (p CompositeNaBSLPredicate) Delete(evt event.DeleteEvent) bool {
 	switch evt.Object.(type) {
 	case *nacv1alpha1.NonAdminBackupStorageLocation:
 		return p.NonAdminBackupStorageLocationPredicate.Delete(p.Context, evt)
+	case *velerov1.BackupStorageLocation:
+		return p.VeleroBackupStorageLocationPredicate.Delete(p.Context, evt)
 	case *nacv1alpha1.NonAdminBackupStorageLocationRequest:
 		return p.NonAdminBackupStorageLocationRequestPredicate.Delete(p.Context, evt)
 	default:
@@ -22,6 +22,7 @@ import (
 
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
@@ -36,6 +37,9 @@ type CompositeBackupPredicate struct {
 	VeleroBackupQueuePredicate     VeleroBackupQueuePredicate
 	VeleroPodVolumeBackupPredicate VeleroPodVolumeBackupPredicate
 	VeleroDataUploadPredicate      VeleroDataUploadPredicate
+	ResourcePolicyPredicate        NonAdminBackupResourcePolicyPredicate
+	ApprovalRequestPredicate       NonAdminApprovalRequestPredicate
+	DeleteBackupRequestPredicate   NonAdminDeleteBackupRequestPredicate
 }
 
 // Create event filter only accepts NonAdminBackup create events
@@ -43,6 +47,8 @@ func (p CompositeBackupPredicate) Create(evt event.CreateEvent) bool {
 	switch evt.Object.(type) {
 	case *nacv1alpha1.NonAdminBackup:
 		return p.NonAdminBackupPredicate.Create(p.Context, evt)
+	case *corev1.ConfigMap:
+		return p.ResourcePolicyPredicate.Create(p.Context, evt)
 	default:
 		return false
 	}
@@ -59,6 +65,12 @@ func (p CompositeBackupPredicate) Update(evt event.TypedUpdateEvent[client.Objec
 		return p.VeleroPodVolumeBackupPredicate.Update(p.Context, evt)
 	case *velerov2alpha1.DataUpload:
 		return p.VeleroDataUploadPredicate.Update(p.Context, evt)
+	case *corev1.ConfigMap:
+		return p.ResourcePolicyPredicate.Update(p.Context, evt)
+	case *nacv1alpha1.NonAdminApprovalRequest:
+		return p.ApprovalRequestPredicate.Update(p.Context, evt)
+	case *nacv1alpha1.NonAdminDeleteBackupRequest:
+		return p.DeleteBackupRequestPredicate.Update(p.Context, evt)
 	default:
 		return false
 	}
@@ -71,6 +83,8 @@ func (p CompositeBackupPredicate) Delete(evt event.DeleteEvent) bool {
 		return p.NonAdminBackupPredicate.Delete(p.Context, evt)
 	case *velerov1.Backup:
 		return p.VeleroBackupPredicate.Delete(p.Context, evt)
+	case *nacv1alpha1.NonAdminApprovalRequest:
+		return p.ApprovalRequestPredicate.Delete(p.Context, evt)
 	default:
 		return false
 	}
@@ -0,0 +1,76 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"context"
+
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// CompositeSchedulePredicate is a combination of NonAdminSchedule and Velero Schedule event filters
+type CompositeSchedulePredicate struct {
+	Context                   context.Context
+	NonAdminSchedulePredicate NonAdminSchedulePredicate
+	VeleroSchedulePredicate   VeleroSchedulePredicate
+}
+
+// Create event filter only accepts NonAdminSchedule create events and the Velero Backup create events
+// of Velero Schedules created by a NonAdminSchedule
+func (p CompositeSchedulePredicate) Create(evt event.CreateEvent) bool {
+	switch evt.Object.(type) {
+	case *nacv1alpha1.NonAdminSchedule:
+		return p.NonAdminSchedulePredicate.Create(p.Context, evt)
+	case *velerov1.Backup:
+		return p.VeleroSchedulePredicate.Create(p.Context, evt)
+	default:
+		return false
+	}
+}
+
+// Update event filter accepts both NonAdminSchedule and Velero Schedule update events
+func (p CompositeSchedulePredicate) Update(evt event.TypedUpdateEvent[client.Object]) bool {
+	switch evt.ObjectNew.(type) {
+	case *nacv1alpha1.NonAdminSchedule:
+		return p.NonAdminSchedulePredicate.Update(p.Context, evt)
+	case *velerov1.Schedule, *velerov1.Backup:
+		return p.VeleroSchedulePredicate.Update(p.Context, evt)
+	default:
+		return false
+	}
+}
+
+// Delete event filter accepts both NonAdminSchedule and Velero Schedule delete events
+func (p CompositeSchedulePredicate) Delete(evt event.DeleteEvent) bool {
+	switch evt.Object.(type) {
+	case *nacv1alpha1.NonAdminSchedule:
+		return p.NonAdminSchedulePredicate.Delete(p.Context, evt)
+	case *velerov1.Schedule, *velerov1.Backup:
+		return p.VeleroSchedulePredicate.Delete(p.Context, evt)
+	default:
+		return false
+	}
+}
+
+// Generic event filter does not accept any generic events
+func (CompositeSchedulePredicate) Generic(_ event.GenericEvent) bool {
+	return false
+}
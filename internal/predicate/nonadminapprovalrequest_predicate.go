@@ -0,0 +1,68 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const nonAdminApprovalRequestPredicateKey = "NonAdminApprovalRequestPredicate"
+
+// NonAdminApprovalRequestPredicate contains event filters for NonAdminApprovalRequest objects
+type NonAdminApprovalRequestPredicate struct {
+	OADPNamespace string
+}
+
+// Update event filter only accepts NonAdminApprovalRequest update events from the OADP namespace
+// that include a decision or phase change
+func (p NonAdminApprovalRequestPredicate) Update(ctx context.Context, evt event.TypedUpdateEvent[client.Object]) bool {
+	if evt.ObjectNew.GetNamespace() != p.OADPNamespace {
+		return false
+	}
+	logger := function.GetLogger(ctx, evt.ObjectNew, nonAdminApprovalRequestPredicateKey)
+
+	if evt.ObjectNew.GetGeneration() != evt.ObjectOld.GetGeneration() {
+		logger.V(1).Info("Accepted Update event")
+		return true
+	}
+
+	oldApprovalRequest, okOld := evt.ObjectOld.(*nacv1alpha1.NonAdminApprovalRequest)
+	newApprovalRequest, okNew := evt.ObjectNew.(*nacv1alpha1.NonAdminApprovalRequest)
+	if okOld && okNew && oldApprovalRequest.Status.Phase != newApprovalRequest.Status.Phase {
+		logger.V(1).Info("Accepted Update event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Update event")
+	return false
+}
+
+// Delete event filter accepts all NonAdminApprovalRequest delete events from the OADP namespace
+func (p NonAdminApprovalRequestPredicate) Delete(ctx context.Context, evt event.DeleteEvent) bool {
+	if evt.Object.GetNamespace() != p.OADPNamespace {
+		return false
+	}
+	logger := function.GetLogger(ctx, evt.Object, nonAdminApprovalRequestPredicateKey)
+	logger.V(1).Info("Accepted Delete event")
+	return true
+}
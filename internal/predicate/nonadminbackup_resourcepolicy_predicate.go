@@ -0,0 +1,67 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// NonAdminBackupResourcePolicyPredicate contains event filters for the resource policy ConfigMaps of NonAdminBackups
+type NonAdminBackupResourcePolicyPredicate struct {
+	OADPNamespace string
+}
+
+// Create event filter only accepts ConfigMap create events from non admin namespaces
+func (p NonAdminBackupResourcePolicyPredicate) Create(ctx context.Context, evt event.CreateEvent) bool {
+	logger := function.GetLogger(ctx, evt.Object, "NonAdminBackupResourcePolicyPredicate")
+
+	if evt.Object.GetNamespace() != p.OADPNamespace {
+		logger.V(1).Info("Accepted Create event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Create event")
+	return false
+}
+
+// Update event filter only accepts ConfigMap update events from non admin namespaces changing the ConfigMap data
+func (p NonAdminBackupResourcePolicyPredicate) Update(ctx context.Context, evt event.TypedUpdateEvent[client.Object]) bool {
+	logger := function.GetLogger(ctx, evt.ObjectNew, "NonAdminBackupResourcePolicyPredicate")
+
+	oldConfigMap, oldOk := evt.ObjectOld.(*corev1.ConfigMap)
+	newConfigMap, newOk := evt.ObjectNew.(*corev1.ConfigMap)
+	if !oldOk || !newOk {
+		logger.Error(nil, "Failed to cast event object to ConfigMap")
+		return false
+	}
+
+	if newConfigMap.Namespace != p.OADPNamespace &&
+		(!reflect.DeepEqual(oldConfigMap.Data, newConfigMap.Data) || !reflect.DeepEqual(oldConfigMap.BinaryData, newConfigMap.BinaryData)) {
+		logger.V(1).Info("Accepted Update event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Update event")
+	return false
+}
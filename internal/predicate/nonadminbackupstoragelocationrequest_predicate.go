@@ -22,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
 	"github.com/migtools/oadp-non-admin/internal/common/function"
 )
 
@@ -48,6 +49,12 @@ func (NonAdminBackupStorageLocationRequestPredicate) Update(ctx context.Context,
 		return true
 	}
 
+	// forced deletion of the NonAdminBackupStorageLocation
+	if evt.ObjectNew.GetAnnotations()[constant.NabslForceDeletionAnnotation] != evt.ObjectOld.GetAnnotations()[constant.NabslForceDeletionAnnotation] {
+		logger.V(1).Info("Accepted Update event")
+		return true
+	}
+
 	logger.V(1).Info("Rejected Update event")
 	return false
 }
@@ -18,6 +18,7 @@ package predicate
 
 import (
 	"context"
+	"reflect"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -46,3 +47,24 @@ func (NonAdminBslSecretPredicate) Create(ctx context.Context, evt event.CreateEv
 	logger.V(1).Info("Rejected Create event")
 	return false
 }
+
+// Update event filter only accepts Secret update events changing the Secret data, for example when the
+// credentials of a NonAdminBackupStorageLocation are rotated
+func (NonAdminBslSecretPredicate) Update(ctx context.Context, evt event.UpdateEvent) bool {
+	logger := function.GetLogger(ctx, evt.ObjectNew, "NonAdminBslSecretPredicate")
+
+	oldSecret, oldOk := evt.ObjectOld.(*corev1.Secret)
+	newSecret, newOk := evt.ObjectNew.(*corev1.Secret)
+	if !oldOk || !newOk {
+		logger.Error(nil, "Failed to cast event object to Secret")
+		return false
+	}
+
+	if newSecret.Type == corev1.SecretTypeOpaque && !reflect.DeepEqual(oldSecret.Data, newSecret.Data) {
+		logger.V(1).Info("Accepted Update event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Update event")
+	return false
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const nonAdminDeleteBackupRequestPredicateKey = "NonAdminDeleteBackupRequestPredicate"
+
+// NonAdminDeleteBackupRequestPredicate contains event filters for NonAdminDeleteBackupRequest objects
+type NonAdminDeleteBackupRequestPredicate struct{}
+
+// Update event filter only accepts NonAdminDeleteBackupRequest update events accepting a NonAdminBackup for deletion
+func (NonAdminDeleteBackupRequestPredicate) Update(ctx context.Context, evt event.TypedUpdateEvent[client.Object]) bool {
+	logger := function.GetLogger(ctx, evt.ObjectNew, nonAdminDeleteBackupRequestPredicateKey)
+
+	oldDeleteBackupRequest, okOld := evt.ObjectOld.(*nacv1alpha1.NonAdminDeleteBackupRequest)
+	newDeleteBackupRequest, okNew := evt.ObjectNew.(*nacv1alpha1.NonAdminDeleteBackupRequest)
+	if okOld && okNew && oldDeleteBackupRequest.Status.Backup == nil && newDeleteBackupRequest.Status.Backup != nil {
+		logger.V(1).Info("Accepted Update event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Update event")
+	return false
+}
@@ -46,3 +46,20 @@ func (p VeleroBackupStorageLocationPredicate) Update(ctx context.Context, evt ev
 	logger.V(1).Info("Rejected Update event")
 	return false
 }
+
+// Delete event filter only accepts Velero BackupStorageLocation delete events from OADP namespace
+// and from Velero BackupStorageLocations that have required metadata
+func (p VeleroBackupStorageLocationPredicate) Delete(ctx context.Context, evt event.DeleteEvent) bool {
+	logger := function.GetLogger(ctx, evt.Object, "VeleroBackupStorageLocationPredicate")
+
+	namespace := evt.Object.GetNamespace()
+	if namespace == p.OADPNamespace {
+		if function.CheckVeleroBackupStorageLocationMetadata(evt.Object) {
+			logger.V(1).Info("Accepted BackupStorageLocation Delete event")
+			return true
+		}
+	}
+
+	logger.V(1).Info("Rejected Delete event")
+	return false
+}
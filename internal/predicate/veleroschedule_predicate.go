@@ -0,0 +1,76 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicate
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const veleroSchedulePredicateKey = "VeleroSchedulePredicate"
+
+// VeleroSchedulePredicate contains event filters for Velero Schedule objects, and for the Velero Backup
+// objects they create, which carry the same Non Admin labels and annotations
+type VeleroSchedulePredicate struct {
+	OADPNamespace string
+}
+
+// Create event filter only accepts Velero Schedule and Velero Backup create events from OADP namespace
+// and from Velero Schedules created by a NonAdminSchedule
+func (p VeleroSchedulePredicate) Create(ctx context.Context, evt event.CreateEvent) bool {
+	logger := function.GetLogger(ctx, evt.Object, veleroSchedulePredicateKey)
+
+	if evt.Object.GetNamespace() == p.OADPNamespace && function.CheckVeleroScheduleMetadata(evt.Object) {
+		logger.V(1).Info("Accepted Create event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Create event")
+	return false
+}
+
+// Update event filter only accepts Velero Schedule and Velero Backup update events from OADP namespace
+// and from Velero Schedules created by a NonAdminSchedule
+func (p VeleroSchedulePredicate) Update(ctx context.Context, evt event.TypedUpdateEvent[client.Object]) bool {
+	logger := function.GetLogger(ctx, evt.ObjectNew, veleroSchedulePredicateKey)
+
+	if evt.ObjectNew.GetNamespace() == p.OADPNamespace && function.CheckVeleroScheduleMetadata(evt.ObjectNew) {
+		logger.V(1).Info("Accepted Update event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Update event")
+	return false
+}
+
+// Delete event filter only accepts Velero Schedule and Velero Backup delete events from OADP namespace
+// and from Velero Schedules created by a NonAdminSchedule
+func (p VeleroSchedulePredicate) Delete(ctx context.Context, evt event.DeleteEvent) bool {
+	logger := function.GetLogger(ctx, evt.Object, veleroSchedulePredicateKey)
+
+	if evt.Object.GetNamespace() == p.OADPNamespace && function.CheckVeleroScheduleMetadata(evt.Object) {
+		logger.V(1).Info("Accepted Delete event")
+		return true
+	}
+
+	logger.V(1).Info("Rejected Delete event")
+	return false
+}
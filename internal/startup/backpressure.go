@@ -0,0 +1,171 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package startup contains the startup backpressure of the controllers, which staggers
+// the initial reconcile of every existing object when the controllers start
+package startup
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultBurst is the default number of reconciles enqueued right away when a controller starts
+const DefaultBurst = 100
+
+// Backpressure staggers the reconciles enqueued while the controllers warm up.
+//
+// A controller warms up from its start until the reconciles of the objects listed by its
+// cache are released to its workers. The first Burst reconciles enqueued while warming up
+// are released right away, the next ones at QPS reconciles per second.
+type Backpressure struct {
+	queues []*queue
+	qps    float64
+	burst  int
+	mu     sync.Mutex
+}
+
+// New returns a Backpressure releasing qps reconciles per second after the first burst ones
+func New(qps float64, burst int) (*Backpressure, error) {
+	if qps <= 0 {
+		return nil, errors.New("startup reconcile QPS must be greater than zero")
+	}
+	if burst < 0 {
+		return nil, errors.New("startup reconcile burst must not be negative")
+	}
+	return &Backpressure{qps: qps, burst: burst}, nil
+}
+
+// ControllerOptions returns the options of a controller using the backpressure.
+// It returns the default options if b is nil.
+func (b *Backpressure) ControllerOptions() controller.Options {
+	if b == nil {
+		return controller.Options{}
+	}
+	return controller.Options{NewQueue: b.newQueue}
+}
+
+// Checker is a check failing while a controller warms up. It must not be a readiness check: the webhooks are
+// served by the same pod, and would reject the requests of the whole cluster while the pod is not ready.
+func (b *Backpressure) Checker(_ *http.Request) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, warmingUpQueue := range b.queues {
+		if released, total, warmingUp := warmingUpQueue.progress(); warmingUp {
+			return fmt.Errorf("controller %s is warming up: %d of %d initial reconciles released", warmingUpQueue.name, released, total)
+		}
+	}
+	return nil
+}
+
+// newQueue is called when a controller starts, which happens only in the leader replica
+func (b *Backpressure) newQueue(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	warmingUpQueue := &queue{
+		TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+			Name: controllerName,
+		}),
+		now:      time.Now,
+		name:     controllerName,
+		interval: time.Duration(float64(time.Second) / b.qps),
+		burst:    b.burst,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.queues = append(b.queues, warmingUpQueue)
+	return warmingUpQueue
+}
+
+// queue delays the reconciles added while its controller warms up
+type queue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+	start time.Time
+	now   func() time.Time
+	name  string
+	// releases holds the time the reconciles added while warming up are released, in order
+	releases []time.Time
+	interval time.Duration
+	burst    int
+	mu       sync.Mutex
+	// workersStarted is set once the controller workers start, after its cache synced
+	workersStarted bool
+}
+
+// Add adds the item to the queue, delaying it while the controller warms up
+func (q *queue) Add(item reconcile.Request) {
+	if delay := q.reserve(); delay > 0 {
+		q.AddAfter(item, delay)
+		return
+	}
+	q.TypedRateLimitingInterface.Add(item)
+}
+
+// Get is called by the controller workers, which start once the controller cache synced
+func (q *queue) Get() (reconcile.Request, bool) {
+	q.mu.Lock()
+	q.workersStarted = true
+	q.mu.Unlock()
+	return q.TypedRateLimitingInterface.Get()
+}
+
+// reserve returns how long an item added now must wait before being released
+func (q *queue) reserve() time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	if !q.warmingUp(now) {
+		return 0
+	}
+	if len(q.releases) == 0 {
+		q.start = now
+	}
+	release := now
+	if position := len(q.releases) - q.burst; position >= 0 {
+		release = q.start.Add(time.Duration(position+1) * q.interval)
+	}
+	if release.Before(now) {
+		release = now
+	}
+	q.releases = append(q.releases, release)
+	return release.Sub(now)
+}
+
+// warmingUp returns true until the workers started and the reconciles added while warming up are released
+func (q *queue) warmingUp(now time.Time) bool {
+	return !q.workersStarted || (len(q.releases) > 0 && now.Before(q.releases[len(q.releases)-1]))
+}
+
+// progress returns how many of the reconciles added while warming up were released and if the queue is still warming up
+func (q *queue) progress() (int, int, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	released := sort.Search(len(q.releases), func(index int) bool {
+		return q.releases[index].After(now)
+	})
+	return released, len(q.releases), q.warmingUp(now)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package startup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	testQPS   = 10
+	testBurst = 2
+)
+
+func newTestQueue(t *testing.T, now *time.Time) (*Backpressure, *queue) {
+	t.Helper()
+	backpressure, err := New(testQPS, testBurst)
+	assert.NoError(t, err)
+
+	warmingUpQueue := backpressure.newQueue("test", workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]()).(*queue)
+	t.Cleanup(warmingUpQueue.ShutDown)
+	warmingUpQueue.now = func() time.Time { return *now }
+	return backpressure, warmingUpQueue
+}
+
+func testRequest(index int) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "test", Name: fmt.Sprintf("nab-%d", index)}}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		qps         float64
+		burst       int
+		expectError bool
+	}{
+		{name: "valid", qps: testQPS, burst: testBurst},
+		{name: "zero burst", qps: testQPS},
+		{name: "zero QPS", burst: testBurst, expectError: true},
+		{name: "negative QPS", qps: -testQPS, burst: testBurst, expectError: true},
+		{name: "negative burst", qps: testQPS, burst: -testBurst, expectError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backpressure, err := New(test.qps, test.burst)
+			if test.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, backpressure)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, backpressure)
+			}
+		})
+	}
+}
+
+func TestReserve(t *testing.T) {
+	now := time.Now()
+	_, warmingUpQueue := newTestQueue(t, &now)
+
+	// burst is released right away, next reconciles every 1/QPS second
+	expected := []time.Duration{0, 0, 100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}
+	for index, expectedDelay := range expected {
+		assert.Equal(t, expectedDelay, warmingUpQueue.reserve(), "reconcile %d", index)
+	}
+
+	// delays are relative to the warm up start
+	now = now.Add(150 * time.Millisecond)
+	assert.Equal(t, 250*time.Millisecond, warmingUpQueue.reserve())
+
+	// once workers started and all reconciles are released, reconciles are not delayed anymore
+	warmingUpQueue.workersStarted = true
+	now = now.Add(time.Second)
+	assert.Zero(t, warmingUpQueue.reserve())
+	assert.Len(t, warmingUpQueue.releases, len(expected)+1)
+}
+
+func TestAdd(t *testing.T) {
+	now := time.Now()
+	_, warmingUpQueue := newTestQueue(t, &now)
+
+	for index := range testBurst + 1 {
+		warmingUpQueue.Add(testRequest(index))
+	}
+	assert.Equal(t, testBurst, warmingUpQueue.Len())
+}
+
+func TestChecker(t *testing.T) {
+	now := time.Now()
+	backpressure, warmingUpQueue := newTestQueue(t, &now)
+
+	for range testBurst + testBurst {
+		warmingUpQueue.reserve()
+	}
+	assert.EqualError(t, backpressure.Checker(nil), "controller test is warming up: 2 of 4 initial reconciles released")
+
+	warmingUpQueue.workersStarted = true
+	now = now.Add(150 * time.Millisecond)
+	assert.EqualError(t, backpressure.Checker(nil), "controller test is warming up: 3 of 4 initial reconciles released")
+
+	now = now.Add(time.Second)
+	assert.NoError(t, backpressure.Checker(nil))
+}
+
+func TestControllerOptions(t *testing.T) {
+	var disabled *Backpressure
+	assert.Nil(t, disabled.ControllerOptions().NewQueue)
+
+	backpressure, err := New(testQPS, testBurst)
+	assert.NoError(t, err)
+	assert.NotNil(t, backpressure.ControllerOptions().NewQueue)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validationhook contains the client of the validation hook, an HTTP endpoint
+// the cluster admin provides to add site-specific rules to the validation of non admin objects
+package validationhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+)
+
+const (
+	// DefaultTimeout is the default timeout of validation hook calls
+	DefaultTimeout = 10 * time.Second
+	// maxResponseBytes limits the size of the validation hook response read by the controller
+	maxResponseBytes = 1 << 20
+)
+
+// Request is the body sent to the validation hook
+type Request struct {
+	// Object is the non admin object being validated
+	Object     client.Object `json:"object"`
+	APIVersion string        `json:"apiVersion"`
+	// Resource is the plural resource name of the object, for example nonadminbackups
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// Response is the body expected from the validation hook
+type Response struct {
+	// Message explains why the object was not allowed
+	Message string `json:"message,omitempty"`
+	Allowed bool   `json:"allowed"`
+}
+
+// RejectedError is returned when the validation hook does not allow an object
+type RejectedError struct {
+	Message string
+}
+
+func (e *RejectedError) Error() string {
+	if e.Message == constant.EmptyString {
+		return "rejected by the cluster administrator validation rules"
+	}
+	return "rejected by the cluster administrator validation rules: " + e.Message
+}
+
+// Hook calls the validation hook endpoint
+type Hook struct {
+	HTTPClient *http.Client
+	URL        string
+}
+
+// New returns a Hook calling url, which must be https if caFile is set.
+// caFile is the PEM encoded CA bundle used to verify the endpoint certificate,
+// if empty the system CA bundle is used
+func New(url string, caFile string, timeout time.Duration) (*Hook, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caFile != constant.EmptyString {
+		caBundle, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read validation hook CA file: %w", err)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("validation hook CA file %s does not contain any PEM certificate", caFile)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    certPool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &Hook{
+		HTTPClient: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+		URL: url,
+	}, nil
+}
+
+// Validate sends obj to the validation hook, unless the hook is not configured or the current
+// generation of obj was already accepted, according to its Accepted condition.
+// Returns a *RejectedError if the hook does not allow obj, or any other error if the hook could not be called
+func (h *Hook) Validate(ctx context.Context, resource string, obj client.Object, conditions []metav1.Condition) error {
+	if h == nil {
+		return nil
+	}
+	accepted := meta.FindStatusCondition(conditions, string(nacv1alpha1.NonAdminConditionAccepted))
+	if accepted != nil && accepted.Status == metav1.ConditionTrue && accepted.ObservedGeneration == obj.GetGeneration() {
+		return nil
+	}
+
+	body, err := json.Marshal(Request{
+		Object:     obj,
+		APIVersion: nacv1alpha1.GroupVersion.String(),
+		Resource:   resource,
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to encode validation hook request: %w", err)
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("unable to create validation hook request: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := h.HTTPClient.Do(httpRequest)
+	if err != nil {
+		return fmt.Errorf("unable to call validation hook: %w", err)
+	}
+	responseBody, err := io.ReadAll(io.LimitReader(httpResponse.Body, maxResponseBytes))
+	closeErr := httpResponse.Body.Close()
+	if err != nil {
+		return fmt.Errorf("unable to read validation hook response: %w", err)
+	}
+	if closeErr != nil {
+		return fmt.Errorf("unable to read validation hook response: %w", closeErr)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("validation hook returned HTTP status %d", httpResponse.StatusCode)
+	}
+
+	response := Response{}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return fmt.Errorf("unable to decode validation hook response: %w", err)
+	}
+	if !response.Allowed {
+		return &RejectedError{Message: response.Message}
+	}
+	return nil
+}
+
+// IsRejected returns true if err was returned because the validation hook did not allow the object
+func IsRejected(err error) bool {
+	var rejectedError *RejectedError
+	return errors.As(err, &rejectedError)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validationhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		response       any
+		name           string
+		conditions     []metav1.Condition
+		statusCode     int
+		expectedCalled bool
+		expectRejected bool
+		expectError    bool
+	}{
+		{
+			name:           "allowed",
+			statusCode:     http.StatusOK,
+			response:       Response{Allowed: true},
+			expectedCalled: true,
+		},
+		{
+			name:           "rejected",
+			statusCode:     http.StatusOK,
+			response:       Response{Allowed: false, Message: "backup name must start with team-"},
+			expectedCalled: true,
+			expectRejected: true,
+			expectError:    true,
+		},
+		{
+			name:           "hook error",
+			statusCode:     http.StatusInternalServerError,
+			response:       Response{Allowed: true},
+			expectedCalled: true,
+			expectError:    true,
+		},
+		{
+			name:           "invalid response",
+			statusCode:     http.StatusOK,
+			response:       "allowed",
+			expectedCalled: true,
+			expectError:    true,
+		},
+		{
+			name: "generation already accepted",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 2,
+				},
+			},
+			statusCode: http.StatusOK,
+			response:   Response{Allowed: false},
+		},
+		{
+			name: "new generation",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(nacv1alpha1.NonAdminConditionAccepted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+				},
+			},
+			statusCode:     http.StatusOK,
+			response:       Response{Allowed: true},
+			expectedCalled: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called := false
+			server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				called = true
+				hookRequest := Request{Object: &nacv1alpha1.NonAdminBackup{}}
+				assert.NoError(t, json.NewDecoder(request.Body).Decode(&hookRequest))
+				assert.Equal(t, nacv1alpha1.NonAdminBackups, hookRequest.Resource)
+				assert.Equal(t, "test-namespace", hookRequest.Namespace)
+				assert.Equal(t, "test-backup", hookRequest.Name)
+				assert.Equal(t, "test-backup", hookRequest.Object.GetName())
+				writer.WriteHeader(test.statusCode)
+				assert.NoError(t, json.NewEncoder(writer).Encode(test.response))
+			}))
+			defer server.Close()
+
+			hook, err := New(server.URL, "", DefaultTimeout)
+			assert.NoError(t, err)
+
+			nonAdminBackup := &nacv1alpha1.NonAdminBackup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-backup",
+					Namespace:  "test-namespace",
+					Generation: 2,
+				},
+			}
+			err = hook.Validate(context.Background(), nacv1alpha1.NonAdminBackups, nonAdminBackup, test.conditions)
+			assert.Equal(t, test.expectedCalled, called)
+			assert.Equal(t, test.expectError, err != nil)
+			assert.Equal(t, test.expectRejected, IsRejected(err))
+		})
+	}
+
+	t.Run("hook not configured", func(t *testing.T) {
+		var hook *Hook
+		assert.NoError(t, hook.Validate(context.Background(), nacv1alpha1.NonAdminBackups, &nacv1alpha1.NonAdminBackup{}, nil))
+	})
+}
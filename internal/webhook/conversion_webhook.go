@@ -0,0 +1,36 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+)
+
+// SetupConversionWebhookWithManager registers the conversion webhook of the NonAdminBackup and NonAdminRestore
+// APIs in the manager, converting the objects between v1beta1 and v1alpha1, their storage version.
+// The conversion webhook is served at /convert.
+func SetupConversionWebhookWithManager(mgr ctrl.Manager) error {
+	for _, apiType := range []runtime.Object{&nacv1alpha1.NonAdminBackup{}, &nacv1alpha1.NonAdminRestore{}} {
+		if err := ctrl.NewWebhookManagedBy(mgr).For(apiType).Complete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
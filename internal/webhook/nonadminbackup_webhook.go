@@ -0,0 +1,44 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook contains all admission webhooks of the project
+package webhook
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// +kubebuilder:webhook:path=/mutate-oadp-openshift-io-v1alpha1-nonadminbackup,mutating=true,failurePolicy=fail,sideEffects=None,groups=oadp.openshift.io,resources=nonadminbackups,verbs=create,versions=v1alpha1,name=mnonadminbackup.oadp.openshift.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-oadp-openshift-io-v1alpha1-nonadminbackup,mutating=false,failurePolicy=fail,sideEffects=None,groups=oadp.openshift.io,resources=nonadminbackups,verbs=update,versions=v1alpha1,name=vnonadminbackup.oadp.openshift.io,admissionReviewVersions=v1
+
+// newNonAdminBackupWebhook returns the webhook which records the identity of the user creating a NonAdminBackup,
+// and prevents it from being changed afterwards
+func newNonAdminBackupWebhook(controllerUsername string) *requesterWebhook[*nacv1alpha1.NonAdminBackup] {
+	return &requesterWebhook[*nacv1alpha1.NonAdminBackup]{
+		kind:                 "NonAdminBackup",
+		requesterAnnotations: function.NonAdminBackupRequesterAnnotations,
+		controllerUsername:   controllerUsername,
+	}
+}
+
+// SetupNonAdminBackupWebhookWithManager registers the NonAdminBackup webhooks in the manager. controllerUsername is the
+// username of NAC, whose NonAdminBackups keep the requester annotations it set.
+func SetupNonAdminBackupWebhookWithManager(mgr ctrl.Manager, controllerUsername string) error {
+	return setupRequesterWebhookWithManager(mgr, &nacv1alpha1.NonAdminBackup{}, newNonAdminBackupWebhook(controllerUsername))
+}
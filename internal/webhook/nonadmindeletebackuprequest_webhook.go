@@ -0,0 +1,43 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// +kubebuilder:webhook:path=/mutate-oadp-openshift-io-v1alpha1-nonadmindeletebackuprequest,mutating=true,failurePolicy=fail,sideEffects=None,groups=oadp.openshift.io,resources=nonadmindeletebackuprequests,verbs=create,versions=v1alpha1,name=mnonadmindeletebackuprequest.oadp.openshift.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-oadp-openshift-io-v1alpha1-nonadmindeletebackuprequest,mutating=false,failurePolicy=fail,sideEffects=None,groups=oadp.openshift.io,resources=nonadmindeletebackuprequests,verbs=update,versions=v1alpha1,name=vnonadmindeletebackuprequest.oadp.openshift.io,admissionReviewVersions=v1
+
+// newNonAdminDeleteBackupRequestWebhook returns the webhook which records the identity of the user creating a NonAdminDeleteBackupRequest,
+// and prevents it from being changed afterwards
+func newNonAdminDeleteBackupRequestWebhook(controllerUsername string) *requesterWebhook[*nacv1alpha1.NonAdminDeleteBackupRequest] {
+	return &requesterWebhook[*nacv1alpha1.NonAdminDeleteBackupRequest]{
+		kind:                 "NonAdminDeleteBackupRequest",
+		requesterAnnotations: function.NonAdminDeleteBackupRequestRequesterAnnotations,
+		controllerUsername:   controllerUsername,
+	}
+}
+
+// SetupNonAdminDeleteBackupRequestWebhookWithManager registers the NonAdminDeleteBackupRequest webhooks in the manager. controllerUsername is the
+// username of NAC, whose NonAdminDeleteBackupRequests keep the requester annotations it set.
+func SetupNonAdminDeleteBackupRequestWebhookWithManager(mgr ctrl.Manager, controllerUsername string) error {
+	return setupRequesterWebhookWithManager(mgr, &nacv1alpha1.NonAdminDeleteBackupRequest{}, newNonAdminDeleteBackupRequestWebhook(controllerUsername))
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// +kubebuilder:webhook:path=/mutate-oadp-openshift-io-v1alpha1-nonadminrestore,mutating=true,failurePolicy=fail,sideEffects=None,groups=oadp.openshift.io,resources=nonadminrestores,verbs=create,versions=v1alpha1,name=mnonadminrestore.oadp.openshift.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-oadp-openshift-io-v1alpha1-nonadminrestore,mutating=false,failurePolicy=fail,sideEffects=None,groups=oadp.openshift.io,resources=nonadminrestores,verbs=update,versions=v1alpha1,name=vnonadminrestore.oadp.openshift.io,admissionReviewVersions=v1
+
+// newNonAdminRestoreWebhook returns the webhook which records the identity of the user creating a NonAdminRestore, and prevents it, and the spec
+// its Velero Restore was created from, from being changed afterwards
+func newNonAdminRestoreWebhook(controllerUsername string) *requesterWebhook[*nacv1alpha1.NonAdminRestore] {
+	return &requesterWebhook[*nacv1alpha1.NonAdminRestore]{
+		kind:                 "NonAdminRestore",
+		requesterAnnotations: function.NonAdminRestoreRequesterAnnotations,
+		validateUpdate:       validateNonAdminRestoreUpdate,
+		controllerUsername:   controllerUsername,
+	}
+}
+
+// SetupNonAdminRestoreWebhookWithManager registers the NonAdminRestore webhooks in the manager. controllerUsername is the
+// username of NAC, whose NonAdminRestores keep the requester annotations it set.
+func SetupNonAdminRestoreWebhookWithManager(mgr ctrl.Manager, controllerUsername string) error {
+	return setupRequesterWebhookWithManager(mgr, &nacv1alpha1.NonAdminRestore{}, newNonAdminRestoreWebhook(controllerUsername))
+}
+
+// validateNonAdminRestoreUpdate rejects changes to the spec of a NonAdminRestore, other than spec.cancel,
+// once its Velero Restore was created
+func validateNonAdminRestoreUpdate(oldNar *nacv1alpha1.NonAdminRestore, newNar *nacv1alpha1.NonAdminRestore) error {
+	if meta.IsStatusConditionTrue(oldNar.Status.Conditions, string(nacv1alpha1.NonAdminConditionQueued)) {
+		oldSpec := oldNar.Spec.DeepCopy()
+		newSpec := newNar.Spec.DeepCopy()
+		oldSpec.Cancel, newSpec.Cancel = false, false
+		if !equality.Semantic.DeepEqual(oldSpec, newSpec) {
+			return errors.New("NonAdminRestore spec can not be changed after its Velero Restore was created, except spec.cancel")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/migtools/oadp-non-admin/internal/common/constant"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+// +kubebuilder:rbac:groups=authentication.k8s.io,resources=selfsubjectreviews,verbs=create
+
+// requesterWebhook records the identity of the user creating an object of kind T in its requester annotations,
+// and prevents them from being changed afterwards
+type requesterWebhook[T client.Object] struct {
+	// validateUpdate rejects the changes of an update other than the requester annotations ones, if set
+	validateUpdate func(oldObj T, newObj T) error
+	kind           string
+	// controllerUsername is the username of NAC, whose objects keep the requester annotations it set, like the
+	// NonAdminBackups of a NonAdminGroupBackup recording the NonAdminGroupBackup requester
+	controllerUsername   string
+	requesterAnnotations function.RequesterAnnotations
+}
+
+// setupRequesterWebhookWithManager registers the requester webhooks of the kind of obj in the manager
+func setupRequesterWebhookWithManager[T client.Object](mgr ctrl.Manager, obj T, webhook *requesterWebhook[T]) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(obj).
+		WithDefaulter(webhook).
+		WithValidator(webhook).
+		Complete()
+}
+
+// Default sets the requester annotations of an object being created,
+// overwriting any value set by the user, unless NAC creates it
+func (webhook *requesterWebhook[T]) Default(ctx context.Context, obj runtime.Object) error {
+	object, ok := obj.(T)
+	if !ok {
+		return fmt.Errorf("expected a %s object but got %T", webhook.kind, obj)
+	}
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if req.Operation != admissionv1.Create {
+		return nil
+	}
+	if webhook.controllerUsername != constant.EmptyString && req.UserInfo.Username == webhook.controllerUsername {
+		return nil
+	}
+
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	for key, value := range function.GetRequesterAnnotations(req.UserInfo, webhook.requesterAnnotations) {
+		annotations[key] = value
+	}
+	object.SetAnnotations(annotations)
+	return nil
+}
+
+// ValidateCreate does not validate anything, requester annotations are set by Default
+func (*requesterWebhook[T]) ValidateCreate(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateUpdate rejects changes to the requester annotations of an object, and the other changes
+// rejected by validateUpdate
+func (webhook *requesterWebhook[T]) ValidateUpdate(_ context.Context, oldObj runtime.Object, newObj runtime.Object) (admission.Warnings, error) {
+	oldObject, ok := oldObj.(T)
+	if !ok {
+		return nil, fmt.Errorf("expected a %s object but got %T", webhook.kind, oldObj)
+	}
+	newObject, ok := newObj.(T)
+	if !ok {
+		return nil, fmt.Errorf("expected a %s object but got %T", webhook.kind, newObj)
+	}
+	for _, key := range webhook.requesterAnnotations.Keys() {
+		if oldObject.GetAnnotations()[key] != newObject.GetAnnotations()[key] {
+			return nil, fmt.Errorf("%s metadata.annotations[%s] can not be changed", webhook.kind, key)
+		}
+	}
+	if webhook.validateUpdate != nil {
+		return nil, webhook.validateUpdate(oldObject, newObject)
+	}
+	return nil, nil
+}
+
+// ValidateDelete does not validate anything
+func (*requesterWebhook[T]) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	nacv1alpha1 "github.com/migtools/oadp-non-admin/api/v1alpha1"
+	"github.com/migtools/oadp-non-admin/internal/common/function"
+)
+
+const testControllerUsername = "system:serviceaccount:openshift-adp:non-admin-controller"
+
+type testRequesterWebhook interface {
+	admission.CustomDefaulter
+	admission.CustomValidator
+}
+
+var requesterWebhookTests = []struct {
+	webhook              testRequesterWebhook
+	newObject            func() client.Object
+	kind                 string
+	requesterAnnotations function.RequesterAnnotations
+}{
+	{
+		kind:                 "NonAdminBackup",
+		webhook:              newNonAdminBackupWebhook(testControllerUsername),
+		newObject:            func() client.Object { return &nacv1alpha1.NonAdminBackup{} },
+		requesterAnnotations: function.NonAdminBackupRequesterAnnotations,
+	},
+	{
+		kind:                 "NonAdminRestore",
+		webhook:              newNonAdminRestoreWebhook(testControllerUsername),
+		newObject:            func() client.Object { return &nacv1alpha1.NonAdminRestore{} },
+		requesterAnnotations: function.NonAdminRestoreRequesterAnnotations,
+	},
+	{
+		kind:                 "NonAdminGroupBackup",
+		webhook:              newNonAdminGroupBackupWebhook(testControllerUsername),
+		newObject:            func() client.Object { return &nacv1alpha1.NonAdminGroupBackup{} },
+		requesterAnnotations: function.NonAdminGroupBackupRequesterAnnotations,
+	},
+	{
+		kind:                 "NonAdminDeleteBackupRequest",
+		webhook:              newNonAdminDeleteBackupRequestWebhook(testControllerUsername),
+		newObject:            func() client.Object { return &nacv1alpha1.NonAdminDeleteBackupRequest{} },
+		requesterAnnotations: function.NonAdminDeleteBackupRequestRequesterAnnotations,
+	},
+	{
+		kind:                 "NonAdminRetentionPolicy",
+		webhook:              newNonAdminRetentionPolicyWebhook(testControllerUsername),
+		newObject:            func() client.Object { return &nacv1alpha1.NonAdminRetentionPolicy{} },
+		requesterAnnotations: function.NonAdminRetentionPolicyRequesterAnnotations,
+	},
+	{
+		kind:                 "NonAdminBackupVerification",
+		webhook:              newNonAdminBackupVerificationWebhook(testControllerUsername),
+		newObject:            func() client.Object { return &nacv1alpha1.NonAdminBackupVerification{} },
+		requesterAnnotations: function.NonAdminBackupVerificationRequesterAnnotations,
+	},
+}
+
+func TestRequesterWebhookDefault(t *testing.T) {
+	userInfo := authenticationv1.UserInfo{
+		Username: "tenant",
+		UID:      "tenant-uid",
+		Groups:   []string{"system:authenticated", "tenants"},
+		Extra:    map[string]authenticationv1.ExtraValue{"scopes.authorization.openshift.io": {"user:full"}},
+	}
+	for _, webhookTest := range requesterWebhookTests {
+		tests := []struct {
+			annotations map[string]string
+			expected    map[string]string
+			name        string
+			username    string
+			operation   admissionv1.Operation
+		}{
+			{
+				name:      "create without annotations",
+				operation: admissionv1.Create,
+				expected:  function.GetRequesterAnnotations(userInfo, webhookTest.requesterAnnotations),
+			},
+			{
+				name:      "create with requester annotations set by the user",
+				operation: admissionv1.Create,
+				annotations: map[string]string{
+					webhookTest.requesterAnnotations.Username: "cluster-admin",
+					webhookTest.requesterAnnotations.Groups:   "system:masters",
+					"other":                                   "value",
+				},
+				expected: map[string]string{
+					webhookTest.requesterAnnotations.Username: "tenant",
+					webhookTest.requesterAnnotations.UID:      "tenant-uid",
+					webhookTest.requesterAnnotations.Groups:   `["system:authenticated","tenants"]`,
+					webhookTest.requesterAnnotations.Extra:    `{"scopes.authorization.openshift.io":["user:full"]}`,
+					"other":                                   "value",
+				},
+			},
+			{
+				name:        "create by NAC keeps the requester annotations",
+				operation:   admissionv1.Create,
+				username:    testControllerUsername,
+				annotations: map[string]string{webhookTest.requesterAnnotations.Username: "group-tenant"},
+				expected:    map[string]string{webhookTest.requesterAnnotations.Username: "group-tenant"},
+			},
+			{
+				name:        "update",
+				operation:   admissionv1.Update,
+				annotations: map[string]string{webhookTest.requesterAnnotations.Username: "cluster-admin"},
+				expected:    map[string]string{webhookTest.requesterAnnotations.Username: "cluster-admin"},
+			},
+		}
+		for _, test := range tests {
+			t.Run(webhookTest.kind+" "+test.name, func(t *testing.T) {
+				object := webhookTest.newObject()
+				object.SetAnnotations(test.annotations)
+				requestUserInfo := userInfo
+				if len(test.username) > 0 {
+					requestUserInfo.Username = test.username
+				}
+				ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{Operation: test.operation, UserInfo: requestUserInfo},
+				})
+
+				assert.NoError(t, webhookTest.webhook.Default(ctx, object))
+				assert.Equal(t, test.expected, object.GetAnnotations())
+			})
+		}
+		t.Run(webhookTest.kind+" other kind", func(t *testing.T) {
+			err := webhookTest.webhook.Default(context.Background(), &nacv1alpha1.NonAdminQuotaStatus{})
+			assert.EqualError(t, err, "expected a "+webhookTest.kind+" object but got *v1alpha1.NonAdminQuotaStatus")
+		})
+	}
+}
+
+func TestRequesterWebhookValidateUpdate(t *testing.T) {
+	for _, webhookTest := range requesterWebhookTests {
+		requesterAnnotations := function.GetRequesterAnnotations(authenticationv1.UserInfo{
+			Username: "tenant",
+			UID:      "tenant-uid",
+		}, webhookTest.requesterAnnotations)
+		tests := []struct {
+			annotations map[string]string
+			name        string
+			errMessage  string
+		}{
+			{
+				name:        "requester annotations unchanged",
+				annotations: map[string]string{"other": "value"},
+			},
+			{
+				name:        "username changed",
+				annotations: map[string]string{webhookTest.requesterAnnotations.Username: "cluster-admin"},
+				errMessage:  webhookTest.kind + " metadata.annotations[" + webhookTest.requesterAnnotations.Username + "] can not be changed",
+			},
+			{
+				name:        "groups added",
+				annotations: map[string]string{webhookTest.requesterAnnotations.Groups: `["system:masters"]`},
+				errMessage:  webhookTest.kind + " metadata.annotations[" + webhookTest.requesterAnnotations.Groups + "] can not be changed",
+			},
+			{
+				name:        "extra added",
+				annotations: map[string]string{webhookTest.requesterAnnotations.Extra: `{"scopes.authorization.openshift.io":["user:full"]}`},
+				errMessage:  webhookTest.kind + " metadata.annotations[" + webhookTest.requesterAnnotations.Extra + "] can not be changed",
+			},
+		}
+		for _, test := range tests {
+			t.Run(webhookTest.kind+" "+test.name, func(t *testing.T) {
+				oldObject := webhookTest.newObject()
+				oldObject.SetAnnotations(requesterAnnotations)
+				newObject := webhookTest.newObject()
+				newAnnotations := maps.Clone(requesterAnnotations)
+				maps.Copy(newAnnotations, test.annotations)
+				newObject.SetAnnotations(newAnnotations)
+
+				_, err := webhookTest.webhook.ValidateUpdate(context.Background(), oldObject, newObject)
+				if len(test.errMessage) == 0 {
+					assert.NoError(t, err)
+				} else {
+					assert.EqualError(t, err, test.errMessage)
+				}
+			})
+		}
+	}
+}
+
+func TestNonAdminRestoreWebhookValidateUpdate(t *testing.T) {
+	queued := []metav1.Condition{{Type: string(nacv1alpha1.NonAdminConditionQueued), Status: metav1.ConditionTrue}}
+	tests := []struct {
+		name       string
+		errMessage string
+		conditions []metav1.Condition
+		cancel     bool
+		backupName string
+	}{
+		{
+			name:       "spec changed before the Velero Restore was created",
+			backupName: "other",
+		},
+		{
+			name:       "spec changed after the Velero Restore was created",
+			conditions: queued,
+			backupName: "other",
+			errMessage: "NonAdminRestore spec can not be changed after its Velero Restore was created, except spec.cancel",
+		},
+		{
+			name:       "canceled after the Velero Restore was created",
+			conditions: queued,
+			backupName: "test",
+			cancel:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldNar := &nacv1alpha1.NonAdminRestore{
+				Spec: nacv1alpha1.NonAdminRestoreSpec{RestoreSpec: &velerov1.RestoreSpec{BackupName: "test"}},
+			}
+			oldNar.Status.Conditions = test.conditions
+			newNar := oldNar.DeepCopy()
+			newNar.Spec.RestoreSpec.BackupName = test.backupName
+			newNar.Spec.Cancel = test.cancel
+
+			_, err := newNonAdminRestoreWebhook(testControllerUsername).ValidateUpdate(context.Background(), oldNar, newNar)
+			if len(test.errMessage) == 0 {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMessage)
+			}
+		})
+	}
+}
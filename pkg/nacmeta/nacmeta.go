@@ -0,0 +1,227 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nacmeta contains the labels and annotations the Non Admin Controller (NAC) sets on the
+// objects it creates in the OADP namespace, like Velero Backups, Restores and BackupStorageLocations,
+// and functions to read them.
+//
+// It is meant to be imported by tools that need to identify NAC objects, like console plugins,
+// must-gather or Velero CLI wrappers. The label and annotation keys of an object depend on the schema
+// version stored in its SchemaVersionAnnotation, so reading them with GetOrigin keeps working across
+// NAC releases.
+package nacmeta
+
+import (
+	"errors"
+	"fmt"
+
+	oadpv1alpha1 "github.com/openshift/oadp-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Labels set by NAC on every object it creates
+const (
+	OadpLabel           = oadpv1alpha1.OadpOperatorLabel
+	OadpLabelValue      = "True"
+	ManagedByLabel      = "app.kubernetes.io/managed-by"
+	ManagedByLabelValue = "oadp-nac-controller"
+)
+
+// Labels holding the NACUUID of the NAC object an object was created for
+const (
+	NabOriginNACUUIDLabel   = oadpv1alpha1.OadpOperatorLabel + "-nab-origin-nacuuid"
+	NarOriginNACUUIDLabel   = oadpv1alpha1.OadpOperatorLabel + "-nar-origin-nacuuid"
+	NabslOriginNACUUIDLabel = oadpv1alpha1.OadpOperatorLabel + "-nabsl-origin-nacuuid"
+	NadrOriginNACUUIDLabel  = oadpv1alpha1.OadpOperatorLabel + "-nadr-origin-nacuuid"
+	NasOriginNACUUIDLabel   = oadpv1alpha1.OadpOperatorLabel + "-nas-origin-nacuuid"
+	NassrOriginNACUUIDLabel = oadpv1alpha1.OadpOperatorLabel + "-nassr-origin-nacuuid"
+	NavslOriginNACUUIDLabel = oadpv1alpha1.OadpOperatorLabel + "-navsl-origin-nacuuid"
+	NadptOriginNACUUIDLabel = oadpv1alpha1.OadpOperatorLabel + "-nadpt-origin-nacuuid"
+	NabvOriginNACUUIDLabel  = oadpv1alpha1.OadpOperatorLabel + "-nabv-origin-nacuuid"
+	NagbOriginNACUUIDLabel  = oadpv1alpha1.OadpOperatorLabel + "-nagb-origin-nacuuid"
+)
+
+// Annotations holding the namespace and name of the NAC object an object was created for
+const (
+	NabOriginNameAnnotation        = oadpv1alpha1.OadpOperatorLabel + "-nab-origin-name"
+	NabOriginNamespaceAnnotation   = oadpv1alpha1.OadpOperatorLabel + "-nab-origin-namespace"
+	NarOriginNameAnnotation        = oadpv1alpha1.OadpOperatorLabel + "-nar-origin-name"
+	NarOriginNamespaceAnnotation   = oadpv1alpha1.OadpOperatorLabel + "-nar-origin-namespace"
+	NabslOriginNameAnnotation      = oadpv1alpha1.OadpOperatorLabel + "-nabsl-origin-name"
+	NabslOriginNamespaceAnnotation = oadpv1alpha1.OadpOperatorLabel + "-nabsl-origin-namespace"
+	NadrOriginNameAnnotation       = oadpv1alpha1.OadpOperatorLabel + "-nadr-origin-name"
+	NadrOriginNamespaceAnnotation  = oadpv1alpha1.OadpOperatorLabel + "-nadr-origin-namespace"
+	NasOriginNameAnnotation        = oadpv1alpha1.OadpOperatorLabel + "-nas-origin-name"
+	NasOriginNamespaceAnnotation   = oadpv1alpha1.OadpOperatorLabel + "-nas-origin-namespace"
+	NassrOriginNameAnnotation      = oadpv1alpha1.OadpOperatorLabel + "-nassr-origin-name"
+	NassrOriginNamespaceAnnotation = oadpv1alpha1.OadpOperatorLabel + "-nassr-origin-namespace"
+	NavslOriginNameAnnotation      = oadpv1alpha1.OadpOperatorLabel + "-navsl-origin-name"
+	NavslOriginNamespaceAnnotation = oadpv1alpha1.OadpOperatorLabel + "-navsl-origin-namespace"
+	NadptOriginNameAnnotation      = oadpv1alpha1.OadpOperatorLabel + "-nadpt-origin-name"
+	NadptOriginNamespaceAnnotation = oadpv1alpha1.OadpOperatorLabel + "-nadpt-origin-namespace"
+	NabvOriginNameAnnotation       = oadpv1alpha1.OadpOperatorLabel + "-nabv-origin-name"
+	NabvOriginNamespaceAnnotation  = oadpv1alpha1.OadpOperatorLabel + "-nabv-origin-namespace"
+	NagbOriginNameAnnotation       = oadpv1alpha1.OadpOperatorLabel + "-nagb-origin-name"
+	NagbOriginNamespaceAnnotation  = oadpv1alpha1.OadpOperatorLabel + "-nagb-origin-namespace"
+)
+
+// SchemaVersionAnnotation holds the schema version of the NAC labels and annotations of an object
+const SchemaVersionAnnotation = oadpv1alpha1.OadpOperatorLabel + "-nac-schema-version"
+
+// Schema versions of the NAC labels and annotations
+const (
+	// LegacySchemaVersion is the schema of objects created before SchemaVersionAnnotation was set,
+	// which have no SchemaVersionAnnotation. Its keys are the same as SchemaVersion1 ones.
+	LegacySchemaVersion = "0"
+	// SchemaVersion1 is the first schema written in SchemaVersionAnnotation
+	SchemaVersion1 = "1"
+	// SchemaVersion is the schema of the objects created by this NAC release
+	SchemaVersion = SchemaVersion1
+)
+
+// Kind is the kind of the NAC object an object was created for
+type Kind string
+
+// Kinds of NAC objects
+const (
+	KindNonAdminBackup                 Kind = "NonAdminBackup"
+	KindNonAdminRestore                Kind = "NonAdminRestore"
+	KindNonAdminBackupStorageLocation  Kind = "NonAdminBackupStorageLocation"
+	KindNonAdminDownloadRequest        Kind = "NonAdminDownloadRequest"
+	KindNonAdminSchedule               Kind = "NonAdminSchedule"
+	KindNonAdminServerStatusRequest    Kind = "NonAdminServerStatusRequest"
+	KindNonAdminVolumeSnapshotLocation Kind = "NonAdminVolumeSnapshotLocation"
+	KindNonAdminDataProtectionTest     Kind = "NonAdminDataProtectionTest"
+	KindNonAdminBackupVerification     Kind = "NonAdminBackupVerification"
+	KindNonAdminGroupBackup            Kind = "NonAdminGroupBackup"
+)
+
+// Origin identifies the NAC object an object was created for
+type Origin struct {
+	// Kind of the NAC object
+	Kind Kind
+	// NACUUID of the NAC object
+	NACUUID string
+	// Namespace of the NAC object
+	Namespace string
+	// Name of the NAC object
+	Name string
+}
+
+// ErrNotManagedByNAC is returned when reading the origin of an object not created by NAC
+var ErrNotManagedByNAC = errors.New("object is not managed by NAC")
+
+// ErrUnsupportedSchemaVersion is returned when reading the origin of an object created by a newer NAC release
+var ErrUnsupportedSchemaVersion = errors.New("unsupported NAC schema version")
+
+type originKeys struct {
+	kind                Kind
+	nacuuidLabel        string
+	namespaceAnnotation string
+	nameAnnotation      string
+}
+
+var schemaVersion1OriginKeys = []originKeys{
+	{KindNonAdminBackup, NabOriginNACUUIDLabel, NabOriginNamespaceAnnotation, NabOriginNameAnnotation},
+	{KindNonAdminRestore, NarOriginNACUUIDLabel, NarOriginNamespaceAnnotation, NarOriginNameAnnotation},
+	{KindNonAdminBackupStorageLocation, NabslOriginNACUUIDLabel, NabslOriginNamespaceAnnotation, NabslOriginNameAnnotation},
+	{KindNonAdminDownloadRequest, NadrOriginNACUUIDLabel, NadrOriginNamespaceAnnotation, NadrOriginNameAnnotation},
+	{KindNonAdminServerStatusRequest, NassrOriginNACUUIDLabel, NassrOriginNamespaceAnnotation, NassrOriginNameAnnotation},
+	{KindNonAdminVolumeSnapshotLocation, NavslOriginNACUUIDLabel, NavslOriginNamespaceAnnotation, NavslOriginNameAnnotation},
+	{KindNonAdminDataProtectionTest, NadptOriginNACUUIDLabel, NadptOriginNamespaceAnnotation, NadptOriginNameAnnotation},
+	{KindNonAdminBackupVerification, NabvOriginNACUUIDLabel, NabvOriginNamespaceAnnotation, NabvOriginNameAnnotation},
+	{KindNonAdminGroupBackup, NagbOriginNACUUIDLabel, NagbOriginNamespaceAnnotation, NagbOriginNameAnnotation},
+	// Velero Backups created by a Velero Schedule also carry the NonAdminBackup keys once adopted,
+	// so NonAdminSchedule keys must be checked last
+	{KindNonAdminSchedule, NasOriginNACUUIDLabel, NasOriginNamespaceAnnotation, NasOriginNameAnnotation},
+}
+
+// IsManagedByNAC returns true if the object has the labels NAC sets on every object it creates
+func IsManagedByNAC(obj metav1.Object) bool {
+	labels := obj.GetLabels()
+	return labels[OadpLabel] == OadpLabelValue && labels[ManagedByLabel] == ManagedByLabelValue
+}
+
+// GetSchemaVersion returns the schema version of the NAC labels and annotations of the object,
+// LegacySchemaVersion if it has no SchemaVersionAnnotation
+func GetSchemaVersion(obj metav1.Object) string {
+	if version, ok := obj.GetAnnotations()[SchemaVersionAnnotation]; ok && version != "" {
+		return version
+	}
+	return LegacySchemaVersion
+}
+
+// GetOrigin returns the NAC object the object was created for. It returns ErrNotManagedByNAC if the object
+// was not created by NAC, or was created for an unknown kind of NAC object, and ErrUnsupportedSchemaVersion
+// if its schema version is newer than the ones known by this package.
+func GetOrigin(obj metav1.Object) (Origin, error) {
+	if !IsManagedByNAC(obj) {
+		return Origin{}, ErrNotManagedByNAC
+	}
+
+	var keys []originKeys
+	switch version := GetSchemaVersion(obj); version {
+	case LegacySchemaVersion, SchemaVersion1:
+		keys = schemaVersion1OriginKeys
+	default:
+		return Origin{}, fmt.Errorf("%w %q", ErrUnsupportedSchemaVersion, version)
+	}
+
+	labels := obj.GetLabels()
+	annotations := obj.GetAnnotations()
+	for _, key := range keys {
+		nacUUID, ok := labels[key.nacuuidLabel]
+		if !ok {
+			continue
+		}
+		return Origin{
+			Kind:      key.kind,
+			NACUUID:   nacUUID,
+			Namespace: annotations[key.namespaceAnnotation],
+			Name:      annotations[key.nameAnnotation],
+		}, nil
+	}
+	return Origin{}, ErrNotManagedByNAC
+}
+
+// SetOrigin sets the labels and annotations identifying origin as the NAC object obj is created for,
+// so GetOrigin of obj returns origin. It returns ErrNotManagedByNAC for an unknown kind of NAC object.
+func SetOrigin(obj metav1.Object, origin Origin) error {
+	for _, key := range schemaVersion1OriginKeys {
+		if key.kind != origin.Kind {
+			continue
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[OadpLabel] = OadpLabelValue
+		labels[ManagedByLabel] = ManagedByLabelValue
+		labels[key.nacuuidLabel] = origin.NACUUID
+		obj.SetLabels(labels)
+
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[key.namespaceAnnotation] = origin.Namespace
+		annotations[key.nameAnnotation] = origin.Name
+		annotations[SchemaVersionAnnotation] = SchemaVersion
+		obj.SetAnnotations(annotations)
+		return nil
+	}
+	return fmt.Errorf("%w: unknown kind %q", ErrNotManagedByNAC, origin.Kind)
+}
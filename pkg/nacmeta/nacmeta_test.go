@@ -0,0 +1,228 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nacmeta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetOrigin(t *testing.T) {
+	nacLabels := func(extra map[string]string) map[string]string {
+		labels := map[string]string{
+			OadpLabel:      OadpLabelValue,
+			ManagedByLabel: ManagedByLabelValue,
+		}
+		for key, value := range extra {
+			labels[key] = value
+		}
+		return labels
+	}
+	tests := []struct {
+		expectedError   error
+		expectedOrigin  Origin
+		name            string
+		expectedVersion string
+		objectMeta      metav1.ObjectMeta
+	}{
+		{
+			name: "Current schema Velero Backup",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NabOriginNACUUIDLabel: "nab-uuid"}),
+				Annotations: map[string]string{
+					NabOriginNamespaceAnnotation: "tenant",
+					NabOriginNameAnnotation:      "nightly",
+					SchemaVersionAnnotation:      SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminBackup, NACUUID: "nab-uuid", Namespace: "tenant", Name: "nightly"},
+		},
+		{
+			name: "Legacy schema Velero Restore",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NarOriginNACUUIDLabel: "nar-uuid"}),
+				Annotations: map[string]string{
+					NarOriginNamespaceAnnotation: "tenant",
+					NarOriginNameAnnotation:      "restore",
+				},
+			},
+			expectedVersion: LegacySchemaVersion,
+			expectedOrigin:  Origin{Kind: KindNonAdminRestore, NACUUID: "nar-uuid", Namespace: "tenant", Name: "restore"},
+		},
+		{
+			name: "Velero BackupStorageLocation",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NabslOriginNACUUIDLabel: "nabsl-uuid"}),
+				Annotations: map[string]string{
+					NabslOriginNamespaceAnnotation: "tenant",
+					NabslOriginNameAnnotation:      "bucket",
+					SchemaVersionAnnotation:        SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminBackupStorageLocation, NACUUID: "nabsl-uuid", Namespace: "tenant", Name: "bucket"},
+		},
+		{
+			name: "Velero ServerStatusRequest",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NassrOriginNACUUIDLabel: "nassr-uuid"}),
+				Annotations: map[string]string{
+					NassrOriginNamespaceAnnotation: "tenant",
+					NassrOriginNameAnnotation:      "status",
+					SchemaVersionAnnotation:        SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminServerStatusRequest, NACUUID: "nassr-uuid", Namespace: "tenant", Name: "status"},
+		},
+		{
+			name: "Velero VolumeSnapshotLocation",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NavslOriginNACUUIDLabel: "navsl-uuid"}),
+				Annotations: map[string]string{
+					NavslOriginNamespaceAnnotation: "tenant",
+					NavslOriginNameAnnotation:      "snapshots",
+					SchemaVersionAnnotation:        SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminVolumeSnapshotLocation, NACUUID: "navsl-uuid", Namespace: "tenant", Name: "snapshots"},
+		},
+		{
+			name: "DataProtectionTest",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NadptOriginNACUUIDLabel: "nadpt-uuid"}),
+				Annotations: map[string]string{
+					NadptOriginNamespaceAnnotation: "tenant",
+					NadptOriginNameAnnotation:      "check",
+					SchemaVersionAnnotation:        SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminDataProtectionTest, NACUUID: "nadpt-uuid", Namespace: "tenant", Name: "check"},
+		},
+		{
+			name: "Backup verification Job",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NabvOriginNACUUIDLabel: "nabv-uuid"}),
+				Annotations: map[string]string{
+					NabvOriginNamespaceAnnotation: "tenant",
+					NabvOriginNameAnnotation:      "weekly",
+					SchemaVersionAnnotation:       SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminBackupVerification, NACUUID: "nabv-uuid", Namespace: "tenant", Name: "weekly"},
+		},
+		{
+			name: "Group backup NonAdminBackup",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NagbOriginNACUUIDLabel: "nagb-uuid"}),
+				Annotations: map[string]string{
+					NagbOriginNamespaceAnnotation: "tenant",
+					NagbOriginNameAnnotation:      "app",
+					SchemaVersionAnnotation:       SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminGroupBackup, NACUUID: "nagb-uuid", Namespace: "tenant", Name: "app"},
+		},
+		{
+			name: "Velero Schedule",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NasOriginNACUUIDLabel: "nas-uuid"}),
+				Annotations: map[string]string{
+					NasOriginNamespaceAnnotation: "tenant",
+					NasOriginNameAnnotation:      "daily",
+					SchemaVersionAnnotation:      SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminSchedule, NACUUID: "nas-uuid", Namespace: "tenant", Name: "daily"},
+		},
+		{
+			name: "Velero Backup of a Velero Schedule adopted by a NonAdminBackup",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(map[string]string{NasOriginNACUUIDLabel: "nas-uuid", NabOriginNACUUIDLabel: "nab-uuid"}),
+				Annotations: map[string]string{
+					NasOriginNamespaceAnnotation: "tenant",
+					NasOriginNameAnnotation:      "daily",
+					NabOriginNamespaceAnnotation: "tenant",
+					NabOriginNameAnnotation:      "daily-20240101000000",
+					SchemaVersionAnnotation:      SchemaVersion,
+				},
+			},
+			expectedVersion: SchemaVersion1,
+			expectedOrigin:  Origin{Kind: KindNonAdminBackup, NACUUID: "nab-uuid", Namespace: "tenant", Name: "daily-20240101000000"},
+		},
+		{
+			name: "Newer schema",
+			objectMeta: metav1.ObjectMeta{
+				Labels:      nacLabels(map[string]string{NabOriginNACUUIDLabel: "nab-uuid"}),
+				Annotations: map[string]string{SchemaVersionAnnotation: "99"},
+			},
+			expectedVersion: "99",
+			expectedError:   ErrUnsupportedSchemaVersion,
+		},
+		{
+			name: "Object not created by NAC",
+			objectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{NabOriginNACUUIDLabel: "nab-uuid"},
+			},
+			expectedVersion: LegacySchemaVersion,
+			expectedError:   ErrNotManagedByNAC,
+		},
+		{
+			name: "Object created by NAC for unknown kind",
+			objectMeta: metav1.ObjectMeta{
+				Labels: nacLabels(nil),
+			},
+			expectedVersion: LegacySchemaVersion,
+			expectedError:   ErrNotManagedByNAC,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedVersion, GetSchemaVersion(&tt.objectMeta))
+			origin, err := GetOrigin(&tt.objectMeta)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedOrigin, origin)
+		})
+	}
+}
+
+func TestSetOrigin(t *testing.T) {
+	origin := Origin{Kind: KindNonAdminBackup, NACUUID: "nab-uuid", Namespace: "tenant", Name: "nightly"}
+	objectMeta := &metav1.ObjectMeta{Labels: map[string]string{"app": "nac"}}
+	assert.NoError(t, SetOrigin(objectMeta, origin))
+	assert.Equal(t, "nac", objectMeta.Labels["app"])
+	assert.Equal(t, SchemaVersion, GetSchemaVersion(objectMeta))
+
+	readOrigin, err := GetOrigin(objectMeta)
+	assert.NoError(t, err)
+	assert.Equal(t, origin, readOrigin)
+
+	assert.ErrorIs(t, SetOrigin(&metav1.ObjectMeta{}, Origin{Kind: "Unknown"}), ErrNotManagedByNAC)
+}